@@ -200,6 +200,7 @@ type DecisionLogEntry struct {
 	//
 	//	*DecisionLogEntry_CheckResources_
 	//	*DecisionLogEntry_PlanResources_
+	//	*DecisionLogEntry_CheckResourcesDivergence_
 	Method        isDecisionLogEntry_Method `protobuf_oneof:"method"`
 	Metadata      map[string]*MetaValues    `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	AuditTrail    *AuditTrail               `protobuf:"bytes,16,opt,name=audit_trail,json=auditTrail,proto3" json:"audit_trail,omitempty"`
@@ -309,6 +310,15 @@ func (x *DecisionLogEntry) GetPlanResources() *DecisionLogEntry_PlanResources {
 	return nil
 }
 
+func (x *DecisionLogEntry) GetCheckResourcesDivergence() *DecisionLogEntry_CheckResourcesDivergence {
+	if x != nil {
+		if x, ok := x.Method.(*DecisionLogEntry_CheckResourcesDivergence_); ok {
+			return x.CheckResourcesDivergence
+		}
+	}
+	return nil
+}
+
 func (x *DecisionLogEntry) GetMetadata() map[string]*MetaValues {
 	if x != nil {
 		return x.Metadata
@@ -349,10 +359,16 @@ type DecisionLogEntry_PlanResources_ struct {
 	PlanResources *DecisionLogEntry_PlanResources `protobuf:"bytes,8,opt,name=plan_resources,json=planResources,proto3,oneof"`
 }
 
+type DecisionLogEntry_CheckResourcesDivergence_ struct {
+	CheckResourcesDivergence *DecisionLogEntry_CheckResourcesDivergence `protobuf:"bytes,9,opt,name=check_resources_divergence,json=checkResourcesDivergence,proto3,oneof"`
+}
+
 func (*DecisionLogEntry_CheckResources_) isDecisionLogEntry_Method() {}
 
 func (*DecisionLogEntry_PlanResources_) isDecisionLogEntry_Method() {}
 
+func (*DecisionLogEntry_CheckResourcesDivergence_) isDecisionLogEntry_Method() {}
+
 type MetaValues struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
@@ -716,10 +732,13 @@ func (x *DecisionLogEntry_CheckResources) GetError() string {
 }
 
 type DecisionLogEntry_PlanResources struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Input         *v1.PlanResourcesInput  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
-	Output        *v1.PlanResourcesOutput `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
-	Error         string                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	state  protoimpl.MessageState  `protogen:"open.v1"`
+	Input  *v1.PlanResourcesInput  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Output *v1.PlanResourcesOutput `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	Error  string                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Inputs and outputs of a request that planned multiple resource kinds at once.
+	Inputs        []*v1.PlanResourcesInput  `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs       []*v1.PlanResourcesOutput `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -775,6 +794,100 @@ func (x *DecisionLogEntry_PlanResources) GetError() string {
 	return ""
 }
 
+func (x *DecisionLogEntry_PlanResources) GetInputs() []*v1.PlanResourcesInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *DecisionLogEntry_PlanResources) GetOutputs() []*v1.PlanResourcesOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+// CheckResourcesDivergence records the inputs of a CheckResources call for which the shadow policies produced a different result.
+type DecisionLogEntry_CheckResourcesDivergence struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Inputs []*v1.CheckInput       `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Outputs produced by the live policies.
+	Outputs []*v1.CheckOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Outputs produced by the shadow policies.
+	ShadowOutputs []*v1.CheckOutput `protobuf:"bytes,3,rep,name=shadow_outputs,json=shadowOutputs,proto3" json:"shadow_outputs,omitempty"`
+	// Error returned while evaluating the shadow policies.
+	ShadowError        string        `protobuf:"bytes,4,opt,name=shadow_error,json=shadowError,proto3" json:"shadow_error,omitempty"`
+	ShadowPolicySource *PolicySource `protobuf:"bytes,5,opt,name=shadow_policy_source,json=shadowPolicySource,proto3" json:"shadow_policy_source,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) Reset() {
+	*x = DecisionLogEntry_CheckResourcesDivergence{}
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionLogEntry_CheckResourcesDivergence) ProtoMessage() {}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionLogEntry_CheckResourcesDivergence.ProtoReflect.Descriptor instead.
+func (*DecisionLogEntry_CheckResourcesDivergence) Descriptor() ([]byte, []int) {
+	return file_cerbos_audit_v1_audit_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) GetInputs() []*v1.CheckInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) GetOutputs() []*v1.CheckOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) GetShadowOutputs() []*v1.CheckOutput {
+	if x != nil {
+		return x.ShadowOutputs
+	}
+	return nil
+}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) GetShadowError() string {
+	if x != nil {
+		return x.ShadowError
+	}
+	return ""
+}
+
+func (x *DecisionLogEntry_CheckResourcesDivergence) GetShadowPolicySource() *PolicySource {
+	if x != nil {
+		return x.ShadowPolicySource
+	}
+	return nil
+}
+
 type PolicySource_Blob struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BucketUrl     string                 `protobuf:"bytes,1,opt,name=bucket_url,json=bucketUrl,proto3" json:"bucket_url,omitempty"`
@@ -785,7 +898,7 @@ type PolicySource_Blob struct {
 
 func (x *PolicySource_Blob) Reset() {
 	*x = PolicySource_Blob{}
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[11]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -797,7 +910,7 @@ func (x *PolicySource_Blob) String() string {
 func (*PolicySource_Blob) ProtoMessage() {}
 
 func (x *PolicySource_Blob) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[11]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +949,7 @@ type PolicySource_Database struct {
 
 func (x *PolicySource_Database) Reset() {
 	*x = PolicySource_Database{}
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[12]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -848,7 +961,7 @@ func (x *PolicySource_Database) String() string {
 func (*PolicySource_Database) ProtoMessage() {}
 
 func (x *PolicySource_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[12]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +993,7 @@ type PolicySource_Disk struct {
 
 func (x *PolicySource_Disk) Reset() {
 	*x = PolicySource_Disk{}
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[13]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -892,7 +1005,7 @@ func (x *PolicySource_Disk) String() string {
 func (*PolicySource_Disk) ProtoMessage() {}
 
 func (x *PolicySource_Disk) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[13]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1039,7 @@ type PolicySource_EmbeddedPDP struct {
 
 func (x *PolicySource_EmbeddedPDP) Reset() {
 	*x = PolicySource_EmbeddedPDP{}
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[14]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -938,7 +1051,7 @@ func (x *PolicySource_EmbeddedPDP) String() string {
 func (*PolicySource_EmbeddedPDP) ProtoMessage() {}
 
 func (x *PolicySource_EmbeddedPDP) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[14]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,7 +1099,7 @@ type PolicySource_Git struct {
 
 func (x *PolicySource_Git) Reset() {
 	*x = PolicySource_Git{}
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[15]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -998,7 +1111,7 @@ func (x *PolicySource_Git) String() string {
 func (*PolicySource_Git) ProtoMessage() {}
 
 func (x *PolicySource_Git) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[15]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,7 +1163,7 @@ type PolicySource_Hub struct {
 
 func (x *PolicySource_Hub) Reset() {
 	*x = PolicySource_Hub{}
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[16]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1062,7 +1175,7 @@ func (x *PolicySource_Hub) String() string {
 func (*PolicySource_Hub) ProtoMessage() {}
 
 func (x *PolicySource_Hub) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[16]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1271,7 @@ type PolicySource_Hub_LocalBundle struct {
 
 func (x *PolicySource_Hub_LocalBundle) Reset() {
 	*x = PolicySource_Hub_LocalBundle{}
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[17]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1170,7 +1283,7 @@ func (x *PolicySource_Hub_LocalBundle) String() string {
 func (*PolicySource_Hub_LocalBundle) ProtoMessage() {}
 
 func (x *PolicySource_Hub_LocalBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[17]
+	mi := &file_cerbos_audit_v1_audit_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1323,7 @@ const file_cerbos_audit_v1_audit_proto_rawDesc = "" +
 	"\rpolicy_source\x18\b \x01(\v2\x1d.cerbos.audit.v1.PolicySourceR\fpolicySource\x1aX\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
-	"\x05value\x18\x02 \x01(\v2\x1b.cerbos.audit.v1.MetaValuesR\x05value:\x028\x01\"\xe4\b\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.cerbos.audit.v1.MetaValuesR\x05value:\x028\x01\"\xa5\r\n" +
 	"\x10DecisionLogEntry\x12\x17\n" +
 	"\acall_id\x18\x01 \x01(\tR\x06callId\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12)\n" +
@@ -1219,7 +1332,8 @@ const file_cerbos_audit_v1_audit_proto_rawDesc = "" +
 	"\aoutputs\x18\x05 \x03(\v2\x1d.cerbos.engine.v1.CheckOutputB\x02\x18\x01R\aoutputs\x12\x18\n" +
 	"\x05error\x18\x06 \x01(\tB\x02\x18\x01R\x05error\x12[\n" +
 	"\x0fcheck_resources\x18\a \x01(\v20.cerbos.audit.v1.DecisionLogEntry.CheckResourcesH\x00R\x0echeckResources\x12X\n" +
-	"\x0eplan_resources\x18\b \x01(\v2/.cerbos.audit.v1.DecisionLogEntry.PlanResourcesH\x00R\rplanResources\x12K\n" +
+	"\x0eplan_resources\x18\b \x01(\v2/.cerbos.audit.v1.DecisionLogEntry.PlanResourcesH\x00R\rplanResources\x12z\n" +
+	"\x1acheck_resources_divergence\x18\t \x01(\v2:.cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergenceH\x00R\x18checkResourcesDivergence\x12K\n" +
 	"\bmetadata\x18\x0f \x03(\v2/.cerbos.audit.v1.DecisionLogEntry.MetadataEntryR\bmetadata\x12<\n" +
 	"\vaudit_trail\x18\x10 \x01(\v2\x1b.cerbos.audit.v1.AuditTrailR\n" +
 	"auditTrail\x12\x1c\n" +
@@ -1228,11 +1342,19 @@ const file_cerbos_audit_v1_audit_proto_rawDesc = "" +
 	"\x0eCheckResources\x124\n" +
 	"\x06inputs\x18\x01 \x03(\v2\x1c.cerbos.engine.v1.CheckInputR\x06inputs\x127\n" +
 	"\aoutputs\x18\x02 \x03(\v2\x1d.cerbos.engine.v1.CheckOutputR\aoutputs\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\x1a\xa0\x01\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x1a\x9f\x02\n" +
 	"\rPlanResources\x12:\n" +
 	"\x05input\x18\x01 \x01(\v2$.cerbos.engine.v1.PlanResourcesInputR\x05input\x12=\n" +
 	"\x06output\x18\x02 \x01(\v2%.cerbos.engine.v1.PlanResourcesOutputR\x06output\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\x1aX\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12<\n" +
+	"\x06inputs\x18\x04 \x03(\v2$.cerbos.engine.v1.PlanResourcesInputR\x06inputs\x12?\n" +
+	"\aoutputs\x18\x05 \x03(\v2%.cerbos.engine.v1.PlanResourcesOutputR\aoutputs\x1a\xc3\x02\n" +
+	"\x18CheckResourcesDivergence\x124\n" +
+	"\x06inputs\x18\x01 \x03(\v2\x1c.cerbos.engine.v1.CheckInputR\x06inputs\x127\n" +
+	"\aoutputs\x18\x02 \x03(\v2\x1d.cerbos.engine.v1.CheckOutputR\aoutputs\x12D\n" +
+	"\x0eshadow_outputs\x18\x03 \x03(\v2\x1d.cerbos.engine.v1.CheckOutputR\rshadowOutputs\x12!\n" +
+	"\fshadow_error\x18\x04 \x01(\tR\vshadowError\x12O\n" +
+	"\x14shadow_policy_source\x18\x05 \x01(\v2\x1d.cerbos.audit.v1.PolicySourceR\x12shadowPolicySource\x1aX\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
 	"\x05value\x18\x02 \x01(\v2\x1b.cerbos.audit.v1.MetaValuesR\x05value:\x028\x01B\b\n" +
@@ -1305,7 +1427,7 @@ func file_cerbos_audit_v1_audit_proto_rawDescGZIP() []byte {
 }
 
 var file_cerbos_audit_v1_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cerbos_audit_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_cerbos_audit_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_cerbos_audit_v1_audit_proto_goTypes = []any{
 	(PolicySource_Database_Driver)(0),       // 0: cerbos.audit.v1.PolicySource.Database.Driver
 	(*AccessLogEntry)(nil),                  // 1: cerbos.audit.v1.AccessLogEntry
@@ -1317,58 +1439,66 @@ var file_cerbos_audit_v1_audit_proto_goTypes = []any{
 	nil,                                     // 7: cerbos.audit.v1.AccessLogEntry.MetadataEntry
 	(*DecisionLogEntry_CheckResources)(nil), // 8: cerbos.audit.v1.DecisionLogEntry.CheckResources
 	(*DecisionLogEntry_PlanResources)(nil),  // 9: cerbos.audit.v1.DecisionLogEntry.PlanResources
-	nil,                                     // 10: cerbos.audit.v1.DecisionLogEntry.MetadataEntry
-	nil,                                     // 11: cerbos.audit.v1.AuditTrail.EffectivePoliciesEntry
-	(*PolicySource_Blob)(nil),               // 12: cerbos.audit.v1.PolicySource.Blob
-	(*PolicySource_Database)(nil),           // 13: cerbos.audit.v1.PolicySource.Database
-	(*PolicySource_Disk)(nil),               // 14: cerbos.audit.v1.PolicySource.Disk
-	(*PolicySource_EmbeddedPDP)(nil),        // 15: cerbos.audit.v1.PolicySource.EmbeddedPDP
-	(*PolicySource_Git)(nil),                // 16: cerbos.audit.v1.PolicySource.Git
-	(*PolicySource_Hub)(nil),                // 17: cerbos.audit.v1.PolicySource.Hub
-	(*PolicySource_Hub_LocalBundle)(nil),    // 18: cerbos.audit.v1.PolicySource.Hub.LocalBundle
-	(*timestamppb.Timestamp)(nil),           // 19: google.protobuf.Timestamp
-	(*v1.CheckInput)(nil),                   // 20: cerbos.engine.v1.CheckInput
-	(*v1.CheckOutput)(nil),                  // 21: cerbos.engine.v1.CheckOutput
-	(*v1.PlanResourcesInput)(nil),           // 22: cerbos.engine.v1.PlanResourcesInput
-	(*v1.PlanResourcesOutput)(nil),          // 23: cerbos.engine.v1.PlanResourcesOutput
-	(*v11.SourceAttributes)(nil),            // 24: cerbos.policy.v1.SourceAttributes
+	(*DecisionLogEntry_CheckResourcesDivergence)(nil), // 10: cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence
+	nil,                                  // 11: cerbos.audit.v1.DecisionLogEntry.MetadataEntry
+	nil,                                  // 12: cerbos.audit.v1.AuditTrail.EffectivePoliciesEntry
+	(*PolicySource_Blob)(nil),            // 13: cerbos.audit.v1.PolicySource.Blob
+	(*PolicySource_Database)(nil),        // 14: cerbos.audit.v1.PolicySource.Database
+	(*PolicySource_Disk)(nil),            // 15: cerbos.audit.v1.PolicySource.Disk
+	(*PolicySource_EmbeddedPDP)(nil),     // 16: cerbos.audit.v1.PolicySource.EmbeddedPDP
+	(*PolicySource_Git)(nil),             // 17: cerbos.audit.v1.PolicySource.Git
+	(*PolicySource_Hub)(nil),             // 18: cerbos.audit.v1.PolicySource.Hub
+	(*PolicySource_Hub_LocalBundle)(nil), // 19: cerbos.audit.v1.PolicySource.Hub.LocalBundle
+	(*timestamppb.Timestamp)(nil),        // 20: google.protobuf.Timestamp
+	(*v1.CheckInput)(nil),                // 21: cerbos.engine.v1.CheckInput
+	(*v1.CheckOutput)(nil),               // 22: cerbos.engine.v1.CheckOutput
+	(*v1.PlanResourcesInput)(nil),        // 23: cerbos.engine.v1.PlanResourcesInput
+	(*v1.PlanResourcesOutput)(nil),       // 24: cerbos.engine.v1.PlanResourcesOutput
+	(*v11.SourceAttributes)(nil),         // 25: cerbos.policy.v1.SourceAttributes
 }
 var file_cerbos_audit_v1_audit_proto_depIdxs = []int32{
-	19, // 0: cerbos.audit.v1.AccessLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 0: cerbos.audit.v1.AccessLogEntry.timestamp:type_name -> google.protobuf.Timestamp
 	4,  // 1: cerbos.audit.v1.AccessLogEntry.peer:type_name -> cerbos.audit.v1.Peer
 	7,  // 2: cerbos.audit.v1.AccessLogEntry.metadata:type_name -> cerbos.audit.v1.AccessLogEntry.MetadataEntry
 	6,  // 3: cerbos.audit.v1.AccessLogEntry.policy_source:type_name -> cerbos.audit.v1.PolicySource
-	19, // 4: cerbos.audit.v1.DecisionLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 4: cerbos.audit.v1.DecisionLogEntry.timestamp:type_name -> google.protobuf.Timestamp
 	4,  // 5: cerbos.audit.v1.DecisionLogEntry.peer:type_name -> cerbos.audit.v1.Peer
-	20, // 6: cerbos.audit.v1.DecisionLogEntry.inputs:type_name -> cerbos.engine.v1.CheckInput
-	21, // 7: cerbos.audit.v1.DecisionLogEntry.outputs:type_name -> cerbos.engine.v1.CheckOutput
+	21, // 6: cerbos.audit.v1.DecisionLogEntry.inputs:type_name -> cerbos.engine.v1.CheckInput
+	22, // 7: cerbos.audit.v1.DecisionLogEntry.outputs:type_name -> cerbos.engine.v1.CheckOutput
 	8,  // 8: cerbos.audit.v1.DecisionLogEntry.check_resources:type_name -> cerbos.audit.v1.DecisionLogEntry.CheckResources
 	9,  // 9: cerbos.audit.v1.DecisionLogEntry.plan_resources:type_name -> cerbos.audit.v1.DecisionLogEntry.PlanResources
-	10, // 10: cerbos.audit.v1.DecisionLogEntry.metadata:type_name -> cerbos.audit.v1.DecisionLogEntry.MetadataEntry
-	5,  // 11: cerbos.audit.v1.DecisionLogEntry.audit_trail:type_name -> cerbos.audit.v1.AuditTrail
-	6,  // 12: cerbos.audit.v1.DecisionLogEntry.policy_source:type_name -> cerbos.audit.v1.PolicySource
-	11, // 13: cerbos.audit.v1.AuditTrail.effective_policies:type_name -> cerbos.audit.v1.AuditTrail.EffectivePoliciesEntry
-	12, // 14: cerbos.audit.v1.PolicySource.blob:type_name -> cerbos.audit.v1.PolicySource.Blob
-	13, // 15: cerbos.audit.v1.PolicySource.database:type_name -> cerbos.audit.v1.PolicySource.Database
-	14, // 16: cerbos.audit.v1.PolicySource.disk:type_name -> cerbos.audit.v1.PolicySource.Disk
-	16, // 17: cerbos.audit.v1.PolicySource.git:type_name -> cerbos.audit.v1.PolicySource.Git
-	17, // 18: cerbos.audit.v1.PolicySource.hub:type_name -> cerbos.audit.v1.PolicySource.Hub
-	15, // 19: cerbos.audit.v1.PolicySource.embedded_pdp:type_name -> cerbos.audit.v1.PolicySource.EmbeddedPDP
-	3,  // 20: cerbos.audit.v1.AccessLogEntry.MetadataEntry.value:type_name -> cerbos.audit.v1.MetaValues
-	20, // 21: cerbos.audit.v1.DecisionLogEntry.CheckResources.inputs:type_name -> cerbos.engine.v1.CheckInput
-	21, // 22: cerbos.audit.v1.DecisionLogEntry.CheckResources.outputs:type_name -> cerbos.engine.v1.CheckOutput
-	22, // 23: cerbos.audit.v1.DecisionLogEntry.PlanResources.input:type_name -> cerbos.engine.v1.PlanResourcesInput
-	23, // 24: cerbos.audit.v1.DecisionLogEntry.PlanResources.output:type_name -> cerbos.engine.v1.PlanResourcesOutput
-	3,  // 25: cerbos.audit.v1.DecisionLogEntry.MetadataEntry.value:type_name -> cerbos.audit.v1.MetaValues
-	24, // 26: cerbos.audit.v1.AuditTrail.EffectivePoliciesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
-	0,  // 27: cerbos.audit.v1.PolicySource.Database.driver:type_name -> cerbos.audit.v1.PolicySource.Database.Driver
-	19, // 28: cerbos.audit.v1.PolicySource.EmbeddedPDP.built_at:type_name -> google.protobuf.Timestamp
-	18, // 29: cerbos.audit.v1.PolicySource.Hub.local_bundle:type_name -> cerbos.audit.v1.PolicySource.Hub.LocalBundle
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	10, // 10: cerbos.audit.v1.DecisionLogEntry.check_resources_divergence:type_name -> cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence
+	11, // 11: cerbos.audit.v1.DecisionLogEntry.metadata:type_name -> cerbos.audit.v1.DecisionLogEntry.MetadataEntry
+	5,  // 12: cerbos.audit.v1.DecisionLogEntry.audit_trail:type_name -> cerbos.audit.v1.AuditTrail
+	6,  // 13: cerbos.audit.v1.DecisionLogEntry.policy_source:type_name -> cerbos.audit.v1.PolicySource
+	12, // 14: cerbos.audit.v1.AuditTrail.effective_policies:type_name -> cerbos.audit.v1.AuditTrail.EffectivePoliciesEntry
+	13, // 15: cerbos.audit.v1.PolicySource.blob:type_name -> cerbos.audit.v1.PolicySource.Blob
+	14, // 16: cerbos.audit.v1.PolicySource.database:type_name -> cerbos.audit.v1.PolicySource.Database
+	15, // 17: cerbos.audit.v1.PolicySource.disk:type_name -> cerbos.audit.v1.PolicySource.Disk
+	17, // 18: cerbos.audit.v1.PolicySource.git:type_name -> cerbos.audit.v1.PolicySource.Git
+	18, // 19: cerbos.audit.v1.PolicySource.hub:type_name -> cerbos.audit.v1.PolicySource.Hub
+	16, // 20: cerbos.audit.v1.PolicySource.embedded_pdp:type_name -> cerbos.audit.v1.PolicySource.EmbeddedPDP
+	3,  // 21: cerbos.audit.v1.AccessLogEntry.MetadataEntry.value:type_name -> cerbos.audit.v1.MetaValues
+	21, // 22: cerbos.audit.v1.DecisionLogEntry.CheckResources.inputs:type_name -> cerbos.engine.v1.CheckInput
+	22, // 23: cerbos.audit.v1.DecisionLogEntry.CheckResources.outputs:type_name -> cerbos.engine.v1.CheckOutput
+	23, // 24: cerbos.audit.v1.DecisionLogEntry.PlanResources.input:type_name -> cerbos.engine.v1.PlanResourcesInput
+	24, // 25: cerbos.audit.v1.DecisionLogEntry.PlanResources.output:type_name -> cerbos.engine.v1.PlanResourcesOutput
+	23, // 26: cerbos.audit.v1.DecisionLogEntry.PlanResources.inputs:type_name -> cerbos.engine.v1.PlanResourcesInput
+	24, // 27: cerbos.audit.v1.DecisionLogEntry.PlanResources.outputs:type_name -> cerbos.engine.v1.PlanResourcesOutput
+	21, // 28: cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.inputs:type_name -> cerbos.engine.v1.CheckInput
+	22, // 29: cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.outputs:type_name -> cerbos.engine.v1.CheckOutput
+	22, // 30: cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_outputs:type_name -> cerbos.engine.v1.CheckOutput
+	6,  // 31: cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_policy_source:type_name -> cerbos.audit.v1.PolicySource
+	3,  // 32: cerbos.audit.v1.DecisionLogEntry.MetadataEntry.value:type_name -> cerbos.audit.v1.MetaValues
+	25, // 33: cerbos.audit.v1.AuditTrail.EffectivePoliciesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
+	0,  // 34: cerbos.audit.v1.PolicySource.Database.driver:type_name -> cerbos.audit.v1.PolicySource.Database.Driver
+	20, // 35: cerbos.audit.v1.PolicySource.EmbeddedPDP.built_at:type_name -> google.protobuf.Timestamp
+	19, // 36: cerbos.audit.v1.PolicySource.Hub.local_bundle:type_name -> cerbos.audit.v1.PolicySource.Hub.LocalBundle
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_cerbos_audit_v1_audit_proto_init() }
@@ -1379,6 +1509,7 @@ func file_cerbos_audit_v1_audit_proto_init() {
 	file_cerbos_audit_v1_audit_proto_msgTypes[1].OneofWrappers = []any{
 		(*DecisionLogEntry_CheckResources_)(nil),
 		(*DecisionLogEntry_PlanResources_)(nil),
+		(*DecisionLogEntry_CheckResourcesDivergence_)(nil),
 	}
 	file_cerbos_audit_v1_audit_proto_msgTypes[5].OneofWrappers = []any{
 		(*PolicySource_Blob_)(nil),
@@ -1388,7 +1519,7 @@ func file_cerbos_audit_v1_audit_proto_init() {
 		(*PolicySource_Hub_)(nil),
 		(*PolicySource_EmbeddedPdp)(nil),
 	}
-	file_cerbos_audit_v1_audit_proto_msgTypes[16].OneofWrappers = []any{
+	file_cerbos_audit_v1_audit_proto_msgTypes[17].OneofWrappers = []any{
 		(*PolicySource_Hub_Label)(nil),
 		(*PolicySource_Hub_DeploymentId)(nil),
 		(*PolicySource_Hub_PlaygroundId)(nil),
@@ -1400,7 +1531,7 @@ func file_cerbos_audit_v1_audit_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cerbos_audit_v1_audit_proto_rawDesc), len(file_cerbos_audit_v1_audit_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   18,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -40,6 +40,14 @@ func (m *DecisionLogEntry_PlanResources) HashPB(hasher hash.Hash, ignore map[str
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *DecisionLogEntry_CheckResourcesDivergence) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_audit_v1_DecisionLogEntry_CheckResourcesDivergence_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *MetaValues) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
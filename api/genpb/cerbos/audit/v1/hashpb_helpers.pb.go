@@ -74,6 +74,45 @@ func cerbos_audit_v1_AuditTrail_hashpb_sum(m *AuditTrail, hasher hash.Hash, igno
 	}
 }
 
+func cerbos_audit_v1_DecisionLogEntry_CheckResourcesDivergence_hashpb_sum(m *DecisionLogEntry_CheckResourcesDivergence, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.inputs"]; !ok {
+		if len(m.Inputs) > 0 {
+			for _, v := range m.Inputs {
+				if v != nil {
+					cerbos_engine_v1_CheckInput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.outputs"]; !ok {
+		if len(m.Outputs) > 0 {
+			for _, v := range m.Outputs {
+				if v != nil {
+					cerbos_engine_v1_CheckOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_outputs"]; !ok {
+		if len(m.ShadowOutputs) > 0 {
+			for _, v := range m.ShadowOutputs {
+				if v != nil {
+					cerbos_engine_v1_CheckOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetShadowError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetShadowError()), len(m.GetShadowError())))
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_policy_source"]; !ok {
+		if m.GetShadowPolicySource() != nil {
+			cerbos_audit_v1_PolicySource_hashpb_sum(m.GetShadowPolicySource(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *DecisionLogEntry_CheckResources, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.inputs"]; !ok {
 		if len(m.Inputs) > 0 {
@@ -114,6 +153,24 @@ func cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(m *DecisionLogEnt
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
 		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.PlanResources.inputs"]; !ok {
+		if len(m.Inputs) > 0 {
+			for _, v := range m.Inputs {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesInput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.PlanResources.outputs"]; !ok {
+		if len(m.Outputs) > 0 {
+			for _, v := range m.Outputs {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *DecisionLogEntry, hasher hash.Hash, ignore map[string]struct{}) {
@@ -164,6 +221,10 @@ func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *DecisionLogEntry, hasher has
 				if t.PlanResources != nil {
 					cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(t.PlanResources, hasher, ignore)
 				}
+			case *DecisionLogEntry_CheckResourcesDivergence_:
+				if t.CheckResourcesDivergence != nil {
+					cerbos_audit_v1_DecisionLogEntry_CheckResourcesDivergence_hashpb_sum(t.CheckResourcesDivergence, hasher, ignore)
+				}
 			}
 		}
 	}
@@ -352,6 +413,17 @@ func cerbos_engine_v1_AuxData_hashpb_sum(m *v1.AuxData, hasher hash.Hash, ignore
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.AuxData.providers"]; !ok {
+		if len(m.Providers) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Providers)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Providers[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Providers[k], hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckInput_hashpb_sum(m *v1.CheckInput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -505,6 +577,15 @@ func cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m *v1.PlanResourcesFilter,
 	}
 }
 
+func cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m *v1.PlanResourcesInput_FilterOptions, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.simplify"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSimplify())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.normal_form"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetNormalForm())))
+	}
+}
+
 func cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m *v1.PlanResourcesInput_Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKind()))))
@@ -566,6 +647,11 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *v1.PlanResourcesInput, ha
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v1.PlanResourcesOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -625,6 +711,11 @@ func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v1.PlanResourcesOutput,
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -19,6 +19,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -31,6 +32,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlanResourcesInput_FilterOptions_NormalForm int32
+
+const (
+	PlanResourcesInput_FilterOptions_NORMAL_FORM_UNSPECIFIED PlanResourcesInput_FilterOptions_NormalForm = 0
+	PlanResourcesInput_FilterOptions_NORMAL_FORM_DNF         PlanResourcesInput_FilterOptions_NormalForm = 1
+	PlanResourcesInput_FilterOptions_NORMAL_FORM_CNF         PlanResourcesInput_FilterOptions_NormalForm = 2
+)
+
+// Enum value maps for PlanResourcesInput_FilterOptions_NormalForm.
+var (
+	PlanResourcesInput_FilterOptions_NormalForm_name = map[int32]string{
+		0: "NORMAL_FORM_UNSPECIFIED",
+		1: "NORMAL_FORM_DNF",
+		2: "NORMAL_FORM_CNF",
+	}
+	PlanResourcesInput_FilterOptions_NormalForm_value = map[string]int32{
+		"NORMAL_FORM_UNSPECIFIED": 0,
+		"NORMAL_FORM_DNF":         1,
+		"NORMAL_FORM_CNF":         2,
+	}
+)
+
+func (x PlanResourcesInput_FilterOptions_NormalForm) Enum() *PlanResourcesInput_FilterOptions_NormalForm {
+	p := new(PlanResourcesInput_FilterOptions_NormalForm)
+	*p = x
+	return p
+}
+
+func (x PlanResourcesInput_FilterOptions_NormalForm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanResourcesInput_FilterOptions_NormalForm) Descriptor() protoreflect.EnumDescriptor {
+	return file_cerbos_engine_v1_engine_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanResourcesInput_FilterOptions_NormalForm) Type() protoreflect.EnumType {
+	return &file_cerbos_engine_v1_engine_proto_enumTypes[0]
+}
+
+func (x PlanResourcesInput_FilterOptions_NormalForm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanResourcesInput_FilterOptions_NormalForm.Descriptor instead.
+func (PlanResourcesInput_FilterOptions_NormalForm) EnumDescriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
 type PlanResourcesAst_LogicalOperation_Operator int32
 
 const (
@@ -67,11 +117,11 @@ func (x PlanResourcesAst_LogicalOperation_Operator) String() string {
 }
 
 func (PlanResourcesAst_LogicalOperation_Operator) Descriptor() protoreflect.EnumDescriptor {
-	return file_cerbos_engine_v1_engine_proto_enumTypes[0].Descriptor()
+	return file_cerbos_engine_v1_engine_proto_enumTypes[1].Descriptor()
 }
 
 func (PlanResourcesAst_LogicalOperation_Operator) Type() protoreflect.EnumType {
-	return &file_cerbos_engine_v1_engine_proto_enumTypes[0]
+	return &file_cerbos_engine_v1_engine_proto_enumTypes[1]
 }
 
 func (x PlanResourcesAst_LogicalOperation_Operator) Number() protoreflect.EnumNumber {
@@ -119,11 +169,11 @@ func (x PlanResourcesFilter_Kind) String() string {
 }
 
 func (PlanResourcesFilter_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_cerbos_engine_v1_engine_proto_enumTypes[1].Descriptor()
+	return file_cerbos_engine_v1_engine_proto_enumTypes[2].Descriptor()
 }
 
 func (PlanResourcesFilter_Kind) Type() protoreflect.EnumType {
-	return &file_cerbos_engine_v1_engine_proto_enumTypes[1]
+	return &file_cerbos_engine_v1_engine_proto_enumTypes[2]
 }
 
 func (x PlanResourcesFilter_Kind) Number() protoreflect.EnumNumber {
@@ -210,11 +260,11 @@ func (x Trace_Component_Kind) String() string {
 }
 
 func (Trace_Component_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_cerbos_engine_v1_engine_proto_enumTypes[2].Descriptor()
+	return file_cerbos_engine_v1_engine_proto_enumTypes[3].Descriptor()
 }
 
 func (Trace_Component_Kind) Type() protoreflect.EnumType {
-	return &file_cerbos_engine_v1_engine_proto_enumTypes[2]
+	return &file_cerbos_engine_v1_engine_proto_enumTypes[3]
 }
 
 func (x Trace_Component_Kind) Number() protoreflect.EnumNumber {
@@ -223,7 +273,7 @@ func (x Trace_Component_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Trace_Component_Kind.Descriptor instead.
 func (Trace_Component_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{10, 0, 0}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{12, 0, 0}
 }
 
 type Trace_Event_Status int32
@@ -259,11 +309,11 @@ func (x Trace_Event_Status) String() string {
 }
 
 func (Trace_Event_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_cerbos_engine_v1_engine_proto_enumTypes[3].Descriptor()
+	return file_cerbos_engine_v1_engine_proto_enumTypes[4].Descriptor()
 }
 
 func (Trace_Event_Status) Type() protoreflect.EnumType {
-	return &file_cerbos_engine_v1_engine_proto_enumTypes[3]
+	return &file_cerbos_engine_v1_engine_proto_enumTypes[4]
 }
 
 func (x Trace_Event_Status) Number() protoreflect.EnumNumber {
@@ -272,19 +322,136 @@ func (x Trace_Event_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Trace_Event_Status.Descriptor instead.
 func (Trace_Event_Status) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{10, 1, 0}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{12, 1, 0}
+}
+
+type Explanation_Condition_Op int32
+
+const (
+	Explanation_Condition_OP_UNSPECIFIED Explanation_Condition_Op = 0
+	Explanation_Condition_OP_ALL         Explanation_Condition_Op = 1
+	Explanation_Condition_OP_ANY         Explanation_Condition_Op = 2
+	Explanation_Condition_OP_NONE        Explanation_Condition_Op = 3
+	Explanation_Condition_OP_EXPR        Explanation_Condition_Op = 4
+)
+
+// Enum value maps for Explanation_Condition_Op.
+var (
+	Explanation_Condition_Op_name = map[int32]string{
+		0: "OP_UNSPECIFIED",
+		1: "OP_ALL",
+		2: "OP_ANY",
+		3: "OP_NONE",
+		4: "OP_EXPR",
+	}
+	Explanation_Condition_Op_value = map[string]int32{
+		"OP_UNSPECIFIED": 0,
+		"OP_ALL":         1,
+		"OP_ANY":         2,
+		"OP_NONE":        3,
+		"OP_EXPR":        4,
+	}
+)
+
+func (x Explanation_Condition_Op) Enum() *Explanation_Condition_Op {
+	p := new(Explanation_Condition_Op)
+	*p = x
+	return p
+}
+
+func (x Explanation_Condition_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Explanation_Condition_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_cerbos_engine_v1_engine_proto_enumTypes[5].Descriptor()
+}
+
+func (Explanation_Condition_Op) Type() protoreflect.EnumType {
+	return &file_cerbos_engine_v1_engine_proto_enumTypes[5]
+}
+
+func (x Explanation_Condition_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Explanation_Condition_Op.Descriptor instead.
+func (Explanation_Condition_Op) EnumDescriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{13, 1, 0}
+}
+
+type Explanation_Rule_Outcome int32
+
+const (
+	Explanation_Rule_OUTCOME_UNSPECIFIED                Explanation_Rule_Outcome = 0
+	Explanation_Rule_OUTCOME_ACTIVATED                  Explanation_Rule_Outcome = 1
+	Explanation_Rule_OUTCOME_ROLE_MISMATCH              Explanation_Rule_Outcome = 2
+	Explanation_Rule_OUTCOME_DERIVED_ROLE_NOT_ACTIVATED Explanation_Rule_Outcome = 3
+	Explanation_Rule_OUTCOME_CONDITION_NOT_MET          Explanation_Rule_Outcome = 4
+	Explanation_Rule_OUTCOME_CONDITION_ERROR            Explanation_Rule_Outcome = 5
+	Explanation_Rule_OUTCOME_SCOPE_PERMISSIONS_BLOCKED  Explanation_Rule_Outcome = 6
+)
+
+// Enum value maps for Explanation_Rule_Outcome.
+var (
+	Explanation_Rule_Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_ACTIVATED",
+		2: "OUTCOME_ROLE_MISMATCH",
+		3: "OUTCOME_DERIVED_ROLE_NOT_ACTIVATED",
+		4: "OUTCOME_CONDITION_NOT_MET",
+		5: "OUTCOME_CONDITION_ERROR",
+		6: "OUTCOME_SCOPE_PERMISSIONS_BLOCKED",
+	}
+	Explanation_Rule_Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED":                0,
+		"OUTCOME_ACTIVATED":                  1,
+		"OUTCOME_ROLE_MISMATCH":              2,
+		"OUTCOME_DERIVED_ROLE_NOT_ACTIVATED": 3,
+		"OUTCOME_CONDITION_NOT_MET":          4,
+		"OUTCOME_CONDITION_ERROR":            5,
+		"OUTCOME_SCOPE_PERMISSIONS_BLOCKED":  6,
+	}
+)
+
+func (x Explanation_Rule_Outcome) Enum() *Explanation_Rule_Outcome {
+	p := new(Explanation_Rule_Outcome)
+	*p = x
+	return p
+}
+
+func (x Explanation_Rule_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Explanation_Rule_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_cerbos_engine_v1_engine_proto_enumTypes[6].Descriptor()
+}
+
+func (Explanation_Rule_Outcome) Type() protoreflect.EnumType {
+	return &file_cerbos_engine_v1_engine_proto_enumTypes[6]
+}
+
+func (x Explanation_Rule_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Explanation_Rule_Outcome.Descriptor instead.
+func (Explanation_Rule_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{13, 2, 0}
 }
 
 type PlanResourcesInput struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Deprecated: Marked as deprecated in cerbos/engine/v1/engine.proto.
-	Action        string                       `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Actions       []string                     `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
-	Principal     *Principal                   `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
-	Resource      *PlanResourcesInput_Resource `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
-	AuxData       *AuxData                     `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
-	IncludeMeta   bool                         `protobuf:"varint,6,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
+	Action        string                            `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Actions       []string                          `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
+	Principal     *Principal                        `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Resource      *PlanResourcesInput_Resource      `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	AuxData       *AuxData                          `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	IncludeMeta   bool                              `protobuf:"varint,6,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
+	FilterOptions *PlanResourcesInput_FilterOptions `protobuf:"bytes,8,opt,name=filter_options,json=filterOptions,proto3" json:"filter_options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -369,6 +536,13 @@ func (x *PlanResourcesInput) GetIncludeMeta() bool {
 	return false
 }
 
+func (x *PlanResourcesInput) GetFilterOptions() *PlanResourcesInput_FilterOptions {
+	if x != nil {
+		return x.FilterOptions
+	}
+	return nil
+}
+
 type PlanResourcesAst struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FilterAst     *PlanResourcesAst_Node `protobuf:"bytes,1,opt,name=filter_ast,json=filterAst,proto3" json:"filter_ast,omitempty"`
@@ -469,15 +643,16 @@ type PlanResourcesOutput struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Deprecated: Marked as deprecated in cerbos/engine/v1/engine.proto.
-	Action           string                `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Kind             string                `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	PolicyVersion    string                `protobuf:"bytes,4,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
-	Scope            string                `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
-	Filter           *PlanResourcesFilter  `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
-	FilterDebug      string                `protobuf:"bytes,7,opt,name=filter_debug,json=filterDebug,proto3" json:"filter_debug,omitempty"`
-	ValidationErrors []*v1.ValidationError `protobuf:"bytes,8,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
-	Actions          []string              `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
-	MatchedScopes    map[string]string     `protobuf:"bytes,10,rep,name=matched_scopes,json=matchedScopes,proto3" json:"matched_scopes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Action           string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	PolicyVersion    string                 `protobuf:"bytes,4,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Scope            string                 `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	Filter           *PlanResourcesFilter   `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	FilterDebug      string                 `protobuf:"bytes,7,opt,name=filter_debug,json=filterDebug,proto3" json:"filter_debug,omitempty"`
+	ValidationErrors []*v1.ValidationError  `protobuf:"bytes,8,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	Actions          []string               `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+	MatchedScopes    map[string]string      `protobuf:"bytes,10,rep,name=matched_scopes,json=matchedScopes,proto3" json:"matched_scopes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Now              *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=now,proto3" json:"now,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -583,6 +758,197 @@ func (x *PlanResourcesOutput) GetMatchedScopes() map[string]string {
 	return nil
 }
 
+func (x *PlanResourcesOutput) GetNow() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Now
+	}
+	return nil
+}
+
+type PlanPrincipalsInput struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	RequestId     string                         `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Actions       []string                       `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	Resource      *Resource                      `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Principal     *PlanPrincipalsInput_Principal `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	AuxData       *AuxData                       `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	IncludeMeta   bool                           `protobuf:"varint,6,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPrincipalsInput) Reset() {
+	*x = PlanPrincipalsInput{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPrincipalsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPrincipalsInput) ProtoMessage() {}
+
+func (x *PlanPrincipalsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPrincipalsInput.ProtoReflect.Descriptor instead.
+func (*PlanPrincipalsInput) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PlanPrincipalsInput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsInput) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsInput) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsInput) GetPrincipal() *PlanPrincipalsInput_Principal {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsInput) GetAuxData() *AuxData {
+	if x != nil {
+		return x.AuxData
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsInput) GetIncludeMeta() bool {
+	if x != nil {
+		return x.IncludeMeta
+	}
+	return false
+}
+
+type PlanPrincipalsOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Actions       []string               `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PolicyVersion string                 `protobuf:"bytes,5,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Scope         string                 `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+	Filter        *PlanResourcesFilter   `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	FilterDebug   string                 `protobuf:"bytes,8,opt,name=filter_debug,json=filterDebug,proto3" json:"filter_debug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPrincipalsOutput) Reset() {
+	*x = PlanPrincipalsOutput{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPrincipalsOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPrincipalsOutput) ProtoMessage() {}
+
+func (x *PlanPrincipalsOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPrincipalsOutput.ProtoReflect.Descriptor instead.
+func (*PlanPrincipalsOutput) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlanPrincipalsOutput) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsOutput) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsOutput) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsOutput) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsOutput) GetPolicyVersion() string {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsOutput) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsOutput) GetFilter() *PlanResourcesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsOutput) GetFilterDebug() string {
+	if x != nil {
+		return x.FilterDebug
+	}
+	return ""
+}
+
 type CheckInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
@@ -596,7 +962,7 @@ type CheckInput struct {
 
 func (x *CheckInput) Reset() {
 	*x = CheckInput{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[4]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +974,7 @@ func (x *CheckInput) String() string {
 func (*CheckInput) ProtoMessage() {}
 
 func (x *CheckInput) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[4]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +987,7 @@ func (x *CheckInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckInput.ProtoReflect.Descriptor instead.
 func (*CheckInput) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{4}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CheckInput) GetRequestId() string {
@@ -673,7 +1039,7 @@ type CheckOutput struct {
 
 func (x *CheckOutput) Reset() {
 	*x = CheckOutput{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[5]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +1051,7 @@ func (x *CheckOutput) String() string {
 func (*CheckOutput) ProtoMessage() {}
 
 func (x *CheckOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[5]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +1064,7 @@ func (x *CheckOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckOutput.ProtoReflect.Descriptor instead.
 func (*CheckOutput) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{5}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CheckOutput) GetRequestId() string {
@@ -753,7 +1119,7 @@ type OutputEntry struct {
 
 func (x *OutputEntry) Reset() {
 	*x = OutputEntry{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[6]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +1131,7 @@ func (x *OutputEntry) String() string {
 func (*OutputEntry) ProtoMessage() {}
 
 func (x *OutputEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[6]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +1144,7 @@ func (x *OutputEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutputEntry.ProtoReflect.Descriptor instead.
 func (*OutputEntry) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{6}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OutputEntry) GetSrc() string {
@@ -808,7 +1174,7 @@ type Resource struct {
 
 func (x *Resource) Reset() {
 	*x = Resource{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[7]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -820,7 +1186,7 @@ func (x *Resource) String() string {
 func (*Resource) ProtoMessage() {}
 
 func (x *Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[7]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,7 +1199,7 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resource.ProtoReflect.Descriptor instead.
 func (*Resource) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{7}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Resource) GetKind() string {
@@ -884,7 +1250,7 @@ type Principal struct {
 
 func (x *Principal) Reset() {
 	*x = Principal{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[8]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -896,7 +1262,7 @@ func (x *Principal) String() string {
 func (*Principal) ProtoMessage() {}
 
 func (x *Principal) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[8]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1275,7 @@ func (x *Principal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Principal.ProtoReflect.Descriptor instead.
 func (*Principal) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{8}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Principal) GetId() string {
@@ -950,13 +1316,14 @@ func (x *Principal) GetScope() string {
 type AuxData struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Jwt           map[string]*structpb.Value `protobuf:"bytes,1,rep,name=jwt,proto3" json:"jwt,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Providers     map[string]*structpb.Value `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuxData) Reset() {
 	*x = AuxData{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[9]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1335,7 @@ func (x *AuxData) String() string {
 func (*AuxData) ProtoMessage() {}
 
 func (x *AuxData) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[9]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1348,7 @@ func (x *AuxData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuxData.ProtoReflect.Descriptor instead.
 func (*AuxData) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{9}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AuxData) GetJwt() map[string]*structpb.Value {
@@ -991,6 +1358,13 @@ func (x *AuxData) GetJwt() map[string]*structpb.Value {
 	return nil
 }
 
+func (x *AuxData) GetProviders() map[string]*structpb.Value {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
 type Trace struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Components    []*Trace_Component     `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
@@ -1001,7 +1375,7 @@ type Trace struct {
 
 func (x *Trace) Reset() {
 	*x = Trace{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[10]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1013,7 +1387,7 @@ func (x *Trace) String() string {
 func (*Trace) ProtoMessage() {}
 
 func (x *Trace) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[10]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,7 +1400,7 @@ func (x *Trace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trace.ProtoReflect.Descriptor instead.
 func (*Trace) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{10}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Trace) GetComponents() []*Trace_Component {
@@ -1043,6 +1417,50 @@ func (x *Trace) GetEvent() *Trace_Event {
 	return nil
 }
 
+type Explanation struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Actions       map[string]*Explanation_Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Explanation) Reset() {
+	*x = Explanation{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation) ProtoMessage() {}
+
+func (x *Explanation) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation.ProtoReflect.Descriptor instead.
+func (*Explanation) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Explanation) GetActions() map[string]*Explanation_Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
 // Data from the request, provided to expressions as the top-level `request` variable.
 type Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1055,7 +1473,7 @@ type Request struct {
 
 func (x *Request) Reset() {
 	*x = Request{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[11]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1067,7 +1485,7 @@ func (x *Request) String() string {
 func (*Request) ProtoMessage() {}
 
 func (x *Request) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[11]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,7 +1498,7 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Request.ProtoReflect.Descriptor instead.
 func (*Request) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{11}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Request) GetPrincipal() *Request_Principal {
@@ -1114,7 +1532,7 @@ type Runtime struct {
 
 func (x *Runtime) Reset() {
 	*x = Runtime{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[12]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1126,7 +1544,7 @@ func (x *Runtime) String() string {
 func (*Runtime) ProtoMessage() {}
 
 func (x *Runtime) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[12]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,7 +1557,7 @@ func (x *Runtime) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Runtime.ProtoReflect.Descriptor instead.
 func (*Runtime) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{12}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Runtime) GetEffectiveDerivedRoles() []string {
@@ -1161,7 +1579,7 @@ type PlanResourcesInput_Resource struct {
 
 func (x *PlanResourcesInput_Resource) Reset() {
 	*x = PlanResourcesInput_Resource{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[13]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1173,7 +1591,7 @@ func (x *PlanResourcesInput_Resource) String() string {
 func (*PlanResourcesInput_Resource) ProtoMessage() {}
 
 func (x *PlanResourcesInput_Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[13]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,6 +1635,58 @@ func (x *PlanResourcesInput_Resource) GetScope() string {
 	return ""
 }
 
+type PlanResourcesInput_FilterOptions struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Simplify      bool                                        `protobuf:"varint,1,opt,name=simplify,proto3" json:"simplify,omitempty"`
+	NormalForm    PlanResourcesInput_FilterOptions_NormalForm `protobuf:"varint,2,opt,name=normal_form,json=normalForm,proto3,enum=cerbos.engine.v1.PlanResourcesInput_FilterOptions_NormalForm" json:"normal_form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourcesInput_FilterOptions) Reset() {
+	*x = PlanResourcesInput_FilterOptions{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourcesInput_FilterOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourcesInput_FilterOptions) ProtoMessage() {}
+
+func (x *PlanResourcesInput_FilterOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourcesInput_FilterOptions.ProtoReflect.Descriptor instead.
+func (*PlanResourcesInput_FilterOptions) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *PlanResourcesInput_FilterOptions) GetSimplify() bool {
+	if x != nil {
+		return x.Simplify
+	}
+	return false
+}
+
+func (x *PlanResourcesInput_FilterOptions) GetNormalForm() PlanResourcesInput_FilterOptions_NormalForm {
+	if x != nil {
+		return x.NormalForm
+	}
+	return PlanResourcesInput_FilterOptions_NORMAL_FORM_UNSPECIFIED
+}
+
 type PlanResourcesAst_Node struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Node:
@@ -1230,7 +1700,7 @@ type PlanResourcesAst_Node struct {
 
 func (x *PlanResourcesAst_Node) Reset() {
 	*x = PlanResourcesAst_Node{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[15]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1242,7 +1712,7 @@ func (x *PlanResourcesAst_Node) String() string {
 func (*PlanResourcesAst_Node) ProtoMessage() {}
 
 func (x *PlanResourcesAst_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[15]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,7 +1779,7 @@ type PlanResourcesAst_LogicalOperation struct {
 
 func (x *PlanResourcesAst_LogicalOperation) Reset() {
 	*x = PlanResourcesAst_LogicalOperation{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[16]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1321,7 +1791,7 @@ func (x *PlanResourcesAst_LogicalOperation) String() string {
 func (*PlanResourcesAst_LogicalOperation) ProtoMessage() {}
 
 func (x *PlanResourcesAst_LogicalOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[16]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,7 +1831,7 @@ type PlanResourcesFilter_Expression struct {
 
 func (x *PlanResourcesFilter_Expression) Reset() {
 	*x = PlanResourcesFilter_Expression{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[17]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1373,7 +1843,7 @@ func (x *PlanResourcesFilter_Expression) String() string {
 func (*PlanResourcesFilter_Expression) ProtoMessage() {}
 
 func (x *PlanResourcesFilter_Expression) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[17]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +1887,7 @@ type PlanResourcesFilter_Expression_Operand struct {
 
 func (x *PlanResourcesFilter_Expression_Operand) Reset() {
 	*x = PlanResourcesFilter_Expression_Operand{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[18]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1429,7 +1899,7 @@ func (x *PlanResourcesFilter_Expression_Operand) String() string {
 func (*PlanResourcesFilter_Expression_Operand) ProtoMessage() {}
 
 func (x *PlanResourcesFilter_Expression_Operand) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[18]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,13 +1965,65 @@ type PlanResourcesFilter_Expression_Operand_Variable struct {
 	Variable string `protobuf:"bytes,3,opt,name=variable,proto3,oneof"`
 }
 
-func (*PlanResourcesFilter_Expression_Operand_Value) isPlanResourcesFilter_Expression_Operand_Node() {
+func (*PlanResourcesFilter_Expression_Operand_Value) isPlanResourcesFilter_Expression_Operand_Node() {
+}
+
+func (*PlanResourcesFilter_Expression_Operand_Expression) isPlanResourcesFilter_Expression_Operand_Node() {
+}
+
+func (*PlanResourcesFilter_Expression_Operand_Variable) isPlanResourcesFilter_Expression_Operand_Node() {
+}
+
+type PlanPrincipalsInput_Principal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyVersion string                 `protobuf:"bytes,1,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPrincipalsInput_Principal) Reset() {
+	*x = PlanPrincipalsInput_Principal{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPrincipalsInput_Principal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPrincipalsInput_Principal) ProtoMessage() {}
+
+func (x *PlanPrincipalsInput_Principal) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPrincipalsInput_Principal.ProtoReflect.Descriptor instead.
+func (*PlanPrincipalsInput_Principal) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (*PlanResourcesFilter_Expression_Operand_Expression) isPlanResourcesFilter_Expression_Operand_Node() {
+func (x *PlanPrincipalsInput_Principal) GetPolicyVersion() string {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return ""
 }
 
-func (*PlanResourcesFilter_Expression_Operand_Variable) isPlanResourcesFilter_Expression_Operand_Node() {
+func (x *PlanPrincipalsInput_Principal) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
 }
 
 type CheckOutput_ActionEffect struct {
@@ -1515,7 +2037,7 @@ type CheckOutput_ActionEffect struct {
 
 func (x *CheckOutput_ActionEffect) Reset() {
 	*x = CheckOutput_ActionEffect{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[20]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1527,7 +2049,7 @@ func (x *CheckOutput_ActionEffect) String() string {
 func (*CheckOutput_ActionEffect) ProtoMessage() {}
 
 func (x *CheckOutput_ActionEffect) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[20]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,7 +2062,7 @@ func (x *CheckOutput_ActionEffect) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckOutput_ActionEffect.ProtoReflect.Descriptor instead.
 func (*CheckOutput_ActionEffect) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{5, 0}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *CheckOutput_ActionEffect) GetEffect() v11.Effect {
@@ -1588,7 +2110,7 @@ type Trace_Component struct {
 
 func (x *Trace_Component) Reset() {
 	*x = Trace_Component{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[25]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1600,7 +2122,7 @@ func (x *Trace_Component) String() string {
 func (*Trace_Component) ProtoMessage() {}
 
 func (x *Trace_Component) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[25]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,7 +2135,7 @@ func (x *Trace_Component) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trace_Component.ProtoReflect.Descriptor instead.
 func (*Trace_Component) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{10, 0}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *Trace_Component) GetKind() Trace_Component_Kind {
@@ -1827,7 +2349,7 @@ type Trace_Event struct {
 
 func (x *Trace_Event) Reset() {
 	*x = Trace_Event{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[26]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1839,7 +2361,7 @@ func (x *Trace_Event) String() string {
 func (*Trace_Event) ProtoMessage() {}
 
 func (x *Trace_Event) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[26]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,7 +2374,7 @@ func (x *Trace_Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trace_Event.ProtoReflect.Descriptor instead.
 func (*Trace_Event) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{10, 1}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *Trace_Event) GetStatus() Trace_Event_Status {
@@ -1900,7 +2422,7 @@ type Trace_Component_Variable struct {
 
 func (x *Trace_Component_Variable) Reset() {
 	*x = Trace_Component_Variable{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[27]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1912,7 +2434,7 @@ func (x *Trace_Component_Variable) String() string {
 func (*Trace_Component_Variable) ProtoMessage() {}
 
 func (x *Trace_Component_Variable) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[27]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +2447,7 @@ func (x *Trace_Component_Variable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trace_Component_Variable.ProtoReflect.Descriptor instead.
 func (*Trace_Component_Variable) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{10, 0, 0}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{12, 0, 0}
 }
 
 func (x *Trace_Component_Variable) GetName() string {
@@ -1942,6 +2464,326 @@ func (x *Trace_Component_Variable) GetExpr() string {
 	return ""
 }
 
+type Explanation_Expr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expr          string                 `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Operands      []*Explanation_Expr    `protobuf:"bytes,4,rep,name=operands,proto3" json:"operands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Explanation_Expr) Reset() {
+	*x = Explanation_Expr{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explanation_Expr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation_Expr) ProtoMessage() {}
+
+func (x *Explanation_Expr) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation_Expr.ProtoReflect.Descriptor instead.
+func (*Explanation_Expr) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *Explanation_Expr) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *Explanation_Expr) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Explanation_Expr) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Explanation_Expr) GetOperands() []*Explanation_Expr {
+	if x != nil {
+		return x.Operands
+	}
+	return nil
+}
+
+type Explanation_Condition struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Op            Explanation_Condition_Op `protobuf:"varint,1,opt,name=op,proto3,enum=cerbos.engine.v1.Explanation_Condition_Op" json:"op,omitempty"`
+	Satisfied     bool                     `protobuf:"varint,2,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	Error         string                   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Expr          *Explanation_Expr        `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	Conditions    []*Explanation_Condition `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Explanation_Condition) Reset() {
+	*x = Explanation_Condition{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explanation_Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation_Condition) ProtoMessage() {}
+
+func (x *Explanation_Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation_Condition.ProtoReflect.Descriptor instead.
+func (*Explanation_Condition) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *Explanation_Condition) GetOp() Explanation_Condition_Op {
+	if x != nil {
+		return x.Op
+	}
+	return Explanation_Condition_OP_UNSPECIFIED
+}
+
+func (x *Explanation_Condition) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+func (x *Explanation_Condition) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Explanation_Condition) GetExpr() *Explanation_Expr {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *Explanation_Condition) GetConditions() []*Explanation_Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type Explanation_Rule struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Policy        string                   `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Scope         string                   `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Name          string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Effect        v11.Effect               `protobuf:"varint,4,opt,name=effect,proto3,enum=cerbos.effect.v1.Effect" json:"effect,omitempty"`
+	Outcome       Explanation_Rule_Outcome `protobuf:"varint,5,opt,name=outcome,proto3,enum=cerbos.engine.v1.Explanation_Rule_Outcome" json:"outcome,omitempty"`
+	DerivedRole   string                   `protobuf:"bytes,6,opt,name=derived_role,json=derivedRole,proto3" json:"derived_role,omitempty"`
+	Condition     *Explanation_Condition   `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`
+	Error         string                   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Explanation_Rule) Reset() {
+	*x = Explanation_Rule{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explanation_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation_Rule) ProtoMessage() {}
+
+func (x *Explanation_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation_Rule.ProtoReflect.Descriptor instead.
+func (*Explanation_Rule) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{13, 2}
+}
+
+func (x *Explanation_Rule) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *Explanation_Rule) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Explanation_Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Explanation_Rule) GetEffect() v11.Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return v11.Effect(0)
+}
+
+func (x *Explanation_Rule) GetOutcome() Explanation_Rule_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Explanation_Rule_OUTCOME_UNSPECIFIED
+}
+
+func (x *Explanation_Rule) GetDerivedRole() string {
+	if x != nil {
+		return x.DerivedRole
+	}
+	return ""
+}
+
+func (x *Explanation_Rule) GetCondition() *Explanation_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *Explanation_Rule) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Explanation_Action struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Effect        v11.Effect             `protobuf:"varint,1,opt,name=effect,proto3,enum=cerbos.effect.v1.Effect" json:"effect,omitempty"`
+	Policy        string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Rule          string                 `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	Rules         []*Explanation_Rule    `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Explanation_Action) Reset() {
+	*x = Explanation_Action{}
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explanation_Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation_Action) ProtoMessage() {}
+
+func (x *Explanation_Action) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation_Action.ProtoReflect.Descriptor instead.
+func (*Explanation_Action) Descriptor() ([]byte, []int) {
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{13, 3}
+}
+
+func (x *Explanation_Action) GetEffect() v11.Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return v11.Effect(0)
+}
+
+func (x *Explanation_Action) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *Explanation_Action) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Explanation_Action) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Explanation_Action) GetRules() []*Explanation_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type Request_Principal struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Id            string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1955,7 +2797,7 @@ type Request_Principal struct {
 
 func (x *Request_Principal) Reset() {
 	*x = Request_Principal{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[28]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1967,7 +2809,7 @@ func (x *Request_Principal) String() string {
 func (*Request_Principal) ProtoMessage() {}
 
 func (x *Request_Principal) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[28]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1980,7 +2822,7 @@ func (x *Request_Principal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Request_Principal.ProtoReflect.Descriptor instead.
 func (*Request_Principal) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{11, 0}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *Request_Principal) GetId() string {
@@ -2031,7 +2873,7 @@ type Request_Resource struct {
 
 func (x *Request_Resource) Reset() {
 	*x = Request_Resource{}
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[29]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2043,7 +2885,7 @@ func (x *Request_Resource) String() string {
 func (*Request_Resource) ProtoMessage() {}
 
 func (x *Request_Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[29]
+	mi := &file_cerbos_engine_v1_engine_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2056,7 +2898,7 @@ func (x *Request_Resource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Request_Resource.ProtoReflect.Descriptor instead.
 func (*Request_Resource) Descriptor() ([]byte, []int) {
-	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{11, 1}
+	return file_cerbos_engine_v1_engine_proto_rawDescGZIP(), []int{14, 1}
 }
 
 func (x *Request_Resource) GetKind() string {
@@ -2098,7 +2940,7 @@ var File_cerbos_engine_v1_engine_proto protoreflect.FileDescriptor
 
 const file_cerbos_engine_v1_engine_proto_rawDesc = "" +
 	"\n" +
-	"\x1dcerbos/engine/v1/engine.proto\x12\x10cerbos.engine.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dcerbos/effect/v1/effect.proto\x1a\x1dcerbos/schema/v1/schema.proto\x1a&google/api/expr/v1alpha1/checked.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe2\b\n" +
+	"\x1dcerbos/engine/v1/engine.proto\x12\x10cerbos.engine.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dcerbos/effect/v1/effect.proto\x1a\x1dcerbos/schema/v1/schema.proto\x1a&google/api/expr/v1alpha1/checked.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x96\r\n" +
 	"\x12PlanResourcesInput\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1a\n" +
@@ -2107,7 +2949,8 @@ const file_cerbos_engine_v1_engine_proto_rawDesc = "" +
 	"\tprincipal\x18\x03 \x01(\v2\x1b.cerbos.engine.v1.PrincipalR\tprincipal\x12I\n" +
 	"\bresource\x18\x04 \x01(\v2-.cerbos.engine.v1.PlanResourcesInput.ResourceR\bresource\x124\n" +
 	"\baux_data\x18\x05 \x01(\v2\x19.cerbos.engine.v1.AuxDataR\aauxData\x12!\n" +
-	"\finclude_meta\x18\x06 \x01(\bR\vincludeMeta\x1a\x97\x06\n" +
+	"\finclude_meta\x18\x06 \x01(\bR\vincludeMeta\x12Y\n" +
+	"\x0efilter_options\x18\b \x01(\v22.cerbos.engine.v1.PlanResourcesInput.FilterOptionsR\rfilterOptions\x1a\x97\x06\n" +
 	"\bResource\x12D\n" +
 	"\x04kind\x18\x01 \x01(\tB0\x92A 2\x0eResource kind.J\x0e\"album:object\"\xe0A\x02\xbaH\a\xc8\x01\x01r\x02\x10\x01R\x04kind\x12\xb0\x01\n" +
 	"\x04attr\x18\x02 \x03(\v27.cerbos.engine.v1.PlanResourcesInput.Resource.AttrEntryBc\x92A`2^Key-value pairs of contextual data about the resource that are known at a time of the request.R\x04attr\x12\xd0\x01\n" +
@@ -2115,7 +2958,16 @@ const file_cerbos_engine_v1_engine_proto_rawDesc = "" +
 	"\x05scope\x18\x04 \x01(\tB\xd6\x01\x92A\xa5\x012}A dot-separated scope that describes the hierarchy this resource belongs to. This is used for determining policy inheritance.\x8a\x01#^([0-9a-zA-Z][\\w\\-]*(\\.[\\w\\-]*)*)*$\xe0A\x01\xbaH'r%2#^([0-9a-zA-Z][\\w\\-]*(\\.[\\w\\-]*)*)*$R\x05scope\x1aO\n" +
 	"\tAttrEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\xa1\x04\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\x1a\xd6\x03\n" +
+	"\rFilterOptions\x12\x98\x01\n" +
+	"\bsimplify\x18\x01 \x01(\bB|\x92Ay2wRemove redundant conditions from the filter and merge equality comparisons of the same attribute into membership tests.R\bsimplify\x12\xd4\x01\n" +
+	"\vnormal_form\x18\x02 \x01(\x0e2=.cerbos.engine.v1.PlanResourcesInput.FilterOptions.NormalFormBt\x92Ai2gReturn the filter in disjunctive (DNF) or conjunctive (CNF) normal form. The filter is also simplified.\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"normalForm\"S\n" +
+	"\n" +
+	"NormalForm\x12\x1b\n" +
+	"\x17NORMAL_FORM_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fNORMAL_FORM_DNF\x10\x01\x12\x13\n" +
+	"\x0fNORMAL_FORM_CNF\x10\x02\"\xa1\x04\n" +
 	"\x10PlanResourcesAst\x12F\n" +
 	"\n" +
 	"filter_ast\x18\x01 \x01(\v2'.cerbos.engine.v1.PlanResourcesAst.NodeR\tfilterAst\x1a\xbb\x01\n" +
@@ -2153,7 +3005,7 @@ const file_cerbos_engine_v1_engine_proto_rawDesc = "" +
 	"\x10KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13KIND_ALWAYS_ALLOWED\x10\x01\x12\x16\n" +
 	"\x12KIND_ALWAYS_DENIED\x10\x02\x12\x14\n" +
-	"\x10KIND_CONDITIONAL\x10\x03\"\x90\x04\n" +
+	"\x10KIND_CONDITIONAL\x10\x03\"\xbe\x04\n" +
 	"\x13PlanResourcesOutput\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1a\n" +
@@ -2166,10 +3018,33 @@ const file_cerbos_engine_v1_engine_proto_rawDesc = "" +
 	"\x11validation_errors\x18\b \x03(\v2!.cerbos.schema.v1.ValidationErrorR\x10validationErrors\x12\x18\n" +
 	"\aactions\x18\t \x03(\tR\aactions\x12_\n" +
 	"\x0ematched_scopes\x18\n" +
-	" \x03(\v28.cerbos.engine.v1.PlanResourcesOutput.MatchedScopesEntryR\rmatchedScopes\x1a@\n" +
+	" \x03(\v28.cerbos.engine.v1.PlanResourcesOutput.MatchedScopesEntryR\rmatchedScopes\x12,\n" +
+	"\x03now\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x03now\x1a@\n" +
 	"\x12MatchedScopesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x97\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x06\n" +
+	"\x13PlanPrincipalsInput\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x18\n" +
+	"\aactions\x18\x02 \x03(\tR\aactions\x126\n" +
+	"\bresource\x18\x03 \x01(\v2\x1a.cerbos.engine.v1.ResourceR\bresource\x12M\n" +
+	"\tprincipal\x18\x04 \x01(\v2/.cerbos.engine.v1.PlanPrincipalsInput.PrincipalR\tprincipal\x124\n" +
+	"\baux_data\x18\x05 \x01(\v2\x19.cerbos.engine.v1.AuxDataR\aauxData\x12!\n" +
+	"\finclude_meta\x18\x06 \x01(\bR\vincludeMeta\x1a\xd5\x03\n" +
+	"\tPrincipal\x12\xd7\x01\n" +
+	"\x0epolicy_version\x18\x01 \x01(\tB\xaf\x01\x92A\x9a\x012\x82\x01The policy version to use to evaluate principal policies. If not specified, will default to the server-configured default version.J\t\"default\"\x8a\x01\a^[\\w]*$\xe0A\x01\xbaH\vr\t2\a^[\\w]*$R\rpolicyVersion\x12\xed\x01\n" +
+	"\x05scope\x18\x02 \x01(\tB\xd6\x01\x92A\xa5\x012}A dot-separated scope that describes the hierarchy the principals belong to. This is used for determining policy inheritance.\x8a\x01#^([0-9a-zA-Z][\\w\\-]*(\\.[\\w\\-]*)*)*$\xe0A\x01\xbaH'r%2#^([0-9a-zA-Z][\\w\\-]*(\\.[\\w\\-]*)*)*$R\x05scope\"\xa3\x02\n" +
+	"\x14PlanPrincipalsOutput\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x18\n" +
+	"\aactions\x18\x02 \x03(\tR\aactions\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vresource_id\x18\x04 \x01(\tR\n" +
+	"resourceId\x12%\n" +
+	"\x0epolicy_version\x18\x05 \x01(\tR\rpolicyVersion\x12\x14\n" +
+	"\x05scope\x18\x06 \x01(\tR\x05scope\x12=\n" +
+	"\x06filter\x18\a \x01(\v2%.cerbos.engine.v1.PlanResourcesFilterR\x06filter\x12!\n" +
+	"\ffilter_debug\x18\b \x01(\tR\vfilterDebug\"\x97\x02\n" +
 	"\n" +
 	"CheckInput\x12\x1d\n" +
 	"\n" +
@@ -2216,11 +3091,15 @@ const file_cerbos_engine_v1_engine_proto_rawDesc = "" +
 	"\tAttrEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
 	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01:Y\x92AV\n" +
-	"T2RA person or application attempting to perform the actions on the set of resources.\"\xb1\x01\n" +
+	"T2RA person or application attempting to perform the actions on the set of resources.\"\xcf\x02\n" +
 	"\aAuxData\x124\n" +
-	"\x03jwt\x18\x01 \x03(\v2\".cerbos.engine.v1.AuxData.JwtEntryR\x03jwt\x1aN\n" +
+	"\x03jwt\x18\x01 \x03(\v2\".cerbos.engine.v1.AuxData.JwtEntryR\x03jwt\x12F\n" +
+	"\tproviders\x18\x02 \x03(\v2(.cerbos.engine.v1.AuxData.ProvidersEntryR\tproviders\x1aN\n" +
 	"\bJwtEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\x1aT\n" +
+	"\x0eProvidersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
 	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01: \x92A\x1d\n" +
 	"\x1b2\x19Structured auxiliary data\"\x82\n" +
 	"\n" +
@@ -2277,7 +3156,63 @@ const file_cerbos_engine_v1_engine_proto_rawDesc = "" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10STATUS_ACTIVATED\x10\x01\x12\x12\n" +
-	"\x0eSTATUS_SKIPPED\x10\x02\"\xc8\x05\n" +
+	"\x0eSTATUS_SKIPPED\x10\x02\"\x9b\x19\n" +
+	"\vExplanation\x12g\n" +
+	"\aactions\x18\x01 \x03(\v2*.cerbos.engine.v1.Explanation.ActionsEntryB!\x92A\x1e2\x1cExplanation for each action.R\aactions\x1a\x9c\x04\n" +
+	"\x04Expr\x12i\n" +
+	"\x04expr\x18\x01 \x01(\tBU\x92AR2\x19Source of the expression.J5\"request.resource.attr.owner == request.principal.id\"R\x04expr\x12\x90\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueBb\x92A_2]Value of the expression. Not set if the expression was not evaluated due to short-circuiting.R\x05value\x12M\n" +
+	"\x05error\x18\x03 \x01(\tB7\x92A422Error encountered while evaluating the expression.R\x05error\x12\x91\x01\n" +
+	"\boperands\x18\x04 \x03(\v2\".cerbos.engine.v1.Explanation.ExprBQ\x92AN2LSub-expressions that were evaluated to produce the value of this expression.R\boperands:3\x92A0\n" +
+	".2,CEL expression and the value it evaluated to\x1a\xa7\x05\n" +
+	"\tCondition\x12S\n" +
+	"\x02op\x18\x01 \x01(\x0e2*.cerbos.engine.v1.Explanation.Condition.OpB\x17\x92A\x142\x12Kind of condition.R\x02op\x12G\n" +
+	"\tsatisfied\x18\x02 \x01(\bB)\x92A&2$Whether the condition was satisfied.R\tsatisfied\x12L\n" +
+	"\x05error\x18\x03 \x01(\tB6\x92A321Error encountered while evaluating the condition.R\x05error\x12j\n" +
+	"\x04expr\x18\x04 \x01(\v2\".cerbos.engine.v1.Explanation.ExprB2\x92A/2-Expression evaluated by an OP_EXPR condition.R\x04expr\x12\xce\x01\n" +
+	"\n" +
+	"conditions\x18\x05 \x03(\v2'.cerbos.engine.v1.Explanation.ConditionB\x84\x01\x92A\x80\x012~Nested conditions evaluated by an OP_ALL, OP_ANY or OP_NONE condition. Conditions skipped due to short-circuiting are omitted.R\n" +
+	"conditions\"J\n" +
+	"\x02Op\x12\x12\n" +
+	"\x0eOP_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OP_ALL\x10\x01\x12\n" +
+	"\n" +
+	"\x06OP_ANY\x10\x02\x12\v\n" +
+	"\aOP_NONE\x10\x03\x12\v\n" +
+	"\aOP_EXPR\x10\x04:%\x92A\"\n" +
+	" 2\x1eEvaluation of a rule condition\x1a\xe4\b\n" +
+	"\x04Rule\x12d\n" +
+	"\x06policy\x18\x01 \x01(\tBL\x92AI2\x1bPolicy containing the rule.J*\"resource.album_object.vdefault/acme.corp\"R\x06policy\x12P\n" +
+	"\x05scope\x18\x02 \x01(\tB:\x92A72(Scope of the policy containing the rule.J\v\"acme.corp\"R\x05scope\x126\n" +
+	"\x04name\x18\x03 \x01(\tB\"\x92A\x1f2\x11Name of the rule.J\n" +
+	"\"rule-001\"R\x04name\x12h\n" +
+	"\x06effect\x18\x04 \x01(\x0e2\x18.cerbos.effect.v1.EffectB6\x92A321Effect of the rule. Only set for activated rules.R\x06effect\x12\x86\x01\n" +
+	"\aoutcome\x18\x05 \x01(\x0e2*.cerbos.engine.v1.Explanation.Rule.OutcomeB@\x92A=2;Whether the rule was activated and, if not, the reason why.R\aoutcome\x12V\n" +
+	"\fderived_role\x18\x06 \x01(\tB3\x92A02.Derived role that the rule applies to, if any.R\vderivedRole\x12\xb6\x01\n" +
+	"\tcondition\x18\a \x01(\v2'.cerbos.engine.v1.Explanation.ConditionBo\x92Al2jEvaluation of the rule condition. Not set if the rule has no condition or the condition was not evaluated.R\tcondition\x12G\n" +
+	"\x05error\x18\b \x01(\tB1\x92A.2,Error encountered while evaluating the rule.R\x05error\"\xdf\x01\n" +
+	"\aOutcome\x12\x17\n" +
+	"\x13OUTCOME_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11OUTCOME_ACTIVATED\x10\x01\x12\x19\n" +
+	"\x15OUTCOME_ROLE_MISMATCH\x10\x02\x12&\n" +
+	"\"OUTCOME_DERIVED_ROLE_NOT_ACTIVATED\x10\x03\x12\x1d\n" +
+	"\x19OUTCOME_CONDITION_NOT_MET\x10\x04\x12\x1b\n" +
+	"\x17OUTCOME_CONDITION_ERROR\x10\x05\x12%\n" +
+	"!OUTCOME_SCOPE_PERMISSIONS_BLOCKED\x10\x06:=\x92A:\n" +
+	"826Rule considered while deciding the effect of an action\x1a\xcf\x04\n" +
+	"\x06Action\x12L\n" +
+	"\x06effect\x18\x01 \x01(\x0e2\x18.cerbos.effect.v1.EffectB\x1a\x92A\x172\x15Effect of the action.R\x06effect\x12h\n" +
+	"\x06policy\x18\x02 \x01(\tBP\x92AM2\x1fPolicy that decided the effect.J*\"resource.album_object.vdefault/acme.corp\"R\x06policy\x12T\n" +
+	"\x05scope\x18\x03 \x01(\tB>\x92A;2,Scope of the policy that decided the effect.J\v\"acme.corp\"R\x05scope\x12\x89\x01\n" +
+	"\x04rule\x18\x04 \x01(\tBu\x92Ar2dRule that decided the effect. Not set if the effect is the default DENY applied when no rules match.J\n" +
+	"\"rule-001\"R\x04rule\x12|\n" +
+	"\x05rules\x18\x05 \x03(\v2\".cerbos.engine.v1.Explanation.RuleBB\x92A?2=Rules that were considered, in the order they were evaluated.R\x05rules:-\x92A*\n" +
+	"(2&Explanation of the effect of an action\x1a`\n" +
+	"\fActionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12:\n" +
+	"\x05value\x18\x02 \x01(\v2$.cerbos.engine.v1.Explanation.ActionR\x05value:\x028\x01:?\x92A<\n" +
+	":28Explanation of how the effect of each action was decided\"\xc8\x05\n" +
 	"\aRequest\x12A\n" +
 	"\tprincipal\x18\x01 \x01(\v2#.cerbos.engine.v1.Request.PrincipalR\tprincipal\x12>\n" +
 	"\bresource\x18\x02 \x01(\v2\".cerbos.engine.v1.Request.ResourceR\bresource\x124\n" +
@@ -2316,103 +3251,139 @@ func file_cerbos_engine_v1_engine_proto_rawDescGZIP() []byte {
 	return file_cerbos_engine_v1_engine_proto_rawDescData
 }
 
-var file_cerbos_engine_v1_engine_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_cerbos_engine_v1_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_cerbos_engine_v1_engine_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_cerbos_engine_v1_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_cerbos_engine_v1_engine_proto_goTypes = []any{
-	(PlanResourcesAst_LogicalOperation_Operator)(0), // 0: cerbos.engine.v1.PlanResourcesAst.LogicalOperation.Operator
-	(PlanResourcesFilter_Kind)(0),                   // 1: cerbos.engine.v1.PlanResourcesFilter.Kind
-	(Trace_Component_Kind)(0),                       // 2: cerbos.engine.v1.Trace.Component.Kind
-	(Trace_Event_Status)(0),                         // 3: cerbos.engine.v1.Trace.Event.Status
-	(*PlanResourcesInput)(nil),                      // 4: cerbos.engine.v1.PlanResourcesInput
-	(*PlanResourcesAst)(nil),                        // 5: cerbos.engine.v1.PlanResourcesAst
-	(*PlanResourcesFilter)(nil),                     // 6: cerbos.engine.v1.PlanResourcesFilter
-	(*PlanResourcesOutput)(nil),                     // 7: cerbos.engine.v1.PlanResourcesOutput
-	(*CheckInput)(nil),                              // 8: cerbos.engine.v1.CheckInput
-	(*CheckOutput)(nil),                             // 9: cerbos.engine.v1.CheckOutput
-	(*OutputEntry)(nil),                             // 10: cerbos.engine.v1.OutputEntry
-	(*Resource)(nil),                                // 11: cerbos.engine.v1.Resource
-	(*Principal)(nil),                               // 12: cerbos.engine.v1.Principal
-	(*AuxData)(nil),                                 // 13: cerbos.engine.v1.AuxData
-	(*Trace)(nil),                                   // 14: cerbos.engine.v1.Trace
-	(*Request)(nil),                                 // 15: cerbos.engine.v1.Request
-	(*Runtime)(nil),                                 // 16: cerbos.engine.v1.Runtime
-	(*PlanResourcesInput_Resource)(nil),             // 17: cerbos.engine.v1.PlanResourcesInput.Resource
-	nil,                                             // 18: cerbos.engine.v1.PlanResourcesInput.Resource.AttrEntry
-	(*PlanResourcesAst_Node)(nil),                   // 19: cerbos.engine.v1.PlanResourcesAst.Node
-	(*PlanResourcesAst_LogicalOperation)(nil),       // 20: cerbos.engine.v1.PlanResourcesAst.LogicalOperation
-	(*PlanResourcesFilter_Expression)(nil),          // 21: cerbos.engine.v1.PlanResourcesFilter.Expression
-	(*PlanResourcesFilter_Expression_Operand)(nil),  // 22: cerbos.engine.v1.PlanResourcesFilter.Expression.Operand
-	nil,                              // 23: cerbos.engine.v1.PlanResourcesOutput.MatchedScopesEntry
-	(*CheckOutput_ActionEffect)(nil), // 24: cerbos.engine.v1.CheckOutput.ActionEffect
-	nil,                              // 25: cerbos.engine.v1.CheckOutput.ActionsEntry
-	nil,                              // 26: cerbos.engine.v1.Resource.AttrEntry
-	nil,                              // 27: cerbos.engine.v1.Principal.AttrEntry
-	nil,                              // 28: cerbos.engine.v1.AuxData.JwtEntry
-	(*Trace_Component)(nil),          // 29: cerbos.engine.v1.Trace.Component
-	(*Trace_Event)(nil),              // 30: cerbos.engine.v1.Trace.Event
-	(*Trace_Component_Variable)(nil), // 31: cerbos.engine.v1.Trace.Component.Variable
-	(*Request_Principal)(nil),        // 32: cerbos.engine.v1.Request.Principal
-	(*Request_Resource)(nil),         // 33: cerbos.engine.v1.Request.Resource
-	nil,                              // 34: cerbos.engine.v1.Request.Principal.AttrEntry
-	nil,                              // 35: cerbos.engine.v1.Request.Resource.AttrEntry
-	(*v1.ValidationError)(nil),       // 36: cerbos.schema.v1.ValidationError
-	(*structpb.Value)(nil),           // 37: google.protobuf.Value
-	(*v1alpha1.CheckedExpr)(nil),     // 38: google.api.expr.v1alpha1.CheckedExpr
-	(v11.Effect)(0),                  // 39: cerbos.effect.v1.Effect
+	(PlanResourcesInput_FilterOptions_NormalForm)(0), // 0: cerbos.engine.v1.PlanResourcesInput.FilterOptions.NormalForm
+	(PlanResourcesAst_LogicalOperation_Operator)(0),  // 1: cerbos.engine.v1.PlanResourcesAst.LogicalOperation.Operator
+	(PlanResourcesFilter_Kind)(0),                    // 2: cerbos.engine.v1.PlanResourcesFilter.Kind
+	(Trace_Component_Kind)(0),                        // 3: cerbos.engine.v1.Trace.Component.Kind
+	(Trace_Event_Status)(0),                          // 4: cerbos.engine.v1.Trace.Event.Status
+	(Explanation_Condition_Op)(0),                    // 5: cerbos.engine.v1.Explanation.Condition.Op
+	(Explanation_Rule_Outcome)(0),                    // 6: cerbos.engine.v1.Explanation.Rule.Outcome
+	(*PlanResourcesInput)(nil),                       // 7: cerbos.engine.v1.PlanResourcesInput
+	(*PlanResourcesAst)(nil),                         // 8: cerbos.engine.v1.PlanResourcesAst
+	(*PlanResourcesFilter)(nil),                      // 9: cerbos.engine.v1.PlanResourcesFilter
+	(*PlanResourcesOutput)(nil),                      // 10: cerbos.engine.v1.PlanResourcesOutput
+	(*PlanPrincipalsInput)(nil),                      // 11: cerbos.engine.v1.PlanPrincipalsInput
+	(*PlanPrincipalsOutput)(nil),                     // 12: cerbos.engine.v1.PlanPrincipalsOutput
+	(*CheckInput)(nil),                               // 13: cerbos.engine.v1.CheckInput
+	(*CheckOutput)(nil),                              // 14: cerbos.engine.v1.CheckOutput
+	(*OutputEntry)(nil),                              // 15: cerbos.engine.v1.OutputEntry
+	(*Resource)(nil),                                 // 16: cerbos.engine.v1.Resource
+	(*Principal)(nil),                                // 17: cerbos.engine.v1.Principal
+	(*AuxData)(nil),                                  // 18: cerbos.engine.v1.AuxData
+	(*Trace)(nil),                                    // 19: cerbos.engine.v1.Trace
+	(*Explanation)(nil),                              // 20: cerbos.engine.v1.Explanation
+	(*Request)(nil),                                  // 21: cerbos.engine.v1.Request
+	(*Runtime)(nil),                                  // 22: cerbos.engine.v1.Runtime
+	(*PlanResourcesInput_Resource)(nil),              // 23: cerbos.engine.v1.PlanResourcesInput.Resource
+	(*PlanResourcesInput_FilterOptions)(nil),         // 24: cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	nil,                                              // 25: cerbos.engine.v1.PlanResourcesInput.Resource.AttrEntry
+	(*PlanResourcesAst_Node)(nil),                    // 26: cerbos.engine.v1.PlanResourcesAst.Node
+	(*PlanResourcesAst_LogicalOperation)(nil),        // 27: cerbos.engine.v1.PlanResourcesAst.LogicalOperation
+	(*PlanResourcesFilter_Expression)(nil),           // 28: cerbos.engine.v1.PlanResourcesFilter.Expression
+	(*PlanResourcesFilter_Expression_Operand)(nil),   // 29: cerbos.engine.v1.PlanResourcesFilter.Expression.Operand
+	nil,                                   // 30: cerbos.engine.v1.PlanResourcesOutput.MatchedScopesEntry
+	(*PlanPrincipalsInput_Principal)(nil), // 31: cerbos.engine.v1.PlanPrincipalsInput.Principal
+	(*CheckOutput_ActionEffect)(nil),      // 32: cerbos.engine.v1.CheckOutput.ActionEffect
+	nil,                                   // 33: cerbos.engine.v1.CheckOutput.ActionsEntry
+	nil,                                   // 34: cerbos.engine.v1.Resource.AttrEntry
+	nil,                                   // 35: cerbos.engine.v1.Principal.AttrEntry
+	nil,                                   // 36: cerbos.engine.v1.AuxData.JwtEntry
+	nil,                                   // 37: cerbos.engine.v1.AuxData.ProvidersEntry
+	(*Trace_Component)(nil),               // 38: cerbos.engine.v1.Trace.Component
+	(*Trace_Event)(nil),                   // 39: cerbos.engine.v1.Trace.Event
+	(*Trace_Component_Variable)(nil),      // 40: cerbos.engine.v1.Trace.Component.Variable
+	(*Explanation_Expr)(nil),              // 41: cerbos.engine.v1.Explanation.Expr
+	(*Explanation_Condition)(nil),         // 42: cerbos.engine.v1.Explanation.Condition
+	(*Explanation_Rule)(nil),              // 43: cerbos.engine.v1.Explanation.Rule
+	(*Explanation_Action)(nil),            // 44: cerbos.engine.v1.Explanation.Action
+	nil,                                   // 45: cerbos.engine.v1.Explanation.ActionsEntry
+	(*Request_Principal)(nil),             // 46: cerbos.engine.v1.Request.Principal
+	(*Request_Resource)(nil),              // 47: cerbos.engine.v1.Request.Resource
+	nil,                                   // 48: cerbos.engine.v1.Request.Principal.AttrEntry
+	nil,                                   // 49: cerbos.engine.v1.Request.Resource.AttrEntry
+	(*v1.ValidationError)(nil),            // 50: cerbos.schema.v1.ValidationError
+	(*timestamppb.Timestamp)(nil),         // 51: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                // 52: google.protobuf.Value
+	(*v1alpha1.CheckedExpr)(nil),          // 53: google.api.expr.v1alpha1.CheckedExpr
+	(v11.Effect)(0),                       // 54: cerbos.effect.v1.Effect
 }
 var file_cerbos_engine_v1_engine_proto_depIdxs = []int32{
-	12, // 0: cerbos.engine.v1.PlanResourcesInput.principal:type_name -> cerbos.engine.v1.Principal
-	17, // 1: cerbos.engine.v1.PlanResourcesInput.resource:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
-	13, // 2: cerbos.engine.v1.PlanResourcesInput.aux_data:type_name -> cerbos.engine.v1.AuxData
-	19, // 3: cerbos.engine.v1.PlanResourcesAst.filter_ast:type_name -> cerbos.engine.v1.PlanResourcesAst.Node
-	1,  // 4: cerbos.engine.v1.PlanResourcesFilter.kind:type_name -> cerbos.engine.v1.PlanResourcesFilter.Kind
-	22, // 5: cerbos.engine.v1.PlanResourcesFilter.condition:type_name -> cerbos.engine.v1.PlanResourcesFilter.Expression.Operand
-	6,  // 6: cerbos.engine.v1.PlanResourcesOutput.filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
-	36, // 7: cerbos.engine.v1.PlanResourcesOutput.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	23, // 8: cerbos.engine.v1.PlanResourcesOutput.matched_scopes:type_name -> cerbos.engine.v1.PlanResourcesOutput.MatchedScopesEntry
-	11, // 9: cerbos.engine.v1.CheckInput.resource:type_name -> cerbos.engine.v1.Resource
-	12, // 10: cerbos.engine.v1.CheckInput.principal:type_name -> cerbos.engine.v1.Principal
-	13, // 11: cerbos.engine.v1.CheckInput.aux_data:type_name -> cerbos.engine.v1.AuxData
-	25, // 12: cerbos.engine.v1.CheckOutput.actions:type_name -> cerbos.engine.v1.CheckOutput.ActionsEntry
-	36, // 13: cerbos.engine.v1.CheckOutput.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	10, // 14: cerbos.engine.v1.CheckOutput.outputs:type_name -> cerbos.engine.v1.OutputEntry
-	37, // 15: cerbos.engine.v1.OutputEntry.val:type_name -> google.protobuf.Value
-	26, // 16: cerbos.engine.v1.Resource.attr:type_name -> cerbos.engine.v1.Resource.AttrEntry
-	27, // 17: cerbos.engine.v1.Principal.attr:type_name -> cerbos.engine.v1.Principal.AttrEntry
-	28, // 18: cerbos.engine.v1.AuxData.jwt:type_name -> cerbos.engine.v1.AuxData.JwtEntry
-	29, // 19: cerbos.engine.v1.Trace.components:type_name -> cerbos.engine.v1.Trace.Component
-	30, // 20: cerbos.engine.v1.Trace.event:type_name -> cerbos.engine.v1.Trace.Event
-	32, // 21: cerbos.engine.v1.Request.principal:type_name -> cerbos.engine.v1.Request.Principal
-	33, // 22: cerbos.engine.v1.Request.resource:type_name -> cerbos.engine.v1.Request.Resource
-	13, // 23: cerbos.engine.v1.Request.aux_data:type_name -> cerbos.engine.v1.AuxData
-	18, // 24: cerbos.engine.v1.PlanResourcesInput.Resource.attr:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource.AttrEntry
-	37, // 25: cerbos.engine.v1.PlanResourcesInput.Resource.AttrEntry.value:type_name -> google.protobuf.Value
-	20, // 26: cerbos.engine.v1.PlanResourcesAst.Node.logical_operation:type_name -> cerbos.engine.v1.PlanResourcesAst.LogicalOperation
-	38, // 27: cerbos.engine.v1.PlanResourcesAst.Node.expression:type_name -> google.api.expr.v1alpha1.CheckedExpr
-	0,  // 28: cerbos.engine.v1.PlanResourcesAst.LogicalOperation.operator:type_name -> cerbos.engine.v1.PlanResourcesAst.LogicalOperation.Operator
-	19, // 29: cerbos.engine.v1.PlanResourcesAst.LogicalOperation.nodes:type_name -> cerbos.engine.v1.PlanResourcesAst.Node
-	22, // 30: cerbos.engine.v1.PlanResourcesFilter.Expression.operands:type_name -> cerbos.engine.v1.PlanResourcesFilter.Expression.Operand
-	37, // 31: cerbos.engine.v1.PlanResourcesFilter.Expression.Operand.value:type_name -> google.protobuf.Value
-	21, // 32: cerbos.engine.v1.PlanResourcesFilter.Expression.Operand.expression:type_name -> cerbos.engine.v1.PlanResourcesFilter.Expression
-	39, // 33: cerbos.engine.v1.CheckOutput.ActionEffect.effect:type_name -> cerbos.effect.v1.Effect
-	24, // 34: cerbos.engine.v1.CheckOutput.ActionsEntry.value:type_name -> cerbos.engine.v1.CheckOutput.ActionEffect
-	37, // 35: cerbos.engine.v1.Resource.AttrEntry.value:type_name -> google.protobuf.Value
-	37, // 36: cerbos.engine.v1.Principal.AttrEntry.value:type_name -> google.protobuf.Value
-	37, // 37: cerbos.engine.v1.AuxData.JwtEntry.value:type_name -> google.protobuf.Value
-	2,  // 38: cerbos.engine.v1.Trace.Component.kind:type_name -> cerbos.engine.v1.Trace.Component.Kind
-	31, // 39: cerbos.engine.v1.Trace.Component.variable:type_name -> cerbos.engine.v1.Trace.Component.Variable
-	3,  // 40: cerbos.engine.v1.Trace.Event.status:type_name -> cerbos.engine.v1.Trace.Event.Status
-	39, // 41: cerbos.engine.v1.Trace.Event.effect:type_name -> cerbos.effect.v1.Effect
-	37, // 42: cerbos.engine.v1.Trace.Event.result:type_name -> google.protobuf.Value
-	34, // 43: cerbos.engine.v1.Request.Principal.attr:type_name -> cerbos.engine.v1.Request.Principal.AttrEntry
-	35, // 44: cerbos.engine.v1.Request.Resource.attr:type_name -> cerbos.engine.v1.Request.Resource.AttrEntry
-	37, // 45: cerbos.engine.v1.Request.Principal.AttrEntry.value:type_name -> google.protobuf.Value
-	37, // 46: cerbos.engine.v1.Request.Resource.AttrEntry.value:type_name -> google.protobuf.Value
-	47, // [47:47] is the sub-list for method output_type
-	47, // [47:47] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+	17, // 0: cerbos.engine.v1.PlanResourcesInput.principal:type_name -> cerbos.engine.v1.Principal
+	23, // 1: cerbos.engine.v1.PlanResourcesInput.resource:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
+	18, // 2: cerbos.engine.v1.PlanResourcesInput.aux_data:type_name -> cerbos.engine.v1.AuxData
+	24, // 3: cerbos.engine.v1.PlanResourcesInput.filter_options:type_name -> cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	26, // 4: cerbos.engine.v1.PlanResourcesAst.filter_ast:type_name -> cerbos.engine.v1.PlanResourcesAst.Node
+	2,  // 5: cerbos.engine.v1.PlanResourcesFilter.kind:type_name -> cerbos.engine.v1.PlanResourcesFilter.Kind
+	29, // 6: cerbos.engine.v1.PlanResourcesFilter.condition:type_name -> cerbos.engine.v1.PlanResourcesFilter.Expression.Operand
+	9,  // 7: cerbos.engine.v1.PlanResourcesOutput.filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	50, // 8: cerbos.engine.v1.PlanResourcesOutput.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	30, // 9: cerbos.engine.v1.PlanResourcesOutput.matched_scopes:type_name -> cerbos.engine.v1.PlanResourcesOutput.MatchedScopesEntry
+	51, // 10: cerbos.engine.v1.PlanResourcesOutput.now:type_name -> google.protobuf.Timestamp
+	16, // 11: cerbos.engine.v1.PlanPrincipalsInput.resource:type_name -> cerbos.engine.v1.Resource
+	31, // 12: cerbos.engine.v1.PlanPrincipalsInput.principal:type_name -> cerbos.engine.v1.PlanPrincipalsInput.Principal
+	18, // 13: cerbos.engine.v1.PlanPrincipalsInput.aux_data:type_name -> cerbos.engine.v1.AuxData
+	9,  // 14: cerbos.engine.v1.PlanPrincipalsOutput.filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	16, // 15: cerbos.engine.v1.CheckInput.resource:type_name -> cerbos.engine.v1.Resource
+	17, // 16: cerbos.engine.v1.CheckInput.principal:type_name -> cerbos.engine.v1.Principal
+	18, // 17: cerbos.engine.v1.CheckInput.aux_data:type_name -> cerbos.engine.v1.AuxData
+	33, // 18: cerbos.engine.v1.CheckOutput.actions:type_name -> cerbos.engine.v1.CheckOutput.ActionsEntry
+	50, // 19: cerbos.engine.v1.CheckOutput.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	15, // 20: cerbos.engine.v1.CheckOutput.outputs:type_name -> cerbos.engine.v1.OutputEntry
+	52, // 21: cerbos.engine.v1.OutputEntry.val:type_name -> google.protobuf.Value
+	34, // 22: cerbos.engine.v1.Resource.attr:type_name -> cerbos.engine.v1.Resource.AttrEntry
+	35, // 23: cerbos.engine.v1.Principal.attr:type_name -> cerbos.engine.v1.Principal.AttrEntry
+	36, // 24: cerbos.engine.v1.AuxData.jwt:type_name -> cerbos.engine.v1.AuxData.JwtEntry
+	37, // 25: cerbos.engine.v1.AuxData.providers:type_name -> cerbos.engine.v1.AuxData.ProvidersEntry
+	38, // 26: cerbos.engine.v1.Trace.components:type_name -> cerbos.engine.v1.Trace.Component
+	39, // 27: cerbos.engine.v1.Trace.event:type_name -> cerbos.engine.v1.Trace.Event
+	45, // 28: cerbos.engine.v1.Explanation.actions:type_name -> cerbos.engine.v1.Explanation.ActionsEntry
+	46, // 29: cerbos.engine.v1.Request.principal:type_name -> cerbos.engine.v1.Request.Principal
+	47, // 30: cerbos.engine.v1.Request.resource:type_name -> cerbos.engine.v1.Request.Resource
+	18, // 31: cerbos.engine.v1.Request.aux_data:type_name -> cerbos.engine.v1.AuxData
+	25, // 32: cerbos.engine.v1.PlanResourcesInput.Resource.attr:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource.AttrEntry
+	0,  // 33: cerbos.engine.v1.PlanResourcesInput.FilterOptions.normal_form:type_name -> cerbos.engine.v1.PlanResourcesInput.FilterOptions.NormalForm
+	52, // 34: cerbos.engine.v1.PlanResourcesInput.Resource.AttrEntry.value:type_name -> google.protobuf.Value
+	27, // 35: cerbos.engine.v1.PlanResourcesAst.Node.logical_operation:type_name -> cerbos.engine.v1.PlanResourcesAst.LogicalOperation
+	53, // 36: cerbos.engine.v1.PlanResourcesAst.Node.expression:type_name -> google.api.expr.v1alpha1.CheckedExpr
+	1,  // 37: cerbos.engine.v1.PlanResourcesAst.LogicalOperation.operator:type_name -> cerbos.engine.v1.PlanResourcesAst.LogicalOperation.Operator
+	26, // 38: cerbos.engine.v1.PlanResourcesAst.LogicalOperation.nodes:type_name -> cerbos.engine.v1.PlanResourcesAst.Node
+	29, // 39: cerbos.engine.v1.PlanResourcesFilter.Expression.operands:type_name -> cerbos.engine.v1.PlanResourcesFilter.Expression.Operand
+	52, // 40: cerbos.engine.v1.PlanResourcesFilter.Expression.Operand.value:type_name -> google.protobuf.Value
+	28, // 41: cerbos.engine.v1.PlanResourcesFilter.Expression.Operand.expression:type_name -> cerbos.engine.v1.PlanResourcesFilter.Expression
+	54, // 42: cerbos.engine.v1.CheckOutput.ActionEffect.effect:type_name -> cerbos.effect.v1.Effect
+	32, // 43: cerbos.engine.v1.CheckOutput.ActionsEntry.value:type_name -> cerbos.engine.v1.CheckOutput.ActionEffect
+	52, // 44: cerbos.engine.v1.Resource.AttrEntry.value:type_name -> google.protobuf.Value
+	52, // 45: cerbos.engine.v1.Principal.AttrEntry.value:type_name -> google.protobuf.Value
+	52, // 46: cerbos.engine.v1.AuxData.JwtEntry.value:type_name -> google.protobuf.Value
+	52, // 47: cerbos.engine.v1.AuxData.ProvidersEntry.value:type_name -> google.protobuf.Value
+	3,  // 48: cerbos.engine.v1.Trace.Component.kind:type_name -> cerbos.engine.v1.Trace.Component.Kind
+	40, // 49: cerbos.engine.v1.Trace.Component.variable:type_name -> cerbos.engine.v1.Trace.Component.Variable
+	4,  // 50: cerbos.engine.v1.Trace.Event.status:type_name -> cerbos.engine.v1.Trace.Event.Status
+	54, // 51: cerbos.engine.v1.Trace.Event.effect:type_name -> cerbos.effect.v1.Effect
+	52, // 52: cerbos.engine.v1.Trace.Event.result:type_name -> google.protobuf.Value
+	52, // 53: cerbos.engine.v1.Explanation.Expr.value:type_name -> google.protobuf.Value
+	41, // 54: cerbos.engine.v1.Explanation.Expr.operands:type_name -> cerbos.engine.v1.Explanation.Expr
+	5,  // 55: cerbos.engine.v1.Explanation.Condition.op:type_name -> cerbos.engine.v1.Explanation.Condition.Op
+	41, // 56: cerbos.engine.v1.Explanation.Condition.expr:type_name -> cerbos.engine.v1.Explanation.Expr
+	42, // 57: cerbos.engine.v1.Explanation.Condition.conditions:type_name -> cerbos.engine.v1.Explanation.Condition
+	54, // 58: cerbos.engine.v1.Explanation.Rule.effect:type_name -> cerbos.effect.v1.Effect
+	6,  // 59: cerbos.engine.v1.Explanation.Rule.outcome:type_name -> cerbos.engine.v1.Explanation.Rule.Outcome
+	42, // 60: cerbos.engine.v1.Explanation.Rule.condition:type_name -> cerbos.engine.v1.Explanation.Condition
+	54, // 61: cerbos.engine.v1.Explanation.Action.effect:type_name -> cerbos.effect.v1.Effect
+	43, // 62: cerbos.engine.v1.Explanation.Action.rules:type_name -> cerbos.engine.v1.Explanation.Rule
+	44, // 63: cerbos.engine.v1.Explanation.ActionsEntry.value:type_name -> cerbos.engine.v1.Explanation.Action
+	48, // 64: cerbos.engine.v1.Request.Principal.attr:type_name -> cerbos.engine.v1.Request.Principal.AttrEntry
+	49, // 65: cerbos.engine.v1.Request.Resource.attr:type_name -> cerbos.engine.v1.Request.Resource.AttrEntry
+	52, // 66: cerbos.engine.v1.Request.Principal.AttrEntry.value:type_name -> google.protobuf.Value
+	52, // 67: cerbos.engine.v1.Request.Resource.AttrEntry.value:type_name -> google.protobuf.Value
+	68, // [68:68] is the sub-list for method output_type
+	68, // [68:68] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_cerbos_engine_v1_engine_proto_init() }
@@ -2420,16 +3391,16 @@ func file_cerbos_engine_v1_engine_proto_init() {
 	if File_cerbos_engine_v1_engine_proto != nil {
 		return
 	}
-	file_cerbos_engine_v1_engine_proto_msgTypes[15].OneofWrappers = []any{
+	file_cerbos_engine_v1_engine_proto_msgTypes[19].OneofWrappers = []any{
 		(*PlanResourcesAst_Node_LogicalOperation)(nil),
 		(*PlanResourcesAst_Node_Expression)(nil),
 	}
-	file_cerbos_engine_v1_engine_proto_msgTypes[18].OneofWrappers = []any{
+	file_cerbos_engine_v1_engine_proto_msgTypes[22].OneofWrappers = []any{
 		(*PlanResourcesFilter_Expression_Operand_Value)(nil),
 		(*PlanResourcesFilter_Expression_Operand_Expression)(nil),
 		(*PlanResourcesFilter_Expression_Operand_Variable)(nil),
 	}
-	file_cerbos_engine_v1_engine_proto_msgTypes[25].OneofWrappers = []any{
+	file_cerbos_engine_v1_engine_proto_msgTypes[31].OneofWrappers = []any{
 		(*Trace_Component_Action)(nil),
 		(*Trace_Component_DerivedRole)(nil),
 		(*Trace_Component_Expr)(nil),
@@ -2448,8 +3419,8 @@ func file_cerbos_engine_v1_engine_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cerbos_engine_v1_engine_proto_rawDesc), len(file_cerbos_engine_v1_engine_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   32,
+			NumEnums:      7,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
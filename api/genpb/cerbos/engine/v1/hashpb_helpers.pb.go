@@ -30,6 +30,17 @@ func cerbos_engine_v1_AuxData_hashpb_sum(m *AuxData, hasher hash.Hash, ignore ma
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.AuxData.providers"]; !ok {
+		if len(m.Providers) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Providers)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Providers[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Providers[k], hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckInput_hashpb_sum(m *CheckInput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -124,6 +135,133 @@ func cerbos_engine_v1_CheckOutput_hashpb_sum(m *CheckOutput, hasher hash.Hash, i
 	}
 }
 
+func cerbos_engine_v1_Explanation_Action_hashpb_sum(m *Explanation_Action, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetEffect())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicy()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicy()), len(m.GetPolicy())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRule()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRule()), len(m.GetRule())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.rules"]; !ok {
+		if len(m.Rules) > 0 {
+			for _, v := range m.Rules {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Rule_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Condition_hashpb_sum(m *Explanation_Condition, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.op"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOp())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.satisfied"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSatisfied())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.expr"]; !ok {
+		if m.GetExpr() != nil {
+			cerbos_engine_v1_Explanation_Expr_hashpb_sum(m.GetExpr(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.conditions"]; !ok {
+		if len(m.Conditions) > 0 {
+			for _, v := range m.Conditions {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Condition_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Expr_hashpb_sum(m *Explanation_Expr, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.expr"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetExpr()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetExpr()), len(m.GetExpr())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.value"]; !ok {
+		if m.GetValue() != nil {
+			google_protobuf_Value_hashpb_sum(m.GetValue(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.operands"]; !ok {
+		if len(m.Operands) > 0 {
+			for _, v := range m.Operands {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Expr_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Rule_hashpb_sum(m *Explanation_Rule, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicy()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicy()), len(m.GetPolicy())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.name"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetName()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetName()), len(m.GetName())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetEffect())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.outcome"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOutcome())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.derived_role"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetDerivedRole()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetDerivedRole()), len(m.GetDerivedRole())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.condition"]; !ok {
+		if m.GetCondition() != nil {
+			cerbos_engine_v1_Explanation_Condition_hashpb_sum(m.GetCondition(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+}
+
+func cerbos_engine_v1_Explanation_hashpb_sum(m *Explanation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Actions)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Actions[k] != nil {
+					cerbos_engine_v1_Explanation_Action_hashpb_sum(m.Actions[k], hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_engine_v1_OutputEntry_hashpb_sum(m *OutputEntry, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.OutputEntry.src"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetSrc()))))
@@ -136,6 +274,90 @@ func cerbos_engine_v1_OutputEntry_hashpb_sum(m *OutputEntry, hasher hash.Hash, i
 	}
 }
 
+func cerbos_engine_v1_PlanPrincipalsInput_Principal_hashpb_sum(m *PlanPrincipalsInput_Principal, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.Principal.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.Principal.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+}
+
+func cerbos_engine_v1_PlanPrincipalsInput_hashpb_sum(m *PlanPrincipalsInput, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.resource"]; !ok {
+		if m.GetResource() != nil {
+			cerbos_engine_v1_Resource_hashpb_sum(m.GetResource(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.principal"]; !ok {
+		if m.GetPrincipal() != nil {
+			cerbos_engine_v1_PlanPrincipalsInput_Principal_hashpb_sum(m.GetPrincipal(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.aux_data"]; !ok {
+		if m.GetAuxData() != nil {
+			cerbos_engine_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.include_meta"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetIncludeMeta())))
+	}
+}
+
+func cerbos_engine_v1_PlanPrincipalsOutput_hashpb_sum(m *PlanPrincipalsOutput, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKind()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetKind()), len(m.GetKind())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.resource_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResourceId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResourceId()), len(m.GetResourceId())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.filter"]; !ok {
+		if m.GetFilter() != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.GetFilter(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsOutput.filter_debug"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetFilterDebug()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetFilterDebug()), len(m.GetFilterDebug())))
+	}
+}
+
 func cerbos_engine_v1_PlanResourcesAst_LogicalOperation_hashpb_sum(m *PlanResourcesAst_LogicalOperation, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.PlanResourcesAst.LogicalOperation.operator"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOperator())))
@@ -223,6 +445,15 @@ func cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m *PlanResourcesFilter, has
 	}
 }
 
+func cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m *PlanResourcesInput_FilterOptions, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.simplify"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSimplify())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.normal_form"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetNormalForm())))
+	}
+}
+
 func cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m *PlanResourcesInput_Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKind()))))
@@ -284,6 +515,11 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *PlanResourcesInput, hashe
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *PlanResourcesOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -343,6 +579,11 @@ func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *PlanResourcesOutput, has
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -28,6 +28,17 @@ func cerbos_engine_v1_AuxData_hashpb_sum(m *v1.AuxData, hasher hash.Hash, ignore
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.AuxData.providers"]; !ok {
+		if len(m.Providers) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Providers)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Providers[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Providers[k], hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckInput_hashpb_sum(m *v1.CheckInput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -72,6 +83,41 @@ func cerbos_engine_v1_OutputEntry_hashpb_sum(m *v1.OutputEntry, hasher hash.Hash
 	}
 }
 
+func cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m *v1.PlanResourcesInput_FilterOptions, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.simplify"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSimplify())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.normal_form"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetNormalForm())))
+	}
+}
+
+func cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m *v1.PlanResourcesInput_Resource, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKind()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetKind()), len(m.GetKind())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.attr"]; !ok {
+		if len(m.Attr) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Attr)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Attr[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Attr[k], hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+}
+
 func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Principal.id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetId()))))
@@ -427,6 +473,128 @@ func cerbos_policy_v1_Output_hashpb_sum(m *Output, hasher hash.Hash, ignore map[
 	}
 }
 
+func cerbos_policy_v1_PlanTestTable_Expectation_hashpb_sum(m *PlanTestTable_Expectation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Expectation.principal"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPrincipal()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPrincipal()), len(m.GetPrincipal())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Expectation.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Actions)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Actions[k] != nil {
+					cerbos_policy_v1_PlanTestTable_PlanExpectation_hashpb_sum(m.Actions[k], hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Expectation.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Expectation.resource_groups"]; !ok {
+		if len(m.ResourceGroups) > 0 {
+			for _, v := range m.ResourceGroups {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_PlanTestTable_Input_hashpb_sum(m *PlanTestTable_Input, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Input.principals"]; !ok {
+		if len(m.Principals) > 0 {
+			for _, v := range m.Principals {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Input.principal_groups"]; !ok {
+		if len(m.PrincipalGroups) > 0 {
+			for _, v := range m.PrincipalGroups {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Input.resource"]; !ok {
+		if m.GetResource() != nil {
+			cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m.GetResource(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Input.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Input.aux_data"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetAuxData()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetAuxData()), len(m.GetAuxData())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.Input.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
+}
+
+func cerbos_policy_v1_PlanTestTable_PlanExpectation_hashpb_sum(m *PlanTestTable_PlanExpectation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.PlanExpectation.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.PlanExpectation.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCondition()), len(m.GetCondition())))
+	}
+}
+
+func cerbos_policy_v1_PlanTestTable_hashpb_sum(m *PlanTestTable, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.name"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetName()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetName()), len(m.GetName())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.description"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetDescription()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetDescription()), len(m.GetDescription())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.skip"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSkip())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.skip_reason"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetSkipReason()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetSkipReason()), len(m.GetSkipReason())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.input"]; !ok {
+		if m.GetInput() != nil {
+			cerbos_policy_v1_PlanTestTable_Input_hashpb_sum(m.GetInput(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.expected"]; !ok {
+		if len(m.Expected) > 0 {
+			for _, v := range m.Expected {
+				if v != nil {
+					cerbos_policy_v1_PlanTestTable_Expectation_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.PlanTestTable.options"]; !ok {
+		if m.GetOptions() != nil {
+			cerbos_policy_v1_TestOptions_hashpb_sum(m.GetOptions(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_policy_v1_Policy_hashpb_sum(m *Policy, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.Policy.api_version"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetApiVersion()))))
@@ -525,6 +693,12 @@ func cerbos_policy_v1_PrincipalPolicy_hashpb_sum(m *PrincipalPolicy, hasher hash
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_PrincipalRule_Action_hashpb_sum(m *PrincipalRule_Action, hasher hash.Hash, ignore map[string]struct{}) {
@@ -615,6 +789,12 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *ResourcePolicy, hasher hash.H
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -874,6 +1054,14 @@ func cerbos_policy_v1_TestResults_Details_hashpb_sum(m *TestResults_Details, has
 			case *TestResults_Details_SkipReason:
 				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(t.SkipReason))))
 				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(t.SkipReason), len(t.SkipReason)))
+			case *TestResults_Details_PlanFailure:
+				if t.PlanFailure != nil {
+					cerbos_policy_v1_TestResults_PlanFailure_hashpb_sum(t.PlanFailure, hasher, ignore)
+				}
+			case *TestResults_Details_PlanSuccess:
+				if t.PlanSuccess != nil {
+					cerbos_policy_v1_TestResults_PlanSuccess_hashpb_sum(t.PlanSuccess, hasher, ignore)
+				}
 			}
 		}
 	}
@@ -948,6 +1136,55 @@ func cerbos_policy_v1_TestResults_OutputFailure_hashpb_sum(m *TestResults_Output
 	}
 }
 
+func cerbos_policy_v1_TestResults_PlanFailure_ResourceMismatch_hashpb_sum(m *TestResults_PlanFailure_ResourceMismatch, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.resource"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResource()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResource()), len(m.GetResource())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.filter_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetFilterAllowed())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.check_effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCheckEffect())))
+	}
+}
+
+func cerbos_policy_v1_TestResults_PlanFailure_hashpb_sum(m *TestResults_PlanFailure, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.expected_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetExpectedKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.actual_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetActualKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.expected_condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetExpectedCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetExpectedCondition()), len(m.GetExpectedCondition())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.actual_condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetActualCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetActualCondition()), len(m.GetActualCondition())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_policy_v1_TestResults_PlanFailure_ResourceMismatch_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_TestResults_PlanSuccess_hashpb_sum(m *TestResults_PlanSuccess, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanSuccess.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanSuccess.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCondition()), len(m.GetCondition())))
+	}
+}
+
 func cerbos_policy_v1_TestResults_Principal_hashpb_sum(m *TestResults_Principal, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.TestResults.Principal.name"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetName()))))
@@ -1190,6 +1427,15 @@ func cerbos_policy_v1_TestSuite_hashpb_sum(m *TestSuite, hasher hash.Hash, ignor
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.TestSuite.plan_tests"]; !ok {
+		if len(m.PlanTests) > 0 {
+			for _, v := range m.PlanTests {
+				if v != nil {
+					cerbos_policy_v1_PlanTestTable_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_TestTable_Expectation_hashpb_sum(m *TestTable_Expectation, hasher hash.Hash, ignore map[string]struct{}) {
@@ -140,6 +140,61 @@ func (ScopePermissions) EnumDescriptor() ([]byte, []int) {
 	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{1}
 }
 
+type CombiningAlgorithm int32
+
+const (
+	CombiningAlgorithm_COMBINING_ALGORITHM_UNSPECIFIED        CombiningAlgorithm = 0
+	CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES     CombiningAlgorithm = 1
+	CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES   CombiningAlgorithm = 2
+	CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE   CombiningAlgorithm = 3
+	CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT CombiningAlgorithm = 4
+)
+
+// Enum value maps for CombiningAlgorithm.
+var (
+	CombiningAlgorithm_name = map[int32]string{
+		0: "COMBINING_ALGORITHM_UNSPECIFIED",
+		1: "COMBINING_ALGORITHM_DENY_OVERRIDES",
+		2: "COMBINING_ALGORITHM_PERMIT_OVERRIDES",
+		3: "COMBINING_ALGORITHM_FIRST_APPLICABLE",
+		4: "COMBINING_ALGORITHM_DENY_UNLESS_PERMIT",
+	}
+	CombiningAlgorithm_value = map[string]int32{
+		"COMBINING_ALGORITHM_UNSPECIFIED":        0,
+		"COMBINING_ALGORITHM_DENY_OVERRIDES":     1,
+		"COMBINING_ALGORITHM_PERMIT_OVERRIDES":   2,
+		"COMBINING_ALGORITHM_FIRST_APPLICABLE":   3,
+		"COMBINING_ALGORITHM_DENY_UNLESS_PERMIT": 4,
+	}
+)
+
+func (x CombiningAlgorithm) Enum() *CombiningAlgorithm {
+	p := new(CombiningAlgorithm)
+	*p = x
+	return p
+}
+
+func (x CombiningAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CombiningAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_cerbos_policy_v1_policy_proto_enumTypes[2].Descriptor()
+}
+
+func (CombiningAlgorithm) Type() protoreflect.EnumType {
+	return &file_cerbos_policy_v1_policy_proto_enumTypes[2]
+}
+
+func (x CombiningAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CombiningAlgorithm.Descriptor instead.
+func (CombiningAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{2}
+}
+
 type TestResults_Result int32
 
 const (
@@ -179,11 +234,11 @@ func (x TestResults_Result) String() string {
 }
 
 func (TestResults_Result) Descriptor() protoreflect.EnumDescriptor {
-	return file_cerbos_policy_v1_policy_proto_enumTypes[2].Descriptor()
+	return file_cerbos_policy_v1_policy_proto_enumTypes[3].Descriptor()
 }
 
 func (TestResults_Result) Type() protoreflect.EnumType {
-	return &file_cerbos_policy_v1_policy_proto_enumTypes[2]
+	return &file_cerbos_policy_v1_policy_proto_enumTypes[3]
 }
 
 func (x TestResults_Result) Number() protoreflect.EnumNumber {
@@ -192,7 +247,7 @@ func (x TestResults_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TestResults_Result.Descriptor instead.
 func (TestResults_Result) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 0}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type Policy struct {
@@ -532,6 +587,8 @@ type ResourcePolicy struct {
 	Variables          *Variables             `protobuf:"bytes,7,opt,name=variables,proto3" json:"variables,omitempty"`
 	ScopePermissions   ScopePermissions       `protobuf:"varint,8,opt,name=scope_permissions,json=scopePermissions,proto3,enum=cerbos.policy.v1.ScopePermissions" json:"scope_permissions,omitempty"`
 	Constants          *Constants             `protobuf:"bytes,9,opt,name=constants,proto3" json:"constants,omitempty"`
+	CombiningAlgorithm CombiningAlgorithm     `protobuf:"varint,10,opt,name=combining_algorithm,json=combiningAlgorithm,proto3,enum=cerbos.policy.v1.CombiningAlgorithm" json:"combining_algorithm,omitempty"`
+	ConditionCostLimit uint32                 `protobuf:"varint,11,opt,name=condition_cost_limit,json=conditionCostLimit,proto3" json:"condition_cost_limit,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -629,6 +686,20 @@ func (x *ResourcePolicy) GetConstants() *Constants {
 	return nil
 }
 
+func (x *ResourcePolicy) GetCombiningAlgorithm() CombiningAlgorithm {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return CombiningAlgorithm_COMBINING_ALGORITHM_UNSPECIFIED
+}
+
+func (x *ResourcePolicy) GetConditionCostLimit() uint32 {
+	if x != nil {
+		return x.ConditionCostLimit
+	}
+	return 0
+}
+
 type ResourceRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Actions       []string               `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
@@ -884,16 +955,18 @@ func (x *RoleRule) GetCondition() *Condition {
 }
 
 type PrincipalPolicy struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Principal        string                 `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
-	Version          string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Rules            []*PrincipalRule       `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
-	Scope            string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
-	Variables        *Variables             `protobuf:"bytes,5,opt,name=variables,proto3" json:"variables,omitempty"`
-	ScopePermissions ScopePermissions       `protobuf:"varint,6,opt,name=scope_permissions,json=scopePermissions,proto3,enum=cerbos.policy.v1.ScopePermissions" json:"scope_permissions,omitempty"`
-	Constants        *Constants             `protobuf:"bytes,7,opt,name=constants,proto3" json:"constants,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Principal          string                 `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Version            string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Rules              []*PrincipalRule       `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Scope              string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Variables          *Variables             `protobuf:"bytes,5,opt,name=variables,proto3" json:"variables,omitempty"`
+	ScopePermissions   ScopePermissions       `protobuf:"varint,6,opt,name=scope_permissions,json=scopePermissions,proto3,enum=cerbos.policy.v1.ScopePermissions" json:"scope_permissions,omitempty"`
+	Constants          *Constants             `protobuf:"bytes,7,opt,name=constants,proto3" json:"constants,omitempty"`
+	CombiningAlgorithm CombiningAlgorithm     `protobuf:"varint,8,opt,name=combining_algorithm,json=combiningAlgorithm,proto3,enum=cerbos.policy.v1.CombiningAlgorithm" json:"combining_algorithm,omitempty"`
+	ConditionCostLimit uint32                 `protobuf:"varint,9,opt,name=condition_cost_limit,json=conditionCostLimit,proto3" json:"condition_cost_limit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *PrincipalPolicy) Reset() {
@@ -975,6 +1048,20 @@ func (x *PrincipalPolicy) GetConstants() *Constants {
 	return nil
 }
 
+func (x *PrincipalPolicy) GetCombiningAlgorithm() CombiningAlgorithm {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return CombiningAlgorithm_COMBINING_ALGORITHM_UNSPECIFIED
+}
+
+func (x *PrincipalPolicy) GetConditionCostLimit() uint32 {
+	if x != nil {
+		return x.ConditionCostLimit
+	}
+	return 0
+}
+
 type PrincipalRule struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Resource      string                  `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
@@ -1819,6 +1906,7 @@ type TestSuite struct {
 	JsonSchema      string                                  `protobuf:"bytes,10,opt,name=json_schema,json=$schema,proto3" json:"json_schema,omitempty"`
 	PrincipalGroups map[string]*TestFixtureGroup_Principals `protobuf:"bytes,11,rep,name=principal_groups,json=principalGroups,proto3" json:"principal_groups,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ResourceGroups  map[string]*TestFixtureGroup_Resources  `protobuf:"bytes,12,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PlanTests       []*PlanTestTable                        `protobuf:"bytes,13,rep,name=plan_tests,json=planTests,proto3" json:"plan_tests,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1937,6 +2025,13 @@ func (x *TestSuite) GetResourceGroups() map[string]*TestFixtureGroup_Resources {
 	return nil
 }
 
+func (x *TestSuite) GetPlanTests() []*PlanTestTable {
+	if x != nil {
+		return x.PlanTests
+	}
+	return nil
+}
+
 type TestTable struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2029,6 +2124,98 @@ func (x *TestTable) GetOptions() *TestOptions {
 	return nil
 }
 
+type PlanTestTable struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Name          string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Skip          bool                         `protobuf:"varint,3,opt,name=skip,proto3" json:"skip,omitempty"`
+	SkipReason    string                       `protobuf:"bytes,4,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	Input         *PlanTestTable_Input         `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	Expected      []*PlanTestTable_Expectation `protobuf:"bytes,6,rep,name=expected,proto3" json:"expected,omitempty"`
+	Options       *TestOptions                 `protobuf:"bytes,7,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanTestTable) Reset() {
+	*x = PlanTestTable{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanTestTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanTestTable) ProtoMessage() {}
+
+func (x *PlanTestTable) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanTestTable.ProtoReflect.Descriptor instead.
+func (*PlanTestTable) Descriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PlanTestTable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanTestTable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlanTestTable) GetSkip() bool {
+	if x != nil {
+		return x.Skip
+	}
+	return false
+}
+
+func (x *PlanTestTable) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+func (x *PlanTestTable) GetInput() *PlanTestTable_Input {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *PlanTestTable) GetExpected() []*PlanTestTable_Expectation {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *PlanTestTable) GetOptions() *TestOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 type Test struct {
 	state           protoimpl.MessageState         `protogen:"open.v1"`
 	Name            *Test_TestName                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2045,7 +2232,7 @@ type Test struct {
 
 func (x *Test) Reset() {
 	*x = Test{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[24]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2057,7 +2244,7 @@ func (x *Test) String() string {
 func (*Test) ProtoMessage() {}
 
 func (x *Test) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[24]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2070,7 +2257,7 @@ func (x *Test) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Test.ProtoReflect.Descriptor instead.
 func (*Test) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{24}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Test) GetName() *Test_TestName {
@@ -2139,7 +2326,7 @@ type TestResults struct {
 
 func (x *TestResults) Reset() {
 	*x = TestResults{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[25]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2151,7 +2338,7 @@ func (x *TestResults) String() string {
 func (*TestResults) ProtoMessage() {}
 
 func (x *TestResults) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[25]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,7 +2351,7 @@ func (x *TestResults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults.ProtoReflect.Descriptor instead.
 func (*TestResults) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TestResults) GetSuites() []*TestResults_Suite {
@@ -2194,7 +2381,7 @@ type PrincipalRule_Action struct {
 
 func (x *PrincipalRule_Action) Reset() {
 	*x = PrincipalRule_Action{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[29]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2206,7 +2393,7 @@ func (x *PrincipalRule_Action) String() string {
 func (*PrincipalRule_Action) ProtoMessage() {}
 
 func (x *PrincipalRule_Action) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[29]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,7 +2453,7 @@ type Match_ExprList struct {
 
 func (x *Match_ExprList) Reset() {
 	*x = Match_ExprList{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[34]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2278,7 +2465,7 @@ func (x *Match_ExprList) String() string {
 func (*Match_ExprList) ProtoMessage() {}
 
 func (x *Match_ExprList) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[34]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,7 +2498,7 @@ type Output_When struct {
 
 func (x *Output_When) Reset() {
 	*x = Output_When{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[35]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2323,7 +2510,7 @@ func (x *Output_When) String() string {
 func (*Output_When) ProtoMessage() {}
 
 func (x *Output_When) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[35]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2362,7 +2549,7 @@ type Schemas_IgnoreWhen struct {
 
 func (x *Schemas_IgnoreWhen) Reset() {
 	*x = Schemas_IgnoreWhen{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[36]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2374,7 +2561,7 @@ func (x *Schemas_IgnoreWhen) String() string {
 func (*Schemas_IgnoreWhen) ProtoMessage() {}
 
 func (x *Schemas_IgnoreWhen) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[36]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2407,7 +2594,7 @@ type Schemas_Schema struct {
 
 func (x *Schemas_Schema) Reset() {
 	*x = Schemas_Schema{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[37]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2419,7 +2606,7 @@ func (x *Schemas_Schema) String() string {
 func (*Schemas_Schema) ProtoMessage() {}
 
 func (x *Schemas_Schema) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[37]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2460,7 +2647,7 @@ type TestFixture_Principals struct {
 
 func (x *TestFixture_Principals) Reset() {
 	*x = TestFixture_Principals{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[38]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2472,7 +2659,7 @@ func (x *TestFixture_Principals) String() string {
 func (*TestFixture_Principals) ProtoMessage() {}
 
 func (x *TestFixture_Principals) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[38]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +2707,7 @@ type TestFixture_Resources struct {
 
 func (x *TestFixture_Resources) Reset() {
 	*x = TestFixture_Resources{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[39]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2532,7 +2719,7 @@ func (x *TestFixture_Resources) String() string {
 func (*TestFixture_Resources) ProtoMessage() {}
 
 func (x *TestFixture_Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[39]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2579,7 +2766,7 @@ type TestFixture_AuxData struct {
 
 func (x *TestFixture_AuxData) Reset() {
 	*x = TestFixture_AuxData{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[40]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2591,7 +2778,7 @@ func (x *TestFixture_AuxData) String() string {
 func (*TestFixture_AuxData) ProtoMessage() {}
 
 func (x *TestFixture_AuxData) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[40]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,7 +2817,7 @@ type TestFixtureGroup_Principals struct {
 
 func (x *TestFixtureGroup_Principals) Reset() {
 	*x = TestFixtureGroup_Principals{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[46]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2642,7 +2829,7 @@ func (x *TestFixtureGroup_Principals) String() string {
 func (*TestFixtureGroup_Principals) ProtoMessage() {}
 
 func (x *TestFixtureGroup_Principals) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[46]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,7 +2861,7 @@ type TestFixtureGroup_Resources struct {
 
 func (x *TestFixtureGroup_Resources) Reset() {
 	*x = TestFixtureGroup_Resources{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[47]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2686,7 +2873,7 @@ func (x *TestFixtureGroup_Resources) String() string {
 func (*TestFixtureGroup_Resources) ProtoMessage() {}
 
 func (x *TestFixtureGroup_Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[47]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2723,7 +2910,7 @@ type TestTable_Input struct {
 
 func (x *TestTable_Input) Reset() {
 	*x = TestTable_Input{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[54]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2735,7 +2922,7 @@ func (x *TestTable_Input) String() string {
 func (*TestTable_Input) ProtoMessage() {}
 
 func (x *TestTable_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[54]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,7 +2990,7 @@ type TestTable_OutputExpectations struct {
 
 func (x *TestTable_OutputExpectations) Reset() {
 	*x = TestTable_OutputExpectations{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[55]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2815,7 +3002,7 @@ func (x *TestTable_OutputExpectations) String() string {
 func (*TestTable_OutputExpectations) ProtoMessage() {}
 
 func (x *TestTable_OutputExpectations) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[55]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,7 +3048,7 @@ type TestTable_Expectation struct {
 
 func (x *TestTable_Expectation) Reset() {
 	*x = TestTable_Expectation{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[56]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2873,7 +3060,7 @@ func (x *TestTable_Expectation) String() string {
 func (*TestTable_Expectation) ProtoMessage() {}
 
 func (x *TestTable_Expectation) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[56]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2945,30 +3132,33 @@ func (x *TestTable_Expectation) GetResourceGroups() []string {
 	return nil
 }
 
-type Test_TestName struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TestTableName string                 `protobuf:"bytes,1,opt,name=test_table_name,json=testTableName,proto3" json:"test_table_name,omitempty"`
-	PrincipalKey  string                 `protobuf:"bytes,2,opt,name=principal_key,json=principalKey,proto3" json:"principal_key,omitempty"`
-	ResourceKey   string                 `protobuf:"bytes,3,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PlanTestTable_Input struct {
+	state           protoimpl.MessageState                `protogen:"open.v1"`
+	Principals      []string                              `protobuf:"bytes,1,rep,name=principals,proto3" json:"principals,omitempty"`
+	PrincipalGroups []string                              `protobuf:"bytes,2,rep,name=principal_groups,json=principalGroups,proto3" json:"principal_groups,omitempty"`
+	Resource        *v11.PlanResourcesInput_Resource      `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Actions         []string                              `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	AuxData         string                                `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	FilterOptions   *v11.PlanResourcesInput_FilterOptions `protobuf:"bytes,6,opt,name=filter_options,json=filterOptions,proto3" json:"filter_options,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Test_TestName) Reset() {
-	*x = Test_TestName{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[58]
+func (x *PlanTestTable_Input) Reset() {
+	*x = PlanTestTable_Input{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Test_TestName) String() string {
+func (x *PlanTestTable_Input) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Test_TestName) ProtoMessage() {}
+func (*PlanTestTable_Input) ProtoMessage() {}
 
-func (x *Test_TestName) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[58]
+func (x *PlanTestTable_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2979,54 +3169,76 @@ func (x *Test_TestName) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Test_TestName.ProtoReflect.Descriptor instead.
-func (*Test_TestName) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlanTestTable_Input.ProtoReflect.Descriptor instead.
+func (*PlanTestTable_Input) Descriptor() ([]byte, []int) {
 	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *Test_TestName) GetTestTableName() string {
+func (x *PlanTestTable_Input) GetPrincipals() []string {
 	if x != nil {
-		return x.TestTableName
+		return x.Principals
 	}
-	return ""
+	return nil
 }
 
-func (x *Test_TestName) GetPrincipalKey() string {
+func (x *PlanTestTable_Input) GetPrincipalGroups() []string {
 	if x != nil {
-		return x.PrincipalKey
+		return x.PrincipalGroups
 	}
-	return ""
+	return nil
 }
 
-func (x *Test_TestName) GetResourceKey() string {
+func (x *PlanTestTable_Input) GetResource() *v11.PlanResourcesInput_Resource {
 	if x != nil {
-		return x.ResourceKey
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *PlanTestTable_Input) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlanTestTable_Input) GetAuxData() string {
+	if x != nil {
+		return x.AuxData
 	}
 	return ""
 }
 
-type Test_OutputEntries struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Entries       map[string]*structpb.Value `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *PlanTestTable_Input) GetFilterOptions() *v11.PlanResourcesInput_FilterOptions {
+	if x != nil {
+		return x.FilterOptions
+	}
+	return nil
+}
+
+type PlanTestTable_PlanExpectation struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Kind          v11.PlanResourcesFilter_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=cerbos.engine.v1.PlanResourcesFilter_Kind" json:"kind,omitempty"`
+	Condition     string                       `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Test_OutputEntries) Reset() {
-	*x = Test_OutputEntries{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[59]
+func (x *PlanTestTable_PlanExpectation) Reset() {
+	*x = PlanTestTable_PlanExpectation{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Test_OutputEntries) String() string {
+func (x *PlanTestTable_PlanExpectation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Test_OutputEntries) ProtoMessage() {}
+func (*PlanTestTable_PlanExpectation) ProtoMessage() {}
 
-func (x *Test_OutputEntries) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[59]
+func (x *PlanTestTable_PlanExpectation) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3037,41 +3249,220 @@ func (x *Test_OutputEntries) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Test_OutputEntries.ProtoReflect.Descriptor instead.
-func (*Test_OutputEntries) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlanTestTable_PlanExpectation.ProtoReflect.Descriptor instead.
+func (*PlanTestTable_PlanExpectation) Descriptor() ([]byte, []int) {
 	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{24, 1}
 }
 
-func (x *Test_OutputEntries) GetEntries() map[string]*structpb.Value {
+func (x *PlanTestTable_PlanExpectation) GetKind() v11.PlanResourcesFilter_Kind {
 	if x != nil {
-		return x.Entries
+		return x.Kind
 	}
-	return nil
+	return v11.PlanResourcesFilter_Kind(0)
 }
 
-type TestResults_Tally struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        TestResults_Result     `protobuf:"varint,1,opt,name=result,proto3,enum=cerbos.policy.v1.TestResults_Result" json:"result,omitempty"`
-	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PlanTestTable_PlanExpectation) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
 }
 
-func (x *TestResults_Tally) Reset() {
-	*x = TestResults_Tally{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[63]
+type PlanTestTable_Expectation struct {
+	state          protoimpl.MessageState                    `protogen:"open.v1"`
+	Principal      string                                    `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Actions        map[string]*PlanTestTable_PlanExpectation `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Resources      []string                                  `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	ResourceGroups []string                                  `protobuf:"bytes,4,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlanTestTable_Expectation) Reset() {
+	*x = PlanTestTable_Expectation{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestResults_Tally) String() string {
+func (x *PlanTestTable_Expectation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanTestTable_Expectation) ProtoMessage() {}
+
+func (x *PlanTestTable_Expectation) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanTestTable_Expectation.ProtoReflect.Descriptor instead.
+func (*PlanTestTable_Expectation) Descriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{24, 2}
+}
+
+func (x *PlanTestTable_Expectation) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *PlanTestTable_Expectation) GetActions() map[string]*PlanTestTable_PlanExpectation {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlanTestTable_Expectation) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *PlanTestTable_Expectation) GetResourceGroups() []string {
+	if x != nil {
+		return x.ResourceGroups
+	}
+	return nil
+}
+
+type Test_TestName struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestTableName string                 `protobuf:"bytes,1,opt,name=test_table_name,json=testTableName,proto3" json:"test_table_name,omitempty"`
+	PrincipalKey  string                 `protobuf:"bytes,2,opt,name=principal_key,json=principalKey,proto3" json:"principal_key,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,3,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Test_TestName) Reset() {
+	*x = Test_TestName{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Test_TestName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Test_TestName) ProtoMessage() {}
+
+func (x *Test_TestName) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Test_TestName.ProtoReflect.Descriptor instead.
+func (*Test_TestName) Descriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *Test_TestName) GetTestTableName() string {
+	if x != nil {
+		return x.TestTableName
+	}
+	return ""
+}
+
+func (x *Test_TestName) GetPrincipalKey() string {
+	if x != nil {
+		return x.PrincipalKey
+	}
+	return ""
+}
+
+func (x *Test_TestName) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+type Test_OutputEntries struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Entries       map[string]*structpb.Value `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Test_OutputEntries) Reset() {
+	*x = Test_OutputEntries{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Test_OutputEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Test_OutputEntries) ProtoMessage() {}
+
+func (x *Test_OutputEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Test_OutputEntries.ProtoReflect.Descriptor instead.
+func (*Test_OutputEntries) Descriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *Test_OutputEntries) GetEntries() map[string]*structpb.Value {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type TestResults_Tally struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        TestResults_Result     `protobuf:"varint,1,opt,name=result,proto3,enum=cerbos.policy.v1.TestResults_Result" json:"result,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestResults_Tally) Reset() {
+	*x = TestResults_Tally{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResults_Tally) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*TestResults_Tally) ProtoMessage() {}
 
 func (x *TestResults_Tally) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[63]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3084,7 +3475,7 @@ func (x *TestResults_Tally) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Tally.ProtoReflect.Descriptor instead.
 func (*TestResults_Tally) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 0}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 0}
 }
 
 func (x *TestResults_Tally) GetResult() TestResults_Result {
@@ -3112,7 +3503,7 @@ type TestResults_Summary struct {
 
 func (x *TestResults_Summary) Reset() {
 	*x = TestResults_Summary{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[64]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3124,7 +3515,7 @@ func (x *TestResults_Summary) String() string {
 func (*TestResults_Summary) ProtoMessage() {}
 
 func (x *TestResults_Summary) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[64]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,7 +3528,7 @@ func (x *TestResults_Summary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Summary.ProtoReflect.Descriptor instead.
 func (*TestResults_Summary) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 1}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 1}
 }
 
 func (x *TestResults_Summary) GetOverallResult() TestResults_Result {
@@ -3178,7 +3569,7 @@ type TestResults_Suite struct {
 
 func (x *TestResults_Suite) Reset() {
 	*x = TestResults_Suite{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[65]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3190,7 +3581,7 @@ func (x *TestResults_Suite) String() string {
 func (*TestResults_Suite) ProtoMessage() {}
 
 func (x *TestResults_Suite) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[65]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3203,7 +3594,7 @@ func (x *TestResults_Suite) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Suite.ProtoReflect.Descriptor instead.
 func (*TestResults_Suite) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 2}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 2}
 }
 
 func (x *TestResults_Suite) GetFile() string {
@@ -3273,7 +3664,7 @@ type TestResults_TestCase struct {
 
 func (x *TestResults_TestCase) Reset() {
 	*x = TestResults_TestCase{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[66]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3285,7 +3676,7 @@ func (x *TestResults_TestCase) String() string {
 func (*TestResults_TestCase) ProtoMessage() {}
 
 func (x *TestResults_TestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[66]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,7 +3689,7 @@ func (x *TestResults_TestCase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_TestCase.ProtoReflect.Descriptor instead.
 func (*TestResults_TestCase) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 3}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 3}
 }
 
 func (x *TestResults_TestCase) GetName() string {
@@ -3325,7 +3716,7 @@ type TestResults_Principal struct {
 
 func (x *TestResults_Principal) Reset() {
 	*x = TestResults_Principal{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[67]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3337,7 +3728,7 @@ func (x *TestResults_Principal) String() string {
 func (*TestResults_Principal) ProtoMessage() {}
 
 func (x *TestResults_Principal) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[67]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3350,7 +3741,7 @@ func (x *TestResults_Principal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Principal.ProtoReflect.Descriptor instead.
 func (*TestResults_Principal) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 4}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 4}
 }
 
 func (x *TestResults_Principal) GetName() string {
@@ -3377,7 +3768,7 @@ type TestResults_Resource struct {
 
 func (x *TestResults_Resource) Reset() {
 	*x = TestResults_Resource{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[68]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3389,7 +3780,7 @@ func (x *TestResults_Resource) String() string {
 func (*TestResults_Resource) ProtoMessage() {}
 
 func (x *TestResults_Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[68]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3402,7 +3793,7 @@ func (x *TestResults_Resource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Resource.ProtoReflect.Descriptor instead.
 func (*TestResults_Resource) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 5}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 5}
 }
 
 func (x *TestResults_Resource) GetName() string {
@@ -3429,7 +3820,7 @@ type TestResults_Action struct {
 
 func (x *TestResults_Action) Reset() {
 	*x = TestResults_Action{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[69]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3441,7 +3832,7 @@ func (x *TestResults_Action) String() string {
 func (*TestResults_Action) ProtoMessage() {}
 
 func (x *TestResults_Action) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[69]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3454,7 +3845,7 @@ func (x *TestResults_Action) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Action.ProtoReflect.Descriptor instead.
 func (*TestResults_Action) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 6}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 6}
 }
 
 func (x *TestResults_Action) GetName() string {
@@ -3480,6 +3871,8 @@ type TestResults_Details struct {
 	//	*TestResults_Details_Error
 	//	*TestResults_Details_Success
 	//	*TestResults_Details_SkipReason
+	//	*TestResults_Details_PlanFailure
+	//	*TestResults_Details_PlanSuccess
 	Outcome       isTestResults_Details_Outcome `protobuf_oneof:"outcome"`
 	EngineTrace   []*v11.Trace                  `protobuf:"bytes,4,rep,name=engine_trace,json=engineTrace,proto3" json:"engine_trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -3488,7 +3881,7 @@ type TestResults_Details struct {
 
 func (x *TestResults_Details) Reset() {
 	*x = TestResults_Details{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[70]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3500,7 +3893,7 @@ func (x *TestResults_Details) String() string {
 func (*TestResults_Details) ProtoMessage() {}
 
 func (x *TestResults_Details) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[70]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3513,7 +3906,7 @@ func (x *TestResults_Details) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Details.ProtoReflect.Descriptor instead.
 func (*TestResults_Details) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 7}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 7}
 }
 
 func (x *TestResults_Details) GetResult() TestResults_Result {
@@ -3566,6 +3959,24 @@ func (x *TestResults_Details) GetSkipReason() string {
 	return ""
 }
 
+func (x *TestResults_Details) GetPlanFailure() *TestResults_PlanFailure {
+	if x != nil {
+		if x, ok := x.Outcome.(*TestResults_Details_PlanFailure); ok {
+			return x.PlanFailure
+		}
+	}
+	return nil
+}
+
+func (x *TestResults_Details) GetPlanSuccess() *TestResults_PlanSuccess {
+	if x != nil {
+		if x, ok := x.Outcome.(*TestResults_Details_PlanSuccess); ok {
+			return x.PlanSuccess
+		}
+	}
+	return nil
+}
+
 func (x *TestResults_Details) GetEngineTrace() []*v11.Trace {
 	if x != nil {
 		return x.EngineTrace
@@ -3593,6 +4004,14 @@ type TestResults_Details_SkipReason struct {
 	SkipReason string `protobuf:"bytes,6,opt,name=skip_reason,json=skipReason,proto3,oneof"`
 }
 
+type TestResults_Details_PlanFailure struct {
+	PlanFailure *TestResults_PlanFailure `protobuf:"bytes,7,opt,name=plan_failure,json=planFailure,proto3,oneof"`
+}
+
+type TestResults_Details_PlanSuccess struct {
+	PlanSuccess *TestResults_PlanSuccess `protobuf:"bytes,8,opt,name=plan_success,json=planSuccess,proto3,oneof"`
+}
+
 func (*TestResults_Details_Failure) isTestResults_Details_Outcome() {}
 
 func (*TestResults_Details_Error) isTestResults_Details_Outcome() {}
@@ -3601,6 +4020,10 @@ func (*TestResults_Details_Success) isTestResults_Details_Outcome() {}
 
 func (*TestResults_Details_SkipReason) isTestResults_Details_Outcome() {}
 
+func (*TestResults_Details_PlanFailure) isTestResults_Details_Outcome() {}
+
+func (*TestResults_Details_PlanSuccess) isTestResults_Details_Outcome() {}
+
 type TestResults_OutputFailure struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Src   string                 `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
@@ -3615,7 +4038,7 @@ type TestResults_OutputFailure struct {
 
 func (x *TestResults_OutputFailure) Reset() {
 	*x = TestResults_OutputFailure{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[71]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3627,7 +4050,7 @@ func (x *TestResults_OutputFailure) String() string {
 func (*TestResults_OutputFailure) ProtoMessage() {}
 
 func (x *TestResults_OutputFailure) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[71]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3640,7 +4063,7 @@ func (x *TestResults_OutputFailure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_OutputFailure.ProtoReflect.Descriptor instead.
 func (*TestResults_OutputFailure) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 8}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 8}
 }
 
 func (x *TestResults_OutputFailure) GetSrc() string {
@@ -3702,7 +4125,7 @@ type TestResults_Failure struct {
 
 func (x *TestResults_Failure) Reset() {
 	*x = TestResults_Failure{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[72]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3714,7 +4137,7 @@ func (x *TestResults_Failure) String() string {
 func (*TestResults_Failure) ProtoMessage() {}
 
 func (x *TestResults_Failure) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[72]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,7 +4150,7 @@ func (x *TestResults_Failure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Failure.ProtoReflect.Descriptor instead.
 func (*TestResults_Failure) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 9}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 9}
 }
 
 func (x *TestResults_Failure) GetExpected() v1.Effect {
@@ -3761,7 +4184,7 @@ type TestResults_Success struct {
 
 func (x *TestResults_Success) Reset() {
 	*x = TestResults_Success{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[73]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3773,7 +4196,7 @@ func (x *TestResults_Success) String() string {
 func (*TestResults_Success) ProtoMessage() {}
 
 func (x *TestResults_Success) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[73]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,7 +4209,7 @@ func (x *TestResults_Success) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestResults_Success.ProtoReflect.Descriptor instead.
 func (*TestResults_Success) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 10}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 10}
 }
 
 func (x *TestResults_Success) GetEffect() v1.Effect {
@@ -3803,6 +4226,134 @@ func (x *TestResults_Success) GetOutputs() []*v11.OutputEntry {
 	return nil
 }
 
+type TestResults_PlanFailure struct {
+	state             protoimpl.MessageState                      `protogen:"open.v1"`
+	ExpectedKind      v11.PlanResourcesFilter_Kind                `protobuf:"varint,1,opt,name=expected_kind,json=expectedKind,proto3,enum=cerbos.engine.v1.PlanResourcesFilter_Kind" json:"expected_kind,omitempty"`
+	ActualKind        v11.PlanResourcesFilter_Kind                `protobuf:"varint,2,opt,name=actual_kind,json=actualKind,proto3,enum=cerbos.engine.v1.PlanResourcesFilter_Kind" json:"actual_kind,omitempty"`
+	ExpectedCondition string                                      `protobuf:"bytes,3,opt,name=expected_condition,json=expectedCondition,proto3" json:"expected_condition,omitempty"`
+	ActualCondition   string                                      `protobuf:"bytes,4,opt,name=actual_condition,json=actualCondition,proto3" json:"actual_condition,omitempty"`
+	Resources         []*TestResults_PlanFailure_ResourceMismatch `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TestResults_PlanFailure) Reset() {
+	*x = TestResults_PlanFailure{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResults_PlanFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResults_PlanFailure) ProtoMessage() {}
+
+func (x *TestResults_PlanFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResults_PlanFailure.ProtoReflect.Descriptor instead.
+func (*TestResults_PlanFailure) Descriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 11}
+}
+
+func (x *TestResults_PlanFailure) GetExpectedKind() v11.PlanResourcesFilter_Kind {
+	if x != nil {
+		return x.ExpectedKind
+	}
+	return v11.PlanResourcesFilter_Kind(0)
+}
+
+func (x *TestResults_PlanFailure) GetActualKind() v11.PlanResourcesFilter_Kind {
+	if x != nil {
+		return x.ActualKind
+	}
+	return v11.PlanResourcesFilter_Kind(0)
+}
+
+func (x *TestResults_PlanFailure) GetExpectedCondition() string {
+	if x != nil {
+		return x.ExpectedCondition
+	}
+	return ""
+}
+
+func (x *TestResults_PlanFailure) GetActualCondition() string {
+	if x != nil {
+		return x.ActualCondition
+	}
+	return ""
+}
+
+func (x *TestResults_PlanFailure) GetResources() []*TestResults_PlanFailure_ResourceMismatch {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type TestResults_PlanSuccess struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Kind          v11.PlanResourcesFilter_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=cerbos.engine.v1.PlanResourcesFilter_Kind" json:"kind,omitempty"`
+	Condition     string                       `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestResults_PlanSuccess) Reset() {
+	*x = TestResults_PlanSuccess{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResults_PlanSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResults_PlanSuccess) ProtoMessage() {}
+
+func (x *TestResults_PlanSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResults_PlanSuccess.ProtoReflect.Descriptor instead.
+func (*TestResults_PlanSuccess) Descriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 12}
+}
+
+func (x *TestResults_PlanSuccess) GetKind() v11.PlanResourcesFilter_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return v11.PlanResourcesFilter_Kind(0)
+}
+
+func (x *TestResults_PlanSuccess) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
 type TestResults_OutputFailure_MismatchedValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Expected      *structpb.Value        `protobuf:"bytes,1,opt,name=expected,proto3" json:"expected,omitempty"`
@@ -3813,7 +4364,7 @@ type TestResults_OutputFailure_MismatchedValue struct {
 
 func (x *TestResults_OutputFailure_MismatchedValue) Reset() {
 	*x = TestResults_OutputFailure_MismatchedValue{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[74]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3825,7 +4376,7 @@ func (x *TestResults_OutputFailure_MismatchedValue) String() string {
 func (*TestResults_OutputFailure_MismatchedValue) ProtoMessage() {}
 
 func (x *TestResults_OutputFailure_MismatchedValue) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[74]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,7 +4389,7 @@ func (x *TestResults_OutputFailure_MismatchedValue) ProtoReflect() protoreflect.
 
 // Deprecated: Use TestResults_OutputFailure_MismatchedValue.ProtoReflect.Descriptor instead.
 func (*TestResults_OutputFailure_MismatchedValue) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 8, 0}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 8, 0}
 }
 
 func (x *TestResults_OutputFailure_MismatchedValue) GetExpected() *structpb.Value {
@@ -3864,7 +4415,7 @@ type TestResults_OutputFailure_MissingValue struct {
 
 func (x *TestResults_OutputFailure_MissingValue) Reset() {
 	*x = TestResults_OutputFailure_MissingValue{}
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[75]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3876,7 +4427,7 @@ func (x *TestResults_OutputFailure_MissingValue) String() string {
 func (*TestResults_OutputFailure_MissingValue) ProtoMessage() {}
 
 func (x *TestResults_OutputFailure_MissingValue) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[75]
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3889,7 +4440,7 @@ func (x *TestResults_OutputFailure_MissingValue) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use TestResults_OutputFailure_MissingValue.ProtoReflect.Descriptor instead.
 func (*TestResults_OutputFailure_MissingValue) Descriptor() ([]byte, []int) {
-	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{25, 8, 1}
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 8, 1}
 }
 
 func (x *TestResults_OutputFailure_MissingValue) GetExpected() *structpb.Value {
@@ -3899,6 +4450,66 @@ func (x *TestResults_OutputFailure_MissingValue) GetExpected() *structpb.Value {
 	return nil
 }
 
+type TestResults_PlanFailure_ResourceMismatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	FilterAllowed bool                   `protobuf:"varint,2,opt,name=filter_allowed,json=filterAllowed,proto3" json:"filter_allowed,omitempty"`
+	CheckEffect   v1.Effect              `protobuf:"varint,3,opt,name=check_effect,json=checkEffect,proto3,enum=cerbos.effect.v1.Effect" json:"check_effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestResults_PlanFailure_ResourceMismatch) Reset() {
+	*x = TestResults_PlanFailure_ResourceMismatch{}
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResults_PlanFailure_ResourceMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResults_PlanFailure_ResourceMismatch) ProtoMessage() {}
+
+func (x *TestResults_PlanFailure_ResourceMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_policy_v1_policy_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResults_PlanFailure_ResourceMismatch.ProtoReflect.Descriptor instead.
+func (*TestResults_PlanFailure_ResourceMismatch) Descriptor() ([]byte, []int) {
+	return file_cerbos_policy_v1_policy_proto_rawDescGZIP(), []int{26, 11, 0}
+}
+
+func (x *TestResults_PlanFailure_ResourceMismatch) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *TestResults_PlanFailure_ResourceMismatch) GetFilterAllowed() bool {
+	if x != nil {
+		return x.FilterAllowed
+	}
+	return false
+}
+
+func (x *TestResults_PlanFailure_ResourceMismatch) GetCheckEffect() v1.Effect {
+	if x != nil {
+		return x.CheckEffect
+	}
+	return v1.Effect(0)
+}
+
 var File_cerbos_policy_v1_policy_proto protoreflect.FileDescriptor
 
 const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
@@ -3942,7 +4553,7 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\x11source_attributes\x18\x06 \x01(\v2\".cerbos.policy.v1.SourceAttributesR\x10sourceAttributes\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb5\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbe\x05\n" +
 	"\x0eResourcePolicy\x125\n" +
 	"\bresource\x18\x01 \x01(\tB\x19\xbaH\x16\xc8\x01\x01r\x112\x0f^[^!*?\\[\\]{}]+$R\bresource\x12+\n" +
 	"\aversion\x18\x02 \x01(\tB\x11\xbaH\x0e\xc8\x01\x01r\t2\a^[\\w]+$R\aversion\x12K\n" +
@@ -3952,7 +4563,10 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\aschemas\x18\x06 \x01(\v2\x19.cerbos.policy.v1.SchemasR\aschemas\x129\n" +
 	"\tvariables\x18\a \x01(\v2\x1b.cerbos.policy.v1.VariablesR\tvariables\x12O\n" +
 	"\x11scope_permissions\x18\b \x01(\x0e2\".cerbos.policy.v1.ScopePermissionsR\x10scopePermissions\x129\n" +
-	"\tconstants\x18\t \x01(\v2\x1b.cerbos.policy.v1.ConstantsR\tconstants\"\x86\x03\n" +
+	"\tconstants\x18\t \x01(\v2\x1b.cerbos.policy.v1.ConstantsR\tconstants\x12U\n" +
+	"\x13combining_algorithm\x18\n" +
+	" \x01(\x0e2$.cerbos.policy.v1.CombiningAlgorithmR\x12combiningAlgorithm\x120\n" +
+	"\x14condition_cost_limit\x18\v \x01(\rR\x12conditionCostLimit\"\x86\x03\n" +
 	"\fResourceRule\x12-\n" +
 	"\aactions\x18\x01 \x03(\tB\x13\xbaH\x10\xc8\x01\x01\x92\x01\n" +
 	"\b\x01\x18\x01\"\x04r\x02\x10\x01R\aactions\x12>\n" +
@@ -3976,7 +4590,7 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\bresource\x128\n" +
 	"\rallow_actions\x18\x02 \x03(\tB\x13\xbaH\x10\xc8\x01\x01\x92\x01\n" +
 	"\b\x01\x18\x01\"\x04r\x02\x10\x01R\fallowActions\x129\n" +
-	"\tcondition\x18\x03 \x01(\v2\x1b.cerbos.policy.v1.ConditionR\tcondition\"\xb7\x03\n" +
+	"\tcondition\x18\x03 \x01(\v2\x1b.cerbos.policy.v1.ConditionR\tcondition\"\xc0\x04\n" +
 	"\x0fPrincipalPolicy\x127\n" +
 	"\tprincipal\x18\x01 \x01(\tB\x19\xbaH\x16\xc8\x01\x01r\x112\x0f^[^!*?\\[\\]{}]+$R\tprincipal\x12+\n" +
 	"\aversion\x18\x02 \x01(\tB\x11\xbaH\x0e\xc8\x01\x01r\t2\a^[\\w]+$R\aversion\x125\n" +
@@ -3984,7 +4598,9 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\x05scope\x18\x04 \x01(\tB*\xbaH'r%2#^([0-9a-zA-Z][\\w\\-]*(\\.[\\w\\-]*)*)*$R\x05scope\x129\n" +
 	"\tvariables\x18\x05 \x01(\v2\x1b.cerbos.policy.v1.VariablesR\tvariables\x12O\n" +
 	"\x11scope_permissions\x18\x06 \x01(\x0e2\".cerbos.policy.v1.ScopePermissionsR\x10scopePermissions\x129\n" +
-	"\tconstants\x18\a \x01(\v2\x1b.cerbos.policy.v1.ConstantsR\tconstants\"\x98\x03\n" +
+	"\tconstants\x18\a \x01(\v2\x1b.cerbos.policy.v1.ConstantsR\tconstants\x12U\n" +
+	"\x13combining_algorithm\x18\b \x01(\x0e2$.cerbos.policy.v1.CombiningAlgorithmR\x12combiningAlgorithm\x120\n" +
+	"\x14condition_cost_limit\x18\t \x01(\rR\x12conditionCostLimit\"\x98\x03\n" +
 	"\rPrincipalRule\x12&\n" +
 	"\bresource\x18\x01 \x01(\tB\n" +
 	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\bresource\x12M\n" +
@@ -4110,15 +4726,16 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\x16default_policy_version\x18\x04 \x01(\tR\x14defaultPolicyVersion\x1aR\n" +
 	"\fGlobalsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\x9d\t\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\xc0\n" +
+	"\n" +
 	"\tTestSuite\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04skip\x18\x03 \x01(\bR\x04skip\x12\x1f\n" +
 	"\vskip_reason\x18\x04 \x01(\tR\n" +
-	"skipReason\x12>\n" +
-	"\x05tests\x18\x05 \x03(\v2\x1b.cerbos.policy.v1.TestTableB\v\xbaH\b\xc8\x01\x01\x92\x01\x02\b\x01R\x05tests\x12K\n" +
+	"skipReason\x121\n" +
+	"\x05tests\x18\x05 \x03(\v2\x1b.cerbos.policy.v1.TestTableR\x05tests\x12K\n" +
 	"\n" +
 	"principals\x18\x06 \x03(\v2+.cerbos.policy.v1.TestSuite.PrincipalsEntryR\n" +
 	"principals\x12H\n" +
@@ -4128,7 +4745,9 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\vjson_schema\x18\n" +
 	" \x01(\tR\a$schema\x12[\n" +
 	"\x10principal_groups\x18\v \x03(\v20.cerbos.policy.v1.TestSuite.PrincipalGroupsEntryR\x0fprincipalGroups\x12X\n" +
-	"\x0fresource_groups\x18\f \x03(\v2/.cerbos.policy.v1.TestSuite.ResourceGroupsEntryR\x0eresourceGroups\x1aZ\n" +
+	"\x0fresource_groups\x18\f \x03(\v2/.cerbos.policy.v1.TestSuite.ResourceGroupsEntryR\x0eresourceGroups\x12>\n" +
+	"\n" +
+	"plan_tests\x18\r \x03(\v2\x1f.cerbos.policy.v1.PlanTestTableR\tplanTests\x1aZ\n" +
 	"\x0fPrincipalsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
 	"\x05value\x18\x02 \x01(\v2\x1b.cerbos.engine.v1.PrincipalR\x05value:\x028\x01\x1aX\n" +
@@ -4143,7 +4762,8 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2-.cerbos.policy.v1.TestFixtureGroup.PrincipalsR\x05value:\x028\x01\x1ao\n" +
 	"\x13ResourceGroupsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12B\n" +
-	"\x05value\x18\x02 \x01(\v2,.cerbos.policy.v1.TestFixtureGroup.ResourcesR\x05value:\x028\x01\"\x99\x11\n" +
+	"\x05value\x18\x02 \x01(\v2,.cerbos.policy.v1.TestFixtureGroup.ResourcesR\x05value:\x028\x01:n\xbaHk\x1ai\n" +
+	"\x10test_suite.tests\x12\"tests or planTests must be present\x1a1size(this.tests) > 0 || size(this.plan_tests) > 0\"\x99\x11\n" +
 	"\tTestTable\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\x04name\x12 \n" +
@@ -4187,7 +4807,41 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\x16expectation.principals\x129principal, principals, or principalGroups must be present\x1aTthis.principal != '' || size(this.principals) > 0 || size(this.principal_groups) > 0\x1a\x8f\x01\n" +
 	"%expectation.principal_nand_principals\x120principal and principals may not both be present\x1a4!(this.principal != '' && size(this.principals) > 0)\x1a\xa2\x01\n" +
 	"\x15expectation.resources\x126resource, resources, or resourceGroups must be present\x1aQthis.resource != '' || size(this.resources) > 0 || size(this.resource_groups) > 0\x1a\x89\x01\n" +
-	"#expectation.resource_nand_resources\x12.resource and resources may not both be present\x1a2!(this.resource != '' && size(this.resources) > 0)\"\xda\a\n" +
+	"#expectation.resource_nand_resources\x12.resource and resources may not both be present\x1a2!(this.resource != '' && size(this.resources) > 0)\"\xc9\n" +
+	"\n" +
+	"\rPlanTestTable\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04skip\x18\x03 \x01(\bR\x04skip\x12\x1f\n" +
+	"\vskip_reason\x18\x04 \x01(\tR\n" +
+	"skipReason\x12C\n" +
+	"\x05input\x18\x05 \x01(\v2%.cerbos.policy.v1.PlanTestTable.InputB\x06\xbaH\x03\xc8\x01\x01R\x05input\x12T\n" +
+	"\bexpected\x18\x06 \x03(\v2+.cerbos.policy.v1.PlanTestTable.ExpectationB\v\xbaH\b\xc8\x01\x01\x92\x01\x02\b\x01R\bexpected\x127\n" +
+	"\aoptions\x18\a \x01(\v2\x1d.cerbos.policy.v1.TestOptionsR\aoptions\x1a\xf8\x03\n" +
+	"\x05Input\x12.\n" +
+	"\n" +
+	"principals\x18\x01 \x03(\tB\x0e\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01R\n" +
+	"principals\x129\n" +
+	"\x10principal_groups\x18\x02 \x03(\tB\x0e\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01R\x0fprincipalGroups\x12Q\n" +
+	"\bresource\x18\x03 \x01(\v2-.cerbos.engine.v1.PlanResourcesInput.ResourceB\x06\xbaH\x03\xc8\x01\x01R\bresource\x12-\n" +
+	"\aactions\x18\x04 \x03(\tB\x13\xbaH\x10\xc8\x01\x01\x92\x01\n" +
+	"\b\x01\x18\x01\"\x04r\x02\x10\x01R\aactions\x12\x19\n" +
+	"\baux_data\x18\x05 \x01(\tR\aauxData\x12Y\n" +
+	"\x0efilter_options\x18\x06 \x01(\v22.cerbos.engine.v1.PlanResourcesInput.FilterOptionsR\rfilterOptions:\x8b\x01\xbaH\x87\x01\x1a\x84\x01\n" +
+	"\x15plan_input.principals\x12-principals or principalGroups must be present\x1a<size(this.principals) > 0 || size(this.principal_groups) > 0\x1a}\n" +
+	"\x0fPlanExpectation\x12L\n" +
+	"\x04kind\x18\x01 \x01(\x0e2*.cerbos.engine.v1.PlanResourcesFilter.KindB\f\xbaH\t\x82\x01\x06\x18\x01\x18\x02\x18\x03R\x04kind\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\x1a\xf2\x02\n" +
+	"\vExpectation\x12(\n" +
+	"\tprincipal\x18\x01 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\tprincipal\x12e\n" +
+	"\aactions\x18\x02 \x03(\v28.cerbos.policy.v1.PlanTestTable.Expectation.ActionsEntryB\x11\xbaH\x0e\xc8\x01\x01\x9a\x01\b\b\x01\"\x04r\x02\x10\x01R\aactions\x12,\n" +
+	"\tresources\x18\x03 \x03(\tB\x0e\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01R\tresources\x127\n" +
+	"\x0fresource_groups\x18\x04 \x03(\tB\x0e\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01R\x0eresourceGroups\x1ak\n" +
+	"\fActionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12E\n" +
+	"\x05value\x18\x02 \x01(\v2/.cerbos.policy.v1.PlanTestTable.PlanExpectationR\x05value:\x028\x01\"\xda\a\n" +
 	"\x04Test\x12;\n" +
 	"\x04name\x18\x01 \x01(\v2\x1f.cerbos.policy.v1.Test.TestNameB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
@@ -4215,7 +4869,7 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x0e2\x18.cerbos.effect.v1.EffectR\x05value:\x028\x01\x1ah\n" +
 	"\x14ExpectedOutputsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12:\n" +
-	"\x05value\x18\x02 \x01(\v2$.cerbos.policy.v1.Test.OutputEntriesR\x05value:\x028\x01\"\xad\x12\n" +
+	"\x05value\x18\x02 \x01(\v2$.cerbos.policy.v1.Test.OutputEntriesR\x05value:\x028\x01\"\xb1\x18\n" +
 	"\vTestResults\x12;\n" +
 	"\x06suites\x18\x01 \x03(\v2#.cerbos.policy.v1.TestResults.SuiteR\x06suites\x12?\n" +
 	"\asummary\x18\x02 \x01(\v2%.cerbos.policy.v1.TestResults.SummaryR\asummary\x1a[\n" +
@@ -4253,14 +4907,16 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\aactions\x18\x02 \x03(\v2$.cerbos.policy.v1.TestResults.ActionR\aactions\x1a]\n" +
 	"\x06Action\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12?\n" +
-	"\adetails\x18\x02 \x01(\v2%.cerbos.policy.v1.TestResults.DetailsR\adetails\x1a\xcf\x02\n" +
+	"\adetails\x18\x02 \x01(\v2%.cerbos.policy.v1.TestResults.DetailsR\adetails\x1a\xef\x03\n" +
 	"\aDetails\x12<\n" +
 	"\x06result\x18\x01 \x01(\x0e2$.cerbos.policy.v1.TestResults.ResultR\x06result\x12A\n" +
 	"\afailure\x18\x02 \x01(\v2%.cerbos.policy.v1.TestResults.FailureH\x00R\afailure\x12\x16\n" +
 	"\x05error\x18\x03 \x01(\tH\x00R\x05error\x12A\n" +
 	"\asuccess\x18\x05 \x01(\v2%.cerbos.policy.v1.TestResults.SuccessH\x00R\asuccess\x12!\n" +
 	"\vskip_reason\x18\x06 \x01(\tH\x00R\n" +
-	"skipReason\x12:\n" +
+	"skipReason\x12N\n" +
+	"\fplan_failure\x18\a \x01(\v2).cerbos.policy.v1.TestResults.PlanFailureH\x00R\vplanFailure\x12N\n" +
+	"\fplan_success\x18\b \x01(\v2).cerbos.policy.v1.TestResults.PlanSuccessH\x00R\vplanSuccess\x12:\n" +
 	"\fengine_trace\x18\x04 \x03(\v2\x17.cerbos.engine.v1.TraceR\vengineTraceB\t\n" +
 	"\aoutcome\x1a\x9c\x03\n" +
 	"\rOutputFailure\x12\x10\n" +
@@ -4281,7 +4937,21 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\aoutputs\x18\x03 \x03(\v2+.cerbos.policy.v1.TestResults.OutputFailureR\aoutputs\x1at\n" +
 	"\aSuccess\x120\n" +
 	"\x06effect\x18\x01 \x01(\x0e2\x18.cerbos.effect.v1.EffectR\x06effect\x127\n" +
-	"\aoutputs\x18\x02 \x03(\v2\x1d.cerbos.engine.v1.OutputEntryR\aoutputs\"n\n" +
+	"\aoutputs\x18\x02 \x03(\v2\x1d.cerbos.engine.v1.OutputEntryR\aoutputs\x1a\xf4\x03\n" +
+	"\vPlanFailure\x12O\n" +
+	"\rexpected_kind\x18\x01 \x01(\x0e2*.cerbos.engine.v1.PlanResourcesFilter.KindR\fexpectedKind\x12K\n" +
+	"\vactual_kind\x18\x02 \x01(\x0e2*.cerbos.engine.v1.PlanResourcesFilter.KindR\n" +
+	"actualKind\x12-\n" +
+	"\x12expected_condition\x18\x03 \x01(\tR\x11expectedCondition\x12)\n" +
+	"\x10actual_condition\x18\x04 \x01(\tR\x0factualCondition\x12X\n" +
+	"\tresources\x18\x05 \x03(\v2:.cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatchR\tresources\x1a\x92\x01\n" +
+	"\x10ResourceMismatch\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12%\n" +
+	"\x0efilter_allowed\x18\x02 \x01(\bR\rfilterAllowed\x12;\n" +
+	"\fcheck_effect\x18\x03 \x01(\x0e2\x18.cerbos.effect.v1.EffectR\vcheckEffect\x1ak\n" +
+	"\vPlanSuccess\x12>\n" +
+	"\x04kind\x18\x01 \x01(\x0e2*.cerbos.engine.v1.PlanResourcesFilter.KindR\x04kind\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\"n\n" +
 	"\x06Result\x12\x16\n" +
 	"\x12RESULT_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eRESULT_SKIPPED\x10\x01\x12\x11\n" +
@@ -4299,7 +4969,13 @@ const file_cerbos_policy_v1_policy_proto_rawDesc = "" +
 	"\x10ScopePermissions\x12!\n" +
 	"\x1dSCOPE_PERMISSIONS_UNSPECIFIED\x10\x00\x12%\n" +
 	"!SCOPE_PERMISSIONS_OVERRIDE_PARENT\x10\x01\x129\n" +
-	"5SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS\x10\x02Bo\n" +
+	"5SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS\x10\x02*\xe1\x01\n" +
+	"\x12CombiningAlgorithm\x12#\n" +
+	"\x1fCOMBINING_ALGORITHM_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"COMBINING_ALGORITHM_DENY_OVERRIDES\x10\x01\x12(\n" +
+	"$COMBINING_ALGORITHM_PERMIT_OVERRIDES\x10\x02\x12(\n" +
+	"$COMBINING_ALGORITHM_FIRST_APPLICABLE\x10\x03\x12*\n" +
+	"&COMBINING_ALGORITHM_DENY_UNLESS_PERMIT\x10\x04Bo\n" +
 	"\x18dev.cerbos.api.v1.policyZ<github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1;policyv1\xaa\x02\x14Cerbos.Api.V1.Policyb\x06proto3"
 
 var (
@@ -4314,223 +4990,253 @@ func file_cerbos_policy_v1_policy_proto_rawDescGZIP() []byte {
 	return file_cerbos_policy_v1_policy_proto_rawDescData
 }
 
-var file_cerbos_policy_v1_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_cerbos_policy_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_cerbos_policy_v1_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cerbos_policy_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_cerbos_policy_v1_policy_proto_goTypes = []any{
-	(Kind)(0),                            // 0: cerbos.policy.v1.Kind
-	(ScopePermissions)(0),                // 1: cerbos.policy.v1.ScopePermissions
-	(TestResults_Result)(0),              // 2: cerbos.policy.v1.TestResults.Result
-	(*Policy)(nil),                       // 3: cerbos.policy.v1.Policy
-	(*SourceAttributes)(nil),             // 4: cerbos.policy.v1.SourceAttributes
-	(*Metadata)(nil),                     // 5: cerbos.policy.v1.Metadata
-	(*ResourcePolicy)(nil),               // 6: cerbos.policy.v1.ResourcePolicy
-	(*ResourceRule)(nil),                 // 7: cerbos.policy.v1.ResourceRule
-	(*RolePolicy)(nil),                   // 8: cerbos.policy.v1.RolePolicy
-	(*RoleRule)(nil),                     // 9: cerbos.policy.v1.RoleRule
-	(*PrincipalPolicy)(nil),              // 10: cerbos.policy.v1.PrincipalPolicy
-	(*PrincipalRule)(nil),                // 11: cerbos.policy.v1.PrincipalRule
-	(*DerivedRoles)(nil),                 // 12: cerbos.policy.v1.DerivedRoles
-	(*RoleDef)(nil),                      // 13: cerbos.policy.v1.RoleDef
-	(*ExportConstants)(nil),              // 14: cerbos.policy.v1.ExportConstants
-	(*Constants)(nil),                    // 15: cerbos.policy.v1.Constants
-	(*ExportVariables)(nil),              // 16: cerbos.policy.v1.ExportVariables
-	(*Variables)(nil),                    // 17: cerbos.policy.v1.Variables
-	(*Condition)(nil),                    // 18: cerbos.policy.v1.Condition
-	(*Match)(nil),                        // 19: cerbos.policy.v1.Match
-	(*Output)(nil),                       // 20: cerbos.policy.v1.Output
-	(*Schemas)(nil),                      // 21: cerbos.policy.v1.Schemas
-	(*TestFixture)(nil),                  // 22: cerbos.policy.v1.TestFixture
-	(*TestFixtureGroup)(nil),             // 23: cerbos.policy.v1.TestFixtureGroup
-	(*TestOptions)(nil),                  // 24: cerbos.policy.v1.TestOptions
-	(*TestSuite)(nil),                    // 25: cerbos.policy.v1.TestSuite
-	(*TestTable)(nil),                    // 26: cerbos.policy.v1.TestTable
-	(*Test)(nil),                         // 27: cerbos.policy.v1.Test
-	(*TestResults)(nil),                  // 28: cerbos.policy.v1.TestResults
-	nil,                                  // 29: cerbos.policy.v1.Policy.VariablesEntry
-	nil,                                  // 30: cerbos.policy.v1.SourceAttributes.AttributesEntry
-	nil,                                  // 31: cerbos.policy.v1.Metadata.AnnotationsEntry
-	(*PrincipalRule_Action)(nil),         // 32: cerbos.policy.v1.PrincipalRule.Action
-	nil,                                  // 33: cerbos.policy.v1.ExportConstants.DefinitionsEntry
-	nil,                                  // 34: cerbos.policy.v1.Constants.LocalEntry
-	nil,                                  // 35: cerbos.policy.v1.ExportVariables.DefinitionsEntry
-	nil,                                  // 36: cerbos.policy.v1.Variables.LocalEntry
-	(*Match_ExprList)(nil),               // 37: cerbos.policy.v1.Match.ExprList
-	(*Output_When)(nil),                  // 38: cerbos.policy.v1.Output.When
-	(*Schemas_IgnoreWhen)(nil),           // 39: cerbos.policy.v1.Schemas.IgnoreWhen
-	(*Schemas_Schema)(nil),               // 40: cerbos.policy.v1.Schemas.Schema
-	(*TestFixture_Principals)(nil),       // 41: cerbos.policy.v1.TestFixture.Principals
-	(*TestFixture_Resources)(nil),        // 42: cerbos.policy.v1.TestFixture.Resources
-	(*TestFixture_AuxData)(nil),          // 43: cerbos.policy.v1.TestFixture.AuxData
-	nil,                                  // 44: cerbos.policy.v1.TestFixture.Principals.PrincipalsEntry
-	nil,                                  // 45: cerbos.policy.v1.TestFixture.Principals.PrincipalGroupsEntry
-	nil,                                  // 46: cerbos.policy.v1.TestFixture.Resources.ResourcesEntry
-	nil,                                  // 47: cerbos.policy.v1.TestFixture.Resources.ResourceGroupsEntry
-	nil,                                  // 48: cerbos.policy.v1.TestFixture.AuxData.AuxDataEntry
-	(*TestFixtureGroup_Principals)(nil),  // 49: cerbos.policy.v1.TestFixtureGroup.Principals
-	(*TestFixtureGroup_Resources)(nil),   // 50: cerbos.policy.v1.TestFixtureGroup.Resources
-	nil,                                  // 51: cerbos.policy.v1.TestOptions.GlobalsEntry
-	nil,                                  // 52: cerbos.policy.v1.TestSuite.PrincipalsEntry
-	nil,                                  // 53: cerbos.policy.v1.TestSuite.ResourcesEntry
-	nil,                                  // 54: cerbos.policy.v1.TestSuite.AuxDataEntry
-	nil,                                  // 55: cerbos.policy.v1.TestSuite.PrincipalGroupsEntry
-	nil,                                  // 56: cerbos.policy.v1.TestSuite.ResourceGroupsEntry
-	(*TestTable_Input)(nil),              // 57: cerbos.policy.v1.TestTable.Input
-	(*TestTable_OutputExpectations)(nil), // 58: cerbos.policy.v1.TestTable.OutputExpectations
-	(*TestTable_Expectation)(nil),        // 59: cerbos.policy.v1.TestTable.Expectation
-	nil,                                  // 60: cerbos.policy.v1.TestTable.Expectation.ActionsEntry
-	(*Test_TestName)(nil),                // 61: cerbos.policy.v1.Test.TestName
-	(*Test_OutputEntries)(nil),           // 62: cerbos.policy.v1.Test.OutputEntries
-	nil,                                  // 63: cerbos.policy.v1.Test.ExpectedEntry
-	nil,                                  // 64: cerbos.policy.v1.Test.ExpectedOutputsEntry
-	nil,                                  // 65: cerbos.policy.v1.Test.OutputEntries.EntriesEntry
-	(*TestResults_Tally)(nil),            // 66: cerbos.policy.v1.TestResults.Tally
-	(*TestResults_Summary)(nil),          // 67: cerbos.policy.v1.TestResults.Summary
-	(*TestResults_Suite)(nil),            // 68: cerbos.policy.v1.TestResults.Suite
-	(*TestResults_TestCase)(nil),         // 69: cerbos.policy.v1.TestResults.TestCase
-	(*TestResults_Principal)(nil),        // 70: cerbos.policy.v1.TestResults.Principal
-	(*TestResults_Resource)(nil),         // 71: cerbos.policy.v1.TestResults.Resource
-	(*TestResults_Action)(nil),           // 72: cerbos.policy.v1.TestResults.Action
-	(*TestResults_Details)(nil),          // 73: cerbos.policy.v1.TestResults.Details
-	(*TestResults_OutputFailure)(nil),    // 74: cerbos.policy.v1.TestResults.OutputFailure
-	(*TestResults_Failure)(nil),          // 75: cerbos.policy.v1.TestResults.Failure
-	(*TestResults_Success)(nil),          // 76: cerbos.policy.v1.TestResults.Success
-	(*TestResults_OutputFailure_MismatchedValue)(nil), // 77: cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue
-	(*TestResults_OutputFailure_MissingValue)(nil),    // 78: cerbos.policy.v1.TestResults.OutputFailure.MissingValue
-	(*wrapperspb.UInt64Value)(nil),                    // 79: google.protobuf.UInt64Value
-	(v1.Effect)(0),                                    // 80: cerbos.effect.v1.Effect
-	(*timestamppb.Timestamp)(nil),                     // 81: google.protobuf.Timestamp
-	(*v11.CheckInput)(nil),                            // 82: cerbos.engine.v1.CheckInput
-	(*structpb.Value)(nil),                            // 83: google.protobuf.Value
-	(*v11.Principal)(nil),                             // 84: cerbos.engine.v1.Principal
-	(*v11.Resource)(nil),                              // 85: cerbos.engine.v1.Resource
-	(*v11.AuxData)(nil),                               // 86: cerbos.engine.v1.AuxData
-	(*v11.OutputEntry)(nil),                           // 87: cerbos.engine.v1.OutputEntry
-	(*v11.Trace)(nil),                                 // 88: cerbos.engine.v1.Trace
+	(Kind)(0),                             // 0: cerbos.policy.v1.Kind
+	(ScopePermissions)(0),                 // 1: cerbos.policy.v1.ScopePermissions
+	(CombiningAlgorithm)(0),               // 2: cerbos.policy.v1.CombiningAlgorithm
+	(TestResults_Result)(0),               // 3: cerbos.policy.v1.TestResults.Result
+	(*Policy)(nil),                        // 4: cerbos.policy.v1.Policy
+	(*SourceAttributes)(nil),              // 5: cerbos.policy.v1.SourceAttributes
+	(*Metadata)(nil),                      // 6: cerbos.policy.v1.Metadata
+	(*ResourcePolicy)(nil),                // 7: cerbos.policy.v1.ResourcePolicy
+	(*ResourceRule)(nil),                  // 8: cerbos.policy.v1.ResourceRule
+	(*RolePolicy)(nil),                    // 9: cerbos.policy.v1.RolePolicy
+	(*RoleRule)(nil),                      // 10: cerbos.policy.v1.RoleRule
+	(*PrincipalPolicy)(nil),               // 11: cerbos.policy.v1.PrincipalPolicy
+	(*PrincipalRule)(nil),                 // 12: cerbos.policy.v1.PrincipalRule
+	(*DerivedRoles)(nil),                  // 13: cerbos.policy.v1.DerivedRoles
+	(*RoleDef)(nil),                       // 14: cerbos.policy.v1.RoleDef
+	(*ExportConstants)(nil),               // 15: cerbos.policy.v1.ExportConstants
+	(*Constants)(nil),                     // 16: cerbos.policy.v1.Constants
+	(*ExportVariables)(nil),               // 17: cerbos.policy.v1.ExportVariables
+	(*Variables)(nil),                     // 18: cerbos.policy.v1.Variables
+	(*Condition)(nil),                     // 19: cerbos.policy.v1.Condition
+	(*Match)(nil),                         // 20: cerbos.policy.v1.Match
+	(*Output)(nil),                        // 21: cerbos.policy.v1.Output
+	(*Schemas)(nil),                       // 22: cerbos.policy.v1.Schemas
+	(*TestFixture)(nil),                   // 23: cerbos.policy.v1.TestFixture
+	(*TestFixtureGroup)(nil),              // 24: cerbos.policy.v1.TestFixtureGroup
+	(*TestOptions)(nil),                   // 25: cerbos.policy.v1.TestOptions
+	(*TestSuite)(nil),                     // 26: cerbos.policy.v1.TestSuite
+	(*TestTable)(nil),                     // 27: cerbos.policy.v1.TestTable
+	(*PlanTestTable)(nil),                 // 28: cerbos.policy.v1.PlanTestTable
+	(*Test)(nil),                          // 29: cerbos.policy.v1.Test
+	(*TestResults)(nil),                   // 30: cerbos.policy.v1.TestResults
+	nil,                                   // 31: cerbos.policy.v1.Policy.VariablesEntry
+	nil,                                   // 32: cerbos.policy.v1.SourceAttributes.AttributesEntry
+	nil,                                   // 33: cerbos.policy.v1.Metadata.AnnotationsEntry
+	(*PrincipalRule_Action)(nil),          // 34: cerbos.policy.v1.PrincipalRule.Action
+	nil,                                   // 35: cerbos.policy.v1.ExportConstants.DefinitionsEntry
+	nil,                                   // 36: cerbos.policy.v1.Constants.LocalEntry
+	nil,                                   // 37: cerbos.policy.v1.ExportVariables.DefinitionsEntry
+	nil,                                   // 38: cerbos.policy.v1.Variables.LocalEntry
+	(*Match_ExprList)(nil),                // 39: cerbos.policy.v1.Match.ExprList
+	(*Output_When)(nil),                   // 40: cerbos.policy.v1.Output.When
+	(*Schemas_IgnoreWhen)(nil),            // 41: cerbos.policy.v1.Schemas.IgnoreWhen
+	(*Schemas_Schema)(nil),                // 42: cerbos.policy.v1.Schemas.Schema
+	(*TestFixture_Principals)(nil),        // 43: cerbos.policy.v1.TestFixture.Principals
+	(*TestFixture_Resources)(nil),         // 44: cerbos.policy.v1.TestFixture.Resources
+	(*TestFixture_AuxData)(nil),           // 45: cerbos.policy.v1.TestFixture.AuxData
+	nil,                                   // 46: cerbos.policy.v1.TestFixture.Principals.PrincipalsEntry
+	nil,                                   // 47: cerbos.policy.v1.TestFixture.Principals.PrincipalGroupsEntry
+	nil,                                   // 48: cerbos.policy.v1.TestFixture.Resources.ResourcesEntry
+	nil,                                   // 49: cerbos.policy.v1.TestFixture.Resources.ResourceGroupsEntry
+	nil,                                   // 50: cerbos.policy.v1.TestFixture.AuxData.AuxDataEntry
+	(*TestFixtureGroup_Principals)(nil),   // 51: cerbos.policy.v1.TestFixtureGroup.Principals
+	(*TestFixtureGroup_Resources)(nil),    // 52: cerbos.policy.v1.TestFixtureGroup.Resources
+	nil,                                   // 53: cerbos.policy.v1.TestOptions.GlobalsEntry
+	nil,                                   // 54: cerbos.policy.v1.TestSuite.PrincipalsEntry
+	nil,                                   // 55: cerbos.policy.v1.TestSuite.ResourcesEntry
+	nil,                                   // 56: cerbos.policy.v1.TestSuite.AuxDataEntry
+	nil,                                   // 57: cerbos.policy.v1.TestSuite.PrincipalGroupsEntry
+	nil,                                   // 58: cerbos.policy.v1.TestSuite.ResourceGroupsEntry
+	(*TestTable_Input)(nil),               // 59: cerbos.policy.v1.TestTable.Input
+	(*TestTable_OutputExpectations)(nil),  // 60: cerbos.policy.v1.TestTable.OutputExpectations
+	(*TestTable_Expectation)(nil),         // 61: cerbos.policy.v1.TestTable.Expectation
+	nil,                                   // 62: cerbos.policy.v1.TestTable.Expectation.ActionsEntry
+	(*PlanTestTable_Input)(nil),           // 63: cerbos.policy.v1.PlanTestTable.Input
+	(*PlanTestTable_PlanExpectation)(nil), // 64: cerbos.policy.v1.PlanTestTable.PlanExpectation
+	(*PlanTestTable_Expectation)(nil),     // 65: cerbos.policy.v1.PlanTestTable.Expectation
+	nil,                                   // 66: cerbos.policy.v1.PlanTestTable.Expectation.ActionsEntry
+	(*Test_TestName)(nil),                 // 67: cerbos.policy.v1.Test.TestName
+	(*Test_OutputEntries)(nil),            // 68: cerbos.policy.v1.Test.OutputEntries
+	nil,                                   // 69: cerbos.policy.v1.Test.ExpectedEntry
+	nil,                                   // 70: cerbos.policy.v1.Test.ExpectedOutputsEntry
+	nil,                                   // 71: cerbos.policy.v1.Test.OutputEntries.EntriesEntry
+	(*TestResults_Tally)(nil),             // 72: cerbos.policy.v1.TestResults.Tally
+	(*TestResults_Summary)(nil),           // 73: cerbos.policy.v1.TestResults.Summary
+	(*TestResults_Suite)(nil),             // 74: cerbos.policy.v1.TestResults.Suite
+	(*TestResults_TestCase)(nil),          // 75: cerbos.policy.v1.TestResults.TestCase
+	(*TestResults_Principal)(nil),         // 76: cerbos.policy.v1.TestResults.Principal
+	(*TestResults_Resource)(nil),          // 77: cerbos.policy.v1.TestResults.Resource
+	(*TestResults_Action)(nil),            // 78: cerbos.policy.v1.TestResults.Action
+	(*TestResults_Details)(nil),           // 79: cerbos.policy.v1.TestResults.Details
+	(*TestResults_OutputFailure)(nil),     // 80: cerbos.policy.v1.TestResults.OutputFailure
+	(*TestResults_Failure)(nil),           // 81: cerbos.policy.v1.TestResults.Failure
+	(*TestResults_Success)(nil),           // 82: cerbos.policy.v1.TestResults.Success
+	(*TestResults_PlanFailure)(nil),       // 83: cerbos.policy.v1.TestResults.PlanFailure
+	(*TestResults_PlanSuccess)(nil),       // 84: cerbos.policy.v1.TestResults.PlanSuccess
+	(*TestResults_OutputFailure_MismatchedValue)(nil), // 85: cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue
+	(*TestResults_OutputFailure_MissingValue)(nil),    // 86: cerbos.policy.v1.TestResults.OutputFailure.MissingValue
+	(*TestResults_PlanFailure_ResourceMismatch)(nil),  // 87: cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch
+	(*wrapperspb.UInt64Value)(nil),                    // 88: google.protobuf.UInt64Value
+	(v1.Effect)(0),                                    // 89: cerbos.effect.v1.Effect
+	(*timestamppb.Timestamp)(nil),                     // 90: google.protobuf.Timestamp
+	(*v11.CheckInput)(nil),                            // 91: cerbos.engine.v1.CheckInput
+	(*structpb.Value)(nil),                            // 92: google.protobuf.Value
+	(*v11.Principal)(nil),                             // 93: cerbos.engine.v1.Principal
+	(*v11.Resource)(nil),                              // 94: cerbos.engine.v1.Resource
+	(*v11.AuxData)(nil),                               // 95: cerbos.engine.v1.AuxData
+	(*v11.OutputEntry)(nil),                           // 96: cerbos.engine.v1.OutputEntry
+	(*v11.PlanResourcesInput_Resource)(nil),           // 97: cerbos.engine.v1.PlanResourcesInput.Resource
+	(*v11.PlanResourcesInput_FilterOptions)(nil),      // 98: cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	(v11.PlanResourcesFilter_Kind)(0),                 // 99: cerbos.engine.v1.PlanResourcesFilter.Kind
+	(*v11.Trace)(nil),                                 // 100: cerbos.engine.v1.Trace
 }
 var file_cerbos_policy_v1_policy_proto_depIdxs = []int32{
-	5,   // 0: cerbos.policy.v1.Policy.metadata:type_name -> cerbos.policy.v1.Metadata
-	6,   // 1: cerbos.policy.v1.Policy.resource_policy:type_name -> cerbos.policy.v1.ResourcePolicy
-	10,  // 2: cerbos.policy.v1.Policy.principal_policy:type_name -> cerbos.policy.v1.PrincipalPolicy
-	12,  // 3: cerbos.policy.v1.Policy.derived_roles:type_name -> cerbos.policy.v1.DerivedRoles
-	16,  // 4: cerbos.policy.v1.Policy.export_variables:type_name -> cerbos.policy.v1.ExportVariables
-	8,   // 5: cerbos.policy.v1.Policy.role_policy:type_name -> cerbos.policy.v1.RolePolicy
-	14,  // 6: cerbos.policy.v1.Policy.export_constants:type_name -> cerbos.policy.v1.ExportConstants
-	29,  // 7: cerbos.policy.v1.Policy.variables:type_name -> cerbos.policy.v1.Policy.VariablesEntry
-	30,  // 8: cerbos.policy.v1.SourceAttributes.attributes:type_name -> cerbos.policy.v1.SourceAttributes.AttributesEntry
-	31,  // 9: cerbos.policy.v1.Metadata.annotations:type_name -> cerbos.policy.v1.Metadata.AnnotationsEntry
-	79,  // 10: cerbos.policy.v1.Metadata.hash:type_name -> google.protobuf.UInt64Value
-	4,   // 11: cerbos.policy.v1.Metadata.source_attributes:type_name -> cerbos.policy.v1.SourceAttributes
-	7,   // 12: cerbos.policy.v1.ResourcePolicy.rules:type_name -> cerbos.policy.v1.ResourceRule
-	21,  // 13: cerbos.policy.v1.ResourcePolicy.schemas:type_name -> cerbos.policy.v1.Schemas
-	17,  // 14: cerbos.policy.v1.ResourcePolicy.variables:type_name -> cerbos.policy.v1.Variables
+	6,   // 0: cerbos.policy.v1.Policy.metadata:type_name -> cerbos.policy.v1.Metadata
+	7,   // 1: cerbos.policy.v1.Policy.resource_policy:type_name -> cerbos.policy.v1.ResourcePolicy
+	11,  // 2: cerbos.policy.v1.Policy.principal_policy:type_name -> cerbos.policy.v1.PrincipalPolicy
+	13,  // 3: cerbos.policy.v1.Policy.derived_roles:type_name -> cerbos.policy.v1.DerivedRoles
+	17,  // 4: cerbos.policy.v1.Policy.export_variables:type_name -> cerbos.policy.v1.ExportVariables
+	9,   // 5: cerbos.policy.v1.Policy.role_policy:type_name -> cerbos.policy.v1.RolePolicy
+	15,  // 6: cerbos.policy.v1.Policy.export_constants:type_name -> cerbos.policy.v1.ExportConstants
+	31,  // 7: cerbos.policy.v1.Policy.variables:type_name -> cerbos.policy.v1.Policy.VariablesEntry
+	32,  // 8: cerbos.policy.v1.SourceAttributes.attributes:type_name -> cerbos.policy.v1.SourceAttributes.AttributesEntry
+	33,  // 9: cerbos.policy.v1.Metadata.annotations:type_name -> cerbos.policy.v1.Metadata.AnnotationsEntry
+	88,  // 10: cerbos.policy.v1.Metadata.hash:type_name -> google.protobuf.UInt64Value
+	5,   // 11: cerbos.policy.v1.Metadata.source_attributes:type_name -> cerbos.policy.v1.SourceAttributes
+	8,   // 12: cerbos.policy.v1.ResourcePolicy.rules:type_name -> cerbos.policy.v1.ResourceRule
+	22,  // 13: cerbos.policy.v1.ResourcePolicy.schemas:type_name -> cerbos.policy.v1.Schemas
+	18,  // 14: cerbos.policy.v1.ResourcePolicy.variables:type_name -> cerbos.policy.v1.Variables
 	1,   // 15: cerbos.policy.v1.ResourcePolicy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
-	15,  // 16: cerbos.policy.v1.ResourcePolicy.constants:type_name -> cerbos.policy.v1.Constants
-	18,  // 17: cerbos.policy.v1.ResourceRule.condition:type_name -> cerbos.policy.v1.Condition
-	80,  // 18: cerbos.policy.v1.ResourceRule.effect:type_name -> cerbos.effect.v1.Effect
-	20,  // 19: cerbos.policy.v1.ResourceRule.output:type_name -> cerbos.policy.v1.Output
-	9,   // 20: cerbos.policy.v1.RolePolicy.rules:type_name -> cerbos.policy.v1.RoleRule
-	1,   // 21: cerbos.policy.v1.RolePolicy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
-	18,  // 22: cerbos.policy.v1.RoleRule.condition:type_name -> cerbos.policy.v1.Condition
-	11,  // 23: cerbos.policy.v1.PrincipalPolicy.rules:type_name -> cerbos.policy.v1.PrincipalRule
-	17,  // 24: cerbos.policy.v1.PrincipalPolicy.variables:type_name -> cerbos.policy.v1.Variables
-	1,   // 25: cerbos.policy.v1.PrincipalPolicy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
-	15,  // 26: cerbos.policy.v1.PrincipalPolicy.constants:type_name -> cerbos.policy.v1.Constants
-	32,  // 27: cerbos.policy.v1.PrincipalRule.actions:type_name -> cerbos.policy.v1.PrincipalRule.Action
-	13,  // 28: cerbos.policy.v1.DerivedRoles.definitions:type_name -> cerbos.policy.v1.RoleDef
-	17,  // 29: cerbos.policy.v1.DerivedRoles.variables:type_name -> cerbos.policy.v1.Variables
-	15,  // 30: cerbos.policy.v1.DerivedRoles.constants:type_name -> cerbos.policy.v1.Constants
-	18,  // 31: cerbos.policy.v1.RoleDef.condition:type_name -> cerbos.policy.v1.Condition
-	33,  // 32: cerbos.policy.v1.ExportConstants.definitions:type_name -> cerbos.policy.v1.ExportConstants.DefinitionsEntry
-	34,  // 33: cerbos.policy.v1.Constants.local:type_name -> cerbos.policy.v1.Constants.LocalEntry
-	35,  // 34: cerbos.policy.v1.ExportVariables.definitions:type_name -> cerbos.policy.v1.ExportVariables.DefinitionsEntry
-	36,  // 35: cerbos.policy.v1.Variables.local:type_name -> cerbos.policy.v1.Variables.LocalEntry
-	19,  // 36: cerbos.policy.v1.Condition.match:type_name -> cerbos.policy.v1.Match
-	37,  // 37: cerbos.policy.v1.Match.all:type_name -> cerbos.policy.v1.Match.ExprList
-	37,  // 38: cerbos.policy.v1.Match.any:type_name -> cerbos.policy.v1.Match.ExprList
-	37,  // 39: cerbos.policy.v1.Match.none:type_name -> cerbos.policy.v1.Match.ExprList
-	38,  // 40: cerbos.policy.v1.Output.when:type_name -> cerbos.policy.v1.Output.When
-	40,  // 41: cerbos.policy.v1.Schemas.principal_schema:type_name -> cerbos.policy.v1.Schemas.Schema
-	40,  // 42: cerbos.policy.v1.Schemas.resource_schema:type_name -> cerbos.policy.v1.Schemas.Schema
-	81,  // 43: cerbos.policy.v1.TestOptions.now:type_name -> google.protobuf.Timestamp
-	51,  // 44: cerbos.policy.v1.TestOptions.globals:type_name -> cerbos.policy.v1.TestOptions.GlobalsEntry
-	26,  // 45: cerbos.policy.v1.TestSuite.tests:type_name -> cerbos.policy.v1.TestTable
-	52,  // 46: cerbos.policy.v1.TestSuite.principals:type_name -> cerbos.policy.v1.TestSuite.PrincipalsEntry
-	53,  // 47: cerbos.policy.v1.TestSuite.resources:type_name -> cerbos.policy.v1.TestSuite.ResourcesEntry
-	54,  // 48: cerbos.policy.v1.TestSuite.aux_data:type_name -> cerbos.policy.v1.TestSuite.AuxDataEntry
-	24,  // 49: cerbos.policy.v1.TestSuite.options:type_name -> cerbos.policy.v1.TestOptions
-	55,  // 50: cerbos.policy.v1.TestSuite.principal_groups:type_name -> cerbos.policy.v1.TestSuite.PrincipalGroupsEntry
-	56,  // 51: cerbos.policy.v1.TestSuite.resource_groups:type_name -> cerbos.policy.v1.TestSuite.ResourceGroupsEntry
-	57,  // 52: cerbos.policy.v1.TestTable.input:type_name -> cerbos.policy.v1.TestTable.Input
-	59,  // 53: cerbos.policy.v1.TestTable.expected:type_name -> cerbos.policy.v1.TestTable.Expectation
-	24,  // 54: cerbos.policy.v1.TestTable.options:type_name -> cerbos.policy.v1.TestOptions
-	61,  // 55: cerbos.policy.v1.Test.name:type_name -> cerbos.policy.v1.Test.TestName
-	82,  // 56: cerbos.policy.v1.Test.input:type_name -> cerbos.engine.v1.CheckInput
-	63,  // 57: cerbos.policy.v1.Test.expected:type_name -> cerbos.policy.v1.Test.ExpectedEntry
-	24,  // 58: cerbos.policy.v1.Test.options:type_name -> cerbos.policy.v1.TestOptions
-	64,  // 59: cerbos.policy.v1.Test.expected_outputs:type_name -> cerbos.policy.v1.Test.ExpectedOutputsEntry
-	68,  // 60: cerbos.policy.v1.TestResults.suites:type_name -> cerbos.policy.v1.TestResults.Suite
-	67,  // 61: cerbos.policy.v1.TestResults.summary:type_name -> cerbos.policy.v1.TestResults.Summary
-	83,  // 62: cerbos.policy.v1.SourceAttributes.AttributesEntry.value:type_name -> google.protobuf.Value
-	18,  // 63: cerbos.policy.v1.PrincipalRule.Action.condition:type_name -> cerbos.policy.v1.Condition
-	80,  // 64: cerbos.policy.v1.PrincipalRule.Action.effect:type_name -> cerbos.effect.v1.Effect
-	20,  // 65: cerbos.policy.v1.PrincipalRule.Action.output:type_name -> cerbos.policy.v1.Output
-	83,  // 66: cerbos.policy.v1.ExportConstants.DefinitionsEntry.value:type_name -> google.protobuf.Value
-	83,  // 67: cerbos.policy.v1.Constants.LocalEntry.value:type_name -> google.protobuf.Value
-	19,  // 68: cerbos.policy.v1.Match.ExprList.of:type_name -> cerbos.policy.v1.Match
-	39,  // 69: cerbos.policy.v1.Schemas.Schema.ignore_when:type_name -> cerbos.policy.v1.Schemas.IgnoreWhen
-	44,  // 70: cerbos.policy.v1.TestFixture.Principals.principals:type_name -> cerbos.policy.v1.TestFixture.Principals.PrincipalsEntry
-	45,  // 71: cerbos.policy.v1.TestFixture.Principals.principal_groups:type_name -> cerbos.policy.v1.TestFixture.Principals.PrincipalGroupsEntry
-	46,  // 72: cerbos.policy.v1.TestFixture.Resources.resources:type_name -> cerbos.policy.v1.TestFixture.Resources.ResourcesEntry
-	47,  // 73: cerbos.policy.v1.TestFixture.Resources.resource_groups:type_name -> cerbos.policy.v1.TestFixture.Resources.ResourceGroupsEntry
-	48,  // 74: cerbos.policy.v1.TestFixture.AuxData.aux_data:type_name -> cerbos.policy.v1.TestFixture.AuxData.AuxDataEntry
-	84,  // 75: cerbos.policy.v1.TestFixture.Principals.PrincipalsEntry.value:type_name -> cerbos.engine.v1.Principal
-	49,  // 76: cerbos.policy.v1.TestFixture.Principals.PrincipalGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Principals
-	85,  // 77: cerbos.policy.v1.TestFixture.Resources.ResourcesEntry.value:type_name -> cerbos.engine.v1.Resource
-	50,  // 78: cerbos.policy.v1.TestFixture.Resources.ResourceGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Resources
-	86,  // 79: cerbos.policy.v1.TestFixture.AuxData.AuxDataEntry.value:type_name -> cerbos.engine.v1.AuxData
-	83,  // 80: cerbos.policy.v1.TestOptions.GlobalsEntry.value:type_name -> google.protobuf.Value
-	84,  // 81: cerbos.policy.v1.TestSuite.PrincipalsEntry.value:type_name -> cerbos.engine.v1.Principal
-	85,  // 82: cerbos.policy.v1.TestSuite.ResourcesEntry.value:type_name -> cerbos.engine.v1.Resource
-	86,  // 83: cerbos.policy.v1.TestSuite.AuxDataEntry.value:type_name -> cerbos.engine.v1.AuxData
-	49,  // 84: cerbos.policy.v1.TestSuite.PrincipalGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Principals
-	50,  // 85: cerbos.policy.v1.TestSuite.ResourceGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Resources
-	87,  // 86: cerbos.policy.v1.TestTable.OutputExpectations.expected:type_name -> cerbos.engine.v1.OutputEntry
-	60,  // 87: cerbos.policy.v1.TestTable.Expectation.actions:type_name -> cerbos.policy.v1.TestTable.Expectation.ActionsEntry
-	58,  // 88: cerbos.policy.v1.TestTable.Expectation.outputs:type_name -> cerbos.policy.v1.TestTable.OutputExpectations
-	80,  // 89: cerbos.policy.v1.TestTable.Expectation.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
-	65,  // 90: cerbos.policy.v1.Test.OutputEntries.entries:type_name -> cerbos.policy.v1.Test.OutputEntries.EntriesEntry
-	80,  // 91: cerbos.policy.v1.Test.ExpectedEntry.value:type_name -> cerbos.effect.v1.Effect
-	62,  // 92: cerbos.policy.v1.Test.ExpectedOutputsEntry.value:type_name -> cerbos.policy.v1.Test.OutputEntries
-	83,  // 93: cerbos.policy.v1.Test.OutputEntries.EntriesEntry.value:type_name -> google.protobuf.Value
-	2,   // 94: cerbos.policy.v1.TestResults.Tally.result:type_name -> cerbos.policy.v1.TestResults.Result
-	2,   // 95: cerbos.policy.v1.TestResults.Summary.overall_result:type_name -> cerbos.policy.v1.TestResults.Result
-	66,  // 96: cerbos.policy.v1.TestResults.Summary.result_counts:type_name -> cerbos.policy.v1.TestResults.Tally
-	70,  // 97: cerbos.policy.v1.TestResults.Suite.principals:type_name -> cerbos.policy.v1.TestResults.Principal
-	67,  // 98: cerbos.policy.v1.TestResults.Suite.summary:type_name -> cerbos.policy.v1.TestResults.Summary
-	69,  // 99: cerbos.policy.v1.TestResults.Suite.test_cases:type_name -> cerbos.policy.v1.TestResults.TestCase
-	70,  // 100: cerbos.policy.v1.TestResults.TestCase.principals:type_name -> cerbos.policy.v1.TestResults.Principal
-	71,  // 101: cerbos.policy.v1.TestResults.Principal.resources:type_name -> cerbos.policy.v1.TestResults.Resource
-	72,  // 102: cerbos.policy.v1.TestResults.Resource.actions:type_name -> cerbos.policy.v1.TestResults.Action
-	73,  // 103: cerbos.policy.v1.TestResults.Action.details:type_name -> cerbos.policy.v1.TestResults.Details
-	2,   // 104: cerbos.policy.v1.TestResults.Details.result:type_name -> cerbos.policy.v1.TestResults.Result
-	75,  // 105: cerbos.policy.v1.TestResults.Details.failure:type_name -> cerbos.policy.v1.TestResults.Failure
-	76,  // 106: cerbos.policy.v1.TestResults.Details.success:type_name -> cerbos.policy.v1.TestResults.Success
-	88,  // 107: cerbos.policy.v1.TestResults.Details.engine_trace:type_name -> cerbos.engine.v1.Trace
-	77,  // 108: cerbos.policy.v1.TestResults.OutputFailure.mismatched:type_name -> cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue
-	78,  // 109: cerbos.policy.v1.TestResults.OutputFailure.missing:type_name -> cerbos.policy.v1.TestResults.OutputFailure.MissingValue
-	80,  // 110: cerbos.policy.v1.TestResults.Failure.expected:type_name -> cerbos.effect.v1.Effect
-	80,  // 111: cerbos.policy.v1.TestResults.Failure.actual:type_name -> cerbos.effect.v1.Effect
-	74,  // 112: cerbos.policy.v1.TestResults.Failure.outputs:type_name -> cerbos.policy.v1.TestResults.OutputFailure
-	80,  // 113: cerbos.policy.v1.TestResults.Success.effect:type_name -> cerbos.effect.v1.Effect
-	87,  // 114: cerbos.policy.v1.TestResults.Success.outputs:type_name -> cerbos.engine.v1.OutputEntry
-	83,  // 115: cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue.expected:type_name -> google.protobuf.Value
-	83,  // 116: cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue.actual:type_name -> google.protobuf.Value
-	83,  // 117: cerbos.policy.v1.TestResults.OutputFailure.MissingValue.expected:type_name -> google.protobuf.Value
-	118, // [118:118] is the sub-list for method output_type
-	118, // [118:118] is the sub-list for method input_type
-	118, // [118:118] is the sub-list for extension type_name
-	118, // [118:118] is the sub-list for extension extendee
-	0,   // [0:118] is the sub-list for field type_name
+	16,  // 16: cerbos.policy.v1.ResourcePolicy.constants:type_name -> cerbos.policy.v1.Constants
+	2,   // 17: cerbos.policy.v1.ResourcePolicy.combining_algorithm:type_name -> cerbos.policy.v1.CombiningAlgorithm
+	19,  // 18: cerbos.policy.v1.ResourceRule.condition:type_name -> cerbos.policy.v1.Condition
+	89,  // 19: cerbos.policy.v1.ResourceRule.effect:type_name -> cerbos.effect.v1.Effect
+	21,  // 20: cerbos.policy.v1.ResourceRule.output:type_name -> cerbos.policy.v1.Output
+	10,  // 21: cerbos.policy.v1.RolePolicy.rules:type_name -> cerbos.policy.v1.RoleRule
+	1,   // 22: cerbos.policy.v1.RolePolicy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
+	19,  // 23: cerbos.policy.v1.RoleRule.condition:type_name -> cerbos.policy.v1.Condition
+	12,  // 24: cerbos.policy.v1.PrincipalPolicy.rules:type_name -> cerbos.policy.v1.PrincipalRule
+	18,  // 25: cerbos.policy.v1.PrincipalPolicy.variables:type_name -> cerbos.policy.v1.Variables
+	1,   // 26: cerbos.policy.v1.PrincipalPolicy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
+	16,  // 27: cerbos.policy.v1.PrincipalPolicy.constants:type_name -> cerbos.policy.v1.Constants
+	2,   // 28: cerbos.policy.v1.PrincipalPolicy.combining_algorithm:type_name -> cerbos.policy.v1.CombiningAlgorithm
+	34,  // 29: cerbos.policy.v1.PrincipalRule.actions:type_name -> cerbos.policy.v1.PrincipalRule.Action
+	14,  // 30: cerbos.policy.v1.DerivedRoles.definitions:type_name -> cerbos.policy.v1.RoleDef
+	18,  // 31: cerbos.policy.v1.DerivedRoles.variables:type_name -> cerbos.policy.v1.Variables
+	16,  // 32: cerbos.policy.v1.DerivedRoles.constants:type_name -> cerbos.policy.v1.Constants
+	19,  // 33: cerbos.policy.v1.RoleDef.condition:type_name -> cerbos.policy.v1.Condition
+	35,  // 34: cerbos.policy.v1.ExportConstants.definitions:type_name -> cerbos.policy.v1.ExportConstants.DefinitionsEntry
+	36,  // 35: cerbos.policy.v1.Constants.local:type_name -> cerbos.policy.v1.Constants.LocalEntry
+	37,  // 36: cerbos.policy.v1.ExportVariables.definitions:type_name -> cerbos.policy.v1.ExportVariables.DefinitionsEntry
+	38,  // 37: cerbos.policy.v1.Variables.local:type_name -> cerbos.policy.v1.Variables.LocalEntry
+	20,  // 38: cerbos.policy.v1.Condition.match:type_name -> cerbos.policy.v1.Match
+	39,  // 39: cerbos.policy.v1.Match.all:type_name -> cerbos.policy.v1.Match.ExprList
+	39,  // 40: cerbos.policy.v1.Match.any:type_name -> cerbos.policy.v1.Match.ExprList
+	39,  // 41: cerbos.policy.v1.Match.none:type_name -> cerbos.policy.v1.Match.ExprList
+	40,  // 42: cerbos.policy.v1.Output.when:type_name -> cerbos.policy.v1.Output.When
+	42,  // 43: cerbos.policy.v1.Schemas.principal_schema:type_name -> cerbos.policy.v1.Schemas.Schema
+	42,  // 44: cerbos.policy.v1.Schemas.resource_schema:type_name -> cerbos.policy.v1.Schemas.Schema
+	90,  // 45: cerbos.policy.v1.TestOptions.now:type_name -> google.protobuf.Timestamp
+	53,  // 46: cerbos.policy.v1.TestOptions.globals:type_name -> cerbos.policy.v1.TestOptions.GlobalsEntry
+	27,  // 47: cerbos.policy.v1.TestSuite.tests:type_name -> cerbos.policy.v1.TestTable
+	54,  // 48: cerbos.policy.v1.TestSuite.principals:type_name -> cerbos.policy.v1.TestSuite.PrincipalsEntry
+	55,  // 49: cerbos.policy.v1.TestSuite.resources:type_name -> cerbos.policy.v1.TestSuite.ResourcesEntry
+	56,  // 50: cerbos.policy.v1.TestSuite.aux_data:type_name -> cerbos.policy.v1.TestSuite.AuxDataEntry
+	25,  // 51: cerbos.policy.v1.TestSuite.options:type_name -> cerbos.policy.v1.TestOptions
+	57,  // 52: cerbos.policy.v1.TestSuite.principal_groups:type_name -> cerbos.policy.v1.TestSuite.PrincipalGroupsEntry
+	58,  // 53: cerbos.policy.v1.TestSuite.resource_groups:type_name -> cerbos.policy.v1.TestSuite.ResourceGroupsEntry
+	28,  // 54: cerbos.policy.v1.TestSuite.plan_tests:type_name -> cerbos.policy.v1.PlanTestTable
+	59,  // 55: cerbos.policy.v1.TestTable.input:type_name -> cerbos.policy.v1.TestTable.Input
+	61,  // 56: cerbos.policy.v1.TestTable.expected:type_name -> cerbos.policy.v1.TestTable.Expectation
+	25,  // 57: cerbos.policy.v1.TestTable.options:type_name -> cerbos.policy.v1.TestOptions
+	63,  // 58: cerbos.policy.v1.PlanTestTable.input:type_name -> cerbos.policy.v1.PlanTestTable.Input
+	65,  // 59: cerbos.policy.v1.PlanTestTable.expected:type_name -> cerbos.policy.v1.PlanTestTable.Expectation
+	25,  // 60: cerbos.policy.v1.PlanTestTable.options:type_name -> cerbos.policy.v1.TestOptions
+	67,  // 61: cerbos.policy.v1.Test.name:type_name -> cerbos.policy.v1.Test.TestName
+	91,  // 62: cerbos.policy.v1.Test.input:type_name -> cerbos.engine.v1.CheckInput
+	69,  // 63: cerbos.policy.v1.Test.expected:type_name -> cerbos.policy.v1.Test.ExpectedEntry
+	25,  // 64: cerbos.policy.v1.Test.options:type_name -> cerbos.policy.v1.TestOptions
+	70,  // 65: cerbos.policy.v1.Test.expected_outputs:type_name -> cerbos.policy.v1.Test.ExpectedOutputsEntry
+	74,  // 66: cerbos.policy.v1.TestResults.suites:type_name -> cerbos.policy.v1.TestResults.Suite
+	73,  // 67: cerbos.policy.v1.TestResults.summary:type_name -> cerbos.policy.v1.TestResults.Summary
+	92,  // 68: cerbos.policy.v1.SourceAttributes.AttributesEntry.value:type_name -> google.protobuf.Value
+	19,  // 69: cerbos.policy.v1.PrincipalRule.Action.condition:type_name -> cerbos.policy.v1.Condition
+	89,  // 70: cerbos.policy.v1.PrincipalRule.Action.effect:type_name -> cerbos.effect.v1.Effect
+	21,  // 71: cerbos.policy.v1.PrincipalRule.Action.output:type_name -> cerbos.policy.v1.Output
+	92,  // 72: cerbos.policy.v1.ExportConstants.DefinitionsEntry.value:type_name -> google.protobuf.Value
+	92,  // 73: cerbos.policy.v1.Constants.LocalEntry.value:type_name -> google.protobuf.Value
+	20,  // 74: cerbos.policy.v1.Match.ExprList.of:type_name -> cerbos.policy.v1.Match
+	41,  // 75: cerbos.policy.v1.Schemas.Schema.ignore_when:type_name -> cerbos.policy.v1.Schemas.IgnoreWhen
+	46,  // 76: cerbos.policy.v1.TestFixture.Principals.principals:type_name -> cerbos.policy.v1.TestFixture.Principals.PrincipalsEntry
+	47,  // 77: cerbos.policy.v1.TestFixture.Principals.principal_groups:type_name -> cerbos.policy.v1.TestFixture.Principals.PrincipalGroupsEntry
+	48,  // 78: cerbos.policy.v1.TestFixture.Resources.resources:type_name -> cerbos.policy.v1.TestFixture.Resources.ResourcesEntry
+	49,  // 79: cerbos.policy.v1.TestFixture.Resources.resource_groups:type_name -> cerbos.policy.v1.TestFixture.Resources.ResourceGroupsEntry
+	50,  // 80: cerbos.policy.v1.TestFixture.AuxData.aux_data:type_name -> cerbos.policy.v1.TestFixture.AuxData.AuxDataEntry
+	93,  // 81: cerbos.policy.v1.TestFixture.Principals.PrincipalsEntry.value:type_name -> cerbos.engine.v1.Principal
+	51,  // 82: cerbos.policy.v1.TestFixture.Principals.PrincipalGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Principals
+	94,  // 83: cerbos.policy.v1.TestFixture.Resources.ResourcesEntry.value:type_name -> cerbos.engine.v1.Resource
+	52,  // 84: cerbos.policy.v1.TestFixture.Resources.ResourceGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Resources
+	95,  // 85: cerbos.policy.v1.TestFixture.AuxData.AuxDataEntry.value:type_name -> cerbos.engine.v1.AuxData
+	92,  // 86: cerbos.policy.v1.TestOptions.GlobalsEntry.value:type_name -> google.protobuf.Value
+	93,  // 87: cerbos.policy.v1.TestSuite.PrincipalsEntry.value:type_name -> cerbos.engine.v1.Principal
+	94,  // 88: cerbos.policy.v1.TestSuite.ResourcesEntry.value:type_name -> cerbos.engine.v1.Resource
+	95,  // 89: cerbos.policy.v1.TestSuite.AuxDataEntry.value:type_name -> cerbos.engine.v1.AuxData
+	51,  // 90: cerbos.policy.v1.TestSuite.PrincipalGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Principals
+	52,  // 91: cerbos.policy.v1.TestSuite.ResourceGroupsEntry.value:type_name -> cerbos.policy.v1.TestFixtureGroup.Resources
+	96,  // 92: cerbos.policy.v1.TestTable.OutputExpectations.expected:type_name -> cerbos.engine.v1.OutputEntry
+	62,  // 93: cerbos.policy.v1.TestTable.Expectation.actions:type_name -> cerbos.policy.v1.TestTable.Expectation.ActionsEntry
+	60,  // 94: cerbos.policy.v1.TestTable.Expectation.outputs:type_name -> cerbos.policy.v1.TestTable.OutputExpectations
+	89,  // 95: cerbos.policy.v1.TestTable.Expectation.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
+	97,  // 96: cerbos.policy.v1.PlanTestTable.Input.resource:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
+	98,  // 97: cerbos.policy.v1.PlanTestTable.Input.filter_options:type_name -> cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	99,  // 98: cerbos.policy.v1.PlanTestTable.PlanExpectation.kind:type_name -> cerbos.engine.v1.PlanResourcesFilter.Kind
+	66,  // 99: cerbos.policy.v1.PlanTestTable.Expectation.actions:type_name -> cerbos.policy.v1.PlanTestTable.Expectation.ActionsEntry
+	64,  // 100: cerbos.policy.v1.PlanTestTable.Expectation.ActionsEntry.value:type_name -> cerbos.policy.v1.PlanTestTable.PlanExpectation
+	71,  // 101: cerbos.policy.v1.Test.OutputEntries.entries:type_name -> cerbos.policy.v1.Test.OutputEntries.EntriesEntry
+	89,  // 102: cerbos.policy.v1.Test.ExpectedEntry.value:type_name -> cerbos.effect.v1.Effect
+	68,  // 103: cerbos.policy.v1.Test.ExpectedOutputsEntry.value:type_name -> cerbos.policy.v1.Test.OutputEntries
+	92,  // 104: cerbos.policy.v1.Test.OutputEntries.EntriesEntry.value:type_name -> google.protobuf.Value
+	3,   // 105: cerbos.policy.v1.TestResults.Tally.result:type_name -> cerbos.policy.v1.TestResults.Result
+	3,   // 106: cerbos.policy.v1.TestResults.Summary.overall_result:type_name -> cerbos.policy.v1.TestResults.Result
+	72,  // 107: cerbos.policy.v1.TestResults.Summary.result_counts:type_name -> cerbos.policy.v1.TestResults.Tally
+	76,  // 108: cerbos.policy.v1.TestResults.Suite.principals:type_name -> cerbos.policy.v1.TestResults.Principal
+	73,  // 109: cerbos.policy.v1.TestResults.Suite.summary:type_name -> cerbos.policy.v1.TestResults.Summary
+	75,  // 110: cerbos.policy.v1.TestResults.Suite.test_cases:type_name -> cerbos.policy.v1.TestResults.TestCase
+	76,  // 111: cerbos.policy.v1.TestResults.TestCase.principals:type_name -> cerbos.policy.v1.TestResults.Principal
+	77,  // 112: cerbos.policy.v1.TestResults.Principal.resources:type_name -> cerbos.policy.v1.TestResults.Resource
+	78,  // 113: cerbos.policy.v1.TestResults.Resource.actions:type_name -> cerbos.policy.v1.TestResults.Action
+	79,  // 114: cerbos.policy.v1.TestResults.Action.details:type_name -> cerbos.policy.v1.TestResults.Details
+	3,   // 115: cerbos.policy.v1.TestResults.Details.result:type_name -> cerbos.policy.v1.TestResults.Result
+	81,  // 116: cerbos.policy.v1.TestResults.Details.failure:type_name -> cerbos.policy.v1.TestResults.Failure
+	82,  // 117: cerbos.policy.v1.TestResults.Details.success:type_name -> cerbos.policy.v1.TestResults.Success
+	83,  // 118: cerbos.policy.v1.TestResults.Details.plan_failure:type_name -> cerbos.policy.v1.TestResults.PlanFailure
+	84,  // 119: cerbos.policy.v1.TestResults.Details.plan_success:type_name -> cerbos.policy.v1.TestResults.PlanSuccess
+	100, // 120: cerbos.policy.v1.TestResults.Details.engine_trace:type_name -> cerbos.engine.v1.Trace
+	85,  // 121: cerbos.policy.v1.TestResults.OutputFailure.mismatched:type_name -> cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue
+	86,  // 122: cerbos.policy.v1.TestResults.OutputFailure.missing:type_name -> cerbos.policy.v1.TestResults.OutputFailure.MissingValue
+	89,  // 123: cerbos.policy.v1.TestResults.Failure.expected:type_name -> cerbos.effect.v1.Effect
+	89,  // 124: cerbos.policy.v1.TestResults.Failure.actual:type_name -> cerbos.effect.v1.Effect
+	80,  // 125: cerbos.policy.v1.TestResults.Failure.outputs:type_name -> cerbos.policy.v1.TestResults.OutputFailure
+	89,  // 126: cerbos.policy.v1.TestResults.Success.effect:type_name -> cerbos.effect.v1.Effect
+	96,  // 127: cerbos.policy.v1.TestResults.Success.outputs:type_name -> cerbos.engine.v1.OutputEntry
+	99,  // 128: cerbos.policy.v1.TestResults.PlanFailure.expected_kind:type_name -> cerbos.engine.v1.PlanResourcesFilter.Kind
+	99,  // 129: cerbos.policy.v1.TestResults.PlanFailure.actual_kind:type_name -> cerbos.engine.v1.PlanResourcesFilter.Kind
+	87,  // 130: cerbos.policy.v1.TestResults.PlanFailure.resources:type_name -> cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch
+	99,  // 131: cerbos.policy.v1.TestResults.PlanSuccess.kind:type_name -> cerbos.engine.v1.PlanResourcesFilter.Kind
+	92,  // 132: cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue.expected:type_name -> google.protobuf.Value
+	92,  // 133: cerbos.policy.v1.TestResults.OutputFailure.MismatchedValue.actual:type_name -> google.protobuf.Value
+	92,  // 134: cerbos.policy.v1.TestResults.OutputFailure.MissingValue.expected:type_name -> google.protobuf.Value
+	89,  // 135: cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.check_effect:type_name -> cerbos.effect.v1.Effect
+	136, // [136:136] is the sub-list for method output_type
+	136, // [136:136] is the sub-list for method input_type
+	136, // [136:136] is the sub-list for extension type_name
+	136, // [136:136] is the sub-list for extension extendee
+	0,   // [0:136] is the sub-list for field type_name
 }
 
 func init() { file_cerbos_policy_v1_policy_proto_init() }
@@ -4559,13 +5265,15 @@ func file_cerbos_policy_v1_policy_proto_init() {
 		(*Match_None)(nil),
 		(*Match_Expr)(nil),
 	}
-	file_cerbos_policy_v1_policy_proto_msgTypes[70].OneofWrappers = []any{
+	file_cerbos_policy_v1_policy_proto_msgTypes[75].OneofWrappers = []any{
 		(*TestResults_Details_Failure)(nil),
 		(*TestResults_Details_Error)(nil),
 		(*TestResults_Details_Success)(nil),
 		(*TestResults_Details_SkipReason)(nil),
+		(*TestResults_Details_PlanFailure)(nil),
+		(*TestResults_Details_PlanSuccess)(nil),
 	}
-	file_cerbos_policy_v1_policy_proto_msgTypes[71].OneofWrappers = []any{
+	file_cerbos_policy_v1_policy_proto_msgTypes[76].OneofWrappers = []any{
 		(*TestResults_OutputFailure_Mismatched)(nil),
 		(*TestResults_OutputFailure_Missing)(nil),
 	}
@@ -4574,8 +5282,8 @@ func file_cerbos_policy_v1_policy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cerbos_policy_v1_policy_proto_rawDesc), len(file_cerbos_policy_v1_policy_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   76,
+			NumEnums:      4,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -40,6 +40,45 @@ func cerbos_audit_v1_AuditTrail_hashpb_sum(m *v1.AuditTrail, hasher hash.Hash, i
 	}
 }
 
+func cerbos_audit_v1_DecisionLogEntry_CheckResourcesDivergence_hashpb_sum(m *v1.DecisionLogEntry_CheckResourcesDivergence, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.inputs"]; !ok {
+		if len(m.Inputs) > 0 {
+			for _, v := range m.Inputs {
+				if v != nil {
+					cerbos_engine_v1_CheckInput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.outputs"]; !ok {
+		if len(m.Outputs) > 0 {
+			for _, v := range m.Outputs {
+				if v != nil {
+					cerbos_engine_v1_CheckOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_outputs"]; !ok {
+		if len(m.ShadowOutputs) > 0 {
+			for _, v := range m.ShadowOutputs {
+				if v != nil {
+					cerbos_engine_v1_CheckOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetShadowError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetShadowError()), len(m.GetShadowError())))
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_policy_source"]; !ok {
+		if m.GetShadowPolicySource() != nil {
+			cerbos_audit_v1_PolicySource_hashpb_sum(m.GetShadowPolicySource(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *v1.DecisionLogEntry_CheckResources, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.inputs"]; !ok {
 		if len(m.Inputs) > 0 {
@@ -80,6 +119,24 @@ func cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(m *v1.DecisionLog
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
 		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.PlanResources.inputs"]; !ok {
+		if len(m.Inputs) > 0 {
+			for _, v := range m.Inputs {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesInput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.PlanResources.outputs"]; !ok {
+		if len(m.Outputs) > 0 {
+			for _, v := range m.Outputs {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *v1.DecisionLogEntry, hasher hash.Hash, ignore map[string]struct{}) {
@@ -130,6 +187,10 @@ func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *v1.DecisionLogEntry, hasher
 				if t.PlanResources != nil {
 					cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(t.PlanResources, hasher, ignore)
 				}
+			case *v1.DecisionLogEntry_CheckResourcesDivergence_:
+				if t.CheckResourcesDivergence != nil {
+					cerbos_audit_v1_DecisionLogEntry_CheckResourcesDivergence_hashpb_sum(t.CheckResourcesDivergence, hasher, ignore)
+				}
 			}
 		}
 	}
@@ -318,6 +379,17 @@ func cerbos_engine_v1_AuxData_hashpb_sum(m *v11.AuxData, hasher hash.Hash, ignor
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.AuxData.providers"]; !ok {
+		if len(m.Providers) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Providers)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Providers[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Providers[k], hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckInput_hashpb_sum(m *v11.CheckInput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -412,6 +484,133 @@ func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v11.CheckOutput, hasher hash.Has
 	}
 }
 
+func cerbos_engine_v1_Explanation_Action_hashpb_sum(m *v11.Explanation_Action, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetEffect())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicy()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicy()), len(m.GetPolicy())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRule()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRule()), len(m.GetRule())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.rules"]; !ok {
+		if len(m.Rules) > 0 {
+			for _, v := range m.Rules {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Rule_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Condition_hashpb_sum(m *v11.Explanation_Condition, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.op"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOp())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.satisfied"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSatisfied())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.expr"]; !ok {
+		if m.GetExpr() != nil {
+			cerbos_engine_v1_Explanation_Expr_hashpb_sum(m.GetExpr(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.conditions"]; !ok {
+		if len(m.Conditions) > 0 {
+			for _, v := range m.Conditions {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Condition_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Expr_hashpb_sum(m *v11.Explanation_Expr, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.expr"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetExpr()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetExpr()), len(m.GetExpr())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.value"]; !ok {
+		if m.GetValue() != nil {
+			google_protobuf_Value_hashpb_sum(m.GetValue(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.operands"]; !ok {
+		if len(m.Operands) > 0 {
+			for _, v := range m.Operands {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Expr_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Rule_hashpb_sum(m *v11.Explanation_Rule, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicy()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicy()), len(m.GetPolicy())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.name"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetName()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetName()), len(m.GetName())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetEffect())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.outcome"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOutcome())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.derived_role"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetDerivedRole()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetDerivedRole()), len(m.GetDerivedRole())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.condition"]; !ok {
+		if m.GetCondition() != nil {
+			cerbos_engine_v1_Explanation_Condition_hashpb_sum(m.GetCondition(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+}
+
+func cerbos_engine_v1_Explanation_hashpb_sum(m *v11.Explanation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Actions)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Actions[k] != nil {
+					cerbos_engine_v1_Explanation_Action_hashpb_sum(m.Actions[k], hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_engine_v1_OutputEntry_hashpb_sum(m *v11.OutputEntry, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.OutputEntry.src"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetSrc()))))
@@ -424,6 +623,17 @@ func cerbos_engine_v1_OutputEntry_hashpb_sum(m *v11.OutputEntry, hasher hash.Has
 	}
 }
 
+func cerbos_engine_v1_PlanPrincipalsInput_Principal_hashpb_sum(m *v11.PlanPrincipalsInput_Principal, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.Principal.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.Principal.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+}
+
 func cerbos_engine_v1_PlanResourcesFilter_Expression_Operand_hashpb_sum(m *v11.PlanResourcesFilter_Expression_Operand, hasher hash.Hash, ignore map[string]struct{}) {
 	if m.Node != nil {
 		if _, ok := ignore["cerbos.engine.v1.PlanResourcesFilter.Expression.Operand.node"]; !ok {
@@ -471,6 +681,15 @@ func cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m *v11.PlanResourcesFilter,
 	}
 }
 
+func cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m *v11.PlanResourcesInput_FilterOptions, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.simplify"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSimplify())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.normal_form"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetNormalForm())))
+	}
+}
+
 func cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m *v11.PlanResourcesInput_Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKind()))))
@@ -532,6 +751,11 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *v11.PlanResourcesInput, h
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v11.PlanResourcesOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -591,6 +815,11 @@ func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v11.PlanResourcesOutput,
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *v11.Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1128,6 +1357,12 @@ func cerbos_policy_v1_PrincipalPolicy_hashpb_sum(m *v12.PrincipalPolicy, hasher
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_PrincipalRule_Action_hashpb_sum(m *v12.PrincipalRule_Action, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1218,6 +1453,12 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v12.ResourcePolicy, hasher ha
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v12.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1449,6 +1690,14 @@ func cerbos_policy_v1_TestResults_Details_hashpb_sum(m *v12.TestResults_Details,
 			case *v12.TestResults_Details_SkipReason:
 				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(t.SkipReason))))
 				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(t.SkipReason), len(t.SkipReason)))
+			case *v12.TestResults_Details_PlanFailure:
+				if t.PlanFailure != nil {
+					cerbos_policy_v1_TestResults_PlanFailure_hashpb_sum(t.PlanFailure, hasher, ignore)
+				}
+			case *v12.TestResults_Details_PlanSuccess:
+				if t.PlanSuccess != nil {
+					cerbos_policy_v1_TestResults_PlanSuccess_hashpb_sum(t.PlanSuccess, hasher, ignore)
+				}
 			}
 		}
 	}
@@ -1523,6 +1772,55 @@ func cerbos_policy_v1_TestResults_OutputFailure_hashpb_sum(m *v12.TestResults_Ou
 	}
 }
 
+func cerbos_policy_v1_TestResults_PlanFailure_ResourceMismatch_hashpb_sum(m *v12.TestResults_PlanFailure_ResourceMismatch, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.resource"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResource()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResource()), len(m.GetResource())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.filter_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetFilterAllowed())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.check_effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCheckEffect())))
+	}
+}
+
+func cerbos_policy_v1_TestResults_PlanFailure_hashpb_sum(m *v12.TestResults_PlanFailure, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.expected_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetExpectedKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.actual_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetActualKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.expected_condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetExpectedCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetExpectedCondition()), len(m.GetExpectedCondition())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.actual_condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetActualCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetActualCondition()), len(m.GetActualCondition())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_policy_v1_TestResults_PlanFailure_ResourceMismatch_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_TestResults_PlanSuccess_hashpb_sum(m *v12.TestResults_PlanSuccess, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanSuccess.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanSuccess.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCondition()), len(m.GetCondition())))
+	}
+}
+
 func cerbos_policy_v1_TestResults_Principal_hashpb_sum(m *v12.TestResults_Principal, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.TestResults.Principal.name"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetName()))))
@@ -2428,6 +2726,11 @@ func cerbos_private_v1_QueryPlannerFilterTestCase_hashpb_sum(m *QueryPlannerFilt
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetWantString()))))
 		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetWantString()), len(m.GetWantString())))
 	}
+	if _, ok := ignore["cerbos.private.v1.QueryPlannerFilterTestCase.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_private_v1_QueryPlannerTestSuite_Test_hashpb_sum(m *QueryPlannerTestSuite_Test, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2543,6 +2846,19 @@ func cerbos_private_v1_ServerTestCase_AdminAddOrUpdateSchemaCall_hashpb_sum(m *S
 	}
 }
 
+func cerbos_private_v1_ServerTestCase_AdminCheckWithPoliciesCall_hashpb_sum(m *ServerTestCase_AdminCheckWithPoliciesCall, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.AdminCheckWithPoliciesCall.input"]; !ok {
+		if m.GetInput() != nil {
+			cerbos_request_v1_CheckWithPoliciesRequest_hashpb_sum(m.GetInput(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.AdminCheckWithPoliciesCall.want_response"]; !ok {
+		if m.GetWantResponse() != nil {
+			cerbos_response_v1_CheckWithPoliciesResponse_hashpb_sum(m.GetWantResponse(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_private_v1_ServerTestCase_CheckResourceBatchCall_hashpb_sum(m *ServerTestCase_CheckResourceBatchCall, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.private.v1.ServerTestCase.CheckResourceBatchCall.input"]; !ok {
 		if m.GetInput() != nil {
@@ -2582,6 +2898,32 @@ func cerbos_private_v1_ServerTestCase_CheckResourcesCall_hashpb_sum(m *ServerTes
 	}
 }
 
+func cerbos_private_v1_ServerTestCase_ExplainCheckCall_hashpb_sum(m *ServerTestCase_ExplainCheckCall, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.ExplainCheckCall.input"]; !ok {
+		if m.GetInput() != nil {
+			cerbos_request_v1_ExplainCheckRequest_hashpb_sum(m.GetInput(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.ExplainCheckCall.want_response"]; !ok {
+		if m.GetWantResponse() != nil {
+			cerbos_response_v1_ExplainCheckResponse_hashpb_sum(m.GetWantResponse(), hasher, ignore)
+		}
+	}
+}
+
+func cerbos_private_v1_ServerTestCase_PlanPrincipalsCall_hashpb_sum(m *ServerTestCase_PlanPrincipalsCall, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.PlanPrincipalsCall.input"]; !ok {
+		if m.GetInput() != nil {
+			cerbos_request_v1_PlanPrincipalsRequest_hashpb_sum(m.GetInput(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.PlanPrincipalsCall.want_response"]; !ok {
+		if m.GetWantResponse() != nil {
+			cerbos_response_v1_PlanPrincipalsResponse_hashpb_sum(m.GetWantResponse(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_private_v1_ServerTestCase_PlanResourcesCall_hashpb_sum(m *ServerTestCase_PlanResourcesCall, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.private.v1.ServerTestCase.PlanResourcesCall.input"]; !ok {
 		if m.GetInput() != nil {
@@ -2716,6 +3058,18 @@ func cerbos_private_v1_ServerTestCase_hashpb_sum(m *ServerTestCase, hasher hash.
 				if t.CheckResources != nil {
 					cerbos_private_v1_ServerTestCase_CheckResourcesCall_hashpb_sum(t.CheckResources, hasher, ignore)
 				}
+			case *ServerTestCase_ExplainCheck:
+				if t.ExplainCheck != nil {
+					cerbos_private_v1_ServerTestCase_ExplainCheckCall_hashpb_sum(t.ExplainCheck, hasher, ignore)
+				}
+			case *ServerTestCase_AdminCheckWithPolicies:
+				if t.AdminCheckWithPolicies != nil {
+					cerbos_private_v1_ServerTestCase_AdminCheckWithPoliciesCall_hashpb_sum(t.AdminCheckWithPolicies, hasher, ignore)
+				}
+			case *ServerTestCase_PlanPrincipals:
+				if t.PlanPrincipals != nil {
+					cerbos_private_v1_ServerTestCase_PlanPrincipalsCall_hashpb_sum(t.PlanPrincipals, hasher, ignore)
+				}
 			}
 		}
 	}
@@ -3195,6 +3549,70 @@ func cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m *v13.CheckResourcesReq
 			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesRequest.include_explanation"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetIncludeExplanation())))
+	}
+}
+
+func cerbos_request_v1_CheckWithPoliciesRequest_hashpb_sum(m *v13.CheckWithPoliciesRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.policies"]; !ok {
+		if len(m.Policies) > 0 {
+			for _, v := range m.Policies {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.principal"]; !ok {
+		if m.GetPrincipal() != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.GetPrincipal(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_request_v1_CheckResourcesRequest_ResourceEntry_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.aux_data"]; !ok {
+		if m.GetAuxData() != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
+		}
+	}
+}
+
+func cerbos_request_v1_ExplainCheckRequest_hashpb_sum(m *v13.ExplainCheckRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.principal"]; !ok {
+		if m.GetPrincipal() != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.GetPrincipal(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_request_v1_CheckResourcesRequest_ResourceEntry_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.aux_data"]; !ok {
+		if m.GetAuxData() != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_request_v1_File_hashpb_sum(m *v13.File, hasher hash.Hash, ignore map[string]struct{}) {
@@ -3208,6 +3626,126 @@ func cerbos_request_v1_File_hashpb_sum(m *v13.File, hasher hash.Hash, ignore map
 	}
 }
 
+func cerbos_request_v1_PlanPrincipalsRequest_hashpb_sum(m *v13.PlanPrincipalsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.resource"]; !ok {
+		if m.GetResource() != nil {
+			cerbos_engine_v1_Resource_hashpb_sum(m.GetResource(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.principal"]; !ok {
+		if m.GetPrincipal() != nil {
+			cerbos_engine_v1_PlanPrincipalsInput_Principal_hashpb_sum(m.GetPrincipal(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.aux_data"]; !ok {
+		if m.GetAuxData() != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.include_meta"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetIncludeMeta())))
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Document_hashpb_sum(m *v13.PlanResourcesRequest_Document, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Document.format"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetFormat())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Document.fields"]; !ok {
+		if len(m.Fields) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Fields)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.Fields[k]))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.Fields[k]), len(m.Fields[k])))
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JoinTable_hashpb_sum(m *v13.PlanResourcesRequest_Sql_Mapping_JoinTable, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.table"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetTable()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetTable()), len(m.GetTable())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.key"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKey()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetKey()), len(m.GetKey())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.reference"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetReference()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetReference()), len(m.GetReference())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.value"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetValue()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetValue()), len(m.GetValue())))
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JsonPath_hashpb_sum(m *v13.PlanResourcesRequest_Sql_Mapping_JsonPath, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JsonPath.column"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetColumn()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetColumn()), len(m.GetColumn())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JsonPath.path"]; !ok {
+		if len(m.Path) > 0 {
+			for _, v := range m.Path {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_hashpb_sum(m *v13.PlanResourcesRequest_Sql_Mapping, hasher hash.Hash, ignore map[string]struct{}) {
+	if m.Mapping != nil {
+		if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.mapping"]; !ok {
+			switch t := m.Mapping.(type) {
+			case *v13.PlanResourcesRequest_Sql_Mapping_Column:
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(t.Column))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(t.Column), len(t.Column)))
+			case *v13.PlanResourcesRequest_Sql_Mapping_Json:
+				if t.Json != nil {
+					cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JsonPath_hashpb_sum(t.Json, hasher, ignore)
+				}
+			case *v13.PlanResourcesRequest_Sql_Mapping_Join:
+				if t.Join != nil {
+					cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JoinTable_hashpb_sum(t.Join, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_hashpb_sum(m *v13.PlanResourcesRequest_Sql, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.dialect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetDialect())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.attributes"]; !ok {
+		if len(m.Attributes) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Attributes)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Attributes[k] != nil {
+					cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_hashpb_sum(m.Attributes[k], hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *v13.PlanResourcesRequest, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.request_id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
@@ -3243,6 +3781,30 @@ func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *v13.PlanResourcesReque
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.sql"]; !ok {
+		if m.GetSql() != nil {
+			cerbos_request_v1_PlanResourcesRequest_Sql_hashpb_sum(m.GetSql(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.document"]; !ok {
+		if m.GetDocument() != nil {
+			cerbos_request_v1_PlanResourcesRequest_Document_hashpb_sum(m.GetDocument(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_request_v1_PlaygroundEvaluateRequest_hashpb_sum(m *v13.PlaygroundEvaluateRequest, hasher hash.Hash, ignore map[string]struct{}) {
@@ -3613,6 +4175,11 @@ func cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m *v14.Che
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.explanation"]; !ok {
+		if m.GetExplanation() != nil {
+			cerbos_engine_v1_Explanation_hashpb_sum(m.GetExplanation(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m *v14.CheckResourcesResponse, hasher hash.Hash, ignore map[string]struct{}) {
@@ -3635,6 +4202,63 @@ func cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m *v14.CheckResourcesR
 	}
 }
 
+func cerbos_response_v1_CheckWithPoliciesResponse_Result_hashpb_sum(m *v14.CheckWithPoliciesResponse_Result, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.Result.current"]; !ok {
+		if m.GetCurrent() != nil {
+			cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m.GetCurrent(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.Result.candidate"]; !ok {
+		if m.GetCandidate() != nil {
+			cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m.GetCandidate(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.Result.changed_actions"]; !ok {
+		if len(m.ChangedActions) > 0 {
+			for _, v := range m.ChangedActions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_CheckWithPoliciesResponse_hashpb_sum(m *v14.CheckWithPoliciesResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.results"]; !ok {
+		if len(m.Results) > 0 {
+			for _, v := range m.Results {
+				if v != nil {
+					cerbos_response_v1_CheckWithPoliciesResponse_Result_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_ExplainCheckResponse_hashpb_sum(m *v14.ExplainCheckResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ExplainCheckResponse.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.ExplainCheckResponse.results"]; !ok {
+		if len(m.Results) > 0 {
+			for _, v := range m.Results {
+				if v != nil {
+					cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.ExplainCheckResponse.cerbos_call_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCerbosCallId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCerbosCallId()), len(m.GetCerbosCallId())))
+	}
+}
+
 func cerbos_response_v1_InspectPoliciesResponse_Attribute_hashpb_sum(m *v14.InspectPoliciesResponse_Attribute, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.InspectPoliciesResponse.Attribute.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetKind())))
@@ -3753,6 +4377,62 @@ func cerbos_response_v1_InspectPoliciesResponse_Variable_hashpb_sum(m *v14.Inspe
 	}
 }
 
+func cerbos_response_v1_PlanPrincipalsResponse_Meta_hashpb_sum(m *v14.PlanPrincipalsResponse_Meta, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.Meta.filter_debug"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetFilterDebug()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetFilterDebug()), len(m.GetFilterDebug())))
+	}
+}
+
+func cerbos_response_v1_PlanPrincipalsResponse_hashpb_sum(m *v14.PlanPrincipalsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.resource_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResourceKind()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResourceKind()), len(m.GetResourceKind())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.resource_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResourceId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResourceId()), len(m.GetResourceId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.filter"]; !ok {
+		if m.GetFilter() != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.GetFilter(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.meta"]; !ok {
+		if m.GetMeta() != nil {
+			cerbos_response_v1_PlanPrincipalsResponse_Meta_hashpb_sum(m.GetMeta(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.cerbos_call_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCerbosCallId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCerbosCallId()), len(m.GetCerbosCallId())))
+	}
+}
+
+func cerbos_response_v1_PlanResourcesResponse_Document_hashpb_sum(m *v14.PlanResourcesResponse_Document, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Document.query"]; !ok {
+		if m.GetQuery() != nil {
+			google_protobuf_Struct_hashpb_sum(m.GetQuery(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_response_v1_PlanResourcesResponse_Meta_hashpb_sum(m *v14.PlanResourcesResponse_Meta, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Meta.filter_debug"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetFilterDebug()))))
@@ -3774,6 +4454,61 @@ func cerbos_response_v1_PlanResourcesResponse_Meta_hashpb_sum(m *v14.PlanResourc
 	}
 }
 
+func cerbos_response_v1_PlanResourcesResponse_Result_hashpb_sum(m *v14.PlanResourcesResponse_Result, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.resource_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResourceKind()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResourceKind()), len(m.GetResourceKind())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.filter"]; !ok {
+		if m.GetFilter() != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.GetFilter(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.meta"]; !ok {
+		if m.GetMeta() != nil {
+			cerbos_response_v1_PlanResourcesResponse_Meta_hashpb_sum(m.GetMeta(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.validation_errors"]; !ok {
+		if len(m.ValidationErrors) > 0 {
+			for _, v := range m.ValidationErrors {
+				if v != nil {
+					cerbos_schema_v1_ValidationError_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
+}
+
+func cerbos_response_v1_PlanResourcesResponse_Sql_hashpb_sum(m *v14.PlanResourcesResponse_Sql, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Sql.where"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetWhere()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetWhere()), len(m.GetWhere())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Sql.args"]; !ok {
+		if len(m.Args) > 0 {
+			for _, v := range m.Args {
+				if v != nil {
+					google_protobuf_Value_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *v14.PlanResourcesResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.request_id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
@@ -3822,6 +4557,30 @@ func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *v14.PlanResourcesRes
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.sql"]; !ok {
+		if m.GetSql() != nil {
+			cerbos_response_v1_PlanResourcesResponse_Sql_hashpb_sum(m.GetSql(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.document"]; !ok {
+		if m.GetDocument() != nil {
+			cerbos_response_v1_PlanResourcesResponse_Document_hashpb_sum(m.GetDocument(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.results"]; !ok {
+		if len(m.Results) > 0 {
+			for _, v := range m.Results {
+				if v != nil {
+					cerbos_response_v1_PlanResourcesResponse_Result_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_response_v1_PlaygroundEvaluateResponse_EvalResultList_hashpb_sum(m *v14.PlaygroundEvaluateResponse_EvalResultList, hasher hash.Hash, ignore map[string]struct{}) {
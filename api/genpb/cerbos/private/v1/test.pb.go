@@ -251,6 +251,9 @@ type ServerTestCase struct {
 	//	*ServerTestCase_AdminAddOrUpdateSchema
 	//	*ServerTestCase_PlaygroundTest
 	//	*ServerTestCase_CheckResources
+	//	*ServerTestCase_ExplainCheck
+	//	*ServerTestCase_AdminCheckWithPolicies
+	//	*ServerTestCase_PlanPrincipals
 	CallKind      isServerTestCase_CallKind `protobuf_oneof:"call_kind"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -411,6 +414,33 @@ func (x *ServerTestCase) GetCheckResources() *ServerTestCase_CheckResourcesCall
 	return nil
 }
 
+func (x *ServerTestCase) GetExplainCheck() *ServerTestCase_ExplainCheckCall {
+	if x != nil {
+		if x, ok := x.CallKind.(*ServerTestCase_ExplainCheck); ok {
+			return x.ExplainCheck
+		}
+	}
+	return nil
+}
+
+func (x *ServerTestCase) GetAdminCheckWithPolicies() *ServerTestCase_AdminCheckWithPoliciesCall {
+	if x != nil {
+		if x, ok := x.CallKind.(*ServerTestCase_AdminCheckWithPolicies); ok {
+			return x.AdminCheckWithPolicies
+		}
+	}
+	return nil
+}
+
+func (x *ServerTestCase) GetPlanPrincipals() *ServerTestCase_PlanPrincipalsCall {
+	if x != nil {
+		if x, ok := x.CallKind.(*ServerTestCase_PlanPrincipals); ok {
+			return x.PlanPrincipals
+		}
+	}
+	return nil
+}
+
 type isServerTestCase_CallKind interface {
 	isServerTestCase_CallKind()
 }
@@ -455,6 +485,18 @@ type ServerTestCase_CheckResources struct {
 	CheckResources *ServerTestCase_CheckResourcesCall `protobuf:"bytes,14,opt,name=check_resources,json=checkResources,proto3,oneof"`
 }
 
+type ServerTestCase_ExplainCheck struct {
+	ExplainCheck *ServerTestCase_ExplainCheckCall `protobuf:"bytes,15,opt,name=explain_check,json=explainCheck,proto3,oneof"`
+}
+
+type ServerTestCase_AdminCheckWithPolicies struct {
+	AdminCheckWithPolicies *ServerTestCase_AdminCheckWithPoliciesCall `protobuf:"bytes,16,opt,name=admin_check_with_policies,json=adminCheckWithPolicies,proto3,oneof"`
+}
+
+type ServerTestCase_PlanPrincipals struct {
+	PlanPrincipals *ServerTestCase_PlanPrincipalsCall `protobuf:"bytes,17,opt,name=plan_principals,json=planPrincipals,proto3,oneof"`
+}
+
 func (*ServerTestCase_CheckResourceSet) isServerTestCase_CallKind() {}
 
 func (*ServerTestCase_CheckResourceBatch) isServerTestCase_CallKind() {}
@@ -475,6 +517,12 @@ func (*ServerTestCase_PlaygroundTest) isServerTestCase_CallKind() {}
 
 func (*ServerTestCase_CheckResources) isServerTestCase_CallKind() {}
 
+func (*ServerTestCase_ExplainCheck) isServerTestCase_CallKind() {}
+
+func (*ServerTestCase_AdminCheckWithPolicies) isServerTestCase_CallKind() {}
+
+func (*ServerTestCase_PlanPrincipals) isServerTestCase_CallKind() {}
+
 type IndexBuilderTestCase struct {
 	state                protoimpl.MessageState                  `protogen:"open.v1"`
 	Files                map[string]string                       `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -994,11 +1042,12 @@ func (x *VerifyTestSuiteRunGetTestsTestCase) GetWantErr() string {
 }
 
 type QueryPlannerFilterTestCase struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Description   string                   `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	Input         *v11.PlanResourcesFilter `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
-	WantFilter    *v11.PlanResourcesFilter `protobuf:"bytes,3,opt,name=want_filter,json=wantFilter,proto3" json:"want_filter,omitempty"`
-	WantString    string                   `protobuf:"bytes,4,opt,name=want_string,json=wantString,proto3" json:"want_string,omitempty"`
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Description   string                                `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Input         *v11.PlanResourcesFilter              `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	WantFilter    *v11.PlanResourcesFilter              `protobuf:"bytes,3,opt,name=want_filter,json=wantFilter,proto3" json:"want_filter,omitempty"`
+	WantString    string                                `protobuf:"bytes,4,opt,name=want_string,json=wantString,proto3" json:"want_string,omitempty"`
+	FilterOptions *v11.PlanResourcesInput_FilterOptions `protobuf:"bytes,5,opt,name=filter_options,json=filterOptions,proto3" json:"filter_options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1061,6 +1110,13 @@ func (x *QueryPlannerFilterTestCase) GetWantString() string {
 	return ""
 }
 
+func (x *QueryPlannerFilterTestCase) GetFilterOptions() *v11.PlanResourcesInput_FilterOptions {
+	if x != nil {
+		return x.FilterOptions
+	}
+	return nil
+}
+
 type VerifyTestCase struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
@@ -2323,6 +2379,110 @@ func (x *ServerTestCase_CheckResourcesCall) GetWantResponse() *v16.CheckResource
 	return nil
 }
 
+type ServerTestCase_PlanPrincipalsCall struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Input         *v17.PlanPrincipalsRequest  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	WantResponse  *v16.PlanPrincipalsResponse `protobuf:"bytes,2,opt,name=want_response,json=wantResponse,proto3" json:"want_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerTestCase_PlanPrincipalsCall) Reset() {
+	*x = ServerTestCase_PlanPrincipalsCall{}
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerTestCase_PlanPrincipalsCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerTestCase_PlanPrincipalsCall) ProtoMessage() {}
+
+func (x *ServerTestCase_PlanPrincipalsCall) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerTestCase_PlanPrincipalsCall.ProtoReflect.Descriptor instead.
+func (*ServerTestCase_PlanPrincipalsCall) Descriptor() ([]byte, []int) {
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *ServerTestCase_PlanPrincipalsCall) GetInput() *v17.PlanPrincipalsRequest {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ServerTestCase_PlanPrincipalsCall) GetWantResponse() *v16.PlanPrincipalsResponse {
+	if x != nil {
+		return x.WantResponse
+	}
+	return nil
+}
+
+type ServerTestCase_ExplainCheckCall struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Input         *v17.ExplainCheckRequest  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	WantResponse  *v16.ExplainCheckResponse `protobuf:"bytes,2,opt,name=want_response,json=wantResponse,proto3" json:"want_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerTestCase_ExplainCheckCall) Reset() {
+	*x = ServerTestCase_ExplainCheckCall{}
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerTestCase_ExplainCheckCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerTestCase_ExplainCheckCall) ProtoMessage() {}
+
+func (x *ServerTestCase_ExplainCheckCall) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerTestCase_ExplainCheckCall.ProtoReflect.Descriptor instead.
+func (*ServerTestCase_ExplainCheckCall) Descriptor() ([]byte, []int) {
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 5}
+}
+
+func (x *ServerTestCase_ExplainCheckCall) GetInput() *v17.ExplainCheckRequest {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ServerTestCase_ExplainCheckCall) GetWantResponse() *v16.ExplainCheckResponse {
+	if x != nil {
+		return x.WantResponse
+	}
+	return nil
+}
+
 type ServerTestCase_PlaygroundValidateCall struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	Input         *v17.PlaygroundValidateRequest  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
@@ -2333,7 +2493,7 @@ type ServerTestCase_PlaygroundValidateCall struct {
 
 func (x *ServerTestCase_PlaygroundValidateCall) Reset() {
 	*x = ServerTestCase_PlaygroundValidateCall{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[34]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2345,7 +2505,7 @@ func (x *ServerTestCase_PlaygroundValidateCall) String() string {
 func (*ServerTestCase_PlaygroundValidateCall) ProtoMessage() {}
 
 func (x *ServerTestCase_PlaygroundValidateCall) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[34]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2358,7 +2518,7 @@ func (x *ServerTestCase_PlaygroundValidateCall) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ServerTestCase_PlaygroundValidateCall.ProtoReflect.Descriptor instead.
 func (*ServerTestCase_PlaygroundValidateCall) Descriptor() ([]byte, []int) {
-	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 4}
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 6}
 }
 
 func (x *ServerTestCase_PlaygroundValidateCall) GetInput() *v17.PlaygroundValidateRequest {
@@ -2385,7 +2545,7 @@ type ServerTestCase_PlaygroundTestCall struct {
 
 func (x *ServerTestCase_PlaygroundTestCall) Reset() {
 	*x = ServerTestCase_PlaygroundTestCall{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[35]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2397,7 +2557,7 @@ func (x *ServerTestCase_PlaygroundTestCall) String() string {
 func (*ServerTestCase_PlaygroundTestCall) ProtoMessage() {}
 
 func (x *ServerTestCase_PlaygroundTestCall) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[35]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2410,7 +2570,7 @@ func (x *ServerTestCase_PlaygroundTestCall) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ServerTestCase_PlaygroundTestCall.ProtoReflect.Descriptor instead.
 func (*ServerTestCase_PlaygroundTestCall) Descriptor() ([]byte, []int) {
-	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 5}
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 7}
 }
 
 func (x *ServerTestCase_PlaygroundTestCall) GetInput() *v17.PlaygroundTestRequest {
@@ -2437,7 +2597,7 @@ type ServerTestCase_PlaygroundEvaluateCall struct {
 
 func (x *ServerTestCase_PlaygroundEvaluateCall) Reset() {
 	*x = ServerTestCase_PlaygroundEvaluateCall{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[36]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2449,7 +2609,7 @@ func (x *ServerTestCase_PlaygroundEvaluateCall) String() string {
 func (*ServerTestCase_PlaygroundEvaluateCall) ProtoMessage() {}
 
 func (x *ServerTestCase_PlaygroundEvaluateCall) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[36]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2462,7 +2622,7 @@ func (x *ServerTestCase_PlaygroundEvaluateCall) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ServerTestCase_PlaygroundEvaluateCall.ProtoReflect.Descriptor instead.
 func (*ServerTestCase_PlaygroundEvaluateCall) Descriptor() ([]byte, []int) {
-	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 6}
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 8}
 }
 
 func (x *ServerTestCase_PlaygroundEvaluateCall) GetInput() *v17.PlaygroundEvaluateRequest {
@@ -2489,7 +2649,7 @@ type ServerTestCase_PlaygroundProxyCall struct {
 
 func (x *ServerTestCase_PlaygroundProxyCall) Reset() {
 	*x = ServerTestCase_PlaygroundProxyCall{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[37]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2501,7 +2661,7 @@ func (x *ServerTestCase_PlaygroundProxyCall) String() string {
 func (*ServerTestCase_PlaygroundProxyCall) ProtoMessage() {}
 
 func (x *ServerTestCase_PlaygroundProxyCall) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[37]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2514,7 +2674,7 @@ func (x *ServerTestCase_PlaygroundProxyCall) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ServerTestCase_PlaygroundProxyCall.ProtoReflect.Descriptor instead.
 func (*ServerTestCase_PlaygroundProxyCall) Descriptor() ([]byte, []int) {
-	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 7}
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 9}
 }
 
 func (x *ServerTestCase_PlaygroundProxyCall) GetInput() *v17.PlaygroundProxyRequest {
@@ -2541,7 +2701,7 @@ type ServerTestCase_AdminAddOrUpdatePolicyCall struct {
 
 func (x *ServerTestCase_AdminAddOrUpdatePolicyCall) Reset() {
 	*x = ServerTestCase_AdminAddOrUpdatePolicyCall{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[38]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2553,7 +2713,7 @@ func (x *ServerTestCase_AdminAddOrUpdatePolicyCall) String() string {
 func (*ServerTestCase_AdminAddOrUpdatePolicyCall) ProtoMessage() {}
 
 func (x *ServerTestCase_AdminAddOrUpdatePolicyCall) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[38]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2566,7 +2726,7 @@ func (x *ServerTestCase_AdminAddOrUpdatePolicyCall) ProtoReflect() protoreflect.
 
 // Deprecated: Use ServerTestCase_AdminAddOrUpdatePolicyCall.ProtoReflect.Descriptor instead.
 func (*ServerTestCase_AdminAddOrUpdatePolicyCall) Descriptor() ([]byte, []int) {
-	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 8}
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 10}
 }
 
 func (x *ServerTestCase_AdminAddOrUpdatePolicyCall) GetInput() *v17.AddOrUpdatePolicyRequest {
@@ -2583,6 +2743,58 @@ func (x *ServerTestCase_AdminAddOrUpdatePolicyCall) GetWantResponse() *v16.AddOr
 	return nil
 }
 
+type ServerTestCase_AdminCheckWithPoliciesCall struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Input         *v17.CheckWithPoliciesRequest  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	WantResponse  *v16.CheckWithPoliciesResponse `protobuf:"bytes,2,opt,name=want_response,json=wantResponse,proto3" json:"want_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerTestCase_AdminCheckWithPoliciesCall) Reset() {
+	*x = ServerTestCase_AdminCheckWithPoliciesCall{}
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerTestCase_AdminCheckWithPoliciesCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerTestCase_AdminCheckWithPoliciesCall) ProtoMessage() {}
+
+func (x *ServerTestCase_AdminCheckWithPoliciesCall) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerTestCase_AdminCheckWithPoliciesCall.ProtoReflect.Descriptor instead.
+func (*ServerTestCase_AdminCheckWithPoliciesCall) Descriptor() ([]byte, []int) {
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 11}
+}
+
+func (x *ServerTestCase_AdminCheckWithPoliciesCall) GetInput() *v17.CheckWithPoliciesRequest {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ServerTestCase_AdminCheckWithPoliciesCall) GetWantResponse() *v16.CheckWithPoliciesResponse {
+	if x != nil {
+		return x.WantResponse
+	}
+	return nil
+}
+
 type ServerTestCase_AdminAddOrUpdateSchemaCall struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Input         *v17.AddOrUpdateSchemaRequest  `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
@@ -2593,7 +2805,7 @@ type ServerTestCase_AdminAddOrUpdateSchemaCall struct {
 
 func (x *ServerTestCase_AdminAddOrUpdateSchemaCall) Reset() {
 	*x = ServerTestCase_AdminAddOrUpdateSchemaCall{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[39]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2605,7 +2817,7 @@ func (x *ServerTestCase_AdminAddOrUpdateSchemaCall) String() string {
 func (*ServerTestCase_AdminAddOrUpdateSchemaCall) ProtoMessage() {}
 
 func (x *ServerTestCase_AdminAddOrUpdateSchemaCall) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[39]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,7 +2830,7 @@ func (x *ServerTestCase_AdminAddOrUpdateSchemaCall) ProtoReflect() protoreflect.
 
 // Deprecated: Use ServerTestCase_AdminAddOrUpdateSchemaCall.ProtoReflect.Descriptor instead.
 func (*ServerTestCase_AdminAddOrUpdateSchemaCall) Descriptor() ([]byte, []int) {
-	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 9}
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 12}
 }
 
 func (x *ServerTestCase_AdminAddOrUpdateSchemaCall) GetInput() *v17.AddOrUpdateSchemaRequest {
@@ -2645,7 +2857,7 @@ type ServerTestCase_Status struct {
 
 func (x *ServerTestCase_Status) Reset() {
 	*x = ServerTestCase_Status{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[40]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2657,7 +2869,7 @@ func (x *ServerTestCase_Status) String() string {
 func (*ServerTestCase_Status) ProtoMessage() {}
 
 func (x *ServerTestCase_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[40]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,7 +2882,7 @@ func (x *ServerTestCase_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerTestCase_Status.ProtoReflect.Descriptor instead.
 func (*ServerTestCase_Status) Descriptor() ([]byte, []int) {
-	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 10}
+	return file_cerbos_private_v1_test_proto_rawDescGZIP(), []int{3, 13}
 }
 
 func (x *ServerTestCase_Status) GetHttpStatusCode() uint32 {
@@ -2698,7 +2910,7 @@ type IndexBuilderTestCase_CompilationUnit struct {
 
 func (x *IndexBuilderTestCase_CompilationUnit) Reset() {
 	*x = IndexBuilderTestCase_CompilationUnit{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[41]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2710,7 +2922,7 @@ func (x *IndexBuilderTestCase_CompilationUnit) String() string {
 func (*IndexBuilderTestCase_CompilationUnit) ProtoMessage() {}
 
 func (x *IndexBuilderTestCase_CompilationUnit) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[41]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2759,7 +2971,7 @@ type CompileTestCase_Variables struct {
 
 func (x *CompileTestCase_Variables) Reset() {
 	*x = CompileTestCase_Variables{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[43]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2771,7 +2983,7 @@ func (x *CompileTestCase_Variables) String() string {
 func (*CompileTestCase_Variables) ProtoMessage() {}
 
 func (x *CompileTestCase_Variables) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[43]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2826,7 +3038,7 @@ type CompileTestCase_Variables_DerivedRole struct {
 
 func (x *CompileTestCase_Variables_DerivedRole) Reset() {
 	*x = CompileTestCase_Variables_DerivedRole{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[44]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2838,7 +3050,7 @@ func (x *CompileTestCase_Variables_DerivedRole) String() string {
 func (*CompileTestCase_Variables_DerivedRole) ProtoMessage() {}
 
 func (x *CompileTestCase_Variables_DerivedRole) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[44]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2888,7 +3100,7 @@ type QueryPlannerTestSuite_Test struct {
 
 func (x *QueryPlannerTestSuite_Test) Reset() {
 	*x = QueryPlannerTestSuite_Test{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[46]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2900,7 +3112,7 @@ func (x *QueryPlannerTestSuite_Test) String() string {
 func (*QueryPlannerTestSuite_Test) ProtoMessage() {}
 
 func (x *QueryPlannerTestSuite_Test) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[46]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2962,7 +3174,7 @@ type VerifyTestCase_Config struct {
 
 func (x *VerifyTestCase_Config) Reset() {
 	*x = VerifyTestCase_Config{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[47]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2974,7 +3186,7 @@ func (x *VerifyTestCase_Config) String() string {
 func (*VerifyTestCase_Config) ProtoMessage() {}
 
 func (x *VerifyTestCase_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[47]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3021,7 +3233,7 @@ type ProtoYamlTestCase_Want struct {
 
 func (x *ProtoYamlTestCase_Want) Reset() {
 	*x = ProtoYamlTestCase_Want{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[48]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3033,7 +3245,7 @@ func (x *ProtoYamlTestCase_Want) String() string {
 func (*ProtoYamlTestCase_Want) ProtoMessage() {}
 
 func (x *ProtoYamlTestCase_Want) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[48]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3073,7 +3285,7 @@ type WellKnownTypes_Nested struct {
 
 func (x *WellKnownTypes_Nested) Reset() {
 	*x = WellKnownTypes_Nested{}
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[49]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3085,7 +3297,7 @@ func (x *WellKnownTypes_Nested) String() string {
 func (*WellKnownTypes_Nested) ProtoMessage() {}
 
 func (x *WellKnownTypes_Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_private_v1_test_proto_msgTypes[49]
+	mi := &file_cerbos_private_v1_test_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3178,7 +3390,7 @@ const file_cerbos_private_v1_test_proto_rawDesc = "" +
 	"\fwant_outputs\x18\x03 \x03(\v2\x1d.cerbos.engine.v1.CheckOutputR\vwantOutputs\x12\x1d\n" +
 	"\n" +
 	"want_error\x18\x04 \x01(\bR\twantError\x12O\n" +
-	"\x12want_decision_logs\x18\x05 \x03(\v2!.cerbos.audit.v1.DecisionLogEntryR\x10wantDecisionLogs\"\x9d\x18\n" +
+	"\x12want_decision_logs\x18\x05 \x03(\v2!.cerbos.audit.v1.DecisionLogEntryR\x10wantDecisionLogs\"\xd4\x1e\n" +
 	"\x0eServerTestCase\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
@@ -3196,7 +3408,10 @@ const file_cerbos_private_v1_test_proto_rawDesc = "" +
 	"\x0eplan_resources\x18\v \x01(\v23.cerbos.private.v1.ServerTestCase.PlanResourcesCallH\x00R\rplanResources\x12z\n" +
 	"\x1aadmin_add_or_update_schema\x18\f \x01(\v2<.cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCallH\x00R\x16adminAddOrUpdateSchema\x12_\n" +
 	"\x0fplayground_test\x18\r \x01(\v24.cerbos.private.v1.ServerTestCase.PlaygroundTestCallH\x00R\x0eplaygroundTest\x12_\n" +
-	"\x0fcheck_resources\x18\x0e \x01(\v24.cerbos.private.v1.ServerTestCase.CheckResourcesCallH\x00R\x0echeckResources\x1a\xa2\x01\n" +
+	"\x0fcheck_resources\x18\x0e \x01(\v24.cerbos.private.v1.ServerTestCase.CheckResourcesCallH\x00R\x0echeckResources\x12Y\n" +
+	"\rexplain_check\x18\x0f \x01(\v22.cerbos.private.v1.ServerTestCase.ExplainCheckCallH\x00R\fexplainCheck\x12y\n" +
+	"\x19admin_check_with_policies\x18\x10 \x01(\v2<.cerbos.private.v1.ServerTestCase.AdminCheckWithPoliciesCallH\x00R\x16adminCheckWithPolicies\x12_\n" +
+	"\x0fplan_principals\x18\x11 \x01(\v24.cerbos.private.v1.ServerTestCase.PlanPrincipalsCallH\x00R\x0eplanPrincipals\x1a\xa2\x01\n" +
 	"\x11PlanResourcesCall\x12=\n" +
 	"\x05input\x18\x01 \x01(\v2'.cerbos.request.v1.PlanResourcesRequestR\x05input\x12N\n" +
 	"\rwant_response\x18\x02 \x01(\v2).cerbos.response.v1.PlanResourcesResponseR\fwantResponse\x1a\xab\x01\n" +
@@ -3208,7 +3423,13 @@ const file_cerbos_private_v1_test_proto_rawDesc = "" +
 	"\rwant_response\x18\x02 \x01(\v2..cerbos.response.v1.CheckResourceBatchResponseR\fwantResponse\x1a\xa5\x01\n" +
 	"\x12CheckResourcesCall\x12>\n" +
 	"\x05input\x18\x01 \x01(\v2(.cerbos.request.v1.CheckResourcesRequestR\x05input\x12O\n" +
-	"\rwant_response\x18\x02 \x01(\v2*.cerbos.response.v1.CheckResourcesResponseR\fwantResponse\x1a\xb1\x01\n" +
+	"\rwant_response\x18\x02 \x01(\v2*.cerbos.response.v1.CheckResourcesResponseR\fwantResponse\x1a\xa5\x01\n" +
+	"\x12PlanPrincipalsCall\x12>\n" +
+	"\x05input\x18\x01 \x01(\v2(.cerbos.request.v1.PlanPrincipalsRequestR\x05input\x12O\n" +
+	"\rwant_response\x18\x02 \x01(\v2*.cerbos.response.v1.PlanPrincipalsResponseR\fwantResponse\x1a\x9f\x01\n" +
+	"\x10ExplainCheckCall\x12<\n" +
+	"\x05input\x18\x01 \x01(\v2&.cerbos.request.v1.ExplainCheckRequestR\x05input\x12M\n" +
+	"\rwant_response\x18\x02 \x01(\v2(.cerbos.response.v1.ExplainCheckResponseR\fwantResponse\x1a\xb1\x01\n" +
 	"\x16PlaygroundValidateCall\x12B\n" +
 	"\x05input\x18\x01 \x01(\v2,.cerbos.request.v1.PlaygroundValidateRequestR\x05input\x12S\n" +
 	"\rwant_response\x18\x02 \x01(\v2..cerbos.response.v1.PlaygroundValidateResponseR\fwantResponse\x1a\xa5\x01\n" +
@@ -3224,6 +3445,9 @@ const file_cerbos_private_v1_test_proto_rawDesc = "" +
 	"\x1aAdminAddOrUpdatePolicyCall\x12A\n" +
 	"\x05input\x18\x01 \x01(\v2+.cerbos.request.v1.AddOrUpdatePolicyRequestR\x05input\x12R\n" +
 	"\rwant_response\x18\x02 \x01(\v2-.cerbos.response.v1.AddOrUpdatePolicyResponseR\fwantResponse\x1a\xb3\x01\n" +
+	"\x1aAdminCheckWithPoliciesCall\x12A\n" +
+	"\x05input\x18\x01 \x01(\v2+.cerbos.request.v1.CheckWithPoliciesRequestR\x05input\x12R\n" +
+	"\rwant_response\x18\x02 \x01(\v2-.cerbos.response.v1.CheckWithPoliciesResponseR\fwantResponse\x1a\xb3\x01\n" +
 	"\x1aAdminAddOrUpdateSchemaCall\x12A\n" +
 	"\x05input\x18\x01 \x01(\v2+.cerbos.request.v1.AddOrUpdateSchemaRequestR\x05input\x12R\n" +
 	"\rwant_response\x18\x02 \x01(\v2-.cerbos.response.v1.AddOrUpdateSchemaResponseR\fwantResponse\x1a\\\n" +
@@ -3296,14 +3520,15 @@ const file_cerbos_private_v1_test_proto_rawDesc = "" +
 	"\x05table\x18\x01 \x01(\v2\x1b.cerbos.policy.v1.TestTableR\x05table\x125\n" +
 	"\n" +
 	"want_tests\x18\x02 \x03(\v2\x16.cerbos.policy.v1.TestR\twantTests\x12\x19\n" +
-	"\bwant_err\x18\x03 \x01(\tR\awantErr\"\xe4\x01\n" +
+	"\bwant_err\x18\x03 \x01(\tR\awantErr\"\xbf\x02\n" +
 	"\x1aQueryPlannerFilterTestCase\x12 \n" +
 	"\vdescription\x18\x01 \x01(\tR\vdescription\x12;\n" +
 	"\x05input\x18\x02 \x01(\v2%.cerbos.engine.v1.PlanResourcesFilterR\x05input\x12F\n" +
 	"\vwant_filter\x18\x03 \x01(\v2%.cerbos.engine.v1.PlanResourcesFilterR\n" +
 	"wantFilter\x12\x1f\n" +
 	"\vwant_string\x18\x04 \x01(\tR\n" +
-	"wantString\"\xdf\x02\n" +
+	"wantString\x12Y\n" +
+	"\x0efilter_options\x18\x05 \x01(\v22.cerbos.engine.v1.PlanResourcesInput.FilterOptionsR\rfilterOptions\"\xdf\x02\n" +
 	"\x0eVerifyTestCase\x12 \n" +
 	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x19\n" +
 	"\bwant_err\x18\x02 \x01(\bR\awantErr\x12@\n" +
@@ -3371,7 +3596,7 @@ func file_cerbos_private_v1_test_proto_rawDescGZIP() []byte {
 	return file_cerbos_private_v1_test_proto_rawDescData
 }
 
-var file_cerbos_private_v1_test_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_cerbos_private_v1_test_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_cerbos_private_v1_test_proto_goTypes = []any{
 	(*InspectTestCase)(nil),                       // 0: cerbos.private.v1.InspectTestCase
 	(*BlobClonerTestCase)(nil),                    // 1: cerbos.private.v1.BlobClonerTestCase
@@ -3407,198 +3632,218 @@ var file_cerbos_private_v1_test_proto_goTypes = []any{
 	(*ServerTestCase_CheckResourceSetCall)(nil),       // 31: cerbos.private.v1.ServerTestCase.CheckResourceSetCall
 	(*ServerTestCase_CheckResourceBatchCall)(nil),     // 32: cerbos.private.v1.ServerTestCase.CheckResourceBatchCall
 	(*ServerTestCase_CheckResourcesCall)(nil),         // 33: cerbos.private.v1.ServerTestCase.CheckResourcesCall
-	(*ServerTestCase_PlaygroundValidateCall)(nil),     // 34: cerbos.private.v1.ServerTestCase.PlaygroundValidateCall
-	(*ServerTestCase_PlaygroundTestCall)(nil),         // 35: cerbos.private.v1.ServerTestCase.PlaygroundTestCall
-	(*ServerTestCase_PlaygroundEvaluateCall)(nil),     // 36: cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall
-	(*ServerTestCase_PlaygroundProxyCall)(nil),        // 37: cerbos.private.v1.ServerTestCase.PlaygroundProxyCall
-	(*ServerTestCase_AdminAddOrUpdatePolicyCall)(nil), // 38: cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall
-	(*ServerTestCase_AdminAddOrUpdateSchemaCall)(nil), // 39: cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall
-	(*ServerTestCase_Status)(nil),                     // 40: cerbos.private.v1.ServerTestCase.Status
-	(*IndexBuilderTestCase_CompilationUnit)(nil),      // 41: cerbos.private.v1.IndexBuilderTestCase.CompilationUnit
-	nil,                               // 42: cerbos.private.v1.IndexBuilderTestCase.FilesEntry
-	(*CompileTestCase_Variables)(nil), // 43: cerbos.private.v1.CompileTestCase.Variables
-	(*CompileTestCase_Variables_DerivedRole)(nil), // 44: cerbos.private.v1.CompileTestCase.Variables.DerivedRole
-	nil,                                        // 45: cerbos.private.v1.AttrWrapper.AttrEntry
-	(*QueryPlannerTestSuite_Test)(nil),         // 46: cerbos.private.v1.QueryPlannerTestSuite.Test
-	(*VerifyTestCase_Config)(nil),              // 47: cerbos.private.v1.VerifyTestCase.Config
-	(*ProtoYamlTestCase_Want)(nil),             // 48: cerbos.private.v1.ProtoYamlTestCase.Want
-	(*WellKnownTypes_Nested)(nil),              // 49: cerbos.private.v1.WellKnownTypes.Nested
-	(*v1.Policy)(nil),                          // 50: cerbos.policy.v1.Policy
-	(*v11.CheckInput)(nil),                     // 51: cerbos.engine.v1.CheckInput
-	(*v11.CheckOutput)(nil),                    // 52: cerbos.engine.v1.CheckOutput
-	(*v12.DecisionLogEntry)(nil),               // 53: cerbos.audit.v1.DecisionLogEntry
-	(*v13.IndexBuildErrors)(nil),               // 54: cerbos.runtime.v1.IndexBuildErrors
-	(*v13.CompileErrors_Err)(nil),              // 55: cerbos.runtime.v1.CompileErrors.Err
-	(*v1.Match)(nil),                           // 56: cerbos.policy.v1.Match
-	(*v11.Request)(nil),                        // 57: cerbos.engine.v1.Request
-	(*v1.Schemas)(nil),                         // 58: cerbos.policy.v1.Schemas
-	(*v11.PlanResourcesInput)(nil),             // 59: cerbos.engine.v1.PlanResourcesInput
-	(*v14.ValidationError)(nil),                // 60: cerbos.schema.v1.ValidationError
-	(*v11.Principal)(nil),                      // 61: cerbos.engine.v1.Principal
-	(*v1.TestTable)(nil),                       // 62: cerbos.policy.v1.TestTable
-	(*v1.Test)(nil),                            // 63: cerbos.policy.v1.Test
-	(*v11.PlanResourcesFilter)(nil),            // 64: cerbos.engine.v1.PlanResourcesFilter
-	(*v15.Error)(nil),                          // 65: cerbos.source.v1.Error
-	(*wrapperspb.BoolValue)(nil),               // 66: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),              // 67: google.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),              // 68: google.protobuf.Int64Value
-	(*wrapperspb.UInt32Value)(nil),             // 69: google.protobuf.UInt32Value
-	(*wrapperspb.UInt64Value)(nil),             // 70: google.protobuf.UInt64Value
-	(*wrapperspb.FloatValue)(nil),              // 71: google.protobuf.FloatValue
-	(*wrapperspb.DoubleValue)(nil),             // 72: google.protobuf.DoubleValue
-	(*wrapperspb.StringValue)(nil),             // 73: google.protobuf.StringValue
-	(*wrapperspb.BytesValue)(nil),              // 74: google.protobuf.BytesValue
-	(*durationpb.Duration)(nil),                // 75: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),              // 76: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                    // 77: google.protobuf.Struct
-	(*anypb.Any)(nil),                          // 78: google.protobuf.Any
-	(*structpb.Value)(nil),                     // 79: google.protobuf.Value
-	(structpb.NullValue)(0),                    // 80: google.protobuf.NullValue
-	(*structpb.ListValue)(nil),                 // 81: google.protobuf.ListValue
-	(*v16.InspectPoliciesResponse_Result)(nil), // 82: cerbos.response.v1.InspectPoliciesResponse.Result
-	(*v17.PlanResourcesRequest)(nil),           // 83: cerbos.request.v1.PlanResourcesRequest
-	(*v16.PlanResourcesResponse)(nil),          // 84: cerbos.response.v1.PlanResourcesResponse
-	(*v17.CheckResourceSetRequest)(nil),        // 85: cerbos.request.v1.CheckResourceSetRequest
-	(*v16.CheckResourceSetResponse)(nil),       // 86: cerbos.response.v1.CheckResourceSetResponse
-	(*v17.CheckResourceBatchRequest)(nil),      // 87: cerbos.request.v1.CheckResourceBatchRequest
-	(*v16.CheckResourceBatchResponse)(nil),     // 88: cerbos.response.v1.CheckResourceBatchResponse
-	(*v17.CheckResourcesRequest)(nil),          // 89: cerbos.request.v1.CheckResourcesRequest
-	(*v16.CheckResourcesResponse)(nil),         // 90: cerbos.response.v1.CheckResourcesResponse
-	(*v17.PlaygroundValidateRequest)(nil),      // 91: cerbos.request.v1.PlaygroundValidateRequest
-	(*v16.PlaygroundValidateResponse)(nil),     // 92: cerbos.response.v1.PlaygroundValidateResponse
-	(*v17.PlaygroundTestRequest)(nil),          // 93: cerbos.request.v1.PlaygroundTestRequest
-	(*v16.PlaygroundTestResponse)(nil),         // 94: cerbos.response.v1.PlaygroundTestResponse
-	(*v17.PlaygroundEvaluateRequest)(nil),      // 95: cerbos.request.v1.PlaygroundEvaluateRequest
-	(*v16.PlaygroundEvaluateResponse)(nil),     // 96: cerbos.response.v1.PlaygroundEvaluateResponse
-	(*v17.PlaygroundProxyRequest)(nil),         // 97: cerbos.request.v1.PlaygroundProxyRequest
-	(*v16.PlaygroundProxyResponse)(nil),        // 98: cerbos.response.v1.PlaygroundProxyResponse
-	(*v17.AddOrUpdatePolicyRequest)(nil),       // 99: cerbos.request.v1.AddOrUpdatePolicyRequest
-	(*v16.AddOrUpdatePolicyResponse)(nil),      // 100: cerbos.response.v1.AddOrUpdatePolicyResponse
-	(*v17.AddOrUpdateSchemaRequest)(nil),       // 101: cerbos.request.v1.AddOrUpdateSchemaRequest
-	(*v16.AddOrUpdateSchemaResponse)(nil),      // 102: cerbos.response.v1.AddOrUpdateSchemaResponse
-	(*v11.PlanResourcesInput_Resource)(nil),    // 103: cerbos.engine.v1.PlanResourcesInput.Resource
+	(*ServerTestCase_PlanPrincipalsCall)(nil),         // 34: cerbos.private.v1.ServerTestCase.PlanPrincipalsCall
+	(*ServerTestCase_ExplainCheckCall)(nil),           // 35: cerbos.private.v1.ServerTestCase.ExplainCheckCall
+	(*ServerTestCase_PlaygroundValidateCall)(nil),     // 36: cerbos.private.v1.ServerTestCase.PlaygroundValidateCall
+	(*ServerTestCase_PlaygroundTestCall)(nil),         // 37: cerbos.private.v1.ServerTestCase.PlaygroundTestCall
+	(*ServerTestCase_PlaygroundEvaluateCall)(nil),     // 38: cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall
+	(*ServerTestCase_PlaygroundProxyCall)(nil),        // 39: cerbos.private.v1.ServerTestCase.PlaygroundProxyCall
+	(*ServerTestCase_AdminAddOrUpdatePolicyCall)(nil), // 40: cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall
+	(*ServerTestCase_AdminCheckWithPoliciesCall)(nil), // 41: cerbos.private.v1.ServerTestCase.AdminCheckWithPoliciesCall
+	(*ServerTestCase_AdminAddOrUpdateSchemaCall)(nil), // 42: cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall
+	(*ServerTestCase_Status)(nil),                     // 43: cerbos.private.v1.ServerTestCase.Status
+	(*IndexBuilderTestCase_CompilationUnit)(nil),      // 44: cerbos.private.v1.IndexBuilderTestCase.CompilationUnit
+	nil,                               // 45: cerbos.private.v1.IndexBuilderTestCase.FilesEntry
+	(*CompileTestCase_Variables)(nil), // 46: cerbos.private.v1.CompileTestCase.Variables
+	(*CompileTestCase_Variables_DerivedRole)(nil), // 47: cerbos.private.v1.CompileTestCase.Variables.DerivedRole
+	nil,                                          // 48: cerbos.private.v1.AttrWrapper.AttrEntry
+	(*QueryPlannerTestSuite_Test)(nil),           // 49: cerbos.private.v1.QueryPlannerTestSuite.Test
+	(*VerifyTestCase_Config)(nil),                // 50: cerbos.private.v1.VerifyTestCase.Config
+	(*ProtoYamlTestCase_Want)(nil),               // 51: cerbos.private.v1.ProtoYamlTestCase.Want
+	(*WellKnownTypes_Nested)(nil),                // 52: cerbos.private.v1.WellKnownTypes.Nested
+	(*v1.Policy)(nil),                            // 53: cerbos.policy.v1.Policy
+	(*v11.CheckInput)(nil),                       // 54: cerbos.engine.v1.CheckInput
+	(*v11.CheckOutput)(nil),                      // 55: cerbos.engine.v1.CheckOutput
+	(*v12.DecisionLogEntry)(nil),                 // 56: cerbos.audit.v1.DecisionLogEntry
+	(*v13.IndexBuildErrors)(nil),                 // 57: cerbos.runtime.v1.IndexBuildErrors
+	(*v13.CompileErrors_Err)(nil),                // 58: cerbos.runtime.v1.CompileErrors.Err
+	(*v1.Match)(nil),                             // 59: cerbos.policy.v1.Match
+	(*v11.Request)(nil),                          // 60: cerbos.engine.v1.Request
+	(*v1.Schemas)(nil),                           // 61: cerbos.policy.v1.Schemas
+	(*v11.PlanResourcesInput)(nil),               // 62: cerbos.engine.v1.PlanResourcesInput
+	(*v14.ValidationError)(nil),                  // 63: cerbos.schema.v1.ValidationError
+	(*v11.Principal)(nil),                        // 64: cerbos.engine.v1.Principal
+	(*v1.TestTable)(nil),                         // 65: cerbos.policy.v1.TestTable
+	(*v1.Test)(nil),                              // 66: cerbos.policy.v1.Test
+	(*v11.PlanResourcesFilter)(nil),              // 67: cerbos.engine.v1.PlanResourcesFilter
+	(*v11.PlanResourcesInput_FilterOptions)(nil), // 68: cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	(*v15.Error)(nil),                            // 69: cerbos.source.v1.Error
+	(*wrapperspb.BoolValue)(nil),                 // 70: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),                // 71: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),                // 72: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil),               // 73: google.protobuf.UInt32Value
+	(*wrapperspb.UInt64Value)(nil),               // 74: google.protobuf.UInt64Value
+	(*wrapperspb.FloatValue)(nil),                // 75: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil),               // 76: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil),               // 77: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),                // 78: google.protobuf.BytesValue
+	(*durationpb.Duration)(nil),                  // 79: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                // 80: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                      // 81: google.protobuf.Struct
+	(*anypb.Any)(nil),                            // 82: google.protobuf.Any
+	(*structpb.Value)(nil),                       // 83: google.protobuf.Value
+	(structpb.NullValue)(0),                      // 84: google.protobuf.NullValue
+	(*structpb.ListValue)(nil),                   // 85: google.protobuf.ListValue
+	(*v16.InspectPoliciesResponse_Result)(nil),   // 86: cerbos.response.v1.InspectPoliciesResponse.Result
+	(*v17.PlanResourcesRequest)(nil),             // 87: cerbos.request.v1.PlanResourcesRequest
+	(*v16.PlanResourcesResponse)(nil),            // 88: cerbos.response.v1.PlanResourcesResponse
+	(*v17.CheckResourceSetRequest)(nil),          // 89: cerbos.request.v1.CheckResourceSetRequest
+	(*v16.CheckResourceSetResponse)(nil),         // 90: cerbos.response.v1.CheckResourceSetResponse
+	(*v17.CheckResourceBatchRequest)(nil),        // 91: cerbos.request.v1.CheckResourceBatchRequest
+	(*v16.CheckResourceBatchResponse)(nil),       // 92: cerbos.response.v1.CheckResourceBatchResponse
+	(*v17.CheckResourcesRequest)(nil),            // 93: cerbos.request.v1.CheckResourcesRequest
+	(*v16.CheckResourcesResponse)(nil),           // 94: cerbos.response.v1.CheckResourcesResponse
+	(*v17.PlanPrincipalsRequest)(nil),            // 95: cerbos.request.v1.PlanPrincipalsRequest
+	(*v16.PlanPrincipalsResponse)(nil),           // 96: cerbos.response.v1.PlanPrincipalsResponse
+	(*v17.ExplainCheckRequest)(nil),              // 97: cerbos.request.v1.ExplainCheckRequest
+	(*v16.ExplainCheckResponse)(nil),             // 98: cerbos.response.v1.ExplainCheckResponse
+	(*v17.PlaygroundValidateRequest)(nil),        // 99: cerbos.request.v1.PlaygroundValidateRequest
+	(*v16.PlaygroundValidateResponse)(nil),       // 100: cerbos.response.v1.PlaygroundValidateResponse
+	(*v17.PlaygroundTestRequest)(nil),            // 101: cerbos.request.v1.PlaygroundTestRequest
+	(*v16.PlaygroundTestResponse)(nil),           // 102: cerbos.response.v1.PlaygroundTestResponse
+	(*v17.PlaygroundEvaluateRequest)(nil),        // 103: cerbos.request.v1.PlaygroundEvaluateRequest
+	(*v16.PlaygroundEvaluateResponse)(nil),       // 104: cerbos.response.v1.PlaygroundEvaluateResponse
+	(*v17.PlaygroundProxyRequest)(nil),           // 105: cerbos.request.v1.PlaygroundProxyRequest
+	(*v16.PlaygroundProxyResponse)(nil),          // 106: cerbos.response.v1.PlaygroundProxyResponse
+	(*v17.AddOrUpdatePolicyRequest)(nil),         // 107: cerbos.request.v1.AddOrUpdatePolicyRequest
+	(*v16.AddOrUpdatePolicyResponse)(nil),        // 108: cerbos.response.v1.AddOrUpdatePolicyResponse
+	(*v17.CheckWithPoliciesRequest)(nil),         // 109: cerbos.request.v1.CheckWithPoliciesRequest
+	(*v16.CheckWithPoliciesResponse)(nil),        // 110: cerbos.response.v1.CheckWithPoliciesResponse
+	(*v17.AddOrUpdateSchemaRequest)(nil),         // 111: cerbos.request.v1.AddOrUpdateSchemaRequest
+	(*v16.AddOrUpdateSchemaResponse)(nil),        // 112: cerbos.response.v1.AddOrUpdateSchemaResponse
+	(*v11.PlanResourcesInput_Resource)(nil),      // 113: cerbos.engine.v1.PlanResourcesInput.Resource
 }
 var file_cerbos_private_v1_test_proto_depIdxs = []int32{
-	50,  // 0: cerbos.private.v1.InspectTestCase.inputs:type_name -> cerbos.policy.v1.Policy
+	53,  // 0: cerbos.private.v1.InspectTestCase.inputs:type_name -> cerbos.policy.v1.Policy
 	16,  // 1: cerbos.private.v1.InspectTestCase.policies_expectation:type_name -> cerbos.private.v1.InspectTestCase.PoliciesExpectation
 	17,  // 2: cerbos.private.v1.InspectTestCase.policy_sets_expectation:type_name -> cerbos.private.v1.InspectTestCase.PolicySetsExpectation
 	21,  // 3: cerbos.private.v1.BlobClonerTestCase.inputs:type_name -> cerbos.private.v1.BlobClonerTestCase.File
 	22,  // 4: cerbos.private.v1.BlobClonerTestCase.steps:type_name -> cerbos.private.v1.BlobClonerTestCase.Step
-	51,  // 5: cerbos.private.v1.EngineTestCase.inputs:type_name -> cerbos.engine.v1.CheckInput
-	52,  // 6: cerbos.private.v1.EngineTestCase.want_outputs:type_name -> cerbos.engine.v1.CheckOutput
-	53,  // 7: cerbos.private.v1.EngineTestCase.want_decision_logs:type_name -> cerbos.audit.v1.DecisionLogEntry
-	40,  // 8: cerbos.private.v1.ServerTestCase.want_status:type_name -> cerbos.private.v1.ServerTestCase.Status
+	54,  // 5: cerbos.private.v1.EngineTestCase.inputs:type_name -> cerbos.engine.v1.CheckInput
+	55,  // 6: cerbos.private.v1.EngineTestCase.want_outputs:type_name -> cerbos.engine.v1.CheckOutput
+	56,  // 7: cerbos.private.v1.EngineTestCase.want_decision_logs:type_name -> cerbos.audit.v1.DecisionLogEntry
+	43,  // 8: cerbos.private.v1.ServerTestCase.want_status:type_name -> cerbos.private.v1.ServerTestCase.Status
 	31,  // 9: cerbos.private.v1.ServerTestCase.check_resource_set:type_name -> cerbos.private.v1.ServerTestCase.CheckResourceSetCall
 	32,  // 10: cerbos.private.v1.ServerTestCase.check_resource_batch:type_name -> cerbos.private.v1.ServerTestCase.CheckResourceBatchCall
-	34,  // 11: cerbos.private.v1.ServerTestCase.playground_validate:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundValidateCall
-	36,  // 12: cerbos.private.v1.ServerTestCase.playground_evaluate:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall
-	38,  // 13: cerbos.private.v1.ServerTestCase.admin_add_or_update_policy:type_name -> cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall
-	37,  // 14: cerbos.private.v1.ServerTestCase.playground_proxy:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundProxyCall
+	36,  // 11: cerbos.private.v1.ServerTestCase.playground_validate:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundValidateCall
+	38,  // 12: cerbos.private.v1.ServerTestCase.playground_evaluate:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall
+	40,  // 13: cerbos.private.v1.ServerTestCase.admin_add_or_update_policy:type_name -> cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall
+	39,  // 14: cerbos.private.v1.ServerTestCase.playground_proxy:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundProxyCall
 	30,  // 15: cerbos.private.v1.ServerTestCase.plan_resources:type_name -> cerbos.private.v1.ServerTestCase.PlanResourcesCall
-	39,  // 16: cerbos.private.v1.ServerTestCase.admin_add_or_update_schema:type_name -> cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall
-	35,  // 17: cerbos.private.v1.ServerTestCase.playground_test:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundTestCall
+	42,  // 16: cerbos.private.v1.ServerTestCase.admin_add_or_update_schema:type_name -> cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall
+	37,  // 17: cerbos.private.v1.ServerTestCase.playground_test:type_name -> cerbos.private.v1.ServerTestCase.PlaygroundTestCall
 	33,  // 18: cerbos.private.v1.ServerTestCase.check_resources:type_name -> cerbos.private.v1.ServerTestCase.CheckResourcesCall
-	42,  // 19: cerbos.private.v1.IndexBuilderTestCase.files:type_name -> cerbos.private.v1.IndexBuilderTestCase.FilesEntry
-	54,  // 20: cerbos.private.v1.IndexBuilderTestCase.want_err_list:type_name -> cerbos.runtime.v1.IndexBuildErrors
-	41,  // 21: cerbos.private.v1.IndexBuilderTestCase.want_compilation_units:type_name -> cerbos.private.v1.IndexBuilderTestCase.CompilationUnit
-	55,  // 22: cerbos.private.v1.CompileTestCase.want_errors:type_name -> cerbos.runtime.v1.CompileErrors.Err
-	43,  // 23: cerbos.private.v1.CompileTestCase.want_variables:type_name -> cerbos.private.v1.CompileTestCase.Variables
-	56,  // 24: cerbos.private.v1.CelTestCase.condition:type_name -> cerbos.policy.v1.Match
-	57,  // 25: cerbos.private.v1.CelTestCase.request:type_name -> cerbos.engine.v1.Request
-	58,  // 26: cerbos.private.v1.SchemaTestCase.schema_refs:type_name -> cerbos.policy.v1.Schemas
-	51,  // 27: cerbos.private.v1.SchemaTestCase.check_input:type_name -> cerbos.engine.v1.CheckInput
-	59,  // 28: cerbos.private.v1.SchemaTestCase.plan_resources_input:type_name -> cerbos.engine.v1.PlanResourcesInput
-	60,  // 29: cerbos.private.v1.SchemaTestCase.want_validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	60,  // 30: cerbos.private.v1.ValidationErrContainer.errors:type_name -> cerbos.schema.v1.ValidationError
-	45,  // 31: cerbos.private.v1.AttrWrapper.attr:type_name -> cerbos.private.v1.AttrWrapper.AttrEntry
-	61,  // 32: cerbos.private.v1.QueryPlannerTestSuite.principal:type_name -> cerbos.engine.v1.Principal
-	46,  // 33: cerbos.private.v1.QueryPlannerTestSuite.tests:type_name -> cerbos.private.v1.QueryPlannerTestSuite.Test
-	62,  // 34: cerbos.private.v1.VerifyTestSuiteRunGetTestsTestCase.table:type_name -> cerbos.policy.v1.TestTable
-	63,  // 35: cerbos.private.v1.VerifyTestSuiteRunGetTestsTestCase.want_tests:type_name -> cerbos.policy.v1.Test
-	64,  // 36: cerbos.private.v1.QueryPlannerFilterTestCase.input:type_name -> cerbos.engine.v1.PlanResourcesFilter
-	64,  // 37: cerbos.private.v1.QueryPlannerFilterTestCase.want_filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
-	47,  // 38: cerbos.private.v1.VerifyTestCase.config:type_name -> cerbos.private.v1.VerifyTestCase.Config
-	48,  // 39: cerbos.private.v1.ProtoYamlTestCase.want:type_name -> cerbos.private.v1.ProtoYamlTestCase.Want
-	65,  // 40: cerbos.private.v1.ProtoYamlTestCase.want_errors:type_name -> cerbos.source.v1.Error
-	66,  // 41: cerbos.private.v1.WellKnownTypes.bool_wrapper:type_name -> google.protobuf.BoolValue
-	67,  // 42: cerbos.private.v1.WellKnownTypes.int32_wrapper:type_name -> google.protobuf.Int32Value
-	68,  // 43: cerbos.private.v1.WellKnownTypes.int64_wrapper:type_name -> google.protobuf.Int64Value
-	69,  // 44: cerbos.private.v1.WellKnownTypes.uint32_wrapper:type_name -> google.protobuf.UInt32Value
-	70,  // 45: cerbos.private.v1.WellKnownTypes.uint64_wrapper:type_name -> google.protobuf.UInt64Value
-	71,  // 46: cerbos.private.v1.WellKnownTypes.float_wrapper:type_name -> google.protobuf.FloatValue
-	72,  // 47: cerbos.private.v1.WellKnownTypes.double_wrapper:type_name -> google.protobuf.DoubleValue
-	73,  // 48: cerbos.private.v1.WellKnownTypes.string_wrapper:type_name -> google.protobuf.StringValue
-	74,  // 49: cerbos.private.v1.WellKnownTypes.bytes_wrapper:type_name -> google.protobuf.BytesValue
-	66,  // 50: cerbos.private.v1.WellKnownTypes.repeated_bool_wrapper:type_name -> google.protobuf.BoolValue
-	67,  // 51: cerbos.private.v1.WellKnownTypes.repeated_int32_wrapper:type_name -> google.protobuf.Int32Value
-	68,  // 52: cerbos.private.v1.WellKnownTypes.repeated_int64_wrapper:type_name -> google.protobuf.Int64Value
-	69,  // 53: cerbos.private.v1.WellKnownTypes.repeated_uint32_wrapper:type_name -> google.protobuf.UInt32Value
-	70,  // 54: cerbos.private.v1.WellKnownTypes.repeated_uint64_wrapper:type_name -> google.protobuf.UInt64Value
-	71,  // 55: cerbos.private.v1.WellKnownTypes.repeated_float_wrapper:type_name -> google.protobuf.FloatValue
-	72,  // 56: cerbos.private.v1.WellKnownTypes.repeated_double_wrapper:type_name -> google.protobuf.DoubleValue
-	73,  // 57: cerbos.private.v1.WellKnownTypes.repeated_string_wrapper:type_name -> google.protobuf.StringValue
-	74,  // 58: cerbos.private.v1.WellKnownTypes.repeated_bytes_wrapper:type_name -> google.protobuf.BytesValue
-	75,  // 59: cerbos.private.v1.WellKnownTypes.duration:type_name -> google.protobuf.Duration
-	76,  // 60: cerbos.private.v1.WellKnownTypes.timestamp:type_name -> google.protobuf.Timestamp
-	77,  // 61: cerbos.private.v1.WellKnownTypes.struct:type_name -> google.protobuf.Struct
-	78,  // 62: cerbos.private.v1.WellKnownTypes.any:type_name -> google.protobuf.Any
-	79,  // 63: cerbos.private.v1.WellKnownTypes.value:type_name -> google.protobuf.Value
-	80,  // 64: cerbos.private.v1.WellKnownTypes.null_value:type_name -> google.protobuf.NullValue
-	75,  // 65: cerbos.private.v1.WellKnownTypes.repeated_duration:type_name -> google.protobuf.Duration
-	76,  // 66: cerbos.private.v1.WellKnownTypes.repeated_timestamp:type_name -> google.protobuf.Timestamp
-	77,  // 67: cerbos.private.v1.WellKnownTypes.repeated_struct:type_name -> google.protobuf.Struct
-	78,  // 68: cerbos.private.v1.WellKnownTypes.repeated_any:type_name -> google.protobuf.Any
-	79,  // 69: cerbos.private.v1.WellKnownTypes.repeated_value:type_name -> google.protobuf.Value
-	81,  // 70: cerbos.private.v1.WellKnownTypes.repeated_list_value:type_name -> google.protobuf.ListValue
-	49,  // 71: cerbos.private.v1.WellKnownTypes.optional_nested_msg:type_name -> cerbos.private.v1.WellKnownTypes.Nested
-	18,  // 72: cerbos.private.v1.InspectTestCase.PoliciesExpectation.policies:type_name -> cerbos.private.v1.InspectTestCase.PoliciesExpectation.PoliciesEntry
-	20,  // 73: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.policy_sets:type_name -> cerbos.private.v1.InspectTestCase.PolicySetsExpectation.PolicySetsEntry
-	19,  // 74: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.compile_errors:type_name -> cerbos.private.v1.InspectTestCase.PolicySetsExpectation.CompileErrors
-	54,  // 75: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.index_build_errors:type_name -> cerbos.runtime.v1.IndexBuildErrors
-	82,  // 76: cerbos.private.v1.InspectTestCase.PoliciesExpectation.PoliciesEntry.value:type_name -> cerbos.response.v1.InspectPoliciesResponse.Result
-	55,  // 77: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.CompileErrors.compile_errors:type_name -> cerbos.runtime.v1.CompileErrors.Err
-	82,  // 78: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.PolicySetsEntry.value:type_name -> cerbos.response.v1.InspectPoliciesResponse.Result
-	23,  // 79: cerbos.private.v1.BlobClonerTestCase.File.add_or_update:type_name -> cerbos.private.v1.BlobClonerTestCase.File.AddOrUpdate
-	24,  // 80: cerbos.private.v1.BlobClonerTestCase.File.delete:type_name -> cerbos.private.v1.BlobClonerTestCase.File.Delete
-	26,  // 81: cerbos.private.v1.BlobClonerTestCase.Step.expectation:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation
-	25,  // 82: cerbos.private.v1.BlobClonerTestCase.Step.differences:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Differences
-	21,  // 83: cerbos.private.v1.BlobClonerTestCase.Step.Differences.files:type_name -> cerbos.private.v1.BlobClonerTestCase.File
-	29,  // 84: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.all:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.AllEntry
-	28,  // 85: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.added_or_updated:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.Info
-	28,  // 86: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.deleted:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.Info
-	27,  // 87: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.AllEntry.value:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.Files
-	83,  // 88: cerbos.private.v1.ServerTestCase.PlanResourcesCall.input:type_name -> cerbos.request.v1.PlanResourcesRequest
-	84,  // 89: cerbos.private.v1.ServerTestCase.PlanResourcesCall.want_response:type_name -> cerbos.response.v1.PlanResourcesResponse
-	85,  // 90: cerbos.private.v1.ServerTestCase.CheckResourceSetCall.input:type_name -> cerbos.request.v1.CheckResourceSetRequest
-	86,  // 91: cerbos.private.v1.ServerTestCase.CheckResourceSetCall.want_response:type_name -> cerbos.response.v1.CheckResourceSetResponse
-	87,  // 92: cerbos.private.v1.ServerTestCase.CheckResourceBatchCall.input:type_name -> cerbos.request.v1.CheckResourceBatchRequest
-	88,  // 93: cerbos.private.v1.ServerTestCase.CheckResourceBatchCall.want_response:type_name -> cerbos.response.v1.CheckResourceBatchResponse
-	89,  // 94: cerbos.private.v1.ServerTestCase.CheckResourcesCall.input:type_name -> cerbos.request.v1.CheckResourcesRequest
-	90,  // 95: cerbos.private.v1.ServerTestCase.CheckResourcesCall.want_response:type_name -> cerbos.response.v1.CheckResourcesResponse
-	91,  // 96: cerbos.private.v1.ServerTestCase.PlaygroundValidateCall.input:type_name -> cerbos.request.v1.PlaygroundValidateRequest
-	92,  // 97: cerbos.private.v1.ServerTestCase.PlaygroundValidateCall.want_response:type_name -> cerbos.response.v1.PlaygroundValidateResponse
-	93,  // 98: cerbos.private.v1.ServerTestCase.PlaygroundTestCall.input:type_name -> cerbos.request.v1.PlaygroundTestRequest
-	94,  // 99: cerbos.private.v1.ServerTestCase.PlaygroundTestCall.want_response:type_name -> cerbos.response.v1.PlaygroundTestResponse
-	95,  // 100: cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall.input:type_name -> cerbos.request.v1.PlaygroundEvaluateRequest
-	96,  // 101: cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall.want_response:type_name -> cerbos.response.v1.PlaygroundEvaluateResponse
-	97,  // 102: cerbos.private.v1.ServerTestCase.PlaygroundProxyCall.input:type_name -> cerbos.request.v1.PlaygroundProxyRequest
-	98,  // 103: cerbos.private.v1.ServerTestCase.PlaygroundProxyCall.want_response:type_name -> cerbos.response.v1.PlaygroundProxyResponse
-	99,  // 104: cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall.input:type_name -> cerbos.request.v1.AddOrUpdatePolicyRequest
-	100, // 105: cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall.want_response:type_name -> cerbos.response.v1.AddOrUpdatePolicyResponse
-	101, // 106: cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall.input:type_name -> cerbos.request.v1.AddOrUpdateSchemaRequest
-	102, // 107: cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall.want_response:type_name -> cerbos.response.v1.AddOrUpdateSchemaResponse
-	44,  // 108: cerbos.private.v1.CompileTestCase.Variables.derived_roles:type_name -> cerbos.private.v1.CompileTestCase.Variables.DerivedRole
-	79,  // 109: cerbos.private.v1.AttrWrapper.AttrEntry.value:type_name -> google.protobuf.Value
-	64,  // 110: cerbos.private.v1.QueryPlannerTestSuite.Test.want:type_name -> cerbos.engine.v1.PlanResourcesFilter
-	103, // 111: cerbos.private.v1.QueryPlannerTestSuite.Test.resource:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
-	50,  // 112: cerbos.private.v1.ProtoYamlTestCase.Want.message:type_name -> cerbos.policy.v1.Policy
-	65,  // 113: cerbos.private.v1.ProtoYamlTestCase.Want.errors:type_name -> cerbos.source.v1.Error
-	79,  // 114: cerbos.private.v1.WellKnownTypes.Nested.value_field:type_name -> google.protobuf.Value
-	115, // [115:115] is the sub-list for method output_type
-	115, // [115:115] is the sub-list for method input_type
-	115, // [115:115] is the sub-list for extension type_name
-	115, // [115:115] is the sub-list for extension extendee
-	0,   // [0:115] is the sub-list for field type_name
+	35,  // 19: cerbos.private.v1.ServerTestCase.explain_check:type_name -> cerbos.private.v1.ServerTestCase.ExplainCheckCall
+	41,  // 20: cerbos.private.v1.ServerTestCase.admin_check_with_policies:type_name -> cerbos.private.v1.ServerTestCase.AdminCheckWithPoliciesCall
+	34,  // 21: cerbos.private.v1.ServerTestCase.plan_principals:type_name -> cerbos.private.v1.ServerTestCase.PlanPrincipalsCall
+	45,  // 22: cerbos.private.v1.IndexBuilderTestCase.files:type_name -> cerbos.private.v1.IndexBuilderTestCase.FilesEntry
+	57,  // 23: cerbos.private.v1.IndexBuilderTestCase.want_err_list:type_name -> cerbos.runtime.v1.IndexBuildErrors
+	44,  // 24: cerbos.private.v1.IndexBuilderTestCase.want_compilation_units:type_name -> cerbos.private.v1.IndexBuilderTestCase.CompilationUnit
+	58,  // 25: cerbos.private.v1.CompileTestCase.want_errors:type_name -> cerbos.runtime.v1.CompileErrors.Err
+	46,  // 26: cerbos.private.v1.CompileTestCase.want_variables:type_name -> cerbos.private.v1.CompileTestCase.Variables
+	59,  // 27: cerbos.private.v1.CelTestCase.condition:type_name -> cerbos.policy.v1.Match
+	60,  // 28: cerbos.private.v1.CelTestCase.request:type_name -> cerbos.engine.v1.Request
+	61,  // 29: cerbos.private.v1.SchemaTestCase.schema_refs:type_name -> cerbos.policy.v1.Schemas
+	54,  // 30: cerbos.private.v1.SchemaTestCase.check_input:type_name -> cerbos.engine.v1.CheckInput
+	62,  // 31: cerbos.private.v1.SchemaTestCase.plan_resources_input:type_name -> cerbos.engine.v1.PlanResourcesInput
+	63,  // 32: cerbos.private.v1.SchemaTestCase.want_validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	63,  // 33: cerbos.private.v1.ValidationErrContainer.errors:type_name -> cerbos.schema.v1.ValidationError
+	48,  // 34: cerbos.private.v1.AttrWrapper.attr:type_name -> cerbos.private.v1.AttrWrapper.AttrEntry
+	64,  // 35: cerbos.private.v1.QueryPlannerTestSuite.principal:type_name -> cerbos.engine.v1.Principal
+	49,  // 36: cerbos.private.v1.QueryPlannerTestSuite.tests:type_name -> cerbos.private.v1.QueryPlannerTestSuite.Test
+	65,  // 37: cerbos.private.v1.VerifyTestSuiteRunGetTestsTestCase.table:type_name -> cerbos.policy.v1.TestTable
+	66,  // 38: cerbos.private.v1.VerifyTestSuiteRunGetTestsTestCase.want_tests:type_name -> cerbos.policy.v1.Test
+	67,  // 39: cerbos.private.v1.QueryPlannerFilterTestCase.input:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	67,  // 40: cerbos.private.v1.QueryPlannerFilterTestCase.want_filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	68,  // 41: cerbos.private.v1.QueryPlannerFilterTestCase.filter_options:type_name -> cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	50,  // 42: cerbos.private.v1.VerifyTestCase.config:type_name -> cerbos.private.v1.VerifyTestCase.Config
+	51,  // 43: cerbos.private.v1.ProtoYamlTestCase.want:type_name -> cerbos.private.v1.ProtoYamlTestCase.Want
+	69,  // 44: cerbos.private.v1.ProtoYamlTestCase.want_errors:type_name -> cerbos.source.v1.Error
+	70,  // 45: cerbos.private.v1.WellKnownTypes.bool_wrapper:type_name -> google.protobuf.BoolValue
+	71,  // 46: cerbos.private.v1.WellKnownTypes.int32_wrapper:type_name -> google.protobuf.Int32Value
+	72,  // 47: cerbos.private.v1.WellKnownTypes.int64_wrapper:type_name -> google.protobuf.Int64Value
+	73,  // 48: cerbos.private.v1.WellKnownTypes.uint32_wrapper:type_name -> google.protobuf.UInt32Value
+	74,  // 49: cerbos.private.v1.WellKnownTypes.uint64_wrapper:type_name -> google.protobuf.UInt64Value
+	75,  // 50: cerbos.private.v1.WellKnownTypes.float_wrapper:type_name -> google.protobuf.FloatValue
+	76,  // 51: cerbos.private.v1.WellKnownTypes.double_wrapper:type_name -> google.protobuf.DoubleValue
+	77,  // 52: cerbos.private.v1.WellKnownTypes.string_wrapper:type_name -> google.protobuf.StringValue
+	78,  // 53: cerbos.private.v1.WellKnownTypes.bytes_wrapper:type_name -> google.protobuf.BytesValue
+	70,  // 54: cerbos.private.v1.WellKnownTypes.repeated_bool_wrapper:type_name -> google.protobuf.BoolValue
+	71,  // 55: cerbos.private.v1.WellKnownTypes.repeated_int32_wrapper:type_name -> google.protobuf.Int32Value
+	72,  // 56: cerbos.private.v1.WellKnownTypes.repeated_int64_wrapper:type_name -> google.protobuf.Int64Value
+	73,  // 57: cerbos.private.v1.WellKnownTypes.repeated_uint32_wrapper:type_name -> google.protobuf.UInt32Value
+	74,  // 58: cerbos.private.v1.WellKnownTypes.repeated_uint64_wrapper:type_name -> google.protobuf.UInt64Value
+	75,  // 59: cerbos.private.v1.WellKnownTypes.repeated_float_wrapper:type_name -> google.protobuf.FloatValue
+	76,  // 60: cerbos.private.v1.WellKnownTypes.repeated_double_wrapper:type_name -> google.protobuf.DoubleValue
+	77,  // 61: cerbos.private.v1.WellKnownTypes.repeated_string_wrapper:type_name -> google.protobuf.StringValue
+	78,  // 62: cerbos.private.v1.WellKnownTypes.repeated_bytes_wrapper:type_name -> google.protobuf.BytesValue
+	79,  // 63: cerbos.private.v1.WellKnownTypes.duration:type_name -> google.protobuf.Duration
+	80,  // 64: cerbos.private.v1.WellKnownTypes.timestamp:type_name -> google.protobuf.Timestamp
+	81,  // 65: cerbos.private.v1.WellKnownTypes.struct:type_name -> google.protobuf.Struct
+	82,  // 66: cerbos.private.v1.WellKnownTypes.any:type_name -> google.protobuf.Any
+	83,  // 67: cerbos.private.v1.WellKnownTypes.value:type_name -> google.protobuf.Value
+	84,  // 68: cerbos.private.v1.WellKnownTypes.null_value:type_name -> google.protobuf.NullValue
+	79,  // 69: cerbos.private.v1.WellKnownTypes.repeated_duration:type_name -> google.protobuf.Duration
+	80,  // 70: cerbos.private.v1.WellKnownTypes.repeated_timestamp:type_name -> google.protobuf.Timestamp
+	81,  // 71: cerbos.private.v1.WellKnownTypes.repeated_struct:type_name -> google.protobuf.Struct
+	82,  // 72: cerbos.private.v1.WellKnownTypes.repeated_any:type_name -> google.protobuf.Any
+	83,  // 73: cerbos.private.v1.WellKnownTypes.repeated_value:type_name -> google.protobuf.Value
+	85,  // 74: cerbos.private.v1.WellKnownTypes.repeated_list_value:type_name -> google.protobuf.ListValue
+	52,  // 75: cerbos.private.v1.WellKnownTypes.optional_nested_msg:type_name -> cerbos.private.v1.WellKnownTypes.Nested
+	18,  // 76: cerbos.private.v1.InspectTestCase.PoliciesExpectation.policies:type_name -> cerbos.private.v1.InspectTestCase.PoliciesExpectation.PoliciesEntry
+	20,  // 77: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.policy_sets:type_name -> cerbos.private.v1.InspectTestCase.PolicySetsExpectation.PolicySetsEntry
+	19,  // 78: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.compile_errors:type_name -> cerbos.private.v1.InspectTestCase.PolicySetsExpectation.CompileErrors
+	57,  // 79: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.index_build_errors:type_name -> cerbos.runtime.v1.IndexBuildErrors
+	86,  // 80: cerbos.private.v1.InspectTestCase.PoliciesExpectation.PoliciesEntry.value:type_name -> cerbos.response.v1.InspectPoliciesResponse.Result
+	58,  // 81: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.CompileErrors.compile_errors:type_name -> cerbos.runtime.v1.CompileErrors.Err
+	86,  // 82: cerbos.private.v1.InspectTestCase.PolicySetsExpectation.PolicySetsEntry.value:type_name -> cerbos.response.v1.InspectPoliciesResponse.Result
+	23,  // 83: cerbos.private.v1.BlobClonerTestCase.File.add_or_update:type_name -> cerbos.private.v1.BlobClonerTestCase.File.AddOrUpdate
+	24,  // 84: cerbos.private.v1.BlobClonerTestCase.File.delete:type_name -> cerbos.private.v1.BlobClonerTestCase.File.Delete
+	26,  // 85: cerbos.private.v1.BlobClonerTestCase.Step.expectation:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation
+	25,  // 86: cerbos.private.v1.BlobClonerTestCase.Step.differences:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Differences
+	21,  // 87: cerbos.private.v1.BlobClonerTestCase.Step.Differences.files:type_name -> cerbos.private.v1.BlobClonerTestCase.File
+	29,  // 88: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.all:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.AllEntry
+	28,  // 89: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.added_or_updated:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.Info
+	28,  // 90: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.deleted:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.Info
+	27,  // 91: cerbos.private.v1.BlobClonerTestCase.Step.Expectation.AllEntry.value:type_name -> cerbos.private.v1.BlobClonerTestCase.Step.Expectation.Files
+	87,  // 92: cerbos.private.v1.ServerTestCase.PlanResourcesCall.input:type_name -> cerbos.request.v1.PlanResourcesRequest
+	88,  // 93: cerbos.private.v1.ServerTestCase.PlanResourcesCall.want_response:type_name -> cerbos.response.v1.PlanResourcesResponse
+	89,  // 94: cerbos.private.v1.ServerTestCase.CheckResourceSetCall.input:type_name -> cerbos.request.v1.CheckResourceSetRequest
+	90,  // 95: cerbos.private.v1.ServerTestCase.CheckResourceSetCall.want_response:type_name -> cerbos.response.v1.CheckResourceSetResponse
+	91,  // 96: cerbos.private.v1.ServerTestCase.CheckResourceBatchCall.input:type_name -> cerbos.request.v1.CheckResourceBatchRequest
+	92,  // 97: cerbos.private.v1.ServerTestCase.CheckResourceBatchCall.want_response:type_name -> cerbos.response.v1.CheckResourceBatchResponse
+	93,  // 98: cerbos.private.v1.ServerTestCase.CheckResourcesCall.input:type_name -> cerbos.request.v1.CheckResourcesRequest
+	94,  // 99: cerbos.private.v1.ServerTestCase.CheckResourcesCall.want_response:type_name -> cerbos.response.v1.CheckResourcesResponse
+	95,  // 100: cerbos.private.v1.ServerTestCase.PlanPrincipalsCall.input:type_name -> cerbos.request.v1.PlanPrincipalsRequest
+	96,  // 101: cerbos.private.v1.ServerTestCase.PlanPrincipalsCall.want_response:type_name -> cerbos.response.v1.PlanPrincipalsResponse
+	97,  // 102: cerbos.private.v1.ServerTestCase.ExplainCheckCall.input:type_name -> cerbos.request.v1.ExplainCheckRequest
+	98,  // 103: cerbos.private.v1.ServerTestCase.ExplainCheckCall.want_response:type_name -> cerbos.response.v1.ExplainCheckResponse
+	99,  // 104: cerbos.private.v1.ServerTestCase.PlaygroundValidateCall.input:type_name -> cerbos.request.v1.PlaygroundValidateRequest
+	100, // 105: cerbos.private.v1.ServerTestCase.PlaygroundValidateCall.want_response:type_name -> cerbos.response.v1.PlaygroundValidateResponse
+	101, // 106: cerbos.private.v1.ServerTestCase.PlaygroundTestCall.input:type_name -> cerbos.request.v1.PlaygroundTestRequest
+	102, // 107: cerbos.private.v1.ServerTestCase.PlaygroundTestCall.want_response:type_name -> cerbos.response.v1.PlaygroundTestResponse
+	103, // 108: cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall.input:type_name -> cerbos.request.v1.PlaygroundEvaluateRequest
+	104, // 109: cerbos.private.v1.ServerTestCase.PlaygroundEvaluateCall.want_response:type_name -> cerbos.response.v1.PlaygroundEvaluateResponse
+	105, // 110: cerbos.private.v1.ServerTestCase.PlaygroundProxyCall.input:type_name -> cerbos.request.v1.PlaygroundProxyRequest
+	106, // 111: cerbos.private.v1.ServerTestCase.PlaygroundProxyCall.want_response:type_name -> cerbos.response.v1.PlaygroundProxyResponse
+	107, // 112: cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall.input:type_name -> cerbos.request.v1.AddOrUpdatePolicyRequest
+	108, // 113: cerbos.private.v1.ServerTestCase.AdminAddOrUpdatePolicyCall.want_response:type_name -> cerbos.response.v1.AddOrUpdatePolicyResponse
+	109, // 114: cerbos.private.v1.ServerTestCase.AdminCheckWithPoliciesCall.input:type_name -> cerbos.request.v1.CheckWithPoliciesRequest
+	110, // 115: cerbos.private.v1.ServerTestCase.AdminCheckWithPoliciesCall.want_response:type_name -> cerbos.response.v1.CheckWithPoliciesResponse
+	111, // 116: cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall.input:type_name -> cerbos.request.v1.AddOrUpdateSchemaRequest
+	112, // 117: cerbos.private.v1.ServerTestCase.AdminAddOrUpdateSchemaCall.want_response:type_name -> cerbos.response.v1.AddOrUpdateSchemaResponse
+	47,  // 118: cerbos.private.v1.CompileTestCase.Variables.derived_roles:type_name -> cerbos.private.v1.CompileTestCase.Variables.DerivedRole
+	83,  // 119: cerbos.private.v1.AttrWrapper.AttrEntry.value:type_name -> google.protobuf.Value
+	67,  // 120: cerbos.private.v1.QueryPlannerTestSuite.Test.want:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	113, // 121: cerbos.private.v1.QueryPlannerTestSuite.Test.resource:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
+	53,  // 122: cerbos.private.v1.ProtoYamlTestCase.Want.message:type_name -> cerbos.policy.v1.Policy
+	69,  // 123: cerbos.private.v1.ProtoYamlTestCase.Want.errors:type_name -> cerbos.source.v1.Error
+	83,  // 124: cerbos.private.v1.WellKnownTypes.Nested.value_field:type_name -> google.protobuf.Value
+	125, // [125:125] is the sub-list for method output_type
+	125, // [125:125] is the sub-list for method input_type
+	125, // [125:125] is the sub-list for extension type_name
+	125, // [125:125] is the sub-list for extension extendee
+	0,   // [0:125] is the sub-list for field type_name
 }
 
 func init() { file_cerbos_private_v1_test_proto_init() }
@@ -3617,6 +3862,9 @@ func file_cerbos_private_v1_test_proto_init() {
 		(*ServerTestCase_AdminAddOrUpdateSchema)(nil),
 		(*ServerTestCase_PlaygroundTest)(nil),
 		(*ServerTestCase_CheckResources)(nil),
+		(*ServerTestCase_ExplainCheck)(nil),
+		(*ServerTestCase_AdminCheckWithPolicies)(nil),
+		(*ServerTestCase_PlanPrincipals)(nil),
 	}
 	file_cerbos_private_v1_test_proto_msgTypes[7].OneofWrappers = []any{
 		(*SchemaTestCase_CheckInput)(nil),
@@ -3640,7 +3888,7 @@ func file_cerbos_private_v1_test_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cerbos_private_v1_test_proto_rawDesc), len(file_cerbos_private_v1_test_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   50,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -160,6 +160,22 @@ func (m *ServerTestCase_CheckResourcesCall) HashPB(hasher hash.Hash, ignore map[
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *ServerTestCase_PlanPrincipalsCall) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_private_v1_ServerTestCase_PlanPrincipalsCall_hashpb_sum(m, hasher, ignore)
+	}
+}
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *ServerTestCase_ExplainCheckCall) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_private_v1_ServerTestCase_ExplainCheckCall_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *ServerTestCase_PlaygroundValidateCall) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -200,6 +216,14 @@ func (m *ServerTestCase_AdminAddOrUpdatePolicyCall) HashPB(hasher hash.Hash, ign
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *ServerTestCase_AdminCheckWithPoliciesCall) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_private_v1_ServerTestCase_AdminCheckWithPoliciesCall_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *ServerTestCase_AdminAddOrUpdateSchemaCall) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -19,6 +19,26 @@ import (
 	unsafe "unsafe"
 )
 
+func cerbos_engine_v1_PlanPrincipalsInput_Principal_hashpb_sum(m *v1.PlanPrincipalsInput_Principal, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.Principal.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanPrincipalsInput.Principal.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+}
+
+func cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m *v1.PlanResourcesInput_FilterOptions, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.simplify"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSimplify())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.normal_form"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetNormalForm())))
+	}
+}
+
 func cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m *v1.PlanResourcesInput_Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKind()))))
@@ -400,6 +420,12 @@ func cerbos_policy_v1_PrincipalPolicy_hashpb_sum(m *v11.PrincipalPolicy, hasher
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_PrincipalRule_Action_hashpb_sum(m *v11.PrincipalRule_Action, hasher hash.Hash, ignore map[string]struct{}) {
@@ -490,6 +516,12 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v11.ResourcePolicy, hasher ha
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v11.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -858,6 +890,44 @@ func cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m *CheckResourcesRequest
 			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesRequest.include_explanation"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetIncludeExplanation())))
+	}
+}
+
+func cerbos_request_v1_CheckWithPoliciesRequest_hashpb_sum(m *CheckWithPoliciesRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.policies"]; !ok {
+		if len(m.Policies) > 0 {
+			for _, v := range m.Policies {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.principal"]; !ok {
+		if m.GetPrincipal() != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.GetPrincipal(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_request_v1_CheckResourcesRequest_ResourceEntry_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.CheckWithPoliciesRequest.aux_data"]; !ok {
+		if m.GetAuxData() != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_request_v1_DeleteSchemaRequest_hashpb_sum(m *DeleteSchemaRequest, hasher hash.Hash, ignore map[string]struct{}) {
@@ -893,6 +963,32 @@ func cerbos_request_v1_EnablePolicyRequest_hashpb_sum(m *EnablePolicyRequest, ha
 	}
 }
 
+func cerbos_request_v1_ExplainCheckRequest_hashpb_sum(m *ExplainCheckRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.principal"]; !ok {
+		if m.GetPrincipal() != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.GetPrincipal(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_request_v1_CheckResourcesRequest_ResourceEntry_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.ExplainCheckRequest.aux_data"]; !ok {
+		if m.GetAuxData() != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_request_v1_File_hashpb_sum(m *File, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.File.file_name"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetFileName()))))
@@ -1019,6 +1115,126 @@ func cerbos_request_v1_ListPoliciesRequest_hashpb_sum(m *ListPoliciesRequest, ha
 func cerbos_request_v1_ListSchemasRequest_hashpb_sum(m *ListSchemasRequest, hasher hash.Hash, ignore map[string]struct{}) {
 }
 
+func cerbos_request_v1_PlanPrincipalsRequest_hashpb_sum(m *PlanPrincipalsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.resource"]; !ok {
+		if m.GetResource() != nil {
+			cerbos_engine_v1_Resource_hashpb_sum(m.GetResource(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.principal"]; !ok {
+		if m.GetPrincipal() != nil {
+			cerbos_engine_v1_PlanPrincipalsInput_Principal_hashpb_sum(m.GetPrincipal(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.aux_data"]; !ok {
+		if m.GetAuxData() != nil {
+			cerbos_request_v1_AuxData_hashpb_sum(m.GetAuxData(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanPrincipalsRequest.include_meta"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetIncludeMeta())))
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Document_hashpb_sum(m *PlanResourcesRequest_Document, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Document.format"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetFormat())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Document.fields"]; !ok {
+		if len(m.Fields) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Fields)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.Fields[k]))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.Fields[k]), len(m.Fields[k])))
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JoinTable_hashpb_sum(m *PlanResourcesRequest_Sql_Mapping_JoinTable, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.table"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetTable()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetTable()), len(m.GetTable())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.key"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKey()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetKey()), len(m.GetKey())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.reference"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetReference()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetReference()), len(m.GetReference())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable.value"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetValue()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetValue()), len(m.GetValue())))
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JsonPath_hashpb_sum(m *PlanResourcesRequest_Sql_Mapping_JsonPath, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JsonPath.column"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetColumn()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetColumn()), len(m.GetColumn())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JsonPath.path"]; !ok {
+		if len(m.Path) > 0 {
+			for _, v := range m.Path {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_hashpb_sum(m *PlanResourcesRequest_Sql_Mapping, hasher hash.Hash, ignore map[string]struct{}) {
+	if m.Mapping != nil {
+		if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.mapping"]; !ok {
+			switch t := m.Mapping.(type) {
+			case *PlanResourcesRequest_Sql_Mapping_Column:
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(t.Column))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(t.Column), len(t.Column)))
+			case *PlanResourcesRequest_Sql_Mapping_Json:
+				if t.Json != nil {
+					cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JsonPath_hashpb_sum(t.Json, hasher, ignore)
+				}
+			case *PlanResourcesRequest_Sql_Mapping_Join:
+				if t.Join != nil {
+					cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_JoinTable_hashpb_sum(t.Join, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlanResourcesRequest_Sql_hashpb_sum(m *PlanResourcesRequest_Sql, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.dialect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetDialect())))
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.Sql.attributes"]; !ok {
+		if len(m.Attributes) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Attributes)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Attributes[k] != nil {
+					cerbos_request_v1_PlanResourcesRequest_Sql_Mapping_hashpb_sum(m.Attributes[k], hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *PlanResourcesRequest, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.request_id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
@@ -1054,6 +1270,30 @@ func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *PlanResourcesRequest,
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.sql"]; !ok {
+		if m.GetSql() != nil {
+			cerbos_request_v1_PlanResourcesRequest_Sql_hashpb_sum(m.GetSql(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.document"]; !ok {
+		if m.GetDocument() != nil {
+			cerbos_request_v1_PlanResourcesRequest_Document_hashpb_sum(m.GetDocument(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_request_v1_PlaygroundEvaluateRequest_hashpb_sum(m *PlaygroundEvaluateRequest, hasher hash.Hash, ignore map[string]struct{}) {
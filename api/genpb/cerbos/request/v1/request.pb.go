@@ -33,6 +33,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlanResourcesRequest_Sql_Dialect int32
+
+const (
+	PlanResourcesRequest_Sql_DIALECT_UNSPECIFIED PlanResourcesRequest_Sql_Dialect = 0
+	PlanResourcesRequest_Sql_DIALECT_POSTGRES    PlanResourcesRequest_Sql_Dialect = 1
+	PlanResourcesRequest_Sql_DIALECT_MYSQL       PlanResourcesRequest_Sql_Dialect = 2
+	PlanResourcesRequest_Sql_DIALECT_SQLITE      PlanResourcesRequest_Sql_Dialect = 3
+)
+
+// Enum value maps for PlanResourcesRequest_Sql_Dialect.
+var (
+	PlanResourcesRequest_Sql_Dialect_name = map[int32]string{
+		0: "DIALECT_UNSPECIFIED",
+		1: "DIALECT_POSTGRES",
+		2: "DIALECT_MYSQL",
+		3: "DIALECT_SQLITE",
+	}
+	PlanResourcesRequest_Sql_Dialect_value = map[string]int32{
+		"DIALECT_UNSPECIFIED": 0,
+		"DIALECT_POSTGRES":    1,
+		"DIALECT_MYSQL":       2,
+		"DIALECT_SQLITE":      3,
+	}
+)
+
+func (x PlanResourcesRequest_Sql_Dialect) Enum() *PlanResourcesRequest_Sql_Dialect {
+	p := new(PlanResourcesRequest_Sql_Dialect)
+	*p = x
+	return p
+}
+
+func (x PlanResourcesRequest_Sql_Dialect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanResourcesRequest_Sql_Dialect) Descriptor() protoreflect.EnumDescriptor {
+	return file_cerbos_request_v1_request_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanResourcesRequest_Sql_Dialect) Type() protoreflect.EnumType {
+	return &file_cerbos_request_v1_request_proto_enumTypes[0]
+}
+
+func (x PlanResourcesRequest_Sql_Dialect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanResourcesRequest_Sql_Dialect.Descriptor instead.
+func (PlanResourcesRequest_Sql_Dialect) EnumDescriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+type PlanResourcesRequest_Document_Format int32
+
+const (
+	PlanResourcesRequest_Document_FORMAT_UNSPECIFIED   PlanResourcesRequest_Document_Format = 0
+	PlanResourcesRequest_Document_FORMAT_MONGODB       PlanResourcesRequest_Document_Format = 1
+	PlanResourcesRequest_Document_FORMAT_ELASTICSEARCH PlanResourcesRequest_Document_Format = 2
+)
+
+// Enum value maps for PlanResourcesRequest_Document_Format.
+var (
+	PlanResourcesRequest_Document_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_MONGODB",
+		2: "FORMAT_ELASTICSEARCH",
+	}
+	PlanResourcesRequest_Document_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED":   0,
+		"FORMAT_MONGODB":       1,
+		"FORMAT_ELASTICSEARCH": 2,
+	}
+)
+
+func (x PlanResourcesRequest_Document_Format) Enum() *PlanResourcesRequest_Document_Format {
+	p := new(PlanResourcesRequest_Document_Format)
+	*p = x
+	return p
+}
+
+func (x PlanResourcesRequest_Document_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanResourcesRequest_Document_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_cerbos_request_v1_request_proto_enumTypes[1].Descriptor()
+}
+
+func (PlanResourcesRequest_Document_Format) Type() protoreflect.EnumType {
+	return &file_cerbos_request_v1_request_proto_enumTypes[1]
+}
+
+func (x PlanResourcesRequest_Document_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanResourcesRequest_Document_Format.Descriptor instead.
+func (PlanResourcesRequest_Document_Format) EnumDescriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
 type ListAuditLogEntriesRequest_Kind int32
 
 const (
@@ -66,11 +167,11 @@ func (x ListAuditLogEntriesRequest_Kind) String() string {
 }
 
 func (ListAuditLogEntriesRequest_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_cerbos_request_v1_request_proto_enumTypes[0].Descriptor()
+	return file_cerbos_request_v1_request_proto_enumTypes[2].Descriptor()
 }
 
 func (ListAuditLogEntriesRequest_Kind) Type() protoreflect.EnumType {
-	return &file_cerbos_request_v1_request_proto_enumTypes[0]
+	return &file_cerbos_request_v1_request_proto_enumTypes[2]
 }
 
 func (x ListAuditLogEntriesRequest_Kind) Number() protoreflect.EnumNumber {
@@ -79,19 +180,23 @@ func (x ListAuditLogEntriesRequest_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListAuditLogEntriesRequest_Kind.Descriptor instead.
 func (ListAuditLogEntriesRequest_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{13, 0}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{16, 0}
 }
 
 type PlanResourcesRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Deprecated: Marked as deprecated in cerbos/request/v1/request.proto.
-	Action        string                          `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Actions       []string                        `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
-	Principal     *v1.Principal                   `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
-	Resource      *v1.PlanResourcesInput_Resource `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
-	AuxData       *AuxData                        `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
-	IncludeMeta   bool                            `protobuf:"varint,6,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
+	Action        string                               `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Actions       []string                             `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
+	Principal     *v1.Principal                        `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Resource      *v1.PlanResourcesInput_Resource      `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	Resources     []*v1.PlanResourcesInput_Resource    `protobuf:"bytes,10,rep,name=resources,proto3" json:"resources,omitempty"`
+	AuxData       *AuxData                             `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	IncludeMeta   bool                                 `protobuf:"varint,6,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
+	Sql           *PlanResourcesRequest_Sql            `protobuf:"bytes,8,opt,name=sql,proto3" json:"sql,omitempty"`
+	Document      *PlanResourcesRequest_Document       `protobuf:"bytes,9,opt,name=document,proto3" json:"document,omitempty"`
+	FilterOptions *v1.PlanResourcesInput_FilterOptions `protobuf:"bytes,11,opt,name=filter_options,json=filterOptions,proto3" json:"filter_options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,6 +267,13 @@ func (x *PlanResourcesRequest) GetResource() *v1.PlanResourcesInput_Resource {
 	return nil
 }
 
+func (x *PlanResourcesRequest) GetResources() []*v1.PlanResourcesInput_Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
 func (x *PlanResourcesRequest) GetAuxData() *AuxData {
 	if x != nil {
 		return x.AuxData
@@ -176,6 +288,111 @@ func (x *PlanResourcesRequest) GetIncludeMeta() bool {
 	return false
 }
 
+func (x *PlanResourcesRequest) GetSql() *PlanResourcesRequest_Sql {
+	if x != nil {
+		return x.Sql
+	}
+	return nil
+}
+
+func (x *PlanResourcesRequest) GetDocument() *PlanResourcesRequest_Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *PlanResourcesRequest) GetFilterOptions() *v1.PlanResourcesInput_FilterOptions {
+	if x != nil {
+		return x.FilterOptions
+	}
+	return nil
+}
+
+type PlanPrincipalsRequest struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	RequestId     string                            `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Actions       []string                          `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	Resource      *v1.Resource                      `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Principal     *v1.PlanPrincipalsInput_Principal `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	AuxData       *AuxData                          `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	IncludeMeta   bool                              `protobuf:"varint,6,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPrincipalsRequest) Reset() {
+	*x = PlanPrincipalsRequest{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPrincipalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPrincipalsRequest) ProtoMessage() {}
+
+func (x *PlanPrincipalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPrincipalsRequest.ProtoReflect.Descriptor instead.
+func (*PlanPrincipalsRequest) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlanPrincipalsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsRequest) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsRequest) GetResource() *v1.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsRequest) GetPrincipal() *v1.PlanPrincipalsInput_Principal {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsRequest) GetAuxData() *AuxData {
+	if x != nil {
+		return x.AuxData
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsRequest) GetIncludeMeta() bool {
+	if x != nil {
+		return x.IncludeMeta
+	}
+	return false
+}
+
 // Deprecated. See CheckResourcesRequest.
 type CheckResourceSetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -191,7 +408,7 @@ type CheckResourceSetRequest struct {
 
 func (x *CheckResourceSetRequest) Reset() {
 	*x = CheckResourceSetRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[1]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -203,7 +420,7 @@ func (x *CheckResourceSetRequest) String() string {
 func (*CheckResourceSetRequest) ProtoMessage() {}
 
 func (x *CheckResourceSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[1]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,7 +433,7 @@ func (x *CheckResourceSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResourceSetRequest.ProtoReflect.Descriptor instead.
 func (*CheckResourceSetRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{1}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CheckResourceSetRequest) GetRequestId() string {
@@ -273,7 +490,7 @@ type ResourceSet struct {
 
 func (x *ResourceSet) Reset() {
 	*x = ResourceSet{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[2]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +502,7 @@ func (x *ResourceSet) String() string {
 func (*ResourceSet) ProtoMessage() {}
 
 func (x *ResourceSet) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[2]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +515,7 @@ func (x *ResourceSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceSet.ProtoReflect.Descriptor instead.
 func (*ResourceSet) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{2}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ResourceSet) GetKind() string {
@@ -338,7 +555,7 @@ type AttributesMap struct {
 
 func (x *AttributesMap) Reset() {
 	*x = AttributesMap{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[3]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +567,7 @@ func (x *AttributesMap) String() string {
 func (*AttributesMap) ProtoMessage() {}
 
 func (x *AttributesMap) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[3]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +580,7 @@ func (x *AttributesMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributesMap.ProtoReflect.Descriptor instead.
 func (*AttributesMap) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{3}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AttributesMap) GetAttr() map[string]*structpb.Value {
@@ -386,7 +603,7 @@ type CheckResourceBatchRequest struct {
 
 func (x *CheckResourceBatchRequest) Reset() {
 	*x = CheckResourceBatchRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[4]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +615,7 @@ func (x *CheckResourceBatchRequest) String() string {
 func (*CheckResourceBatchRequest) ProtoMessage() {}
 
 func (x *CheckResourceBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[4]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +628,7 @@ func (x *CheckResourceBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResourceBatchRequest.ProtoReflect.Descriptor instead.
 func (*CheckResourceBatchRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{4}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CheckResourceBatchRequest) GetRequestId() string {
@@ -444,19 +661,20 @@ func (x *CheckResourceBatchRequest) GetAuxData() *AuxData {
 
 // Structure of the request for the check resources API call.
 type CheckResourcesRequest struct {
-	state         protoimpl.MessageState                 `protogen:"open.v1"`
-	RequestId     string                                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	IncludeMeta   bool                                   `protobuf:"varint,2,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
-	Principal     *v1.Principal                          `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
-	Resources     []*CheckResourcesRequest_ResourceEntry `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
-	AuxData       *AuxData                               `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState                 `protogen:"open.v1"`
+	RequestId          string                                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	IncludeMeta        bool                                   `protobuf:"varint,2,opt,name=include_meta,json=includeMeta,proto3" json:"include_meta,omitempty"`
+	Principal          *v1.Principal                          `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Resources          []*CheckResourcesRequest_ResourceEntry `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
+	AuxData            *AuxData                               `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	IncludeExplanation bool                                   `protobuf:"varint,6,opt,name=include_explanation,json=includeExplanation,proto3" json:"include_explanation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CheckResourcesRequest) Reset() {
 	*x = CheckResourcesRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[5]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +686,7 @@ func (x *CheckResourcesRequest) String() string {
 func (*CheckResourcesRequest) ProtoMessage() {}
 
 func (x *CheckResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[5]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +699,7 @@ func (x *CheckResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResourcesRequest.ProtoReflect.Descriptor instead.
 func (*CheckResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{5}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CheckResourcesRequest) GetRequestId() string {
@@ -519,6 +737,81 @@ func (x *CheckResourcesRequest) GetAuxData() *AuxData {
 	return nil
 }
 
+func (x *CheckResourcesRequest) GetIncludeExplanation() bool {
+	if x != nil {
+		return x.IncludeExplanation
+	}
+	return false
+}
+
+type ExplainCheckRequest struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	RequestId     string                                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Principal     *v1.Principal                          `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	Resources     []*CheckResourcesRequest_ResourceEntry `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	AuxData       *AuxData                               `protobuf:"bytes,4,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainCheckRequest) Reset() {
+	*x = ExplainCheckRequest{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainCheckRequest) ProtoMessage() {}
+
+func (x *ExplainCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainCheckRequest.ProtoReflect.Descriptor instead.
+func (*ExplainCheckRequest) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExplainCheckRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ExplainCheckRequest) GetPrincipal() *v1.Principal {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *ExplainCheckRequest) GetResources() []*CheckResourcesRequest_ResourceEntry {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ExplainCheckRequest) GetAuxData() *AuxData {
+	if x != nil {
+		return x.AuxData
+	}
+	return nil
+}
+
 type AuxData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Jwt           *AuxData_JWT           `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
@@ -528,7 +821,7 @@ type AuxData struct {
 
 func (x *AuxData) Reset() {
 	*x = AuxData{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[6]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +833,7 @@ func (x *AuxData) String() string {
 func (*AuxData) ProtoMessage() {}
 
 func (x *AuxData) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[6]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +846,7 @@ func (x *AuxData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuxData.ProtoReflect.Descriptor instead.
 func (*AuxData) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{6}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AuxData) GetJwt() *AuxData_JWT {
@@ -573,7 +866,7 @@ type File struct {
 
 func (x *File) Reset() {
 	*x = File{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[7]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -585,7 +878,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[7]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +891,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{7}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *File) GetFileName() string {
@@ -625,7 +918,7 @@ type PlaygroundValidateRequest struct {
 
 func (x *PlaygroundValidateRequest) Reset() {
 	*x = PlaygroundValidateRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[8]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +930,7 @@ func (x *PlaygroundValidateRequest) String() string {
 func (*PlaygroundValidateRequest) ProtoMessage() {}
 
 func (x *PlaygroundValidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[8]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +943,7 @@ func (x *PlaygroundValidateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundValidateRequest.ProtoReflect.Descriptor instead.
 func (*PlaygroundValidateRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{8}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PlaygroundValidateRequest) GetPlaygroundId() string {
@@ -677,7 +970,7 @@ type PlaygroundTestRequest struct {
 
 func (x *PlaygroundTestRequest) Reset() {
 	*x = PlaygroundTestRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[9]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +982,7 @@ func (x *PlaygroundTestRequest) String() string {
 func (*PlaygroundTestRequest) ProtoMessage() {}
 
 func (x *PlaygroundTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[9]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +995,7 @@ func (x *PlaygroundTestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundTestRequest.ProtoReflect.Descriptor instead.
 func (*PlaygroundTestRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{9}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PlaygroundTestRequest) GetPlaygroundId() string {
@@ -733,7 +1026,7 @@ type PlaygroundEvaluateRequest struct {
 
 func (x *PlaygroundEvaluateRequest) Reset() {
 	*x = PlaygroundEvaluateRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[10]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +1038,7 @@ func (x *PlaygroundEvaluateRequest) String() string {
 func (*PlaygroundEvaluateRequest) ProtoMessage() {}
 
 func (x *PlaygroundEvaluateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[10]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +1051,7 @@ func (x *PlaygroundEvaluateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundEvaluateRequest.ProtoReflect.Descriptor instead.
 func (*PlaygroundEvaluateRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{10}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PlaygroundEvaluateRequest) GetPlaygroundId() string {
@@ -820,7 +1113,7 @@ type PlaygroundProxyRequest struct {
 
 func (x *PlaygroundProxyRequest) Reset() {
 	*x = PlaygroundProxyRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[11]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -832,7 +1125,7 @@ func (x *PlaygroundProxyRequest) String() string {
 func (*PlaygroundProxyRequest) ProtoMessage() {}
 
 func (x *PlaygroundProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[11]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,7 +1138,7 @@ func (x *PlaygroundProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundProxyRequest.ProtoReflect.Descriptor instead.
 func (*PlaygroundProxyRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{11}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PlaygroundProxyRequest) GetPlaygroundId() string {
@@ -942,7 +1235,7 @@ type AddOrUpdatePolicyRequest struct {
 
 func (x *AddOrUpdatePolicyRequest) Reset() {
 	*x = AddOrUpdatePolicyRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[12]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -954,7 +1247,7 @@ func (x *AddOrUpdatePolicyRequest) String() string {
 func (*AddOrUpdatePolicyRequest) ProtoMessage() {}
 
 func (x *AddOrUpdatePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[12]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,7 +1260,7 @@ func (x *AddOrUpdatePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddOrUpdatePolicyRequest.ProtoReflect.Descriptor instead.
 func (*AddOrUpdatePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{12}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddOrUpdatePolicyRequest) GetPolicies() []*v11.Policy {
@@ -977,6 +1270,82 @@ func (x *AddOrUpdatePolicyRequest) GetPolicies() []*v11.Policy {
 	return nil
 }
 
+type CheckWithPoliciesRequest struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	RequestId     string                                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Policies      []*v11.Policy                          `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	Principal     *v1.Principal                          `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Resources     []*CheckResourcesRequest_ResourceEntry `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
+	AuxData       *AuxData                               `protobuf:"bytes,5,opt,name=aux_data,json=auxData,proto3" json:"aux_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckWithPoliciesRequest) Reset() {
+	*x = CheckWithPoliciesRequest{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckWithPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckWithPoliciesRequest) ProtoMessage() {}
+
+func (x *CheckWithPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckWithPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*CheckWithPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckWithPoliciesRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CheckWithPoliciesRequest) GetPolicies() []*v11.Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *CheckWithPoliciesRequest) GetPrincipal() *v1.Principal {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *CheckWithPoliciesRequest) GetResources() []*CheckResourcesRequest_ResourceEntry {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *CheckWithPoliciesRequest) GetAuxData() *AuxData {
+	if x != nil {
+		return x.AuxData
+	}
+	return nil
+}
+
 type ListAuditLogEntriesRequest struct {
 	state protoimpl.MessageState          `protogen:"open.v1"`
 	Kind  ListAuditLogEntriesRequest_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=cerbos.request.v1.ListAuditLogEntriesRequest_Kind" json:"kind,omitempty"`
@@ -993,7 +1362,7 @@ type ListAuditLogEntriesRequest struct {
 
 func (x *ListAuditLogEntriesRequest) Reset() {
 	*x = ListAuditLogEntriesRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[13]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +1374,7 @@ func (x *ListAuditLogEntriesRequest) String() string {
 func (*ListAuditLogEntriesRequest) ProtoMessage() {}
 
 func (x *ListAuditLogEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[13]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1387,7 @@ func (x *ListAuditLogEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditLogEntriesRequest.ProtoReflect.Descriptor instead.
 func (*ListAuditLogEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{13}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListAuditLogEntriesRequest) GetKind() ListAuditLogEntriesRequest_Kind {
@@ -1107,7 +1476,7 @@ type ServerInfoRequest struct {
 
 func (x *ServerInfoRequest) Reset() {
 	*x = ServerInfoRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[14]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1119,7 +1488,7 @@ func (x *ServerInfoRequest) String() string {
 func (*ServerInfoRequest) ProtoMessage() {}
 
 func (x *ServerInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[14]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +1501,7 @@ func (x *ServerInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfoRequest.ProtoReflect.Descriptor instead.
 func (*ServerInfoRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{14}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{17}
 }
 
 type ListPoliciesRequest struct {
@@ -1148,7 +1517,7 @@ type ListPoliciesRequest struct {
 
 func (x *ListPoliciesRequest) Reset() {
 	*x = ListPoliciesRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[15]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1160,7 +1529,7 @@ func (x *ListPoliciesRequest) String() string {
 func (*ListPoliciesRequest) ProtoMessage() {}
 
 func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[15]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,7 +1542,7 @@ func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPoliciesRequest.ProtoReflect.Descriptor instead.
 func (*ListPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{15}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListPoliciesRequest) GetIncludeDisabled() bool {
@@ -1220,7 +1589,7 @@ type GetPolicyRequest struct {
 
 func (x *GetPolicyRequest) Reset() {
 	*x = GetPolicyRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[16]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1232,7 +1601,7 @@ func (x *GetPolicyRequest) String() string {
 func (*GetPolicyRequest) ProtoMessage() {}
 
 func (x *GetPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[16]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,7 +1614,7 @@ func (x *GetPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyRequest.ProtoReflect.Descriptor instead.
 func (*GetPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{16}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetPolicyRequest) GetId() []string {
@@ -1264,7 +1633,7 @@ type DisablePolicyRequest struct {
 
 func (x *DisablePolicyRequest) Reset() {
 	*x = DisablePolicyRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[17]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1276,7 +1645,7 @@ func (x *DisablePolicyRequest) String() string {
 func (*DisablePolicyRequest) ProtoMessage() {}
 
 func (x *DisablePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[17]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,7 +1658,7 @@ func (x *DisablePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisablePolicyRequest.ProtoReflect.Descriptor instead.
 func (*DisablePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{17}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DisablePolicyRequest) GetId() []string {
@@ -1308,7 +1677,7 @@ type EnablePolicyRequest struct {
 
 func (x *EnablePolicyRequest) Reset() {
 	*x = EnablePolicyRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[18]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1320,7 +1689,7 @@ func (x *EnablePolicyRequest) String() string {
 func (*EnablePolicyRequest) ProtoMessage() {}
 
 func (x *EnablePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[18]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +1702,7 @@ func (x *EnablePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnablePolicyRequest.ProtoReflect.Descriptor instead.
 func (*EnablePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{18}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EnablePolicyRequest) GetId() []string {
@@ -1356,7 +1725,7 @@ type InspectPoliciesRequest struct {
 
 func (x *InspectPoliciesRequest) Reset() {
 	*x = InspectPoliciesRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[19]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1368,7 +1737,7 @@ func (x *InspectPoliciesRequest) String() string {
 func (*InspectPoliciesRequest) ProtoMessage() {}
 
 func (x *InspectPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[19]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,7 +1750,7 @@ func (x *InspectPoliciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectPoliciesRequest.ProtoReflect.Descriptor instead.
 func (*InspectPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{19}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *InspectPoliciesRequest) GetIncludeDisabled() bool {
@@ -1428,7 +1797,7 @@ type AddOrUpdateSchemaRequest struct {
 
 func (x *AddOrUpdateSchemaRequest) Reset() {
 	*x = AddOrUpdateSchemaRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[20]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1440,7 +1809,7 @@ func (x *AddOrUpdateSchemaRequest) String() string {
 func (*AddOrUpdateSchemaRequest) ProtoMessage() {}
 
 func (x *AddOrUpdateSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[20]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,7 +1822,7 @@ func (x *AddOrUpdateSchemaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddOrUpdateSchemaRequest.ProtoReflect.Descriptor instead.
 func (*AddOrUpdateSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{20}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddOrUpdateSchemaRequest) GetSchemas() []*v12.Schema {
@@ -1471,7 +1840,7 @@ type ListSchemasRequest struct {
 
 func (x *ListSchemasRequest) Reset() {
 	*x = ListSchemasRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[21]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1483,7 +1852,7 @@ func (x *ListSchemasRequest) String() string {
 func (*ListSchemasRequest) ProtoMessage() {}
 
 func (x *ListSchemasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[21]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,7 +1865,7 @@ func (x *ListSchemasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchemasRequest.ProtoReflect.Descriptor instead.
 func (*ListSchemasRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{21}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{24}
 }
 
 type GetSchemaRequest struct {
@@ -1508,7 +1877,7 @@ type GetSchemaRequest struct {
 
 func (x *GetSchemaRequest) Reset() {
 	*x = GetSchemaRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[22]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1520,7 +1889,7 @@ func (x *GetSchemaRequest) String() string {
 func (*GetSchemaRequest) ProtoMessage() {}
 
 func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[22]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,7 +1902,7 @@ func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
 func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{22}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetSchemaRequest) GetId() []string {
@@ -1552,7 +1921,7 @@ type DeleteSchemaRequest struct {
 
 func (x *DeleteSchemaRequest) Reset() {
 	*x = DeleteSchemaRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[23]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1564,7 +1933,7 @@ func (x *DeleteSchemaRequest) String() string {
 func (*DeleteSchemaRequest) ProtoMessage() {}
 
 func (x *DeleteSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[23]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,7 +1946,7 @@ func (x *DeleteSchemaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSchemaRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{23}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteSchemaRequest) GetId() []string {
@@ -1596,7 +1965,7 @@ type ReloadStoreRequest struct {
 
 func (x *ReloadStoreRequest) Reset() {
 	*x = ReloadStoreRequest{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[24]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1608,7 +1977,7 @@ func (x *ReloadStoreRequest) String() string {
 func (*ReloadStoreRequest) ProtoMessage() {}
 
 func (x *ReloadStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[24]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1621,7 +1990,7 @@ func (x *ReloadStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReloadStoreRequest.ProtoReflect.Descriptor instead.
 func (*ReloadStoreRequest) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{24}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReloadStoreRequest) GetWait() bool {
@@ -1631,6 +2000,328 @@ func (x *ReloadStoreRequest) GetWait() bool {
 	return false
 }
 
+type PlanResourcesRequest_Sql struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Dialect       PlanResourcesRequest_Sql_Dialect             `protobuf:"varint,1,opt,name=dialect,proto3,enum=cerbos.request.v1.PlanResourcesRequest_Sql_Dialect" json:"dialect,omitempty"`
+	Attributes    map[string]*PlanResourcesRequest_Sql_Mapping `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourcesRequest_Sql) Reset() {
+	*x = PlanResourcesRequest_Sql{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourcesRequest_Sql) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourcesRequest_Sql) ProtoMessage() {}
+
+func (x *PlanResourcesRequest_Sql) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourcesRequest_Sql.ProtoReflect.Descriptor instead.
+func (*PlanResourcesRequest_Sql) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *PlanResourcesRequest_Sql) GetDialect() PlanResourcesRequest_Sql_Dialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return PlanResourcesRequest_Sql_DIALECT_UNSPECIFIED
+}
+
+func (x *PlanResourcesRequest_Sql) GetAttributes() map[string]*PlanResourcesRequest_Sql_Mapping {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type PlanResourcesRequest_Document struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Format        PlanResourcesRequest_Document_Format `protobuf:"varint,1,opt,name=format,proto3,enum=cerbos.request.v1.PlanResourcesRequest_Document_Format" json:"format,omitempty"`
+	Fields        map[string]string                    `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourcesRequest_Document) Reset() {
+	*x = PlanResourcesRequest_Document{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourcesRequest_Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourcesRequest_Document) ProtoMessage() {}
+
+func (x *PlanResourcesRequest_Document) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourcesRequest_Document.ProtoReflect.Descriptor instead.
+func (*PlanResourcesRequest_Document) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *PlanResourcesRequest_Document) GetFormat() PlanResourcesRequest_Document_Format {
+	if x != nil {
+		return x.Format
+	}
+	return PlanResourcesRequest_Document_FORMAT_UNSPECIFIED
+}
+
+func (x *PlanResourcesRequest_Document) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type PlanResourcesRequest_Sql_Mapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Mapping:
+	//
+	//	*PlanResourcesRequest_Sql_Mapping_Column
+	//	*PlanResourcesRequest_Sql_Mapping_Json
+	//	*PlanResourcesRequest_Sql_Mapping_Join
+	Mapping       isPlanResourcesRequest_Sql_Mapping_Mapping `protobuf_oneof:"mapping"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping) Reset() {
+	*x = PlanResourcesRequest_Sql_Mapping{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourcesRequest_Sql_Mapping) ProtoMessage() {}
+
+func (x *PlanResourcesRequest_Sql_Mapping) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourcesRequest_Sql_Mapping.ProtoReflect.Descriptor instead.
+func (*PlanResourcesRequest_Sql_Mapping) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping) GetMapping() isPlanResourcesRequest_Sql_Mapping_Mapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping) GetColumn() string {
+	if x != nil {
+		if x, ok := x.Mapping.(*PlanResourcesRequest_Sql_Mapping_Column); ok {
+			return x.Column
+		}
+	}
+	return ""
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping) GetJson() *PlanResourcesRequest_Sql_Mapping_JsonPath {
+	if x != nil {
+		if x, ok := x.Mapping.(*PlanResourcesRequest_Sql_Mapping_Json); ok {
+			return x.Json
+		}
+	}
+	return nil
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping) GetJoin() *PlanResourcesRequest_Sql_Mapping_JoinTable {
+	if x != nil {
+		if x, ok := x.Mapping.(*PlanResourcesRequest_Sql_Mapping_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+type isPlanResourcesRequest_Sql_Mapping_Mapping interface {
+	isPlanResourcesRequest_Sql_Mapping_Mapping()
+}
+
+type PlanResourcesRequest_Sql_Mapping_Column struct {
+	Column string `protobuf:"bytes,1,opt,name=column,proto3,oneof"`
+}
+
+type PlanResourcesRequest_Sql_Mapping_Json struct {
+	Json *PlanResourcesRequest_Sql_Mapping_JsonPath `protobuf:"bytes,2,opt,name=json,proto3,oneof"`
+}
+
+type PlanResourcesRequest_Sql_Mapping_Join struct {
+	Join *PlanResourcesRequest_Sql_Mapping_JoinTable `protobuf:"bytes,3,opt,name=join,proto3,oneof"`
+}
+
+func (*PlanResourcesRequest_Sql_Mapping_Column) isPlanResourcesRequest_Sql_Mapping_Mapping() {}
+
+func (*PlanResourcesRequest_Sql_Mapping_Json) isPlanResourcesRequest_Sql_Mapping_Mapping() {}
+
+func (*PlanResourcesRequest_Sql_Mapping_Join) isPlanResourcesRequest_Sql_Mapping_Mapping() {}
+
+type PlanResourcesRequest_Sql_Mapping_JsonPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        string                 `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Path          []string               `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JsonPath) Reset() {
+	*x = PlanResourcesRequest_Sql_Mapping_JsonPath{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JsonPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourcesRequest_Sql_Mapping_JsonPath) ProtoMessage() {}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JsonPath) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourcesRequest_Sql_Mapping_JsonPath.ProtoReflect.Descriptor instead.
+func (*PlanResourcesRequest_Sql_Mapping_JsonPath) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JsonPath) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JsonPath) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type PlanResourcesRequest_Sql_Mapping_JoinTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JoinTable) Reset() {
+	*x = PlanResourcesRequest_Sql_Mapping_JoinTable{}
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JoinTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourcesRequest_Sql_Mapping_JoinTable) ProtoMessage() {}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JoinTable) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourcesRequest_Sql_Mapping_JoinTable.ProtoReflect.Descriptor instead.
+func (*PlanResourcesRequest_Sql_Mapping_JoinTable) Descriptor() ([]byte, []int) {
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{0, 0, 0, 1}
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JoinTable) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JoinTable) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JoinTable) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PlanResourcesRequest_Sql_Mapping_JoinTable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type CheckResourceBatchRequest_BatchEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Actions       []string               `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
@@ -1641,7 +2332,7 @@ type CheckResourceBatchRequest_BatchEntry struct {
 
 func (x *CheckResourceBatchRequest_BatchEntry) Reset() {
 	*x = CheckResourceBatchRequest_BatchEntry{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[27]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1653,7 +2344,7 @@ func (x *CheckResourceBatchRequest_BatchEntry) String() string {
 func (*CheckResourceBatchRequest_BatchEntry) ProtoMessage() {}
 
 func (x *CheckResourceBatchRequest_BatchEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[27]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +2357,7 @@ func (x *CheckResourceBatchRequest_BatchEntry) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CheckResourceBatchRequest_BatchEntry.ProtoReflect.Descriptor instead.
 func (*CheckResourceBatchRequest_BatchEntry) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{4, 0}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *CheckResourceBatchRequest_BatchEntry) GetActions() []string {
@@ -1693,7 +2384,7 @@ type CheckResourcesRequest_ResourceEntry struct {
 
 func (x *CheckResourcesRequest_ResourceEntry) Reset() {
 	*x = CheckResourcesRequest_ResourceEntry{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[28]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1705,7 +2396,7 @@ func (x *CheckResourcesRequest_ResourceEntry) String() string {
 func (*CheckResourcesRequest_ResourceEntry) ProtoMessage() {}
 
 func (x *CheckResourcesRequest_ResourceEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[28]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,7 +2409,7 @@ func (x *CheckResourcesRequest_ResourceEntry) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CheckResourcesRequest_ResourceEntry.ProtoReflect.Descriptor instead.
 func (*CheckResourcesRequest_ResourceEntry) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{5, 0}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *CheckResourcesRequest_ResourceEntry) GetActions() []string {
@@ -1745,7 +2436,7 @@ type AuxData_JWT struct {
 
 func (x *AuxData_JWT) Reset() {
 	*x = AuxData_JWT{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[29]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1757,7 +2448,7 @@ func (x *AuxData_JWT) String() string {
 func (*AuxData_JWT) ProtoMessage() {}
 
 func (x *AuxData_JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[29]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1770,7 +2461,7 @@ func (x *AuxData_JWT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuxData_JWT.ProtoReflect.Descriptor instead.
 func (*AuxData_JWT) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{6, 0}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *AuxData_JWT) GetToken() string {
@@ -1797,7 +2488,7 @@ type ListAuditLogEntriesRequest_TimeRange struct {
 
 func (x *ListAuditLogEntriesRequest_TimeRange) Reset() {
 	*x = ListAuditLogEntriesRequest_TimeRange{}
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[30]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1809,7 +2500,7 @@ func (x *ListAuditLogEntriesRequest_TimeRange) String() string {
 func (*ListAuditLogEntriesRequest_TimeRange) ProtoMessage() {}
 
 func (x *ListAuditLogEntriesRequest_TimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_request_v1_request_proto_msgTypes[30]
+	mi := &file_cerbos_request_v1_request_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,7 +2513,7 @@ func (x *ListAuditLogEntriesRequest_TimeRange) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ListAuditLogEntriesRequest_TimeRange.ProtoReflect.Descriptor instead.
 func (*ListAuditLogEntriesRequest_TimeRange) Descriptor() ([]byte, []int) {
-	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{13, 0}
+	return file_cerbos_request_v1_request_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *ListAuditLogEntriesRequest_TimeRange) GetStart() *timestamppb.Timestamp {
@@ -1843,18 +2534,74 @@ var File_cerbos_request_v1_request_proto protoreflect.FileDescriptor
 
 const file_cerbos_request_v1_request_proto_rawDesc = "" +
 	"\n" +
-	"\x1fcerbos/request/v1/request.proto\x12\x11cerbos.request.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dcerbos/engine/v1/engine.proto\x1a\x1dcerbos/policy/v1/policy.proto\x1a\x1dcerbos/schema/v1/schema.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xac\b\n" +
+	"\x1fcerbos/request/v1/request.proto\x12\x11cerbos.request.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dcerbos/engine/v1/engine.proto\x1a\x1dcerbos/policy/v1/policy.proto\x1a\x1dcerbos/schema/v1/schema.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xdc$\n" +
 	"\x14PlanResourcesRequest\x12\x96\x01\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tBw\x92At2JOptional application-specific ID useful for correlating logs for analysis.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12`\n" +
 	"\x06action\x18\x02 \x01(\tBH\x92AC22Action to be applied to each resource in the list.J\r\"view:public\"\x18\x01R\x06action\x12\xfb\x01\n" +
 	"\aactions\x18\a \x03(\tB\xe0\x01\x92A\xca\x012\xa3\x01List of actions to generate the query plan for. Mutually exclusive with the singular action field. Must contain at least one action and all actions must be unique.J\x1f[\"view:public\", \"edit:profile\"]\xb0\x01\x01\xbaH\x0f\x92\x01\f\b\x00\x10\x14\x18\x01\"\x04r\x02\x10\x01R\aactions\x12D\n" +
-	"\tprincipal\x18\x03 \x01(\v2\x1b.cerbos.engine.v1.PrincipalB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tprincipal\x12T\n" +
-	"\bresource\x18\x04 \x01(\v2-.cerbos.engine.v1.PlanResourcesInput.ResourceB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\bresource\x12:\n" +
+	"\tprincipal\x18\x03 \x01(\v2\x1b.cerbos.engine.v1.PrincipalB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tprincipal\x12\xa5\x01\n" +
+	"\bresource\x18\x04 \x01(\v2-.cerbos.engine.v1.PlanResourcesInput.ResourceBZ\x92AW2UResource to generate the query plan for. Mutually exclusive with the resources field.R\bresource\x12\x89\x02\n" +
+	"\tresources\x18\n" +
+	" \x03(\v2-.cerbos.engine.v1.PlanResourcesInput.ResourceB\xbb\x01\x92A\xaf\x012\xa9\x01List of resources to generate query plans for. Mutually exclusive with the resource field. Each resource produces a separate filter in the results field of the response.\xa0\x01\x14\xbaH\x05\x92\x01\x02\x10\x14R\tresources\x12:\n" +
+	"\baux_data\x18\x05 \x01(\v2\x1a.cerbos.request.v1.AuxDataB\x03\xe0A\x01R\aauxData\x12c\n" +
+	"\finclude_meta\x18\x06 \x01(\bB@\x92A=2;Opt to receive request processing metadata in the response.R\vincludeMeta\x12\x82\x01\n" +
+	"\x03sql\x18\b \x01(\v2+.cerbos.request.v1.PlanResourcesRequest.SqlBC\x92A=2;Opt to receive the filter translated to a SQL WHERE clause.\xe0A\x01R\x03sql\x12\x95\x01\n" +
+	"\bdocument\x18\t \x01(\v20.cerbos.request.v1.PlanResourcesRequest.DocumentBG\x92AA2?Opt to receive the filter translated to a document store query.\xe0A\x01R\bdocument\x12\xa8\x01\n" +
+	"\x0efilter_options\x18\v \x01(\v22.cerbos.engine.v1.PlanResourcesInput.FilterOptionsBM\x92AG2EOptions for simplifying the filter or converting it to a normal form.\xe0A\x01R\rfilterOptions\x1a\xe7\r\n" +
+	"\x03Sql\x12\x90\x01\n" +
+	"\adialect\x18\x01 \x01(\x0e23.cerbos.request.v1.PlanResourcesRequest.Sql.DialectBA\x92A,2*SQL dialect of the generated WHERE clause.\xe0A\x02\xbaH\f\xc8\x01\x01\x82\x01\x06\x18\x01\x18\x02\x18\x03R\adialect\x12\xbf\x01\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2;.cerbos.request.v1.PlanResourcesRequest.Sql.AttributesEntryBb\x92A_2]Mapping of attribute names, as they appear in the filter, to their locations in the database.R\n" +
+	"attributes\x1a\xf4\b\n" +
+	"\aMapping\x12h\n" +
+	"\x06column\x18\x01 \x01(\tBN\x92AD2/Name of the column that contains the attribute.J\x11\"documents.owner\"\xbaH\x04r\x02\x10\x01H\x00R\x06column\x12\x80\x01\n" +
+	"\x04json\x18\x02 \x01(\v2<.cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JsonPathB,\x92A)2'Path to the attribute in a JSON column.H\x00R\x04json\x12\x94\x01\n" +
+	"\x04join\x18\x03 \x01(\v2=.cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTableB?\x92A<2:Join table that contains the elements of a list attribute.H\x00R\x04join\x1a\xd8\x01\n" +
+	"\bJsonPath\x12q\n" +
+	"\x06column\x18\x01 \x01(\tBY\x92AL24Name of the JSON column that contains the attribute.J\x14\"documents.metadata\"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06column\x12Y\n" +
+	"\x04path\x18\x02 \x03(\tBE\x92AB2/Path to the attribute within the JSON document.J\x0f[\"owner\", \"id\"]R\x04path\x1a\xc6\x03\n" +
+	"\tJoinTable\x12o\n" +
+	"\x05table\x18\x01 \x01(\tBY\x92AL29Name of the table that contains the elements of the list.J\x0f\"document_tags\"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x05table\x12f\n" +
+	"\x03key\x18\x02 \x01(\tBT\x92AG26Column of the join table that references the resource.J\r\"document_id\"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x03key\x12y\n" +
+	"\treference\x18\x03 \x01(\tB[\x92AN2<Column of the resource that is referenced by the key column.J\x0e\"documents.id\"\xe0A\x02\xbaH\x04r\x02\x10\x01R\treference\x12e\n" +
+	"\x05value\x18\x04 \x01(\tBO\x92AB29Column of the join table that contains the list elements.J\x05\"tag\"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x05value:/\x92A,\n" +
+	"*2(Location of an attribute in the databaseB\x10\n" +
+	"\amapping\x12\x05\xbaH\x02\b\x01\x1ar\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12I\n" +
+	"\x05value\x18\x02 \x01(\v23.cerbos.request.v1.PlanResourcesRequest.Sql.MappingR\x05value:\x028\x01\"_\n" +
+	"\aDialect\x12\x17\n" +
+	"\x13DIALECT_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10DIALECT_POSTGRES\x10\x01\x12\x11\n" +
+	"\rDIALECT_MYSQL\x10\x02\x12\x12\n" +
+	"\x0eDIALECT_SQLITE\x10\x03:?\x92A<\n" +
+	":28Options for translating the filter to a SQL WHERE clause\x1a\xb0\x05\n" +
+	"\bDocument\x12\xc3\x01\n" +
+	"\x06format\x18\x01 \x01(\x0e27.cerbos.request.v1.PlanResourcesRequest.Document.FormatBr\x92A_2]Query language of the generated query. Elasticsearch queries are also accepted by OpenSearch.\xe0A\x02\xbaH\n" +
+	"\xc8\x01\x01\x82\x01\x04\x18\x01\x18\x02R\x06format\x12\x8d\x02\n" +
+	"\x06fields\x18\x02 \x03(\v2<.cerbos.request.v1.PlanResourcesRequest.Document.FieldsEntryB\xb6\x01\x92A\xb2\x012fMapping of attribute names, as they appear in the filter, to the names of the fields in the documents.JH{\"request.resource.attr\": \"\", \"request.resource.attr.owner\": \"owner.id\"}R\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eFORMAT_MONGODB\x10\x01\x12\x18\n" +
+	"\x14FORMAT_ELASTICSEARCH\x10\x02:C\x92A@\n" +
+	">2<Options for translating the filter to a document store query:\xca\x04\x92A$\n" +
+	"\"2 PDP Resources Query Plan Request\xbaH\x9f\x04\x1a\xaf\x01\n" +
+	"\x1eexclusiveFieldsActionOrActions\x126Exactly one of 'action' or 'actions' field must be set\x1aUhas(this.action) && !has(this.actions) || !has(this.action) && size(this.actions) > 0\x1a\xc4\x01\n" +
+	"\"exclusiveFieldsResourceOrResources\x12:Exactly one of 'resource' or 'resources' field must be set\x1abhas(this.resource) && size(this.resources) == 0 || !has(this.resource) && size(this.resources) > 0\x1a\xa3\x01\n" +
+	"\x1dtranslationWithSingleResource\x12>Filter translation is only supported with the 'resource' field\x1aBsize(this.resources) == 0 || !has(this.sql) && !has(this.document)\"\xb5\x06\n" +
+	"\x15PlanPrincipalsRequest\x12\x96\x01\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tBw\x92At2JOptional application-specific ID useful for correlating logs for analysis.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\xbe\x01\n" +
+	"\aactions\x18\x02 \x03(\tB\xa3\x01\x92A\x8a\x012pList of actions to generate the query plan for. Must contain at least one action and all actions must be unique.J\x10[\"view\", \"edit\"]\xa8\x01\x01\xb0\x01\x01\xe0A\x02\xbaH\x0f\x92\x01\f\b\x01\x10\x14\x18\x01\"\x04r\x02\x10\x01R\aactions\x12r\n" +
+	"\bresource\x18\x03 \x01(\v2\x1a.cerbos.engine.v1.ResourceB:\x92A.2,Resource that the principals want to access.\xe0A\x02\xbaH\x03\xc8\x01\x01R\bresource\x12\x82\x01\n" +
+	"\tprincipal\x18\x04 \x01(\v2/.cerbos.engine.v1.PlanPrincipalsInput.PrincipalB3\x92A-2+Scope and policy version of the principals.\xe0A\x01R\tprincipal\x12:\n" +
 	"\baux_data\x18\x05 \x01(\v2\x1a.cerbos.request.v1.AuxDataB\x03\xe0A\x01R\aauxData\x12c\n" +
-	"\finclude_meta\x18\x06 \x01(\bB@\x92A=2;Opt to receive request processing metadata in the response.R\vincludeMeta:\xdd\x01\x92A$\n" +
-	"\"2 PDP Resources Query Plan Request\xbaH\xb2\x01\x1a\xaf\x01\n" +
-	"\x1eexclusiveFieldsActionOrActions\x126Exactly one of 'action' or 'actions' field must be set\x1aUhas(this.action) && !has(this.actions) || !has(this.action) && size(this.actions) > 0\"\x86\x05\n" +
+	"\finclude_meta\x18\x06 \x01(\bB@\x92A=2;Opt to receive request processing metadata in the response.R\vincludeMeta:(\x92A%\n" +
+	"#2!PDP Principals Query Plan Request\"\x86\x05\n" +
 	"\x17CheckResourceSetRequest\x12\x96\x01\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tBw\x92At2JOptional application-specific ID useful for correlating logs for analysis.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\x8f\x01\n" +
@@ -1891,19 +2638,27 @@ const file_cerbos_request_v1_request_proto_rawDesc = "" +
 	"\aactions\x18\x01 \x03(\tBm\x92AT20List of actions being performed on the resource.J\x1a[\"view:public\", \"comment\"]\xa8\x01\x01\xb0\x01\x01\xe0A\x02\xbaH\x10\xc8\x01\x01\x92\x01\n" +
 	"\b\x01\x18\x01\"\x04r\x02\x10\x01R\aactions\x12A\n" +
 	"\bresource\x18\x02 \x01(\v2\x1a.cerbos.engine.v1.ResourceB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\bresource:\x12\x92A\x0f\n" +
-	"\r2\vPDP Request\"\xc7\a\n" +
+	"\r2\vPDP Request\"\xc3\b\n" +
 	"\x15CheckResourcesRequest\x12\x96\x01\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tBw\x92At2JOptional application-specific ID useful for correlating logs for analysis.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12X\n" +
 	"\finclude_meta\x18\x02 \x01(\bB5\x92A220Add request processing metadata to the response.R\vincludeMeta\x12D\n" +
 	"\tprincipal\x18\x03 \x01(\v2\x1b.cerbos.engine.v1.PrincipalB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tprincipal\x12\xbe\x02\n" +
 	"\tresources\x18\x04 \x03(\v26.cerbos.request.v1.CheckResourcesRequest.ResourceEntryB\xe7\x01\x92A\xd5\x012\x1eList of resources and actions.J\xac\x01[{\"actions\":[\"view\",\"comment\"], \"resource\":{\"kind\":\"album:object\",\"policyVersion\":\"default\",\"id\":\"XX125\",\"attr\":{\"owner\":\"bugs_bunny\", \"public\": false, \"flagged\": false}}}]\xa8\x01\x01\xb0\x01\x01\xe0A\x02\xbaH\b\xc8\x01\x01\x92\x01\x02\b\x01R\tresources\x125\n" +
-	"\baux_data\x18\x05 \x01(\v2\x1a.cerbos.request.v1.AuxDataR\aauxData\x1a\xdc\x01\n" +
+	"\baux_data\x18\x05 \x01(\v2\x1a.cerbos.request.v1.AuxDataR\aauxData\x12z\n" +
+	"\x13include_explanation\x18\x06 \x01(\bBI\x92AF2DAdd an explanation of how each decision was reached to the response.R\x12includeExplanation\x1a\xdc\x01\n" +
 	"\rResourceEntry\x12\x87\x01\n" +
 	"\aactions\x18\x01 \x03(\tBm\x92AT20List of actions being performed on the resource.J\x1a[\"view:public\", \"comment\"]\xa8\x01\x01\xb0\x01\x01\xe0A\x02\xbaH\x10\xc8\x01\x01\x92\x01\n" +
 	"\b\x01\x18\x01\"\x04r\x02\x10\x01R\aactions\x12A\n" +
 	"\bresource\x18\x02 \x01(\v2\x1a.cerbos.engine.v1.ResourceB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\bresource:\x1e\x92A\x1b\n" +
-	"\x192\x17Check resources request\"\xb2\a\n" +
+	"\x192\x17Check resources request\"\x8a\x05\n" +
+	"\x13ExplainCheckRequest\x12\x96\x01\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tBw\x92At2JOptional application-specific ID useful for correlating logs for analysis.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12D\n" +
+	"\tprincipal\x18\x02 \x01(\v2\x1b.cerbos.engine.v1.PrincipalB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tprincipal\x12\xbe\x02\n" +
+	"\tresources\x18\x03 \x03(\v26.cerbos.request.v1.CheckResourcesRequest.ResourceEntryB\xe7\x01\x92A\xd5\x012\x1eList of resources and actions.J\xac\x01[{\"actions\":[\"view\",\"comment\"], \"resource\":{\"kind\":\"album:object\",\"policyVersion\":\"default\",\"id\":\"XX125\",\"attr\":{\"owner\":\"bugs_bunny\", \"public\": false, \"flagged\": false}}}]\xa8\x01\x01\xb0\x01\x01\xe0A\x02\xbaH\b\xc8\x01\x01\x92\x01\x02\b\x01R\tresources\x125\n" +
+	"\baux_data\x18\x04 \x01(\v2\x1a.cerbos.request.v1.AuxDataR\aauxData:\x1c\x92A\x19\n" +
+	"\x172\x15Explain check request\"\xb2\a\n" +
 	"\aAuxData\x120\n" +
 	"\x03jwt\x18\x01 \x01(\v2\x1e.cerbos.request.v1.AuxData.JWTR\x03jwt\x1a\xb0\x06\n" +
 	"\x03JWT\x12\xc7\x04\n" +
@@ -1951,7 +2706,16 @@ const file_cerbos_request_v1_request_proto_rawDesc = "" +
 	"\x18AddOrUpdatePolicyRequest\x12b\n" +
 	"\bpolicies\x18\x01 \x03(\v2\x18.cerbos.policy.v1.PolicyB,\x92A\x192\x11List of policies.\xa0\x01d\xa8\x01\x01\xe0A\x02\xbaH\n" +
 	"\xc8\x01\x01\x92\x01\x04\b\x01\x10dR\bpolicies: \x92A\x1d\n" +
-	"\x1b2\x19Add/update policy request\"\xac\a\n" +
+	"\x1b2\x19Add/update policy request\"\xb2\x06\n" +
+	"\x18CheckWithPoliciesRequest\x12\x96\x01\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tBw\x92At2JOptional application-specific ID useful for correlating logs for analysis.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\xb7\x02\n" +
+	"\bpolicies\x18\x02 \x03(\v2\x18.cerbos.policy.v1.PolicyB\x80\x02\x92A\xec\x012\xe3\x01Candidate policies. They replace the live policies with the same IDs for the duration of the request. The set must include any derived roles, exported constants, exported variables and parent scopes that the policies depend on.\xa0\x01d\xa8\x01\x01\xe0A\x02\xbaH\n" +
+	"\xc8\x01\x01\x92\x01\x04\b\x01\x10dR\bpolicies\x12D\n" +
+	"\tprincipal\x18\x03 \x01(\v2\x1b.cerbos.engine.v1.PrincipalB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tprincipal\x12\x8d\x01\n" +
+	"\tresources\x18\x04 \x03(\v26.cerbos.request.v1.CheckResourcesRequest.ResourceEntryB7\x92A&2\x1eList of resources and actions.\xa8\x01\x01\xb0\x01\x01\xe0A\x02\xbaH\b\xc8\x01\x01\x92\x01\x02\b\x01R\tresources\x125\n" +
+	"\baux_data\x18\x05 \x01(\v2\x1a.cerbos.request.v1.AuxDataR\aauxData:6\x92A3\n" +
+	"12/Check resources with candidate policies request\"\xac\a\n" +
 	"\x1aListAuditLogEntriesRequest\x12\x89\x01\n" +
 	"\x04kind\x18\x01 \x01(\x0e22.cerbos.request.v1.ListAuditLogEntriesRequest.KindBA\x92A12\x11Kind of log entry\xf2\x02\vKIND_ACCESS\xf2\x02\rKIND_DECISION\xbaH\n" +
 	"\xc8\x01\x01\x82\x01\x04\x18\x01\x18\x02R\x04kind\x12F\n" +
@@ -2028,93 +2792,128 @@ func file_cerbos_request_v1_request_proto_rawDescGZIP() []byte {
 	return file_cerbos_request_v1_request_proto_rawDescData
 }
 
-var file_cerbos_request_v1_request_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cerbos_request_v1_request_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_cerbos_request_v1_request_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cerbos_request_v1_request_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_cerbos_request_v1_request_proto_goTypes = []any{
-	(ListAuditLogEntriesRequest_Kind)(0),         // 0: cerbos.request.v1.ListAuditLogEntriesRequest.Kind
-	(*PlanResourcesRequest)(nil),                 // 1: cerbos.request.v1.PlanResourcesRequest
-	(*CheckResourceSetRequest)(nil),              // 2: cerbos.request.v1.CheckResourceSetRequest
-	(*ResourceSet)(nil),                          // 3: cerbos.request.v1.ResourceSet
-	(*AttributesMap)(nil),                        // 4: cerbos.request.v1.AttributesMap
-	(*CheckResourceBatchRequest)(nil),            // 5: cerbos.request.v1.CheckResourceBatchRequest
-	(*CheckResourcesRequest)(nil),                // 6: cerbos.request.v1.CheckResourcesRequest
-	(*AuxData)(nil),                              // 7: cerbos.request.v1.AuxData
-	(*File)(nil),                                 // 8: cerbos.request.v1.File
-	(*PlaygroundValidateRequest)(nil),            // 9: cerbos.request.v1.PlaygroundValidateRequest
-	(*PlaygroundTestRequest)(nil),                // 10: cerbos.request.v1.PlaygroundTestRequest
-	(*PlaygroundEvaluateRequest)(nil),            // 11: cerbos.request.v1.PlaygroundEvaluateRequest
-	(*PlaygroundProxyRequest)(nil),               // 12: cerbos.request.v1.PlaygroundProxyRequest
-	(*AddOrUpdatePolicyRequest)(nil),             // 13: cerbos.request.v1.AddOrUpdatePolicyRequest
-	(*ListAuditLogEntriesRequest)(nil),           // 14: cerbos.request.v1.ListAuditLogEntriesRequest
-	(*ServerInfoRequest)(nil),                    // 15: cerbos.request.v1.ServerInfoRequest
-	(*ListPoliciesRequest)(nil),                  // 16: cerbos.request.v1.ListPoliciesRequest
-	(*GetPolicyRequest)(nil),                     // 17: cerbos.request.v1.GetPolicyRequest
-	(*DisablePolicyRequest)(nil),                 // 18: cerbos.request.v1.DisablePolicyRequest
-	(*EnablePolicyRequest)(nil),                  // 19: cerbos.request.v1.EnablePolicyRequest
-	(*InspectPoliciesRequest)(nil),               // 20: cerbos.request.v1.InspectPoliciesRequest
-	(*AddOrUpdateSchemaRequest)(nil),             // 21: cerbos.request.v1.AddOrUpdateSchemaRequest
-	(*ListSchemasRequest)(nil),                   // 22: cerbos.request.v1.ListSchemasRequest
-	(*GetSchemaRequest)(nil),                     // 23: cerbos.request.v1.GetSchemaRequest
-	(*DeleteSchemaRequest)(nil),                  // 24: cerbos.request.v1.DeleteSchemaRequest
-	(*ReloadStoreRequest)(nil),                   // 25: cerbos.request.v1.ReloadStoreRequest
-	nil,                                          // 26: cerbos.request.v1.ResourceSet.InstancesEntry
-	nil,                                          // 27: cerbos.request.v1.AttributesMap.AttrEntry
-	(*CheckResourceBatchRequest_BatchEntry)(nil), // 28: cerbos.request.v1.CheckResourceBatchRequest.BatchEntry
-	(*CheckResourcesRequest_ResourceEntry)(nil),  // 29: cerbos.request.v1.CheckResourcesRequest.ResourceEntry
-	(*AuxData_JWT)(nil),                          // 30: cerbos.request.v1.AuxData.JWT
-	(*ListAuditLogEntriesRequest_TimeRange)(nil), // 31: cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange
-	(*v1.Principal)(nil),                         // 32: cerbos.engine.v1.Principal
-	(*v1.PlanResourcesInput_Resource)(nil),       // 33: cerbos.engine.v1.PlanResourcesInput.Resource
-	(*v1.Resource)(nil),                          // 34: cerbos.engine.v1.Resource
-	(*v11.Policy)(nil),                           // 35: cerbos.policy.v1.Policy
-	(*durationpb.Duration)(nil),                  // 36: google.protobuf.Duration
-	(*v12.Schema)(nil),                           // 37: cerbos.schema.v1.Schema
-	(*structpb.Value)(nil),                       // 38: google.protobuf.Value
-	(*timestamppb.Timestamp)(nil),                // 39: google.protobuf.Timestamp
+	(PlanResourcesRequest_Sql_Dialect)(0),              // 0: cerbos.request.v1.PlanResourcesRequest.Sql.Dialect
+	(PlanResourcesRequest_Document_Format)(0),          // 1: cerbos.request.v1.PlanResourcesRequest.Document.Format
+	(ListAuditLogEntriesRequest_Kind)(0),               // 2: cerbos.request.v1.ListAuditLogEntriesRequest.Kind
+	(*PlanResourcesRequest)(nil),                       // 3: cerbos.request.v1.PlanResourcesRequest
+	(*PlanPrincipalsRequest)(nil),                      // 4: cerbos.request.v1.PlanPrincipalsRequest
+	(*CheckResourceSetRequest)(nil),                    // 5: cerbos.request.v1.CheckResourceSetRequest
+	(*ResourceSet)(nil),                                // 6: cerbos.request.v1.ResourceSet
+	(*AttributesMap)(nil),                              // 7: cerbos.request.v1.AttributesMap
+	(*CheckResourceBatchRequest)(nil),                  // 8: cerbos.request.v1.CheckResourceBatchRequest
+	(*CheckResourcesRequest)(nil),                      // 9: cerbos.request.v1.CheckResourcesRequest
+	(*ExplainCheckRequest)(nil),                        // 10: cerbos.request.v1.ExplainCheckRequest
+	(*AuxData)(nil),                                    // 11: cerbos.request.v1.AuxData
+	(*File)(nil),                                       // 12: cerbos.request.v1.File
+	(*PlaygroundValidateRequest)(nil),                  // 13: cerbos.request.v1.PlaygroundValidateRequest
+	(*PlaygroundTestRequest)(nil),                      // 14: cerbos.request.v1.PlaygroundTestRequest
+	(*PlaygroundEvaluateRequest)(nil),                  // 15: cerbos.request.v1.PlaygroundEvaluateRequest
+	(*PlaygroundProxyRequest)(nil),                     // 16: cerbos.request.v1.PlaygroundProxyRequest
+	(*AddOrUpdatePolicyRequest)(nil),                   // 17: cerbos.request.v1.AddOrUpdatePolicyRequest
+	(*CheckWithPoliciesRequest)(nil),                   // 18: cerbos.request.v1.CheckWithPoliciesRequest
+	(*ListAuditLogEntriesRequest)(nil),                 // 19: cerbos.request.v1.ListAuditLogEntriesRequest
+	(*ServerInfoRequest)(nil),                          // 20: cerbos.request.v1.ServerInfoRequest
+	(*ListPoliciesRequest)(nil),                        // 21: cerbos.request.v1.ListPoliciesRequest
+	(*GetPolicyRequest)(nil),                           // 22: cerbos.request.v1.GetPolicyRequest
+	(*DisablePolicyRequest)(nil),                       // 23: cerbos.request.v1.DisablePolicyRequest
+	(*EnablePolicyRequest)(nil),                        // 24: cerbos.request.v1.EnablePolicyRequest
+	(*InspectPoliciesRequest)(nil),                     // 25: cerbos.request.v1.InspectPoliciesRequest
+	(*AddOrUpdateSchemaRequest)(nil),                   // 26: cerbos.request.v1.AddOrUpdateSchemaRequest
+	(*ListSchemasRequest)(nil),                         // 27: cerbos.request.v1.ListSchemasRequest
+	(*GetSchemaRequest)(nil),                           // 28: cerbos.request.v1.GetSchemaRequest
+	(*DeleteSchemaRequest)(nil),                        // 29: cerbos.request.v1.DeleteSchemaRequest
+	(*ReloadStoreRequest)(nil),                         // 30: cerbos.request.v1.ReloadStoreRequest
+	(*PlanResourcesRequest_Sql)(nil),                   // 31: cerbos.request.v1.PlanResourcesRequest.Sql
+	(*PlanResourcesRequest_Document)(nil),              // 32: cerbos.request.v1.PlanResourcesRequest.Document
+	(*PlanResourcesRequest_Sql_Mapping)(nil),           // 33: cerbos.request.v1.PlanResourcesRequest.Sql.Mapping
+	nil,                                                // 34: cerbos.request.v1.PlanResourcesRequest.Sql.AttributesEntry
+	(*PlanResourcesRequest_Sql_Mapping_JsonPath)(nil),  // 35: cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JsonPath
+	(*PlanResourcesRequest_Sql_Mapping_JoinTable)(nil), // 36: cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable
+	nil, // 37: cerbos.request.v1.PlanResourcesRequest.Document.FieldsEntry
+	nil, // 38: cerbos.request.v1.ResourceSet.InstancesEntry
+	nil, // 39: cerbos.request.v1.AttributesMap.AttrEntry
+	(*CheckResourceBatchRequest_BatchEntry)(nil), // 40: cerbos.request.v1.CheckResourceBatchRequest.BatchEntry
+	(*CheckResourcesRequest_ResourceEntry)(nil),  // 41: cerbos.request.v1.CheckResourcesRequest.ResourceEntry
+	(*AuxData_JWT)(nil),                          // 42: cerbos.request.v1.AuxData.JWT
+	(*ListAuditLogEntriesRequest_TimeRange)(nil), // 43: cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange
+	(*v1.Principal)(nil),                         // 44: cerbos.engine.v1.Principal
+	(*v1.PlanResourcesInput_Resource)(nil),       // 45: cerbos.engine.v1.PlanResourcesInput.Resource
+	(*v1.PlanResourcesInput_FilterOptions)(nil),  // 46: cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	(*v1.Resource)(nil),                          // 47: cerbos.engine.v1.Resource
+	(*v1.PlanPrincipalsInput_Principal)(nil),     // 48: cerbos.engine.v1.PlanPrincipalsInput.Principal
+	(*v11.Policy)(nil),                           // 49: cerbos.policy.v1.Policy
+	(*durationpb.Duration)(nil),                  // 50: google.protobuf.Duration
+	(*v12.Schema)(nil),                           // 51: cerbos.schema.v1.Schema
+	(*structpb.Value)(nil),                       // 52: google.protobuf.Value
+	(*timestamppb.Timestamp)(nil),                // 53: google.protobuf.Timestamp
 }
 var file_cerbos_request_v1_request_proto_depIdxs = []int32{
-	32, // 0: cerbos.request.v1.PlanResourcesRequest.principal:type_name -> cerbos.engine.v1.Principal
-	33, // 1: cerbos.request.v1.PlanResourcesRequest.resource:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
-	7,  // 2: cerbos.request.v1.PlanResourcesRequest.aux_data:type_name -> cerbos.request.v1.AuxData
-	32, // 3: cerbos.request.v1.CheckResourceSetRequest.principal:type_name -> cerbos.engine.v1.Principal
-	3,  // 4: cerbos.request.v1.CheckResourceSetRequest.resource:type_name -> cerbos.request.v1.ResourceSet
-	7,  // 5: cerbos.request.v1.CheckResourceSetRequest.aux_data:type_name -> cerbos.request.v1.AuxData
-	26, // 6: cerbos.request.v1.ResourceSet.instances:type_name -> cerbos.request.v1.ResourceSet.InstancesEntry
-	27, // 7: cerbos.request.v1.AttributesMap.attr:type_name -> cerbos.request.v1.AttributesMap.AttrEntry
-	32, // 8: cerbos.request.v1.CheckResourceBatchRequest.principal:type_name -> cerbos.engine.v1.Principal
-	28, // 9: cerbos.request.v1.CheckResourceBatchRequest.resources:type_name -> cerbos.request.v1.CheckResourceBatchRequest.BatchEntry
-	7,  // 10: cerbos.request.v1.CheckResourceBatchRequest.aux_data:type_name -> cerbos.request.v1.AuxData
-	32, // 11: cerbos.request.v1.CheckResourcesRequest.principal:type_name -> cerbos.engine.v1.Principal
-	29, // 12: cerbos.request.v1.CheckResourcesRequest.resources:type_name -> cerbos.request.v1.CheckResourcesRequest.ResourceEntry
-	7,  // 13: cerbos.request.v1.CheckResourcesRequest.aux_data:type_name -> cerbos.request.v1.AuxData
-	30, // 14: cerbos.request.v1.AuxData.jwt:type_name -> cerbos.request.v1.AuxData.JWT
-	8,  // 15: cerbos.request.v1.PlaygroundValidateRequest.files:type_name -> cerbos.request.v1.File
-	8,  // 16: cerbos.request.v1.PlaygroundTestRequest.files:type_name -> cerbos.request.v1.File
-	8,  // 17: cerbos.request.v1.PlaygroundEvaluateRequest.files:type_name -> cerbos.request.v1.File
-	32, // 18: cerbos.request.v1.PlaygroundEvaluateRequest.principal:type_name -> cerbos.engine.v1.Principal
-	34, // 19: cerbos.request.v1.PlaygroundEvaluateRequest.resource:type_name -> cerbos.engine.v1.Resource
-	7,  // 20: cerbos.request.v1.PlaygroundEvaluateRequest.aux_data:type_name -> cerbos.request.v1.AuxData
-	8,  // 21: cerbos.request.v1.PlaygroundProxyRequest.files:type_name -> cerbos.request.v1.File
-	2,  // 22: cerbos.request.v1.PlaygroundProxyRequest.check_resource_set:type_name -> cerbos.request.v1.CheckResourceSetRequest
-	5,  // 23: cerbos.request.v1.PlaygroundProxyRequest.check_resource_batch:type_name -> cerbos.request.v1.CheckResourceBatchRequest
-	1,  // 24: cerbos.request.v1.PlaygroundProxyRequest.plan_resources:type_name -> cerbos.request.v1.PlanResourcesRequest
-	6,  // 25: cerbos.request.v1.PlaygroundProxyRequest.check_resources:type_name -> cerbos.request.v1.CheckResourcesRequest
-	35, // 26: cerbos.request.v1.AddOrUpdatePolicyRequest.policies:type_name -> cerbos.policy.v1.Policy
-	0,  // 27: cerbos.request.v1.ListAuditLogEntriesRequest.kind:type_name -> cerbos.request.v1.ListAuditLogEntriesRequest.Kind
-	31, // 28: cerbos.request.v1.ListAuditLogEntriesRequest.between:type_name -> cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange
-	36, // 29: cerbos.request.v1.ListAuditLogEntriesRequest.since:type_name -> google.protobuf.Duration
-	37, // 30: cerbos.request.v1.AddOrUpdateSchemaRequest.schemas:type_name -> cerbos.schema.v1.Schema
-	4,  // 31: cerbos.request.v1.ResourceSet.InstancesEntry.value:type_name -> cerbos.request.v1.AttributesMap
-	38, // 32: cerbos.request.v1.AttributesMap.AttrEntry.value:type_name -> google.protobuf.Value
-	34, // 33: cerbos.request.v1.CheckResourceBatchRequest.BatchEntry.resource:type_name -> cerbos.engine.v1.Resource
-	34, // 34: cerbos.request.v1.CheckResourcesRequest.ResourceEntry.resource:type_name -> cerbos.engine.v1.Resource
-	39, // 35: cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange.start:type_name -> google.protobuf.Timestamp
-	39, // 36: cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange.end:type_name -> google.protobuf.Timestamp
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	44, // 0: cerbos.request.v1.PlanResourcesRequest.principal:type_name -> cerbos.engine.v1.Principal
+	45, // 1: cerbos.request.v1.PlanResourcesRequest.resource:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
+	45, // 2: cerbos.request.v1.PlanResourcesRequest.resources:type_name -> cerbos.engine.v1.PlanResourcesInput.Resource
+	11, // 3: cerbos.request.v1.PlanResourcesRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	31, // 4: cerbos.request.v1.PlanResourcesRequest.sql:type_name -> cerbos.request.v1.PlanResourcesRequest.Sql
+	32, // 5: cerbos.request.v1.PlanResourcesRequest.document:type_name -> cerbos.request.v1.PlanResourcesRequest.Document
+	46, // 6: cerbos.request.v1.PlanResourcesRequest.filter_options:type_name -> cerbos.engine.v1.PlanResourcesInput.FilterOptions
+	47, // 7: cerbos.request.v1.PlanPrincipalsRequest.resource:type_name -> cerbos.engine.v1.Resource
+	48, // 8: cerbos.request.v1.PlanPrincipalsRequest.principal:type_name -> cerbos.engine.v1.PlanPrincipalsInput.Principal
+	11, // 9: cerbos.request.v1.PlanPrincipalsRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	44, // 10: cerbos.request.v1.CheckResourceSetRequest.principal:type_name -> cerbos.engine.v1.Principal
+	6,  // 11: cerbos.request.v1.CheckResourceSetRequest.resource:type_name -> cerbos.request.v1.ResourceSet
+	11, // 12: cerbos.request.v1.CheckResourceSetRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	38, // 13: cerbos.request.v1.ResourceSet.instances:type_name -> cerbos.request.v1.ResourceSet.InstancesEntry
+	39, // 14: cerbos.request.v1.AttributesMap.attr:type_name -> cerbos.request.v1.AttributesMap.AttrEntry
+	44, // 15: cerbos.request.v1.CheckResourceBatchRequest.principal:type_name -> cerbos.engine.v1.Principal
+	40, // 16: cerbos.request.v1.CheckResourceBatchRequest.resources:type_name -> cerbos.request.v1.CheckResourceBatchRequest.BatchEntry
+	11, // 17: cerbos.request.v1.CheckResourceBatchRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	44, // 18: cerbos.request.v1.CheckResourcesRequest.principal:type_name -> cerbos.engine.v1.Principal
+	41, // 19: cerbos.request.v1.CheckResourcesRequest.resources:type_name -> cerbos.request.v1.CheckResourcesRequest.ResourceEntry
+	11, // 20: cerbos.request.v1.CheckResourcesRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	44, // 21: cerbos.request.v1.ExplainCheckRequest.principal:type_name -> cerbos.engine.v1.Principal
+	41, // 22: cerbos.request.v1.ExplainCheckRequest.resources:type_name -> cerbos.request.v1.CheckResourcesRequest.ResourceEntry
+	11, // 23: cerbos.request.v1.ExplainCheckRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	42, // 24: cerbos.request.v1.AuxData.jwt:type_name -> cerbos.request.v1.AuxData.JWT
+	12, // 25: cerbos.request.v1.PlaygroundValidateRequest.files:type_name -> cerbos.request.v1.File
+	12, // 26: cerbos.request.v1.PlaygroundTestRequest.files:type_name -> cerbos.request.v1.File
+	12, // 27: cerbos.request.v1.PlaygroundEvaluateRequest.files:type_name -> cerbos.request.v1.File
+	44, // 28: cerbos.request.v1.PlaygroundEvaluateRequest.principal:type_name -> cerbos.engine.v1.Principal
+	47, // 29: cerbos.request.v1.PlaygroundEvaluateRequest.resource:type_name -> cerbos.engine.v1.Resource
+	11, // 30: cerbos.request.v1.PlaygroundEvaluateRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	12, // 31: cerbos.request.v1.PlaygroundProxyRequest.files:type_name -> cerbos.request.v1.File
+	5,  // 32: cerbos.request.v1.PlaygroundProxyRequest.check_resource_set:type_name -> cerbos.request.v1.CheckResourceSetRequest
+	8,  // 33: cerbos.request.v1.PlaygroundProxyRequest.check_resource_batch:type_name -> cerbos.request.v1.CheckResourceBatchRequest
+	3,  // 34: cerbos.request.v1.PlaygroundProxyRequest.plan_resources:type_name -> cerbos.request.v1.PlanResourcesRequest
+	9,  // 35: cerbos.request.v1.PlaygroundProxyRequest.check_resources:type_name -> cerbos.request.v1.CheckResourcesRequest
+	49, // 36: cerbos.request.v1.AddOrUpdatePolicyRequest.policies:type_name -> cerbos.policy.v1.Policy
+	49, // 37: cerbos.request.v1.CheckWithPoliciesRequest.policies:type_name -> cerbos.policy.v1.Policy
+	44, // 38: cerbos.request.v1.CheckWithPoliciesRequest.principal:type_name -> cerbos.engine.v1.Principal
+	41, // 39: cerbos.request.v1.CheckWithPoliciesRequest.resources:type_name -> cerbos.request.v1.CheckResourcesRequest.ResourceEntry
+	11, // 40: cerbos.request.v1.CheckWithPoliciesRequest.aux_data:type_name -> cerbos.request.v1.AuxData
+	2,  // 41: cerbos.request.v1.ListAuditLogEntriesRequest.kind:type_name -> cerbos.request.v1.ListAuditLogEntriesRequest.Kind
+	43, // 42: cerbos.request.v1.ListAuditLogEntriesRequest.between:type_name -> cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange
+	50, // 43: cerbos.request.v1.ListAuditLogEntriesRequest.since:type_name -> google.protobuf.Duration
+	51, // 44: cerbos.request.v1.AddOrUpdateSchemaRequest.schemas:type_name -> cerbos.schema.v1.Schema
+	0,  // 45: cerbos.request.v1.PlanResourcesRequest.Sql.dialect:type_name -> cerbos.request.v1.PlanResourcesRequest.Sql.Dialect
+	34, // 46: cerbos.request.v1.PlanResourcesRequest.Sql.attributes:type_name -> cerbos.request.v1.PlanResourcesRequest.Sql.AttributesEntry
+	1,  // 47: cerbos.request.v1.PlanResourcesRequest.Document.format:type_name -> cerbos.request.v1.PlanResourcesRequest.Document.Format
+	37, // 48: cerbos.request.v1.PlanResourcesRequest.Document.fields:type_name -> cerbos.request.v1.PlanResourcesRequest.Document.FieldsEntry
+	35, // 49: cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.json:type_name -> cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JsonPath
+	36, // 50: cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.join:type_name -> cerbos.request.v1.PlanResourcesRequest.Sql.Mapping.JoinTable
+	33, // 51: cerbos.request.v1.PlanResourcesRequest.Sql.AttributesEntry.value:type_name -> cerbos.request.v1.PlanResourcesRequest.Sql.Mapping
+	7,  // 52: cerbos.request.v1.ResourceSet.InstancesEntry.value:type_name -> cerbos.request.v1.AttributesMap
+	52, // 53: cerbos.request.v1.AttributesMap.AttrEntry.value:type_name -> google.protobuf.Value
+	47, // 54: cerbos.request.v1.CheckResourceBatchRequest.BatchEntry.resource:type_name -> cerbos.engine.v1.Resource
+	47, // 55: cerbos.request.v1.CheckResourcesRequest.ResourceEntry.resource:type_name -> cerbos.engine.v1.Resource
+	53, // 56: cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange.start:type_name -> google.protobuf.Timestamp
+	53, // 57: cerbos.request.v1.ListAuditLogEntriesRequest.TimeRange.end:type_name -> google.protobuf.Timestamp
+	58, // [58:58] is the sub-list for method output_type
+	58, // [58:58] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_cerbos_request_v1_request_proto_init() }
@@ -2122,25 +2921,30 @@ func file_cerbos_request_v1_request_proto_init() {
 	if File_cerbos_request_v1_request_proto != nil {
 		return
 	}
-	file_cerbos_request_v1_request_proto_msgTypes[11].OneofWrappers = []any{
+	file_cerbos_request_v1_request_proto_msgTypes[13].OneofWrappers = []any{
 		(*PlaygroundProxyRequest_CheckResourceSet)(nil),
 		(*PlaygroundProxyRequest_CheckResourceBatch)(nil),
 		(*PlaygroundProxyRequest_PlanResources)(nil),
 		(*PlaygroundProxyRequest_CheckResources)(nil),
 	}
-	file_cerbos_request_v1_request_proto_msgTypes[13].OneofWrappers = []any{
+	file_cerbos_request_v1_request_proto_msgTypes[16].OneofWrappers = []any{
 		(*ListAuditLogEntriesRequest_Tail)(nil),
 		(*ListAuditLogEntriesRequest_Between)(nil),
 		(*ListAuditLogEntriesRequest_Since)(nil),
 		(*ListAuditLogEntriesRequest_Lookup)(nil),
 	}
+	file_cerbos_request_v1_request_proto_msgTypes[30].OneofWrappers = []any{
+		(*PlanResourcesRequest_Sql_Mapping_Column)(nil),
+		(*PlanResourcesRequest_Sql_Mapping_Json)(nil),
+		(*PlanResourcesRequest_Sql_Mapping_Join)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cerbos_request_v1_request_proto_rawDesc), len(file_cerbos_request_v1_request_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   31,
+			NumEnums:      3,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -77,6 +77,45 @@ func cerbos_audit_v1_AuditTrail_hashpb_sum(m *v1.AuditTrail, hasher hash.Hash, i
 	}
 }
 
+func cerbos_audit_v1_DecisionLogEntry_CheckResourcesDivergence_hashpb_sum(m *v1.DecisionLogEntry_CheckResourcesDivergence, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.inputs"]; !ok {
+		if len(m.Inputs) > 0 {
+			for _, v := range m.Inputs {
+				if v != nil {
+					cerbos_engine_v1_CheckInput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.outputs"]; !ok {
+		if len(m.Outputs) > 0 {
+			for _, v := range m.Outputs {
+				if v != nil {
+					cerbos_engine_v1_CheckOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_outputs"]; !ok {
+		if len(m.ShadowOutputs) > 0 {
+			for _, v := range m.ShadowOutputs {
+				if v != nil {
+					cerbos_engine_v1_CheckOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetShadowError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetShadowError()), len(m.GetShadowError())))
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResourcesDivergence.shadow_policy_source"]; !ok {
+		if m.GetShadowPolicySource() != nil {
+			cerbos_audit_v1_PolicySource_hashpb_sum(m.GetShadowPolicySource(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *v1.DecisionLogEntry_CheckResources, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.inputs"]; !ok {
 		if len(m.Inputs) > 0 {
@@ -117,6 +156,24 @@ func cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(m *v1.DecisionLog
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
 		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.PlanResources.inputs"]; !ok {
+		if len(m.Inputs) > 0 {
+			for _, v := range m.Inputs {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesInput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.PlanResources.outputs"]; !ok {
+		if len(m.Outputs) > 0 {
+			for _, v := range m.Outputs {
+				if v != nil {
+					cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *v1.DecisionLogEntry, hasher hash.Hash, ignore map[string]struct{}) {
@@ -167,6 +224,10 @@ func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *v1.DecisionLogEntry, hasher
 				if t.PlanResources != nil {
 					cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(t.PlanResources, hasher, ignore)
 				}
+			case *v1.DecisionLogEntry_CheckResourcesDivergence_:
+				if t.CheckResourcesDivergence != nil {
+					cerbos_audit_v1_DecisionLogEntry_CheckResourcesDivergence_hashpb_sum(t.CheckResourcesDivergence, hasher, ignore)
+				}
 			}
 		}
 	}
@@ -355,6 +416,17 @@ func cerbos_engine_v1_AuxData_hashpb_sum(m *v11.AuxData, hasher hash.Hash, ignor
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.AuxData.providers"]; !ok {
+		if len(m.Providers) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Providers)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Providers[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Providers[k], hasher, ignore)
+				}
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckInput_hashpb_sum(m *v11.CheckInput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -449,6 +521,133 @@ func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v11.CheckOutput, hasher hash.Has
 	}
 }
 
+func cerbos_engine_v1_Explanation_Action_hashpb_sum(m *v11.Explanation_Action, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetEffect())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicy()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicy()), len(m.GetPolicy())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRule()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRule()), len(m.GetRule())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Action.rules"]; !ok {
+		if len(m.Rules) > 0 {
+			for _, v := range m.Rules {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Rule_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Condition_hashpb_sum(m *v11.Explanation_Condition, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.op"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOp())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.satisfied"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSatisfied())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.expr"]; !ok {
+		if m.GetExpr() != nil {
+			cerbos_engine_v1_Explanation_Expr_hashpb_sum(m.GetExpr(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Condition.conditions"]; !ok {
+		if len(m.Conditions) > 0 {
+			for _, v := range m.Conditions {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Condition_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Expr_hashpb_sum(m *v11.Explanation_Expr, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.expr"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetExpr()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetExpr()), len(m.GetExpr())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.value"]; !ok {
+		if m.GetValue() != nil {
+			google_protobuf_Value_hashpb_sum(m.GetValue(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Expr.operands"]; !ok {
+		if len(m.Operands) > 0 {
+			for _, v := range m.Operands {
+				if v != nil {
+					cerbos_engine_v1_Explanation_Expr_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Explanation_Rule_hashpb_sum(m *v11.Explanation_Rule, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicy()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicy()), len(m.GetPolicy())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.name"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetName()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetName()), len(m.GetName())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetEffect())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.outcome"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOutcome())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.derived_role"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetDerivedRole()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetDerivedRole()), len(m.GetDerivedRole())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.condition"]; !ok {
+		if m.GetCondition() != nil {
+			cerbos_engine_v1_Explanation_Condition_hashpb_sum(m.GetCondition(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Explanation.Rule.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetError()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetError()), len(m.GetError())))
+	}
+}
+
+func cerbos_engine_v1_Explanation_hashpb_sum(m *v11.Explanation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Explanation.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, k := range slices.Sorted(maps.Keys(m.Actions)) {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(k))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(k), len(k)))
+				if m.Actions[k] != nil {
+					cerbos_engine_v1_Explanation_Action_hashpb_sum(m.Actions[k], hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_engine_v1_OutputEntry_hashpb_sum(m *v11.OutputEntry, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.OutputEntry.src"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetSrc()))))
@@ -508,6 +707,15 @@ func cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m *v11.PlanResourcesFilter,
 	}
 }
 
+func cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m *v11.PlanResourcesInput_FilterOptions, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.simplify"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetSimplify())))
+	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.FilterOptions.normal_form"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetNormalForm())))
+	}
+}
+
 func cerbos_engine_v1_PlanResourcesInput_Resource_hashpb_sum(m *v11.PlanResourcesInput_Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetKind()))))
@@ -569,6 +777,11 @@ func cerbos_engine_v1_PlanResourcesInput_hashpb_sum(m *v11.PlanResourcesInput, h
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesInput.filter_options"]; !ok {
+		if m.GetFilterOptions() != nil {
+			cerbos_engine_v1_PlanResourcesInput_FilterOptions_hashpb_sum(m.GetFilterOptions(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v11.PlanResourcesOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -628,6 +841,11 @@ func cerbos_engine_v1_PlanResourcesOutput_hashpb_sum(m *v11.PlanResourcesOutput,
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.PlanResourcesOutput.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_engine_v1_Principal_hashpb_sum(m *v11.Principal, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1083,6 +1301,12 @@ func cerbos_policy_v1_PrincipalPolicy_hashpb_sum(m *v12.PrincipalPolicy, hasher
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.PrincipalPolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_PrincipalRule_Action_hashpb_sum(m *v12.PrincipalRule_Action, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1173,6 +1397,12 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v12.ResourcePolicy, hasher ha
 			cerbos_policy_v1_Constants_hashpb_sum(m.GetConstants(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v12.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1378,6 +1608,14 @@ func cerbos_policy_v1_TestResults_Details_hashpb_sum(m *v12.TestResults_Details,
 			case *v12.TestResults_Details_SkipReason:
 				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(t.SkipReason))))
 				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(t.SkipReason), len(t.SkipReason)))
+			case *v12.TestResults_Details_PlanFailure:
+				if t.PlanFailure != nil {
+					cerbos_policy_v1_TestResults_PlanFailure_hashpb_sum(t.PlanFailure, hasher, ignore)
+				}
+			case *v12.TestResults_Details_PlanSuccess:
+				if t.PlanSuccess != nil {
+					cerbos_policy_v1_TestResults_PlanSuccess_hashpb_sum(t.PlanSuccess, hasher, ignore)
+				}
 			}
 		}
 	}
@@ -1452,6 +1690,55 @@ func cerbos_policy_v1_TestResults_OutputFailure_hashpb_sum(m *v12.TestResults_Ou
 	}
 }
 
+func cerbos_policy_v1_TestResults_PlanFailure_ResourceMismatch_hashpb_sum(m *v12.TestResults_PlanFailure_ResourceMismatch, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.resource"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResource()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResource()), len(m.GetResource())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.filter_allowed"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetFilterAllowed())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.ResourceMismatch.check_effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCheckEffect())))
+	}
+}
+
+func cerbos_policy_v1_TestResults_PlanFailure_hashpb_sum(m *v12.TestResults_PlanFailure, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.expected_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetExpectedKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.actual_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetActualKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.expected_condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetExpectedCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetExpectedCondition()), len(m.GetExpectedCondition())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.actual_condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetActualCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetActualCondition()), len(m.GetActualCondition())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanFailure.resources"]; !ok {
+		if len(m.Resources) > 0 {
+			for _, v := range m.Resources {
+				if v != nil {
+					cerbos_policy_v1_TestResults_PlanFailure_ResourceMismatch_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_TestResults_PlanSuccess_hashpb_sum(m *v12.TestResults_PlanSuccess, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanSuccess.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetKind())))
+	}
+	if _, ok := ignore["cerbos.policy.v1.TestResults.PlanSuccess.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCondition()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCondition()), len(m.GetCondition())))
+	}
+}
+
 func cerbos_policy_v1_TestResults_Principal_hashpb_sum(m *v12.TestResults_Principal, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.policy.v1.TestResults.Principal.name"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetName()))))
@@ -1859,6 +2146,11 @@ func cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m *CheckRe
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.explanation"]; !ok {
+		if m.GetExplanation() != nil {
+			cerbos_engine_v1_Explanation_hashpb_sum(m.GetExplanation(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m *CheckResourcesResponse, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1881,6 +2173,43 @@ func cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m *CheckResourcesRespo
 	}
 }
 
+func cerbos_response_v1_CheckWithPoliciesResponse_Result_hashpb_sum(m *CheckWithPoliciesResponse_Result, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.Result.current"]; !ok {
+		if m.GetCurrent() != nil {
+			cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m.GetCurrent(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.Result.candidate"]; !ok {
+		if m.GetCandidate() != nil {
+			cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m.GetCandidate(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.Result.changed_actions"]; !ok {
+		if len(m.ChangedActions) > 0 {
+			for _, v := range m.ChangedActions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_CheckWithPoliciesResponse_hashpb_sum(m *CheckWithPoliciesResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckWithPoliciesResponse.results"]; !ok {
+		if len(m.Results) > 0 {
+			for _, v := range m.Results {
+				if v != nil {
+					cerbos_response_v1_CheckWithPoliciesResponse_Result_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_DeleteSchemaResponse_hashpb_sum(m *DeleteSchemaResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.DeleteSchemaResponse.deleted_schemas"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetDeletedSchemas())))
@@ -1899,6 +2228,26 @@ func cerbos_response_v1_EnablePolicyResponse_hashpb_sum(m *EnablePolicyResponse,
 	}
 }
 
+func cerbos_response_v1_ExplainCheckResponse_hashpb_sum(m *ExplainCheckResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ExplainCheckResponse.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.ExplainCheckResponse.results"]; !ok {
+		if len(m.Results) > 0 {
+			for _, v := range m.Results {
+				if v != nil {
+					cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.ExplainCheckResponse.cerbos_call_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCerbosCallId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCerbosCallId()), len(m.GetCerbosCallId())))
+	}
+}
+
 func cerbos_response_v1_GetPolicyResponse_hashpb_sum(m *GetPolicyResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.GetPolicyResponse.policies"]; !ok {
 		if len(m.Policies) > 0 {
@@ -2094,6 +2443,62 @@ func cerbos_response_v1_ListSchemasResponse_hashpb_sum(m *ListSchemasResponse, h
 	}
 }
 
+func cerbos_response_v1_PlanPrincipalsResponse_Meta_hashpb_sum(m *PlanPrincipalsResponse_Meta, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.Meta.filter_debug"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetFilterDebug()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetFilterDebug()), len(m.GetFilterDebug())))
+	}
+}
+
+func cerbos_response_v1_PlanPrincipalsResponse_hashpb_sum(m *PlanPrincipalsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetRequestId()), len(m.GetRequestId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(v))))
+				_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(v), len(v)))
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.resource_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResourceKind()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResourceKind()), len(m.GetResourceKind())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.resource_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResourceId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResourceId()), len(m.GetResourceId())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.filter"]; !ok {
+		if m.GetFilter() != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.GetFilter(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.meta"]; !ok {
+		if m.GetMeta() != nil {
+			cerbos_response_v1_PlanPrincipalsResponse_Meta_hashpb_sum(m.GetMeta(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanPrincipalsResponse.cerbos_call_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetCerbosCallId()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetCerbosCallId()), len(m.GetCerbosCallId())))
+	}
+}
+
+func cerbos_response_v1_PlanResourcesResponse_Document_hashpb_sum(m *PlanResourcesResponse_Document, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Document.query"]; !ok {
+		if m.GetQuery() != nil {
+			google_protobuf_Struct_hashpb_sum(m.GetQuery(), hasher, ignore)
+		}
+	}
+}
+
 func cerbos_response_v1_PlanResourcesResponse_Meta_hashpb_sum(m *PlanResourcesResponse_Meta, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Meta.filter_debug"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetFilterDebug()))))
@@ -2115,6 +2520,61 @@ func cerbos_response_v1_PlanResourcesResponse_Meta_hashpb_sum(m *PlanResourcesRe
 	}
 }
 
+func cerbos_response_v1_PlanResourcesResponse_Result_hashpb_sum(m *PlanResourcesResponse_Result, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.resource_kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetResourceKind()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetResourceKind()), len(m.GetResourceKind())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.policy_version"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetPolicyVersion()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetPolicyVersion()), len(m.GetPolicyVersion())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetScope()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetScope()), len(m.GetScope())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.filter"]; !ok {
+		if m.GetFilter() != nil {
+			cerbos_engine_v1_PlanResourcesFilter_hashpb_sum(m.GetFilter(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.meta"]; !ok {
+		if m.GetMeta() != nil {
+			cerbos_response_v1_PlanResourcesResponse_Meta_hashpb_sum(m.GetMeta(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.validation_errors"]; !ok {
+		if len(m.ValidationErrors) > 0 {
+			for _, v := range m.ValidationErrors {
+				if v != nil {
+					cerbos_schema_v1_ValidationError_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Result.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
+}
+
+func cerbos_response_v1_PlanResourcesResponse_Sql_hashpb_sum(m *PlanResourcesResponse_Sql, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Sql.where"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetWhere()))))
+		_, _ = hasher.Write(unsafe.Slice(unsafe.StringData(m.GetWhere()), len(m.GetWhere())))
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.Sql.args"]; !ok {
+		if len(m.Args) > 0 {
+			for _, v := range m.Args {
+				if v != nil {
+					google_protobuf_Value_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *PlanResourcesResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.request_id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(len(m.GetRequestId()))))
@@ -2163,6 +2623,30 @@ func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *PlanResourcesRespons
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.sql"]; !ok {
+		if m.GetSql() != nil {
+			cerbos_response_v1_PlanResourcesResponse_Sql_hashpb_sum(m.GetSql(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.document"]; !ok {
+		if m.GetDocument() != nil {
+			cerbos_response_v1_PlanResourcesResponse_Document_hashpb_sum(m.GetDocument(), hasher, ignore)
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.results"]; !ok {
+		if len(m.Results) > 0 {
+			for _, v := range m.Results {
+				if v != nil {
+					cerbos_response_v1_PlanResourcesResponse_Result_hashpb_sum(v, hasher, ignore)
+				}
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.PlanResourcesResponse.now"]; !ok {
+		if m.GetNow() != nil {
+			google_protobuf_Timestamp_hashpb_sum(m.GetNow(), hasher, ignore)
+		}
+	}
 }
 
 func cerbos_response_v1_PlaygroundEvaluateResponse_EvalResultList_hashpb_sum(m *PlaygroundEvaluateResponse_EvalResultList, hasher hash.Hash, ignore map[string]struct{}) {
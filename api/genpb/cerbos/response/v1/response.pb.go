@@ -20,6 +20,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -78,7 +79,7 @@ func (x InspectPoliciesResponse_Attribute_Kind) Number() protoreflect.EnumNumber
 
 // Deprecated: Use InspectPoliciesResponse_Attribute_Kind.Descriptor instead.
 func (InspectPoliciesResponse_Attribute_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 0, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 0, 0}
 }
 
 type InspectPoliciesResponse_DerivedRole_Kind int32
@@ -130,7 +131,7 @@ func (x InspectPoliciesResponse_DerivedRole_Kind) Number() protoreflect.EnumNumb
 
 // Deprecated: Use InspectPoliciesResponse_DerivedRole_Kind.Descriptor instead.
 func (InspectPoliciesResponse_DerivedRole_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 1, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 1, 0}
 }
 
 type InspectPoliciesResponse_Constant_Kind int32
@@ -188,7 +189,7 @@ func (x InspectPoliciesResponse_Constant_Kind) Number() protoreflect.EnumNumber
 
 // Deprecated: Use InspectPoliciesResponse_Constant_Kind.Descriptor instead.
 func (InspectPoliciesResponse_Constant_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 2, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 2, 0}
 }
 
 type InspectPoliciesResponse_Variable_Kind int32
@@ -246,21 +247,25 @@ func (x InspectPoliciesResponse_Variable_Kind) Number() protoreflect.EnumNumber
 
 // Deprecated: Use InspectPoliciesResponse_Variable_Kind.Descriptor instead.
 func (InspectPoliciesResponse_Variable_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 3, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 3, 0}
 }
 
 type PlanResourcesResponse struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Deprecated: Marked as deprecated in cerbos/response/v1/response.proto.
-	Action           string                      `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Actions          []string                    `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
-	ResourceKind     string                      `protobuf:"bytes,3,opt,name=resource_kind,json=resourceKind,proto3" json:"resource_kind,omitempty"`
-	PolicyVersion    string                      `protobuf:"bytes,4,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
-	Filter           *v1.PlanResourcesFilter     `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
-	Meta             *PlanResourcesResponse_Meta `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
-	ValidationErrors []*v11.ValidationError      `protobuf:"bytes,7,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
-	CerbosCallId     string                      `protobuf:"bytes,8,opt,name=cerbos_call_id,json=cerbosCallId,proto3" json:"cerbos_call_id,omitempty"`
+	Action           string                          `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Actions          []string                        `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+	ResourceKind     string                          `protobuf:"bytes,3,opt,name=resource_kind,json=resourceKind,proto3" json:"resource_kind,omitempty"`
+	PolicyVersion    string                          `protobuf:"bytes,4,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Filter           *v1.PlanResourcesFilter         `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Meta             *PlanResourcesResponse_Meta     `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
+	ValidationErrors []*v11.ValidationError          `protobuf:"bytes,7,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	CerbosCallId     string                          `protobuf:"bytes,8,opt,name=cerbos_call_id,json=cerbosCallId,proto3" json:"cerbos_call_id,omitempty"`
+	Sql              *PlanResourcesResponse_Sql      `protobuf:"bytes,10,opt,name=sql,proto3" json:"sql,omitempty"`
+	Document         *PlanResourcesResponse_Document `protobuf:"bytes,11,opt,name=document,proto3" json:"document,omitempty"`
+	Results          []*PlanResourcesResponse_Result `protobuf:"bytes,12,rep,name=results,proto3" json:"results,omitempty"`
+	Now              *timestamppb.Timestamp          `protobuf:"bytes,13,opt,name=now,proto3" json:"now,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -359,6 +364,134 @@ func (x *PlanResourcesResponse) GetCerbosCallId() string {
 	return ""
 }
 
+func (x *PlanResourcesResponse) GetSql() *PlanResourcesResponse_Sql {
+	if x != nil {
+		return x.Sql
+	}
+	return nil
+}
+
+func (x *PlanResourcesResponse) GetDocument() *PlanResourcesResponse_Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *PlanResourcesResponse) GetResults() []*PlanResourcesResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PlanResourcesResponse) GetNow() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Now
+	}
+	return nil
+}
+
+type PlanPrincipalsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	RequestId     string                       `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Actions       []string                     `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	ResourceKind  string                       `protobuf:"bytes,3,opt,name=resource_kind,json=resourceKind,proto3" json:"resource_kind,omitempty"`
+	ResourceId    string                       `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PolicyVersion string                       `protobuf:"bytes,5,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Filter        *v1.PlanResourcesFilter      `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	Meta          *PlanPrincipalsResponse_Meta `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	CerbosCallId  string                       `protobuf:"bytes,8,opt,name=cerbos_call_id,json=cerbosCallId,proto3" json:"cerbos_call_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPrincipalsResponse) Reset() {
+	*x = PlanPrincipalsResponse{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPrincipalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPrincipalsResponse) ProtoMessage() {}
+
+func (x *PlanPrincipalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPrincipalsResponse.ProtoReflect.Descriptor instead.
+func (*PlanPrincipalsResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlanPrincipalsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsResponse) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsResponse) GetResourceKind() string {
+	if x != nil {
+		return x.ResourceKind
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsResponse) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsResponse) GetPolicyVersion() string {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return ""
+}
+
+func (x *PlanPrincipalsResponse) GetFilter() *v1.PlanResourcesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsResponse) GetMeta() *PlanPrincipalsResponse_Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PlanPrincipalsResponse) GetCerbosCallId() string {
+	if x != nil {
+		return x.CerbosCallId
+	}
+	return ""
+}
+
 // Deprecated. See CheckResourcesResponse.
 type CheckResourceSetResponse struct {
 	state             protoimpl.MessageState                               `protogen:"open.v1"`
@@ -371,7 +504,7 @@ type CheckResourceSetResponse struct {
 
 func (x *CheckResourceSetResponse) Reset() {
 	*x = CheckResourceSetResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[1]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +516,7 @@ func (x *CheckResourceSetResponse) String() string {
 func (*CheckResourceSetResponse) ProtoMessage() {}
 
 func (x *CheckResourceSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[1]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +529,7 @@ func (x *CheckResourceSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResourceSetResponse.ProtoReflect.Descriptor instead.
 func (*CheckResourceSetResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{1}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CheckResourceSetResponse) GetRequestId() string {
@@ -431,7 +564,7 @@ type CheckResourceBatchResponse struct {
 
 func (x *CheckResourceBatchResponse) Reset() {
 	*x = CheckResourceBatchResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[2]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -443,7 +576,7 @@ func (x *CheckResourceBatchResponse) String() string {
 func (*CheckResourceBatchResponse) ProtoMessage() {}
 
 func (x *CheckResourceBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[2]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +589,7 @@ func (x *CheckResourceBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResourceBatchResponse.ProtoReflect.Descriptor instead.
 func (*CheckResourceBatchResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{2}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CheckResourceBatchResponse) GetRequestId() string {
@@ -484,7 +617,7 @@ type CheckResourcesResponse struct {
 
 func (x *CheckResourcesResponse) Reset() {
 	*x = CheckResourcesResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[3]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +629,7 @@ func (x *CheckResourcesResponse) String() string {
 func (*CheckResourcesResponse) ProtoMessage() {}
 
 func (x *CheckResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[3]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +642,7 @@ func (x *CheckResourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResourcesResponse.ProtoReflect.Descriptor instead.
 func (*CheckResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{3}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CheckResourcesResponse) GetRequestId() string {
@@ -533,6 +666,66 @@ func (x *CheckResourcesResponse) GetCerbosCallId() string {
 	return ""
 }
 
+type ExplainCheckResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	RequestId     string                                `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Results       []*CheckResourcesResponse_ResultEntry `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	CerbosCallId  string                                `protobuf:"bytes,3,opt,name=cerbos_call_id,json=cerbosCallId,proto3" json:"cerbos_call_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainCheckResponse) Reset() {
+	*x = ExplainCheckResponse{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainCheckResponse) ProtoMessage() {}
+
+func (x *ExplainCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainCheckResponse.ProtoReflect.Descriptor instead.
+func (*ExplainCheckResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExplainCheckResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ExplainCheckResponse) GetResults() []*CheckResourcesResponse_ResultEntry {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ExplainCheckResponse) GetCerbosCallId() string {
+	if x != nil {
+		return x.CerbosCallId
+	}
+	return ""
+}
+
 type PlaygroundFailure struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Errors        []*PlaygroundFailure_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
@@ -542,7 +735,7 @@ type PlaygroundFailure struct {
 
 func (x *PlaygroundFailure) Reset() {
 	*x = PlaygroundFailure{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[4]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +747,7 @@ func (x *PlaygroundFailure) String() string {
 func (*PlaygroundFailure) ProtoMessage() {}
 
 func (x *PlaygroundFailure) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[4]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +760,7 @@ func (x *PlaygroundFailure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundFailure.ProtoReflect.Descriptor instead.
 func (*PlaygroundFailure) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PlaygroundFailure) GetErrors() []*PlaygroundFailure_Error {
@@ -591,7 +784,7 @@ type PlaygroundValidateResponse struct {
 
 func (x *PlaygroundValidateResponse) Reset() {
 	*x = PlaygroundValidateResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[5]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -603,7 +796,7 @@ func (x *PlaygroundValidateResponse) String() string {
 func (*PlaygroundValidateResponse) ProtoMessage() {}
 
 func (x *PlaygroundValidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[5]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +809,7 @@ func (x *PlaygroundValidateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundValidateResponse.ProtoReflect.Descriptor instead.
 func (*PlaygroundValidateResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{5}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PlaygroundValidateResponse) GetPlaygroundId() string {
@@ -681,7 +874,7 @@ type PlaygroundTestResponse struct {
 
 func (x *PlaygroundTestResponse) Reset() {
 	*x = PlaygroundTestResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[6]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +886,7 @@ func (x *PlaygroundTestResponse) String() string {
 func (*PlaygroundTestResponse) ProtoMessage() {}
 
 func (x *PlaygroundTestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[6]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +899,7 @@ func (x *PlaygroundTestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundTestResponse.ProtoReflect.Descriptor instead.
 func (*PlaygroundTestResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{6}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PlaygroundTestResponse) GetPlaygroundId() string {
@@ -771,7 +964,7 @@ type PlaygroundEvaluateResponse struct {
 
 func (x *PlaygroundEvaluateResponse) Reset() {
 	*x = PlaygroundEvaluateResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[7]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -783,7 +976,7 @@ func (x *PlaygroundEvaluateResponse) String() string {
 func (*PlaygroundEvaluateResponse) ProtoMessage() {}
 
 func (x *PlaygroundEvaluateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[7]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +989,7 @@ func (x *PlaygroundEvaluateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundEvaluateResponse.ProtoReflect.Descriptor instead.
 func (*PlaygroundEvaluateResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{7}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PlaygroundEvaluateResponse) GetPlaygroundId() string {
@@ -864,7 +1057,7 @@ type PlaygroundProxyResponse struct {
 
 func (x *PlaygroundProxyResponse) Reset() {
 	*x = PlaygroundProxyResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[8]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -876,7 +1069,7 @@ func (x *PlaygroundProxyResponse) String() string {
 func (*PlaygroundProxyResponse) ProtoMessage() {}
 
 func (x *PlaygroundProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[8]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1082,7 @@ func (x *PlaygroundProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundProxyResponse.ProtoReflect.Descriptor instead.
 func (*PlaygroundProxyResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{8}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PlaygroundProxyResponse) GetPlaygroundId() string {
@@ -994,7 +1187,7 @@ type AddOrUpdatePolicyResponse struct {
 
 func (x *AddOrUpdatePolicyResponse) Reset() {
 	*x = AddOrUpdatePolicyResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[9]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +1199,7 @@ func (x *AddOrUpdatePolicyResponse) String() string {
 func (*AddOrUpdatePolicyResponse) ProtoMessage() {}
 
 func (x *AddOrUpdatePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[9]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1212,7 @@ func (x *AddOrUpdatePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddOrUpdatePolicyResponse.ProtoReflect.Descriptor instead.
 func (*AddOrUpdatePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{9}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AddOrUpdatePolicyResponse) GetSuccess() *emptypb.Empty {
@@ -1029,6 +1222,58 @@ func (x *AddOrUpdatePolicyResponse) GetSuccess() *emptypb.Empty {
 	return nil
 }
 
+type CheckWithPoliciesResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	RequestId     string                              `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Results       []*CheckWithPoliciesResponse_Result `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckWithPoliciesResponse) Reset() {
+	*x = CheckWithPoliciesResponse{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckWithPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckWithPoliciesResponse) ProtoMessage() {}
+
+func (x *CheckWithPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckWithPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*CheckWithPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckWithPoliciesResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CheckWithPoliciesResponse) GetResults() []*CheckWithPoliciesResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type ListAuditLogEntriesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Entry:
@@ -1042,7 +1287,7 @@ type ListAuditLogEntriesResponse struct {
 
 func (x *ListAuditLogEntriesResponse) Reset() {
 	*x = ListAuditLogEntriesResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[10]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1054,7 +1299,7 @@ func (x *ListAuditLogEntriesResponse) String() string {
 func (*ListAuditLogEntriesResponse) ProtoMessage() {}
 
 func (x *ListAuditLogEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[10]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1312,7 @@ func (x *ListAuditLogEntriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditLogEntriesResponse.ProtoReflect.Descriptor instead.
 func (*ListAuditLogEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{10}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListAuditLogEntriesResponse) GetEntry() isListAuditLogEntriesResponse_Entry {
@@ -1122,7 +1367,7 @@ type ServerInfoResponse struct {
 
 func (x *ServerInfoResponse) Reset() {
 	*x = ServerInfoResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[11]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1134,7 +1379,7 @@ func (x *ServerInfoResponse) String() string {
 func (*ServerInfoResponse) ProtoMessage() {}
 
 func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[11]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1392,7 @@ func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfoResponse.ProtoReflect.Descriptor instead.
 func (*ServerInfoResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{11}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ServerInfoResponse) GetVersion() string {
@@ -1180,7 +1425,7 @@ type ListPoliciesResponse struct {
 
 func (x *ListPoliciesResponse) Reset() {
 	*x = ListPoliciesResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[12]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1192,7 +1437,7 @@ func (x *ListPoliciesResponse) String() string {
 func (*ListPoliciesResponse) ProtoMessage() {}
 
 func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[12]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,7 +1450,7 @@ func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
 func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{12}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListPoliciesResponse) GetPolicyIds() []string {
@@ -1224,7 +1469,7 @@ type GetPolicyResponse struct {
 
 func (x *GetPolicyResponse) Reset() {
 	*x = GetPolicyResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[13]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1236,7 +1481,7 @@ func (x *GetPolicyResponse) String() string {
 func (*GetPolicyResponse) ProtoMessage() {}
 
 func (x *GetPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[13]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +1494,7 @@ func (x *GetPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyResponse.ProtoReflect.Descriptor instead.
 func (*GetPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{13}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetPolicyResponse) GetPolicies() []*v13.Policy {
@@ -1268,7 +1513,7 @@ type DisablePolicyResponse struct {
 
 func (x *DisablePolicyResponse) Reset() {
 	*x = DisablePolicyResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[14]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1280,7 +1525,7 @@ func (x *DisablePolicyResponse) String() string {
 func (*DisablePolicyResponse) ProtoMessage() {}
 
 func (x *DisablePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[14]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1538,7 @@ func (x *DisablePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisablePolicyResponse.ProtoReflect.Descriptor instead.
 func (*DisablePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{14}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DisablePolicyResponse) GetDisabledPolicies() uint32 {
@@ -1312,7 +1557,7 @@ type EnablePolicyResponse struct {
 
 func (x *EnablePolicyResponse) Reset() {
 	*x = EnablePolicyResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[15]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1324,7 +1569,7 @@ func (x *EnablePolicyResponse) String() string {
 func (*EnablePolicyResponse) ProtoMessage() {}
 
 func (x *EnablePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[15]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,7 +1582,7 @@ func (x *EnablePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnablePolicyResponse.ProtoReflect.Descriptor instead.
 func (*EnablePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{15}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *EnablePolicyResponse) GetEnabledPolicies() uint32 {
@@ -1356,7 +1601,7 @@ type InspectPoliciesResponse struct {
 
 func (x *InspectPoliciesResponse) Reset() {
 	*x = InspectPoliciesResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[16]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1368,7 +1613,7 @@ func (x *InspectPoliciesResponse) String() string {
 func (*InspectPoliciesResponse) ProtoMessage() {}
 
 func (x *InspectPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[16]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,7 +1626,7 @@ func (x *InspectPoliciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectPoliciesResponse.ProtoReflect.Descriptor instead.
 func (*InspectPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *InspectPoliciesResponse) GetResults() map[string]*InspectPoliciesResponse_Result {
@@ -1399,7 +1644,7 @@ type AddOrUpdateSchemaResponse struct {
 
 func (x *AddOrUpdateSchemaResponse) Reset() {
 	*x = AddOrUpdateSchemaResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[17]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1411,7 +1656,7 @@ func (x *AddOrUpdateSchemaResponse) String() string {
 func (*AddOrUpdateSchemaResponse) ProtoMessage() {}
 
 func (x *AddOrUpdateSchemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[17]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,36 +1664,267 @@ func (x *AddOrUpdateSchemaResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrUpdateSchemaResponse.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{20}
+}
+
+type ListSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaIds     []string               `protobuf:"bytes,1,rep,name=schema_ids,json=schemaIds,proto3" json:"schema_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchemasResponse) Reset() {
+	*x = ListSchemasResponse{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchemasResponse) ProtoMessage() {}
+
+func (x *ListSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchemasResponse.ProtoReflect.Descriptor instead.
+func (*ListSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSchemasResponse) GetSchemaIds() []string {
+	if x != nil {
+		return x.SchemaIds
+	}
+	return nil
+}
+
+type GetSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*v11.Schema          `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaResponse) Reset() {
+	*x = GetSchemaResponse{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaResponse) ProtoMessage() {}
+
+func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSchemaResponse) GetSchemas() []*v11.Schema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+type DeleteSchemaResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeletedSchemas uint32                 `protobuf:"varint,1,opt,name=deleted_schemas,json=deletedSchemas,proto3" json:"deleted_schemas,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteSchemaResponse) Reset() {
+	*x = DeleteSchemaResponse{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSchemaResponse) ProtoMessage() {}
+
+func (x *DeleteSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSchemaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteSchemaResponse) GetDeletedSchemas() uint32 {
+	if x != nil {
+		return x.DeletedSchemas
+	}
+	return 0
+}
+
+type ReloadStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadStoreResponse) Reset() {
+	*x = ReloadStoreResponse{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadStoreResponse) ProtoMessage() {}
+
+func (x *ReloadStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadStoreResponse.ProtoReflect.Descriptor instead.
+func (*ReloadStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{24}
+}
+
+type PlanResourcesResponse_Meta struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FilterDebug string                 `protobuf:"bytes,1,opt,name=filter_debug,json=filterDebug,proto3" json:"filter_debug,omitempty"`
+	// Deprecated: Marked as deprecated in cerbos/response/v1/response.proto.
+	MatchedScope  string            `protobuf:"bytes,2,opt,name=matched_scope,json=matchedScope,proto3" json:"matched_scope,omitempty"`
+	MatchedScopes map[string]string `protobuf:"bytes,3,rep,name=matched_scopes,json=matchedScopes,proto3" json:"matched_scopes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourcesResponse_Meta) Reset() {
+	*x = PlanResourcesResponse_Meta{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourcesResponse_Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourcesResponse_Meta) ProtoMessage() {}
+
+func (x *PlanResourcesResponse_Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourcesResponse_Meta.ProtoReflect.Descriptor instead.
+func (*PlanResourcesResponse_Meta) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *PlanResourcesResponse_Meta) GetFilterDebug() string {
+	if x != nil {
+		return x.FilterDebug
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in cerbos/response/v1/response.proto.
+func (x *PlanResourcesResponse_Meta) GetMatchedScope() string {
+	if x != nil {
+		return x.MatchedScope
+	}
+	return ""
 }
 
-// Deprecated: Use AddOrUpdateSchemaResponse.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateSchemaResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{17}
+func (x *PlanResourcesResponse_Meta) GetMatchedScopes() map[string]string {
+	if x != nil {
+		return x.MatchedScopes
+	}
+	return nil
 }
 
-type ListSchemasResponse struct {
+type PlanResourcesResponse_Sql struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SchemaIds     []string               `protobuf:"bytes,1,rep,name=schema_ids,json=schemaIds,proto3" json:"schema_ids,omitempty"`
+	Where         string                 `protobuf:"bytes,1,opt,name=where,proto3" json:"where,omitempty"`
+	Args          []*structpb.Value      `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSchemasResponse) Reset() {
-	*x = ListSchemasResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[18]
+func (x *PlanResourcesResponse_Sql) Reset() {
+	*x = PlanResourcesResponse_Sql{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSchemasResponse) String() string {
+func (x *PlanResourcesResponse_Sql) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSchemasResponse) ProtoMessage() {}
+func (*PlanResourcesResponse_Sql) ProtoMessage() {}
 
-func (x *ListSchemasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[18]
+func (x *PlanResourcesResponse_Sql) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,40 +1935,47 @@ func (x *ListSchemasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSchemasResponse.ProtoReflect.Descriptor instead.
-func (*ListSchemasResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PlanResourcesResponse_Sql.ProtoReflect.Descriptor instead.
+func (*PlanResourcesResponse_Sql) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{0, 1}
 }
 
-func (x *ListSchemasResponse) GetSchemaIds() []string {
+func (x *PlanResourcesResponse_Sql) GetWhere() string {
 	if x != nil {
-		return x.SchemaIds
+		return x.Where
+	}
+	return ""
+}
+
+func (x *PlanResourcesResponse_Sql) GetArgs() []*structpb.Value {
+	if x != nil {
+		return x.Args
 	}
 	return nil
 }
 
-type GetSchemaResponse struct {
+type PlanResourcesResponse_Document struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schemas       []*v11.Schema          `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	Query         *structpb.Struct       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSchemaResponse) Reset() {
-	*x = GetSchemaResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[19]
+func (x *PlanResourcesResponse_Document) Reset() {
+	*x = PlanResourcesResponse_Document{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSchemaResponse) String() string {
+func (x *PlanResourcesResponse_Document) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSchemaResponse) ProtoMessage() {}
+func (*PlanResourcesResponse_Document) ProtoMessage() {}
 
-func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[19]
+func (x *PlanResourcesResponse_Document) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1503,40 +1986,46 @@ func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSchemaResponse.ProtoReflect.Descriptor instead.
-func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PlanResourcesResponse_Document.ProtoReflect.Descriptor instead.
+func (*PlanResourcesResponse_Document) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{0, 2}
 }
 
-func (x *GetSchemaResponse) GetSchemas() []*v11.Schema {
+func (x *PlanResourcesResponse_Document) GetQuery() *structpb.Struct {
 	if x != nil {
-		return x.Schemas
+		return x.Query
 	}
 	return nil
 }
 
-type DeleteSchemaResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DeletedSchemas uint32                 `protobuf:"varint,1,opt,name=deleted_schemas,json=deletedSchemas,proto3" json:"deleted_schemas,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type PlanResourcesResponse_Result struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	ResourceKind     string                      `protobuf:"bytes,1,opt,name=resource_kind,json=resourceKind,proto3" json:"resource_kind,omitempty"`
+	PolicyVersion    string                      `protobuf:"bytes,2,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Scope            string                      `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Filter           *v1.PlanResourcesFilter     `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Meta             *PlanResourcesResponse_Meta `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	ValidationErrors []*v11.ValidationError      `protobuf:"bytes,6,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	Now              *timestamppb.Timestamp      `protobuf:"bytes,7,opt,name=now,proto3" json:"now,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DeleteSchemaResponse) Reset() {
-	*x = DeleteSchemaResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[20]
+func (x *PlanResourcesResponse_Result) Reset() {
+	*x = PlanResourcesResponse_Result{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSchemaResponse) String() string {
+func (x *PlanResourcesResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSchemaResponse) ProtoMessage() {}
+func (*PlanResourcesResponse_Result) ProtoMessage() {}
 
-func (x *DeleteSchemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[20]
+func (x *PlanResourcesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,79 +2036,82 @@ func (x *DeleteSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSchemaResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSchemaResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PlanResourcesResponse_Result.ProtoReflect.Descriptor instead.
+func (*PlanResourcesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{0, 3}
 }
 
-func (x *DeleteSchemaResponse) GetDeletedSchemas() uint32 {
+func (x *PlanResourcesResponse_Result) GetResourceKind() string {
 	if x != nil {
-		return x.DeletedSchemas
+		return x.ResourceKind
 	}
-	return 0
+	return ""
 }
 
-type ReloadStoreResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PlanResourcesResponse_Result) GetPolicyVersion() string {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return ""
 }
 
-func (x *ReloadStoreResponse) Reset() {
-	*x = ReloadStoreResponse{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PlanResourcesResponse_Result) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
 }
 
-func (x *ReloadStoreResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PlanResourcesResponse_Result) GetFilter() *v1.PlanResourcesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-func (*ReloadStoreResponse) ProtoMessage() {}
+func (x *PlanResourcesResponse_Result) GetMeta() *PlanResourcesResponse_Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
 
-func (x *ReloadStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[21]
+func (x *PlanResourcesResponse_Result) GetValidationErrors() []*v11.ValidationError {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ValidationErrors
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ReloadStoreResponse.ProtoReflect.Descriptor instead.
-func (*ReloadStoreResponse) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{21}
+func (x *PlanResourcesResponse_Result) GetNow() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Now
+	}
+	return nil
 }
 
-type PlanResourcesResponse_Meta struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	FilterDebug string                 `protobuf:"bytes,1,opt,name=filter_debug,json=filterDebug,proto3" json:"filter_debug,omitempty"`
-	// Deprecated: Marked as deprecated in cerbos/response/v1/response.proto.
-	MatchedScope  string            `protobuf:"bytes,2,opt,name=matched_scope,json=matchedScope,proto3" json:"matched_scope,omitempty"`
-	MatchedScopes map[string]string `protobuf:"bytes,3,rep,name=matched_scopes,json=matchedScopes,proto3" json:"matched_scopes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type PlanPrincipalsResponse_Meta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FilterDebug   string                 `protobuf:"bytes,1,opt,name=filter_debug,json=filterDebug,proto3" json:"filter_debug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlanResourcesResponse_Meta) Reset() {
-	*x = PlanResourcesResponse_Meta{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[22]
+func (x *PlanPrincipalsResponse_Meta) Reset() {
+	*x = PlanPrincipalsResponse_Meta{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlanResourcesResponse_Meta) String() string {
+func (x *PlanPrincipalsResponse_Meta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanResourcesResponse_Meta) ProtoMessage() {}
+func (*PlanPrincipalsResponse_Meta) ProtoMessage() {}
 
-func (x *PlanResourcesResponse_Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[22]
+func (x *PlanPrincipalsResponse_Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1630,33 +2122,18 @@ func (x *PlanResourcesResponse_Meta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanResourcesResponse_Meta.ProtoReflect.Descriptor instead.
-func (*PlanResourcesResponse_Meta) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use PlanPrincipalsResponse_Meta.ProtoReflect.Descriptor instead.
+func (*PlanPrincipalsResponse_Meta) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *PlanResourcesResponse_Meta) GetFilterDebug() string {
+func (x *PlanPrincipalsResponse_Meta) GetFilterDebug() string {
 	if x != nil {
 		return x.FilterDebug
 	}
 	return ""
 }
 
-// Deprecated: Marked as deprecated in cerbos/response/v1/response.proto.
-func (x *PlanResourcesResponse_Meta) GetMatchedScope() string {
-	if x != nil {
-		return x.MatchedScope
-	}
-	return ""
-}
-
-func (x *PlanResourcesResponse_Meta) GetMatchedScopes() map[string]string {
-	if x != nil {
-		return x.MatchedScopes
-	}
-	return nil
-}
-
 type CheckResourceSetResponse_ActionEffectMap struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Actions          map[string]v14.Effect  `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=cerbos.effect.v1.Effect"`
@@ -1667,7 +2144,7 @@ type CheckResourceSetResponse_ActionEffectMap struct {
 
 func (x *CheckResourceSetResponse_ActionEffectMap) Reset() {
 	*x = CheckResourceSetResponse_ActionEffectMap{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[24]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1679,7 +2156,7 @@ func (x *CheckResourceSetResponse_ActionEffectMap) String() string {
 func (*CheckResourceSetResponse_ActionEffectMap) ProtoMessage() {}
 
 func (x *CheckResourceSetResponse_ActionEffectMap) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[24]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,7 +2169,7 @@ func (x *CheckResourceSetResponse_ActionEffectMap) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CheckResourceSetResponse_ActionEffectMap.ProtoReflect.Descriptor instead.
 func (*CheckResourceSetResponse_ActionEffectMap) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{1, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *CheckResourceSetResponse_ActionEffectMap) GetActions() map[string]v14.Effect {
@@ -1718,7 +2195,7 @@ type CheckResourceSetResponse_Meta struct {
 
 func (x *CheckResourceSetResponse_Meta) Reset() {
 	*x = CheckResourceSetResponse_Meta{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[25]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1730,7 +2207,7 @@ func (x *CheckResourceSetResponse_Meta) String() string {
 func (*CheckResourceSetResponse_Meta) ProtoMessage() {}
 
 func (x *CheckResourceSetResponse_Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[25]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +2220,7 @@ func (x *CheckResourceSetResponse_Meta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResourceSetResponse_Meta.ProtoReflect.Descriptor instead.
 func (*CheckResourceSetResponse_Meta) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{1, 1}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *CheckResourceSetResponse_Meta) GetResourceInstances() map[string]*CheckResourceSetResponse_Meta_ActionMeta {
@@ -1763,7 +2240,7 @@ type CheckResourceSetResponse_Meta_EffectMeta struct {
 
 func (x *CheckResourceSetResponse_Meta_EffectMeta) Reset() {
 	*x = CheckResourceSetResponse_Meta_EffectMeta{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[28]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1775,7 +2252,7 @@ func (x *CheckResourceSetResponse_Meta_EffectMeta) String() string {
 func (*CheckResourceSetResponse_Meta_EffectMeta) ProtoMessage() {}
 
 func (x *CheckResourceSetResponse_Meta_EffectMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[28]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,7 +2265,7 @@ func (x *CheckResourceSetResponse_Meta_EffectMeta) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CheckResourceSetResponse_Meta_EffectMeta.ProtoReflect.Descriptor instead.
 func (*CheckResourceSetResponse_Meta_EffectMeta) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{1, 1, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{2, 1, 0}
 }
 
 func (x *CheckResourceSetResponse_Meta_EffectMeta) GetMatchedPolicy() string {
@@ -1815,7 +2292,7 @@ type CheckResourceSetResponse_Meta_ActionMeta struct {
 
 func (x *CheckResourceSetResponse_Meta_ActionMeta) Reset() {
 	*x = CheckResourceSetResponse_Meta_ActionMeta{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[29]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1827,7 +2304,7 @@ func (x *CheckResourceSetResponse_Meta_ActionMeta) String() string {
 func (*CheckResourceSetResponse_Meta_ActionMeta) ProtoMessage() {}
 
 func (x *CheckResourceSetResponse_Meta_ActionMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[29]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +2317,7 @@ func (x *CheckResourceSetResponse_Meta_ActionMeta) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CheckResourceSetResponse_Meta_ActionMeta.ProtoReflect.Descriptor instead.
 func (*CheckResourceSetResponse_Meta_ActionMeta) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{1, 1, 1}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{2, 1, 1}
 }
 
 func (x *CheckResourceSetResponse_Meta_ActionMeta) GetActions() map[string]*CheckResourceSetResponse_Meta_EffectMeta {
@@ -1868,7 +2345,7 @@ type CheckResourceBatchResponse_ActionEffectMap struct {
 
 func (x *CheckResourceBatchResponse_ActionEffectMap) Reset() {
 	*x = CheckResourceBatchResponse_ActionEffectMap{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[32]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1880,7 +2357,7 @@ func (x *CheckResourceBatchResponse_ActionEffectMap) String() string {
 func (*CheckResourceBatchResponse_ActionEffectMap) ProtoMessage() {}
 
 func (x *CheckResourceBatchResponse_ActionEffectMap) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[32]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1893,7 +2370,7 @@ func (x *CheckResourceBatchResponse_ActionEffectMap) ProtoReflect() protoreflect
 
 // Deprecated: Use CheckResourceBatchResponse_ActionEffectMap.ProtoReflect.Descriptor instead.
 func (*CheckResourceBatchResponse_ActionEffectMap) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{2, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *CheckResourceBatchResponse_ActionEffectMap) GetResourceId() string {
@@ -1924,13 +2401,14 @@ type CheckResourcesResponse_ResultEntry struct {
 	ValidationErrors []*v11.ValidationError                       `protobuf:"bytes,3,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
 	Meta             *CheckResourcesResponse_ResultEntry_Meta     `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
 	Outputs          []*v1.OutputEntry                            `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Explanation      *v1.Explanation                              `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CheckResourcesResponse_ResultEntry) Reset() {
 	*x = CheckResourcesResponse_ResultEntry{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[34]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1942,7 +2420,7 @@ func (x *CheckResourcesResponse_ResultEntry) String() string {
 func (*CheckResourcesResponse_ResultEntry) ProtoMessage() {}
 
 func (x *CheckResourcesResponse_ResultEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[34]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1955,7 +2433,7 @@ func (x *CheckResourcesResponse_ResultEntry) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CheckResourcesResponse_ResultEntry.ProtoReflect.Descriptor instead.
 func (*CheckResourcesResponse_ResultEntry) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{3, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *CheckResourcesResponse_ResultEntry) GetResource() *CheckResourcesResponse_ResultEntry_Resource {
@@ -1993,6 +2471,13 @@ func (x *CheckResourcesResponse_ResultEntry) GetOutputs() []*v1.OutputEntry {
 	return nil
 }
 
+func (x *CheckResourcesResponse_ResultEntry) GetExplanation() *v1.Explanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
 type CheckResourcesResponse_ResultEntry_Resource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2005,7 +2490,7 @@ type CheckResourcesResponse_ResultEntry_Resource struct {
 
 func (x *CheckResourcesResponse_ResultEntry_Resource) Reset() {
 	*x = CheckResourcesResponse_ResultEntry_Resource{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[35]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2017,7 +2502,7 @@ func (x *CheckResourcesResponse_ResultEntry_Resource) String() string {
 func (*CheckResourcesResponse_ResultEntry_Resource) ProtoMessage() {}
 
 func (x *CheckResourcesResponse_ResultEntry_Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[35]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2030,7 +2515,7 @@ func (x *CheckResourcesResponse_ResultEntry_Resource) ProtoReflect() protoreflec
 
 // Deprecated: Use CheckResourcesResponse_ResultEntry_Resource.ProtoReflect.Descriptor instead.
 func (*CheckResourcesResponse_ResultEntry_Resource) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{3, 0, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4, 0, 0}
 }
 
 func (x *CheckResourcesResponse_ResultEntry_Resource) GetId() string {
@@ -2071,7 +2556,7 @@ type CheckResourcesResponse_ResultEntry_Meta struct {
 
 func (x *CheckResourcesResponse_ResultEntry_Meta) Reset() {
 	*x = CheckResourcesResponse_ResultEntry_Meta{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[36]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2083,7 +2568,7 @@ func (x *CheckResourcesResponse_ResultEntry_Meta) String() string {
 func (*CheckResourcesResponse_ResultEntry_Meta) ProtoMessage() {}
 
 func (x *CheckResourcesResponse_ResultEntry_Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[36]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,7 +2581,7 @@ func (x *CheckResourcesResponse_ResultEntry_Meta) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use CheckResourcesResponse_ResultEntry_Meta.ProtoReflect.Descriptor instead.
 func (*CheckResourcesResponse_ResultEntry_Meta) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{3, 0, 1}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4, 0, 1}
 }
 
 func (x *CheckResourcesResponse_ResultEntry_Meta) GetActions() map[string]*CheckResourcesResponse_ResultEntry_Meta_EffectMeta {
@@ -2123,7 +2608,7 @@ type CheckResourcesResponse_ResultEntry_Meta_EffectMeta struct {
 
 func (x *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) Reset() {
 	*x = CheckResourcesResponse_ResultEntry_Meta_EffectMeta{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[38]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2135,7 +2620,7 @@ func (x *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) String() string {
 func (*CheckResourcesResponse_ResultEntry_Meta_EffectMeta) ProtoMessage() {}
 
 func (x *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[38]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2148,7 +2633,7 @@ func (x *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) ProtoReflect() prot
 
 // Deprecated: Use CheckResourcesResponse_ResultEntry_Meta_EffectMeta.ProtoReflect.Descriptor instead.
 func (*CheckResourcesResponse_ResultEntry_Meta_EffectMeta) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{3, 0, 1, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4, 0, 1, 0}
 }
 
 func (x *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) GetMatchedPolicy() string {
@@ -2176,7 +2661,7 @@ type PlaygroundFailure_ErrorDetails struct {
 
 func (x *PlaygroundFailure_ErrorDetails) Reset() {
 	*x = PlaygroundFailure_ErrorDetails{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[40]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2188,7 +2673,7 @@ func (x *PlaygroundFailure_ErrorDetails) String() string {
 func (*PlaygroundFailure_ErrorDetails) ProtoMessage() {}
 
 func (x *PlaygroundFailure_ErrorDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[40]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2686,7 @@ func (x *PlaygroundFailure_ErrorDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundFailure_ErrorDetails.ProtoReflect.Descriptor instead.
 func (*PlaygroundFailure_ErrorDetails) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *PlaygroundFailure_ErrorDetails) GetLine() uint32 {
@@ -2236,7 +2721,7 @@ type PlaygroundFailure_Error struct {
 
 func (x *PlaygroundFailure_Error) Reset() {
 	*x = PlaygroundFailure_Error{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[41]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2248,7 +2733,7 @@ func (x *PlaygroundFailure_Error) String() string {
 func (*PlaygroundFailure_Error) ProtoMessage() {}
 
 func (x *PlaygroundFailure_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[41]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2261,7 +2746,7 @@ func (x *PlaygroundFailure_Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaygroundFailure_Error.ProtoReflect.Descriptor instead.
 func (*PlaygroundFailure_Error) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{4, 1}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *PlaygroundFailure_Error) GetFile() string {
@@ -2294,7 +2779,7 @@ type PlaygroundTestResponse_TestResults struct {
 
 func (x *PlaygroundTestResponse_TestResults) Reset() {
 	*x = PlaygroundTestResponse_TestResults{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[42]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2306,7 +2791,7 @@ func (x *PlaygroundTestResponse_TestResults) String() string {
 func (*PlaygroundTestResponse_TestResults) ProtoMessage() {}
 
 func (x *PlaygroundTestResponse_TestResults) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[42]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2319,7 +2804,7 @@ func (x *PlaygroundTestResponse_TestResults) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use PlaygroundTestResponse_TestResults.ProtoReflect.Descriptor instead.
 func (*PlaygroundTestResponse_TestResults) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{6, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *PlaygroundTestResponse_TestResults) GetResults() *v13.TestResults {
@@ -2344,7 +2829,7 @@ type PlaygroundEvaluateResponse_EvalResult struct {
 
 func (x *PlaygroundEvaluateResponse_EvalResult) Reset() {
 	*x = PlaygroundEvaluateResponse_EvalResult{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[43]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2356,7 +2841,7 @@ func (x *PlaygroundEvaluateResponse_EvalResult) String() string {
 func (*PlaygroundEvaluateResponse_EvalResult) ProtoMessage() {}
 
 func (x *PlaygroundEvaluateResponse_EvalResult) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[43]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +2854,7 @@ func (x *PlaygroundEvaluateResponse_EvalResult) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use PlaygroundEvaluateResponse_EvalResult.ProtoReflect.Descriptor instead.
 func (*PlaygroundEvaluateResponse_EvalResult) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{7, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *PlaygroundEvaluateResponse_EvalResult) GetAction() string {
@@ -2421,7 +2906,7 @@ type PlaygroundEvaluateResponse_EvalResultList struct {
 
 func (x *PlaygroundEvaluateResponse_EvalResultList) Reset() {
 	*x = PlaygroundEvaluateResponse_EvalResultList{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[44]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2433,7 +2918,7 @@ func (x *PlaygroundEvaluateResponse_EvalResultList) String() string {
 func (*PlaygroundEvaluateResponse_EvalResultList) ProtoMessage() {}
 
 func (x *PlaygroundEvaluateResponse_EvalResultList) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[44]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2446,7 +2931,7 @@ func (x *PlaygroundEvaluateResponse_EvalResultList) ProtoReflect() protoreflect.
 
 // Deprecated: Use PlaygroundEvaluateResponse_EvalResultList.ProtoReflect.Descriptor instead.
 func (*PlaygroundEvaluateResponse_EvalResultList) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{7, 1}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{9, 1}
 }
 
 func (x *PlaygroundEvaluateResponse_EvalResultList) GetResults() []*PlaygroundEvaluateResponse_EvalResult {
@@ -2477,6 +2962,66 @@ func (x *PlaygroundEvaluateResponse_EvalResultList) GetOutputs() []*v1.OutputEnt
 	return nil
 }
 
+type CheckWithPoliciesResponse_Result struct {
+	state          protoimpl.MessageState              `protogen:"open.v1"`
+	Current        *CheckResourcesResponse_ResultEntry `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Candidate      *CheckResourcesResponse_ResultEntry `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	ChangedActions []string                            `protobuf:"bytes,3,rep,name=changed_actions,json=changedActions,proto3" json:"changed_actions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckWithPoliciesResponse_Result) Reset() {
+	*x = CheckWithPoliciesResponse_Result{}
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckWithPoliciesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckWithPoliciesResponse_Result) ProtoMessage() {}
+
+func (x *CheckWithPoliciesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckWithPoliciesResponse_Result.ProtoReflect.Descriptor instead.
+func (*CheckWithPoliciesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *CheckWithPoliciesResponse_Result) GetCurrent() *CheckResourcesResponse_ResultEntry {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *CheckWithPoliciesResponse_Result) GetCandidate() *CheckResourcesResponse_ResultEntry {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *CheckWithPoliciesResponse_Result) GetChangedActions() []string {
+	if x != nil {
+		return x.ChangedActions
+	}
+	return nil
+}
+
 type InspectPoliciesResponse_Attribute struct {
 	state         protoimpl.MessageState                 `protogen:"open.v1"`
 	Kind          InspectPoliciesResponse_Attribute_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=cerbos.response.v1.InspectPoliciesResponse_Attribute_Kind" json:"kind,omitempty"`
@@ -2487,7 +3032,7 @@ type InspectPoliciesResponse_Attribute struct {
 
 func (x *InspectPoliciesResponse_Attribute) Reset() {
 	*x = InspectPoliciesResponse_Attribute{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[45]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2499,7 +3044,7 @@ func (x *InspectPoliciesResponse_Attribute) String() string {
 func (*InspectPoliciesResponse_Attribute) ProtoMessage() {}
 
 func (x *InspectPoliciesResponse_Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[45]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2512,7 +3057,7 @@ func (x *InspectPoliciesResponse_Attribute) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InspectPoliciesResponse_Attribute.ProtoReflect.Descriptor instead.
 func (*InspectPoliciesResponse_Attribute) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 0}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *InspectPoliciesResponse_Attribute) GetKind() InspectPoliciesResponse_Attribute_Kind {
@@ -2540,7 +3085,7 @@ type InspectPoliciesResponse_DerivedRole struct {
 
 func (x *InspectPoliciesResponse_DerivedRole) Reset() {
 	*x = InspectPoliciesResponse_DerivedRole{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[46]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2552,7 +3097,7 @@ func (x *InspectPoliciesResponse_DerivedRole) String() string {
 func (*InspectPoliciesResponse_DerivedRole) ProtoMessage() {}
 
 func (x *InspectPoliciesResponse_DerivedRole) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[46]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2565,7 +3110,7 @@ func (x *InspectPoliciesResponse_DerivedRole) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use InspectPoliciesResponse_DerivedRole.ProtoReflect.Descriptor instead.
 func (*InspectPoliciesResponse_DerivedRole) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 1}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 1}
 }
 
 func (x *InspectPoliciesResponse_DerivedRole) GetName() string {
@@ -2602,7 +3147,7 @@ type InspectPoliciesResponse_Constant struct {
 
 func (x *InspectPoliciesResponse_Constant) Reset() {
 	*x = InspectPoliciesResponse_Constant{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[47]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2614,7 +3159,7 @@ func (x *InspectPoliciesResponse_Constant) String() string {
 func (*InspectPoliciesResponse_Constant) ProtoMessage() {}
 
 func (x *InspectPoliciesResponse_Constant) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[47]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2627,7 +3172,7 @@ func (x *InspectPoliciesResponse_Constant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectPoliciesResponse_Constant.ProtoReflect.Descriptor instead.
 func (*InspectPoliciesResponse_Constant) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 2}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 2}
 }
 
 func (x *InspectPoliciesResponse_Constant) GetName() string {
@@ -2678,7 +3223,7 @@ type InspectPoliciesResponse_Variable struct {
 
 func (x *InspectPoliciesResponse_Variable) Reset() {
 	*x = InspectPoliciesResponse_Variable{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[48]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2690,7 +3235,7 @@ func (x *InspectPoliciesResponse_Variable) String() string {
 func (*InspectPoliciesResponse_Variable) ProtoMessage() {}
 
 func (x *InspectPoliciesResponse_Variable) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[48]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,7 +3248,7 @@ func (x *InspectPoliciesResponse_Variable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectPoliciesResponse_Variable.ProtoReflect.Descriptor instead.
 func (*InspectPoliciesResponse_Variable) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 3}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 3}
 }
 
 func (x *InspectPoliciesResponse_Variable) GetName() string {
@@ -2755,7 +3300,7 @@ type InspectPoliciesResponse_Result struct {
 
 func (x *InspectPoliciesResponse_Result) Reset() {
 	*x = InspectPoliciesResponse_Result{}
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[49]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2767,7 +3312,7 @@ func (x *InspectPoliciesResponse_Result) String() string {
 func (*InspectPoliciesResponse_Result) ProtoMessage() {}
 
 func (x *InspectPoliciesResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_cerbos_response_v1_response_proto_msgTypes[49]
+	mi := &file_cerbos_response_v1_response_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2780,7 +3325,7 @@ func (x *InspectPoliciesResponse_Result) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InspectPoliciesResponse_Result.ProtoReflect.Descriptor instead.
 func (*InspectPoliciesResponse_Result) Descriptor() ([]byte, []int) {
-	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{16, 4}
+	return file_cerbos_response_v1_response_proto_rawDescGZIP(), []int{19, 4}
 }
 
 func (x *InspectPoliciesResponse_Result) GetActions() []string {
@@ -2829,8 +3374,7 @@ var File_cerbos_response_v1_response_proto protoreflect.FileDescriptor
 
 const file_cerbos_response_v1_response_proto_rawDesc = "" +
 	"\n" +
-	"!cerbos/response/v1/response.proto\x12\x12cerbos.response.v1\x1a\x1bcerbos/audit/v1/audit.proto\x1a\x1dcerbos/effect/v1/effect.proto\x1a\x1dcerbos/engine/v1/engine.proto\x1a\x1dcerbos/policy/v1/policy.proto\x1a\x1dcerbos/schema/v1/schema.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x91\n" +
-	"\n" +
+	"!cerbos/response/v1/response.proto\x12\x12cerbos.response.v1\x1a\x1bcerbos/audit/v1/audit.proto\x1a\x1dcerbos/effect/v1/effect.proto\x1a\x1dcerbos/engine/v1/engine.proto\x1a\x1dcerbos/policy/v1/policy.proto\x1a\x1dcerbos/schema/v1/schema.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb6\x19\n" +
 	"\x15PlanResourcesResponse\x12o\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tBP\x92AM2#Request ID provided in the request.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\x1a\n" +
@@ -2841,7 +3385,12 @@ const file_cerbos_response_v1_response_proto_rawDesc = "" +
 	"\x06filter\x18\x05 \x01(\v2%.cerbos.engine.v1.PlanResourcesFilterB\v\x92A\b2\x06FilterR\x06filter\x12\x7f\n" +
 	"\x04meta\x18\x06 \x01(\v2..cerbos.response.v1.PlanResourcesResponse.MetaB;\x92A826Optional metadata about the request evaluation processR\x04meta\x12\x90\x01\n" +
 	"\x11validation_errors\x18\a \x03(\v2!.cerbos.schema.v1.ValidationErrorB@\x92A=2;List of validation errors (if schema validation is enabled)R\x10validationErrors\x12Y\n" +
-	"\x0ecerbos_call_id\x18\b \x01(\tB3\x92A02.Audit log call ID associated with this requestR\fcerbosCallId\x1a\x92\x03\n" +
+	"\x0ecerbos_call_id\x18\b \x01(\tB3\x92A02.Audit log call ID associated with this requestR\fcerbosCallId\x12|\n" +
+	"\x03sql\x18\n" +
+	" \x01(\v2-.cerbos.response.v1.PlanResourcesResponse.SqlB;\x92A826Filter translated to SQL. Only populated if requested.R\x03sql\x12\x9e\x01\n" +
+	"\bdocument\x18\v \x01(\v22.cerbos.response.v1.PlanResourcesResponse.DocumentBN\x92AK2IFilter translated to a document store query. Only populated if requested.R\bdocument\x12\xdb\x01\n" +
+	"\aresults\x18\f \x03(\v20.cerbos.response.v1.PlanResourcesResponse.ResultB\x8e\x01\x92A\x8a\x012\x87\x01Query plans for each of the resources in the request, in the same order. Only populated if the resources field of the request was used.R\aresults\x12\xa7\x01\n" +
+	"\x03now\x18\r \x01(\v2\x1a.google.protobuf.TimestampBy\x92Av2tTime used as the value of now() when producing the filter. Only populated if the filter depends on the current time.R\x03now\x1a\x92\x03\n" +
 	"\x04Meta\x12]\n" +
 	"\ffilter_debug\x18\x01 \x01(\tB:\x92A725Filter textual representation for debugging purposes.R\vfilterDebug\x12'\n" +
 	"\rmatched_scope\x18\x02 \x01(\tB\x02\x18\x01R\fmatchedScope\x12\x94\x01\n" +
@@ -2849,8 +3398,39 @@ const file_cerbos_response_v1_response_proto_rawDesc = "" +
 	"\x12MatchedScopesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:)\x92A&\n" +
-	"$2\"Metadata about request evaluation.:<\x92A9\n" +
-	"725Resources query plan response for a set of resources.\"\xc8\x15\n" +
+	"$2\"Metadata about request evaluation.\x1a\x9b\x02\n" +
+	"\x03Sql\x12q\n" +
+	"\x05where\x18\x01 \x01(\tB[\x92AX26Parameterised WHERE clause, without the WHERE keyword.J\x1e\"\\\"documents\\\".\\\"owner\\\" = $1\"R\x05where\x12p\n" +
+	"\x04args\x18\x02 \x03(\v2\x16.google.protobuf.ValueBD\x92AA2?Values to bind to the parameters of the WHERE clause, in order.R\x04args:/\x92A,\n" +
+	"*2(Filter translated to a SQL WHERE clause.\x1a\xbd\x01\n" +
+	"\bDocument\x12|\n" +
+	"\x05query\x18\x01 \x01(\v2\x17.google.protobuf.StructBM\x92AJ2'Query document in the requested format.J\x1f{\"owner.id\": {\"$eq\": \"alicia\"}}R\x05query:3\x92A0\n" +
+	".2,Filter translated to a document store query.\x1a\x9d\x06\n" +
+	"\x06Result\x12H\n" +
+	"\rresource_kind\x18\x01 \x01(\tB#\x92A 2\x0eResource kind.J\x0e\"album:object\"R\fresourceKind\x12J\n" +
+	"\x0epolicy_version\x18\x02 \x01(\tB#\x92A 2\x13The policy version.J\t\"default\"R\rpolicyVersion\x127\n" +
+	"\x05scope\x18\x03 \x01(\tB!\x92A\x1e2\x0fResource scope.J\v\"acme.corp\"R\x05scope\x12J\n" +
+	"\x06filter\x18\x04 \x01(\v2%.cerbos.engine.v1.PlanResourcesFilterB\v\x92A\b2\x06FilterR\x06filter\x12\x7f\n" +
+	"\x04meta\x18\x05 \x01(\v2..cerbos.response.v1.PlanResourcesResponse.MetaB;\x92A826Optional metadata about the request evaluation processR\x04meta\x12\x90\x01\n" +
+	"\x11validation_errors\x18\x06 \x03(\v2!.cerbos.schema.v1.ValidationErrorB@\x92A=2;List of validation errors (if schema validation is enabled)R\x10validationErrors\x12\xa7\x01\n" +
+	"\x03now\x18\a \x01(\v2\x1a.google.protobuf.TimestampBy\x92Av2tTime used as the value of now() when producing the filter. Only populated if the filter depends on the current time.R\x03now::\x92A7\n" +
+	"523Query plan for one of the resources in the request.:<\x92A9\n" +
+	"725Resources query plan response for a set of resources.\"\xec\a\n" +
+	"\x16PlanPrincipalsResponse\x12o\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tBP\x92AM2#Request ID provided in the request.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x128\n" +
+	"\aactions\x18\x02 \x03(\tB\x1e\x92A\x1b2\aActionsJ\x10[\"view\", \"edit\"]R\aactions\x12H\n" +
+	"\rresource_kind\x18\x03 \x01(\tB#\x92A 2\x0eResource kind.J\x0e\"album:object\"R\fresourceKind\x12;\n" +
+	"\vresource_id\x18\x04 \x01(\tB\x1a\x92A\x172\fResource ID.J\a\"XX125\"R\n" +
+	"resourceId\x12J\n" +
+	"\x0epolicy_version\x18\x05 \x01(\tB#\x92A 2\x13The policy version.J\t\"default\"R\rpolicyVersion\x12\xab\x01\n" +
+	"\x06filter\x18\x06 \x01(\v2%.cerbos.engine.v1.PlanResourcesFilterBl\x92Ai2gFilter over the attributes, roles and ID of the principals that are allowed to perform all the actions.R\x06filter\x12\x80\x01\n" +
+	"\x04meta\x18\a \x01(\v2/.cerbos.response.v1.PlanPrincipalsResponse.MetaB;\x92A826Optional metadata about the request evaluation processR\x04meta\x12Y\n" +
+	"\x0ecerbos_call_id\x18\b \x01(\tB3\x92A02.Audit log call ID associated with this requestR\fcerbosCallId\x1a\x90\x01\n" +
+	"\x04Meta\x12]\n" +
+	"\ffilter_debug\x18\x01 \x01(\tB:\x92A725Filter textual representation for debugging purposes.R\vfilterDebug:)\x92A&\n" +
+	"$2\"Metadata about request evaluation.:5\x92A2\n" +
+	"02.Principals query plan response for a resource.\"\xc8\x15\n" +
 	"\x18CheckResourceSetResponse\x12o\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tBP\x92AM2#Request ID provided in the request.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\xdb\x02\n" +
@@ -2897,18 +3477,19 @@ const file_cerbos_response_v1_response_proto_rawDesc = "" +
 	"\fActionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
 	"\x05value\x18\x02 \x01(\x0e2\x18.cerbos.effect.v1.EffectR\x05value:\x028\x01:;\x92A8\n" +
-	"624Policy evaluation response for a batch of resources.\"\xac\x17\n" +
+	"624Policy evaluation response for a batch of resources.\"\xbc\x18\n" +
 	"\x16CheckResourcesResponse\x12o\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tBP\x92AM2#Request ID provided in the request.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\xe3\x01\n" +
 	"\aresults\x18\x02 \x03(\v26.cerbos.response.v1.CheckResourcesResponse.ResultEntryB\x90\x01\x92A\x8c\x012\x18Result for each resourceJp[{\"resource\": {\"Id\":\"XX125\", \"kind\":\"album:object\"}, \"actions\":{\"view\":\"EFFECT_ALLOW\",\"comment\":\"EFFECT_DENY\"}}]R\aresults\x12Y\n" +
-	"\x0ecerbos_call_id\x18\x03 \x01(\tB3\x92A02.Audit log call ID associated with this requestR\fcerbosCallId\x1a\xab\x13\n" +
+	"\x0ecerbos_call_id\x18\x03 \x01(\tB3\x92A02.Audit log call ID associated with this requestR\fcerbosCallId\x1a\xbb\x14\n" +
 	"\vResultEntry\x12[\n" +
 	"\bresource\x18\x01 \x01(\v2?.cerbos.response.v1.CheckResourcesResponse.ResultEntry.ResourceR\bresource\x12\xa1\x01\n" +
 	"\aactions\x18\x02 \x03(\v2C.cerbos.response.v1.CheckResourcesResponse.ResultEntry.ActionsEntryBB\x92A?2$Mapping of each action to an effect.J\x17{\"view\":\"EFFECT_ALLOW\"}R\aactions\x12\x90\x01\n" +
 	"\x11validation_errors\x18\x03 \x03(\v2!.cerbos.schema.v1.ValidationErrorB@\x92A=2;List of validation errors (if schema validation is enabled)R\x10validationErrors\x12\x98\x02\n" +
 	"\x04meta\x18\x04 \x01(\v2;.cerbos.response.v1.CheckResourcesResponse.ResultEntry.MetaB\xc6\x01\x92A\xc2\x012 Metadata about policy evaluationJ\x9d\x01{\"actions\": {\"view:*\":{\"matched_policy\": \"album:object:default\"},\"comment\":{\"matched_policy\": \"album:object:default\"}}, \"effective_derived_roles\": [\"owner\"]}R\x04meta\x12\xf6\x01\n" +
-	"\aoutputs\x18\x05 \x03(\v2\x1d.cerbos.engine.v1.OutputEntryB\xbc\x01\x92A\xb8\x012,Output for each rule with outputs configuredJ\x87\x01[{\"src\": \"resource.expense.v1/acme#rule-001\", \"val\": \"view_allowed:alice\"}, {\"src\": \"resource.expense.v1/acme#rule-002\", \"val\": \"foo\"}]R\aoutputs\x1a\xaa\x04\n" +
+	"\aoutputs\x18\x05 \x03(\v2\x1d.cerbos.engine.v1.OutputEntryB\xbc\x01\x92A\xb8\x012,Output for each rule with outputs configuredJ\x87\x01[{\"src\": \"resource.expense.v1/acme#rule-001\", \"val\": \"view_allowed:alice\"}, {\"src\": \"resource.expense.v1/acme#rule-002\", \"val\": \"foo\"}]R\aoutputs\x12\x8d\x01\n" +
+	"\vexplanation\x18\x06 \x01(\v2\x1d.cerbos.engine.v1.ExplanationBL\x92AI2GExplanation of how the effect of each action was decided (if requested)R\vexplanation\x1a\xaa\x04\n" +
 	"\bResource\x129\n" +
 	"\x02id\x18\x01 \x01(\tB)\x92A&2\x1bID of the resource instanceJ\a\"XX125\"R\x02id\x12Q\n" +
 	"\x04kind\x18\x02 \x01(\tB=\x92A:2)Name of the resource kind being accessed.J\r\"album:photo\"R\x04kind\x12\xbf\x01\n" +
@@ -2929,7 +3510,13 @@ const file_cerbos_response_v1_response_proto_rawDesc = "" +
 	"\fActionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
 	"\x05value\x18\x02 \x01(\x0e2\x18.cerbos.effect.v1.EffectR\x05value:\x028\x01:2\x92A/\n" +
-	"-2+Response from the check resources API call.\"\xcb\x02\n" +
+	"-2+Response from the check resources API call.\"\xb2\x03\n" +
+	"\x14ExplainCheckResponse\x12o\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tBP\x92AM2#Request ID provided in the request.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\x9b\x01\n" +
+	"\aresults\x18\x02 \x03(\v26.cerbos.response.v1.CheckResourcesResponse.ResultEntryBI\x92AF2DResult for each resource, including the explanation of each decisionR\aresults\x12Y\n" +
+	"\x0ecerbos_call_id\x18\x03 \x01(\tB3\x92A02.Audit log call ID associated with this requestR\fcerbosCallId:0\x92A-\n" +
+	"+2)Response from the explain check API call.\"\xcb\x02\n" +
 	"\x11PlaygroundFailure\x12C\n" +
 	"\x06errors\x18\x01 \x03(\v2+.cerbos.response.v1.PlaygroundFailure.ErrorR\x06errors\x1aT\n" +
 	"\fErrorDetails\x12\x12\n" +
@@ -2984,7 +3571,17 @@ const file_cerbos_response_v1_response_proto_rawDesc = "" +
 	"\aoutcome\"p\n" +
 	"\x19AddOrUpdatePolicyResponse\x120\n" +
 	"\asuccess\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\asuccess:!\x92A\x1e\n" +
-	"\x1c2\x1aAdd/update policy response\"\xe0\x01\n" +
+	"\x1c2\x1aAdd/update policy response\"\xe3\x06\n" +
+	"\x19CheckWithPoliciesResponse\x12o\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tBP\x92AM2#Request ID provided in the request.J&\"c2db17b8-4f9f-4fb1-acfd-9162a02be42b\"R\trequestId\x12\x90\x01\n" +
+	"\aresults\x18\x02 \x03(\v24.cerbos.response.v1.CheckWithPoliciesResponse.ResultB@\x92A=2;Results for each resource, in the same order as the requestR\aresults\x1a\xff\x03\n" +
+	"\x06Result\x12{\n" +
+	"\acurrent\x18\x01 \x01(\v26.cerbos.response.v1.CheckResourcesResponse.ResultEntryB)\x92A&2$Result produced by the live policiesR\acurrent\x12\xa4\x01\n" +
+	"\tcandidate\x18\x02 \x01(\v26.cerbos.response.v1.CheckResourcesResponse.ResultEntryBN\x92AK2IResult produced by the live policies overlaid with the candidate policiesR\tcandidate\x12\x87\x01\n" +
+	"\x0fchanged_actions\x18\x03 \x03(\tB^\x92A[2DActions whose effect differs between the live and candidate policiesJ\x13[\"view\", \"comment\"]R\x0echangedActions:G\x92AD\n" +
+	"B2@Decisions for a resource made by the live and candidate policies:@\x92A=\n" +
+	";29Response from the check with candidate policies API call.\"\xe0\x01\n" +
 	"\x1bListAuditLogEntriesResponse\x12K\n" +
 	"\x10access_log_entry\x18\x01 \x01(\v2\x1f.cerbos.audit.v1.AccessLogEntryH\x00R\x0eaccessLogEntry\x12Q\n" +
 	"\x12decision_log_entry\x18\x02 \x01(\v2!.cerbos.audit.v1.DecisionLogEntryH\x00R\x10decisionLogEntry:\x18\x92A\x15\n" +
@@ -3097,143 +3694,171 @@ func file_cerbos_response_v1_response_proto_rawDescGZIP() []byte {
 }
 
 var file_cerbos_response_v1_response_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_cerbos_response_v1_response_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_cerbos_response_v1_response_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_cerbos_response_v1_response_proto_goTypes = []any{
 	(InspectPoliciesResponse_Attribute_Kind)(0),      // 0: cerbos.response.v1.InspectPoliciesResponse.Attribute.Kind
 	(InspectPoliciesResponse_DerivedRole_Kind)(0),    // 1: cerbos.response.v1.InspectPoliciesResponse.DerivedRole.Kind
 	(InspectPoliciesResponse_Constant_Kind)(0),       // 2: cerbos.response.v1.InspectPoliciesResponse.Constant.Kind
 	(InspectPoliciesResponse_Variable_Kind)(0),       // 3: cerbos.response.v1.InspectPoliciesResponse.Variable.Kind
 	(*PlanResourcesResponse)(nil),                    // 4: cerbos.response.v1.PlanResourcesResponse
-	(*CheckResourceSetResponse)(nil),                 // 5: cerbos.response.v1.CheckResourceSetResponse
-	(*CheckResourceBatchResponse)(nil),               // 6: cerbos.response.v1.CheckResourceBatchResponse
-	(*CheckResourcesResponse)(nil),                   // 7: cerbos.response.v1.CheckResourcesResponse
-	(*PlaygroundFailure)(nil),                        // 8: cerbos.response.v1.PlaygroundFailure
-	(*PlaygroundValidateResponse)(nil),               // 9: cerbos.response.v1.PlaygroundValidateResponse
-	(*PlaygroundTestResponse)(nil),                   // 10: cerbos.response.v1.PlaygroundTestResponse
-	(*PlaygroundEvaluateResponse)(nil),               // 11: cerbos.response.v1.PlaygroundEvaluateResponse
-	(*PlaygroundProxyResponse)(nil),                  // 12: cerbos.response.v1.PlaygroundProxyResponse
-	(*AddOrUpdatePolicyResponse)(nil),                // 13: cerbos.response.v1.AddOrUpdatePolicyResponse
-	(*ListAuditLogEntriesResponse)(nil),              // 14: cerbos.response.v1.ListAuditLogEntriesResponse
-	(*ServerInfoResponse)(nil),                       // 15: cerbos.response.v1.ServerInfoResponse
-	(*ListPoliciesResponse)(nil),                     // 16: cerbos.response.v1.ListPoliciesResponse
-	(*GetPolicyResponse)(nil),                        // 17: cerbos.response.v1.GetPolicyResponse
-	(*DisablePolicyResponse)(nil),                    // 18: cerbos.response.v1.DisablePolicyResponse
-	(*EnablePolicyResponse)(nil),                     // 19: cerbos.response.v1.EnablePolicyResponse
-	(*InspectPoliciesResponse)(nil),                  // 20: cerbos.response.v1.InspectPoliciesResponse
-	(*AddOrUpdateSchemaResponse)(nil),                // 21: cerbos.response.v1.AddOrUpdateSchemaResponse
-	(*ListSchemasResponse)(nil),                      // 22: cerbos.response.v1.ListSchemasResponse
-	(*GetSchemaResponse)(nil),                        // 23: cerbos.response.v1.GetSchemaResponse
-	(*DeleteSchemaResponse)(nil),                     // 24: cerbos.response.v1.DeleteSchemaResponse
-	(*ReloadStoreResponse)(nil),                      // 25: cerbos.response.v1.ReloadStoreResponse
-	(*PlanResourcesResponse_Meta)(nil),               // 26: cerbos.response.v1.PlanResourcesResponse.Meta
-	nil,                                              // 27: cerbos.response.v1.PlanResourcesResponse.Meta.MatchedScopesEntry
-	(*CheckResourceSetResponse_ActionEffectMap)(nil), // 28: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap
-	(*CheckResourceSetResponse_Meta)(nil),            // 29: cerbos.response.v1.CheckResourceSetResponse.Meta
-	nil,                                              // 30: cerbos.response.v1.CheckResourceSetResponse.ResourceInstancesEntry
-	nil,                                              // 31: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.ActionsEntry
-	(*CheckResourceSetResponse_Meta_EffectMeta)(nil), // 32: cerbos.response.v1.CheckResourceSetResponse.Meta.EffectMeta
-	(*CheckResourceSetResponse_Meta_ActionMeta)(nil), // 33: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta
-	nil, // 34: cerbos.response.v1.CheckResourceSetResponse.Meta.ResourceInstancesEntry
-	nil, // 35: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.ActionsEntry
-	(*CheckResourceBatchResponse_ActionEffectMap)(nil), // 36: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap
-	nil, // 37: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.ActionsEntry
-	(*CheckResourcesResponse_ResultEntry)(nil),          // 38: cerbos.response.v1.CheckResourcesResponse.ResultEntry
-	(*CheckResourcesResponse_ResultEntry_Resource)(nil), // 39: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Resource
-	(*CheckResourcesResponse_ResultEntry_Meta)(nil),     // 40: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta
-	nil, // 41: cerbos.response.v1.CheckResourcesResponse.ResultEntry.ActionsEntry
-	(*CheckResourcesResponse_ResultEntry_Meta_EffectMeta)(nil), // 42: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.EffectMeta
-	nil,                                    // 43: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.ActionsEntry
-	(*PlaygroundFailure_ErrorDetails)(nil), // 44: cerbos.response.v1.PlaygroundFailure.ErrorDetails
-	(*PlaygroundFailure_Error)(nil),        // 45: cerbos.response.v1.PlaygroundFailure.Error
-	(*PlaygroundTestResponse_TestResults)(nil),        // 46: cerbos.response.v1.PlaygroundTestResponse.TestResults
-	(*PlaygroundEvaluateResponse_EvalResult)(nil),     // 47: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult
-	(*PlaygroundEvaluateResponse_EvalResultList)(nil), // 48: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList
-	(*InspectPoliciesResponse_Attribute)(nil),         // 49: cerbos.response.v1.InspectPoliciesResponse.Attribute
-	(*InspectPoliciesResponse_DerivedRole)(nil),       // 50: cerbos.response.v1.InspectPoliciesResponse.DerivedRole
-	(*InspectPoliciesResponse_Constant)(nil),          // 51: cerbos.response.v1.InspectPoliciesResponse.Constant
-	(*InspectPoliciesResponse_Variable)(nil),          // 52: cerbos.response.v1.InspectPoliciesResponse.Variable
-	(*InspectPoliciesResponse_Result)(nil),            // 53: cerbos.response.v1.InspectPoliciesResponse.Result
-	nil,                                               // 54: cerbos.response.v1.InspectPoliciesResponse.ResultsEntry
-	(*v1.PlanResourcesFilter)(nil),                    // 55: cerbos.engine.v1.PlanResourcesFilter
-	(*v11.ValidationError)(nil),                       // 56: cerbos.schema.v1.ValidationError
-	(*emptypb.Empty)(nil),                             // 57: google.protobuf.Empty
-	(*v12.AccessLogEntry)(nil),                        // 58: cerbos.audit.v1.AccessLogEntry
-	(*v12.DecisionLogEntry)(nil),                      // 59: cerbos.audit.v1.DecisionLogEntry
-	(*v13.Policy)(nil),                                // 60: cerbos.policy.v1.Policy
-	(*v11.Schema)(nil),                                // 61: cerbos.schema.v1.Schema
-	(v14.Effect)(0),                                   // 62: cerbos.effect.v1.Effect
-	(*v1.OutputEntry)(nil),                            // 63: cerbos.engine.v1.OutputEntry
-	(*v13.TestResults)(nil),                           // 64: cerbos.policy.v1.TestResults
-	(*structpb.Value)(nil),                            // 65: google.protobuf.Value
+	(*PlanPrincipalsResponse)(nil),                   // 5: cerbos.response.v1.PlanPrincipalsResponse
+	(*CheckResourceSetResponse)(nil),                 // 6: cerbos.response.v1.CheckResourceSetResponse
+	(*CheckResourceBatchResponse)(nil),               // 7: cerbos.response.v1.CheckResourceBatchResponse
+	(*CheckResourcesResponse)(nil),                   // 8: cerbos.response.v1.CheckResourcesResponse
+	(*ExplainCheckResponse)(nil),                     // 9: cerbos.response.v1.ExplainCheckResponse
+	(*PlaygroundFailure)(nil),                        // 10: cerbos.response.v1.PlaygroundFailure
+	(*PlaygroundValidateResponse)(nil),               // 11: cerbos.response.v1.PlaygroundValidateResponse
+	(*PlaygroundTestResponse)(nil),                   // 12: cerbos.response.v1.PlaygroundTestResponse
+	(*PlaygroundEvaluateResponse)(nil),               // 13: cerbos.response.v1.PlaygroundEvaluateResponse
+	(*PlaygroundProxyResponse)(nil),                  // 14: cerbos.response.v1.PlaygroundProxyResponse
+	(*AddOrUpdatePolicyResponse)(nil),                // 15: cerbos.response.v1.AddOrUpdatePolicyResponse
+	(*CheckWithPoliciesResponse)(nil),                // 16: cerbos.response.v1.CheckWithPoliciesResponse
+	(*ListAuditLogEntriesResponse)(nil),              // 17: cerbos.response.v1.ListAuditLogEntriesResponse
+	(*ServerInfoResponse)(nil),                       // 18: cerbos.response.v1.ServerInfoResponse
+	(*ListPoliciesResponse)(nil),                     // 19: cerbos.response.v1.ListPoliciesResponse
+	(*GetPolicyResponse)(nil),                        // 20: cerbos.response.v1.GetPolicyResponse
+	(*DisablePolicyResponse)(nil),                    // 21: cerbos.response.v1.DisablePolicyResponse
+	(*EnablePolicyResponse)(nil),                     // 22: cerbos.response.v1.EnablePolicyResponse
+	(*InspectPoliciesResponse)(nil),                  // 23: cerbos.response.v1.InspectPoliciesResponse
+	(*AddOrUpdateSchemaResponse)(nil),                // 24: cerbos.response.v1.AddOrUpdateSchemaResponse
+	(*ListSchemasResponse)(nil),                      // 25: cerbos.response.v1.ListSchemasResponse
+	(*GetSchemaResponse)(nil),                        // 26: cerbos.response.v1.GetSchemaResponse
+	(*DeleteSchemaResponse)(nil),                     // 27: cerbos.response.v1.DeleteSchemaResponse
+	(*ReloadStoreResponse)(nil),                      // 28: cerbos.response.v1.ReloadStoreResponse
+	(*PlanResourcesResponse_Meta)(nil),               // 29: cerbos.response.v1.PlanResourcesResponse.Meta
+	(*PlanResourcesResponse_Sql)(nil),                // 30: cerbos.response.v1.PlanResourcesResponse.Sql
+	(*PlanResourcesResponse_Document)(nil),           // 31: cerbos.response.v1.PlanResourcesResponse.Document
+	(*PlanResourcesResponse_Result)(nil),             // 32: cerbos.response.v1.PlanResourcesResponse.Result
+	nil,                                              // 33: cerbos.response.v1.PlanResourcesResponse.Meta.MatchedScopesEntry
+	(*PlanPrincipalsResponse_Meta)(nil),              // 34: cerbos.response.v1.PlanPrincipalsResponse.Meta
+	(*CheckResourceSetResponse_ActionEffectMap)(nil), // 35: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap
+	(*CheckResourceSetResponse_Meta)(nil),            // 36: cerbos.response.v1.CheckResourceSetResponse.Meta
+	nil,                                              // 37: cerbos.response.v1.CheckResourceSetResponse.ResourceInstancesEntry
+	nil,                                              // 38: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.ActionsEntry
+	(*CheckResourceSetResponse_Meta_EffectMeta)(nil), // 39: cerbos.response.v1.CheckResourceSetResponse.Meta.EffectMeta
+	(*CheckResourceSetResponse_Meta_ActionMeta)(nil), // 40: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta
+	nil, // 41: cerbos.response.v1.CheckResourceSetResponse.Meta.ResourceInstancesEntry
+	nil, // 42: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.ActionsEntry
+	(*CheckResourceBatchResponse_ActionEffectMap)(nil), // 43: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap
+	nil, // 44: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.ActionsEntry
+	(*CheckResourcesResponse_ResultEntry)(nil),          // 45: cerbos.response.v1.CheckResourcesResponse.ResultEntry
+	(*CheckResourcesResponse_ResultEntry_Resource)(nil), // 46: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Resource
+	(*CheckResourcesResponse_ResultEntry_Meta)(nil),     // 47: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta
+	nil, // 48: cerbos.response.v1.CheckResourcesResponse.ResultEntry.ActionsEntry
+	(*CheckResourcesResponse_ResultEntry_Meta_EffectMeta)(nil), // 49: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.EffectMeta
+	nil,                                    // 50: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.ActionsEntry
+	(*PlaygroundFailure_ErrorDetails)(nil), // 51: cerbos.response.v1.PlaygroundFailure.ErrorDetails
+	(*PlaygroundFailure_Error)(nil),        // 52: cerbos.response.v1.PlaygroundFailure.Error
+	(*PlaygroundTestResponse_TestResults)(nil),        // 53: cerbos.response.v1.PlaygroundTestResponse.TestResults
+	(*PlaygroundEvaluateResponse_EvalResult)(nil),     // 54: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult
+	(*PlaygroundEvaluateResponse_EvalResultList)(nil), // 55: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList
+	(*CheckWithPoliciesResponse_Result)(nil),          // 56: cerbos.response.v1.CheckWithPoliciesResponse.Result
+	(*InspectPoliciesResponse_Attribute)(nil),         // 57: cerbos.response.v1.InspectPoliciesResponse.Attribute
+	(*InspectPoliciesResponse_DerivedRole)(nil),       // 58: cerbos.response.v1.InspectPoliciesResponse.DerivedRole
+	(*InspectPoliciesResponse_Constant)(nil),          // 59: cerbos.response.v1.InspectPoliciesResponse.Constant
+	(*InspectPoliciesResponse_Variable)(nil),          // 60: cerbos.response.v1.InspectPoliciesResponse.Variable
+	(*InspectPoliciesResponse_Result)(nil),            // 61: cerbos.response.v1.InspectPoliciesResponse.Result
+	nil,                                               // 62: cerbos.response.v1.InspectPoliciesResponse.ResultsEntry
+	(*v1.PlanResourcesFilter)(nil),                    // 63: cerbos.engine.v1.PlanResourcesFilter
+	(*v11.ValidationError)(nil),                       // 64: cerbos.schema.v1.ValidationError
+	(*timestamppb.Timestamp)(nil),                     // 65: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                             // 66: google.protobuf.Empty
+	(*v12.AccessLogEntry)(nil),                        // 67: cerbos.audit.v1.AccessLogEntry
+	(*v12.DecisionLogEntry)(nil),                      // 68: cerbos.audit.v1.DecisionLogEntry
+	(*v13.Policy)(nil),                                // 69: cerbos.policy.v1.Policy
+	(*v11.Schema)(nil),                                // 70: cerbos.schema.v1.Schema
+	(*structpb.Value)(nil),                            // 71: google.protobuf.Value
+	(*structpb.Struct)(nil),                           // 72: google.protobuf.Struct
+	(v14.Effect)(0),                                   // 73: cerbos.effect.v1.Effect
+	(*v1.OutputEntry)(nil),                            // 74: cerbos.engine.v1.OutputEntry
+	(*v1.Explanation)(nil),                            // 75: cerbos.engine.v1.Explanation
+	(*v13.TestResults)(nil),                           // 76: cerbos.policy.v1.TestResults
 }
 var file_cerbos_response_v1_response_proto_depIdxs = []int32{
-	55, // 0: cerbos.response.v1.PlanResourcesResponse.filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
-	26, // 1: cerbos.response.v1.PlanResourcesResponse.meta:type_name -> cerbos.response.v1.PlanResourcesResponse.Meta
-	56, // 2: cerbos.response.v1.PlanResourcesResponse.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	30, // 3: cerbos.response.v1.CheckResourceSetResponse.resource_instances:type_name -> cerbos.response.v1.CheckResourceSetResponse.ResourceInstancesEntry
-	29, // 4: cerbos.response.v1.CheckResourceSetResponse.meta:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta
-	36, // 5: cerbos.response.v1.CheckResourceBatchResponse.results:type_name -> cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap
-	38, // 6: cerbos.response.v1.CheckResourcesResponse.results:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry
-	45, // 7: cerbos.response.v1.PlaygroundFailure.errors:type_name -> cerbos.response.v1.PlaygroundFailure.Error
-	8,  // 8: cerbos.response.v1.PlaygroundValidateResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
-	57, // 9: cerbos.response.v1.PlaygroundValidateResponse.success:type_name -> google.protobuf.Empty
-	8,  // 10: cerbos.response.v1.PlaygroundTestResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
-	46, // 11: cerbos.response.v1.PlaygroundTestResponse.success:type_name -> cerbos.response.v1.PlaygroundTestResponse.TestResults
-	8,  // 12: cerbos.response.v1.PlaygroundEvaluateResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
-	48, // 13: cerbos.response.v1.PlaygroundEvaluateResponse.success:type_name -> cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList
-	8,  // 14: cerbos.response.v1.PlaygroundProxyResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
-	5,  // 15: cerbos.response.v1.PlaygroundProxyResponse.check_resource_set:type_name -> cerbos.response.v1.CheckResourceSetResponse
-	6,  // 16: cerbos.response.v1.PlaygroundProxyResponse.check_resource_batch:type_name -> cerbos.response.v1.CheckResourceBatchResponse
-	4,  // 17: cerbos.response.v1.PlaygroundProxyResponse.plan_resources:type_name -> cerbos.response.v1.PlanResourcesResponse
-	7,  // 18: cerbos.response.v1.PlaygroundProxyResponse.check_resources:type_name -> cerbos.response.v1.CheckResourcesResponse
-	57, // 19: cerbos.response.v1.AddOrUpdatePolicyResponse.success:type_name -> google.protobuf.Empty
-	58, // 20: cerbos.response.v1.ListAuditLogEntriesResponse.access_log_entry:type_name -> cerbos.audit.v1.AccessLogEntry
-	59, // 21: cerbos.response.v1.ListAuditLogEntriesResponse.decision_log_entry:type_name -> cerbos.audit.v1.DecisionLogEntry
-	60, // 22: cerbos.response.v1.GetPolicyResponse.policies:type_name -> cerbos.policy.v1.Policy
-	54, // 23: cerbos.response.v1.InspectPoliciesResponse.results:type_name -> cerbos.response.v1.InspectPoliciesResponse.ResultsEntry
-	61, // 24: cerbos.response.v1.GetSchemaResponse.schemas:type_name -> cerbos.schema.v1.Schema
-	27, // 25: cerbos.response.v1.PlanResourcesResponse.Meta.matched_scopes:type_name -> cerbos.response.v1.PlanResourcesResponse.Meta.MatchedScopesEntry
-	31, // 26: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.actions:type_name -> cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.ActionsEntry
-	56, // 27: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	34, // 28: cerbos.response.v1.CheckResourceSetResponse.Meta.resource_instances:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.ResourceInstancesEntry
-	28, // 29: cerbos.response.v1.CheckResourceSetResponse.ResourceInstancesEntry.value:type_name -> cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap
-	62, // 30: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
-	35, // 31: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.actions:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.ActionsEntry
-	33, // 32: cerbos.response.v1.CheckResourceSetResponse.Meta.ResourceInstancesEntry.value:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta
-	32, // 33: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.ActionsEntry.value:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.EffectMeta
-	37, // 34: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.actions:type_name -> cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.ActionsEntry
-	56, // 35: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	62, // 36: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
-	39, // 37: cerbos.response.v1.CheckResourcesResponse.ResultEntry.resource:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Resource
-	41, // 38: cerbos.response.v1.CheckResourcesResponse.ResultEntry.actions:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.ActionsEntry
-	56, // 39: cerbos.response.v1.CheckResourcesResponse.ResultEntry.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	40, // 40: cerbos.response.v1.CheckResourcesResponse.ResultEntry.meta:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta
-	63, // 41: cerbos.response.v1.CheckResourcesResponse.ResultEntry.outputs:type_name -> cerbos.engine.v1.OutputEntry
-	43, // 42: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.actions:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.ActionsEntry
-	62, // 43: cerbos.response.v1.CheckResourcesResponse.ResultEntry.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
-	42, // 44: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.ActionsEntry.value:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.EffectMeta
-	44, // 45: cerbos.response.v1.PlaygroundFailure.Error.details:type_name -> cerbos.response.v1.PlaygroundFailure.ErrorDetails
-	64, // 46: cerbos.response.v1.PlaygroundTestResponse.TestResults.results:type_name -> cerbos.policy.v1.TestResults
-	62, // 47: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult.effect:type_name -> cerbos.effect.v1.Effect
-	56, // 48: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	47, // 49: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.results:type_name -> cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult
-	56, // 50: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.validation_errors:type_name -> cerbos.schema.v1.ValidationError
-	63, // 51: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.outputs:type_name -> cerbos.engine.v1.OutputEntry
-	0,  // 52: cerbos.response.v1.InspectPoliciesResponse.Attribute.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.Attribute.Kind
-	1,  // 53: cerbos.response.v1.InspectPoliciesResponse.DerivedRole.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.DerivedRole.Kind
-	65, // 54: cerbos.response.v1.InspectPoliciesResponse.Constant.value:type_name -> google.protobuf.Value
-	2,  // 55: cerbos.response.v1.InspectPoliciesResponse.Constant.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.Constant.Kind
-	3,  // 56: cerbos.response.v1.InspectPoliciesResponse.Variable.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.Variable.Kind
-	52, // 57: cerbos.response.v1.InspectPoliciesResponse.Result.variables:type_name -> cerbos.response.v1.InspectPoliciesResponse.Variable
-	50, // 58: cerbos.response.v1.InspectPoliciesResponse.Result.derived_roles:type_name -> cerbos.response.v1.InspectPoliciesResponse.DerivedRole
-	49, // 59: cerbos.response.v1.InspectPoliciesResponse.Result.attributes:type_name -> cerbos.response.v1.InspectPoliciesResponse.Attribute
-	51, // 60: cerbos.response.v1.InspectPoliciesResponse.Result.constants:type_name -> cerbos.response.v1.InspectPoliciesResponse.Constant
-	53, // 61: cerbos.response.v1.InspectPoliciesResponse.ResultsEntry.value:type_name -> cerbos.response.v1.InspectPoliciesResponse.Result
-	62, // [62:62] is the sub-list for method output_type
-	62, // [62:62] is the sub-list for method input_type
-	62, // [62:62] is the sub-list for extension type_name
-	62, // [62:62] is the sub-list for extension extendee
-	0,  // [0:62] is the sub-list for field type_name
+	63, // 0: cerbos.response.v1.PlanResourcesResponse.filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	29, // 1: cerbos.response.v1.PlanResourcesResponse.meta:type_name -> cerbos.response.v1.PlanResourcesResponse.Meta
+	64, // 2: cerbos.response.v1.PlanResourcesResponse.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	30, // 3: cerbos.response.v1.PlanResourcesResponse.sql:type_name -> cerbos.response.v1.PlanResourcesResponse.Sql
+	31, // 4: cerbos.response.v1.PlanResourcesResponse.document:type_name -> cerbos.response.v1.PlanResourcesResponse.Document
+	32, // 5: cerbos.response.v1.PlanResourcesResponse.results:type_name -> cerbos.response.v1.PlanResourcesResponse.Result
+	65, // 6: cerbos.response.v1.PlanResourcesResponse.now:type_name -> google.protobuf.Timestamp
+	63, // 7: cerbos.response.v1.PlanPrincipalsResponse.filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	34, // 8: cerbos.response.v1.PlanPrincipalsResponse.meta:type_name -> cerbos.response.v1.PlanPrincipalsResponse.Meta
+	37, // 9: cerbos.response.v1.CheckResourceSetResponse.resource_instances:type_name -> cerbos.response.v1.CheckResourceSetResponse.ResourceInstancesEntry
+	36, // 10: cerbos.response.v1.CheckResourceSetResponse.meta:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta
+	43, // 11: cerbos.response.v1.CheckResourceBatchResponse.results:type_name -> cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap
+	45, // 12: cerbos.response.v1.CheckResourcesResponse.results:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry
+	45, // 13: cerbos.response.v1.ExplainCheckResponse.results:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry
+	52, // 14: cerbos.response.v1.PlaygroundFailure.errors:type_name -> cerbos.response.v1.PlaygroundFailure.Error
+	10, // 15: cerbos.response.v1.PlaygroundValidateResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
+	66, // 16: cerbos.response.v1.PlaygroundValidateResponse.success:type_name -> google.protobuf.Empty
+	10, // 17: cerbos.response.v1.PlaygroundTestResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
+	53, // 18: cerbos.response.v1.PlaygroundTestResponse.success:type_name -> cerbos.response.v1.PlaygroundTestResponse.TestResults
+	10, // 19: cerbos.response.v1.PlaygroundEvaluateResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
+	55, // 20: cerbos.response.v1.PlaygroundEvaluateResponse.success:type_name -> cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList
+	10, // 21: cerbos.response.v1.PlaygroundProxyResponse.failure:type_name -> cerbos.response.v1.PlaygroundFailure
+	6,  // 22: cerbos.response.v1.PlaygroundProxyResponse.check_resource_set:type_name -> cerbos.response.v1.CheckResourceSetResponse
+	7,  // 23: cerbos.response.v1.PlaygroundProxyResponse.check_resource_batch:type_name -> cerbos.response.v1.CheckResourceBatchResponse
+	4,  // 24: cerbos.response.v1.PlaygroundProxyResponse.plan_resources:type_name -> cerbos.response.v1.PlanResourcesResponse
+	8,  // 25: cerbos.response.v1.PlaygroundProxyResponse.check_resources:type_name -> cerbos.response.v1.CheckResourcesResponse
+	66, // 26: cerbos.response.v1.AddOrUpdatePolicyResponse.success:type_name -> google.protobuf.Empty
+	56, // 27: cerbos.response.v1.CheckWithPoliciesResponse.results:type_name -> cerbos.response.v1.CheckWithPoliciesResponse.Result
+	67, // 28: cerbos.response.v1.ListAuditLogEntriesResponse.access_log_entry:type_name -> cerbos.audit.v1.AccessLogEntry
+	68, // 29: cerbos.response.v1.ListAuditLogEntriesResponse.decision_log_entry:type_name -> cerbos.audit.v1.DecisionLogEntry
+	69, // 30: cerbos.response.v1.GetPolicyResponse.policies:type_name -> cerbos.policy.v1.Policy
+	62, // 31: cerbos.response.v1.InspectPoliciesResponse.results:type_name -> cerbos.response.v1.InspectPoliciesResponse.ResultsEntry
+	70, // 32: cerbos.response.v1.GetSchemaResponse.schemas:type_name -> cerbos.schema.v1.Schema
+	33, // 33: cerbos.response.v1.PlanResourcesResponse.Meta.matched_scopes:type_name -> cerbos.response.v1.PlanResourcesResponse.Meta.MatchedScopesEntry
+	71, // 34: cerbos.response.v1.PlanResourcesResponse.Sql.args:type_name -> google.protobuf.Value
+	72, // 35: cerbos.response.v1.PlanResourcesResponse.Document.query:type_name -> google.protobuf.Struct
+	63, // 36: cerbos.response.v1.PlanResourcesResponse.Result.filter:type_name -> cerbos.engine.v1.PlanResourcesFilter
+	29, // 37: cerbos.response.v1.PlanResourcesResponse.Result.meta:type_name -> cerbos.response.v1.PlanResourcesResponse.Meta
+	64, // 38: cerbos.response.v1.PlanResourcesResponse.Result.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	65, // 39: cerbos.response.v1.PlanResourcesResponse.Result.now:type_name -> google.protobuf.Timestamp
+	38, // 40: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.actions:type_name -> cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.ActionsEntry
+	64, // 41: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	41, // 42: cerbos.response.v1.CheckResourceSetResponse.Meta.resource_instances:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.ResourceInstancesEntry
+	35, // 43: cerbos.response.v1.CheckResourceSetResponse.ResourceInstancesEntry.value:type_name -> cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap
+	73, // 44: cerbos.response.v1.CheckResourceSetResponse.ActionEffectMap.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
+	42, // 45: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.actions:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.ActionsEntry
+	40, // 46: cerbos.response.v1.CheckResourceSetResponse.Meta.ResourceInstancesEntry.value:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta
+	39, // 47: cerbos.response.v1.CheckResourceSetResponse.Meta.ActionMeta.ActionsEntry.value:type_name -> cerbos.response.v1.CheckResourceSetResponse.Meta.EffectMeta
+	44, // 48: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.actions:type_name -> cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.ActionsEntry
+	64, // 49: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	73, // 50: cerbos.response.v1.CheckResourceBatchResponse.ActionEffectMap.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
+	46, // 51: cerbos.response.v1.CheckResourcesResponse.ResultEntry.resource:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Resource
+	48, // 52: cerbos.response.v1.CheckResourcesResponse.ResultEntry.actions:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.ActionsEntry
+	64, // 53: cerbos.response.v1.CheckResourcesResponse.ResultEntry.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	47, // 54: cerbos.response.v1.CheckResourcesResponse.ResultEntry.meta:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta
+	74, // 55: cerbos.response.v1.CheckResourcesResponse.ResultEntry.outputs:type_name -> cerbos.engine.v1.OutputEntry
+	75, // 56: cerbos.response.v1.CheckResourcesResponse.ResultEntry.explanation:type_name -> cerbos.engine.v1.Explanation
+	50, // 57: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.actions:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.ActionsEntry
+	73, // 58: cerbos.response.v1.CheckResourcesResponse.ResultEntry.ActionsEntry.value:type_name -> cerbos.effect.v1.Effect
+	49, // 59: cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.ActionsEntry.value:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.EffectMeta
+	51, // 60: cerbos.response.v1.PlaygroundFailure.Error.details:type_name -> cerbos.response.v1.PlaygroundFailure.ErrorDetails
+	76, // 61: cerbos.response.v1.PlaygroundTestResponse.TestResults.results:type_name -> cerbos.policy.v1.TestResults
+	73, // 62: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult.effect:type_name -> cerbos.effect.v1.Effect
+	64, // 63: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	54, // 64: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.results:type_name -> cerbos.response.v1.PlaygroundEvaluateResponse.EvalResult
+	64, // 65: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.validation_errors:type_name -> cerbos.schema.v1.ValidationError
+	74, // 66: cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.outputs:type_name -> cerbos.engine.v1.OutputEntry
+	45, // 67: cerbos.response.v1.CheckWithPoliciesResponse.Result.current:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry
+	45, // 68: cerbos.response.v1.CheckWithPoliciesResponse.Result.candidate:type_name -> cerbos.response.v1.CheckResourcesResponse.ResultEntry
+	0,  // 69: cerbos.response.v1.InspectPoliciesResponse.Attribute.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.Attribute.Kind
+	1,  // 70: cerbos.response.v1.InspectPoliciesResponse.DerivedRole.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.DerivedRole.Kind
+	71, // 71: cerbos.response.v1.InspectPoliciesResponse.Constant.value:type_name -> google.protobuf.Value
+	2,  // 72: cerbos.response.v1.InspectPoliciesResponse.Constant.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.Constant.Kind
+	3,  // 73: cerbos.response.v1.InspectPoliciesResponse.Variable.kind:type_name -> cerbos.response.v1.InspectPoliciesResponse.Variable.Kind
+	60, // 74: cerbos.response.v1.InspectPoliciesResponse.Result.variables:type_name -> cerbos.response.v1.InspectPoliciesResponse.Variable
+	58, // 75: cerbos.response.v1.InspectPoliciesResponse.Result.derived_roles:type_name -> cerbos.response.v1.InspectPoliciesResponse.DerivedRole
+	57, // 76: cerbos.response.v1.InspectPoliciesResponse.Result.attributes:type_name -> cerbos.response.v1.InspectPoliciesResponse.Attribute
+	59, // 77: cerbos.response.v1.InspectPoliciesResponse.Result.constants:type_name -> cerbos.response.v1.InspectPoliciesResponse.Constant
+	61, // 78: cerbos.response.v1.InspectPoliciesResponse.ResultsEntry.value:type_name -> cerbos.response.v1.InspectPoliciesResponse.Result
+	79, // [79:79] is the sub-list for method output_type
+	79, // [79:79] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_cerbos_response_v1_response_proto_init() }
@@ -3241,26 +3866,26 @@ func file_cerbos_response_v1_response_proto_init() {
 	if File_cerbos_response_v1_response_proto != nil {
 		return
 	}
-	file_cerbos_response_v1_response_proto_msgTypes[5].OneofWrappers = []any{
+	file_cerbos_response_v1_response_proto_msgTypes[7].OneofWrappers = []any{
 		(*PlaygroundValidateResponse_Failure)(nil),
 		(*PlaygroundValidateResponse_Success)(nil),
 	}
-	file_cerbos_response_v1_response_proto_msgTypes[6].OneofWrappers = []any{
+	file_cerbos_response_v1_response_proto_msgTypes[8].OneofWrappers = []any{
 		(*PlaygroundTestResponse_Failure)(nil),
 		(*PlaygroundTestResponse_Success)(nil),
 	}
-	file_cerbos_response_v1_response_proto_msgTypes[7].OneofWrappers = []any{
+	file_cerbos_response_v1_response_proto_msgTypes[9].OneofWrappers = []any{
 		(*PlaygroundEvaluateResponse_Failure)(nil),
 		(*PlaygroundEvaluateResponse_Success)(nil),
 	}
-	file_cerbos_response_v1_response_proto_msgTypes[8].OneofWrappers = []any{
+	file_cerbos_response_v1_response_proto_msgTypes[10].OneofWrappers = []any{
 		(*PlaygroundProxyResponse_Failure)(nil),
 		(*PlaygroundProxyResponse_CheckResourceSet)(nil),
 		(*PlaygroundProxyResponse_CheckResourceBatch)(nil),
 		(*PlaygroundProxyResponse_PlanResources)(nil),
 		(*PlaygroundProxyResponse_CheckResources)(nil),
 	}
-	file_cerbos_response_v1_response_proto_msgTypes[10].OneofWrappers = []any{
+	file_cerbos_response_v1_response_proto_msgTypes[13].OneofWrappers = []any{
 		(*ListAuditLogEntriesResponse_AccessLogEntry)(nil),
 		(*ListAuditLogEntriesResponse_DecisionLogEntry)(nil),
 	}
@@ -3270,7 +3895,7 @@ func file_cerbos_response_v1_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cerbos_response_v1_response_proto_rawDesc), len(file_cerbos_response_v1_response_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   51,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -588,6 +588,15 @@ func cerbos_runtime_v1_RuleTable_RuleRow_hashpb_sum(m *RuleTable_RuleRow, hasher
 	if _, ok := ignore["cerbos.runtime.v1.RuleTable.RuleRow.from_role_policy"]; !ok {
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.GetFromRolePolicy())))
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RuleTable.RuleRow.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.runtime.v1.RuleTable.RuleRow.ordinal"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOrdinal())))
+	}
+	if _, ok := ignore["cerbos.runtime.v1.RuleTable.RuleRow.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_runtime_v1_RuleTable_hashpb_sum(m *RuleTable, hasher hash.Hash, ignore map[string]struct{}) {
@@ -847,6 +856,9 @@ func cerbos_runtime_v1_RunnablePrincipalPolicySet_Policy_ActionRule_hashpb_sum(m
 			cerbos_runtime_v1_Output_hashpb_sum(m.GetEmitOutput(), hasher, ignore)
 		}
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.ordinal"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetOrdinal())))
+	}
 }
 
 func cerbos_runtime_v1_RunnablePrincipalPolicySet_Policy_ResourceRules_hashpb_sum(m *RunnablePrincipalPolicySet_Policy_ResourceRules, hasher hash.Hash, ignore map[string]struct{}) {
@@ -911,6 +923,12 @@ func cerbos_runtime_v1_RunnablePrincipalPolicySet_Policy_hashpb_sum(m *RunnableP
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_runtime_v1_RunnablePrincipalPolicySet_hashpb_sum(m *RunnablePrincipalPolicySet, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1088,6 +1106,12 @@ func cerbos_runtime_v1_RunnableResourcePolicySet_Policy_hashpb_sum(m *RunnableRe
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnableResourcePolicySet.Policy.combining_algorithm"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetCombiningAlgorithm())))
+	}
+	if _, ok := ignore["cerbos.runtime.v1.RunnableResourcePolicySet.Policy.condition_cost_limit"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.GetConditionCostLimit())))
+	}
 }
 
 func cerbos_runtime_v1_RunnableResourcePolicySet_hashpb_sum(m *RunnableResourcePolicySet, hasher hash.Hash, ignore map[string]struct{}) {
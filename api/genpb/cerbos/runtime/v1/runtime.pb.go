@@ -1328,6 +1328,9 @@ type RuleTable_RuleRow struct {
 	EvaluationKey        string                        `protobuf:"bytes,18,opt,name=evaluation_key,json=evaluationKey,proto3" json:"evaluation_key,omitempty"`
 	PolicyKind           v1.Kind                       `protobuf:"varint,19,opt,name=policy_kind,json=policyKind,proto3,enum=cerbos.policy.v1.Kind" json:"policy_kind,omitempty"`
 	FromRolePolicy       bool                          `protobuf:"varint,20,opt,name=from_role_policy,json=fromRolePolicy,proto3" json:"from_role_policy,omitempty"`
+	CombiningAlgorithm   v1.CombiningAlgorithm         `protobuf:"varint,21,opt,name=combining_algorithm,json=combiningAlgorithm,proto3,enum=cerbos.policy.v1.CombiningAlgorithm" json:"combining_algorithm,omitempty"`
+	Ordinal              uint32                        `protobuf:"varint,22,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	ConditionCostLimit   uint32                        `protobuf:"varint,23,opt,name=condition_cost_limit,json=conditionCostLimit,proto3" json:"condition_cost_limit,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -1513,6 +1516,27 @@ func (x *RuleTable_RuleRow) GetFromRolePolicy() bool {
 	return false
 }
 
+func (x *RuleTable_RuleRow) GetCombiningAlgorithm() v1.CombiningAlgorithm {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return v1.CombiningAlgorithm(0)
+}
+
+func (x *RuleTable_RuleRow) GetOrdinal() uint32 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+func (x *RuleTable_RuleRow) GetConditionCostLimit() uint32 {
+	if x != nil {
+		return x.ConditionCostLimit
+	}
+	return 0
+}
+
 type isRuleTable_RuleRow_ActionSet interface {
 	isRuleTable_RuleRow_ActionSet()
 }
@@ -2046,14 +2070,16 @@ type RunnableResourcePolicySet_Policy struct {
 	Scope        string                          `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
 	DerivedRoles map[string]*RunnableDerivedRole `protobuf:"bytes,2,rep,name=derived_roles,json=derivedRoles,proto3" json:"derived_roles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Deprecated: Marked as deprecated in cerbos/runtime/v1/runtime.proto.
-	Variables        map[string]*Expr                         `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Rules            []*RunnableResourcePolicySet_Policy_Rule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
-	Schemas          *v1.Schemas                              `protobuf:"bytes,5,opt,name=schemas,proto3" json:"schemas,omitempty"`
-	OrderedVariables []*Variable                              `protobuf:"bytes,6,rep,name=ordered_variables,json=orderedVariables,proto3" json:"ordered_variables,omitempty"`
-	ScopePermissions v1.ScopePermissions                      `protobuf:"varint,7,opt,name=scope_permissions,json=scopePermissions,proto3,enum=cerbos.policy.v1.ScopePermissions" json:"scope_permissions,omitempty"`
-	Constants        map[string]*structpb.Value               `protobuf:"bytes,8,rep,name=constants,proto3" json:"constants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Variables          map[string]*Expr                         `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Rules              []*RunnableResourcePolicySet_Policy_Rule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	Schemas            *v1.Schemas                              `protobuf:"bytes,5,opt,name=schemas,proto3" json:"schemas,omitempty"`
+	OrderedVariables   []*Variable                              `protobuf:"bytes,6,rep,name=ordered_variables,json=orderedVariables,proto3" json:"ordered_variables,omitempty"`
+	ScopePermissions   v1.ScopePermissions                      `protobuf:"varint,7,opt,name=scope_permissions,json=scopePermissions,proto3,enum=cerbos.policy.v1.ScopePermissions" json:"scope_permissions,omitempty"`
+	Constants          map[string]*structpb.Value               `protobuf:"bytes,8,rep,name=constants,proto3" json:"constants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CombiningAlgorithm v1.CombiningAlgorithm                    `protobuf:"varint,9,opt,name=combining_algorithm,json=combiningAlgorithm,proto3,enum=cerbos.policy.v1.CombiningAlgorithm" json:"combining_algorithm,omitempty"`
+	ConditionCostLimit uint32                                   `protobuf:"varint,10,opt,name=condition_cost_limit,json=conditionCostLimit,proto3" json:"condition_cost_limit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *RunnableResourcePolicySet_Policy) Reset() {
@@ -2143,6 +2169,20 @@ func (x *RunnableResourcePolicySet_Policy) GetConstants() map[string]*structpb.V
 	return nil
 }
 
+func (x *RunnableResourcePolicySet_Policy) GetCombiningAlgorithm() v1.CombiningAlgorithm {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return v1.CombiningAlgorithm(0)
+}
+
+func (x *RunnableResourcePolicySet_Policy) GetConditionCostLimit() uint32 {
+	if x != nil {
+		return x.ConditionCostLimit
+	}
+	return 0
+}
+
 type RunnableResourcePolicySet_Policy_Rule struct {
 	state        protoimpl.MessageState    `protogen:"open.v1"`
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2429,13 +2469,15 @@ type RunnablePrincipalPolicySet_Policy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Scope string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
 	// Deprecated: Marked as deprecated in cerbos/runtime/v1/runtime.proto.
-	Variables        map[string]*Expr                                            `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ResourceRules    map[string]*RunnablePrincipalPolicySet_Policy_ResourceRules `protobuf:"bytes,3,rep,name=resource_rules,json=resourceRules,proto3" json:"resource_rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	OrderedVariables []*Variable                                                 `protobuf:"bytes,4,rep,name=ordered_variables,json=orderedVariables,proto3" json:"ordered_variables,omitempty"`
-	ScopePermissions v1.ScopePermissions                                         `protobuf:"varint,5,opt,name=scope_permissions,json=scopePermissions,proto3,enum=cerbos.policy.v1.ScopePermissions" json:"scope_permissions,omitempty"`
-	Constants        map[string]*structpb.Value                                  `protobuf:"bytes,6,rep,name=constants,proto3" json:"constants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Variables          map[string]*Expr                                            `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResourceRules      map[string]*RunnablePrincipalPolicySet_Policy_ResourceRules `protobuf:"bytes,3,rep,name=resource_rules,json=resourceRules,proto3" json:"resource_rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OrderedVariables   []*Variable                                                 `protobuf:"bytes,4,rep,name=ordered_variables,json=orderedVariables,proto3" json:"ordered_variables,omitempty"`
+	ScopePermissions   v1.ScopePermissions                                         `protobuf:"varint,5,opt,name=scope_permissions,json=scopePermissions,proto3,enum=cerbos.policy.v1.ScopePermissions" json:"scope_permissions,omitempty"`
+	Constants          map[string]*structpb.Value                                  `protobuf:"bytes,6,rep,name=constants,proto3" json:"constants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CombiningAlgorithm v1.CombiningAlgorithm                                       `protobuf:"varint,7,opt,name=combining_algorithm,json=combiningAlgorithm,proto3,enum=cerbos.policy.v1.CombiningAlgorithm" json:"combining_algorithm,omitempty"`
+	ConditionCostLimit uint32                                                      `protobuf:"varint,8,opt,name=condition_cost_limit,json=conditionCostLimit,proto3" json:"condition_cost_limit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *RunnablePrincipalPolicySet_Policy) Reset() {
@@ -2511,6 +2553,20 @@ func (x *RunnablePrincipalPolicySet_Policy) GetConstants() map[string]*structpb.
 	return nil
 }
 
+func (x *RunnablePrincipalPolicySet_Policy) GetCombiningAlgorithm() v1.CombiningAlgorithm {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return v1.CombiningAlgorithm(0)
+}
+
+func (x *RunnablePrincipalPolicySet_Policy) GetConditionCostLimit() uint32 {
+	if x != nil {
+		return x.ConditionCostLimit
+	}
+	return 0
+}
+
 type RunnablePrincipalPolicySet_Policy_ActionRule struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	Action    string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
@@ -2520,6 +2576,7 @@ type RunnablePrincipalPolicySet_Policy_ActionRule struct {
 	// Deprecated: Marked as deprecated in cerbos/runtime/v1/runtime.proto.
 	Output        *Expr   `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
 	EmitOutput    *Output `protobuf:"bytes,6,opt,name=emit_output,json=emitOutput,proto3" json:"emit_output,omitempty"`
+	Ordinal       uint32  `protobuf:"varint,7,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2597,6 +2654,13 @@ func (x *RunnablePrincipalPolicySet_Policy_ActionRule) GetEmitOutput() *Output {
 	return nil
 }
 
+func (x *RunnablePrincipalPolicySet_Policy_ActionRule) GetOrdinal() uint32 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
 type RunnablePrincipalPolicySet_Policy_ResourceRules struct {
 	state         protoimpl.MessageState                          `protogen:"open.v1"`
 	ActionRules   []*RunnablePrincipalPolicySet_Policy_ActionRule `protobuf:"bytes,1,rep,name=action_rules,json=actionRules,proto3" json:"action_rules,omitempty"`
@@ -3206,14 +3270,14 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"rolePolicy\x12)\n" +
 	"\x10compiler_version\x18\x06 \x01(\rR\x0fcompilerVersionB\f\n" +
 	"\n" +
-	"policy_set\"\xd2\x17\n" +
+	"policy_set\"\xf5\x18\n" +
 	"\tRuleTable\x12:\n" +
 	"\x05rules\x18\x01 \x03(\v2$.cerbos.runtime.v1.RuleTable.RuleRowR\x05rules\x12C\n" +
 	"\aschemas\x18\x02 \x03(\v2).cerbos.runtime.v1.RuleTable.SchemasEntryR\aschemas\x12:\n" +
 	"\x04meta\x18\x03 \x03(\v2&.cerbos.runtime.v1.RuleTable.MetaEntryR\x04meta\x12`\n" +
 	"\x12scope_parent_roles\x18\x04 \x03(\v22.cerbos.runtime.v1.RuleTable.ScopeParentRolesEntryR\x10scopeParentRoles\x12f\n" +
 	"\x14policy_derived_roles\x18\x05 \x03(\v24.cerbos.runtime.v1.RuleTable.PolicyDerivedRolesEntryR\x12policyDerivedRoles\x12P\n" +
-	"\fjson_schemas\x18\x06 \x03(\v2-.cerbos.runtime.v1.RuleTable.JsonSchemasEntryR\vjsonSchemas\x1a\xab\v\n" +
+	"\fjson_schemas\x18\x06 \x03(\v2-.cerbos.runtime.v1.RuleTable.JsonSchemasEntryR\vjsonSchemas\x1a\xce\f\n" +
 	"\aRuleRow\x12\x1d\n" +
 	"\n" +
 	"origin_fqn\x18\x01 \x01(\tR\toriginFqn\x12\x1a\n" +
@@ -3238,7 +3302,10 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"\x0eevaluation_key\x18\x12 \x01(\tR\revaluationKey\x127\n" +
 	"\vpolicy_kind\x18\x13 \x01(\x0e2\x16.cerbos.policy.v1.KindR\n" +
 	"policyKind\x12(\n" +
-	"\x10from_role_policy\x18\x14 \x01(\bR\x0efromRolePolicy\x1a\xbc\x01\n" +
+	"\x10from_role_policy\x18\x14 \x01(\bR\x0efromRolePolicy\x12U\n" +
+	"\x13combining_algorithm\x18\x15 \x01(\x0e2$.cerbos.policy.v1.CombiningAlgorithmR\x12combiningAlgorithm\x12\x18\n" +
+	"\aordinal\x18\x16 \x01(\rR\aordinal\x120\n" +
+	"\x14condition_cost_limit\x18\x17 \x01(\rR\x12conditionCostLimit\x1a\xbc\x01\n" +
 	"\fAllowActions\x12X\n" +
 	"\aactions\x18\x01 \x03(\v2>.cerbos.runtime.v1.RuleTable.RuleRow.AllowActions.ActionsEntryR\aactions\x1aR\n" +
 	"\fActionsEntry\x12\x10\n" +
@@ -3325,7 +3392,7 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"\x05rules\x18\x01 \x03(\v2-.cerbos.runtime.v1.RunnableRolePolicySet.RuleR\x05rules\x1ao\n" +
 	"\x0eResourcesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12G\n" +
-	"\x05value\x18\x02 \x01(\v21.cerbos.runtime.v1.RunnableRolePolicySet.RuleListR\x05value:\x028\x01\"\x81\x13\n" +
+	"\x05value\x18\x02 \x01(\v21.cerbos.runtime.v1.RunnableRolePolicySet.RuleListR\x05value:\x028\x01\"\x8a\x14\n" +
 	"\x19RunnableResourcePolicySet\x12I\n" +
 	"\x04meta\x18\x01 \x01(\v25.cerbos.runtime.v1.RunnableResourcePolicySet.MetadataR\x04meta\x12O\n" +
 	"\bpolicies\x18\x02 \x03(\v23.cerbos.runtime.v1.RunnableResourcePolicySet.PolicyR\bpolicies\x123\n" +
@@ -3341,7 +3408,7 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\".cerbos.policy.v1.SourceAttributesR\x05value:\x028\x01\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xb0\r\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xb9\x0e\n" +
 	"\x06Policy\x12\x14\n" +
 	"\x05scope\x18\x01 \x01(\tR\x05scope\x12j\n" +
 	"\rderived_roles\x18\x02 \x03(\v2E.cerbos.runtime.v1.RunnableResourcePolicySet.Policy.DerivedRolesEntryR\fderivedRoles\x12d\n" +
@@ -3350,7 +3417,10 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"\aschemas\x18\x05 \x01(\v2\x19.cerbos.policy.v1.SchemasR\aschemas\x12H\n" +
 	"\x11ordered_variables\x18\x06 \x03(\v2\x1b.cerbos.runtime.v1.VariableR\x10orderedVariables\x12O\n" +
 	"\x11scope_permissions\x18\a \x01(\x0e2\".cerbos.policy.v1.ScopePermissionsR\x10scopePermissions\x12`\n" +
-	"\tconstants\x18\b \x03(\v2B.cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ConstantsEntryR\tconstants\x1a\xa5\x06\n" +
+	"\tconstants\x18\b \x03(\v2B.cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ConstantsEntryR\tconstants\x12U\n" +
+	"\x13combining_algorithm\x18\t \x01(\x0e2$.cerbos.policy.v1.CombiningAlgorithmR\x12combiningAlgorithm\x120\n" +
+	"\x14condition_cost_limit\x18\n" +
+	" \x01(\rR\x12conditionCostLimit\x1a\xa5\x06\n" +
 	"\x04Rule\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12_\n" +
 	"\aactions\x18\x02 \x03(\v2E.cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.ActionsEntryR\aactions\x12o\n" +
@@ -3421,7 +3491,7 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
 	"\x0eVariablesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
-	"\x05value\x18\x02 \x01(\v2\x17.cerbos.runtime.v1.ExprR\x05value:\x028\x01:\x02\x18\x01\"\xd9\x0e\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.cerbos.runtime.v1.ExprR\x05value:\x028\x01:\x02\x18\x01\"\xfc\x0f\n" +
 	"\x1aRunnablePrincipalPolicySet\x12J\n" +
 	"\x04meta\x18\x01 \x01(\v26.cerbos.runtime.v1.RunnablePrincipalPolicySet.MetadataR\x04meta\x12P\n" +
 	"\bpolicies\x18\x02 \x03(\v24.cerbos.runtime.v1.RunnablePrincipalPolicySet.PolicyR\bpolicies\x1a\xe3\x03\n" +
@@ -3436,14 +3506,17 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\".cerbos.policy.v1.SourceAttributesR\x05value:\x028\x01\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xb6\t\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xd9\n" +
+	"\n" +
 	"\x06Policy\x12\x14\n" +
 	"\x05scope\x18\x01 \x01(\tR\x05scope\x12e\n" +
 	"\tvariables\x18\x02 \x03(\v2C.cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.VariablesEntryB\x02\x18\x01R\tvariables\x12n\n" +
 	"\x0eresource_rules\x18\x03 \x03(\v2G.cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRulesEntryR\rresourceRules\x12H\n" +
 	"\x11ordered_variables\x18\x04 \x03(\v2\x1b.cerbos.runtime.v1.VariableR\x10orderedVariables\x12O\n" +
 	"\x11scope_permissions\x18\x05 \x01(\x0e2\".cerbos.policy.v1.ScopePermissionsR\x10scopePermissions\x12a\n" +
-	"\tconstants\x18\x06 \x03(\v2C.cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ConstantsEntryR\tconstants\x1a\x97\x02\n" +
+	"\tconstants\x18\x06 \x03(\v2C.cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ConstantsEntryR\tconstants\x12U\n" +
+	"\x13combining_algorithm\x18\a \x01(\x0e2$.cerbos.policy.v1.CombiningAlgorithmR\x12combiningAlgorithm\x120\n" +
+	"\x14condition_cost_limit\x18\b \x01(\rR\x12conditionCostLimit\x1a\xb1\x02\n" +
 	"\n" +
 	"ActionRule\x12\x16\n" +
 	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
@@ -3452,7 +3525,8 @@ const file_cerbos_runtime_v1_runtime_proto_rawDesc = "" +
 	"\x06effect\x18\x04 \x01(\x0e2\x18.cerbos.effect.v1.EffectR\x06effect\x123\n" +
 	"\x06output\x18\x05 \x01(\v2\x17.cerbos.runtime.v1.ExprB\x02\x18\x01R\x06output\x12:\n" +
 	"\vemit_output\x18\x06 \x01(\v2\x19.cerbos.runtime.v1.OutputR\n" +
-	"emitOutput\x1as\n" +
+	"emitOutput\x12\x18\n" +
+	"\aordinal\x18\a \x01(\rR\aordinal\x1as\n" +
 	"\rResourceRules\x12b\n" +
 	"\faction_rules\x18\x01 \x03(\v2?.cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRuleR\vactionRules\x1aU\n" +
 	"\x0eVariablesEntry\x12\x10\n" +
@@ -3632,11 +3706,12 @@ var file_cerbos_runtime_v1_runtime_proto_goTypes = []any{
 	(*v1alpha1.CheckedExpr)(nil),                       // 81: google.api.expr.v1alpha1.CheckedExpr
 	(v11.Effect)(0),                                    // 82: cerbos.effect.v1.Effect
 	(v1.Kind)(0),                                       // 83: cerbos.policy.v1.Kind
-	(*emptypb.Empty)(nil),                              // 84: google.protobuf.Empty
-	(*structpb.Value)(nil),                             // 85: google.protobuf.Value
-	(*v1.SourceAttributes)(nil),                        // 86: cerbos.policy.v1.SourceAttributes
-	(*v12.Position)(nil),                               // 87: cerbos.source.v1.Position
-	(*v12.Error)(nil),                                  // 88: cerbos.source.v1.Error
+	(v1.CombiningAlgorithm)(0),                         // 84: cerbos.policy.v1.CombiningAlgorithm
+	(*emptypb.Empty)(nil),                              // 85: google.protobuf.Empty
+	(*structpb.Value)(nil),                             // 86: google.protobuf.Value
+	(*v1.SourceAttributes)(nil),                        // 87: cerbos.policy.v1.SourceAttributes
+	(*v12.Position)(nil),                               // 88: cerbos.source.v1.Position
+	(*v12.Error)(nil),                                  // 89: cerbos.source.v1.Error
 }
 var file_cerbos_runtime_v1_runtime_proto_depIdxs = []int32{
 	4,   // 0: cerbos.runtime.v1.RunnablePolicySet.resource_policy:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet
@@ -3694,88 +3769,91 @@ var file_cerbos_runtime_v1_runtime_proto_depIdxs = []int32{
 	26,  // 52: cerbos.runtime.v1.RuleTable.RuleRow.params:type_name -> cerbos.runtime.v1.RuleTable.RuleRow.Params
 	26,  // 53: cerbos.runtime.v1.RuleTable.RuleRow.derived_role_params:type_name -> cerbos.runtime.v1.RuleTable.RuleRow.Params
 	83,  // 54: cerbos.runtime.v1.RuleTable.RuleRow.policy_kind:type_name -> cerbos.policy.v1.Kind
-	30,  // 55: cerbos.runtime.v1.RuleTable.RoleParentRoles.role_parent_roles:type_name -> cerbos.runtime.v1.RuleTable.RoleParentRoles.RoleParentRolesEntry
-	31,  // 56: cerbos.runtime.v1.RuleTable.PolicyDerivedRoles.derived_roles:type_name -> cerbos.runtime.v1.RuleTable.PolicyDerivedRoles.DerivedRolesEntry
-	80,  // 57: cerbos.runtime.v1.RuleTable.SchemasEntry.value:type_name -> cerbos.policy.v1.Schemas
-	2,   // 58: cerbos.runtime.v1.RuleTable.MetaEntry.value:type_name -> cerbos.runtime.v1.RuleTableMetadata
-	17,  // 59: cerbos.runtime.v1.RuleTable.ScopeParentRolesEntry.value:type_name -> cerbos.runtime.v1.RuleTable.RoleParentRoles
-	18,  // 60: cerbos.runtime.v1.RuleTable.PolicyDerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RuleTable.PolicyDerivedRoles
-	19,  // 61: cerbos.runtime.v1.RuleTable.JsonSchemasEntry.value:type_name -> cerbos.runtime.v1.RuleTable.JSONSchema
-	27,  // 62: cerbos.runtime.v1.RuleTable.RuleRow.AllowActions.actions:type_name -> cerbos.runtime.v1.RuleTable.RuleRow.AllowActions.ActionsEntry
-	11,  // 63: cerbos.runtime.v1.RuleTable.RuleRow.Params.ordered_variables:type_name -> cerbos.runtime.v1.Variable
-	28,  // 64: cerbos.runtime.v1.RuleTable.RuleRow.Params.constants:type_name -> cerbos.runtime.v1.RuleTable.RuleRow.Params.ConstantsEntry
-	84,  // 65: cerbos.runtime.v1.RuleTable.RuleRow.AllowActions.ActionsEntry.value:type_name -> google.protobuf.Empty
-	85,  // 66: cerbos.runtime.v1.RuleTable.RuleRow.Params.ConstantsEntry.value:type_name -> google.protobuf.Value
-	29,  // 67: cerbos.runtime.v1.RuleTable.RoleParentRoles.RoleParentRolesEntry.value:type_name -> cerbos.runtime.v1.RuleTable.RoleParentRoles.ParentRoles
-	5,   // 68: cerbos.runtime.v1.RuleTable.PolicyDerivedRoles.DerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RunnableDerivedRole
-	86,  // 69: cerbos.runtime.v1.RuleTableMetadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
-	38,  // 70: cerbos.runtime.v1.RunnableRolePolicySet.Metadata.source_attributes:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Metadata.SourceAttributesEntry
-	39,  // 71: cerbos.runtime.v1.RunnableRolePolicySet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Metadata.AnnotationsEntry
-	40,  // 72: cerbos.runtime.v1.RunnableRolePolicySet.Rule.allow_actions:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Rule.AllowActionsEntry
-	12,  // 73: cerbos.runtime.v1.RunnableRolePolicySet.Rule.condition:type_name -> cerbos.runtime.v1.Condition
-	35,  // 74: cerbos.runtime.v1.RunnableRolePolicySet.RuleList.rules:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Rule
-	36,  // 75: cerbos.runtime.v1.RunnableRolePolicySet.ResourcesEntry.value:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.RuleList
-	86,  // 76: cerbos.runtime.v1.RunnableRolePolicySet.Metadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
-	84,  // 77: cerbos.runtime.v1.RunnableRolePolicySet.Rule.AllowActionsEntry.value:type_name -> google.protobuf.Empty
-	43,  // 78: cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.source_attributes:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.SourceAttributesEntry
-	44,  // 79: cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.AnnotationsEntry
-	46,  // 80: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.derived_roles:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.DerivedRolesEntry
-	47,  // 81: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.variables:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.VariablesEntry
-	45,  // 82: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.rules:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule
-	80,  // 83: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.schemas:type_name -> cerbos.policy.v1.Schemas
-	11,  // 84: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ordered_variables:type_name -> cerbos.runtime.v1.Variable
-	79,  // 85: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
-	48,  // 86: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.constants:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ConstantsEntry
-	86,  // 87: cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
-	49,  // 88: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.actions:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.ActionsEntry
-	50,  // 89: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.derived_roles:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.DerivedRolesEntry
-	51,  // 90: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.roles:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.RolesEntry
-	12,  // 91: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.condition:type_name -> cerbos.runtime.v1.Condition
-	82,  // 92: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.effect:type_name -> cerbos.effect.v1.Effect
-	9,   // 93: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.output:type_name -> cerbos.runtime.v1.Expr
-	10,  // 94: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.emit_output:type_name -> cerbos.runtime.v1.Output
-	5,   // 95: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.DerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RunnableDerivedRole
-	9,   // 96: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
-	85,  // 97: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ConstantsEntry.value:type_name -> google.protobuf.Value
-	84,  // 98: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.ActionsEntry.value:type_name -> google.protobuf.Empty
-	84,  // 99: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.DerivedRolesEntry.value:type_name -> google.protobuf.Empty
-	84,  // 100: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.RolesEntry.value:type_name -> google.protobuf.Empty
-	84,  // 101: cerbos.runtime.v1.RunnableDerivedRole.ParentRolesEntry.value:type_name -> google.protobuf.Empty
-	9,   // 102: cerbos.runtime.v1.RunnableDerivedRole.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
-	85,  // 103: cerbos.runtime.v1.RunnableDerivedRole.ConstantsEntry.value:type_name -> google.protobuf.Value
-	57,  // 104: cerbos.runtime.v1.RunnableDerivedRolesSet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableDerivedRolesSet.Metadata.AnnotationsEntry
-	5,   // 105: cerbos.runtime.v1.RunnableDerivedRolesSet.DerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RunnableDerivedRole
-	60,  // 106: cerbos.runtime.v1.RunnableVariablesSet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableVariablesSet.Metadata.AnnotationsEntry
-	9,   // 107: cerbos.runtime.v1.RunnableVariablesSet.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
-	63,  // 108: cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.source_attributes:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.SourceAttributesEntry
-	64,  // 109: cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.AnnotationsEntry
-	67,  // 110: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.variables:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.VariablesEntry
-	68,  // 111: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.resource_rules:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRulesEntry
-	11,  // 112: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ordered_variables:type_name -> cerbos.runtime.v1.Variable
-	79,  // 113: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
-	69,  // 114: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.constants:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ConstantsEntry
-	86,  // 115: cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
-	12,  // 116: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.condition:type_name -> cerbos.runtime.v1.Condition
-	82,  // 117: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.effect:type_name -> cerbos.effect.v1.Effect
-	9,   // 118: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.output:type_name -> cerbos.runtime.v1.Expr
-	10,  // 119: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.emit_output:type_name -> cerbos.runtime.v1.Output
-	65,  // 120: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRules.action_rules:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule
-	9,   // 121: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
-	66,  // 122: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRulesEntry.value:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRules
-	85,  // 123: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ConstantsEntry.value:type_name -> google.protobuf.Value
-	9,   // 124: cerbos.runtime.v1.Output.When.rule_activated:type_name -> cerbos.runtime.v1.Expr
-	9,   // 125: cerbos.runtime.v1.Output.When.condition_not_met:type_name -> cerbos.runtime.v1.Expr
-	12,  // 126: cerbos.runtime.v1.Condition.ExprList.expr:type_name -> cerbos.runtime.v1.Condition
-	87,  // 127: cerbos.runtime.v1.CompileErrors.Err.position:type_name -> cerbos.source.v1.Position
-	87,  // 128: cerbos.runtime.v1.IndexBuildErrors.DuplicateDef.position:type_name -> cerbos.source.v1.Position
-	87,  // 129: cerbos.runtime.v1.IndexBuildErrors.MissingImport.position:type_name -> cerbos.source.v1.Position
-	88,  // 130: cerbos.runtime.v1.IndexBuildErrors.LoadFailure.error_details:type_name -> cerbos.source.v1.Error
-	87,  // 131: cerbos.runtime.v1.IndexBuildErrors.Disabled.position:type_name -> cerbos.source.v1.Position
-	132, // [132:132] is the sub-list for method output_type
-	132, // [132:132] is the sub-list for method input_type
-	132, // [132:132] is the sub-list for extension type_name
-	132, // [132:132] is the sub-list for extension extendee
-	0,   // [0:132] is the sub-list for field type_name
+	84,  // 55: cerbos.runtime.v1.RuleTable.RuleRow.combining_algorithm:type_name -> cerbos.policy.v1.CombiningAlgorithm
+	30,  // 56: cerbos.runtime.v1.RuleTable.RoleParentRoles.role_parent_roles:type_name -> cerbos.runtime.v1.RuleTable.RoleParentRoles.RoleParentRolesEntry
+	31,  // 57: cerbos.runtime.v1.RuleTable.PolicyDerivedRoles.derived_roles:type_name -> cerbos.runtime.v1.RuleTable.PolicyDerivedRoles.DerivedRolesEntry
+	80,  // 58: cerbos.runtime.v1.RuleTable.SchemasEntry.value:type_name -> cerbos.policy.v1.Schemas
+	2,   // 59: cerbos.runtime.v1.RuleTable.MetaEntry.value:type_name -> cerbos.runtime.v1.RuleTableMetadata
+	17,  // 60: cerbos.runtime.v1.RuleTable.ScopeParentRolesEntry.value:type_name -> cerbos.runtime.v1.RuleTable.RoleParentRoles
+	18,  // 61: cerbos.runtime.v1.RuleTable.PolicyDerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RuleTable.PolicyDerivedRoles
+	19,  // 62: cerbos.runtime.v1.RuleTable.JsonSchemasEntry.value:type_name -> cerbos.runtime.v1.RuleTable.JSONSchema
+	27,  // 63: cerbos.runtime.v1.RuleTable.RuleRow.AllowActions.actions:type_name -> cerbos.runtime.v1.RuleTable.RuleRow.AllowActions.ActionsEntry
+	11,  // 64: cerbos.runtime.v1.RuleTable.RuleRow.Params.ordered_variables:type_name -> cerbos.runtime.v1.Variable
+	28,  // 65: cerbos.runtime.v1.RuleTable.RuleRow.Params.constants:type_name -> cerbos.runtime.v1.RuleTable.RuleRow.Params.ConstantsEntry
+	85,  // 66: cerbos.runtime.v1.RuleTable.RuleRow.AllowActions.ActionsEntry.value:type_name -> google.protobuf.Empty
+	86,  // 67: cerbos.runtime.v1.RuleTable.RuleRow.Params.ConstantsEntry.value:type_name -> google.protobuf.Value
+	29,  // 68: cerbos.runtime.v1.RuleTable.RoleParentRoles.RoleParentRolesEntry.value:type_name -> cerbos.runtime.v1.RuleTable.RoleParentRoles.ParentRoles
+	5,   // 69: cerbos.runtime.v1.RuleTable.PolicyDerivedRoles.DerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RunnableDerivedRole
+	87,  // 70: cerbos.runtime.v1.RuleTableMetadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
+	38,  // 71: cerbos.runtime.v1.RunnableRolePolicySet.Metadata.source_attributes:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Metadata.SourceAttributesEntry
+	39,  // 72: cerbos.runtime.v1.RunnableRolePolicySet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Metadata.AnnotationsEntry
+	40,  // 73: cerbos.runtime.v1.RunnableRolePolicySet.Rule.allow_actions:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Rule.AllowActionsEntry
+	12,  // 74: cerbos.runtime.v1.RunnableRolePolicySet.Rule.condition:type_name -> cerbos.runtime.v1.Condition
+	35,  // 75: cerbos.runtime.v1.RunnableRolePolicySet.RuleList.rules:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.Rule
+	36,  // 76: cerbos.runtime.v1.RunnableRolePolicySet.ResourcesEntry.value:type_name -> cerbos.runtime.v1.RunnableRolePolicySet.RuleList
+	87,  // 77: cerbos.runtime.v1.RunnableRolePolicySet.Metadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
+	85,  // 78: cerbos.runtime.v1.RunnableRolePolicySet.Rule.AllowActionsEntry.value:type_name -> google.protobuf.Empty
+	43,  // 79: cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.source_attributes:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.SourceAttributesEntry
+	44,  // 80: cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.AnnotationsEntry
+	46,  // 81: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.derived_roles:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.DerivedRolesEntry
+	47,  // 82: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.variables:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.VariablesEntry
+	45,  // 83: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.rules:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule
+	80,  // 84: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.schemas:type_name -> cerbos.policy.v1.Schemas
+	11,  // 85: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ordered_variables:type_name -> cerbos.runtime.v1.Variable
+	79,  // 86: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
+	48,  // 87: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.constants:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ConstantsEntry
+	84,  // 88: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.combining_algorithm:type_name -> cerbos.policy.v1.CombiningAlgorithm
+	87,  // 89: cerbos.runtime.v1.RunnableResourcePolicySet.Metadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
+	49,  // 90: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.actions:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.ActionsEntry
+	50,  // 91: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.derived_roles:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.DerivedRolesEntry
+	51,  // 92: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.roles:type_name -> cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.RolesEntry
+	12,  // 93: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.condition:type_name -> cerbos.runtime.v1.Condition
+	82,  // 94: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.effect:type_name -> cerbos.effect.v1.Effect
+	9,   // 95: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.output:type_name -> cerbos.runtime.v1.Expr
+	10,  // 96: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.emit_output:type_name -> cerbos.runtime.v1.Output
+	5,   // 97: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.DerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RunnableDerivedRole
+	9,   // 98: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
+	86,  // 99: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.ConstantsEntry.value:type_name -> google.protobuf.Value
+	85,  // 100: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.ActionsEntry.value:type_name -> google.protobuf.Empty
+	85,  // 101: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.DerivedRolesEntry.value:type_name -> google.protobuf.Empty
+	85,  // 102: cerbos.runtime.v1.RunnableResourcePolicySet.Policy.Rule.RolesEntry.value:type_name -> google.protobuf.Empty
+	85,  // 103: cerbos.runtime.v1.RunnableDerivedRole.ParentRolesEntry.value:type_name -> google.protobuf.Empty
+	9,   // 104: cerbos.runtime.v1.RunnableDerivedRole.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
+	86,  // 105: cerbos.runtime.v1.RunnableDerivedRole.ConstantsEntry.value:type_name -> google.protobuf.Value
+	57,  // 106: cerbos.runtime.v1.RunnableDerivedRolesSet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableDerivedRolesSet.Metadata.AnnotationsEntry
+	5,   // 107: cerbos.runtime.v1.RunnableDerivedRolesSet.DerivedRolesEntry.value:type_name -> cerbos.runtime.v1.RunnableDerivedRole
+	60,  // 108: cerbos.runtime.v1.RunnableVariablesSet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnableVariablesSet.Metadata.AnnotationsEntry
+	9,   // 109: cerbos.runtime.v1.RunnableVariablesSet.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
+	63,  // 110: cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.source_attributes:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.SourceAttributesEntry
+	64,  // 111: cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.annotations:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.AnnotationsEntry
+	67,  // 112: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.variables:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.VariablesEntry
+	68,  // 113: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.resource_rules:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRulesEntry
+	11,  // 114: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ordered_variables:type_name -> cerbos.runtime.v1.Variable
+	79,  // 115: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.scope_permissions:type_name -> cerbos.policy.v1.ScopePermissions
+	69,  // 116: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.constants:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ConstantsEntry
+	84,  // 117: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.combining_algorithm:type_name -> cerbos.policy.v1.CombiningAlgorithm
+	87,  // 118: cerbos.runtime.v1.RunnablePrincipalPolicySet.Metadata.SourceAttributesEntry.value:type_name -> cerbos.policy.v1.SourceAttributes
+	12,  // 119: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.condition:type_name -> cerbos.runtime.v1.Condition
+	82,  // 120: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.effect:type_name -> cerbos.effect.v1.Effect
+	9,   // 121: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.output:type_name -> cerbos.runtime.v1.Expr
+	10,  // 122: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule.emit_output:type_name -> cerbos.runtime.v1.Output
+	65,  // 123: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRules.action_rules:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ActionRule
+	9,   // 124: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.VariablesEntry.value:type_name -> cerbos.runtime.v1.Expr
+	66,  // 125: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRulesEntry.value:type_name -> cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ResourceRules
+	86,  // 126: cerbos.runtime.v1.RunnablePrincipalPolicySet.Policy.ConstantsEntry.value:type_name -> google.protobuf.Value
+	9,   // 127: cerbos.runtime.v1.Output.When.rule_activated:type_name -> cerbos.runtime.v1.Expr
+	9,   // 128: cerbos.runtime.v1.Output.When.condition_not_met:type_name -> cerbos.runtime.v1.Expr
+	12,  // 129: cerbos.runtime.v1.Condition.ExprList.expr:type_name -> cerbos.runtime.v1.Condition
+	88,  // 130: cerbos.runtime.v1.CompileErrors.Err.position:type_name -> cerbos.source.v1.Position
+	88,  // 131: cerbos.runtime.v1.IndexBuildErrors.DuplicateDef.position:type_name -> cerbos.source.v1.Position
+	88,  // 132: cerbos.runtime.v1.IndexBuildErrors.MissingImport.position:type_name -> cerbos.source.v1.Position
+	89,  // 133: cerbos.runtime.v1.IndexBuildErrors.LoadFailure.error_details:type_name -> cerbos.source.v1.Error
+	88,  // 134: cerbos.runtime.v1.IndexBuildErrors.Disabled.position:type_name -> cerbos.source.v1.Position
+	135, // [135:135] is the sub-list for method output_type
+	135, // [135:135] is the sub-list for method input_type
+	135, // [135:135] is the sub-list for extension type_name
+	135, // [135:135] is the sub-list for extension extendee
+	0,   // [0:135] is the sub-list for field type_name
 }
 
 func init() { file_cerbos_runtime_v1_runtime_proto_init() }
@@ -357,6 +357,27 @@ func (m *RuleTable_RuleRow) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 		i -= size
 	}
+	if m.ConditionCostLimit != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ConditionCostLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Ordinal != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Ordinal))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.CombiningAlgorithm != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.CombiningAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.FromRolePolicy {
 		i--
 		if m.FromRolePolicy {
@@ -1630,6 +1651,16 @@ func (m *RunnableResourcePolicySet_Policy) MarshalToSizedBufferVT(dAtA []byte) (
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.ConditionCostLimit != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ConditionCostLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CombiningAlgorithm != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.CombiningAlgorithm))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Constants) > 0 {
 		for k := range m.Constants {
 			v := m.Constants[k]
@@ -2354,6 +2385,11 @@ func (m *RunnablePrincipalPolicySet_Policy_ActionRule) MarshalToSizedBufferVT(dA
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.Ordinal != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Ordinal))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.EmitOutput != nil {
 		size, err := m.EmitOutput.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -2481,6 +2517,16 @@ func (m *RunnablePrincipalPolicySet_Policy) MarshalToSizedBufferVT(dAtA []byte)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.ConditionCostLimit != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.ConditionCostLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CombiningAlgorithm != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.CombiningAlgorithm))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Constants) > 0 {
 		for k := range m.Constants {
 			v := m.Constants[k]
@@ -3974,6 +4020,15 @@ func (m *RuleTable_RuleRow) SizeVT() (n int) {
 	if m.FromRolePolicy {
 		n += 3
 	}
+	if m.CombiningAlgorithm != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.CombiningAlgorithm))
+	}
+	if m.Ordinal != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.Ordinal))
+	}
+	if m.ConditionCostLimit != 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.ConditionCostLimit))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -4566,6 +4621,12 @@ func (m *RunnableResourcePolicySet_Policy) SizeVT() (n int) {
 			n += mapEntrySize + 1 + protohelpers.SizeOfVarint(uint64(mapEntrySize))
 		}
 	}
+	if m.CombiningAlgorithm != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.CombiningAlgorithm))
+	}
+	if m.ConditionCostLimit != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.ConditionCostLimit))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -4843,6 +4904,9 @@ func (m *RunnablePrincipalPolicySet_Policy_ActionRule) SizeVT() (n int) {
 		l = m.EmitOutput.SizeVT()
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	if m.Ordinal != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.Ordinal))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -4921,6 +4985,12 @@ func (m *RunnablePrincipalPolicySet_Policy) SizeVT() (n int) {
 			n += mapEntrySize + 1 + protohelpers.SizeOfVarint(uint64(mapEntrySize))
 		}
 	}
+	if m.CombiningAlgorithm != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.CombiningAlgorithm))
+	}
+	if m.ConditionCostLimit != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.ConditionCostLimit))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -6769,6 +6839,63 @@ func (m *RuleTable_RuleRow) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 			m.FromRolePolicy = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombiningAlgorithm", wireType)
+			}
+			m.CombiningAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CombiningAlgorithm |= v11.CombiningAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordinal", wireType)
+			}
+			m.Ordinal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordinal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionCostLimit", wireType)
+			}
+			m.ConditionCostLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionCostLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -11088,6 +11215,44 @@ func (m *RunnableResourcePolicySet_Policy) UnmarshalVT(dAtA []byte) error {
 			}
 			m.Constants[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombiningAlgorithm", wireType)
+			}
+			m.CombiningAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CombiningAlgorithm |= v11.CombiningAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionCostLimit", wireType)
+			}
+			m.ConditionCostLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionCostLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -13330,6 +13495,25 @@ func (m *RunnablePrincipalPolicySet_Policy_ActionRule) UnmarshalVT(dAtA []byte)
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordinal", wireType)
+			}
+			m.Ordinal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordinal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -13938,6 +14122,44 @@ func (m *RunnablePrincipalPolicySet_Policy) UnmarshalVT(dAtA []byte) error {
 			}
 			m.Constants[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombiningAlgorithm", wireType)
+			}
+			m.CombiningAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CombiningAlgorithm |= v11.CombiningAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionCostLimit", wireType)
+			}
+			m.ConditionCostLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionCostLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -33,6 +33,7 @@ const (
 	ValidationError_SOURCE_UNSPECIFIED ValidationError_Source = 0
 	ValidationError_SOURCE_PRINCIPAL   ValidationError_Source = 1
 	ValidationError_SOURCE_RESOURCE    ValidationError_Source = 2
+	ValidationError_SOURCE_POLICY      ValidationError_Source = 3
 )
 
 // Enum value maps for ValidationError_Source.
@@ -41,11 +42,13 @@ var (
 		0: "SOURCE_UNSPECIFIED",
 		1: "SOURCE_PRINCIPAL",
 		2: "SOURCE_RESOURCE",
+		3: "SOURCE_POLICY",
 	}
 	ValidationError_Source_value = map[string]int32{
 		"SOURCE_UNSPECIFIED": 0,
 		"SOURCE_PRINCIPAL":   1,
 		"SOURCE_RESOURCE":    2,
+		"SOURCE_POLICY":      3,
 	}
 )
 
@@ -192,15 +195,16 @@ var File_cerbos_schema_v1_schema_proto protoreflect.FileDescriptor
 
 const file_cerbos_schema_v1_schema_proto_rawDesc = "" +
 	"\n" +
-	"\x1dcerbos/schema/v1/schema.proto\x12\x10cerbos.schema.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xce\x01\n" +
+	"\x1dcerbos/schema/v1/schema.proto\x12\x10cerbos.schema.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe1\x01\n" +
 	"\x0fValidationError\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12@\n" +
-	"\x06source\x18\x03 \x01(\x0e2(.cerbos.schema.v1.ValidationError.SourceR\x06source\"K\n" +
+	"\x06source\x18\x03 \x01(\x0e2(.cerbos.schema.v1.ValidationError.SourceR\x06source\"^\n" +
 	"\x06Source\x12\x16\n" +
 	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10SOURCE_PRINCIPAL\x10\x01\x12\x13\n" +
-	"\x0fSOURCE_RESOURCE\x10\x02\"\xcf\x01\n" +
+	"\x0fSOURCE_RESOURCE\x10\x02\x12\x11\n" +
+	"\rSOURCE_POLICY\x10\x03\"\xcf\x01\n" +
 	"\x06Schema\x12W\n" +
 	"\x02id\x18\x01 \x01(\tBG\x92A42 Unique identifier for the schemaJ\x10\"principal.json\"\xe0A\x02\xbaH\n" +
 	"\xc8\x01\x01r\x05\x10\x01\x18\xff\x01R\x02id\x12l\n" +
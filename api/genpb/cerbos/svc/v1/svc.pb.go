@@ -32,16 +32,17 @@ var File_cerbos_svc_v1_svc_proto protoreflect.FileDescriptor
 
 const file_cerbos_svc_v1_svc_proto_rawDesc = "" +
 	"\n" +
-	"\x17cerbos/svc/v1/svc.proto\x12\rcerbos.svc.v1\x1a\x1fcerbos/request/v1/request.proto\x1a!cerbos/response/v1/response.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/api/visibility.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xd6\n" +
-	"\n" +
+	"\x17cerbos/svc/v1/svc.proto\x12\rcerbos.svc.v1\x1a\x1fcerbos/request/v1/request.proto\x1a!cerbos/response/v1/response.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/api/visibility.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xf7\x0e\n" +
 	"\rCerbosService\x12\xa7\x02\n" +
 	"\x10CheckResourceSet\x12*.cerbos.request.v1.CheckResourceSetRequest\x1a,.cerbos.response.v1.CheckResourceSetResponse\"\xb8\x01\x92A\x9f\x01\x12\x05Check\x1a\x93\x01[Deprecated: Use CheckResources API instead] Check whether a principal has permissions to perform the given actions on a set of resource instances.X\x01\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/api/check\x12\xb6\x02\n" +
 	"\x12CheckResourceBatch\x12,.cerbos.request.v1.CheckResourceBatchRequest\x1a..cerbos.response.v1.CheckResourceBatchResponse\"\xc1\x01\x92A\x99\x01\x12\x14Check resource batch\x1a\x7f[Deprecated: Use CheckResources API instead] Check a principal's permissions to a batch of heterogeneous resources and actions.X\x01\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/check_resource_batch\x12\xf0\x01\n" +
-	"\x0eCheckResources\x12(.cerbos.request.v1.CheckResourcesRequest\x1a*.cerbos.response.v1.CheckResourcesResponse\"\x87\x01\x92Ae\x12\x0fCheck resources\x1aRCheck a principal's permissions to a batch of heterogeneous resources and actions.\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/check/resources\x12\xc5\x01\n" +
+	"\x0eCheckResources\x12(.cerbos.request.v1.CheckResourcesRequest\x1a*.cerbos.response.v1.CheckResourcesResponse\"\x87\x01\x92Ae\x12\x0fCheck resources\x1aRCheck a principal's permissions to a batch of heterogeneous resources and actions.\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/check/resources\x12\x92\x02\n" +
+	"\fExplainCheck\x12&.cerbos.request.v1.ExplainCheckRequest\x1a(.cerbos.response.v1.ExplainCheckResponse\"\xaf\x01\x92A\x8e\x01\x12\rExplain check\x1a}Check a principal's permissions to a batch of heterogeneous resources and actions, and explain how each decision was reached.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/check/explain\x12\xc5\x01\n" +
 	"\n" +
 	"ServerInfo\x12$.cerbos.request.v1.ServerInfoRequest\x1a&.cerbos.response.v1.ServerInfoResponse\"i\x92AN\x12\x16Get server information\x1a4Get information about the server e.g. server version\x82\xd3\xe4\x93\x02\x12\x12\x10/api/server_info\x12\x83\x02\n" +
-	"\rPlanResources\x12'.cerbos.request.v1.PlanResourcesRequest\x1a).cerbos.response.v1.PlanResourcesResponse\"\x9d\x01\x92A|\x12\x0ePlan resources\x1ajProduce a query plan with conditions that must be satisfied for accessing a set of instances of a resource\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/plan/resources\x1a!\x92A\x1e\x12\x1cCerbos Policy Decision Point2\xf8\x10\n" +
+	"\rPlanResources\x12'.cerbos.request.v1.PlanResourcesRequest\x1a).cerbos.response.v1.PlanResourcesResponse\"\x9d\x01\x92A|\x12\x0ePlan resources\x1ajProduce a query plan with conditions that must be satisfied for accessing a set of instances of a resource\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/plan/resources\x12\x89\x02\n" +
+	"\x0ePlanPrincipals\x12(.cerbos.request.v1.PlanPrincipalsRequest\x1a*.cerbos.response.v1.PlanPrincipalsResponse\"\xa0\x01\x92A~\x12\x0fPlan principals\x1akProduce a query plan with conditions that must be satisfied by a principal to perform actions on a resource\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/plan/principals\x1a!\x92A\x1e\x12\x1cCerbos Policy Decision Point2\xe0\x13\n" +
 	"\x12CerbosAdminService\x12\xc9\x01\n" +
 	"\x11AddOrUpdatePolicy\x12+.cerbos.request.v1.AddOrUpdatePolicyRequest\x1a-.cerbos.response.v1.AddOrUpdatePolicyResponse\"X\x92A)\x12\x16Add or update policiesb\x0f\n" +
 	"\r\n" +
@@ -61,7 +62,10 @@ const file_cerbos_svc_v1_svc_proto_rawDesc = "" +
 	"\tBasicAuth\x12\x00\x82\xd3\xe4\x93\x02DZ\x1a:\x01*\x1a\x15/admin/policy/disableZ\x0f*\r/admin/policy\"\x15/admin/policy/disable\x12\xbc\x01\n" +
 	"\fEnablePolicy\x12&.cerbos.request.v1.EnablePolicyRequest\x1a(.cerbos.response.v1.EnablePolicyResponse\"Z\x92A \x12\rEnable policyb\x0f\n" +
 	"\r\n" +
-	"\tBasicAuth\x12\x00\x82\xd3\xe4\x93\x021Z\x19:\x01*\x1a\x14/admin/policy/enable\"\x14/admin/policy/enable\x12\xc8\x01\n" +
+	"\tBasicAuth\x12\x00\x82\xd3\xe4\x93\x021Z\x19:\x01*\x1a\x14/admin/policy/enable\"\x14/admin/policy/enable\x12\xe5\x02\n" +
+	"\x11CheckWithPolicies\x12+.cerbos.request.v1.CheckWithPoliciesRequest\x1a-.cerbos.response.v1.CheckWithPoliciesResponse\"\xf3\x01\x92A\xca\x01\x12'Check resources with candidate policies\x1a\x8d\x01Evaluate the request against the live policies and against the live policies overlaid with the candidate policies. The store is not modified.b\x0f\n" +
+	"\r\n" +
+	"\tBasicAuth\x12\x00\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/admin/check/with_policies\x12\xc8\x01\n" +
 	"\x13ListAuditLogEntries\x12-.cerbos.request.v1.ListAuditLogEntriesRequest\x1a/.cerbos.response.v1.ListAuditLogEntriesResponse\"O\x92A)\x12\x16List audit log entriesb\x0f\n" +
 	"\r\n" +
 	"\tBasicAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d\x12\x1b/admin/auditlog/list/{kind}0\x01\x12\xc7\x01\n" +
@@ -97,91 +101,103 @@ var file_cerbos_svc_v1_svc_proto_goTypes = []any{
 	(*v1.CheckResourceSetRequest)(nil),      // 0: cerbos.request.v1.CheckResourceSetRequest
 	(*v1.CheckResourceBatchRequest)(nil),    // 1: cerbos.request.v1.CheckResourceBatchRequest
 	(*v1.CheckResourcesRequest)(nil),        // 2: cerbos.request.v1.CheckResourcesRequest
-	(*v1.ServerInfoRequest)(nil),            // 3: cerbos.request.v1.ServerInfoRequest
-	(*v1.PlanResourcesRequest)(nil),         // 4: cerbos.request.v1.PlanResourcesRequest
-	(*v1.AddOrUpdatePolicyRequest)(nil),     // 5: cerbos.request.v1.AddOrUpdatePolicyRequest
-	(*v1.InspectPoliciesRequest)(nil),       // 6: cerbos.request.v1.InspectPoliciesRequest
-	(*v1.ListPoliciesRequest)(nil),          // 7: cerbos.request.v1.ListPoliciesRequest
-	(*v1.GetPolicyRequest)(nil),             // 8: cerbos.request.v1.GetPolicyRequest
-	(*v1.DisablePolicyRequest)(nil),         // 9: cerbos.request.v1.DisablePolicyRequest
-	(*v1.EnablePolicyRequest)(nil),          // 10: cerbos.request.v1.EnablePolicyRequest
-	(*v1.ListAuditLogEntriesRequest)(nil),   // 11: cerbos.request.v1.ListAuditLogEntriesRequest
-	(*v1.AddOrUpdateSchemaRequest)(nil),     // 12: cerbos.request.v1.AddOrUpdateSchemaRequest
-	(*v1.ListSchemasRequest)(nil),           // 13: cerbos.request.v1.ListSchemasRequest
-	(*v1.GetSchemaRequest)(nil),             // 14: cerbos.request.v1.GetSchemaRequest
-	(*v1.DeleteSchemaRequest)(nil),          // 15: cerbos.request.v1.DeleteSchemaRequest
-	(*v1.ReloadStoreRequest)(nil),           // 16: cerbos.request.v1.ReloadStoreRequest
-	(*v1.PlaygroundValidateRequest)(nil),    // 17: cerbos.request.v1.PlaygroundValidateRequest
-	(*v1.PlaygroundTestRequest)(nil),        // 18: cerbos.request.v1.PlaygroundTestRequest
-	(*v1.PlaygroundEvaluateRequest)(nil),    // 19: cerbos.request.v1.PlaygroundEvaluateRequest
-	(*v1.PlaygroundProxyRequest)(nil),       // 20: cerbos.request.v1.PlaygroundProxyRequest
-	(*v11.CheckResourceSetResponse)(nil),    // 21: cerbos.response.v1.CheckResourceSetResponse
-	(*v11.CheckResourceBatchResponse)(nil),  // 22: cerbos.response.v1.CheckResourceBatchResponse
-	(*v11.CheckResourcesResponse)(nil),      // 23: cerbos.response.v1.CheckResourcesResponse
-	(*v11.ServerInfoResponse)(nil),          // 24: cerbos.response.v1.ServerInfoResponse
-	(*v11.PlanResourcesResponse)(nil),       // 25: cerbos.response.v1.PlanResourcesResponse
-	(*v11.AddOrUpdatePolicyResponse)(nil),   // 26: cerbos.response.v1.AddOrUpdatePolicyResponse
-	(*v11.InspectPoliciesResponse)(nil),     // 27: cerbos.response.v1.InspectPoliciesResponse
-	(*v11.ListPoliciesResponse)(nil),        // 28: cerbos.response.v1.ListPoliciesResponse
-	(*v11.GetPolicyResponse)(nil),           // 29: cerbos.response.v1.GetPolicyResponse
-	(*v11.DisablePolicyResponse)(nil),       // 30: cerbos.response.v1.DisablePolicyResponse
-	(*v11.EnablePolicyResponse)(nil),        // 31: cerbos.response.v1.EnablePolicyResponse
-	(*v11.ListAuditLogEntriesResponse)(nil), // 32: cerbos.response.v1.ListAuditLogEntriesResponse
-	(*v11.AddOrUpdateSchemaResponse)(nil),   // 33: cerbos.response.v1.AddOrUpdateSchemaResponse
-	(*v11.ListSchemasResponse)(nil),         // 34: cerbos.response.v1.ListSchemasResponse
-	(*v11.GetSchemaResponse)(nil),           // 35: cerbos.response.v1.GetSchemaResponse
-	(*v11.DeleteSchemaResponse)(nil),        // 36: cerbos.response.v1.DeleteSchemaResponse
-	(*v11.ReloadStoreResponse)(nil),         // 37: cerbos.response.v1.ReloadStoreResponse
-	(*v11.PlaygroundValidateResponse)(nil),  // 38: cerbos.response.v1.PlaygroundValidateResponse
-	(*v11.PlaygroundTestResponse)(nil),      // 39: cerbos.response.v1.PlaygroundTestResponse
-	(*v11.PlaygroundEvaluateResponse)(nil),  // 40: cerbos.response.v1.PlaygroundEvaluateResponse
-	(*v11.PlaygroundProxyResponse)(nil),     // 41: cerbos.response.v1.PlaygroundProxyResponse
+	(*v1.ExplainCheckRequest)(nil),          // 3: cerbos.request.v1.ExplainCheckRequest
+	(*v1.ServerInfoRequest)(nil),            // 4: cerbos.request.v1.ServerInfoRequest
+	(*v1.PlanResourcesRequest)(nil),         // 5: cerbos.request.v1.PlanResourcesRequest
+	(*v1.PlanPrincipalsRequest)(nil),        // 6: cerbos.request.v1.PlanPrincipalsRequest
+	(*v1.AddOrUpdatePolicyRequest)(nil),     // 7: cerbos.request.v1.AddOrUpdatePolicyRequest
+	(*v1.InspectPoliciesRequest)(nil),       // 8: cerbos.request.v1.InspectPoliciesRequest
+	(*v1.ListPoliciesRequest)(nil),          // 9: cerbos.request.v1.ListPoliciesRequest
+	(*v1.GetPolicyRequest)(nil),             // 10: cerbos.request.v1.GetPolicyRequest
+	(*v1.DisablePolicyRequest)(nil),         // 11: cerbos.request.v1.DisablePolicyRequest
+	(*v1.EnablePolicyRequest)(nil),          // 12: cerbos.request.v1.EnablePolicyRequest
+	(*v1.CheckWithPoliciesRequest)(nil),     // 13: cerbos.request.v1.CheckWithPoliciesRequest
+	(*v1.ListAuditLogEntriesRequest)(nil),   // 14: cerbos.request.v1.ListAuditLogEntriesRequest
+	(*v1.AddOrUpdateSchemaRequest)(nil),     // 15: cerbos.request.v1.AddOrUpdateSchemaRequest
+	(*v1.ListSchemasRequest)(nil),           // 16: cerbos.request.v1.ListSchemasRequest
+	(*v1.GetSchemaRequest)(nil),             // 17: cerbos.request.v1.GetSchemaRequest
+	(*v1.DeleteSchemaRequest)(nil),          // 18: cerbos.request.v1.DeleteSchemaRequest
+	(*v1.ReloadStoreRequest)(nil),           // 19: cerbos.request.v1.ReloadStoreRequest
+	(*v1.PlaygroundValidateRequest)(nil),    // 20: cerbos.request.v1.PlaygroundValidateRequest
+	(*v1.PlaygroundTestRequest)(nil),        // 21: cerbos.request.v1.PlaygroundTestRequest
+	(*v1.PlaygroundEvaluateRequest)(nil),    // 22: cerbos.request.v1.PlaygroundEvaluateRequest
+	(*v1.PlaygroundProxyRequest)(nil),       // 23: cerbos.request.v1.PlaygroundProxyRequest
+	(*v11.CheckResourceSetResponse)(nil),    // 24: cerbos.response.v1.CheckResourceSetResponse
+	(*v11.CheckResourceBatchResponse)(nil),  // 25: cerbos.response.v1.CheckResourceBatchResponse
+	(*v11.CheckResourcesResponse)(nil),      // 26: cerbos.response.v1.CheckResourcesResponse
+	(*v11.ExplainCheckResponse)(nil),        // 27: cerbos.response.v1.ExplainCheckResponse
+	(*v11.ServerInfoResponse)(nil),          // 28: cerbos.response.v1.ServerInfoResponse
+	(*v11.PlanResourcesResponse)(nil),       // 29: cerbos.response.v1.PlanResourcesResponse
+	(*v11.PlanPrincipalsResponse)(nil),      // 30: cerbos.response.v1.PlanPrincipalsResponse
+	(*v11.AddOrUpdatePolicyResponse)(nil),   // 31: cerbos.response.v1.AddOrUpdatePolicyResponse
+	(*v11.InspectPoliciesResponse)(nil),     // 32: cerbos.response.v1.InspectPoliciesResponse
+	(*v11.ListPoliciesResponse)(nil),        // 33: cerbos.response.v1.ListPoliciesResponse
+	(*v11.GetPolicyResponse)(nil),           // 34: cerbos.response.v1.GetPolicyResponse
+	(*v11.DisablePolicyResponse)(nil),       // 35: cerbos.response.v1.DisablePolicyResponse
+	(*v11.EnablePolicyResponse)(nil),        // 36: cerbos.response.v1.EnablePolicyResponse
+	(*v11.CheckWithPoliciesResponse)(nil),   // 37: cerbos.response.v1.CheckWithPoliciesResponse
+	(*v11.ListAuditLogEntriesResponse)(nil), // 38: cerbos.response.v1.ListAuditLogEntriesResponse
+	(*v11.AddOrUpdateSchemaResponse)(nil),   // 39: cerbos.response.v1.AddOrUpdateSchemaResponse
+	(*v11.ListSchemasResponse)(nil),         // 40: cerbos.response.v1.ListSchemasResponse
+	(*v11.GetSchemaResponse)(nil),           // 41: cerbos.response.v1.GetSchemaResponse
+	(*v11.DeleteSchemaResponse)(nil),        // 42: cerbos.response.v1.DeleteSchemaResponse
+	(*v11.ReloadStoreResponse)(nil),         // 43: cerbos.response.v1.ReloadStoreResponse
+	(*v11.PlaygroundValidateResponse)(nil),  // 44: cerbos.response.v1.PlaygroundValidateResponse
+	(*v11.PlaygroundTestResponse)(nil),      // 45: cerbos.response.v1.PlaygroundTestResponse
+	(*v11.PlaygroundEvaluateResponse)(nil),  // 46: cerbos.response.v1.PlaygroundEvaluateResponse
+	(*v11.PlaygroundProxyResponse)(nil),     // 47: cerbos.response.v1.PlaygroundProxyResponse
 }
 var file_cerbos_svc_v1_svc_proto_depIdxs = []int32{
 	0,  // 0: cerbos.svc.v1.CerbosService.CheckResourceSet:input_type -> cerbos.request.v1.CheckResourceSetRequest
 	1,  // 1: cerbos.svc.v1.CerbosService.CheckResourceBatch:input_type -> cerbos.request.v1.CheckResourceBatchRequest
 	2,  // 2: cerbos.svc.v1.CerbosService.CheckResources:input_type -> cerbos.request.v1.CheckResourcesRequest
-	3,  // 3: cerbos.svc.v1.CerbosService.ServerInfo:input_type -> cerbos.request.v1.ServerInfoRequest
-	4,  // 4: cerbos.svc.v1.CerbosService.PlanResources:input_type -> cerbos.request.v1.PlanResourcesRequest
-	5,  // 5: cerbos.svc.v1.CerbosAdminService.AddOrUpdatePolicy:input_type -> cerbos.request.v1.AddOrUpdatePolicyRequest
-	6,  // 6: cerbos.svc.v1.CerbosAdminService.InspectPolicies:input_type -> cerbos.request.v1.InspectPoliciesRequest
-	7,  // 7: cerbos.svc.v1.CerbosAdminService.ListPolicies:input_type -> cerbos.request.v1.ListPoliciesRequest
-	8,  // 8: cerbos.svc.v1.CerbosAdminService.GetPolicy:input_type -> cerbos.request.v1.GetPolicyRequest
-	9,  // 9: cerbos.svc.v1.CerbosAdminService.DisablePolicy:input_type -> cerbos.request.v1.DisablePolicyRequest
-	10, // 10: cerbos.svc.v1.CerbosAdminService.EnablePolicy:input_type -> cerbos.request.v1.EnablePolicyRequest
-	11, // 11: cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries:input_type -> cerbos.request.v1.ListAuditLogEntriesRequest
-	12, // 12: cerbos.svc.v1.CerbosAdminService.AddOrUpdateSchema:input_type -> cerbos.request.v1.AddOrUpdateSchemaRequest
-	13, // 13: cerbos.svc.v1.CerbosAdminService.ListSchemas:input_type -> cerbos.request.v1.ListSchemasRequest
-	14, // 14: cerbos.svc.v1.CerbosAdminService.GetSchema:input_type -> cerbos.request.v1.GetSchemaRequest
-	15, // 15: cerbos.svc.v1.CerbosAdminService.DeleteSchema:input_type -> cerbos.request.v1.DeleteSchemaRequest
-	16, // 16: cerbos.svc.v1.CerbosAdminService.ReloadStore:input_type -> cerbos.request.v1.ReloadStoreRequest
-	17, // 17: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:input_type -> cerbos.request.v1.PlaygroundValidateRequest
-	18, // 18: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:input_type -> cerbos.request.v1.PlaygroundTestRequest
-	19, // 19: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:input_type -> cerbos.request.v1.PlaygroundEvaluateRequest
-	20, // 20: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:input_type -> cerbos.request.v1.PlaygroundProxyRequest
-	21, // 21: cerbos.svc.v1.CerbosService.CheckResourceSet:output_type -> cerbos.response.v1.CheckResourceSetResponse
-	22, // 22: cerbos.svc.v1.CerbosService.CheckResourceBatch:output_type -> cerbos.response.v1.CheckResourceBatchResponse
-	23, // 23: cerbos.svc.v1.CerbosService.CheckResources:output_type -> cerbos.response.v1.CheckResourcesResponse
-	24, // 24: cerbos.svc.v1.CerbosService.ServerInfo:output_type -> cerbos.response.v1.ServerInfoResponse
-	25, // 25: cerbos.svc.v1.CerbosService.PlanResources:output_type -> cerbos.response.v1.PlanResourcesResponse
-	26, // 26: cerbos.svc.v1.CerbosAdminService.AddOrUpdatePolicy:output_type -> cerbos.response.v1.AddOrUpdatePolicyResponse
-	27, // 27: cerbos.svc.v1.CerbosAdminService.InspectPolicies:output_type -> cerbos.response.v1.InspectPoliciesResponse
-	28, // 28: cerbos.svc.v1.CerbosAdminService.ListPolicies:output_type -> cerbos.response.v1.ListPoliciesResponse
-	29, // 29: cerbos.svc.v1.CerbosAdminService.GetPolicy:output_type -> cerbos.response.v1.GetPolicyResponse
-	30, // 30: cerbos.svc.v1.CerbosAdminService.DisablePolicy:output_type -> cerbos.response.v1.DisablePolicyResponse
-	31, // 31: cerbos.svc.v1.CerbosAdminService.EnablePolicy:output_type -> cerbos.response.v1.EnablePolicyResponse
-	32, // 32: cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries:output_type -> cerbos.response.v1.ListAuditLogEntriesResponse
-	33, // 33: cerbos.svc.v1.CerbosAdminService.AddOrUpdateSchema:output_type -> cerbos.response.v1.AddOrUpdateSchemaResponse
-	34, // 34: cerbos.svc.v1.CerbosAdminService.ListSchemas:output_type -> cerbos.response.v1.ListSchemasResponse
-	35, // 35: cerbos.svc.v1.CerbosAdminService.GetSchema:output_type -> cerbos.response.v1.GetSchemaResponse
-	36, // 36: cerbos.svc.v1.CerbosAdminService.DeleteSchema:output_type -> cerbos.response.v1.DeleteSchemaResponse
-	37, // 37: cerbos.svc.v1.CerbosAdminService.ReloadStore:output_type -> cerbos.response.v1.ReloadStoreResponse
-	38, // 38: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:output_type -> cerbos.response.v1.PlaygroundValidateResponse
-	39, // 39: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:output_type -> cerbos.response.v1.PlaygroundTestResponse
-	40, // 40: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:output_type -> cerbos.response.v1.PlaygroundEvaluateResponse
-	41, // 41: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:output_type -> cerbos.response.v1.PlaygroundProxyResponse
-	21, // [21:42] is the sub-list for method output_type
-	0,  // [0:21] is the sub-list for method input_type
+	3,  // 3: cerbos.svc.v1.CerbosService.ExplainCheck:input_type -> cerbos.request.v1.ExplainCheckRequest
+	4,  // 4: cerbos.svc.v1.CerbosService.ServerInfo:input_type -> cerbos.request.v1.ServerInfoRequest
+	5,  // 5: cerbos.svc.v1.CerbosService.PlanResources:input_type -> cerbos.request.v1.PlanResourcesRequest
+	6,  // 6: cerbos.svc.v1.CerbosService.PlanPrincipals:input_type -> cerbos.request.v1.PlanPrincipalsRequest
+	7,  // 7: cerbos.svc.v1.CerbosAdminService.AddOrUpdatePolicy:input_type -> cerbos.request.v1.AddOrUpdatePolicyRequest
+	8,  // 8: cerbos.svc.v1.CerbosAdminService.InspectPolicies:input_type -> cerbos.request.v1.InspectPoliciesRequest
+	9,  // 9: cerbos.svc.v1.CerbosAdminService.ListPolicies:input_type -> cerbos.request.v1.ListPoliciesRequest
+	10, // 10: cerbos.svc.v1.CerbosAdminService.GetPolicy:input_type -> cerbos.request.v1.GetPolicyRequest
+	11, // 11: cerbos.svc.v1.CerbosAdminService.DisablePolicy:input_type -> cerbos.request.v1.DisablePolicyRequest
+	12, // 12: cerbos.svc.v1.CerbosAdminService.EnablePolicy:input_type -> cerbos.request.v1.EnablePolicyRequest
+	13, // 13: cerbos.svc.v1.CerbosAdminService.CheckWithPolicies:input_type -> cerbos.request.v1.CheckWithPoliciesRequest
+	14, // 14: cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries:input_type -> cerbos.request.v1.ListAuditLogEntriesRequest
+	15, // 15: cerbos.svc.v1.CerbosAdminService.AddOrUpdateSchema:input_type -> cerbos.request.v1.AddOrUpdateSchemaRequest
+	16, // 16: cerbos.svc.v1.CerbosAdminService.ListSchemas:input_type -> cerbos.request.v1.ListSchemasRequest
+	17, // 17: cerbos.svc.v1.CerbosAdminService.GetSchema:input_type -> cerbos.request.v1.GetSchemaRequest
+	18, // 18: cerbos.svc.v1.CerbosAdminService.DeleteSchema:input_type -> cerbos.request.v1.DeleteSchemaRequest
+	19, // 19: cerbos.svc.v1.CerbosAdminService.ReloadStore:input_type -> cerbos.request.v1.ReloadStoreRequest
+	20, // 20: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:input_type -> cerbos.request.v1.PlaygroundValidateRequest
+	21, // 21: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:input_type -> cerbos.request.v1.PlaygroundTestRequest
+	22, // 22: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:input_type -> cerbos.request.v1.PlaygroundEvaluateRequest
+	23, // 23: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:input_type -> cerbos.request.v1.PlaygroundProxyRequest
+	24, // 24: cerbos.svc.v1.CerbosService.CheckResourceSet:output_type -> cerbos.response.v1.CheckResourceSetResponse
+	25, // 25: cerbos.svc.v1.CerbosService.CheckResourceBatch:output_type -> cerbos.response.v1.CheckResourceBatchResponse
+	26, // 26: cerbos.svc.v1.CerbosService.CheckResources:output_type -> cerbos.response.v1.CheckResourcesResponse
+	27, // 27: cerbos.svc.v1.CerbosService.ExplainCheck:output_type -> cerbos.response.v1.ExplainCheckResponse
+	28, // 28: cerbos.svc.v1.CerbosService.ServerInfo:output_type -> cerbos.response.v1.ServerInfoResponse
+	29, // 29: cerbos.svc.v1.CerbosService.PlanResources:output_type -> cerbos.response.v1.PlanResourcesResponse
+	30, // 30: cerbos.svc.v1.CerbosService.PlanPrincipals:output_type -> cerbos.response.v1.PlanPrincipalsResponse
+	31, // 31: cerbos.svc.v1.CerbosAdminService.AddOrUpdatePolicy:output_type -> cerbos.response.v1.AddOrUpdatePolicyResponse
+	32, // 32: cerbos.svc.v1.CerbosAdminService.InspectPolicies:output_type -> cerbos.response.v1.InspectPoliciesResponse
+	33, // 33: cerbos.svc.v1.CerbosAdminService.ListPolicies:output_type -> cerbos.response.v1.ListPoliciesResponse
+	34, // 34: cerbos.svc.v1.CerbosAdminService.GetPolicy:output_type -> cerbos.response.v1.GetPolicyResponse
+	35, // 35: cerbos.svc.v1.CerbosAdminService.DisablePolicy:output_type -> cerbos.response.v1.DisablePolicyResponse
+	36, // 36: cerbos.svc.v1.CerbosAdminService.EnablePolicy:output_type -> cerbos.response.v1.EnablePolicyResponse
+	37, // 37: cerbos.svc.v1.CerbosAdminService.CheckWithPolicies:output_type -> cerbos.response.v1.CheckWithPoliciesResponse
+	38, // 38: cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries:output_type -> cerbos.response.v1.ListAuditLogEntriesResponse
+	39, // 39: cerbos.svc.v1.CerbosAdminService.AddOrUpdateSchema:output_type -> cerbos.response.v1.AddOrUpdateSchemaResponse
+	40, // 40: cerbos.svc.v1.CerbosAdminService.ListSchemas:output_type -> cerbos.response.v1.ListSchemasResponse
+	41, // 41: cerbos.svc.v1.CerbosAdminService.GetSchema:output_type -> cerbos.response.v1.GetSchemaResponse
+	42, // 42: cerbos.svc.v1.CerbosAdminService.DeleteSchema:output_type -> cerbos.response.v1.DeleteSchemaResponse
+	43, // 43: cerbos.svc.v1.CerbosAdminService.ReloadStore:output_type -> cerbos.response.v1.ReloadStoreResponse
+	44, // 44: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:output_type -> cerbos.response.v1.PlaygroundValidateResponse
+	45, // 45: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:output_type -> cerbos.response.v1.PlaygroundTestResponse
+	46, // 46: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:output_type -> cerbos.response.v1.PlaygroundEvaluateResponse
+	47, // 47: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:output_type -> cerbos.response.v1.PlaygroundProxyResponse
+	24, // [24:48] is the sub-list for method output_type
+	0,  // [0:24] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -27,8 +27,10 @@ const (
 	CerbosService_CheckResourceSet_FullMethodName   = "/cerbos.svc.v1.CerbosService/CheckResourceSet"
 	CerbosService_CheckResourceBatch_FullMethodName = "/cerbos.svc.v1.CerbosService/CheckResourceBatch"
 	CerbosService_CheckResources_FullMethodName     = "/cerbos.svc.v1.CerbosService/CheckResources"
+	CerbosService_ExplainCheck_FullMethodName       = "/cerbos.svc.v1.CerbosService/ExplainCheck"
 	CerbosService_ServerInfo_FullMethodName         = "/cerbos.svc.v1.CerbosService/ServerInfo"
 	CerbosService_PlanResources_FullMethodName      = "/cerbos.svc.v1.CerbosService/PlanResources"
+	CerbosService_PlanPrincipals_FullMethodName     = "/cerbos.svc.v1.CerbosService/PlanPrincipals"
 )
 
 // CerbosServiceClient is the client API for CerbosService service.
@@ -38,8 +40,10 @@ type CerbosServiceClient interface {
 	CheckResourceSet(ctx context.Context, in *v1.CheckResourceSetRequest, opts ...grpc.CallOption) (*v11.CheckResourceSetResponse, error)
 	CheckResourceBatch(ctx context.Context, in *v1.CheckResourceBatchRequest, opts ...grpc.CallOption) (*v11.CheckResourceBatchResponse, error)
 	CheckResources(ctx context.Context, in *v1.CheckResourcesRequest, opts ...grpc.CallOption) (*v11.CheckResourcesResponse, error)
+	ExplainCheck(ctx context.Context, in *v1.ExplainCheckRequest, opts ...grpc.CallOption) (*v11.ExplainCheckResponse, error)
 	ServerInfo(ctx context.Context, in *v1.ServerInfoRequest, opts ...grpc.CallOption) (*v11.ServerInfoResponse, error)
 	PlanResources(ctx context.Context, in *v1.PlanResourcesRequest, opts ...grpc.CallOption) (*v11.PlanResourcesResponse, error)
+	PlanPrincipals(ctx context.Context, in *v1.PlanPrincipalsRequest, opts ...grpc.CallOption) (*v11.PlanPrincipalsResponse, error)
 }
 
 type cerbosServiceClient struct {
@@ -80,6 +84,16 @@ func (c *cerbosServiceClient) CheckResources(ctx context.Context, in *v1.CheckRe
 	return out, nil
 }
 
+func (c *cerbosServiceClient) ExplainCheck(ctx context.Context, in *v1.ExplainCheckRequest, opts ...grpc.CallOption) (*v11.ExplainCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ExplainCheckResponse)
+	err := c.cc.Invoke(ctx, CerbosService_ExplainCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cerbosServiceClient) ServerInfo(ctx context.Context, in *v1.ServerInfoRequest, opts ...grpc.CallOption) (*v11.ServerInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v11.ServerInfoResponse)
@@ -100,6 +114,16 @@ func (c *cerbosServiceClient) PlanResources(ctx context.Context, in *v1.PlanReso
 	return out, nil
 }
 
+func (c *cerbosServiceClient) PlanPrincipals(ctx context.Context, in *v1.PlanPrincipalsRequest, opts ...grpc.CallOption) (*v11.PlanPrincipalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.PlanPrincipalsResponse)
+	err := c.cc.Invoke(ctx, CerbosService_PlanPrincipals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CerbosServiceServer is the server API for CerbosService service.
 // All implementations must embed UnimplementedCerbosServiceServer
 // for forward compatibility.
@@ -107,8 +131,10 @@ type CerbosServiceServer interface {
 	CheckResourceSet(context.Context, *v1.CheckResourceSetRequest) (*v11.CheckResourceSetResponse, error)
 	CheckResourceBatch(context.Context, *v1.CheckResourceBatchRequest) (*v11.CheckResourceBatchResponse, error)
 	CheckResources(context.Context, *v1.CheckResourcesRequest) (*v11.CheckResourcesResponse, error)
+	ExplainCheck(context.Context, *v1.ExplainCheckRequest) (*v11.ExplainCheckResponse, error)
 	ServerInfo(context.Context, *v1.ServerInfoRequest) (*v11.ServerInfoResponse, error)
 	PlanResources(context.Context, *v1.PlanResourcesRequest) (*v11.PlanResourcesResponse, error)
+	PlanPrincipals(context.Context, *v1.PlanPrincipalsRequest) (*v11.PlanPrincipalsResponse, error)
 	mustEmbedUnimplementedCerbosServiceServer()
 }
 
@@ -128,12 +154,18 @@ func (UnimplementedCerbosServiceServer) CheckResourceBatch(context.Context, *v1.
 func (UnimplementedCerbosServiceServer) CheckResources(context.Context, *v1.CheckResourcesRequest) (*v11.CheckResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckResources not implemented")
 }
+func (UnimplementedCerbosServiceServer) ExplainCheck(context.Context, *v1.ExplainCheckRequest) (*v11.ExplainCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainCheck not implemented")
+}
 func (UnimplementedCerbosServiceServer) ServerInfo(context.Context, *v1.ServerInfoRequest) (*v11.ServerInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
 }
 func (UnimplementedCerbosServiceServer) PlanResources(context.Context, *v1.PlanResourcesRequest) (*v11.PlanResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlanResources not implemented")
 }
+func (UnimplementedCerbosServiceServer) PlanPrincipals(context.Context, *v1.PlanPrincipalsRequest) (*v11.PlanPrincipalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanPrincipals not implemented")
+}
 func (UnimplementedCerbosServiceServer) mustEmbedUnimplementedCerbosServiceServer() {}
 func (UnimplementedCerbosServiceServer) testEmbeddedByValue()                       {}
 
@@ -209,6 +241,24 @@ func _CerbosService_CheckResources_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosService_ExplainCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ExplainCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosServiceServer).ExplainCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosService_ExplainCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosServiceServer).ExplainCheck(ctx, req.(*v1.ExplainCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CerbosService_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.ServerInfoRequest)
 	if err := dec(in); err != nil {
@@ -245,6 +295,24 @@ func _CerbosService_PlanResources_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosService_PlanPrincipals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PlanPrincipalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosServiceServer).PlanPrincipals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosService_PlanPrincipals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosServiceServer).PlanPrincipals(ctx, req.(*v1.PlanPrincipalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CerbosService_ServiceDesc is the grpc.ServiceDesc for CerbosService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -264,6 +332,10 @@ var CerbosService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckResources",
 			Handler:    _CerbosService_CheckResources_Handler,
 		},
+		{
+			MethodName: "ExplainCheck",
+			Handler:    _CerbosService_ExplainCheck_Handler,
+		},
 		{
 			MethodName: "ServerInfo",
 			Handler:    _CerbosService_ServerInfo_Handler,
@@ -272,6 +344,10 @@ var CerbosService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlanResources",
 			Handler:    _CerbosService_PlanResources_Handler,
 		},
+		{
+			MethodName: "PlanPrincipals",
+			Handler:    _CerbosService_PlanPrincipals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cerbos/svc/v1/svc.proto",
@@ -284,6 +360,7 @@ const (
 	CerbosAdminService_GetPolicy_FullMethodName           = "/cerbos.svc.v1.CerbosAdminService/GetPolicy"
 	CerbosAdminService_DisablePolicy_FullMethodName       = "/cerbos.svc.v1.CerbosAdminService/DisablePolicy"
 	CerbosAdminService_EnablePolicy_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/EnablePolicy"
+	CerbosAdminService_CheckWithPolicies_FullMethodName   = "/cerbos.svc.v1.CerbosAdminService/CheckWithPolicies"
 	CerbosAdminService_ListAuditLogEntries_FullMethodName = "/cerbos.svc.v1.CerbosAdminService/ListAuditLogEntries"
 	CerbosAdminService_AddOrUpdateSchema_FullMethodName   = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdateSchema"
 	CerbosAdminService_ListSchemas_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/ListSchemas"
@@ -302,6 +379,7 @@ type CerbosAdminServiceClient interface {
 	GetPolicy(ctx context.Context, in *v1.GetPolicyRequest, opts ...grpc.CallOption) (*v11.GetPolicyResponse, error)
 	DisablePolicy(ctx context.Context, in *v1.DisablePolicyRequest, opts ...grpc.CallOption) (*v11.DisablePolicyResponse, error)
 	EnablePolicy(ctx context.Context, in *v1.EnablePolicyRequest, opts ...grpc.CallOption) (*v11.EnablePolicyResponse, error)
+	CheckWithPolicies(ctx context.Context, in *v1.CheckWithPoliciesRequest, opts ...grpc.CallOption) (*v11.CheckWithPoliciesResponse, error)
 	ListAuditLogEntries(ctx context.Context, in *v1.ListAuditLogEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v11.ListAuditLogEntriesResponse], error)
 	AddOrUpdateSchema(ctx context.Context, in *v1.AddOrUpdateSchemaRequest, opts ...grpc.CallOption) (*v11.AddOrUpdateSchemaResponse, error)
 	ListSchemas(ctx context.Context, in *v1.ListSchemasRequest, opts ...grpc.CallOption) (*v11.ListSchemasResponse, error)
@@ -378,6 +456,16 @@ func (c *cerbosAdminServiceClient) EnablePolicy(ctx context.Context, in *v1.Enab
 	return out, nil
 }
 
+func (c *cerbosAdminServiceClient) CheckWithPolicies(ctx context.Context, in *v1.CheckWithPoliciesRequest, opts ...grpc.CallOption) (*v11.CheckWithPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.CheckWithPoliciesResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_CheckWithPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cerbosAdminServiceClient) ListAuditLogEntries(ctx context.Context, in *v1.ListAuditLogEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v11.ListAuditLogEntriesResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &CerbosAdminService_ServiceDesc.Streams[0], CerbosAdminService_ListAuditLogEntries_FullMethodName, cOpts...)
@@ -457,6 +545,7 @@ type CerbosAdminServiceServer interface {
 	GetPolicy(context.Context, *v1.GetPolicyRequest) (*v11.GetPolicyResponse, error)
 	DisablePolicy(context.Context, *v1.DisablePolicyRequest) (*v11.DisablePolicyResponse, error)
 	EnablePolicy(context.Context, *v1.EnablePolicyRequest) (*v11.EnablePolicyResponse, error)
+	CheckWithPolicies(context.Context, *v1.CheckWithPoliciesRequest) (*v11.CheckWithPoliciesResponse, error)
 	ListAuditLogEntries(*v1.ListAuditLogEntriesRequest, grpc.ServerStreamingServer[v11.ListAuditLogEntriesResponse]) error
 	AddOrUpdateSchema(context.Context, *v1.AddOrUpdateSchemaRequest) (*v11.AddOrUpdateSchemaResponse, error)
 	ListSchemas(context.Context, *v1.ListSchemasRequest) (*v11.ListSchemasResponse, error)
@@ -491,6 +580,9 @@ func (UnimplementedCerbosAdminServiceServer) DisablePolicy(context.Context, *v1.
 func (UnimplementedCerbosAdminServiceServer) EnablePolicy(context.Context, *v1.EnablePolicyRequest) (*v11.EnablePolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnablePolicy not implemented")
 }
+func (UnimplementedCerbosAdminServiceServer) CheckWithPolicies(context.Context, *v1.CheckWithPoliciesRequest) (*v11.CheckWithPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckWithPolicies not implemented")
+}
 func (UnimplementedCerbosAdminServiceServer) ListAuditLogEntries(*v1.ListAuditLogEntriesRequest, grpc.ServerStreamingServer[v11.ListAuditLogEntriesResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ListAuditLogEntries not implemented")
 }
@@ -638,6 +730,24 @@ func _CerbosAdminService_EnablePolicy_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosAdminService_CheckWithPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.CheckWithPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).CheckWithPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_CheckWithPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).CheckWithPolicies(ctx, req.(*v1.CheckWithPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CerbosAdminService_ListAuditLogEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(v1.ListAuditLogEntriesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -770,6 +880,10 @@ var CerbosAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnablePolicy",
 			Handler:    _CerbosAdminService_EnablePolicy_Handler,
 		},
+		{
+			MethodName: "CheckWithPolicies",
+			Handler:    _CerbosAdminService_CheckWithPolicies_Handler,
+		},
 		{
 			MethodName: "AddOrUpdateSchema",
 			Handler:    _CerbosAdminService_AddOrUpdateSchema_Handler,
@@ -51,12 +51,18 @@ const (
 	// CerbosServiceCheckResourcesProcedure is the fully-qualified name of the CerbosService's
 	// CheckResources RPC.
 	CerbosServiceCheckResourcesProcedure = "/cerbos.svc.v1.CerbosService/CheckResources"
+	// CerbosServiceExplainCheckProcedure is the fully-qualified name of the CerbosService's
+	// ExplainCheck RPC.
+	CerbosServiceExplainCheckProcedure = "/cerbos.svc.v1.CerbosService/ExplainCheck"
 	// CerbosServiceServerInfoProcedure is the fully-qualified name of the CerbosService's ServerInfo
 	// RPC.
 	CerbosServiceServerInfoProcedure = "/cerbos.svc.v1.CerbosService/ServerInfo"
 	// CerbosServicePlanResourcesProcedure is the fully-qualified name of the CerbosService's
 	// PlanResources RPC.
 	CerbosServicePlanResourcesProcedure = "/cerbos.svc.v1.CerbosService/PlanResources"
+	// CerbosServicePlanPrincipalsProcedure is the fully-qualified name of the CerbosService's
+	// PlanPrincipals RPC.
+	CerbosServicePlanPrincipalsProcedure = "/cerbos.svc.v1.CerbosService/PlanPrincipals"
 	// CerbosAdminServiceAddOrUpdatePolicyProcedure is the fully-qualified name of the
 	// CerbosAdminService's AddOrUpdatePolicy RPC.
 	CerbosAdminServiceAddOrUpdatePolicyProcedure = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdatePolicy"
@@ -75,6 +81,9 @@ const (
 	// CerbosAdminServiceEnablePolicyProcedure is the fully-qualified name of the CerbosAdminService's
 	// EnablePolicy RPC.
 	CerbosAdminServiceEnablePolicyProcedure = "/cerbos.svc.v1.CerbosAdminService/EnablePolicy"
+	// CerbosAdminServiceCheckWithPoliciesProcedure is the fully-qualified name of the
+	// CerbosAdminService's CheckWithPolicies RPC.
+	CerbosAdminServiceCheckWithPoliciesProcedure = "/cerbos.svc.v1.CerbosAdminService/CheckWithPolicies"
 	// CerbosAdminServiceListAuditLogEntriesProcedure is the fully-qualified name of the
 	// CerbosAdminService's ListAuditLogEntries RPC.
 	CerbosAdminServiceListAuditLogEntriesProcedure = "/cerbos.svc.v1.CerbosAdminService/ListAuditLogEntries"
@@ -112,8 +121,10 @@ type CerbosServiceClient interface {
 	CheckResourceSet(context.Context, *connect.Request[v1.CheckResourceSetRequest]) (*connect.Response[v11.CheckResourceSetResponse], error)
 	CheckResourceBatch(context.Context, *connect.Request[v1.CheckResourceBatchRequest]) (*connect.Response[v11.CheckResourceBatchResponse], error)
 	CheckResources(context.Context, *connect.Request[v1.CheckResourcesRequest]) (*connect.Response[v11.CheckResourcesResponse], error)
+	ExplainCheck(context.Context, *connect.Request[v1.ExplainCheckRequest]) (*connect.Response[v11.ExplainCheckResponse], error)
 	ServerInfo(context.Context, *connect.Request[v1.ServerInfoRequest]) (*connect.Response[v11.ServerInfoResponse], error)
 	PlanResources(context.Context, *connect.Request[v1.PlanResourcesRequest]) (*connect.Response[v11.PlanResourcesResponse], error)
+	PlanPrincipals(context.Context, *connect.Request[v1.PlanPrincipalsRequest]) (*connect.Response[v11.PlanPrincipalsResponse], error)
 }
 
 // NewCerbosServiceClient constructs a client for the cerbos.svc.v1.CerbosService service. By
@@ -145,6 +156,12 @@ func NewCerbosServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(cerbosServiceMethods.ByName("CheckResources")),
 			connect.WithClientOptions(opts...),
 		),
+		explainCheck: connect.NewClient[v1.ExplainCheckRequest, v11.ExplainCheckResponse](
+			httpClient,
+			baseURL+CerbosServiceExplainCheckProcedure,
+			connect.WithSchema(cerbosServiceMethods.ByName("ExplainCheck")),
+			connect.WithClientOptions(opts...),
+		),
 		serverInfo: connect.NewClient[v1.ServerInfoRequest, v11.ServerInfoResponse](
 			httpClient,
 			baseURL+CerbosServiceServerInfoProcedure,
@@ -157,6 +174,12 @@ func NewCerbosServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(cerbosServiceMethods.ByName("PlanResources")),
 			connect.WithClientOptions(opts...),
 		),
+		planPrincipals: connect.NewClient[v1.PlanPrincipalsRequest, v11.PlanPrincipalsResponse](
+			httpClient,
+			baseURL+CerbosServicePlanPrincipalsProcedure,
+			connect.WithSchema(cerbosServiceMethods.ByName("PlanPrincipals")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -165,8 +188,10 @@ type cerbosServiceClient struct {
 	checkResourceSet   *connect.Client[v1.CheckResourceSetRequest, v11.CheckResourceSetResponse]
 	checkResourceBatch *connect.Client[v1.CheckResourceBatchRequest, v11.CheckResourceBatchResponse]
 	checkResources     *connect.Client[v1.CheckResourcesRequest, v11.CheckResourcesResponse]
+	explainCheck       *connect.Client[v1.ExplainCheckRequest, v11.ExplainCheckResponse]
 	serverInfo         *connect.Client[v1.ServerInfoRequest, v11.ServerInfoResponse]
 	planResources      *connect.Client[v1.PlanResourcesRequest, v11.PlanResourcesResponse]
+	planPrincipals     *connect.Client[v1.PlanPrincipalsRequest, v11.PlanPrincipalsResponse]
 }
 
 // CheckResourceSet calls cerbos.svc.v1.CerbosService.CheckResourceSet.
@@ -184,6 +209,11 @@ func (c *cerbosServiceClient) CheckResources(ctx context.Context, req *connect.R
 	return c.checkResources.CallUnary(ctx, req)
 }
 
+// ExplainCheck calls cerbos.svc.v1.CerbosService.ExplainCheck.
+func (c *cerbosServiceClient) ExplainCheck(ctx context.Context, req *connect.Request[v1.ExplainCheckRequest]) (*connect.Response[v11.ExplainCheckResponse], error) {
+	return c.explainCheck.CallUnary(ctx, req)
+}
+
 // ServerInfo calls cerbos.svc.v1.CerbosService.ServerInfo.
 func (c *cerbosServiceClient) ServerInfo(ctx context.Context, req *connect.Request[v1.ServerInfoRequest]) (*connect.Response[v11.ServerInfoResponse], error) {
 	return c.serverInfo.CallUnary(ctx, req)
@@ -194,13 +224,20 @@ func (c *cerbosServiceClient) PlanResources(ctx context.Context, req *connect.Re
 	return c.planResources.CallUnary(ctx, req)
 }
 
+// PlanPrincipals calls cerbos.svc.v1.CerbosService.PlanPrincipals.
+func (c *cerbosServiceClient) PlanPrincipals(ctx context.Context, req *connect.Request[v1.PlanPrincipalsRequest]) (*connect.Response[v11.PlanPrincipalsResponse], error) {
+	return c.planPrincipals.CallUnary(ctx, req)
+}
+
 // CerbosServiceHandler is an implementation of the cerbos.svc.v1.CerbosService service.
 type CerbosServiceHandler interface {
 	CheckResourceSet(context.Context, *connect.Request[v1.CheckResourceSetRequest]) (*connect.Response[v11.CheckResourceSetResponse], error)
 	CheckResourceBatch(context.Context, *connect.Request[v1.CheckResourceBatchRequest]) (*connect.Response[v11.CheckResourceBatchResponse], error)
 	CheckResources(context.Context, *connect.Request[v1.CheckResourcesRequest]) (*connect.Response[v11.CheckResourcesResponse], error)
+	ExplainCheck(context.Context, *connect.Request[v1.ExplainCheckRequest]) (*connect.Response[v11.ExplainCheckResponse], error)
 	ServerInfo(context.Context, *connect.Request[v1.ServerInfoRequest]) (*connect.Response[v11.ServerInfoResponse], error)
 	PlanResources(context.Context, *connect.Request[v1.PlanResourcesRequest]) (*connect.Response[v11.PlanResourcesResponse], error)
+	PlanPrincipals(context.Context, *connect.Request[v1.PlanPrincipalsRequest]) (*connect.Response[v11.PlanPrincipalsResponse], error)
 }
 
 // NewCerbosServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -228,6 +265,12 @@ func NewCerbosServiceHandler(svc CerbosServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(cerbosServiceMethods.ByName("CheckResources")),
 		connect.WithHandlerOptions(opts...),
 	)
+	cerbosServiceExplainCheckHandler := connect.NewUnaryHandler(
+		CerbosServiceExplainCheckProcedure,
+		svc.ExplainCheck,
+		connect.WithSchema(cerbosServiceMethods.ByName("ExplainCheck")),
+		connect.WithHandlerOptions(opts...),
+	)
 	cerbosServiceServerInfoHandler := connect.NewUnaryHandler(
 		CerbosServiceServerInfoProcedure,
 		svc.ServerInfo,
@@ -240,6 +283,12 @@ func NewCerbosServiceHandler(svc CerbosServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(cerbosServiceMethods.ByName("PlanResources")),
 		connect.WithHandlerOptions(opts...),
 	)
+	cerbosServicePlanPrincipalsHandler := connect.NewUnaryHandler(
+		CerbosServicePlanPrincipalsProcedure,
+		svc.PlanPrincipals,
+		connect.WithSchema(cerbosServiceMethods.ByName("PlanPrincipals")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/cerbos.svc.v1.CerbosService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CerbosServiceCheckResourceSetProcedure:
@@ -248,10 +297,14 @@ func NewCerbosServiceHandler(svc CerbosServiceHandler, opts ...connect.HandlerOp
 			cerbosServiceCheckResourceBatchHandler.ServeHTTP(w, r)
 		case CerbosServiceCheckResourcesProcedure:
 			cerbosServiceCheckResourcesHandler.ServeHTTP(w, r)
+		case CerbosServiceExplainCheckProcedure:
+			cerbosServiceExplainCheckHandler.ServeHTTP(w, r)
 		case CerbosServiceServerInfoProcedure:
 			cerbosServiceServerInfoHandler.ServeHTTP(w, r)
 		case CerbosServicePlanResourcesProcedure:
 			cerbosServicePlanResourcesHandler.ServeHTTP(w, r)
+		case CerbosServicePlanPrincipalsProcedure:
+			cerbosServicePlanPrincipalsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -273,6 +326,10 @@ func (UnimplementedCerbosServiceHandler) CheckResources(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosService.CheckResources is not implemented"))
 }
 
+func (UnimplementedCerbosServiceHandler) ExplainCheck(context.Context, *connect.Request[v1.ExplainCheckRequest]) (*connect.Response[v11.ExplainCheckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosService.ExplainCheck is not implemented"))
+}
+
 func (UnimplementedCerbosServiceHandler) ServerInfo(context.Context, *connect.Request[v1.ServerInfoRequest]) (*connect.Response[v11.ServerInfoResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosService.ServerInfo is not implemented"))
 }
@@ -281,6 +338,10 @@ func (UnimplementedCerbosServiceHandler) PlanResources(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosService.PlanResources is not implemented"))
 }
 
+func (UnimplementedCerbosServiceHandler) PlanPrincipals(context.Context, *connect.Request[v1.PlanPrincipalsRequest]) (*connect.Response[v11.PlanPrincipalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosService.PlanPrincipals is not implemented"))
+}
+
 // CerbosAdminServiceClient is a client for the cerbos.svc.v1.CerbosAdminService service.
 type CerbosAdminServiceClient interface {
 	AddOrUpdatePolicy(context.Context, *connect.Request[v1.AddOrUpdatePolicyRequest]) (*connect.Response[v11.AddOrUpdatePolicyResponse], error)
@@ -289,6 +350,7 @@ type CerbosAdminServiceClient interface {
 	GetPolicy(context.Context, *connect.Request[v1.GetPolicyRequest]) (*connect.Response[v11.GetPolicyResponse], error)
 	DisablePolicy(context.Context, *connect.Request[v1.DisablePolicyRequest]) (*connect.Response[v11.DisablePolicyResponse], error)
 	EnablePolicy(context.Context, *connect.Request[v1.EnablePolicyRequest]) (*connect.Response[v11.EnablePolicyResponse], error)
+	CheckWithPolicies(context.Context, *connect.Request[v1.CheckWithPoliciesRequest]) (*connect.Response[v11.CheckWithPoliciesResponse], error)
 	ListAuditLogEntries(context.Context, *connect.Request[v1.ListAuditLogEntriesRequest]) (*connect.ServerStreamForClient[v11.ListAuditLogEntriesResponse], error)
 	AddOrUpdateSchema(context.Context, *connect.Request[v1.AddOrUpdateSchemaRequest]) (*connect.Response[v11.AddOrUpdateSchemaResponse], error)
 	ListSchemas(context.Context, *connect.Request[v1.ListSchemasRequest]) (*connect.Response[v11.ListSchemasResponse], error)
@@ -344,6 +406,12 @@ func NewCerbosAdminServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(cerbosAdminServiceMethods.ByName("EnablePolicy")),
 			connect.WithClientOptions(opts...),
 		),
+		checkWithPolicies: connect.NewClient[v1.CheckWithPoliciesRequest, v11.CheckWithPoliciesResponse](
+			httpClient,
+			baseURL+CerbosAdminServiceCheckWithPoliciesProcedure,
+			connect.WithSchema(cerbosAdminServiceMethods.ByName("CheckWithPolicies")),
+			connect.WithClientOptions(opts...),
+		),
 		listAuditLogEntries: connect.NewClient[v1.ListAuditLogEntriesRequest, v11.ListAuditLogEntriesResponse](
 			httpClient,
 			baseURL+CerbosAdminServiceListAuditLogEntriesProcedure,
@@ -391,6 +459,7 @@ type cerbosAdminServiceClient struct {
 	getPolicy           *connect.Client[v1.GetPolicyRequest, v11.GetPolicyResponse]
 	disablePolicy       *connect.Client[v1.DisablePolicyRequest, v11.DisablePolicyResponse]
 	enablePolicy        *connect.Client[v1.EnablePolicyRequest, v11.EnablePolicyResponse]
+	checkWithPolicies   *connect.Client[v1.CheckWithPoliciesRequest, v11.CheckWithPoliciesResponse]
 	listAuditLogEntries *connect.Client[v1.ListAuditLogEntriesRequest, v11.ListAuditLogEntriesResponse]
 	addOrUpdateSchema   *connect.Client[v1.AddOrUpdateSchemaRequest, v11.AddOrUpdateSchemaResponse]
 	listSchemas         *connect.Client[v1.ListSchemasRequest, v11.ListSchemasResponse]
@@ -429,6 +498,11 @@ func (c *cerbosAdminServiceClient) EnablePolicy(ctx context.Context, req *connec
 	return c.enablePolicy.CallUnary(ctx, req)
 }
 
+// CheckWithPolicies calls cerbos.svc.v1.CerbosAdminService.CheckWithPolicies.
+func (c *cerbosAdminServiceClient) CheckWithPolicies(ctx context.Context, req *connect.Request[v1.CheckWithPoliciesRequest]) (*connect.Response[v11.CheckWithPoliciesResponse], error) {
+	return c.checkWithPolicies.CallUnary(ctx, req)
+}
+
 // ListAuditLogEntries calls cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries.
 func (c *cerbosAdminServiceClient) ListAuditLogEntries(ctx context.Context, req *connect.Request[v1.ListAuditLogEntriesRequest]) (*connect.ServerStreamForClient[v11.ListAuditLogEntriesResponse], error) {
 	return c.listAuditLogEntries.CallServerStream(ctx, req)
@@ -467,6 +541,7 @@ type CerbosAdminServiceHandler interface {
 	GetPolicy(context.Context, *connect.Request[v1.GetPolicyRequest]) (*connect.Response[v11.GetPolicyResponse], error)
 	DisablePolicy(context.Context, *connect.Request[v1.DisablePolicyRequest]) (*connect.Response[v11.DisablePolicyResponse], error)
 	EnablePolicy(context.Context, *connect.Request[v1.EnablePolicyRequest]) (*connect.Response[v11.EnablePolicyResponse], error)
+	CheckWithPolicies(context.Context, *connect.Request[v1.CheckWithPoliciesRequest]) (*connect.Response[v11.CheckWithPoliciesResponse], error)
 	ListAuditLogEntries(context.Context, *connect.Request[v1.ListAuditLogEntriesRequest], *connect.ServerStream[v11.ListAuditLogEntriesResponse]) error
 	AddOrUpdateSchema(context.Context, *connect.Request[v1.AddOrUpdateSchemaRequest]) (*connect.Response[v11.AddOrUpdateSchemaResponse], error)
 	ListSchemas(context.Context, *connect.Request[v1.ListSchemasRequest]) (*connect.Response[v11.ListSchemasResponse], error)
@@ -518,6 +593,12 @@ func NewCerbosAdminServiceHandler(svc CerbosAdminServiceHandler, opts ...connect
 		connect.WithSchema(cerbosAdminServiceMethods.ByName("EnablePolicy")),
 		connect.WithHandlerOptions(opts...),
 	)
+	cerbosAdminServiceCheckWithPoliciesHandler := connect.NewUnaryHandler(
+		CerbosAdminServiceCheckWithPoliciesProcedure,
+		svc.CheckWithPolicies,
+		connect.WithSchema(cerbosAdminServiceMethods.ByName("CheckWithPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
 	cerbosAdminServiceListAuditLogEntriesHandler := connect.NewServerStreamHandler(
 		CerbosAdminServiceListAuditLogEntriesProcedure,
 		svc.ListAuditLogEntries,
@@ -568,6 +649,8 @@ func NewCerbosAdminServiceHandler(svc CerbosAdminServiceHandler, opts ...connect
 			cerbosAdminServiceDisablePolicyHandler.ServeHTTP(w, r)
 		case CerbosAdminServiceEnablePolicyProcedure:
 			cerbosAdminServiceEnablePolicyHandler.ServeHTTP(w, r)
+		case CerbosAdminServiceCheckWithPoliciesProcedure:
+			cerbosAdminServiceCheckWithPoliciesHandler.ServeHTTP(w, r)
 		case CerbosAdminServiceListAuditLogEntriesProcedure:
 			cerbosAdminServiceListAuditLogEntriesHandler.ServeHTTP(w, r)
 		case CerbosAdminServiceAddOrUpdateSchemaProcedure:
@@ -613,6 +696,10 @@ func (UnimplementedCerbosAdminServiceHandler) EnablePolicy(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosAdminService.EnablePolicy is not implemented"))
 }
 
+func (UnimplementedCerbosAdminServiceHandler) CheckWithPolicies(context.Context, *connect.Request[v1.CheckWithPoliciesRequest]) (*connect.Response[v11.CheckWithPoliciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosAdminService.CheckWithPolicies is not implemented"))
+}
+
 func (UnimplementedCerbosAdminServiceHandler) ListAuditLogEntries(context.Context, *connect.Request[v1.ListAuditLogEntriesRequest], *connect.ServerStream[v11.ListAuditLogEntriesResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries is not implemented"))
 }
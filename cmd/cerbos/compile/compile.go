@@ -4,12 +4,17 @@
 package compile
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io/fs"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"github.com/fatih/color"
@@ -18,22 +23,34 @@ import (
 
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	compileerrors "github.com/cerbos/cerbos/cmd/cerbos/compile/errors"
+	internalanalysis "github.com/cerbos/cerbos/cmd/cerbos/compile/internal/analysis"
 	internalcompile "github.com/cerbos/cerbos/cmd/cerbos/compile/internal/compilation"
+	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/cost"
+	internalcoverage "github.com/cerbos/cerbos/cmd/cerbos/compile/internal/coverage"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/flagset"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/lint"
+	internalmutation "github.com/cerbos/cerbos/cmd/cerbos/compile/internal/mutation"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/verification"
 	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/functions"
+	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/outputcolor"
+	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/printer"
 	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/ruletable/analysis"
 	internalschema "github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage/disk"
 	"github.com/cerbos/cerbos/internal/storage/index"
 	"github.com/cerbos/cerbos/internal/util"
 	"github.com/cerbos/cerbos/internal/verify"
+	"github.com/cerbos/cerbos/internal/verify/coverage"
+	"github.com/cerbos/cerbos/internal/verify/mutation"
 )
 
+var errCoverageWithoutTests = errors.New("--coverage, --coverage-report and --coverage-min can't be used with --skip-tests")
+
 const (
 	help = `
 Examples:
@@ -49,23 +66,63 @@ cerbos compile --run=Delete /path/to/policy/repo
 # Compile but skip tests
 
 cerbos compile --skip-tests /path/to/policy/repo
+
+# Compile and report the worst-case cost of evaluating the conditions of each rule, assuming that lists have at most 100 elements
+
+cerbos compile --cost-estimates --cost-size-hint=100 /path/to/policy/repo
+
+# Compile and fail if there are lint warnings or rules that can never take effect
+
+cerbos compile --fail-on-warnings /path/to/policy/repo
+
+# Compile and write lint findings in SARIF format for code review tools
+
+cerbos compile --sarif=cerbos.sarif /path/to/policy/repo
+
+# Compile, run tests and fail if less than 80% of the rules, derived roles and condition branches are covered
+
+cerbos compile --coverage --coverage-min=80 /path/to/policy/repo
+
+# Compile, run tests and write a coverage report in LCOV format
+
+cerbos compile --coverage-report=lcov.info --coverage-format=lcov /path/to/policy/repo
+
+# Compile, run tests and report the policy mutants that the tests don't detect
+
+cerbos compile --mutate /path/to/policy/repo
+
+# Compile and run tests using the custom functions defined in a Cerbos config file
+
+cerbos compile --config=/path/to/.cerbos.yaml /path/to/policy/repo
 `
 )
 
 type Cmd struct { //nolint:govet // Kong prints fields in order, so we don't want to reorder fields to save bytes.
-	Dir           string                            `help:"Policy directory" arg:"" required:"" type:"path"`
-	IgnoreSchemas bool                              `help:"Ignore schemas during compilation"`
-	Tests         string                            `help:"[Deprecated] Path to the directory containing tests. Defaults to policy directory." type:"path"`
-	RunRegexp     string                            `help:"Run only tests that match this regex" name:"run"`
-	SkipTests     bool                              `help:"Skip tests"`
-	Output        flagset.OutputFormat              `help:"Output format (${enum})" default:"tree" enum:"tree,list,json" short:"o"`
-	TestOutput    *flagset.VerificationOutputFormat `help:"Test output format. If unspecified matches the value of the output flag. (tree,list,json,junit)"`
-	Color         *outputcolor.Level                `help:"Output color level (auto,never,always,256,16m). Defaults to auto." xor:"color"`
-	NoColor       bool                              `help:"Disable colored output" xor:"color"`
-	Verbose       bool                              `help:"Verbose output on test failure"`
+	Dir            string                            `help:"Policy directory" arg:"" required:"" type:"path"`
+	IgnoreSchemas  bool                              `help:"Ignore schemas during compilation"`
+	Tests          string                            `help:"[Deprecated] Path to the directory containing tests. Defaults to policy directory." type:"path"`
+	RunRegexp      string                            `help:"Run only tests that match this regex" name:"run"`
+	SkipTests      bool                              `help:"Skip tests"`
+	Output         flagset.OutputFormat              `help:"Output format (${enum})" default:"tree" enum:"tree,list,json" short:"o"`
+	TestOutput     *flagset.VerificationOutputFormat `help:"Test output format. If unspecified matches the value of the output flag. (tree,list,json,junit)"`
+	Color          *outputcolor.Level                `help:"Output color level (auto,never,always,256,16m). Defaults to auto." xor:"color"`
+	NoColor        bool                              `help:"Disable colored output" xor:"color"`
+	Verbose        bool                              `help:"Verbose output on test failure"`
+	FailOnWarnings bool                              `help:"Fail if linting or static analysis reports any warnings"`
+	LintConfig     string                            `help:"Path to the lint configuration file. Defaults to .cerbos-lint.yaml in the policy directory." type:"existingfile"`
+	SARIF          string                            `help:"Write lint findings and static analysis warnings to this file in SARIF format" name:"sarif" type:"path" placeholder:"FILE"`
+	Coverage       bool                              `help:"Report the rules, derived roles and condition branches exercised by the tests"`
+	CoverageReport string                            `help:"Write the coverage report to this file" type:"path" placeholder:"FILE"`
+	CoverageFormat string                            `help:"Format of the coverage report file (${enum})" default:"cobertura" enum:"cobertura,lcov"`
+	CoverageMin    float64                           `help:"Fail if the percentage of covered rules, derived roles and condition branches is lower than this value" placeholder:"PERCENT"`
+	Mutate         bool                              `help:"Run the tests against mutants of the policies and report the mutants that no test detects"`
+	MutateWorkers  int                               `help:"Number of mutants to test in parallel. Defaults to the number of CPUs."`
+	CostEstimates  bool                              `help:"Report static worst-case cost estimates of rule conditions"`
+	CostSizeHint   uint64                            `help:"Assumed maximum size of lists, maps and strings when estimating costs. Sizes are unbounded if zero."`
+	Config         string                            `help:"Path to a Cerbos config file that defines custom functions" type:"existingfile" placeholder:".cerbos.yaml"`
 }
 
-func (c *Cmd) Run(k *kong.Kong) error {
+func (c *Cmd) Run(k *kong.Kong) (err error) {
 	ctx, stopFunc := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer stopFunc()
 
@@ -81,6 +138,34 @@ func (c *Cmd) Run(k *kong.Kong) error {
 
 	p := printer.New(k.Stdout, k.Stderr)
 
+	if c.TestOutput == nil {
+		var value flagset.VerificationOutputFormat
+		switch c.Output {
+		case flagset.OutputFormatTree:
+			value = flagset.VerificationOutputFormatTree
+		case flagset.OutputFormatList:
+			value = flagset.VerificationOutputFormatList
+		case flagset.OutputFormatJSON:
+			value = flagset.VerificationOutputFormatJSON
+		}
+		c.TestOutput = &value
+	}
+
+	// Reports are written to stderr if the test results are written to stdout in a different format,
+	// so that tools reading the test results (for example, JUnit reports in CI) are not given anything else.
+	reports := p
+	if !c.SkipTests && string(*c.TestOutput) != string(c.Output) {
+		reports = printer.New(k.Stderr, k.Stderr)
+	}
+
+	if c.Config != "" {
+		customFunctions, err := functions.NewFromFile(ctx, c.Config)
+		if err != nil {
+			return fmt.Errorf("failed to load custom functions: %w", err)
+		}
+		defer customFunctions.Close()
+	}
+
 	fsys, err := util.OpenDirectoryFS(c.Dir)
 	if err != nil {
 		return fmt.Errorf("failed to open policy repository at %q: %w", c.Dir, err)
@@ -114,17 +199,94 @@ func (c *Cmd) Run(k *kong.Kong) error {
 		return fmt.Errorf("failed to compile policies: %w", err)
 	}
 
-	if c.TestOutput == nil {
-		var value flagset.VerificationOutputFormat
-		switch c.Output {
-		case flagset.OutputFormatTree:
-			value = flagset.VerificationOutputFormatTree
-		case flagset.OutputFormatList:
-			value = flagset.VerificationOutputFormatList
-		case flagset.OutputFormatJSON:
-			value = flagset.VerificationOutputFormatJSON
+	var out jsonOutput
+	if c.Output == flagset.OutputFormatJSON {
+		defer func() {
+			if printErr := out.print(reports, colorLevel); printErr != nil && err == nil {
+				err = fmt.Errorf("failed to display results: %w", printErr)
+			}
+		}()
+	}
+
+	if c.CostEstimates {
+		costs, err := estimateCosts(idx.GetAllCompilationUnits(ctx), schemaMgr, c.CostSizeHint)
+		if err != nil {
+			return fmt.Errorf("failed to estimate costs: %w", err)
 		}
-		c.TestOutput = &value
+
+		if c.Output == flagset.OutputFormatJSON {
+			out.add("costEstimates", costs)
+		} else {
+			cost.Display(reports, costs)
+		}
+	}
+
+	linter, err := c.linter(fsys)
+	if err != nil {
+		return err
+	}
+
+	files := idx.GetFiles()
+	slices.Sort(files)
+	findings := linter.Lint(lint.LoadSources(fsys, files))
+	if len(findings) > 0 {
+		if c.Output == flagset.OutputFormatJSON {
+			out.add("lintFindings", findings)
+		} else {
+			lint.DisplayFindings(reports, findings)
+		}
+	}
+
+	rt := ruletable.NewProtoRuletable()
+
+	compileMgr, err := compile.NewManager(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	if err := ruletable.LoadPolicies(ctx, rt, compileMgr); err != nil {
+		return err
+	}
+
+	testFsys, testDir, err := c.testsDir()
+	if err != nil {
+		return err
+	}
+
+	// Roles are only checked against the test fixtures when the tests are run
+	var fixtureRoles map[string]struct{}
+	if !c.SkipTests {
+		if fixtureRoles, err = verify.PrincipalRoles(ctx, testFsys); err != nil {
+			return fmt.Errorf("failed to read test fixtures from %q: %w", testDir, err)
+		}
+	}
+
+	var units []*policy.CompilationUnit
+	for unit := range idx.GetAllCompilationUnits(ctx) {
+		units = append(units, unit)
+	}
+
+	warnings := analysis.Analyse(rt, units, fixtureRoles)
+	if len(warnings) > 0 {
+		if c.Output == flagset.OutputFormatJSON {
+			out.add("warnings", warnings)
+		} else {
+			internalanalysis.Display(reports, warnings)
+		}
+	}
+
+	if c.SARIF != "" {
+		if err := c.writeSARIF(findings, warnings); err != nil {
+			return fmt.Errorf("failed to write SARIF report to %q: %w", c.SARIF, err)
+		}
+	}
+
+	if lint.Count(findings, lint.SeverityError) > 0 {
+		return compileerrors.ErrLintFailed
+	}
+
+	if c.FailOnWarnings && (len(warnings) > 0 || lint.Count(findings, lint.SeverityWarning) > 0) {
+		return compileerrors.ErrWarnings
 	}
 
 	if !c.SkipTests { //nolint:nestif
@@ -133,15 +295,8 @@ func (c *Cmd) Run(k *kong.Kong) error {
 			Trace:                   c.Verbose,
 		}
 
-		rt := ruletable.NewProtoRuletable()
-
-		compileMgr, err := compile.NewManager(ctx, store)
-		if err != nil {
-			return err
-		}
-
-		if err := ruletable.LoadPolicies(ctx, rt, compileMgr); err != nil {
-			return err
+		if c.coverageEnabled() {
+			verifyConf.Coverage = coverage.NewRecorder()
 		}
 
 		rtMgr, err := ruletable.NewRuleTableManager(rt, compileMgr, store, schemaMgr)
@@ -151,30 +306,197 @@ func (c *Cmd) Run(k *kong.Kong) error {
 
 		eng := engine.NewEphemeral(nil, rtMgr, schemaMgr)
 
-		testFsys, testDir, err := c.testsDir()
-		if err != nil {
-			return err
-		}
-
 		results, err := verify.Verify(ctx, testFsys, eng, verifyConf)
 		if err != nil {
 			return fmt.Errorf("failed to run tests from %q: %w", testDir, err)
 		}
 
-		if err = verification.Display(p, results, *c.TestOutput, c.Verbose, colorLevel); err != nil {
+		if c.Output == flagset.OutputFormatJSON && *c.TestOutput == flagset.VerificationOutputFormatJSON {
+			out.testResults = results
+		} else if err = verification.Display(p, results, *c.TestOutput, c.Verbose, colorLevel); err != nil {
 			return fmt.Errorf("failed to display test results: %w", err)
 		}
 
+		var testsErr error
 		switch results.Summary.OverallResult {
 		case policyv1.TestResults_RESULT_FAILED, policyv1.TestResults_RESULT_ERRORED:
-			return compileerrors.ErrTestsFailed
+			testsErr = compileerrors.ErrTestsFailed
 		default:
 		}
+
+		if c.Mutate && testsErr == nil {
+			runner, err := mutation.NewRunner(units, schemaMgr, testFsys, verifyConf, c.MutateWorkers)
+			if err != nil {
+				return fmt.Errorf("failed to create mutation test runner: %w", err)
+			}
+
+			report, err := runner.Run(ctx, mutation.Generate(units))
+			if err != nil {
+				return fmt.Errorf("failed to run mutation tests: %w", err)
+			}
+
+			if c.Output == flagset.OutputFormatJSON {
+				out.add("mutation", map[string]any{
+					"mutants": report.Results,
+					"summary": report.Summary,
+					"score":   report.Summary.Score(),
+				})
+			} else {
+				internalmutation.Display(reports, report)
+			}
+		}
+
+		// Coverage is reported even if the tests fail, because it helps to find out which rules the failing tests exercise
+		if verifyConf.Coverage != nil {
+			if err := c.reportCoverage(reports, &out, verifyConf.Coverage.NewReport(units)); err != nil && testsErr == nil {
+				return err
+			}
+		}
+
+		return testsErr
+	}
+
+	return nil
+}
+
+func (c *Cmd) Validate() error {
+	if c.SkipTests && c.coverageEnabled() {
+		return errCoverageWithoutTests
+	}
+
+	return nil
+}
+
+func (c *Cmd) coverageEnabled() bool {
+	return c.Coverage || c.CoverageReport != "" || c.CoverageMin > 0
+}
+
+func estimateCosts(units <-chan *policy.CompilationUnit, schemaMgr internalschema.Manager, sizeHint uint64) ([]compile.RuleCost, error) {
+	var costs []compile.RuleCost
+	for unit := range units {
+		rps, err := compile.Compile(unit, schemaMgr)
+		if err != nil {
+			return nil, err
+		}
+
+		if rps == nil {
+			continue
+		}
+
+		rpsCosts, err := compile.EstimateCosts(rps, sizeHint)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", namer.PolicyKeyFromFQN(rps.Fqn), err)
+		}
+		costs = append(costs, rpsCosts...)
+	}
+
+	slices.SortStableFunc(costs, func(a, b compile.RuleCost) int { return cmp.Compare(a.Policy, b.Policy) })
+	return costs, nil
+}
+
+func (c *Cmd) linter(fsys fs.FS) (*lint.Linter, error) {
+	var conf *lint.Conf
+	if c.LintConfig != "" {
+		f, err := os.Open(c.LintConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open lint configuration: %w", err)
+		}
+		defer f.Close()
+
+		if conf, err = lint.ReadConf(f); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if conf, err = lint.LoadConf(fsys, lint.ConfFileName); err != nil {
+			return nil, err
+		}
+	}
+
+	return lint.New(conf)
+}
+
+// writeSARIF writes the lint findings and the static analysis warnings to the SARIF file.
+// Source file paths are made relative to the working directory where possible, so that code review tools can locate them.
+func (c *Cmd) writeSARIF(findings []lint.Finding, warnings []analysis.Warning) error {
+	descriptions := lint.RuleDescriptions()
+	all := slices.Clone(findings)
+	for _, w := range warnings {
+		descriptions[string(w.Kind)] = analysis.Descriptions[w.Kind]
+		all = append(all, lint.Finding{
+			Position: w.Position,
+			File:     w.File,
+			Policy:   w.Policy,
+			Rule:     string(w.Kind),
+			Severity: lint.SeverityWarning,
+			Message:  w.Message,
+		})
+	}
+
+	srcRoot := c.Dir
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, c.Dir); err == nil && !strings.HasPrefix(rel, "..") {
+			srcRoot = rel
+		}
+	}
+
+	f, err := os.Create(c.SARIF)
+	if err != nil {
+		return err
+	}
+
+	if err := lint.WriteSARIF(f, all, descriptions, filepath.ToSlash(srcRoot)); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// reportCoverage displays and writes the coverage report, and checks it against the minimum coverage.
+func (c *Cmd) reportCoverage(p *printer.Printer, out *jsonOutput, report *coverage.Report) error {
+	if c.Coverage {
+		if c.Output == flagset.OutputFormatJSON {
+			out.add("coverage", report)
+		} else {
+			internalcoverage.Display(p, report)
+		}
+	}
+
+	if c.CoverageReport != "" {
+		if err := c.writeCoverageReport(report); err != nil {
+			return fmt.Errorf("failed to write coverage report to %q: %w", c.CoverageReport, err)
+		}
+	}
+
+	if overall := report.Summary.Overall().Percent(); overall < c.CoverageMin {
+		return fmt.Errorf("%w: %.1f%% is lower than %.1f%%", compileerrors.ErrCoverageBelowThreshold, overall, c.CoverageMin)
 	}
 
 	return nil
 }
 
+func (c *Cmd) writeCoverageReport(report *coverage.Report) error {
+	f, err := os.Create(c.CoverageReport)
+	if err != nil {
+		return err
+	}
+
+	switch c.CoverageFormat {
+	case "lcov":
+		err = coverage.WriteLCOV(f, report)
+	default:
+		err = coverage.WriteCobertura(f, report, c.Dir, time.Now())
+	}
+
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
 func (c *Cmd) testsDir() (fs.FS, string, error) {
 	dir := c.Dir
 	if c.Tests != "" {
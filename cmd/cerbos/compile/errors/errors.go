@@ -10,4 +10,10 @@ var (
 	ErrFailed = errors.New("failed to compile")
 	// ErrTestsFailed is the error returned when tests fail.
 	ErrTestsFailed = errors.New("tests failed")
+	// ErrLintFailed is the error returned when linting reports errors.
+	ErrLintFailed = errors.New("lint errors found")
+	// ErrWarnings is the error returned when static analysis reports warnings and the command is configured to fail on them.
+	ErrWarnings = errors.New("static analysis reported warnings")
+	// ErrCoverageBelowThreshold is the error returned when the test coverage is lower than the configured minimum.
+	ErrCoverageBelowThreshold = errors.New("test coverage is below the minimum")
 )
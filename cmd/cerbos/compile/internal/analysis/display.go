@@ -0,0 +1,18 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package analysis
+
+import (
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/printer/colored"
+	"github.com/cerbos/cerbos/internal/ruletable/analysis"
+)
+
+func Display(p *printer.Printer, warnings []analysis.Warning) {
+	p.Println(colored.Header("Warnings"))
+	for _, w := range warnings {
+		p.Printf("%s %s <%s>\n", colored.Position(w.File, w.Position), colored.WarningMsg(w.Message), w.Kind)
+	}
+	p.Println()
+}
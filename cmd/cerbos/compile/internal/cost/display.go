@@ -0,0 +1,27 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"strconv"
+
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/printer/colored"
+)
+
+func Display(p *printer.Printer, costs []compile.RuleCost) {
+	p.Println(colored.Header("Condition cost estimates"))
+	for _, c := range costs {
+		maxCost := strconv.FormatUint(c.Max, 10)
+		if c.Unbounded {
+			maxCost = colored.ErrorMsg("unbounded")
+		} else if c.Limit > 0 && c.Max > uint64(c.Limit) {
+			maxCost = colored.ErrorMsg(maxCost + " (exceeds policy limit of " + strconv.FormatUint(uint64(c.Limit), 10) + ")")
+		}
+
+		p.Printf("%s#%s min=%d max=%s\n", colored.PolicyKey(c.Policy), c.Rule, c.Min, maxCost)
+	}
+	p.Println()
+}
@@ -0,0 +1,59 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package coverage
+
+import (
+	"fmt"
+
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/printer/colored"
+	"github.com/cerbos/cerbos/internal/verify/coverage"
+)
+
+func Display(p *printer.Printer, report *coverage.Report) {
+	p.Println(colored.Header("Coverage"))
+	for _, pr := range report.Policies {
+		p.Printf("%s %s\n", colored.PolicyKey(pr.Policy), summary(pr.Summary))
+
+		for _, r := range pr.Rules {
+			if r.Hits == 0 {
+				p.Printf("  %s %s\n", colored.Position(pr.File, r.Position), colored.WarningMsg(fmt.Sprintf("Rule %q was never activated", r.Name)))
+			}
+		}
+
+		for _, d := range pr.DerivedRoles {
+			if d.Hits == 0 {
+				p.Printf("  %s %s\n", colored.Position(pr.File, d.Position), colored.WarningMsg(fmt.Sprintf("Derived role %q was never activated", d.Name)))
+			}
+		}
+
+		for _, b := range pr.Branches {
+			var missing string
+			switch {
+			case b.True == 0 && b.False == 0:
+				missing = "was never evaluated"
+			case b.True == 0:
+				missing = "never evaluated to true"
+			case b.False == 0:
+				missing = "never evaluated to false"
+			default:
+				continue
+			}
+
+			p.Printf("  %s %s\n", colored.Position(pr.File, b.Position), colored.WarningMsg(fmt.Sprintf("Condition %s of %q %s", b.Path, b.Owner, missing)))
+		}
+	}
+
+	p.Printf("%s %s\n", colored.Header("Total"), summary(report.Summary))
+	p.Println()
+}
+
+func summary(s coverage.Summary) string {
+	return fmt.Sprintf("%.1f%% (rules %s, derived roles %s, branches %s)",
+		s.Overall().Percent(), counts(s.Rules), counts(s.DerivedRoles), counts(s.Branches))
+}
+
+func counts(c coverage.Counts) string {
+	return fmt.Sprintf("%d/%d", c.Covered, c.Total)
+}
@@ -0,0 +1,91 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfFileName is the name of the lint configuration file that is read from the root of the policy directory.
+const ConfFileName = ".cerbos-lint.yaml"
+
+type Severity string
+
+const (
+	SeverityOff     Severity = "off"
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+var severities = []Severity{SeverityOff, SeverityInfo, SeverityWarning, SeverityError}
+
+func (s *Severity) UnmarshalYAML(node *yaml.Node) error {
+	var str string
+	if err := node.Decode(&str); err != nil {
+		return err
+	}
+
+	sev := Severity(strings.ToLower(str))
+	if !slices.Contains(severities, sev) {
+		return fmt.Errorf("invalid severity %q: must be one of %v", str, severities)
+	}
+
+	*s = sev
+	return nil
+}
+
+// Conf is the lint configuration.
+type Conf struct {
+	// Rules holds the configuration of each rule, keyed by rule ID. Rules that are not listed use their default configuration.
+	Rules map[string]RuleConf `yaml:"rules"`
+}
+
+// RuleConf is the configuration of a single rule. Only the options that are relevant to the rule are used.
+type RuleConf struct {
+	// Severity of the rule. Set to "off" to disable the rule.
+	Severity Severity `yaml:"severity"`
+	// Pattern is the regular expression that names must match (actionNaming and roleNaming).
+	Pattern string `yaml:"pattern"`
+	// Annotations is the list of annotations that every policy must have (requiredAnnotations).
+	Annotations []string `yaml:"annotations"`
+	// AllowedScopes is the list of scopes where wildcard actions are allowed (wildcardActions).
+	AllowedScopes []string `yaml:"allowedScopes"`
+	// MaxNodes is the maximum number of nodes in a condition expression (conditionComplexity).
+	MaxNodes int `yaml:"maxNodes"`
+}
+
+// ReadConf reads the lint configuration from YAML.
+func ReadConf(r io.Reader) (*Conf, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	conf := &Conf{}
+	if err := dec.Decode(conf); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read lint configuration: %w", err)
+	}
+
+	return conf, nil
+}
+
+// LoadConf reads the lint configuration from the given file. It returns the default configuration if the file does not exist.
+func LoadConf(fsys fs.FS, path string) (*Conf, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &Conf{}, nil
+		}
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	return ReadConf(f)
+}
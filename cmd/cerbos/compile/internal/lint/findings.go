@@ -0,0 +1,26 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/printer/colored"
+)
+
+func DisplayFindings(p *printer.Printer, findings []Finding) {
+	p.Println(colored.Header("Lint findings"))
+	for _, f := range findings {
+		msg := f.Message
+		switch f.Severity {
+		case SeverityError:
+			msg = colored.ErrorMsg(msg)
+		case SeverityWarning:
+			msg = colored.WarningMsg(msg)
+		default:
+		}
+
+		p.Printf("%s %s <%s>\n", colored.Position(f.File, f.Position), msg, f.Rule)
+	}
+	p.Println()
+}
@@ -0,0 +1,174 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lint implements configurable checks of policy style and hygiene, and displays their findings along with policy repository errors.
+package lint
+
+import (
+	"cmp"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	sourcev1 "github.com/cerbos/cerbos/api/genpb/cerbos/source/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/parser"
+	"github.com/cerbos/cerbos/internal/policy"
+)
+
+// DisableAnnotation is the policy annotation that lists the IDs of the rules that should not be applied to the policy.
+// The value is a comma-separated list of rule IDs, or "*" to disable all rules.
+const DisableAnnotation = "lint.cerbos.dev/disable"
+
+// Finding is a lint rule violation.
+type Finding struct {
+	Position *sourcev1.Position `json:"position,omitempty"`
+	File     string             `json:"file"`
+	Policy   string             `json:"policy"`
+	Rule     string             `json:"rule"`
+	Severity Severity           `json:"severity"`
+	Message  string             `json:"message"`
+}
+
+// Source is a policy to lint.
+type Source struct {
+	Policy *policyv1.Policy
+	SrcCtx parser.SourceCtx
+}
+
+// LoadSources reads the policies from the given files. Files that can't be read are skipped because they are reported
+// as errors when the policy index is built.
+func LoadSources(fsys fs.FS, files []string) []Source {
+	sources := make([]Source, 0, len(files))
+	for _, file := range files {
+		p, srcCtx, err := policy.ReadPolicyWithSourceContext(fsys, file)
+		if err != nil || p == nil {
+			continue
+		}
+
+		sources = append(sources, Source{Policy: policy.WithMetadata(p, file, nil, file), SrcCtx: srcCtx})
+	}
+
+	return sources
+}
+
+// Linter applies the configured rules to policies.
+type Linter struct {
+	rules []*configuredRule
+}
+
+type configuredRule struct {
+	*Rule
+	check    checkFunc
+	severity Severity
+}
+
+// New creates a linter from the configuration.
+func New(conf *Conf) (*Linter, error) {
+	known := make(map[string]*Rule, len(Rules))
+	for _, r := range Rules {
+		known[r.ID] = r
+	}
+
+	for id := range conf.Rules {
+		if _, ok := known[id]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %q", id)
+		}
+	}
+
+	l := &Linter{}
+	for _, r := range Rules {
+		rc, ok := conf.Rules[r.ID]
+		if !ok {
+			rc = RuleConf{}
+		}
+
+		if rc.Severity == "" {
+			rc.Severity = r.DefaultSeverity
+		}
+
+		if rc.Severity == SeverityOff {
+			continue
+		}
+
+		check, err := r.configure(rc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration for lint rule %q: %w", r.ID, err)
+		}
+
+		l.rules = append(l.rules, &configuredRule{Rule: r, check: check, severity: rc.Severity})
+	}
+
+	return l, nil
+}
+
+// Lint applies the rules to the policies and returns the findings ordered by file and position.
+func (l *Linter) Lint(sources []Source) []Finding {
+	var findings []Finding
+	for _, src := range sources {
+		disabled := disabledRules(src.Policy)
+		if _, ok := disabled["*"]; ok {
+			continue
+		}
+
+		file := policy.GetSourceFile(src.Policy)
+		policyKey := namer.PolicyKey(src.Policy)
+		for _, r := range l.rules {
+			if _, ok := disabled[r.ID]; ok {
+				continue
+			}
+
+			r.check(src.Policy, func(path, format string, args ...any) {
+				findings = append(findings, Finding{
+					Position: src.SrcCtx.PositionForProtoPath(path),
+					File:     file,
+					Policy:   policyKey,
+					Rule:     r.ID,
+					Severity: r.severity,
+					Message:  fmt.Sprintf(format, args...),
+				})
+			})
+		}
+	}
+
+	slices.SortStableFunc(findings, func(x, y Finding) int {
+		return cmp.Or(
+			cmp.Compare(x.File, y.File),
+			cmp.Compare(x.Position.GetLine(), y.Position.GetLine()),
+			cmp.Compare(x.Position.GetColumn(), y.Position.GetColumn()),
+			cmp.Compare(x.Rule, y.Rule),
+		)
+	})
+
+	return findings
+}
+
+func disabledRules(p *policyv1.Policy) map[string]struct{} {
+	value, ok := p.GetMetadata().GetAnnotations()[DisableAnnotation]
+	if !ok {
+		return nil
+	}
+
+	disabled := make(map[string]struct{})
+	for id := range strings.SplitSeq(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			disabled[id] = struct{}{}
+		}
+	}
+
+	return disabled
+}
+
+// Count returns the number of findings with the given severity.
+func Count(findings []Finding, severity Severity) int {
+	n := 0
+	for _, f := range findings {
+		if f.Severity == severity {
+			n++
+		}
+	}
+
+	return n
+}
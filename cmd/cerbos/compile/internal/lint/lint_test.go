@@ -0,0 +1,214 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/lint"
+)
+
+const lintConf = `
+rules:
+  actionNaming:
+    severity: warning
+    pattern: "^[a-z:]+$"
+  roleNaming:
+    severity: info
+    pattern: "^[a-z_]+$"
+  requiredAnnotations:
+    severity: error
+    annotations: ["owner"]
+  conditionComplexity:
+    maxNodes: 10
+  resourceSchemas:
+    severity: warning
+  wildcardActions:
+    severity: error
+    allowedScopes: ["admin"]
+`
+
+var policies = fstest.MapFS{
+	"document.yaml": {Data: []byte(`apiVersion: api.cerbos.dev/v1
+metadata:
+  annotations:
+    owner: docs-team
+resourcePolicy:
+  resource: document
+  version: default
+  rules:
+    - actions: ["view", "Edit"]
+      effect: EFFECT_ALLOW
+      roles: ["user", "Admin"]
+      condition:
+        match:
+          all:
+            of:
+              - expr: has_intersection(request.resource.attr.tags, ["public"])
+              - expr: request.resource.attr.a + request.resource.attr.b + request.resource.attr.c > 1
+    - actions: ["*"]
+      effect: EFFECT_DENY
+      roles: ["user"]
+`)},
+	"admin_document.yaml": {Data: []byte(`apiVersion: api.cerbos.dev/v1
+metadata:
+  annotations:
+    owner: docs-team
+resourcePolicy:
+  resource: document
+  version: default
+  scope: admin
+  schemas:
+    resourceSchema:
+      ref: cerbos:///document.json
+  rules:
+    - actions: ["*"]
+      effect: EFFECT_ALLOW
+      roles: ["admin"]
+`)},
+	"suppressed.yaml": {Data: []byte(`apiVersion: api.cerbos.dev/v1
+metadata:
+  annotations:
+    lint.cerbos.dev/disable: "requiredAnnotations, roleNaming"
+derivedRoles:
+  name: common
+  definitions:
+    - name: Owner
+      parentRoles: ["user"]
+`)},
+}
+
+func TestLint(t *testing.T) {
+	conf, err := lint.ReadConf(strings.NewReader(lintConf))
+	require.NoError(t, err)
+
+	linter, err := lint.New(conf)
+	require.NoError(t, err)
+
+	findings := linter.Lint(lint.LoadSources(policies, []string{"admin_document.yaml", "document.yaml", "suppressed.yaml"}))
+
+	type finding struct {
+		file     string
+		rule     string
+		severity lint.Severity
+		line     uint32
+	}
+
+	have := make([]finding, len(findings))
+	for i, f := range findings {
+		have[i] = finding{file: f.File, rule: f.Rule, severity: f.Severity, line: f.Position.GetLine()}
+	}
+
+	require.Equal(t, []finding{
+		{file: "document.yaml", rule: "resourceSchemas", severity: lint.SeverityWarning, line: 5},
+		{file: "document.yaml", rule: "actionNaming", severity: lint.SeverityWarning, line: 9},
+		{file: "document.yaml", rule: "roleNaming", severity: lint.SeverityInfo, line: 11},
+		{file: "document.yaml", rule: "deprecatedFunctions", severity: lint.SeverityWarning, line: 16},
+		{file: "document.yaml", rule: "conditionComplexity", severity: lint.SeverityWarning, line: 17},
+		{file: "document.yaml", rule: "wildcardActions", severity: lint.SeverityError, line: 18},
+	}, have)
+
+	require.Equal(t, 1, lint.Count(findings, lint.SeverityError))
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name    string
+		conf    string
+		wantErr string
+	}{
+		{
+			name:    "unknown_rule",
+			conf:    "rules:\n  noSuchRule:\n    severity: error\n",
+			wantErr: `unknown lint rule "noSuchRule"`,
+		},
+		{
+			name:    "missing_pattern",
+			conf:    "rules:\n  actionNaming:\n    severity: error\n",
+			wantErr: "pattern is required",
+		},
+		{
+			name:    "invalid_severity",
+			conf:    "rules:\n  actionNaming:\n    severity: fatal\n",
+			wantErr: `invalid severity "fatal"`,
+		},
+		{
+			name: "defaults",
+			conf: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf, err := lint.ReadConf(strings.NewReader(tc.conf))
+			if err == nil {
+				_, err = lint.New(conf)
+			}
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	linter, err := lint.New(&lint.Conf{})
+	require.NoError(t, err)
+
+	findings := linter.Lint(lint.LoadSources(policies, []string{"document.yaml"}))
+	require.Len(t, findings, 1)
+
+	var buf bytes.Buffer
+	require.NoError(t, lint.WriteSARIF(&buf, findings, lint.RuleDescriptions(), "policies"))
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine uint32 `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, 1)
+	require.Equal(t, "deprecatedFunctions", log.Runs[0].Tool.Driver.Rules[0].ID)
+
+	require.Len(t, log.Runs[0].Results, 1)
+	result := log.Runs[0].Results[0]
+	require.Equal(t, "deprecatedFunctions", result.RuleID)
+	require.Equal(t, "warning", result.Level)
+	require.Equal(t, "policies/document.yaml", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.Equal(t, uint32(16), result.Locations[0].PhysicalLocation.Region.StartLine)
+}
@@ -0,0 +1,323 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	celast "github.com/google/cel-go/common/ast"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/policy"
+)
+
+const defaultMaxNodes = 100
+
+type (
+	reportFunc func(path, format string, args ...any)
+	checkFunc  func(p *policyv1.Policy, report reportFunc)
+)
+
+// Rule is a built-in lint rule.
+type Rule struct {
+	configure       func(RuleConf) (checkFunc, error)
+	ID              string
+	Description     string
+	DefaultSeverity Severity
+}
+
+// Rules is the list of built-in rules.
+var Rules = []*Rule{
+	{
+		ID:              "actionNaming",
+		Description:     "Actions must match the configured pattern",
+		DefaultSeverity: SeverityOff,
+		configure:       configureNaming(actionNames, "Action"),
+	},
+	{
+		ID:              "roleNaming",
+		Description:     "Roles and derived roles must match the configured pattern",
+		DefaultSeverity: SeverityOff,
+		configure:       configureNaming(roleNames, "Role"),
+	},
+	{
+		ID:              "requiredAnnotations",
+		Description:     "Policies must have the configured metadata annotations",
+		DefaultSeverity: SeverityOff,
+		configure:       configureRequiredAnnotations,
+	},
+	{
+		ID:              "deprecatedFunctions",
+		Description:     "Conditions must not use deprecated functions",
+		DefaultSeverity: SeverityWarning,
+		configure:       configureDeprecatedFunctions,
+	},
+	{
+		ID:              "conditionComplexity",
+		Description:     "Condition expressions must not exceed the configured number of nodes",
+		DefaultSeverity: SeverityWarning,
+		configure:       configureConditionComplexity,
+	},
+	{
+		ID:              "resourceSchemas",
+		Description:     "Resource policies must declare a resource schema",
+		DefaultSeverity: SeverityOff,
+		configure:       configureResourceSchemas,
+	},
+	{
+		ID:              "wildcardActions",
+		Description:     "Rules must not use the * action outside the configured scopes",
+		DefaultSeverity: SeverityOff,
+		configure:       configureWildcardActions,
+	},
+}
+
+type name struct {
+	path  string
+	value string
+}
+
+func configureNaming(names func(*policyv1.Policy) []name, kind string) func(RuleConf) (checkFunc, error) {
+	return func(rc RuleConf) (checkFunc, error) {
+		if rc.Pattern == "" {
+			return nil, errors.New("pattern is required")
+		}
+
+		re, err := regexp.Compile(rc.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+
+		return func(p *policyv1.Policy, report reportFunc) {
+			for _, n := range names(p) {
+				if n.value != "*" && !re.MatchString(n.value) {
+					report(n.path, "%s %q does not match the pattern %q", kind, n.value, rc.Pattern)
+				}
+			}
+		}, nil
+	}
+}
+
+func actionNames(p *policyv1.Policy) []name {
+	var names []name
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		for i, r := range pt.ResourcePolicy.Rules {
+			for j, a := range r.Actions {
+				names = append(names, name{path: fmt.Sprintf("%s.actions[%d]", policy.ResourcePolicyRuleProtoPath(i), j), value: a})
+			}
+		}
+	case *policyv1.Policy_PrincipalPolicy:
+		for i, r := range pt.PrincipalPolicy.Rules {
+			for j, a := range r.Actions {
+				names = append(names, name{path: policy.PrincipalPolicyActionRuleProtoPath(i, j) + ".action", value: a.Action})
+			}
+		}
+	case *policyv1.Policy_RolePolicy:
+		for i, r := range pt.RolePolicy.Rules {
+			for j, a := range r.AllowActions {
+				names = append(names, name{path: fmt.Sprintf("%s.allow_actions[%d]", policy.RolePolicyRuleProtoPath(i), j), value: a})
+			}
+		}
+	}
+
+	return names
+}
+
+func roleNames(p *policyv1.Policy) []name {
+	var names []name
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		for i, r := range pt.ResourcePolicy.Rules {
+			for j, role := range r.Roles {
+				names = append(names, name{path: fmt.Sprintf("%s.roles[%d]", policy.ResourcePolicyRuleProtoPath(i), j), value: role})
+			}
+		}
+	case *policyv1.Policy_DerivedRoles:
+		for i, d := range pt.DerivedRoles.Definitions {
+			names = append(names, name{path: policy.DerivedRoleRuleProtoPath(i) + ".name", value: d.Name})
+			for j, role := range d.ParentRoles {
+				names = append(names, name{path: fmt.Sprintf("%s.parent_roles[%d]", policy.DerivedRoleRuleProtoPath(i), j), value: role})
+			}
+		}
+	case *policyv1.Policy_RolePolicy:
+		names = append(names, name{path: "role_policy.role", value: pt.RolePolicy.GetRole()})
+		for j, role := range pt.RolePolicy.ParentRoles {
+			names = append(names, name{path: fmt.Sprintf("role_policy.parent_roles[%d]", j), value: role})
+		}
+	}
+
+	return names
+}
+
+func configureRequiredAnnotations(rc RuleConf) (checkFunc, error) {
+	if len(rc.Annotations) == 0 {
+		return nil, errors.New("annotations are required")
+	}
+
+	return func(p *policyv1.Policy, report reportFunc) {
+		annotations := p.GetMetadata().GetAnnotations()
+		for _, a := range rc.Annotations {
+			if _, ok := annotations[a]; !ok {
+				report(policy.PolicyProtoPath(p), "Policy does not have the required annotation %q", a)
+			}
+		}
+	}, nil
+}
+
+func configureDeprecatedFunctions(RuleConf) (checkFunc, error) {
+	return func(p *policyv1.Policy, report reportFunc) {
+		forEachExpr(p, func(path string, ast *celast.AST) {
+			celast.PostOrderVisit(ast.Expr(), celast.NewExprVisitor(func(e celast.Expr) {
+				if e.Kind() != celast.CallKind {
+					return
+				}
+
+				if replacement, ok := conditions.DeprecatedFunctions[e.AsCall().FunctionName()]; ok {
+					report(path, "Function %q is deprecated, use %q instead", e.AsCall().FunctionName(), replacement)
+				}
+			}))
+		})
+	}, nil
+}
+
+func configureConditionComplexity(rc RuleConf) (checkFunc, error) {
+	maxNodes := rc.MaxNodes
+	if maxNodes < 0 {
+		return nil, errors.New("maxNodes must be positive")
+	}
+
+	if maxNodes == 0 {
+		maxNodes = defaultMaxNodes
+	}
+
+	return func(p *policyv1.Policy, report reportFunc) {
+		forEachExpr(p, func(path string, ast *celast.AST) {
+			nodes := 0
+			celast.PostOrderVisit(ast.Expr(), celast.NewExprVisitor(func(celast.Expr) { nodes++ }))
+			if nodes > maxNodes {
+				report(path, "Expression has %d nodes, which exceeds the limit of %d", nodes, maxNodes)
+			}
+		})
+	}, nil
+}
+
+func configureResourceSchemas(RuleConf) (checkFunc, error) {
+	return func(p *policyv1.Policy, report reportFunc) {
+		rp := p.GetResourcePolicy()
+		if rp == nil {
+			return
+		}
+
+		if rp.GetSchemas().GetResourceSchema().GetRef() == "" {
+			report("resource_policy", "Resource policy does not declare a resource schema")
+		}
+	}, nil
+}
+
+func configureWildcardActions(rc RuleConf) (checkFunc, error) {
+	return func(p *policyv1.Policy, report reportFunc) {
+		if slices.Contains(rc.AllowedScopes, policyScope(p)) {
+			return
+		}
+
+		for _, n := range actionNames(p) {
+			if n.value == "*" {
+				report(n.path, "Wildcard action is not allowed in scope %q", policyScope(p))
+			}
+		}
+	}, nil
+}
+
+func policyScope(p *policyv1.Policy) string {
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		return pt.ResourcePolicy.Scope
+	case *policyv1.Policy_PrincipalPolicy:
+		return pt.PrincipalPolicy.Scope
+	case *policyv1.Policy_RolePolicy:
+		return pt.RolePolicy.Scope
+	default:
+		return ""
+	}
+}
+
+// forEachExpr calls fn for each condition and variable expression of the policy that can be parsed.
+// Expressions that can't be parsed are skipped because they are reported as compilation errors.
+func forEachExpr(p *policyv1.Policy, fn func(path string, ast *celast.AST)) {
+	visit := func(path, expr string) {
+		ast, issues := conditions.StdEnv.Parse(expr)
+		if issues != nil && issues.Err() != nil {
+			return
+		}
+
+		fn(path, ast.NativeRep())
+	}
+
+	visitVariables := func(path string, variables map[string]string) {
+		for _, k := range slices.Sorted(maps.Keys(variables)) {
+			visit(fmt.Sprintf("%s[%q]", path, k), variables[k])
+		}
+	}
+
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		visitVariables(policy.VariablesLocalProtoPath(p), pt.ResourcePolicy.GetVariables().GetLocal())
+		for i, r := range pt.ResourcePolicy.Rules {
+			visitCondition(policy.ResourcePolicyRuleProtoPath(i)+".condition", r.Condition, visit)
+		}
+	case *policyv1.Policy_PrincipalPolicy:
+		visitVariables(policy.VariablesLocalProtoPath(p), pt.PrincipalPolicy.GetVariables().GetLocal())
+		for i, r := range pt.PrincipalPolicy.Rules {
+			for j, a := range r.Actions {
+				visitCondition(policy.PrincipalPolicyActionRuleProtoPath(i, j)+".condition", a.Condition, visit)
+			}
+		}
+	case *policyv1.Policy_DerivedRoles:
+		visitVariables(policy.VariablesLocalProtoPath(p), pt.DerivedRoles.GetVariables().GetLocal())
+		for i, d := range pt.DerivedRoles.Definitions {
+			visitCondition(policy.DerivedRoleConditionProtoPath(i), d.Condition, visit)
+		}
+	case *policyv1.Policy_RolePolicy:
+		for i, r := range pt.RolePolicy.Rules {
+			visitCondition(policy.RolePolicyConditionProtoPath(i), r.Condition, visit)
+		}
+	case *policyv1.Policy_ExportVariables:
+		visitVariables(policy.ExportVariablesVariableProtoPath(), pt.ExportVariables.Definitions)
+	}
+}
+
+func visitCondition(path string, cond *policyv1.Condition, visit func(path, expr string)) {
+	if m := cond.GetMatch(); m != nil {
+		visitMatch(path+".match", m, visit)
+	}
+}
+
+func visitMatch(path string, m *policyv1.Match, visit func(path, expr string)) {
+	var (
+		listPath string
+		list     []*policyv1.Match
+	)
+
+	switch op := m.Op.(type) {
+	case *policyv1.Match_Expr:
+		visit(path+".expr", op.Expr)
+		return
+	case *policyv1.Match_All:
+		listPath, list = path+".all.of", op.All.GetOf()
+	case *policyv1.Match_Any:
+		listPath, list = path+".any.of", op.Any.GetOf()
+	case *policyv1.Match_None:
+		listPath, list = path+".none.of", op.None.GetOf()
+	}
+
+	for i, sub := range list {
+		visitMatch(fmt.Sprintf("%s[%d]", listPath, i), sub, visit)
+	}
+}
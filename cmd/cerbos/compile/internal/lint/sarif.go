@@ -0,0 +1,136 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"encoding/json"
+	"io"
+	"maps"
+	"path"
+	"slices"
+
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	Region           *sarifRegion          `json:"region,omitempty"`
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   uint32 `json:"startLine"`
+	StartColumn uint32 `json:"startColumn,omitempty"`
+}
+
+// RuleDescriptions returns the descriptions of the built-in rules keyed by rule ID.
+func RuleDescriptions() map[string]string {
+	descriptions := make(map[string]string, len(Rules))
+	for _, r := range Rules {
+		descriptions[r.ID] = r.Description
+	}
+
+	return descriptions
+}
+
+// WriteSARIF writes the findings as a SARIF log. The descriptions of the rules referenced by the findings are taken from
+// the given map and the file paths are resolved relative to srcRoot.
+func WriteSARIF(w io.Writer, findings []Finding, descriptions map[string]string, srcRoot string) error {
+	driver := sarifDriver{
+		Name:           util.AppName,
+		Version:        util.Version,
+		InformationURI: "https://docs.cerbos.dev",
+	}
+
+	referenced := make(map[string]struct{})
+	results := make([]sarifResult, len(findings))
+	for i, f := range findings {
+		referenced[f.Rule] = struct{}{}
+
+		loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: path.Join(srcRoot, f.File)}}
+		if f.Position != nil {
+			loc.Region = &sarifRegion{StartLine: f.Position.GetLine(), StartColumn: f.Position.GetColumn()}
+		}
+
+		results[i] = sarifResult{
+			RuleID:    f.Rule,
+			Level:     sarifLevel(f.Severity),
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{PhysicalLocation: loc}},
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(referenced)) {
+		driver.Rules = append(driver.Rules, sarifRule{ID: id, ShortDescription: sarifMessage{Text: descriptions[id]}})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	})
+}
+
+func sarifLevel(severity Severity) string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
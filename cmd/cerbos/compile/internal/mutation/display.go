@@ -0,0 +1,26 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation
+
+import (
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/printer/colored"
+	"github.com/cerbos/cerbos/internal/verify/mutation"
+)
+
+func Display(p *printer.Printer, report *mutation.Report) {
+	p.Println(colored.Header("Surviving mutants"))
+	for _, res := range report.Results {
+		if res.Status != mutation.StatusSurvived {
+			continue
+		}
+
+		m := res.Mutant
+		p.Printf("%s %s %s <%s>\n", colored.Position(m.File, m.Position), colored.PolicyKey(m.Rule), colored.WarningMsg(m.Description), m.Operator)
+	}
+
+	s := report.Summary
+	p.Printf("%s %.1f%% (%d killed, %d survived, %d invalid, %d errored)\n", colored.Header("Mutation score"), s.Score(), s.Killed, s.Survived, s.Invalid, s.Errored)
+	p.Println()
+}
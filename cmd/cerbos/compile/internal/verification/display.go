@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/flagset"
 	"github.com/cerbos/cerbos/cmd/cerbos/compile/internal/verification/internal/traces"
@@ -209,6 +210,11 @@ func (o *testOutput) addAction(suite *policyv1.TestResults_Suite, principal *pol
 
 	switch action.Details.Result {
 	case policyv1.TestResults_RESULT_FAILED:
+		if planFailure := action.Details.GetPlanFailure(); planFailure != nil {
+			o.addPlanFailure(planFailure)
+			return
+		}
+
 		failure := action.Details.GetFailure()
 		o.traces.Add(suite.Name, principal.Name, resource.Name, action.Name, action.Details.EngineTrace)
 		if len(failure.Outputs) > 0 {
@@ -236,6 +242,10 @@ func (o *testOutput) addAction(suite *policyv1.TestResults_Suite, principal *pol
 
 	default:
 		if o.verbose {
+			if planSuccess := action.Details.GetPlanSuccess(); planSuccess != nil {
+				o.appendNode(resultLevel, fmt.Sprintf("%s %s", "RESULT:", colored.PassedTest(planKindAndCondition(planSuccess.Kind, planSuccess.Condition))))
+			}
+
 			if success := action.Details.GetSuccess(); success != nil {
 				o.appendNode(resultLevel, fmt.Sprintf("%s %s", "RESULT:", colored.PassedTest(success.Effect)))
 				if len(success.Outputs) > 0 {
@@ -253,6 +263,22 @@ func (o *testOutput) addAction(suite *policyv1.TestResults_Suite, principal *pol
 	}
 }
 
+func (o *testOutput) addPlanFailure(failure *policyv1.TestResults_PlanFailure) {
+	if len(failure.Resources) > 0 {
+		o.appendNode(resultLevel, fmt.Sprintf("%s plan does not match the decisions for the resources", colored.ErrorMsg("OUTCOME:")))
+		for _, r := range failure.Resources {
+			o.appendNode(outputSrcLevel, colored.Resource(r.Resource))
+			o.appendNode(outputErrKindLevel, fmt.Sprintf("%s %t", colored.TestOutputVal("FILTER ALLOWED:"), r.FilterAllowed))
+			o.appendNode(outputErrKindLevel, fmt.Sprintf("%s %s", colored.TestOutputVal("CHECK:"), colored.FailedTest(r.CheckEffect)))
+		}
+		return
+	}
+
+	o.appendNode(resultLevel, fmt.Sprintf("%s plan expectation unsatisfied", colored.ErrorMsg("OUTCOME:")))
+	o.appendNode(outputErrKindLevel, fmt.Sprintf("%s %s", colored.TestOutputVal("EXPECTED:"), planKindAndCondition(failure.ExpectedKind, failure.ExpectedCondition)))
+	o.appendNode(outputErrKindLevel, fmt.Sprintf("%s %s", colored.TestOutputVal("ACTUAL:"), colored.FailedTest(planKindAndCondition(failure.ActualKind, failure.ActualCondition))))
+}
+
 func (o *testOutput) shouldAddAction(action *policyv1.TestResults_Action) bool {
 	switch action.Details.Result {
 	case policyv1.TestResults_RESULT_PASSED:
@@ -278,6 +304,14 @@ func tallyLabel(tally *policyv1.TestResults_Tally) string {
 	return labelColors[tally.Result](fmt.Sprintf("[%d %s]", tally.Count, labels[tally.Result]))
 }
 
+func planKindAndCondition(kind enginev1.PlanResourcesFilter_Kind, condition string) string {
+	if condition == "" {
+		return kind.String()
+	}
+
+	return fmt.Sprintf("%s %s", kind, condition)
+}
+
 func singleLineJSON(m proto.Message) string {
 	v, err := protojson.Marshal(m)
 	if err != nil {
@@ -0,0 +1,60 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/outputcolor"
+	"github.com/cerbos/cerbos/internal/printer"
+)
+
+// jsonOutput collects the results of a run, so that they can be printed as a single JSON object when the output format is JSON.
+// The fields are added to the test results object, so that the output is compatible with the output of earlier versions.
+type jsonOutput struct {
+	fields      map[string]any
+	testResults *policyv1.TestResults
+}
+
+func (o *jsonOutput) add(key string, value any) {
+	if o.fields == nil {
+		o.fields = make(map[string]any)
+	}
+
+	o.fields[key] = value
+}
+
+func (o *jsonOutput) print(p *printer.Printer, colorLevel outputcolor.Level) error {
+	if len(o.fields) == 0 {
+		if o.testResults != nil {
+			return p.PrintProtoJSON(o.testResults, colorLevel)
+		}
+		return nil
+	}
+
+	result := make(map[string]any, len(o.fields))
+	if o.testResults != nil {
+		data, err := protojson.Marshal(o.testResults)
+		if err != nil {
+			return fmt.Errorf("failed to encode test results: %w", err)
+		}
+
+		var testResults map[string]json.RawMessage
+		if err := json.Unmarshal(data, &testResults); err != nil {
+			return fmt.Errorf("failed to decode test results: %w", err)
+		}
+
+		for key, value := range testResults {
+			result[key] = value
+		}
+	}
+
+	maps.Copy(result, o.fields)
+	return p.PrintJSON(result, colorLevel)
+}
@@ -0,0 +1,239 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/alecthomas/kong"
+	"github.com/fatih/color"
+	"github.com/pterm/pterm"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	cloudapi "github.com/cerbos/cloud-api/bundle"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/engine/policyloader"
+	"github.com/cerbos/cerbos/internal/outputcolor"
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/ruletable/diff"
+	internalschema "github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/hub"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const help = `
+Compares two sets of policies and reports the permissions that differ between them. Each set of policies can be a policy directory or a local bundle.
+
+The policies are compiled into rule tables, which are compared to find the actions that each role gained or lost, the rules whose conditions changed, and the policies whose scope permissions changed.
+
+Use the --tests and --decision-log flags to evaluate check requests against both sets of policies and list the requests whose effects or outputs differ.
+
+Examples:
+
+# Compare the policies of two branches checked out to different directories
+
+cerbos diff /path/to/main/policies /path/to/feature/policies
+
+# Compare the decisions made for the checks in the test suites and a decision log, and format the report for a pull request comment
+
+cerbos diff --tests=/path/to/feature/policies --decision-log=decisions.log --output=markdown /path/to/main/policies /path/to/feature/policies
+
+# Compare a bundle with a policy directory
+
+cerbos diff --encryption-key=<hex key> bundle.crbp /path/to/policies
+`
+
+type Cmd struct { //nolint:govet // Kong prints fields in order, so we don't want to reorder fields to save bytes.
+	Old           string             `help:"Old policy directory or bundle" arg:"" type:"existingpath"`
+	New           string             `help:"New policy directory or bundle" arg:"" type:"existingpath"`
+	Tests         string             `help:"Compare the decisions made for the checks in the test suites found in this directory" type:"existingdir"`
+	DecisionLog   []string           `help:"Compare the decisions made for the CheckResources calls recorded in this decision log file (JSON lines)" type:"existingfile" placeholder:"FILE"`
+	EncryptionKey string             `help:"Hex-encoded encryption key of the bundles. Bundles are read as unencrypted if unspecified." placeholder:"KEY"`
+	IgnoreSchemas bool               `help:"Ignore schemas when evaluating decisions"`
+	Output        string             `help:"Output format (${enum})" default:"tree" enum:"tree,json,markdown" short:"o"`
+	Color         *outputcolor.Level `help:"Output color level (auto,never,always,256,16m). Defaults to auto." xor:"color"`
+	NoColor       bool               `help:"Disable colored output" xor:"color"`
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	ctx, stopFunc := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stopFunc()
+
+	colorLevel := c.Color.Resolve(c.NoColor)
+
+	color.NoColor = !colorLevel.Enabled()
+
+	if colorLevel.Enabled() {
+		pterm.EnableColor()
+	} else {
+		pterm.DisableColor()
+	}
+
+	p := printer.New(k.Stdout, k.Stderr)
+
+	oldSet, err := c.load(ctx, c.Old)
+	if err != nil {
+		return err
+	}
+	defer oldSet.close()
+
+	newSet, err := c.load(ctx, c.New)
+	if err != nil {
+		return err
+	}
+	defer newSet.close()
+
+	report := diff.Compare(oldSet.ruleTable, newSet.ruleTable)
+
+	if c.Tests != "" || len(c.DecisionLog) > 0 {
+		comparer := diff.NewComparer(oldSet.engine, newSet.engine)
+		if c.Tests != "" {
+			fsys, err := util.OpenDirectoryFS(c.Tests)
+			if err != nil {
+				return fmt.Errorf("failed to open tests directory at %q: %w", c.Tests, err)
+			}
+
+			if err := comparer.CompareTests(ctx, fsys); err != nil {
+				return fmt.Errorf("failed to run tests from %q: %w", c.Tests, err)
+			}
+		}
+
+		for _, path := range c.DecisionLog {
+			if err := compareDecisionLog(ctx, comparer, path); err != nil {
+				return err
+			}
+		}
+
+		report.Decisions = comparer.Changes()
+	}
+
+	return display(p, report, c.Output, colorLevel)
+}
+
+func compareDecisionLog(ctx context.Context, comparer *diff.Comparer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open decision log %q: %w", path, err)
+	}
+	defer f.Close()
+
+	if err := comparer.CompareDecisionLog(ctx, path, f); err != nil {
+		return fmt.Errorf("failed to compare decisions from %q: %w", path, err)
+	}
+
+	return nil
+}
+
+type policySet struct {
+	ruleTable *runtimev1.RuleTable
+	engine    *engine.Engine
+	close     func()
+}
+
+func (c *Cmd) load(ctx context.Context, path string) (*policySet, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+
+	if info.IsDir() {
+		return c.loadDir(ctx, path)
+	}
+
+	return c.loadBundle(ctx, path)
+}
+
+func (c *Cmd) loadDir(ctx context.Context, dir string) (*policySet, error) {
+	fsys, err := util.OpenDirectoryFS(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open policy repository at %q: %w", dir, err)
+	}
+
+	idx, err := index.Build(ctx, fsys, index.WithBuildFailureLogLevel(zap.DebugLevel))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policy repository at %q: %w", dir, err)
+	}
+
+	store := disk.NewFromIndexWithConf(idx, &disk.Conf{})
+
+	compileMgr, err := compile.NewManager(ctx, store)
+	if err != nil {
+		_ = store.Close()
+		return nil, err
+	}
+
+	ps, err := c.newPolicySet(ctx, compileMgr, store)
+	if err != nil {
+		_ = store.Close()
+		return nil, fmt.Errorf("failed to compile policies at %q: %w", dir, err)
+	}
+
+	ps.close = func() { _ = store.Close() }
+	return ps, nil
+}
+
+func (c *Cmd) loadBundle(ctx context.Context, path string) (*policySet, error) {
+	params := hub.LocalParams{BundlePath: path, BundleVersion: cloudapi.Version1}
+	if c.EncryptionKey != "" {
+		key, err := hex.DecodeString(c.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode encryption key: %w", err)
+		}
+
+		params.BundleVersion = cloudapi.Version2
+		params.EncryptionKey = key
+	}
+
+	source, err := hub.NewLocalSource(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bundle at %q: %w", path, err)
+	}
+
+	ps, err := c.newPolicySet(ctx, source, source)
+	if err != nil {
+		_ = source.Close()
+		return nil, fmt.Errorf("failed to load policies from bundle at %q: %w", path, err)
+	}
+
+	ps.close = func() { _ = source.Close() }
+	return ps, nil
+}
+
+func (c *Cmd) newPolicySet(ctx context.Context, loader policyloader.PolicyLoader, schemaLoader internalschema.Loader) (*policySet, error) {
+	enforcement := internalschema.EnforcementReject
+	if c.IgnoreSchemas {
+		enforcement = internalschema.EnforcementNone
+	}
+	schemaMgr := internalschema.NewFromConf(ctx, schemaLoader, internalschema.NewConf(enforcement))
+
+	rt := ruletable.NewProtoRuletable()
+	if err := ruletable.LoadPolicies(ctx, rt, loader); err != nil {
+		return nil, err
+	}
+
+	// The manager indexes the rows and removes them from the rule table, so they are compared using a copy.
+	ruleTable := proto.CloneOf(rt)
+
+	rtMgr, err := ruletable.NewRuleTableManager(rt, loader, schemaLoader, schemaMgr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ruletable manager: %w", err)
+	}
+
+	return &policySet{ruleTable: ruleTable, engine: engine.NewEphemeral(nil, rtMgr, schemaMgr)}, nil
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
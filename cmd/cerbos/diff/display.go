@@ -0,0 +1,227 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/pterm/pterm/putils"
+
+	"github.com/cerbos/cerbos/internal/outputcolor"
+	"github.com/cerbos/cerbos/internal/printer"
+	"github.com/cerbos/cerbos/internal/printer/colored"
+	"github.com/cerbos/cerbos/internal/ruletable/diff"
+)
+
+const (
+	outputFormatJSON     = "json"
+	outputFormatMarkdown = "markdown"
+)
+
+func display(p *printer.Printer, report *diff.Report, output string, colorLevel outputcolor.Level) error {
+	switch output {
+	case outputFormatJSON:
+		return p.PrintJSON(report, colorLevel)
+	case outputFormatMarkdown:
+		displayMarkdown(p, report)
+		return nil
+	default:
+		return displayTree(p, report)
+	}
+}
+
+func displayTree(p *printer.Printer, report *diff.Report) error {
+	if report.Empty() {
+		p.Println("No differences found")
+		return nil
+	}
+
+	if len(report.Actions) > 0 {
+		var list pterm.LeveledList
+		var target string
+		for _, c := range report.Actions {
+			if t := c.Target.String(); t != target {
+				target = t
+				list = append(list, pterm.LeveledListItem{Level: 0, Text: colored.PolicyKey(target)})
+			}
+
+			list = append(list, pterm.LeveledListItem{Level: 1, Text: fmt.Sprintf("%s %s", c.Role, c.Effect)})
+			for _, a := range c.Added {
+				list = append(list, pterm.LeveledListItem{Level: 2, Text: pterm.Green("+ " + a)})
+			}
+			for _, a := range c.Removed {
+				list = append(list, pterm.LeveledListItem{Level: 2, Text: pterm.Red("- " + a)})
+			}
+		}
+
+		if err := printTree(p, "Actions", list); err != nil {
+			return err
+		}
+	}
+
+	if len(report.Conditions) > 0 {
+		var list pterm.LeveledList
+		var target string
+		for _, c := range report.Conditions {
+			if t := c.Target.String(); t != target {
+				target = t
+				list = append(list, pterm.LeveledListItem{Level: 0, Text: colored.PolicyKey(target)})
+			}
+
+			list = append(list,
+				pterm.LeveledListItem{Level: 1, Text: fmt.Sprintf("%s (%s)", c.Rule, c.Role)},
+				pterm.LeveledListItem{Level: 2, Text: pterm.Red("- " + c.Old)},
+				pterm.LeveledListItem{Level: 2, Text: pterm.Green("+ " + c.New)},
+			)
+		}
+
+		if err := printTree(p, "Conditions", list); err != nil {
+			return err
+		}
+	}
+
+	if len(report.ScopePermissions) > 0 {
+		list := make(pterm.LeveledList, len(report.ScopePermissions))
+		for i, c := range report.ScopePermissions {
+			list[i] = pterm.LeveledListItem{Level: 0, Text: fmt.Sprintf("%s: %s → %s", colored.PolicyKey(c.Policy), c.Old, c.New)}
+		}
+
+		if err := printTree(p, "Scope permissions", list); err != nil {
+			return err
+		}
+	}
+
+	if len(report.Decisions) > 0 {
+		var list pterm.LeveledList
+		for _, c := range report.Decisions {
+			list = append(list, pterm.LeveledListItem{Level: 0, Text: fmt.Sprintf("%s → %s [%s]", c.Principal, c.Resource, c.Source)})
+			for _, action := range slices.Sorted(maps.Keys(c.Effects)) {
+				e := c.Effects[action]
+				list = append(list, pterm.LeveledListItem{Level: 1, Text: fmt.Sprintf("%s: %s → %s", action, e.Old, colored.WarningMsg(e.New))})
+			}
+
+			if c.OldOutputs != nil || c.NewOutputs != nil {
+				list = append(list,
+					pterm.LeveledListItem{Level: 1, Text: "outputs"},
+					pterm.LeveledListItem{Level: 2, Text: pterm.Red("- " + toJSON(c.OldOutputs))},
+					pterm.LeveledListItem{Level: 2, Text: pterm.Green("+ " + toJSON(c.NewOutputs))},
+				)
+			}
+		}
+
+		if err := printTree(p, "Decisions", list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printTree(p *printer.Printer, header string, list pterm.LeveledList) error {
+	out, err := pterm.DefaultTree.WithRoot(putils.TreeFromLeveledList(list)).Srender()
+	if err != nil {
+		return fmt.Errorf("failed to render tree: %w", err)
+	}
+
+	p.Println(colored.Header(header))
+	p.Println(out)
+	return nil
+}
+
+func displayMarkdown(p *printer.Printer, report *diff.Report) {
+	p.Println("## Policy changes")
+	p.Println()
+
+	if report.Empty() {
+		p.Println("No differences found.")
+		return
+	}
+
+	if len(report.Actions) > 0 {
+		p.Println("### Actions")
+		p.Println()
+		p.Println("| Policy | Role | Effect | Added | Removed |")
+		p.Println("|---|---|---|---|---|")
+		for _, c := range report.Actions {
+			p.Printf("| %s | %s | %s | %s | %s |\n", code(c.Target.String()), code(c.Role), c.Effect, codeList(c.Added), codeList(c.Removed))
+		}
+		p.Println()
+	}
+
+	if len(report.Conditions) > 0 {
+		p.Println("### Conditions")
+		p.Println()
+		p.Println("| Policy | Rule | Role | Old | New |")
+		p.Println("|---|---|---|---|---|")
+		for _, c := range report.Conditions {
+			p.Printf("| %s | %s | %s | %s | %s |\n", code(c.Target.String()), code(c.Rule), code(c.Role), code(c.Old), code(c.New))
+		}
+		p.Println()
+	}
+
+	if len(report.ScopePermissions) > 0 {
+		p.Println("### Scope permissions")
+		p.Println()
+		p.Println("| Policy | Old | New |")
+		p.Println("|---|---|---|")
+		for _, c := range report.ScopePermissions {
+			p.Printf("| %s | %s | %s |\n", code(c.Policy), c.Old, c.New)
+		}
+		p.Println()
+	}
+
+	if len(report.Decisions) > 0 {
+		p.Println("### Decisions")
+		p.Println()
+		p.Println("| Principal | Resource | Action | Old | New | Source |")
+		p.Println("|---|---|---|---|---|---|")
+		for _, c := range report.Decisions {
+			for _, action := range slices.Sorted(maps.Keys(c.Effects)) {
+				e := c.Effects[action]
+				p.Printf("| %s | %s | %s | %s | %s | %s |\n", code(c.Principal), code(c.Resource), code(action), e.Old, e.New, escape(c.Source))
+			}
+
+			if c.OldOutputs != nil || c.NewOutputs != nil {
+				p.Printf("| %s | %s | _outputs_ | %s | %s | %s |\n", code(c.Principal), code(c.Resource), code(toJSON(c.OldOutputs)), code(toJSON(c.NewOutputs)), escape(c.Source))
+			}
+		}
+		p.Println()
+	}
+}
+
+func code(s string) string {
+	return "`" + escape(s) + "`"
+}
+
+func codeList(values []string) string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = code(v)
+	}
+
+	return strings.Join(res, ", ")
+}
+
+// escape prevents pipes in CEL expressions from being interpreted as table cell separators.
+func escape(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "|", `\|`), "\n", " ")
+}
+
+func toJSON(v map[string]any) string {
+	if len(v) == 0 {
+		return "{}"
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,200 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/cerbos/cerbos/internal/policy/format"
+)
+
+const help = `
+Formats policy and test suite files in a canonical style.
+
+Fields are written in the order that they are declared in the policy definitions, lists of actions and roles are sorted, and long conditions are folded over several lines. Comments are preserved.
+Formatting never changes the meaning of a policy: the formatted file is checked to decode to the same policy as the original before it is written.
+
+Directories are searched recursively for YAML files. Schemas, test fixtures and JSON files are not formatted.
+If no paths are given, or the path is -, the contents of stdin are formatted and written to stdout.
+
+Examples:
+
+# Print the formatted contents of a policy
+
+cerbos fmt policy.yaml
+
+# Format all the policies and tests in a directory in place
+
+cerbos fmt -w /path/to/policy/repo
+
+# List the files that are not formatted and exit with a non-zero status if there are any
+
+cerbos fmt --check /path/to/policy/repo
+
+# Format a test suite read from stdin
+
+cat leave_request_test.yaml | cerbos fmt --stdin-kind=test
+`
+
+var errUnformatted = errors.New("some files are not formatted")
+
+type Cmd struct { //nolint:govet // Kong prints fields in order, so we don't want to reorder fields to save bytes.
+	Paths     []string `help:"Files or directories to format. Reads from stdin if unspecified or -." arg:"" optional:""`
+	Check     bool     `help:"List the files that are not formatted and fail if there are any" xor:"mode"`
+	Write     bool     `help:"Rewrite the files in place instead of printing them" short:"w" xor:"mode"`
+	StdinKind string   `help:"Kind of the contents read from stdin (${enum})" default:"policy" enum:"policy,test"`
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	if len(c.Paths) == 0 {
+		c.Paths = []string{"-"}
+	}
+
+	unformatted := false
+	for _, path := range c.Paths {
+		if path == "-" {
+			ok, err := c.formatStdin(k)
+			if err != nil {
+				return err
+			}
+			unformatted = unformatted || !ok
+			continue
+		}
+
+		ok, err := c.formatPath(k, path)
+		if err != nil {
+			return err
+		}
+		unformatted = unformatted || !ok
+	}
+
+	if c.Check && unformatted {
+		return errUnformatted
+	}
+
+	return nil
+}
+
+func (c *Cmd) formatStdin(k *kong.Kong) (bool, error) {
+	contents, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return false, fmt.Errorf("failed to read from stdin: %w", err)
+	}
+
+	kind := format.KindPolicy
+	if c.StdinKind == "test" {
+		kind = format.KindTestSuite
+	}
+
+	formatted, err := format.Format(contents, kind)
+	if err != nil {
+		return false, fmt.Errorf("failed to format stdin: %w", err)
+	}
+
+	if c.Check {
+		ok := bytes.Equal(contents, formatted)
+		if !ok {
+			fmt.Fprintln(k.Stdout, "-")
+		}
+		return ok, nil
+	}
+
+	_, err = k.Stdout.Write(formatted)
+	return true, err
+}
+
+func (c *Cmd) formatPath(k *kong.Kong, path string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+
+	if !info.IsDir() {
+		kind, ok := format.KindOf(filepath.Base(path))
+		if !ok {
+			return false, fmt.Errorf("%s is not a policy or test suite file", path)
+		}
+		return c.formatFile(k, path, kind)
+	}
+
+	allFormatted := true
+	err = fs.WalkDir(os.DirFS(path), ".", func(relPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if relPath != "." && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		kind, ok := format.KindOf(relPath)
+		if !ok {
+			return nil
+		}
+
+		ok, err = c.formatFile(k, filepath.Join(path, relPath), kind)
+		allFormatted = allFormatted && ok
+		return err
+	})
+
+	return allFormatted, err
+}
+
+func (c *Cmd) formatFile(k *kong.Kong, path string, kind format.Kind) (bool, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	formatted, err := format.Format(contents, kind)
+	if err != nil {
+		return false, fmt.Errorf("failed to format %s: %w", path, err)
+	}
+
+	switch {
+	case c.Check:
+		ok := bytes.Equal(contents, formatted)
+		if !ok {
+			fmt.Fprintln(k.Stdout, path)
+		}
+		return ok, nil
+	case c.Write:
+		if bytes.Equal(contents, formatted) {
+			return true, nil
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return false, err
+		}
+
+		if err := os.WriteFile(path, formatted, info.Mode().Perm()); err != nil {
+			return false, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		return true, nil
+	default:
+		_, err := k.Stdout.Write(formatted)
+		return true, err
+	}
+}
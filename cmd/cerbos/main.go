@@ -10,7 +10,10 @@ import (
 
 	"github.com/cerbos/cerbos/cmd/cerbos/compile"
 	compileerr "github.com/cerbos/cerbos/cmd/cerbos/compile/errors"
+	"github.com/cerbos/cerbos/cmd/cerbos/diff"
+	"github.com/cerbos/cerbos/cmd/cerbos/format"
 	"github.com/cerbos/cerbos/cmd/cerbos/healthcheck"
+	"github.com/cerbos/cerbos/cmd/cerbos/migrate"
 	"github.com/cerbos/cerbos/cmd/cerbos/repl"
 	"github.com/cerbos/cerbos/cmd/cerbos/run"
 	"github.com/cerbos/cerbos/cmd/cerbos/server"
@@ -31,6 +34,9 @@ func main() {
 		Healthcheck healthcheck.Cmd  `cmd:"" help:"Healthcheck utility" aliases:"hc"`
 		Run         run.Cmd          `cmd:"" help:"Run a command in the context of a Cerbos PDP"`
 		Repl        repl.Cmd         `cmd:"" help:"Start a REPL to try out conditions"`
+		Diff        diff.Cmd         `cmd:"" help:"Compare the permissions granted by two sets of policies"`
+		Fmt         format.Cmd       `cmd:"" help:"Format policy and test suite files"`
+		Migrate     migrate.Cmd      `cmd:"" help:"Rewrite policies that use deprecated constructs"`
 		Version     kong.VersionFlag `help:"Show cerbos version"`
 	}
 
@@ -44,10 +50,10 @@ func main() {
 
 	if err := ctx.Run(); err != nil {
 		switch {
-		case errors.Is(err, compileerr.ErrFailed):
+		case errors.Is(err, compileerr.ErrFailed), errors.Is(err, compileerr.ErrLintFailed), errors.Is(err, compileerr.ErrWarnings):
 			ctx.Errorf("%v", err)
 			ctx.Exit(CompileFailureExitCode)
-		case errors.Is(err, compileerr.ErrTestsFailed):
+		case errors.Is(err, compileerr.ErrTestsFailed), errors.Is(err, compileerr.ErrCoverageBelowThreshold):
 			ctx.Errorf("%v", err)
 			ctx.Exit(TestFailureExitCode)
 		default:
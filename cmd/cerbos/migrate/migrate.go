@@ -0,0 +1,195 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alecthomas/kong"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/cerbos/cerbos/internal/policy/format"
+	"github.com/cerbos/cerbos/internal/policy/migrate"
+)
+
+const help = `
+Rewrites policies that use deprecated constructs. The policies are edited in place, so formatting and comments are preserved.
+
+Each rewrite is a codemod associated with the Cerbos version that introduced the replacement for the deprecated construct. Use --list to see the available codemods.
+Constructs that can't be migrated automatically are reported as warnings and left unchanged.
+
+Examples:
+
+# Show the changes that would be made to the policies, without modifying them
+
+cerbos migrate --dry-run /path/to/policy/repo
+
+# Migrate policies written for Cerbos 0.28.0 or earlier
+
+cerbos migrate --from=0.28.0 /path/to/policy/repo
+
+# Only replace deprecated functions
+
+cerbos migrate --codemod=deprecated-functions /path/to/policy/repo
+`
+
+type Cmd struct { //nolint:govet // Kong prints fields in order, so we don't want to reorder fields to save bytes.
+	Dir     string   `help:"Policy directory" arg:"" type:"existingdir" default:"."`
+	DryRun  bool     `help:"Print the changes as a unified diff instead of writing them"`
+	From    string   `help:"Only apply codemods for constructs deprecated after this Cerbos version" placeholder:"VERSION"`
+	Codemod []string `help:"Only apply the codemods with these IDs" placeholder:"ID"`
+	List    bool     `help:"List the available codemods and exit"`
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	if c.List {
+		return listCodemods(k)
+	}
+
+	codemods, err := migrate.Select(c.From, c.Codemod)
+	if err != nil {
+		return err
+	}
+
+	files, err := readPolicies(c.Dir)
+	if err != nil {
+		return err
+	}
+
+	res, err := migrate.Run(files, codemods)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range res.Warnings {
+		fmt.Fprintf(k.Stderr, "Warning: %s\n", w)
+	}
+
+	if len(res.Changes) == 0 {
+		fmt.Fprintln(k.Stdout, "No changes required")
+		return nil
+	}
+
+	if c.DryRun {
+		return printDiff(k, res.Changes)
+	}
+
+	for _, change := range res.Changes {
+		if err := writeChange(c.Dir, change); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(k.Stdout, "Migrated %s (%s)\n", change.Path, strings.Join(change.Codemods, ", "))
+	}
+
+	return nil
+}
+
+func listCodemods(k *kong.Kong) error {
+	tw := tabwriter.NewWriter(k.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+	fmt.Fprintln(tw, "ID\tVERSION\tDESCRIPTION")
+	for _, c := range migrate.Codemods {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", c.ID, c.Version, c.Description)
+	}
+
+	return tw.Flush()
+}
+
+// readPolicies reads the policy files in the directory, skipping hidden files, schemas, test suites and test fixtures.
+func readPolicies(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	fsys := os.DirFS(dir)
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != "." && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		if kind, ok := format.KindOf(path); !ok || kind != format.KindPolicy {
+			return nil
+		}
+
+		contents, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		files[path] = contents
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policies from %s: %w", dir, err)
+	}
+
+	return files, nil
+}
+
+func printDiff(k *kong.Kong, changes []migrate.Change) error {
+	for _, change := range changes {
+		from, old := "/dev/null", []string(nil)
+		if change.Old != nil {
+			from, old = "a/"+change.Path, difflib.SplitLines(string(change.Old))
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        old,
+			B:        difflib.SplitLines(string(change.New)),
+			FromFile: from,
+			ToFile:   "b/" + change.Path,
+			Context:  3, //nolint:mnd
+		})
+		if err != nil {
+			return fmt.Errorf("failed to diff %s: %w", change.Path, err)
+		}
+
+		fmt.Fprintf(k.Stdout, "# %s\n%s", strings.Join(change.Codemods, ", "), diff)
+	}
+
+	return nil
+}
+
+func writeChange(dir string, change migrate.Change) error {
+	path := filepath.Join(dir, filepath.FromSlash(change.Path))
+	if change.Old == nil {
+		if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to create %s: file already exists", path)
+		}
+
+		if err := os.WriteFile(path, change.New, 0o644); err != nil { //nolint:gosec,mnd
+			return fmt.Errorf("failed to create %s: %w", path, err)
+		}
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, change.New, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/peterh/liner"
 
 	"github.com/cerbos/cerbos/cmd/cerbos/repl/internal"
+	"github.com/cerbos/cerbos/internal/functions"
 )
 
 type Cmd struct {
 	History string `help:"Path to history file" type:"path"`
+	Config  string `help:"Path to a Cerbos config file that defines custom functions" type:"existingfile" placeholder:".cerbos.yaml"`
 }
 
 func (c *Cmd) clear(stdout io.Writer) {
@@ -27,6 +29,14 @@ func (c *Cmd) clear(stdout io.Writer) {
 }
 
 func (c *Cmd) Run(k *kong.Kong) error {
+	if c.Config != "" {
+		customFunctions, err := functions.NewFromFile(context.Background(), c.Config)
+		if err != nil {
+			return fmt.Errorf("failed to load custom functions: %w", err)
+		}
+		defer customFunctions.Close()
+	}
+
 	c.clear(k.Stdout)
 
 	histFile := getHistoryFile(c.History)
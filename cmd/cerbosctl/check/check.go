@@ -0,0 +1,180 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/alecthomas/kong"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	cmdclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/files"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/printer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const help = `# Compare the decisions of the live policies with the decisions of the policies in a directory
+cerbosctl check --with-policies ./path/to/policies ./path/to/request.yaml
+
+# Only show the actions whose decision would change
+cerbosctl check --with-policies ./path/to/policies --changed-only ./path/to/request.yaml
+
+The request file contains a CheckResources request in JSON or YAML format.
+The policies replace the live policies with the same IDs for the duration of the request, and the store is not modified.
+The policies must include any derived roles, exported constants, exported variables and parent scopes that they depend on.`
+
+var errNoPolicies = errors.New("no policies found")
+
+type Cmd struct {
+	Request      string   `arg:"" type:"existingfile" help:"Path to a file containing a CheckResources request"`
+	Output       string   `name:"output" short:"o" default:"table" help:"Output format (${enum})" enum:"table,json,prettyjson"`
+	WithPolicies []string `name:"with-policies" type:"path" required:"" help:"Path to a candidate policy file, directory or archive"`
+	ChangedOnly  bool     `help:"Only show actions whose decision would change"`
+	NoHeaders    bool     `help:"Do not output headers"`
+}
+
+func (c *Cmd) Run(k *kong.Kong, ctx *cmdclient.Context) error {
+	checkReq, err := readRequest(c.Request)
+	if err != nil {
+		return err
+	}
+
+	policies, err := c.readPolicies()
+	if err != nil {
+		return err
+	}
+
+	resp, err := ctx.AdminServiceClient.CheckWithPolicies(context.Background(), &requestv1.CheckWithPoliciesRequest{
+		RequestId: checkReq.RequestId,
+		Policies:  policies,
+		Principal: checkReq.Principal,
+		Resources: checkReq.Resources,
+		AuxData:   checkReq.AuxData,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check with candidate policies: %w", err)
+	}
+
+	switch c.Output {
+	case "json":
+		return printJSON(k.Stdout, resp, protojson.MarshalOptions{})
+	case "prettyjson":
+		return printJSON(k.Stdout, resp, protojson.MarshalOptions{Multiline: true})
+	default:
+		c.printTable(k.Stdout, resp)
+		return nil
+	}
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+func (c *Cmd) readPolicies() ([]*policyv1.Policy, error) {
+	var policies []*policyv1.Policy
+	if err := files.Find(c.WithPolicies, true, util.FileTypePolicy, func(found files.Found) error {
+		f, err := found.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", found.Path(), err)
+		}
+		if closer, ok := f.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		p, err := policy.ReadPolicy(f)
+		if err != nil {
+			return fmt.Errorf("failed to read policy from %s: %w", found.Path(), err)
+		}
+
+		policies = append(policies, policy.WithSourceAttributes(p, policy.SourceFile(found.Path())))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
+		return nil, errNoPolicies
+	}
+
+	return policies, nil
+}
+
+func readRequest(path string) (*requestv1.CheckResourcesRequest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open request file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	req := &requestv1.CheckResourcesRequest{}
+	if err := util.ReadJSONOrYAML(f, req); err != nil {
+		return nil, fmt.Errorf("failed to read request from %s: %w", path, err)
+	}
+
+	return req, nil
+}
+
+func printJSON(w io.Writer, resp *responsev1.CheckWithPoliciesResponse, opts protojson.MarshalOptions) error {
+	b, err := opts.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+func (c *Cmd) printTable(w io.Writer, resp *responsev1.CheckWithPoliciesResponse) {
+	tw := printer.NewTableWriter(w)
+	if !c.NoHeaders {
+		tw.SetHeader([]string{"KIND", "ID", "ACTION", "CURRENT", "CANDIDATE", "CHANGED"})
+	}
+
+	for _, result := range resp.Results {
+		changed := make(map[string]struct{}, len(result.ChangedActions))
+		for _, action := range result.ChangedActions {
+			changed[action] = struct{}{}
+		}
+
+		actions := make([]string, 0, len(result.Current.GetActions()))
+		for action := range result.Current.GetActions() {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+
+		resource := result.Current.GetResource()
+		for _, action := range actions {
+			_, isChanged := changed[action]
+			if c.ChangedOnly && !isChanged {
+				continue
+			}
+
+			marker := ""
+			if isChanged {
+				marker = "*"
+			}
+
+			tw.Append([]string{
+				resource.GetKind(),
+				resource.GetId(),
+				action,
+				result.Current.GetActions()[action].String(),
+				result.Candidate.GetActions()[action].String(),
+				marker,
+			})
+		}
+	}
+
+	tw.Render()
+}
@@ -228,6 +228,10 @@ func populateEntriesTable(ui *decisionsUI, entries []*auditv1.DecisionLogEntry)
 			}
 		case *auditv1.DecisionLogEntry_PlanResources_:
 			requestID = t.PlanResources.Input.RequestId
+		case *auditv1.DecisionLogEntry_CheckResourcesDivergence_:
+			if len(t.CheckResourcesDivergence.Inputs) > 0 {
+				requestID = t.CheckResourcesDivergence.Inputs[0].RequestId
+			}
 		}
 
 		ui.browser.entriesTable.SetCell(rowIndex, 2, tview.NewTableCell(requestID))
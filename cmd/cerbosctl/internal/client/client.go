@@ -4,10 +4,20 @@
 package client
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/cerbos/cerbos-sdk-go/cerbos"
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/flagset"
 )
 
@@ -16,6 +26,8 @@ var errInvalidCredentials = errors.New("invalid credentials: username and passwo
 type Context struct {
 	Client      *cerbos.GRPCClient
 	AdminClient *cerbos.GRPCAdminClient
+	// AdminServiceClient is a raw gRPC client for admin API calls that are not supported by the SDK.
+	AdminServiceClient svcv1.CerbosAdminServiceClient
 }
 
 func GetAdminClient(globals *flagset.Globals) (*cerbos.GRPCAdminClient, error) {
@@ -33,6 +45,28 @@ func GetAdminClient(globals *flagset.Globals) (*cerbos.GRPCAdminClient, error) {
 	return ac, nil
 }
 
+func GetAdminServiceClient(globals *flagset.Globals) (svcv1.CerbosAdminServiceClient, error) {
+	if globals.Username == "" || globals.Password == "" {
+		return nil, errInvalidCredentials
+	}
+
+	transportCreds, err := mkTransportCredentials(globals)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient("passthrough:///"+globals.Server,
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithPerRPCCredentials(basicAuthCredentials{username: globals.Username, password: globals.Password, requireTLS: !globals.Plaintext}),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(flagset.MaxRecvMsgSizeBytes)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the admin service client: %w", err)
+	}
+
+	return svcv1.NewCerbosAdminServiceClient(conn), nil
+}
+
 func GetClient(globals *flagset.Globals) (*cerbos.GRPCClient, error) {
 	opts := globals.ToClientOpts()
 
@@ -43,3 +77,48 @@ func GetClient(globals *flagset.Globals) (*cerbos.GRPCClient, error) {
 
 	return c, nil
 }
+
+func mkTransportCredentials(globals *flagset.Globals) (credentials.TransportCredentials, error) {
+	if globals.Plaintext {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: globals.Insecure} //nolint:gosec
+	if globals.CaCert != "" {
+		bs, err := os.ReadFile(globals.CaCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate from %s: %w", globals.CaCert, err)
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(bs) {
+			return nil, errors.New("failed to append CA certificate to the pool")
+		}
+		tlsConf.RootCAs = certPool
+	}
+
+	if globals.TLSClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(globals.TLSClientCert, globals.TLSClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConf), nil
+}
+
+type basicAuthCredentials struct {
+	username   string
+	password   string
+	requireTLS bool
+}
+
+func (ba basicAuthCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	enc := base64.StdEncoding.EncodeToString([]byte(ba.username + ":" + ba.password))
+	return map[string]string{"authorization": "Basic " + enc}, nil
+}
+
+func (ba basicAuthCredentials) RequireTransportSecurity() bool {
+	return ba.requireTLS
+}
@@ -5,7 +5,7 @@ package flagset
 
 import "github.com/cerbos/cerbos-sdk-go/cerbos"
 
-const maxRecvMsgSizeBytes = 25 * 1024 * 1024 // 25MiB
+const MaxRecvMsgSizeBytes = 25 * 1024 * 1024 // 25MiB
 
 type Globals struct {
 	Server        string `help:"Address of the Cerbos server" env:"CERBOS_SERVER" default:"localhost:3593"`
@@ -19,7 +19,7 @@ type Globals struct {
 }
 
 func (g *Globals) ToClientOpts() []cerbos.Opt {
-	opts := []cerbos.Opt{cerbos.WithMaxRecvMsgSizeBytes(maxRecvMsgSizeBytes)}
+	opts := []cerbos.Opt{cerbos.WithMaxRecvMsgSizeBytes(MaxRecvMsgSizeBytes)}
 	if g.Plaintext {
 		opts = append(opts, cerbos.WithPlaintext())
 	}
@@ -11,8 +11,8 @@ import (
 
 	"github.com/cerbos/cerbos-sdk-go/cerbos"
 	cmdclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/files"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/put/internal/errors"
-	"github.com/cerbos/cerbos/cmd/cerbosctl/put/internal/files"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/alecthomas/kong"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/audit"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/check"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/decisions"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/del"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/disable"
@@ -59,6 +60,7 @@ type Cli struct {
 	Put       put.Cmd       `cmd:"" help:"Put policies or schemas"`
 	Decisions decisions.Cmd `cmd:"" help:"Interactive decision log viewer"`
 	Audit     audit.Cmd     `cmd:"" help:"View audit logs"`
+	Check     check.Cmd     `cmd:"" help:"Compare the decisions of the live policies with those of candidate policies"`
 	Version   version.Cmd   `cmd:"" help:"Show cerbosctl and PDP version"`
 }
 
@@ -103,5 +105,10 @@ func createClientContext(globals *flagset.Globals) (*client.Context, error) {
 		return nil, fmt.Errorf("failed to get the admin client: %w", err)
 	}
 
-	return &client.Context{Client: c, AdminClient: ac}, nil
+	asc, err := client.GetAdminServiceClient(globals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the admin service client: %w", err)
+	}
+
+	return &client.Context{Client: c, AdminClient: ac, AdminServiceClient: asc}, nil
 }
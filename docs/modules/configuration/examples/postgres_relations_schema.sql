@@ -0,0 +1,8 @@
+CREATE TABLE cerbos.relation_tuple (
+    subject VARCHAR(255) NOT NULL,
+    relation VARCHAR(128) NOT NULL,
+    object VARCHAR(255) NOT NULL,
+    PRIMARY KEY (relation, object, subject)
+);
+
+GRANT SELECT ON cerbos.relation_tuple TO cerbos_user;
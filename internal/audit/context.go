@@ -58,6 +58,21 @@ func CallIDFromContext(ctx context.Context) (ID, bool) {
 	return id, true
 }
 
+type excludedAuxDataProvidersCtxKeyType struct{}
+
+var excludedAuxDataProvidersCtxKey = excludedAuxDataProvidersCtxKeyType{}
+
+// NewContextWithExcludedAuxDataProviders returns a context that causes the attributes fetched by the named aux data
+// providers to be removed from the decision log entries written for the request.
+func NewContextWithExcludedAuxDataProviders(ctx context.Context, names []string) context.Context {
+	return context.WithValue(ctx, excludedAuxDataProvidersCtxKey, names)
+}
+
+func excludedAuxDataProvidersFromContext(ctx context.Context) []string {
+	names, _ := ctx.Value(excludedAuxDataProvidersCtxKey).([]string)
+	return names
+}
+
 func PeerFromContext(ctx context.Context) *auditv1.Peer {
 	p := peerFromContext(ctx)
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -5,6 +5,9 @@ package audit
 
 import (
 	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
@@ -98,15 +101,23 @@ func buildPlanResourcesFilter(f PlanResourcesFilter) func(*auditv1.DecisionLogEn
 
 	if f.IgnoreAlwaysAllow {
 		return func(pr *auditv1.DecisionLogEntry_PlanResources) *auditv1.DecisionLogEntry_PlanResources {
-			if pr == nil || pr.Output == nil || pr.Output.Filter == nil {
+			if pr == nil {
 				return nil
 			}
 
-			if pr.Output.Filter.Kind == enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED {
-				return nil
+			outputs := pr.Outputs
+			if pr.Output != nil {
+				outputs = append(outputs, pr.Output)
+			}
+
+			// batch entries are only ignored if all of the plans are ALWAYS_ALLOWED
+			for _, o := range outputs {
+				if o.GetFilter() != nil && o.Filter.Kind != enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED {
+					return pr
+				}
 			}
 
-			return pr
+			return nil
 		}
 	}
 
@@ -114,3 +125,73 @@ func buildPlanResourcesFilter(f PlanResourcesFilter) func(*auditv1.DecisionLogEn
 		return pr
 	}
 }
+
+// withoutAuxDataProviders removes the attributes fetched by the named aux data providers from the inputs of the entry.
+// The inputs are shared with the request, so the ones that contain such attributes are replaced with copies.
+func withoutAuxDataProviders(maker DecisionLogEntryMaker, names []string) DecisionLogEntryMaker {
+	return func() (*auditv1.DecisionLogEntry, error) {
+		entry, err := maker()
+		if err != nil || entry == nil {
+			return entry, err
+		}
+
+		switch mt := entry.Method.(type) {
+		case *auditv1.DecisionLogEntry_CheckResources_:
+			mt.CheckResources.Inputs = withoutAuxDataProvidersInInputs(mt.CheckResources.Inputs, names)
+		case *auditv1.DecisionLogEntry_PlanResources_:
+			if input := mt.PlanResources.Input; hasAuxDataProviders(input.GetAuxData(), names) {
+				mt.PlanResources.Input = proto.Clone(input).(*enginev1.PlanResourcesInput)
+				deleteAuxDataProviders(mt.PlanResources.Input.AuxData, names)
+			}
+			mt.PlanResources.Inputs = withoutAuxDataProvidersInInputs(mt.PlanResources.Inputs, names)
+		case *auditv1.DecisionLogEntry_CheckResourcesDivergence_:
+			mt.CheckResourcesDivergence.Inputs = withoutAuxDataProvidersInInputs(mt.CheckResourcesDivergence.Inputs, names)
+		}
+
+		return entry, nil
+	}
+}
+
+func withoutAuxDataProvidersInInputs[T interface {
+	proto.Message
+	GetAuxData() *enginev1.AuxData
+}](inputs []T, names []string) []T {
+	var out []T
+	for i, input := range inputs {
+		if !hasAuxDataProviders(input.GetAuxData(), names) {
+			continue
+		}
+
+		if out == nil {
+			out = slices.Clone(inputs)
+		}
+		out[i] = proto.Clone(input).(T)
+		deleteAuxDataProviders(out[i].GetAuxData(), names)
+	}
+
+	if out == nil {
+		return inputs
+	}
+
+	return out
+}
+
+func hasAuxDataProviders(auxData *enginev1.AuxData, names []string) bool {
+	for _, name := range names {
+		if _, ok := auxData.GetProviders()[name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deleteAuxDataProviders(auxData *enginev1.AuxData, names []string) {
+	for _, name := range names {
+		delete(auxData.Providers, name)
+	}
+
+	if len(auxData.Providers) == 0 {
+		auxData.Providers = nil
+	}
+}
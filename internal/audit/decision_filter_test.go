@@ -4,6 +4,7 @@
 package audit
 
 import (
+	"fmt"
 	"testing"
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestDecisionLogEntryFilter(t *testing.T) {
@@ -90,6 +92,25 @@ func TestDecisionLogEntryFilter(t *testing.T) {
 			input: mkPlanResourcesLogEntry(enginev1.PlanResourcesFilter_KIND_CONDITIONAL),
 			want:  mkPlanResourcesLogEntry(enginev1.PlanResourcesFilter_KIND_CONDITIONAL),
 		},
+		{
+			name: "PlanResources/IgnoreAlwaysAllow/Batch/AlwaysAllowed",
+			filters: DecisionLogFilters{
+				PlanResources: PlanResourcesFilter{
+					IgnoreAlwaysAllow: true,
+				},
+			},
+			input: mkPlanResourcesBatchLogEntry(enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED),
+		},
+		{
+			name: "PlanResources/IgnoreAlwaysAllow/Batch/Conditional",
+			filters: DecisionLogFilters{
+				PlanResources: PlanResourcesFilter{
+					IgnoreAlwaysAllow: true,
+				},
+			},
+			input: mkPlanResourcesBatchLogEntry(enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, enginev1.PlanResourcesFilter_KIND_CONDITIONAL),
+			want:  mkPlanResourcesBatchLogEntry(enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, enginev1.PlanResourcesFilter_KIND_CONDITIONAL),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -104,6 +125,64 @@ func TestDecisionLogEntryFilter(t *testing.T) {
 	}
 }
 
+func TestWithoutAuxDataProviders(t *testing.T) {
+	mkAuxData := func(names ...string) *enginev1.AuxData {
+		ad := &enginev1.AuxData{Jwt: map[string]*structpb.Value{"sub": structpb.NewStringValue("john")}}
+		for _, name := range names {
+			if ad.Providers == nil {
+				ad.Providers = make(map[string]*structpb.Value)
+			}
+			ad.Providers[name] = structpb.NewStringValue(name)
+		}
+		return ad
+	}
+
+	checkInputs := []*enginev1.CheckInput{
+		{RequestId: "1", AuxData: mkAuxData("crm", "hr")},
+		{RequestId: "2", AuxData: mkAuxData("crm")},
+		{RequestId: "3"},
+	}
+	planInput := &enginev1.PlanResourcesInput{RequestId: "4", AuxData: mkAuxData("hr")}
+
+	t.Run("CheckResources", func(t *testing.T) {
+		maker := withoutAuxDataProviders(func() (*auditv1.DecisionLogEntry, error) {
+			return &auditv1.DecisionLogEntry{
+				Method: &auditv1.DecisionLogEntry_CheckResources_{
+					CheckResources: &auditv1.DecisionLogEntry_CheckResources{Inputs: checkInputs},
+				},
+			}, nil
+		}, []string{"hr"})
+
+		have, err := maker()
+		require.NoError(t, err)
+
+		want := []*enginev1.CheckInput{
+			{RequestId: "1", AuxData: mkAuxData("crm")},
+			{RequestId: "2", AuxData: mkAuxData("crm")},
+			{RequestId: "3"},
+		}
+		require.Empty(t, cmp.Diff(want, have.GetCheckResources().Inputs, protocmp.Transform()))
+		require.Len(t, checkInputs[0].AuxData.Providers, 2, "Request inputs must not be modified")
+	})
+
+	t.Run("PlanResources", func(t *testing.T) {
+		maker := withoutAuxDataProviders(func() (*auditv1.DecisionLogEntry, error) {
+			return &auditv1.DecisionLogEntry{
+				Method: &auditv1.DecisionLogEntry_PlanResources_{
+					PlanResources: &auditv1.DecisionLogEntry_PlanResources{Input: planInput},
+				},
+			}, nil
+		}, []string{"crm", "hr"})
+
+		have, err := maker()
+		require.NoError(t, err)
+
+		want := &enginev1.PlanResourcesInput{RequestId: "4", AuxData: mkAuxData()}
+		require.Empty(t, cmp.Diff(want, have.GetPlanResources().Input, protocmp.Transform()))
+		require.Contains(t, planInput.AuxData.Providers, "hr", "Request input must not be modified")
+	})
+}
+
 func mkCheckResourcesLogEntry(includeDeny bool) *auditv1.DecisionLogEntry {
 	outputs := []*enginev1.CheckOutput{
 		{
@@ -171,3 +250,35 @@ func mkPlanResourcesLogEntry(kind enginev1.PlanResourcesFilter_Kind) *auditv1.De
 		},
 	}
 }
+
+func mkPlanResourcesBatchLogEntry(kinds ...enginev1.PlanResourcesFilter_Kind) *auditv1.DecisionLogEntry {
+	planRes := &auditv1.DecisionLogEntry_PlanResources{}
+	for i, kind := range kinds {
+		resourceKind := fmt.Sprintf("kind_%d", i)
+		planRes.Inputs = append(planRes.Inputs, &enginev1.PlanResourcesInput{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: &enginev1.Principal{
+				Id: "george",
+			},
+			Resource: &enginev1.PlanResourcesInput_Resource{
+				Kind: resourceKind,
+			},
+		})
+		planRes.Outputs = append(planRes.Outputs, &enginev1.PlanResourcesOutput{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Kind:      resourceKind,
+			Filter: &enginev1.PlanResourcesFilter{
+				Kind: kind,
+			},
+		})
+	}
+
+	return &auditv1.DecisionLogEntry{
+		CallId: "foo",
+		Method: &auditv1.DecisionLogEntry_PlanResources_{
+			PlanResources: planRes,
+		},
+	}
+}
@@ -19,10 +19,13 @@ import (
 )
 
 const (
-	peerPart             = "peer"
-	metadataPart         = "metadata"
-	checkResourcesPrefix = "decisionLogEntry.checkResources"
-	planResourcesPrefix  = "decisionLogEntry.planResources"
+	peerPart                       = "peer"
+	metadataPart                   = "metadata"
+	checkResourcesPrefix           = "decisionLogEntry.checkResources"
+	checkResourcesDivergencePrefix = "decisionLogEntry.checkResourcesDivergence"
+	planResourcesPrefix            = "decisionLogEntry.planResources"
+	outputsPart                    = "outputs"
+	shadowOutputsPart              = "shadowOutputs"
 )
 
 var entryKindPrefixes = []string{"accessLogEntry", "decisionLogEntry"}
@@ -80,6 +83,9 @@ func NewOversizedLogFilter() (*AuditLogFilter, error) {
 			"input.resource.attr",
 			"input.principal.attr",
 			"output.filterDebug",
+			"inputs[*].resource.attr",
+			"inputs[*].principal.attr",
+			"outputs[*].filterDebug",
 		},
 	})
 }
@@ -131,15 +137,28 @@ metaOuter:
 		}
 	}
 
+	// Divergence entries contain the same inputs and outputs as check resources entries, plus the shadow outputs,
+	// so the check resources rules apply to them as well.
 	for _, r := range conf.CheckResources {
 		if r == "*" {
 			if err := parse(checkResourcesPrefix); err != nil {
 				outErr = multierr.Append(outErr, err)
 			}
+			if err := parse(checkResourcesDivergencePrefix); err != nil {
+				outErr = multierr.Append(outErr, err)
+			}
 			break
 		}
-		if err := parse(checkResourcesPrefix + "." + r); err != nil {
-			outErr = multierr.Append(outErr, err)
+
+		rules := []string{checkResourcesPrefix + "." + r, checkResourcesDivergencePrefix + "." + r}
+		if rest, ok := strings.CutPrefix(r, outputsPart); ok && (rest == "" || rest[0] == '.' || rest[0] == '[') {
+			rules = append(rules, checkResourcesDivergencePrefix+"."+shadowOutputsPart+rest)
+		}
+
+		for _, rule := range rules {
+			if err := parse(rule); err != nil {
+				outErr = multierr.Append(outErr, err)
+			}
 		}
 	}
 
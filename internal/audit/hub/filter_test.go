@@ -451,6 +451,72 @@ func TestAuditLogFilter(t *testing.T) {
 		require.Empty(t, logEntry.GetDecisionLogEntry().GetCheckResources())
 		require.Empty(t, planEntry.GetDecisionLogEntry().GetPlanResources())
 	})
+
+	t.Run("FilterCheckResourcesDivergence", func(t *testing.T) {
+		ts := timestamppb.New(time.Now())
+
+		maskConf := hub.MaskConf{
+			CheckResources: []string{
+				"inputs[*].principal.attr",
+				"outputs",
+			},
+		}
+
+		output := &enginev1.CheckOutput{
+			RequestId:  "check-1",
+			ResourceId: "test-resource",
+			Actions: map[string]*enginev1.CheckOutput_ActionEffect{
+				"action1": {Effect: effectv1.Effect_EFFECT_ALLOW},
+			},
+		}
+
+		logEntry := &logsv1.IngestBatch_Entry{
+			Kind:      logsv1.IngestBatch_ENTRY_KIND_DECISION_LOG,
+			Timestamp: ts,
+			Entry: &logsv1.IngestBatch_Entry_DecisionLogEntry{
+				DecisionLogEntry: &auditv1.DecisionLogEntry{
+					CallId:    "1",
+					Timestamp: ts,
+					Method: &auditv1.DecisionLogEntry_CheckResourcesDivergence_{
+						CheckResourcesDivergence: &auditv1.DecisionLogEntry_CheckResourcesDivergence{
+							Inputs: []*enginev1.CheckInput{
+								{
+									RequestId: "check-1",
+									Principal: &enginev1.Principal{
+										Id: "test-principal",
+										Attr: map[string]*structpb.Value{
+											"test_attr": structpb.NewStringValue("test_value"),
+										},
+									},
+									Resource: &enginev1.Resource{
+										Kind: "test:kind",
+										Id:   "test-resource",
+									},
+									Actions: []string{"action1"},
+								},
+							},
+							Outputs:       []*enginev1.CheckOutput{output},
+							ShadowOutputs: []*enginev1.CheckOutput{output},
+							ShadowError:   "BOOM",
+						},
+					},
+				},
+			},
+		}
+
+		masker, err := hub.NewAuditLogFilter(maskConf)
+		require.NoError(t, err)
+		require.NoError(t, masker.Filter(logEntry))
+
+		divergence := logEntry.GetDecisionLogEntry().GetCheckResourcesDivergence()
+		require.NotNil(t, divergence)
+		require.Len(t, divergence.Inputs, 1)
+		require.Equal(t, "test-principal", divergence.Inputs[0].Principal.Id)
+		require.Empty(t, divergence.Inputs[0].Principal.Attr)
+		require.Empty(t, divergence.Outputs)
+		require.Empty(t, divergence.ShadowOutputs)
+		require.Equal(t, "BOOM", divergence.ShadowError)
+	})
 }
 
 type diffReporter struct {
@@ -170,6 +170,9 @@ func (lw *logWrapper) WriteDecisionLogEntry(ctx context.Context, entry DecisionL
 	}
 
 	ctx = context.WithoutCancel(ctx)
+	if names := excludedAuxDataProvidersFromContext(ctx); len(names) > 0 {
+		entry = withoutAuxDataProviders(entry, names)
+	}
 
 	lw.pool.Go(func() {
 		if err := lw.backend.WriteDecisionLogEntry(ctx, entry); err != nil {
@@ -10,6 +10,7 @@ import (
 
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/audit"
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
 )
@@ -17,7 +18,10 @@ import (
 var ErrFailedToExtractJWT = errors.New("failed to extract JWT")
 
 type AuxData struct {
-	jwt *jwtHelper
+	jwt       *jwtHelper
+	providers []*attributeProvider
+	// auditExclusions are the names of the providers whose attributes are removed from decision logs.
+	auditExclusions []string
 }
 
 func New(ctx context.Context) (*AuxData, error) {
@@ -26,13 +30,40 @@ func New(ctx context.Context) (*AuxData, error) {
 		return nil, err
 	}
 
-	return NewFromConf(ctx, conf), nil
+	return NewWithProviders(ctx, conf)
 }
 
 func NewFromConf(ctx context.Context, conf *Conf) *AuxData {
 	return &AuxData{jwt: newJWTHelper(ctx, conf.JWT)}
 }
 
+// NewWithProviders creates aux data that also fetches attributes from the configured providers.
+func NewWithProviders(ctx context.Context, conf *Conf) (*AuxData, error) {
+	ad := NewFromConf(ctx, conf)
+	if err := ad.initProviders(conf.Providers); err != nil {
+		_ = ad.Close()
+		return nil, err
+	}
+
+	return ad, nil
+}
+
+func (ad *AuxData) initProviders(confs []ProviderConf) error {
+	for _, pc := range confs {
+		ap, err := newAttributeProvider(pc)
+		if err != nil {
+			return err
+		}
+		ad.providers = append(ad.providers, ap)
+
+		if pc.ExcludeFromAuditLog {
+			ad.auditExclusions = append(ad.auditExclusions, pc.Name)
+		}
+	}
+
+	return nil
+}
+
 func NewWithoutVerification(ctx context.Context) *AuxData {
 	return &AuxData{jwt: newJWTHelper(ctx, &JWTConf{DisableVerification: true})}
 }
@@ -53,3 +84,13 @@ func (ad *AuxData) Extract(ctx context.Context, adProto *requestv1.AuxData) (*en
 
 	return &enginev1.AuxData{Jwt: jwtPB}, nil
 }
+
+// AuditContext returns a context that causes the attributes fetched by the providers that are excluded from the audit log
+// to be removed from the decision log entries written for the request.
+func (ad *AuxData) AuditContext(ctx context.Context) context.Context {
+	if len(ad.auditExclusions) == 0 {
+		return ctx
+	}
+
+	return audit.NewContextWithExcludedAuxDataProviders(ctx, ad.auditExclusions)
+}
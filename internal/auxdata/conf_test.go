@@ -143,6 +143,96 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid providers",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"name": "crm", "http": map[string]any{"url": "https://crm.tld/users/{id}"}, "cacheExpiry": "1m"},
+						{"name": "hr", "target": "resource", "sql": map[string]any{"driver": "postgres", "dsn": "postgres://localhost/hr", "query": "SELECT * FROM docs WHERE kind = $1 AND id = $2", "args": []string{"kind", "id"}}},
+						{"name": "dir", "grpc": map[string]any{"addr": "dir.tld:443", "method": "/dir.v1.Directory/Get"}, "failOpen": true},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate provider name",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"name": "crm", "http": map[string]any{"url": "https://crm.tld/users/{id}"}},
+						{"name": "crm", "http": map[string]any{"url": "https://crm.tld/people/{id}"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "provider without name",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"http": map[string]any{"url": "https://crm.tld/users/{id}"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "provider without source",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"name": "crm"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "provider with multiple sources",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"name": "crm", "http": map[string]any{"url": "https://crm.tld/users/{id}"}, "grpc": map[string]any{"addr": "dir.tld:443", "method": "/dir.v1.Directory/Get"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid provider target",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"name": "crm", "target": "action", "http": map[string]any{"url": "https://crm.tld/users/{id}"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid sql provider argument",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"name": "hr", "sql": map[string]any{"driver": "sqlite3", "dsn": "hr.db", "query": "SELECT * FROM users WHERE id = ?", "args": []string{"name"}}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative provider timeout",
+			conf: map[string]any{
+				"auxData": map[string]any{
+					"providers": []map[string]any{
+						{"name": "crm", "http": map[string]any{"url": "https://crm.tld/users/{id}"}, "timeout": "-1s"},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
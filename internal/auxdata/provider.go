@@ -0,0 +1,311 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auxdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/cache"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+)
+
+const (
+	providerTargetPrincipal = "principal"
+	providerTargetResource  = "resource"
+
+	defaultProviderTimeout = 1 * time.Second
+	maxConcurrentLookups   = 8
+)
+
+var ErrFailedToFetchAttributes = errors.New("failed to fetch attributes from provider")
+
+// fetcher retrieves the attributes of a target from an external source.
+// It returns a nil value without an error if the source has no attributes for the target.
+type fetcher interface {
+	io.Closer
+	fetch(context.Context, target) (*structpb.Value, error)
+}
+
+// target identifies the entity that attributes are fetched for. The kind is empty for principals.
+type target struct {
+	kind string
+	id   string
+}
+
+type attributeProvider struct {
+	fetcher       fetcher
+	cache         *cache.Cache[target, *structpb.Value]
+	breaker       *gobreaker.CircuitBreaker[*structpb.Value]
+	resourceKinds map[string]struct{}
+	name          string
+	timeout       time.Duration
+	cacheExpiry   time.Duration
+	resource      bool
+	failOpen      bool
+}
+
+func newAttributeProvider(conf ProviderConf) (*attributeProvider, error) {
+	var f fetcher
+	var err error
+	switch {
+	case conf.HTTP != nil:
+		f = newHTTPFetcher(conf.HTTP)
+	case conf.GRPC != nil:
+		f, err = newGRPCFetcher(conf.GRPC)
+	case conf.SQL != nil:
+		f, err = newSQLFetcher(conf.SQL)
+	default:
+		err = errors.New("no attribute source defined")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provider '%s': %w", conf.Name, err)
+	}
+
+	ap := &attributeProvider{
+		fetcher:     f,
+		name:        conf.Name,
+		timeout:     conf.Timeout,
+		cacheExpiry: conf.CacheExpiry,
+		resource:    conf.Target == providerTargetResource,
+		failOpen:    conf.FailOpen,
+	}
+
+	if ap.timeout <= 0 {
+		ap.timeout = defaultProviderTimeout
+	}
+
+	if len(conf.ResourceKinds) > 0 {
+		ap.resourceKinds = make(map[string]struct{}, len(conf.ResourceKinds))
+		for _, kind := range conf.ResourceKinds {
+			ap.resourceKinds[kind] = struct{}{}
+		}
+	}
+
+	if conf.CacheExpiry > 0 {
+		cacheSize := conf.CacheSize
+		if cacheSize == 0 {
+			cacheSize = defaultCacheSize
+		}
+		ap.cache = cache.New[target, *structpb.Value]("auxdata_provider", cacheSize)
+	}
+
+	if threshold := conf.CircuitBreaker.FailureThreshold; threshold > 0 {
+		ap.breaker = gobreaker.NewCircuitBreaker[*structpb.Value](gobreaker.Settings{
+			Name: conf.Name,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= threshold
+			},
+			Timeout: conf.CircuitBreaker.OpenDuration,
+		})
+	}
+
+	return ap, nil
+}
+
+// targetFor returns the target to fetch attributes for, or false if the provider doesn't apply to the given entities.
+func (ap *attributeProvider) targetFor(principal *enginev1.Principal, resource *enginev1.Resource) (target, bool) {
+	if !ap.resource {
+		id := principal.GetId()
+		return target{id: id}, id != ""
+	}
+
+	if resource.GetId() == "" {
+		return target{}, false
+	}
+
+	if ap.resourceKinds != nil {
+		if _, ok := ap.resourceKinds[resource.GetKind()]; !ok {
+			return target{}, false
+		}
+	}
+
+	return target{kind: resource.GetKind(), id: resource.GetId()}, true
+}
+
+func (ap *attributeProvider) get(ctx context.Context, t target) (*structpb.Value, error) {
+	if ap.cache != nil {
+		if v, ok := ap.cache.Get(t); ok {
+			return v, nil
+		}
+	}
+
+	fetch := func() (*structpb.Value, error) {
+		ctx, cancel := context.WithTimeout(ctx, ap.timeout)
+		defer cancel()
+
+		return ap.fetcher.fetch(ctx, t)
+	}
+
+	var v *structpb.Value
+	var err error
+	if ap.breaker != nil {
+		v, err = ap.breaker.Execute(fetch)
+	} else {
+		v, err = fetch()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if ap.cache != nil {
+		ap.cache.SetWithExpire(t, v, ap.cacheExpiry)
+	}
+
+	return v, nil
+}
+
+type lookupKey struct {
+	target   target
+	provider int
+}
+
+// Enrich adds the attributes fetched from the configured providers to the auxiliary data of each input.
+func (ad *AuxData) Enrich(ctx context.Context, inputs []*enginev1.CheckInput) error {
+	if len(ad.providers) == 0 || len(inputs) == 0 {
+		return nil
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "aux_data.Enrich")
+	defer span.End()
+
+	keys := make([][]lookupKey, len(inputs))
+	for i, input := range inputs {
+		for j, ap := range ad.providers {
+			if t, ok := ap.targetFor(input.Principal, input.Resource); ok {
+				keys[i] = append(keys[i], lookupKey{provider: j, target: t})
+			}
+		}
+	}
+
+	values, err := ad.lookup(ctx, keys...)
+	if err != nil {
+		return err
+	}
+
+	for i, input := range inputs {
+		input.AuxData = ad.withProviders(input.AuxData, keys[i], values)
+	}
+
+	return nil
+}
+
+// EnrichPlan adds the attributes fetched from the configured principal providers to the auxiliary data of the input.
+// Resource providers are not used because plans are not made for individual resources.
+func (ad *AuxData) EnrichPlan(ctx context.Context, input *enginev1.PlanResourcesInput) error {
+	if len(ad.providers) == 0 {
+		return nil
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "aux_data.EnrichPlan")
+	defer span.End()
+
+	var keys []lookupKey
+	for j, ap := range ad.providers {
+		if ap.resource {
+			continue
+		}
+
+		if t, ok := ap.targetFor(input.Principal, nil); ok {
+			keys = append(keys, lookupKey{provider: j, target: t})
+		}
+	}
+
+	values, err := ad.lookup(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	input.AuxData = ad.withProviders(input.AuxData, keys, values)
+	return nil
+}
+
+// lookup fetches the attributes for each distinct key concurrently.
+func (ad *AuxData) lookup(ctx context.Context, keySets ...[]lookupKey) (map[lookupKey]*structpb.Value, error) {
+	values := make(map[lookupKey]*structpb.Value)
+	var mu sync.Mutex
+
+	p := pool.New().WithErrors().WithMaxGoroutines(maxConcurrentLookups)
+	seen := make(map[lookupKey]struct{})
+	for _, keys := range keySets {
+		for _, key := range keys {
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			p.Go(func() error {
+				ap := ad.providers[key.provider]
+				v, err := ap.get(ctx, key.target)
+				if err != nil {
+					if ap.failOpen {
+						logging.FromContext(ctx).Warn("Failed to fetch attributes from provider", zap.String("provider", ap.name), zap.Error(err))
+						return nil
+					}
+
+					return fmt.Errorf("%w '%s': %w", ErrFailedToFetchAttributes, ap.name, err)
+				}
+
+				mu.Lock()
+				values[key] = v
+				mu.Unlock()
+
+				return nil
+			})
+		}
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func (ad *AuxData) withProviders(auxData *enginev1.AuxData, keys []lookupKey, values map[lookupKey]*structpb.Value) *enginev1.AuxData {
+	var providers map[string]*structpb.Value
+	for _, key := range keys {
+		v, ok := values[key]
+		if !ok || v == nil {
+			continue
+		}
+
+		if providers == nil {
+			providers = make(map[string]*structpb.Value, len(keys))
+		}
+		providers[ad.providers[key.provider].name] = v
+	}
+
+	if providers == nil {
+		return auxData
+	}
+
+	// The aux data is shared between the inputs of a request, so each input gets its own copy.
+	enriched := &enginev1.AuxData{Providers: providers}
+	if auxData != nil {
+		enriched.Jwt = auxData.Jwt
+	}
+
+	return enriched
+}
+
+func (ad *AuxData) Close() (outErr error) {
+	for _, ap := range ad.providers {
+		outErr = multierr.Append(outErr, ap.fetcher.Close())
+	}
+
+	return outErr
+}
@@ -0,0 +1,57 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auxdata
+
+import (
+	"context"
+	"crypto/tls"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type grpcFetcher struct {
+	conn   *grpc.ClientConn
+	method string
+}
+
+func newGRPCFetcher(conf *GRPCProviderConf) (*grpcFetcher, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if conf.Plaintext {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.NewClient(conf.Addr, grpc.WithTransportCredentials(creds), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcFetcher{conn: conn, method: conf.Method}, nil
+}
+
+func (gf *grpcFetcher) fetch(ctx context.Context, t target) (*structpb.Value, error) {
+	req, err := structpb.NewStruct(map[string]any{"id": t.id, "kind": t.kind})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &structpb.Struct{}
+	if err := gf.conn.Invoke(ctx, gf.method, req, resp); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return structpb.NewStructValue(resp), nil
+}
+
+func (gf *grpcFetcher) Close() error {
+	return gf.conn.Close()
+}
@@ -0,0 +1,77 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auxdata
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const maxHTTPResponseSize = 1 << 20
+
+type httpFetcher struct {
+	client  *http.Client
+	headers map[string]string
+	url     string
+}
+
+func newHTTPFetcher(conf *HTTPProviderConf) *httpFetcher {
+	return &httpFetcher{
+		client:  &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
+		headers: conf.Headers,
+		url:     conf.URL,
+	}
+}
+
+func (hf *httpFetcher) fetch(ctx context.Context, t target) (*structpb.Value, error) {
+	u := strings.NewReplacer("{id}", url.PathEscape(t.id), "{kind}", url.PathEscape(t.kind)).Replace(hf.url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	for k, v := range hf.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := hf.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	v := &structpb.Value{}
+	if err := protojson.Unmarshal(body, v); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return v, nil
+}
+
+func (hf *httpFetcher) Close() error {
+	hf.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auxdata
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	// Import the mysql database driver.
+	_ "github.com/go-sql-driver/mysql"
+	// Import the postgres database driver.
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	// Import the sqlite3 database driver.
+	_ "modernc.org/sqlite"
+)
+
+const (
+	sqlArgID   = "id"
+	sqlArgKind = "kind"
+)
+
+// sqlDrivers maps the supported driver names to the names of the registered database/sql drivers.
+var sqlDrivers = map[string]string{
+	"mysql":    "mysql",
+	"postgres": "pgx",
+	"sqlite3":  "sqlite",
+}
+
+type sqlFetcher struct {
+	db    *sql.DB
+	query string
+	args  []string
+}
+
+func newSQLFetcher(conf *SQLProviderConf) (*sqlFetcher, error) {
+	driver, ok := sqlDrivers[conf.Driver]
+	if !ok {
+		return nil, fmt.Errorf("unsupported driver %q", conf.Driver)
+	}
+
+	db, err := sql.Open(driver, conf.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	args := conf.Args
+	if len(args) == 0 {
+		args = []string{sqlArgID}
+	}
+
+	return &sqlFetcher{db: db, query: conf.Query, args: args}, nil
+}
+
+func (sf *sqlFetcher) fetch(ctx context.Context, t target) (*structpb.Value, error) {
+	args := make([]any, len(sf.args))
+	for i, arg := range sf.args {
+		if arg == sqlArgKind {
+			args[i] = t.kind
+		} else {
+			args[i] = t.id
+		}
+	}
+
+	rows, err := sf.db.QueryContext(ctx, sf.query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, len(columns))
+	ptrs := make([]any, len(columns))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+
+	if err := rows.Scan(ptrs...); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]*structpb.Value, len(columns))
+	for i, column := range columns {
+		v, err := structpb.NewValue(sqlValue(values[i]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert column %q: %w", column, err)
+		}
+		fields[column] = v
+	}
+
+	return structpb.NewStructValue(&structpb.Struct{Fields: fields}), rows.Err()
+}
+
+func sqlValue(v any) any {
+	switch t := v.(type) {
+	case []byte:
+		return string(t)
+	case time.Time:
+		return t.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+func (sf *sqlFetcher) Close() error {
+	return sf.db.Close()
+}
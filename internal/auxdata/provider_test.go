@@ -0,0 +1,283 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auxdata_test
+
+import (
+	"database/sql"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+	_ "modernc.org/sqlite"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/auxdata"
+)
+
+type stubServer struct {
+	*httptest.Server
+	calls   atomic.Int32
+	failing atomic.Bool
+}
+
+func newStubServer(t *testing.T) *stubServer {
+	t.Helper()
+
+	s := &stubServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /principals/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.calls.Add(1)
+		if s.failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.PathValue("id") == "nobody" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"department": "dept-%s"}`, r.PathValue("id"))
+	})
+	mux.HandleFunc("GET /resources/{kind}/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.calls.Add(1)
+		_, _ = fmt.Fprintf(w, `{"owner": "%s-%s"}`, r.PathValue("kind"), r.PathValue("id"))
+	})
+	mux.HandleFunc("GET /slow/{id}", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(1 * time.Second):
+		}
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func mkInputs(principalID string, resourceIDs ...string) []*enginev1.CheckInput {
+	auxData := &enginev1.AuxData{Jwt: map[string]*structpb.Value{"sub": structpb.NewStringValue(principalID)}}
+	inputs := make([]*enginev1.CheckInput, len(resourceIDs))
+	for i, id := range resourceIDs {
+		inputs[i] = &enginev1.CheckInput{
+			Principal: &enginev1.Principal{Id: principalID, Roles: []string{"user"}},
+			Resource:  &enginev1.Resource{Kind: "document", Id: id},
+			Actions:   []string{"view"},
+			AuxData:   auxData,
+		}
+	}
+
+	return inputs
+}
+
+func providerValue(t *testing.T, input *enginev1.CheckInput, provider string) map[string]any {
+	t.Helper()
+
+	v, ok := input.GetAuxData().GetProviders()[provider]
+	if !ok {
+		return nil
+	}
+
+	return v.GetStructValue().AsMap()
+}
+
+func TestEnrich(t *testing.T) {
+	stub := newStubServer(t)
+
+	ad, err := auxdata.NewWithProviders(t.Context(), &auxdata.Conf{
+		Providers: []auxdata.ProviderConf{
+			{
+				Name:        "crm",
+				HTTP:        &auxdata.HTTPProviderConf{URL: stub.URL + "/principals/{id}", Headers: map[string]string{"Authorization": "Bearer token"}},
+				CacheExpiry: 1 * time.Minute,
+			},
+			{
+				Name:          "inventory",
+				Target:        "resource",
+				ResourceKinds: []string{"document"},
+				HTTP:          &auxdata.HTTPProviderConf{URL: stub.URL + "/resources/{kind}/{id}"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ad.Close() })
+
+	inputs := mkInputs("alice", "doc1", "doc2")
+	require.NoError(t, ad.Enrich(t.Context(), inputs))
+
+	for i, input := range inputs {
+		require.Equal(t, map[string]any{"department": "dept-alice"}, providerValue(t, input, "crm"))
+		require.Equal(t, map[string]any{"owner": fmt.Sprintf("document-doc%d", i+1)}, providerValue(t, input, "inventory"))
+		require.Equal(t, "alice", input.AuxData.Jwt["sub"].GetStringValue())
+	}
+	// one principal lookup and two resource lookups
+	require.Equal(t, int32(3), stub.calls.Load())
+
+	t.Run("cached", func(t *testing.T) {
+		stub.calls.Store(0)
+		inputs := mkInputs("alice", "doc1")
+		inputs[0].Resource.Kind = "folder"
+		require.NoError(t, ad.Enrich(t.Context(), inputs))
+
+		require.Equal(t, map[string]any{"department": "dept-alice"}, providerValue(t, inputs[0], "crm"))
+		require.Nil(t, providerValue(t, inputs[0], "inventory"))
+		require.Equal(t, int32(0), stub.calls.Load())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		inputs := mkInputs("nobody", "doc1")
+		require.NoError(t, ad.Enrich(t.Context(), inputs))
+		require.Nil(t, providerValue(t, inputs[0], "crm"))
+		require.NotNil(t, providerValue(t, inputs[0], "inventory"))
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		input := &enginev1.PlanResourcesInput{
+			Principal: &enginev1.Principal{Id: "bob", Roles: []string{"user"}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "document"},
+		}
+		require.NoError(t, ad.EnrichPlan(t.Context(), input))
+		require.Equal(t, map[string]any{"department": "dept-bob"}, input.AuxData.Providers["crm"].GetStructValue().AsMap())
+		require.NotContains(t, input.AuxData.Providers, "inventory")
+	})
+}
+
+func TestEnrichFailures(t *testing.T) {
+	stub := newStubServer(t)
+
+	mkAuxData := func(t *testing.T, conf auxdata.ProviderConf) *auxdata.AuxData {
+		t.Helper()
+
+		ad, err := auxdata.NewWithProviders(t.Context(), &auxdata.Conf{Providers: []auxdata.ProviderConf{conf}})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = ad.Close() })
+
+		return ad
+	}
+
+	t.Run("timeout_fail_closed", func(t *testing.T) {
+		ad := mkAuxData(t, auxdata.ProviderConf{
+			Name:    "slow",
+			HTTP:    &auxdata.HTTPProviderConf{URL: stub.URL + "/slow/{id}"},
+			Timeout: 50 * time.Millisecond,
+		})
+
+		err := ad.Enrich(t.Context(), mkInputs("alice", "doc1"))
+		require.ErrorIs(t, err, auxdata.ErrFailedToFetchAttributes)
+	})
+
+	t.Run("timeout_fail_open", func(t *testing.T) {
+		ad := mkAuxData(t, auxdata.ProviderConf{
+			Name:     "slow",
+			HTTP:     &auxdata.HTTPProviderConf{URL: stub.URL + "/slow/{id}"},
+			Timeout:  50 * time.Millisecond,
+			FailOpen: true,
+		})
+
+		inputs := mkInputs("alice", "doc1")
+		require.NoError(t, ad.Enrich(t.Context(), inputs))
+		require.Nil(t, providerValue(t, inputs[0], "slow"))
+	})
+
+	t.Run("circuit_breaker", func(t *testing.T) {
+		ad := mkAuxData(t, auxdata.ProviderConf{
+			Name:           "crm",
+			HTTP:           &auxdata.HTTPProviderConf{URL: stub.URL + "/principals/{id}", Headers: map[string]string{"Authorization": "Bearer token"}},
+			CircuitBreaker: auxdata.CircuitBreakerConf{FailureThreshold: 2, OpenDuration: 100 * time.Millisecond},
+		})
+
+		stub.failing.Store(true)
+		stub.calls.Store(0)
+		for range 4 {
+			require.Error(t, ad.Enrich(t.Context(), mkInputs("alice", "doc1")))
+		}
+		require.Equal(t, int32(2), stub.calls.Load(), "circuit should open after two failures")
+
+		stub.failing.Store(false)
+		require.Eventually(t, func() bool {
+			return ad.Enrich(t.Context(), mkInputs("alice", "doc1")) == nil
+		}, 1*time.Second, 50*time.Millisecond)
+	})
+}
+
+func TestSQLProvider(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "attr.sqlite")
+	db, err := sql.Open("sqlite", dsn)
+	require.NoError(t, err)
+	_, err = db.ExecContext(t.Context(), `CREATE TABLE employees (id TEXT PRIMARY KEY, department TEXT, clearance INTEGER);
+INSERT INTO employees VALUES ('alice', 'engineering', 3);`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	ad, err := auxdata.NewWithProviders(t.Context(), &auxdata.Conf{
+		Providers: []auxdata.ProviderConf{
+			{
+				Name: "hr",
+				SQL:  &auxdata.SQLProviderConf{Driver: "sqlite3", DSN: dsn, Query: "SELECT department, clearance FROM employees WHERE id = ?"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ad.Close() })
+
+	inputs := append(mkInputs("alice", "doc1"), mkInputs("bob", "doc1")...)
+	require.NoError(t, ad.Enrich(t.Context(), inputs))
+	require.Equal(t, map[string]any{"department": "engineering", "clearance": float64(3)}, providerValue(t, inputs[0], "hr"))
+	require.Nil(t, providerValue(t, inputs[1], "hr"))
+}
+
+func TestGRPCProvider(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var gotMethod atomic.Value
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		method, _ := grpc.MethodFromServerStream(stream)
+		gotMethod.Store(method)
+
+		req := &structpb.Struct{}
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+
+		resp, err := structpb.NewStruct(map[string]any{"team": "team-" + req.Fields["id"].GetStringValue()})
+		if err != nil {
+			return err
+		}
+
+		return stream.SendMsg(resp)
+	}))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	ad, err := auxdata.NewWithProviders(t.Context(), &auxdata.Conf{
+		Providers: []auxdata.ProviderConf{
+			{
+				Name: "directory",
+				GRPC: &auxdata.GRPCProviderConf{Addr: lis.Addr().String(), Method: "/acme.directory.v1.Directory/GetAttributes", Plaintext: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ad.Close() })
+
+	inputs := mkInputs("alice", "doc1")
+	require.NoError(t, ad.Enrich(t.Context(), inputs))
+	require.Equal(t, map[string]any{"team": "team-alice"}, providerValue(t, inputs[0], "directory"))
+	require.Equal(t, "/acme.directory.v1.Directory/GetAttributes", gotMethod.Load())
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -200,12 +201,16 @@ func compileResourcePolicy(modCtx *moduleCtx, schemaMgr schema.Manager) (*runtim
 		scopePermissions = policyv1.ScopePermissions_SCOPE_PERMISSIONS_OVERRIDE_PARENT
 	}
 
+	checkCombiningAlgorithm(modCtx, rp.CombiningAlgorithm, scopePermissions)
+
 	rrp := &runtimev1.RunnableResourcePolicySet_Policy{
-		DerivedRoles:     referencedRoles,
-		Scope:            rp.Scope,
-		Rules:            make([]*runtimev1.RunnableResourcePolicySet_Policy_Rule, len(rp.Rules)),
-		Schemas:          rp.Schemas,
-		ScopePermissions: scopePermissions,
+		DerivedRoles:       referencedRoles,
+		Scope:              rp.Scope,
+		Rules:              make([]*runtimev1.RunnableResourcePolicySet_Policy_Rule, len(rp.Rules)),
+		Schemas:            rp.Schemas,
+		ScopePermissions:   scopePermissions,
+		CombiningAlgorithm: rp.CombiningAlgorithm,
+		ConditionCostLimit: rp.ConditionCostLimit,
 	}
 
 	for i, rule := range rp.Rules {
@@ -351,19 +356,35 @@ func checkReferencedSchemas(modCtx *moduleCtx, rp *policyv1.ResourcePolicy, sche
 		return nil
 	}
 
+	var principalSchema, resourceSchema *jsonschema.Schema
 	if ps := rp.Schemas.PrincipalSchema; ps != nil && ps.Ref != "" {
-		if _, err := schemaMgr.LoadSchema(context.TODO(), ps.Ref); err != nil {
+		s, err := schemaMgr.LoadSchema(context.TODO(), ps.Ref)
+		if err != nil {
 			modCtx.addErrForProtoPath(policy.ResourcePolicyPrincipalSchemaProtoPath(), errInvalidSchema, "Failed to load principal schema %q: %v", ps.Ref, err)
 		}
+		principalSchema = s
 	}
 
 	if rs := rp.Schemas.ResourceSchema; rs != nil && rs.Ref != "" {
-		if _, err := schemaMgr.LoadSchema(context.TODO(), rs.Ref); err != nil {
+		s, err := schemaMgr.LoadSchema(context.TODO(), rs.Ref)
+		if err != nil {
 			modCtx.addErrForProtoPath(policy.ResourcePolicyResourceSchemaProtoPath(), errInvalidSchema, "Failed to load resource schema %q: %v", rs.Ref, err)
 		}
+		resourceSchema = s
 	}
 
-	return modCtx.error()
+	if err := modCtx.error(); err != nil {
+		return err
+	}
+
+	schemaEnv, err := newSchemaTypedEnv(principalSchema, resourceSchema)
+	if err != nil {
+		modCtx.addErrWithDesc(errUnexpectedErr, "Failed to create type-checking environment from schemas: %v", err)
+		return modCtx.error()
+	}
+
+	modCtx.schemaEnv = schemaEnv
+	return nil
 }
 
 func compileResourceRule(modCtx *moduleCtx, path string, rule *policyv1.ResourceRule) *runtimev1.RunnableResourcePolicySet_Policy_Rule {
@@ -494,12 +515,19 @@ func compilePrincipalPolicy(modCtx *moduleCtx) (*runtimev1.RunnablePrincipalPoli
 		scopePermissions = policyv1.ScopePermissions_SCOPE_PERMISSIONS_OVERRIDE_PARENT
 	}
 
+	checkCombiningAlgorithm(modCtx, pp.CombiningAlgorithm, scopePermissions)
+
 	rpp := &runtimev1.RunnablePrincipalPolicySet_Policy{
-		Scope:            pp.Scope,
-		ResourceRules:    make(map[string]*runtimev1.RunnablePrincipalPolicySet_Policy_ResourceRules, len(pp.Rules)),
-		ScopePermissions: scopePermissions,
+		Scope:              pp.Scope,
+		ResourceRules:      make(map[string]*runtimev1.RunnablePrincipalPolicySet_Policy_ResourceRules, len(pp.Rules)),
+		ScopePermissions:   scopePermissions,
+		CombiningAlgorithm: pp.CombiningAlgorithm,
+		ConditionCostLimit: pp.ConditionCostLimit,
 	}
 
+	// ordinal records the position of each action rule in the source policy because
+	// the map of resource rules doesn't preserve the order required by the firstApplicable combining algorithm.
+	var ordinal uint32
 	for ruleNum, rule := range pp.Rules {
 		rr := &runtimev1.RunnablePrincipalPolicySet_Policy_ResourceRules{
 			ActionRules: make([]*runtimev1.RunnablePrincipalPolicySet_Policy_ActionRule, len(rule.Actions)),
@@ -513,7 +541,9 @@ func compilePrincipalPolicy(modCtx *moduleCtx) (*runtimev1.RunnablePrincipalPoli
 				Name:      action.Name,
 				Effect:    action.Effect,
 				Condition: compileCondition(modCtx, path+".condition", action.Condition, true),
+				Ordinal:   ordinal,
 			}
+			ordinal++
 
 			//nolint:dupl
 			if action.Output != nil {
@@ -556,6 +586,16 @@ func compilePrincipalPolicy(modCtx *moduleCtx) (*runtimev1.RunnablePrincipalPoli
 	return rpp, modCtx.def.GetMetadata().GetSourceAttributes()
 }
 
+func checkCombiningAlgorithm(modCtx *moduleCtx, algorithm policyv1.CombiningAlgorithm, scopePermissions policyv1.ScopePermissions) {
+	// Scopes requiring parental consent rewrite conditional ALLOW rules into DENY rules, which is only sound under deny-overrides.
+	if scopePermissions == policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS &&
+		algorithm != policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_UNSPECIFIED &&
+		algorithm != policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES {
+		modCtx.addErrForProtoPath(policy.CombiningAlgorithmProtoPath(modCtx.def), errInvalidCombiningAlgorithm,
+			"Combining algorithm %s cannot be used with %s", algorithm, scopePermissions)
+	}
+}
+
 func reportMissingAncestors(modCtx *moduleCtx) {
 	required := policy.RequiredAncestors(modCtx.def)
 	defs := modCtx.unit.Definitions
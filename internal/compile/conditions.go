@@ -70,6 +70,17 @@ func compileCELExpr(modCtx *moduleCtx, path, expr string, markReferencedConstant
 		return nil
 	}
 
+	if modCtx.schemaEnv != nil {
+		if _, issues := modCtx.schemaEnv.Compile(expr); issues != nil && issues.Err() != nil {
+			errList := make([]string, len(issues.Errors()))
+			for i, ce := range issues.Errors() {
+				errList[i] = ce.Message
+			}
+			modCtx.addErrForProtoPath(path, errSchemaTypeMismatch, "Expression `%s` does not match the schemas: [%s]", expr, strings.Join(errList, ", "))
+			return nil
+		}
+	}
+
 	checkedExpr, err := cel.AstToCheckedExpr(celAST)
 	if err != nil {
 		modCtx.addErrForProtoPath(path, err, "Failed to convert AST of `%s`", expr)
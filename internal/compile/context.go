@@ -6,6 +6,8 @@ package compile
 import (
 	"fmt"
 
+	"github.com/google/cel-go/cel"
+
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	"github.com/cerbos/cerbos/internal/namer"
@@ -43,10 +45,12 @@ func (uc *unitCtx) moduleCtx(id namer.ModuleID) *moduleCtx {
 
 type moduleCtx struct {
 	*unitCtx
-	def        *policyv1.Policy
-	srcCtx     parser.SourceCtx
-	constants  *constantDefinitions
-	variables  *variableDefinitions
+	def       *policyv1.Policy
+	srcCtx    parser.SourceCtx
+	constants *constantDefinitions
+	variables *variableDefinitions
+	// schemaEnv is used to type-check expressions against the principal and resource schemas, if they are available.
+	schemaEnv  *cel.Env
 	fqn        string
 	sourceFile string
 }
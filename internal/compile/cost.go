@@ -0,0 +1,192 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+// RuleCost is the static estimate of the cost of evaluating the variables and conditions of a rule.
+type RuleCost struct {
+	Policy    string `json:"policy"`
+	Rule      string `json:"rule"`
+	Min       uint64 `json:"min"`
+	Max       uint64 `json:"max"`
+	Unbounded bool   `json:"unbounded"`
+	// Limit is the cost limit declared by the policy. Zero means that the engine-wide limit applies.
+	Limit uint32 `json:"limit,omitempty"`
+}
+
+// EstimateCosts returns the worst-case cost of evaluating each rule of the policy set. The estimate for a rule includes the
+// variables of the policy and the conditions of the derived roles that the rule refers to.
+// If maxSize is not zero, lists, maps and strings from the request are assumed to contain at most that many elements.
+func EstimateCosts(rps *runtimev1.RunnablePolicySet, maxSize uint64) ([]RuleCost, error) {
+	policyKey := namer.PolicyKeyFromFQN(rps.GetFqn())
+
+	switch ps := rps.PolicySet.(type) {
+	case *runtimev1.RunnablePolicySet_ResourcePolicy:
+		// Policies of ancestor scopes are estimated when their own policy sets are compiled
+		if len(ps.ResourcePolicy.GetPolicies()) == 0 {
+			return nil, nil
+		}
+		return estimateResourcePolicyCosts(policyKey, ps.ResourcePolicy.Policies[0], maxSize)
+
+	case *runtimev1.RunnablePolicySet_PrincipalPolicy:
+		if len(ps.PrincipalPolicy.GetPolicies()) == 0 {
+			return nil, nil
+		}
+		return estimatePrincipalPolicyCosts(policyKey, ps.PrincipalPolicy.Policies[0], maxSize)
+
+	default:
+		return nil, nil
+	}
+}
+
+func estimateResourcePolicyCosts(policyKey string, p *runtimev1.RunnableResourcePolicySet_Policy, maxSize uint64) ([]RuleCost, error) {
+	varsCost, err := estimateVariablesCost(p.OrderedVariables, maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedRoleCosts := make(map[string]conditions.CostEstimate, len(p.DerivedRoles))
+	for name, dr := range p.DerivedRoles {
+		drVarsCost, err := estimateVariablesCost(dr.OrderedVariables, maxSize)
+		if err != nil {
+			return nil, err
+		}
+
+		condCost, err := estimateConditionCost(dr.Condition, maxSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate cost of derived role %q: %w", name, err)
+		}
+
+		derivedRoleCosts[name] = drVarsCost.Add(condCost)
+	}
+
+	costs := make([]RuleCost, len(p.Rules))
+	for i, rule := range p.Rules {
+		cost, err := estimateConditionCost(rule.Condition, maxSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate cost of rule %q: %w", rule.Name, err)
+		}
+		cost = cost.Add(varsCost)
+
+		// Only one of the derived roles needs to be activated, so the most expensive one is the worst case
+		var drCost conditions.CostEstimate
+		for name := range rule.DerivedRoles {
+			if c := derivedRoleCosts[name]; c.Max > drCost.Max {
+				drCost = c
+			}
+		}
+
+		costs[i] = newRuleCost(policyKey, rule.Name, cost.Add(drCost), p.ConditionCostLimit)
+	}
+
+	return costs, nil
+}
+
+func estimatePrincipalPolicyCosts(policyKey string, p *runtimev1.RunnablePrincipalPolicySet_Policy, maxSize uint64) ([]RuleCost, error) {
+	varsCost, err := estimateVariablesCost(p.OrderedVariables, maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	type orderedCost struct {
+		cost    RuleCost
+		ordinal uint32
+	}
+
+	var ordered []orderedCost
+	for _, resourceRules := range p.ResourceRules {
+		for _, rule := range resourceRules.ActionRules {
+			cost, err := estimateConditionCost(rule.Condition, maxSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to estimate cost of rule %q: %w", rule.Name, err)
+			}
+
+			ordered = append(ordered, orderedCost{cost: newRuleCost(policyKey, rule.Name, cost.Add(varsCost), p.ConditionCostLimit), ordinal: rule.Ordinal})
+		}
+	}
+
+	// the resource rules are stored in a map, so restore the order of the rules in the source policy
+	slices.SortFunc(ordered, func(a, b orderedCost) int { return cmp.Compare(a.ordinal, b.ordinal) })
+
+	costs := make([]RuleCost, len(ordered))
+	for i, oc := range ordered {
+		costs[i] = oc.cost
+	}
+
+	return costs, nil
+}
+
+func newRuleCost(policyKey, rule string, cost conditions.CostEstimate, limit uint32) RuleCost {
+	return RuleCost{
+		Policy:    policyKey,
+		Rule:      rule,
+		Min:       cost.Min,
+		Max:       cost.Max,
+		Unbounded: cost.Unbounded(),
+		Limit:     limit,
+	}
+}
+
+func estimateVariablesCost(variables []*runtimev1.Variable, maxSize uint64) (conditions.CostEstimate, error) {
+	var total conditions.CostEstimate
+	for _, v := range variables {
+		if v.GetExpr().GetChecked() == nil {
+			continue
+		}
+
+		cost, err := conditions.EstimateCost(v.Expr.Checked, maxSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to estimate cost of variable %q: %w", v.Name, err)
+		}
+		total = total.Add(cost)
+	}
+
+	return total, nil
+}
+
+// estimateConditionCost returns the cost of evaluating all the expressions of the condition, which is the worst case
+// because evaluation of all, any and none stops as soon as the outcome is known.
+func estimateConditionCost(cond *runtimev1.Condition, maxSize uint64) (conditions.CostEstimate, error) {
+	switch op := cond.GetOp().(type) {
+	case *runtimev1.Condition_Expr:
+		if op.Expr.GetChecked() == nil {
+			return conditions.CostEstimate{}, nil
+		}
+		return conditions.EstimateCost(op.Expr.Checked, maxSize)
+
+	case *runtimev1.Condition_All:
+		return estimateConditionListCost(op.All, maxSize)
+
+	case *runtimev1.Condition_Any:
+		return estimateConditionListCost(op.Any, maxSize)
+
+	case *runtimev1.Condition_None:
+		return estimateConditionListCost(op.None, maxSize)
+
+	default:
+		return conditions.CostEstimate{}, nil
+	}
+}
+
+func estimateConditionListCost(list *runtimev1.Condition_ExprList, maxSize uint64) (conditions.CostEstimate, error) {
+	var total conditions.CostEstimate
+	for _, c := range list.GetExpr() {
+		cost, err := estimateConditionCost(c, maxSize)
+		if err != nil {
+			return total, err
+		}
+		total = total.Add(cost)
+	}
+
+	return total, nil
+}
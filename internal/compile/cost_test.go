@@ -0,0 +1,91 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile_test
+
+import (
+	"testing"
+
+	"github.com/rogpeppe/go-internal/txtar"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/schema"
+)
+
+const costPolicies = `
+-- derived_roles.yaml --
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: roles
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: request.resource.attr.level > 3
+-- resource.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: report
+  version: default
+  conditionCostLimit: 500
+  importDerivedRoles:
+    - roles
+  rules:
+    - name: constant
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["*"]
+    - name: owner
+      actions: ["edit"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["owner"]
+    - name: cells
+      actions: ["export"]
+      effect: EFFECT_ALLOW
+      roles: ["*"]
+      condition:
+        match:
+          expr: request.resource.attr.cells.all(c, c > 0)
+`
+
+func TestEstimateCosts(t *testing.T) {
+	archive := txtar.Parse([]byte(costPolicies))
+	cu := mkCompilationUnit(t, "resource.yaml", archive)
+
+	rps, err := compile.Compile(cu, schema.NewNopManager())
+	require.NoError(t, err)
+
+	t.Run("unbounded", func(t *testing.T) {
+		costs, err := compile.EstimateCosts(rps, 0)
+		require.NoError(t, err)
+		require.Len(t, costs, 3)
+
+		byRule := make(map[string]compile.RuleCost, len(costs))
+		for _, c := range costs {
+			require.Equal(t, "resource.report.vdefault", c.Policy)
+			require.Equal(t, uint32(500), c.Limit)
+			byRule[c.Rule] = c
+		}
+
+		require.Zero(t, byRule["constant"].Max)
+		require.False(t, byRule["owner"].Unbounded)
+		require.NotZero(t, byRule["owner"].Max)
+		require.True(t, byRule["cells"].Unbounded)
+	})
+
+	t.Run("with_size_hint", func(t *testing.T) {
+		small, err := compile.EstimateCosts(rps, 10)
+		require.NoError(t, err)
+
+		large, err := compile.EstimateCosts(rps, 1000)
+		require.NoError(t, err)
+
+		for i := range small {
+			require.False(t, small[i].Unbounded)
+			require.LessOrEqual(t, small[i].Max, large[i].Max)
+		}
+		require.Less(t, small[2].Max, large[2].Max)
+	})
+}
@@ -19,20 +19,22 @@ import (
 )
 
 var (
-	errAmbiguousDerivedRole   = errors.New("ambiguous derived role")
-	errConstantRedefined      = errors.New("constant redefined")
-	errCyclicalVariables      = errors.New("cyclical variable definitions")
-	errImportNotFound         = errors.New("import not found")
-	errInvalidCompilationUnit = errors.New("invalid compilation unit")
-	errInvalidResourceRule    = errors.New("invalid resource rule")
-	errInvalidSchema          = errors.New("invalid schema")
-	errMissingDefinition      = errors.New("missing policy definition")
-	errScriptsUnsupported     = errors.New("scripts in conditions are no longer supported")
-	errUndefinedConstant      = errors.New("undefined constant")
-	errUndefinedVariable      = errors.New("undefined variable")
-	errUnexpectedErr          = errors.New("unexpected error")
-	errUnknownDerivedRole     = errors.New("unknown derived role")
-	errVariableRedefined      = errors.New("variable redefined")
+	errAmbiguousDerivedRole      = errors.New("ambiguous derived role")
+	errConstantRedefined         = errors.New("constant redefined")
+	errCyclicalVariables         = errors.New("cyclical variable definitions")
+	errImportNotFound            = errors.New("import not found")
+	errInvalidCombiningAlgorithm = errors.New("invalid combining algorithm")
+	errInvalidCompilationUnit    = errors.New("invalid compilation unit")
+	errInvalidResourceRule       = errors.New("invalid resource rule")
+	errInvalidSchema             = errors.New("invalid schema")
+	errMissingDefinition         = errors.New("missing policy definition")
+	errSchemaTypeMismatch        = errors.New("expression does not match schema")
+	errScriptsUnsupported        = errors.New("scripts in conditions are no longer supported")
+	errUndefinedConstant         = errors.New("undefined constant")
+	errUndefinedVariable         = errors.New("undefined variable")
+	errUnexpectedErr             = errors.New("unexpected error")
+	errUnknownDerivedRole        = errors.New("unknown derived role")
+	errVariableRedefined         = errors.New("variable redefined")
 )
 
 type ErrorSet struct {
@@ -7,15 +7,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/parser"
 	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
 )
 
@@ -135,6 +139,151 @@ func (c *Manager) GetPolicySet(ctx context.Context, modID namer.ModuleID) (*runt
 	return nil, nil
 }
 
+// CompileWithOverrides compiles the policies against the policies in the store, as if they replaced the stored policies with the same module IDs.
+// The stored policies that depend on the given policies are compiled again to pick up the changes and are included in the result.
+func (c *Manager) CompileWithOverrides(ctx context.Context, policies []*policyv1.Policy, schemaMgr schema.Manager) ([]*runtimev1.RunnablePolicySet, error) {
+	o := &overrides{
+		store:    c.store,
+		policies: make(map[namer.ModuleID]*policyv1.Policy, len(policies)),
+		stored:   make(map[namer.ModuleID]*policy.CompilationUnit),
+	}
+
+	ids := make([]namer.ModuleID, 0, len(policies))
+	var errs []error
+	for _, p := range policies {
+		if err := policy.Validate(p, parser.SourceCtx{}); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		id := namer.GenModuleID(p)
+		if _, ok := o.policies[id]; ok {
+			errs = append(errs, fmt.Errorf("policy %s is defined more than once", namer.PolicyKey(p)))
+			continue
+		}
+
+		o.policies[id] = p
+		ids = append(ids, id)
+	}
+
+	if len(errs) > 0 {
+		return nil, PolicyCompilationErr{underlying: errors.Join(errs...)}
+	}
+
+	dependents, err := c.store.GetDependents(ctx, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependents: %w", err)
+	}
+
+	for _, deps := range dependents {
+		for _, dep := range deps {
+			if !slices.Contains(ids, dep) {
+				ids = append(ids, dep)
+			}
+		}
+	}
+
+	rpsSet := make([]*runtimev1.RunnablePolicySet, 0, len(ids))
+	for _, id := range ids {
+		cu, err := o.compilationUnit(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get compilation units: %w", err)
+		}
+
+		rps, err := Compile(cu, schemaMgr)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if rps != nil {
+			rpsSet = append(rpsSet, rps)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, PolicyCompilationErr{underlying: errors.Join(errs...)}
+	}
+
+	return rpsSet, nil
+}
+
+// overrides looks up policy definitions in a set of policies before looking them up in the store.
+type overrides struct {
+	store    storage.SourceStore
+	policies map[namer.ModuleID]*policyv1.Policy
+	stored   map[namer.ModuleID]*policy.CompilationUnit
+}
+
+// compilationUnit builds the compilation unit of the module with the given ID from the definitions of the module, its ancestors and their dependencies.
+func (o *overrides) compilationUnit(ctx context.Context, id namer.ModuleID) (*policy.CompilationUnit, error) {
+	cu := &policy.CompilationUnit{ModID: id}
+
+	var add func(namer.ModuleID) error
+	add = func(id namer.ModuleID) error {
+		if _, ok := cu.Definitions[id]; ok {
+			return nil
+		}
+
+		p, sc, err := o.definition(ctx, id)
+		if err != nil || p == nil {
+			// missing definitions are reported by the compiler
+			return err
+		}
+
+		cu.AddDefinition(id, p, sc)
+		for _, dep := range policy.Wrap(p).Dependencies() {
+			if err := add(dep); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := add(id); err != nil {
+		return nil, err
+	}
+
+	if cu.MainPolicy() == nil {
+		return cu, nil
+	}
+
+	for _, ancestor := range cu.Ancestors() {
+		if err := add(ancestor); err != nil {
+			return nil, err
+		}
+	}
+
+	return cu, nil
+}
+
+func (o *overrides) definition(ctx context.Context, id namer.ModuleID) (*policyv1.Policy, parser.SourceCtx, error) {
+	if p, ok := o.policies[id]; ok {
+		return p, parser.SourceCtx{}, nil
+	}
+
+	// stored compilation units contain the definitions of their dependencies, which are likely to be needed next
+	for _, cu := range o.stored {
+		if p, ok := cu.Definitions[id]; ok {
+			return p, cu.SourceContexts[id], nil
+		}
+	}
+
+	cus, err := o.store.GetCompilationUnits(ctx, id)
+	if err != nil {
+		return nil, parser.SourceCtx{}, err
+	}
+
+	cu, ok := cus[id]
+	if !ok {
+		return nil, parser.SourceCtx{}, nil
+	}
+
+	o.stored[id] = cu
+	return cu.Definitions[id], cu.SourceContexts[id], nil
+}
+
 func (c *Manager) Source() *auditv1.PolicySource {
 	return c.store.Source()
 }
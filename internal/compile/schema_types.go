@@ -0,0 +1,263 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/decls"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+const (
+	// maxSchemaDepth limits the depth of nested schemas that are converted to types, which guards against recursive schemas.
+	maxSchemaDepth = 32
+
+	requestProtoType   = "cerbos.engine.v1.Request"
+	principalProtoType = "cerbos.engine.v1.Request.Principal"
+	resourceProtoType  = "cerbos.engine.v1.Request.Resource"
+
+	schemaTypePrefix = "schema."
+)
+
+// schemaTypes is a CEL type provider for the attributes of principals and resources described by JSON schemas.
+// The attributes of a principal or resource are represented by a struct type with a field for each property declared
+// by the schema, so that references to unknown attributes and type mismatches are detected by the type checker.
+type schemaTypes struct {
+	types.Provider
+	// fields are the fields of each struct type created from a schema, or overridden in a protobuf message type.
+	fields map[string]map[string]*types.Type
+	// delegates are the protobuf message types that provide the fields that are not overridden.
+	delegates map[string]string
+	// open contains the struct types whose schemas allow properties other than the declared ones.
+	open map[string]struct{}
+}
+
+// newSchemaTypedEnv returns an environment for type-checking conditions in which the attributes of the principal
+// and resource have the types described by their schemas. Either schema can be nil.
+func newSchemaTypedEnv(principalSchema, resourceSchema *jsonschema.Schema) (*cel.Env, error) {
+	st := &schemaTypes{
+		fields:    make(map[string]map[string]*types.Type),
+		delegates: make(map[string]string),
+		open:      make(map[string]struct{}),
+	}
+
+	requestFields := make(map[string]*types.Type)
+	var varDecls []*decls.VariableDecl
+
+	if principalSchema != nil {
+		principalType := st.addMessage(schemaTypePrefix+conditions.CELPrincipalField, principalProtoType, principalSchema)
+		requestFields[conditions.CELPrincipalField] = principalType
+		varDecls = append(varDecls, decls.NewVariable(conditions.CELPrincipalAbbrev, principalType))
+	}
+
+	if resourceSchema != nil {
+		resourceType := st.addMessage(schemaTypePrefix+conditions.CELResourceField, resourceProtoType, resourceSchema)
+		requestFields[conditions.CELResourceField] = resourceType
+		varDecls = append(varDecls, decls.NewVariable(conditions.CELResourceAbbrev, resourceType))
+	}
+
+	if len(varDecls) == 0 {
+		return nil, nil
+	}
+
+	requestType := schemaTypePrefix + conditions.CELRequestIdent
+	st.fields[requestType] = requestFields
+	st.delegates[requestType] = requestProtoType
+	varDecls = append(varDecls, decls.NewVariable(conditions.CELRequestIdent, types.NewObjectType(requestType)))
+
+	return conditions.NewTypedEnv(varDecls, func(base types.Provider) types.Provider {
+		st.Provider = base
+		return st
+	}, st.mapOperators()...)
+}
+
+// addMessage creates a struct type that has the same fields as the protobuf message, except for attr which has the type described by the schema.
+func (st *schemaTypes) addMessage(name, protoType string, schema *jsonschema.Schema) *types.Type {
+	st.fields[name] = map[string]*types.Type{conditions.CELAttrField: st.typeOf(name+"."+conditions.CELAttrField, schema, 0)}
+	st.delegates[name] = protoType
+	return types.NewObjectType(name)
+}
+
+// typeOf returns the CEL type of values described by the schema. Types that cannot be represented precisely are dynamic.
+func (st *schemaTypes) typeOf(name string, schema *jsonschema.Schema, depth int) *types.Type {
+	if depth > maxSchemaDepth {
+		return types.DynType
+	}
+
+	if schema.Ref != nil && len(schema.Types) == 0 && len(schema.Properties) == 0 {
+		return st.typeOf(name, schema.Ref, depth+1)
+	}
+
+	if schema.Always != nil || len(schema.AllOf) > 0 || len(schema.AnyOf) > 0 || len(schema.OneOf) > 0 {
+		return types.DynType
+	}
+
+	schemaTypes := schema.Types
+	if len(schemaTypes) == 0 && len(schema.Properties) > 0 {
+		schemaTypes = []string{"object"}
+	}
+
+	nullable := slices.Contains(schemaTypes, "null")
+	schemaTypes = slices.DeleteFunc(slices.Clone(schemaTypes), func(t string) bool { return t == "null" })
+	if len(schemaTypes) != 1 {
+		return types.DynType
+	}
+
+	switch schemaTypes[0] {
+	// Strings and booleans are always nullable, because conditions commonly compare attributes with null before using
+	// them (for example, `R.attr.owner != null && R.attr.owner == P.id`) regardless of whether the schema requires them.
+	case "string":
+		return types.NewNullableType(types.StringType)
+	case "boolean":
+		return types.NewNullableType(types.BoolType)
+	case "array":
+		if nullable {
+			return types.DynType
+		}
+		return types.NewListType(st.typeOf(name+"[]", itemsSchema(schema), depth+1))
+	case "object":
+		if nullable {
+			return types.DynType
+		}
+		return st.objectType(name, schema, depth)
+	default:
+		// Numbers in attributes are always doubles at runtime, but they can be compared with int literals because of
+		// heterogeneous equality, which the type checker does not allow. Leave them untyped to avoid false positives.
+		return types.DynType
+	}
+}
+
+func (st *schemaTypes) objectType(name string, schema *jsonschema.Schema, depth int) *types.Type {
+	if len(schema.Properties) == 0 {
+		if ap, ok := schema.AdditionalProperties.(*jsonschema.Schema); ok {
+			return types.NewMapType(types.StringType, st.typeOf(name+"[]", ap, depth+1))
+		}
+		return types.NewMapType(types.StringType, types.DynType)
+	}
+
+	fields := make(map[string]*types.Type, len(schema.Properties))
+	st.fields[name] = fields
+	for prop, propSchema := range schema.Properties {
+		fields[prop] = st.typeOf(name+"."+prop, propSchema, depth+1)
+	}
+
+	if allowsAdditionalProperties(schema) {
+		st.open[name] = struct{}{}
+	}
+
+	return types.NewObjectType(name)
+}
+
+func itemsSchema(schema *jsonschema.Schema) *jsonschema.Schema {
+	if schema.Items2020 != nil {
+		return schema.Items2020
+	}
+
+	if items, ok := schema.Items.(*jsonschema.Schema); ok {
+		return items
+	}
+
+	return &jsonschema.Schema{}
+}
+
+// allowsAdditionalProperties returns true unless the schema explicitly forbids properties other than those it declares.
+// References to undeclared attributes of an object are only reported as errors if the object is closed in this way,
+// because schema validation would otherwise accept requests that contain them.
+func allowsAdditionalProperties(schema *jsonschema.Schema) bool {
+	if ap, ok := schema.AdditionalProperties.(bool); ok && !ap {
+		return len(schema.PatternProperties) > 0
+	}
+
+	if up := schema.UnevaluatedProperties; up != nil && up.Always != nil && !*up.Always {
+		return len(schema.PatternProperties) > 0
+	}
+
+	return true
+}
+
+// mapOperators returns declarations that allow attribute structs to be used with the index and in operators, as they can be with maps.
+func (st *schemaTypes) mapOperators() []cel.EnvOption {
+	var indexOverloads, inOverloads []cel.FunctionOpt
+	for _, name := range slices.Sorted(maps.Keys(st.fields)) {
+		if _, ok := st.delegates[name]; ok {
+			continue
+		}
+
+		structType := types.NewObjectType(name)
+		indexOverloads = append(indexOverloads, cel.Overload(fmt.Sprintf("index_%s", name), []*cel.Type{structType, cel.StringType}, cel.DynType))
+		inOverloads = append(inOverloads, cel.Overload(fmt.Sprintf("in_%s", name), []*cel.Type{cel.StringType, structType}, cel.BoolType))
+	}
+
+	if len(indexOverloads) == 0 {
+		return nil
+	}
+
+	return []cel.EnvOption{
+		cel.Function(operators.Index, indexOverloads...),
+		cel.Function(operators.In, inOverloads...),
+	}
+}
+
+func (st *schemaTypes) FindStructType(structType string) (*types.Type, bool) {
+	if _, ok := st.fields[structType]; ok {
+		return types.NewTypeTypeWithParam(types.NewObjectType(structType)), true
+	}
+
+	return st.Provider.FindStructType(structType)
+}
+
+func (st *schemaTypes) FindStructFieldNames(structType string) ([]string, bool) {
+	fields, ok := st.fields[structType]
+	if !ok {
+		return st.Provider.FindStructFieldNames(structType)
+	}
+
+	names := slices.Collect(maps.Keys(fields))
+	if delegate, ok := st.delegates[structType]; ok {
+		if delegateNames, ok := st.Provider.FindStructFieldNames(delegate); ok {
+			names = append(names, delegateNames...)
+		}
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names), true
+}
+
+func (st *schemaTypes) FindStructFieldType(structType, fieldName string) (*types.FieldType, bool) {
+	fields, ok := st.fields[structType]
+	if !ok {
+		return st.Provider.FindStructFieldType(structType, fieldName)
+	}
+
+	if t, ok := fields[fieldName]; ok {
+		return &types.FieldType{Type: t}, true
+	}
+
+	if delegate, ok := st.delegates[structType]; ok {
+		return st.Provider.FindStructFieldType(delegate, fieldName)
+	}
+
+	if _, ok := st.open[structType]; ok {
+		return &types.FieldType{Type: types.DynType}, true
+	}
+
+	return nil, false
+}
+
+func (st *schemaTypes) NewValue(structType string, fields map[string]ref.Val) ref.Val {
+	if _, ok := st.fields[structType]; ok {
+		return types.NewErr("cannot create values of type %s", structType)
+	}
+
+	return st.Provider.NewValue(structType, fields)
+}
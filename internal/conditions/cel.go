@@ -5,6 +5,7 @@ package conditions
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/cel-go/cel"
@@ -41,6 +42,8 @@ var (
 
 	StdEnv *cel.Env
 
+	customFunctions []Function
+
 	StdEnvDecls = []*decls.VariableDecl{
 		decls.NewVariable(CELRequestIdent, celtypes.NewObjectType("cerbos.engine.v1.Request")),
 		decls.NewVariable(CELPrincipalAbbrev, celtypes.NewObjectType("cerbos.engine.v1.Request.Principal")),
@@ -58,20 +61,18 @@ var (
 		ext.TwoVarComprehensions(),
 		cel.CrossTypeNumericComparisons(true),
 		cel.Types(&enginev1.Request{}, &enginev1.Request_Principal{}, &enginev1.Request_Resource{}, &enginev1.Runtime{}),
-		cel.VariableDecls(StdEnvDecls...),
 		ext.Lists(),
 		ext.Bindings(),
 		ext.Strings(),
 		ext.Encoders(),
 		ext.Math(),
-		CerbosCELLib(),
 	}
 )
 
 func init() {
 	var err error
 
-	StdEnv, err = initEnv(StdEnvOptions)
+	StdEnv, err = newStdEnv(StdEnvDecls, nil)
 	if err != nil {
 		panic(fmt.Errorf("failed to initialize standard CEL environment: %w", err))
 	}
@@ -87,6 +88,54 @@ func init() {
 	}
 }
 
+// RegisterFunctions replaces the custom functions available to conditions and rebuilds StdEnv to include them.
+// It is not safe to call concurrently with the compilation or evaluation of conditions, so it must be called
+// before any policies are loaded.
+func RegisterFunctions(fns ...Function) error {
+	for _, fn := range fns {
+		if StdEnv.HasFunction(fn.Name) && !slices.ContainsFunc(customFunctions, func(f Function) bool { return f.Name == fn.Name }) {
+			return fmt.Errorf("custom function %q conflicts with a built-in function", fn.Name)
+		}
+	}
+
+	env, err := newStdEnv(StdEnvDecls, fns)
+	if err != nil {
+		return fmt.Errorf("failed to initialize CEL environment with custom functions: %w", err)
+	}
+
+	StdEnv = env
+	customFunctions = fns
+	return nil
+}
+
+// NewTypedEnv returns an environment that is equivalent to StdEnv, except that the given variable declarations replace the
+// standard declarations with the same names. The fields of types that are not protobuf messages are resolved by the
+// provider returned from the wrap function, which receives the standard provider to delegate to.
+// The environment is only suitable for type-checking expressions because the variable types don't match the activation
+// used for evaluation, so programs must always be planned using StdEnv.
+func NewTypedEnv(varDecls []*decls.VariableDecl, wrap func(celtypes.Provider) celtypes.Provider, opts ...cel.EnvOption) (*cel.Env, error) {
+	typedDecls := slices.Clone(StdEnvDecls)
+	for _, vd := range varDecls {
+		idx := slices.IndexFunc(typedDecls, func(d *decls.VariableDecl) bool { return d.Name() == vd.Name() })
+		if idx < 0 {
+			typedDecls = append(typedDecls, vd)
+			continue
+		}
+		typedDecls[idx] = vd
+	}
+
+	env, err := newStdEnv(typedDecls, customFunctions)
+	if err != nil {
+		return nil, err
+	}
+
+	return env.Extend(append(opts, cel.CustomTypeProvider(wrap(env.CELTypeProvider())))...)
+}
+
+func newStdEnv(varDecls []*decls.VariableDecl, fns []Function) (*cel.Env, error) {
+	return initEnv(append(slices.Clone(StdEnvOptions), cel.VariableDecls(varDecls...), CerbosCELLib(fns...)))
+}
+
 func initEnv(options []cel.EnvOption) (*cel.Env, error) {
 	env, err := cel.NewEnv(options...)
 	if err != nil {
@@ -28,17 +28,26 @@ const (
 	intersectFn                 = "intersect"
 	isSubsetFnDeprecated        = "is_subset"
 	isSubsetFn                  = "isSubset"
-	nowFn                       = "now"
-	timeSinceFn                 = "timeSince"
+	NowFn                       = "now"
+	relatedFn                   = "related"
+	TimeSinceFn                 = "timeSince"
 	IDFn                        = "id"
 )
 
-// CerbosCELLib returns the custom CEL functions provided by Cerbos.
-func CerbosCELLib() cel.EnvOption {
-	return cel.Lib(cerbosLib{})
+// DeprecatedFunctions maps the names of deprecated functions to the names of the functions that replace them.
+var DeprecatedFunctions = map[string]string{
+	hasIntersectionFnDeprecated: hasIntersectionFn,
+	isSubsetFnDeprecated:        isSubsetFn,
 }
 
-type cerbosLib struct{}
+// CerbosCELLib returns the custom CEL functions provided by Cerbos, along with any functions provided by the operator.
+func CerbosCELLib(fns ...Function) cel.EnvOption {
+	return cel.Lib(cerbosLib{functions: fns})
+}
+
+type cerbosLib struct {
+	functions []Function
+}
 
 func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 	genericListType := cel.ListType(cel.TypeParamType("A"))
@@ -81,7 +90,7 @@ func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 		}
 	}
 
-	return []cel.EnvOption{
+	opts := []cel.EnvOption{
 		cel.FunctionDecls(customtypes.HierarchyDeclrations...),
 		cel.FunctionDecls(customtypes.SPIFFEDeclrations...),
 		cel.Types(customtypes.HierarchyType, customtypes.SPIFFEIDType, customtypes.SPIFFETrustDomainType, customtypes.SPIFFEMatcherType),
@@ -97,20 +106,32 @@ func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 		cel.Function(intersectFn, setOpFuncOverloads(intersectFn, intersect)...),
 		cel.Function(isSubsetFn, setCheckFuncOverloads(isSubsetFn, isSubset)...),
 		cel.Function(isSubsetFnDeprecated, setCheckFuncOverloads(isSubsetFnDeprecated, isSubset)...),
-		cel.Function(nowFn,
-			cel.Overload(nowFn,
+		cel.Function(NowFn,
+			cel.Overload(NowFn,
 				nil,
 				cel.TimestampType,
 				cel.FunctionBinding(callInNothingOutTimestamp(time.Now)),
 			),
 		),
-		cel.Function(timeSinceFn,
-			cel.Overload(fmt.Sprintf("%s_overload", timeSinceFn),
+		cel.Function(relatedFn,
+			cel.Overload(fmt.Sprintf("%s_string_overload", relatedFn),
+				[]*cel.Type{cel.StringType, cel.StringType, cel.StringType},
+				cel.BoolType,
+				cel.FunctionBinding(unconfiguredRelations),
+			),
+			cel.Overload(fmt.Sprintf("%s_list_overload", relatedFn),
+				[]*cel.Type{cel.StringType, cel.StringType, cel.ListType(cel.StringType)},
+				cel.BoolType,
+				cel.FunctionBinding(unconfiguredRelations),
+			),
+		),
+		cel.Function(TimeSinceFn,
+			cel.Overload(fmt.Sprintf("%s_overload", TimeSinceFn),
 				[]*cel.Type{cel.TimestampType},
 				cel.DurationType,
 				cel.UnaryBinding(callInTimestampOutDuration(time.Now().Sub)),
 			),
-			cel.MemberOverload(fmt.Sprintf("%s_member_overload", timeSinceFn),
+			cel.MemberOverload(fmt.Sprintf("%s_member_overload", TimeSinceFn),
 				[]*cel.Type{cel.TimestampType},
 				cel.DurationType,
 				cel.UnaryBinding(callInTimestampOutDuration(time.Now().Sub)),
@@ -131,6 +152,12 @@ func (clib cerbosLib) CompileOptions() []cel.EnvOption {
 		customtypes.SPIFFEMatchTrustDomainFunc,
 		customtypes.SPIFFETrustDomainFunc,
 	}
+
+	for _, fn := range clib.functions {
+		opts = append(opts, fn.envOption())
+	}
+
+	return opts
 }
 
 func (clib cerbosLib) ProgramOptions() []cel.ProgramOption {
@@ -149,15 +176,17 @@ func Now() NowFunc {
 // providing time-based functions with a static definition of the current time.
 //
 // The given nowFunc must return the same timestamp each time it is called.
+// Comprehensions are interrupted if the context is cancelled, and ErrCostLimitExceeded is returned if
+// the evaluation exceeds a cost limit set using CostLimit.
 //
 // See https://pkg.go.dev/github.com/google/cel-go/cel#Program.ContextEval.
 func ContextEval(ctx context.Context, env *cel.Env, ast *celast.AST, vars any, nowFunc NowFunc, opts ...cel.ProgramOption) (ref.Val, *cel.EvalDetails, error) {
-	programOpts := append([]cel.ProgramOption{cel.CustomDecorator(newTimeDecorator(nowFunc))}, opts...)
+	programOpts := append([]cel.ProgramOption{cel.CustomDecorator(newTimeDecorator(nowFunc)), InterruptCheck()}, opts...)
 	prg, err := env.PlanProgram(ast, programOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
-	return prg.ContextEval(ctx, vars)
+	return ProgramContextEval(ctx, prg, vars)
 }
 
 func newTimeDecorator(nowFunc NowFunc) interpreter.InterpretableDecorator {
@@ -177,9 +206,9 @@ func (t *timeDecorator) decorate(in interpreter.Interpretable) (interpreter.Inte
 
 	funcName := call.Function()
 	switch funcName {
-	case nowFn:
+	case NowFn:
 		return interpreter.NewConstValue(call.ID(), types.DefaultTypeAdapter.NativeToValue(t.nowFunc())), nil
-	case timeSinceFn:
+	case TimeSinceFn:
 		return interpreter.NewCall(call.ID(), funcName, call.OverloadID(), call.Args(), func(values ...ref.Val) ref.Val {
 			if len(values) != 1 {
 				return types.NoSuchOverloadErr()
@@ -0,0 +1,125 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/interpreter"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+const (
+	// interruptCheckFrequency is the number of comprehension iterations between checks for cancellation of the evaluation context.
+	interruptCheckFrequency = 100
+	// unboundedSizeProbe is the input size used to detect whether the cost of an expression depends on the size of its inputs.
+	unboundedSizeProbe = 1 << 20
+)
+
+// ErrCostLimitExceeded is returned when the evaluation of an expression is aborted because its runtime cost exceeded the limit.
+var ErrCostLimitExceeded = errors.New("condition evaluation cost limit exceeded")
+
+// CostLimit returns the program options that abort the evaluation of an expression once its runtime cost exceeds the limit.
+// A limit of zero means that the cost is not tracked.
+func CostLimit(limit uint64) []cel.ProgramOption {
+	if limit == 0 {
+		return nil
+	}
+
+	return []cel.ProgramOption{cel.CostLimit(limit)}
+}
+
+// ProgramContextEval evaluates the program, returning ErrCostLimitExceeded if the evaluation was aborted because of its cost.
+// The program should be created with an interrupt check frequency so that long-running comprehensions honour cancellation of the context.
+func ProgramContextEval(ctx context.Context, prg cel.Program, vars any) (ref.Val, *cel.EvalDetails, error) {
+	val, details, err := prg.ContextEval(ctx, vars)
+	if err != nil {
+		var cancelled interpreter.EvalCancelledError
+		if errors.As(err, &cancelled) && cancelled.Cause == interpreter.CostLimitExceeded {
+			return val, details, ErrCostLimitExceeded
+		}
+	}
+
+	return val, details, err
+}
+
+// InterruptCheck returns the program option that makes comprehensions check for cancellation of the evaluation context.
+func InterruptCheck() cel.ProgramOption {
+	return cel.InterruptCheckFrequency(interruptCheckFrequency)
+}
+
+// CostEstimate is the static estimate of the cost of evaluating an expression.
+type CostEstimate struct {
+	Min uint64
+	Max uint64
+}
+
+// Unbounded returns true if the maximum cost depends on the size of an input that has no known limit.
+func (ce CostEstimate) Unbounded() bool {
+	return ce.Max == math.MaxUint64
+}
+
+// Add returns the estimate of evaluating both expressions.
+func (ce CostEstimate) Add(other CostEstimate) CostEstimate {
+	return CostEstimate{Min: addSaturating(ce.Min, other.Min), Max: addSaturating(ce.Max, other.Max)}
+}
+
+func addSaturating(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+
+	return a + b
+}
+
+// EstimateCost returns the static worst-case cost of evaluating the checked expression.
+// If maxSize is not zero, lists, maps and strings are assumed to contain at most that many elements. Otherwise, the cost of
+// an expression that depends on the size of its inputs is unbounded.
+func EstimateCost(expr *exprpb.CheckedExpr, maxSize uint64) (CostEstimate, error) {
+	if maxSize > 0 {
+		return estimateCost(expr, maxSize)
+	}
+
+	small, err := estimateCost(expr, 1)
+	if err != nil {
+		return small, err
+	}
+
+	large, err := estimateCost(expr, unboundedSizeProbe)
+	if err != nil {
+		return large, err
+	}
+
+	if large.Max != small.Max {
+		small.Max = math.MaxUint64
+	}
+
+	return small, nil
+}
+
+func estimateCost(expr *exprpb.CheckedExpr, maxSize uint64) (CostEstimate, error) {
+	est, err := StdEnv.EstimateCost(cel.CheckedExprToAst(expr), sizeEstimator{maxSize: maxSize})
+	if err != nil {
+		return CostEstimate{}, err
+	}
+
+	return CostEstimate{Min: est.Min, Max: est.Max}, nil
+}
+
+type sizeEstimator struct {
+	maxSize uint64
+}
+
+func (se sizeEstimator) EstimateSize(checker.AstNode) *checker.SizeEstimate {
+	return &checker.SizeEstimate{Min: 0, Max: se.maxSize}
+}
+
+func (sizeEstimator) EstimateCallCost(string, string, *checker.AstNode, []checker.AstNode) *checker.CallEstimate {
+	return nil
+}
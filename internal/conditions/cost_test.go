@@ -0,0 +1,98 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+const nestedComprehension = `xs.exists(a, xs.exists(b, a + b < 0))`
+
+func TestCostLimit(t *testing.T) {
+	env, err := conditions.StdEnv.Extend(cel.Variable("xs", cel.ListType(cel.IntType)))
+	require.NoError(t, err)
+
+	ast, issues := env.Compile(nestedComprehension)
+	require.NoError(t, issues.Err())
+
+	mkVars := func(n int) map[string]any {
+		xs := make([]int64, n)
+		for i := range xs {
+			xs[i] = int64(i)
+		}
+		return map[string]any{"xs": xs}
+	}
+
+	testCases := []struct {
+		name    string
+		size    int
+		limit   uint64
+		wantErr error
+	}{
+		{name: "no_limit", size: 100},
+		{name: "within_limit", size: 10, limit: 10_000},
+		{name: "exceeds_limit", size: 100, limit: 10_000, wantErr: conditions.ErrCostLimitExceeded},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, _, err := conditions.ContextEval(t.Context(), env, ast.NativeRep(), mkVars(tc.size), conditions.Now(), conditions.CostLimit(tc.limit)...)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, types.False, val)
+		})
+	}
+
+	t.Run("precompiled_program", func(t *testing.T) {
+		prg, err := env.Program(ast, append([]cel.ProgramOption{conditions.InterruptCheck()}, conditions.CostLimit(10_000)...)...)
+		require.NoError(t, err)
+
+		_, _, err = conditions.ProgramContextEval(t.Context(), prg, mkVars(100))
+		require.ErrorIs(t, err, conditions.ErrCostLimitExceeded)
+	})
+
+	t.Run("cancelled_context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		_, _, err := conditions.ContextEval(ctx, env, ast.NativeRep(), mkVars(1000), conditions.Now())
+		require.Error(t, err)
+		require.NotErrorIs(t, err, conditions.ErrCostLimitExceeded)
+	})
+}
+
+func TestEstimateCost(t *testing.T) {
+	env, err := conditions.StdEnv.Extend(cel.Variable("xs", cel.ListType(cel.IntType)))
+	require.NoError(t, err)
+
+	ast, issues := env.Compile(nestedComprehension)
+	require.NoError(t, issues.Err())
+
+	checked, err := cel.AstToCheckedExpr(ast)
+	require.NoError(t, err)
+
+	unbounded, err := conditions.EstimateCost(checked, 0)
+	require.NoError(t, err)
+	require.True(t, unbounded.Unbounded())
+
+	small, err := conditions.EstimateCost(checked, 10)
+	require.NoError(t, err)
+	require.False(t, small.Unbounded())
+
+	large, err := conditions.EstimateCost(checked, 100)
+	require.NoError(t, err)
+	require.Greater(t, large.Max, small.Max)
+	require.Equal(t, large.Max, conditions.CostEstimate{}.Add(large).Max)
+}
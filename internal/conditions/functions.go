@@ -0,0 +1,30 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// Function is a custom function that is implemented outside of Cerbos and made available to conditions.
+type Function struct {
+	// Impl is called with arguments of the declared parameter types and must return a value of the result type or an error.
+	Impl   func(args ...ref.Val) ref.Val
+	Result *cel.Type
+	Name   string
+	Params []*cel.Type
+}
+
+func (fn Function) envOption() cel.EnvOption {
+	return cel.Function(fn.Name,
+		cel.Overload(fmt.Sprintf("%s_custom_overload", fn.Name),
+			fn.Params,
+			fn.Result,
+			cel.FunctionBinding(fn.Impl),
+		),
+	)
+}
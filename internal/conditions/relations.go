@@ -0,0 +1,103 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/interpreter"
+)
+
+var errRelationsNotConfigured = errors.New("relationship provider is not configured")
+
+// RelationChecker determines whether a subject has a relation with an object.
+type RelationChecker interface {
+	Related(ctx context.Context, subject, relation, object string) (bool, error)
+}
+
+func unconfiguredRelations(...ref.Val) ref.Val {
+	return types.WrapErr(errRelationsNotConfigured)
+}
+
+// WithRelations returns a program option that evaluates calls to the `related` function using the given checker.
+// If the checker is nil, calls to `related` produce an error.
+func WithRelations(ctx context.Context, checker RelationChecker) cel.ProgramOption {
+	return cel.CustomDecorator(func(in interpreter.Interpretable) (interpreter.Interpretable, error) {
+		call, ok := in.(interpreter.InterpretableCall)
+		if !ok || call.Function() != relatedFn || checker == nil {
+			return in, nil
+		}
+
+		return interpreter.NewCall(call.ID(), call.Function(), call.OverloadID(), call.Args(), func(values ...ref.Val) ref.Val {
+			return related(ctx, checker, values)
+		}), nil
+	})
+}
+
+// OpaqueRelations returns a program option that treats calls to the `related` function as unknown during partial evaluation,
+// so that they are retained in the residual expression with their arguments evaluated as far as possible.
+func OpaqueRelations() cel.ProgramOption {
+	return cel.CustomDecorator(func(in interpreter.Interpretable) (interpreter.Interpretable, error) {
+		call, ok := in.(interpreter.InterpretableCall)
+		if !ok || call.Function() != relatedFn {
+			return in, nil
+		}
+
+		id := call.ID()
+		return interpreter.NewCall(id, call.Function(), call.OverloadID(), call.Args(), func(...ref.Val) ref.Val {
+			return types.NewUnknown(id, nil)
+		}), nil
+	})
+}
+
+func related(ctx context.Context, checker RelationChecker, values []ref.Val) ref.Val {
+	if len(values) != 3 { //nolint:mnd
+		return types.NoSuchOverloadErr()
+	}
+
+	subject, ok := values[0].(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(values[0])
+	}
+
+	relation, ok := values[1].(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(values[1])
+	}
+
+	switch object := values[2].(type) {
+	case types.String:
+		return checkRelated(ctx, checker, string(subject), string(relation), string(object))
+	case traits.Lister:
+		// The subject is related if it has the relation with any of the objects in the list.
+		for it := object.Iterator(); it.HasNext() == types.True; {
+			item := it.Next()
+			o, ok := item.(types.String)
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(item)
+			}
+
+			if result := checkRelated(ctx, checker, string(subject), string(relation), string(o)); result != types.False {
+				return result
+			}
+		}
+		return types.False
+	default:
+		return types.MaybeNoSuchOverloadErr(object)
+	}
+}
+
+func checkRelated(ctx context.Context, checker RelationChecker, subject, relation, object string) ref.Val {
+	ok, err := checker.Related(ctx, subject, relation, object)
+	if err != nil {
+		return types.WrapErr(err)
+	}
+
+	return types.Bool(ok)
+}
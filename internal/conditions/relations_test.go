@@ -0,0 +1,89 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+type relationTuples map[[3]string]struct{}
+
+func (rt relationTuples) Related(_ context.Context, subject, relation, object string) (bool, error) {
+	_, ok := rt[[3]string{subject, relation, object}]
+	return ok, nil
+}
+
+func TestRelated(t *testing.T) {
+	checker := relationTuples{
+		{"alice", "editor", "folder:1"}: {},
+		{"bob", "viewer", "folder:2"}:   {},
+	}
+
+	testCases := []struct {
+		expr    string
+		want    bool
+		wantErr bool
+	}{
+		{expr: `related("alice", "editor", "folder:1")`, want: true},
+		{expr: `related("alice", "viewer", "folder:1")`, want: false},
+		{expr: `related("bob", "editor", "folder:1")`, want: false},
+		{expr: `related("bob", "viewer", ["folder:1", "folder:2"])`, want: true},
+		{expr: `related("bob", "viewer", ["folder:1", "folder:3"])`, want: false},
+		{expr: `related("bob", "viewer", [])`, want: false},
+		{expr: `related("alice", "editor", dyn(1))`, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			ast, issues := conditions.StdEnv.Compile(tc.expr)
+			require.NoError(t, issues.Err())
+
+			val, _, err := conditions.ContextEval(t.Context(), conditions.StdEnv, ast.NativeRep(), cel.NoVars(), conditions.Now(), conditions.WithRelations(t.Context(), checker))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, types.Bool(tc.want), val)
+		})
+	}
+
+	t.Run("not_configured", func(t *testing.T) {
+		ast, issues := conditions.StdEnv.Compile(`related("alice", "editor", "folder:1")`)
+		require.NoError(t, issues.Err())
+
+		_, _, err := conditions.ContextEval(t.Context(), conditions.StdEnv, ast.NativeRep(), cel.NoVars(), conditions.Now(), conditions.WithRelations(t.Context(), nil))
+		require.ErrorContains(t, err, "relationship provider is not configured")
+	})
+}
+
+func TestOpaqueRelations(t *testing.T) {
+	env, err := conditions.StdEnv.Extend(cel.Variable("folder", cel.StringType))
+	require.NoError(t, err)
+
+	ast, issues := env.Compile(`"alice" + "" == "alice" && related("alice" + "", "editor", folder)`)
+	require.NoError(t, issues.Err())
+
+	vars, err := cel.PartialVars(map[string]any{"folder": "folder:1"})
+	require.NoError(t, err)
+
+	val, details, err := conditions.ContextEval(t.Context(), env, ast.NativeRep(), vars, conditions.Now(), cel.EvalOptions(cel.OptPartialEval, cel.OptTrackState), conditions.OpaqueRelations())
+	require.NoError(t, err)
+	require.True(t, types.IsUnknown(val))
+
+	residual, err := env.ResidualAst(ast, details)
+	require.NoError(t, err)
+
+	got, err := cel.AstToString(residual)
+	require.NoError(t, err)
+	require.Equal(t, `related("alice", "editor", "folder:1")`, got)
+}
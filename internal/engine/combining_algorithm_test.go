@@ -0,0 +1,386 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/engine/tracer"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/policy"
+)
+
+const (
+	combiningRootPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  rules:
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+	combiningScopedPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  scope: acme
+  rules:
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_DENY
+      condition:
+        match:
+          expr: R.attr.locked
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: R.attr.public
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_DENY
+      condition:
+        match:
+          expr: R.attr.archived
+`
+)
+
+func TestCombiningAlgorithms(t *testing.T) {
+	type attrs struct{ locked, public, archived bool }
+	testCases := []struct {
+		attrs attrs
+		want  map[policyv1.CombiningAlgorithm]effectv1.Effect
+	}{
+		{
+			// nothing matches in the scope, so the parent scope decides
+			attrs: attrs{},
+			want: map[policyv1.CombiningAlgorithm]effectv1.Effect{
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES:     effectv1.Effect_EFFECT_ALLOW,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES:   effectv1.Effect_EFFECT_ALLOW,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE:   effectv1.Effect_EFFECT_ALLOW,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT: effectv1.Effect_EFFECT_ALLOW,
+			},
+		},
+		{
+			attrs: attrs{locked: true},
+			want: map[policyv1.CombiningAlgorithm]effectv1.Effect{
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES:     effectv1.Effect_EFFECT_DENY,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES:   effectv1.Effect_EFFECT_DENY,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE:   effectv1.Effect_EFFECT_DENY,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT: effectv1.Effect_EFFECT_DENY,
+			},
+		},
+		{
+			attrs: attrs{locked: true, public: true},
+			want: map[policyv1.CombiningAlgorithm]effectv1.Effect{
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES:     effectv1.Effect_EFFECT_DENY,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES:   effectv1.Effect_EFFECT_ALLOW,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE:   effectv1.Effect_EFFECT_DENY,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT: effectv1.Effect_EFFECT_ALLOW,
+			},
+		},
+		{
+			attrs: attrs{public: true, archived: true},
+			want: map[policyv1.CombiningAlgorithm]effectv1.Effect{
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES:     effectv1.Effect_EFFECT_DENY,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES:   effectv1.Effect_EFFECT_ALLOW,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE:   effectv1.Effect_EFFECT_ALLOW,
+				policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT: effectv1.Effect_EFFECT_ALLOW,
+			},
+		},
+	}
+
+	mkInput := func(a attrs) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "1",
+			Resource: &enginev1.Resource{
+				Kind:  "document",
+				Id:    "1",
+				Scope: "acme",
+				Attr: map[string]*structpb.Value{
+					"locked":   structpb.NewBoolValue(a.locked),
+					"public":   structpb.NewBoolValue(a.public),
+					"archived": structpb.NewBoolValue(a.archived),
+				},
+			},
+			Principal: &enginev1.Principal{Id: "sam", Roles: []string{"user"}},
+			Actions:   []string{"view"},
+		}
+	}
+
+	mkPlanInput := func() *enginev1.PlanResourcesInput {
+		return &enginev1.PlanResourcesInput{
+			RequestId: "1",
+			Action:    "view",
+			Actions:   []string{"view"},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "document", Scope: "acme"},
+			Principal: &enginev1.Principal{Id: "sam", Roles: []string{"user"}},
+		}
+	}
+
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+
+	for _, algorithm := range []policyv1.CombiningAlgorithm{
+		policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES,
+		policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES,
+		policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE,
+		policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT,
+	} {
+		t.Run(evaluator.CombiningAlgorithmName(algorithm), func(t *testing.T) {
+			eng, ms := mkMemEngine(t, conf)
+
+			scoped := readPolicy(t, combiningScopedPolicy)
+			scoped.GetResourcePolicy().CombiningAlgorithm = algorithm
+			ms.addOrUpdatePolicy(t, "resource_policies/document.yaml", readPolicy(t, combiningRootPolicy))
+			ms.addOrUpdatePolicy(t, "resource_policies/document_acme.yaml", scoped)
+			waitForAllow(t, eng, mkInput(attrs{public: true}))
+
+			for _, tc := range testCases {
+				input := mkInput(tc.attrs)
+				want := tc.want[algorithm]
+
+				traceCollector := tracer.NewCollector()
+				outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{input}, evaluator.WithTraceSink(traceCollector))
+				require.NoError(t, err)
+				require.Len(t, outputs, 1)
+				require.Equal(t, want, outputs[0].Actions["view"].GetEffect(), "attributes %+v", tc.attrs)
+
+				if algorithm != policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES && (want == effectv1.Effect_EFFECT_DENY || tc.attrs.public) {
+					requireAppliedEffectMessage(t, traceCollector, "Decided by the "+evaluator.CombiningAlgorithmName(algorithm)+" combining algorithm")
+				}
+
+				// The planner resolves a scope as soon as it has rules for the action, so it doesn't model the fall through
+				// to the parent scope that happens when none of the conditions are satisfied.
+				if tc.attrs == (attrs{}) && want == effectv1.Effect_EFFECT_ALLOW {
+					continue
+				}
+
+				// the query plan must agree with the check result
+				plan, err := eng.Plan(t.Context(), mkPlanInput())
+				require.NoError(t, err)
+				planned, err := evalPlanFilter(plan.Filter, input)
+				require.NoError(t, err)
+				require.Equal(t, want == effectv1.Effect_EFFECT_ALLOW, planned, "attributes %+v: filter %s", tc.attrs, plan.FilterDebug)
+			}
+		})
+	}
+
+	t.Run("deny_unless_permit_without_parent_allow", func(t *testing.T) {
+		eng, ms := mkMemEngine(t, conf)
+
+		root := readPolicy(t, combiningRootPolicy)
+		root.GetResourcePolicy().Rules[0].Actions = []string{"edit"}
+		scoped := readPolicy(t, combiningScopedPolicy)
+		scoped.GetResourcePolicy().CombiningAlgorithm = policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT
+		ms.addOrUpdatePolicy(t, "resource_policies/document.yaml", root)
+		ms.addOrUpdatePolicy(t, "resource_policies/document_acme.yaml", scoped)
+		waitForAllow(t, eng, mkInput(attrs{public: true}))
+
+		// none of the scopes allow the action, so the policy denies it
+		traceCollector := tracer.NewCollector()
+		outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{mkInput(attrs{})}, evaluator.WithTraceSink(traceCollector))
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, outputs[0].Actions["view"].GetEffect())
+		require.Equal(t, "resource.document.vdefault/acme", outputs[0].Actions["view"].GetPolicy())
+		requireAppliedEffectMessage(t, traceCollector, "Decided by the denyUnlessPermit combining algorithm")
+
+		plan, err := eng.Plan(t.Context(), mkPlanInput())
+		require.NoError(t, err)
+		planned, err := evalPlanFilter(plan.Filter, mkInput(attrs{}))
+		require.NoError(t, err)
+		require.False(t, planned, "filter %s", plan.FilterDebug)
+	})
+
+	t.Run("default_from_conf", func(t *testing.T) {
+		require.NoError(t, config.LoadMap(map[string]any{
+			"engine": map[string]any{"defaultCombiningAlgorithm": "permitOverrides"},
+		}))
+		t.Cleanup(func() { _ = config.LoadMap(map[string]any{}) })
+
+		eng, ms := mkMemEngine(t, conf)
+		ms.addOrUpdatePolicy(t, "resource_policies/document.yaml", readPolicy(t, combiningRootPolicy))
+		ms.addOrUpdatePolicy(t, "resource_policies/document_acme.yaml", readPolicy(t, combiningScopedPolicy))
+
+		// permitOverrides lets the ALLOW override the DENY for a locked document
+		waitForAllow(t, eng, mkInput(attrs{locked: true, public: true}))
+	})
+}
+
+func waitForAllow(t *testing.T, eng *Engine, input *enginev1.CheckInput) {
+	t.Helper()
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{input})
+		require.NoError(c, err)
+		require.Len(c, outputs, 1)
+		require.Equal(c, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions[input.Actions[0]].GetEffect())
+	}, 1*time.Second, 50*time.Millisecond)
+}
+
+func readPolicy(t *testing.T, src string) *policyv1.Policy {
+	t.Helper()
+
+	p, err := policy.ReadPolicy(strings.NewReader(src))
+	require.NoError(t, err)
+
+	return p
+}
+
+func requireAppliedEffectMessage(t *testing.T, traceCollector *tracer.Collector, wantMessage string) {
+	t.Helper()
+
+	for _, trace := range traceCollector.Traces() {
+		if trace.Event.GetEffect() != effectv1.Effect_EFFECT_UNSPECIFIED && trace.Event.GetMessage() == wantMessage {
+			return
+		}
+	}
+
+	t.Fatalf("No applied effect with message %q in trace", wantMessage)
+}
+
+// evalPlanFilter evaluates the boolean operators of a query plan filter against the attributes of a check input.
+func evalPlanFilter(filter *enginev1.PlanResourcesFilter, input *enginev1.CheckInput) (bool, error) {
+	switch filter.Kind {
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED:
+		return true, nil
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED:
+		return false, nil
+	default:
+		v, err := evalPlanOperand(filter.Condition, input, nil)
+		if err != nil {
+			return false, err
+		}
+
+		b, ok := v.(bool)
+		if !ok {
+			return false, fmt.Errorf("filter evaluated to non-boolean value %v", v)
+		}
+
+		return b, nil
+	}
+}
+
+func evalPlanOperand(operand *enginev1.PlanResourcesFilter_Expression_Operand, input *enginev1.CheckInput, vars map[string]any) (any, error) {
+	switch op := operand.Node.(type) {
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Value:
+		return op.Value.AsInterface(), nil
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Variable:
+		return evalPlanVariable(op.Variable, input, vars)
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Expression:
+		if op.Expression.Operator == "exists" {
+			return evalPlanExists(op.Expression, input, vars)
+		}
+
+		args := make([]any, len(op.Expression.Operands))
+		for i, o := range op.Expression.Operands {
+			v, err := evalPlanOperand(o, input, vars)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+
+		switch op.Expression.Operator {
+		case "and":
+			return !slices.Contains(args, any(false)), nil
+		case "or":
+			return slices.Contains(args, any(true)), nil
+		case "not":
+			return args[0] != true, nil
+		case "eq":
+			return args[0] == args[1], nil
+		case "in":
+			list, ok := args[1].([]any)
+			return ok && slices.Contains(list, args[0]), nil
+		case "lt":
+			a, aOK := args[0].(float64)
+			b, bOK := args[1].(float64)
+			return aOK && bOK && a < b, nil
+		default:
+			return nil, fmt.Errorf("unsupported operator %q", op.Expression.Operator)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported operand %T", op)
+	}
+}
+
+func evalPlanVariable(name string, input *enginev1.CheckInput, vars map[string]any) (any, error) {
+	if v, ok := vars[name]; ok {
+		return v, nil
+	}
+
+	if attr, ok := strings.CutPrefix(name, "request.resource.attr."); ok {
+		return input.Resource.Attr[attr].AsInterface(), nil
+	}
+
+	if attr, ok := strings.CutPrefix(name, "request.principal.attr."); ok {
+		return input.Principal.Attr[attr].AsInterface(), nil
+	}
+
+	switch name {
+	case "request.principal.id":
+		return input.Principal.Id, nil
+	case "request.principal.roles":
+		roles := make([]any, len(input.Principal.Roles))
+		for i, r := range input.Principal.Roles {
+			roles[i] = r
+		}
+		return roles, nil
+	default:
+		return nil, fmt.Errorf("unsupported variable %q", name)
+	}
+}
+
+// evalPlanExists evaluates an exists expression, whose operands are a list and a lambda with a body and a variable name.
+func evalPlanExists(expr *enginev1.PlanResourcesFilter_Expression, input *enginev1.CheckInput, vars map[string]any) (any, error) {
+	v, err := evalPlanOperand(expr.Operands[0], input, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("exists applied to non-list value %v", v)
+	}
+
+	lambda := expr.Operands[1].GetExpression()
+	body, name := lambda.GetOperands()[0], lambda.GetOperands()[1].GetVariable()
+	for _, elem := range list {
+		elemVars := maps.Clone(vars)
+		if elemVars == nil {
+			elemVars = make(map[string]any)
+		}
+		elemVars[name] = elem
+
+		b, err := evalPlanOperand(body, input, elemVars)
+		if err != nil {
+			return nil, err
+		}
+		if b == true {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
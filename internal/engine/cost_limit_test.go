@@ -0,0 +1,111 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/engine/tracer"
+	"github.com/cerbos/cerbos/internal/evaluator"
+)
+
+const costLimitPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: report
+  version: default
+  rules:
+    - name: everyone
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+    - name: negative-pairs
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_DENY
+      condition:
+        match:
+          expr: R.attr.cells.exists(a, R.attr.cells.exists(b, a + b < 0))
+`
+
+func TestConditionCostLimit(t *testing.T) {
+	mkInput := func(numCells int) *enginev1.CheckInput {
+		cells := make([]any, numCells)
+		for i := range cells {
+			cells[i] = float64(i)
+		}
+
+		list, err := structpb.NewList(cells)
+		require.NoError(t, err)
+
+		return &enginev1.CheckInput{
+			RequestId: "1",
+			Resource: &enginev1.Resource{
+				Kind: "report",
+				Id:   "1",
+				Attr: map[string]*structpb.Value{"cells": structpb.NewListValue(list)},
+			},
+			Principal: &enginev1.Principal{Id: "sam", Roles: []string{"user"}},
+			Actions:   []string{"view"},
+		}
+	}
+
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+
+	testCases := []struct {
+		name        string
+		engineLimit uint64
+		policyLimit uint32
+		wantDeny    bool
+	}{
+		{name: "no_limit"},
+		{name: "engine_limit", engineLimit: 10_000, wantDeny: true},
+		{name: "policy_limit", policyLimit: 10_000, wantDeny: true},
+		{name: "policy_overrides_engine_limit", engineLimit: 10_000, policyLimit: 1_000_000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, config.LoadMap(map[string]any{
+				"engine": map[string]any{"conditionCostLimit": tc.engineLimit},
+			}))
+			t.Cleanup(func() { _ = config.LoadMap(map[string]any{}) })
+
+			eng, ms := mkMemEngine(t, conf)
+			p := readPolicy(t, costLimitPolicy)
+			p.GetResourcePolicy().ConditionCostLimit = tc.policyLimit
+			ms.addOrUpdatePolicy(t, "resource_policies/report.yaml", p)
+
+			// small inputs are always within the limit
+			waitForAllow(t, eng, mkInput(3))
+
+			traceCollector := tracer.NewCollector()
+			outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{mkInput(200)}, evaluator.WithTraceSink(traceCollector))
+			require.NoError(t, err)
+			require.Len(t, outputs, 1)
+
+			if !tc.wantDeny {
+				require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["view"].GetEffect())
+				require.Empty(t, outputs[0].ValidationErrors)
+				return
+			}
+
+			require.Equal(t, effectv1.Effect_EFFECT_DENY, outputs[0].Actions["view"].GetEffect())
+			require.Equal(t, "resource.report.vdefault", outputs[0].Actions["view"].GetPolicy())
+			require.Len(t, outputs[0].ValidationErrors, 1)
+			require.Equal(t, schemav1.ValidationError_SOURCE_POLICY, outputs[0].ValidationErrors[0].Source)
+			require.Equal(t, "resource.report.vdefault#negative-pairs", outputs[0].ValidationErrors[0].Path)
+			requireAppliedEffectMessage(t, traceCollector, "Condition evaluation cost limit exceeded")
+		})
+	}
+}
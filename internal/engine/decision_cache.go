@@ -0,0 +1,197 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/cache"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// decisionCacheIgnoreFields are the fields of the check input that don't influence the decision.
+var decisionCacheIgnoreFields = map[string]struct{}{
+	"cerbos.engine.v1.CheckInput.request_id": {},
+}
+
+// decisionCache caches check outputs keyed by a hash of the input and the evaluation parameters.
+//
+// Rather than tracking which cache entries were produced by which policies, the cache maintains generation counters
+// for each resource kind and principal ID (plus a global one) and mixes them into the cache key.
+// Invalidating a set of decisions is then just a matter of bumping the relevant counters: stale entries become
+// unreachable and are eventually evicted by the LRU policy or the TTL.
+type decisionCache struct {
+	entries *cache.Cache[uint64, *decisionCacheEntry]
+	*cacheGenerations
+	ttl time.Duration
+}
+
+// cacheGenerations holds the generation counters used to invalidate cache entries without tracking their dependencies.
+type cacheGenerations struct {
+	resources  map[string]uint64
+	principals map[string]uint64
+	global     uint64
+	mu         sync.RWMutex
+}
+
+type decisionCacheEntry struct {
+	output *enginev1.CheckOutput
+	trail  *auditv1.AuditTrail
+}
+
+// decisionCacheParams holds the hash of the evaluation parameters shared by all inputs of a check request.
+type decisionCacheParams struct {
+	hash uint64
+}
+
+func newDecisionCache(conf evaluator.DecisionCacheConf) *decisionCache {
+	return &decisionCache{
+		entries:          cache.New[uint64, *decisionCacheEntry]("decision", conf.Size),
+		cacheGenerations: newCacheGenerations(),
+		ttl:              conf.TTL,
+	}
+}
+
+func newCacheGenerations() *cacheGenerations {
+	return &cacheGenerations{
+		resources:  make(map[string]uint64),
+		principals: make(map[string]uint64),
+	}
+}
+
+// params computes the cache parameters for a check request.
+// It returns nil if the request options make the decisions unsuitable for caching.
+func (dc *decisionCache) params(checkOpts *evaluator.CheckOptions) *decisionCacheParams {
+	if dc == nil {
+		return nil
+	}
+
+	// traces are only produced by an actual evaluation
+	if checkOpts.TracerSink != nil && checkOpts.TracerSink.Enabled() {
+		return nil
+	}
+
+	hash, ok := cacheParamsHash(checkOpts)
+	if !ok {
+		return nil
+	}
+
+	return &decisionCacheParams{hash: hash}
+}
+
+// cacheParamsHash hashes the evaluation parameters that apply to every input of a request.
+func cacheParamsHash(checkOpts *evaluator.CheckOptions) (uint64, bool) {
+	d := xxhash.New()
+	if globals := checkOpts.Globals(); len(globals) > 0 {
+		g, err := structpb.NewStruct(globals)
+		if err != nil {
+			return 0, false
+		}
+
+		gBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(g)
+		if err != nil {
+			return 0, false
+		}
+
+		_, _ = d.Write(gBytes)
+	}
+
+	_, _ = d.WriteString(checkOpts.DefaultPolicyVersion())
+	if checkOpts.LenientScopeSearch() {
+		_, _ = d.Write([]byte{1})
+	}
+
+	return d.Sum64(), true
+}
+
+func (dc *decisionCache) key(input *enginev1.CheckInput, params *decisionCacheParams) uint64 {
+	return dc.mix(util.HashPB(input, decisionCacheIgnoreFields), params.hash, input.GetResource().GetKind(), input.GetPrincipal().GetId())
+}
+
+// mix combines the input and parameter hashes with the current generations of the resource kind and principal ID.
+func (g *cacheGenerations) mix(inputHash, paramsHash uint64, resourceKind, principalID string) uint64 {
+	g.mu.RLock()
+	generation := g.global
+	resourceGeneration := g.resources[namer.SanitizedResource(resourceKind)]
+	principalGeneration := g.principals[principalID]
+	g.mu.RUnlock()
+
+	var buf [40]byte
+	binary.LittleEndian.PutUint64(buf[0:], inputHash)
+	binary.LittleEndian.PutUint64(buf[8:], paramsHash)
+	binary.LittleEndian.PutUint64(buf[16:], generation)
+	binary.LittleEndian.PutUint64(buf[24:], resourceGeneration)
+	binary.LittleEndian.PutUint64(buf[32:], principalGeneration)
+
+	return xxhash.Sum64(buf[:])
+}
+
+func (dc *decisionCache) get(key uint64, input *enginev1.CheckInput) (*enginev1.CheckOutput, *auditv1.AuditTrail, bool) {
+	entry, ok := dc.entries.Get(key)
+	if !ok {
+		return nil, nil, false
+	}
+
+	// callers are free to modify the output and the trail, so we always hand out copies
+	output := proto.Clone(entry.output).(*enginev1.CheckOutput) //nolint:forcetypeassert
+	output.RequestId = input.RequestId
+
+	var trail *auditv1.AuditTrail
+	if entry.trail != nil {
+		trail = proto.Clone(entry.trail).(*auditv1.AuditTrail) //nolint:forcetypeassert
+	}
+
+	return output, trail, true
+}
+
+func (dc *decisionCache) set(key uint64, output *enginev1.CheckOutput, trail *auditv1.AuditTrail) {
+	entry := &decisionCacheEntry{output: proto.Clone(output).(*enginev1.CheckOutput)} //nolint:forcetypeassert
+	if trail != nil {
+		entry.trail = proto.Clone(trail).(*auditv1.AuditTrail) //nolint:forcetypeassert
+	}
+
+	dc.entries.SetWithExpire(key, entry, dc.ttl)
+}
+
+// invalidate makes the decisions affected by the rule table change unreachable.
+func (dc *decisionCache) invalidate(change ruletable.Change) {
+	if dc.bump(change) {
+		dc.entries.Purge()
+	}
+}
+
+// bump increments the generation counters affected by the rule table change.
+// It returns true if the change affects every cache entry.
+func (g *cacheGenerations) bump(change ruletable.Change) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if change.All {
+		g.global++
+		clear(g.resources)
+		clear(g.principals)
+		return true
+	}
+
+	for _, resource := range change.Resources {
+		g.resources[resource]++
+	}
+
+	for _, principal := range change.Principals {
+		g.principals[principal]++
+	}
+
+	return false
+}
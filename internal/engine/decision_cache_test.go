@@ -0,0 +1,180 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/relations"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/index"
+)
+
+func TestDecisionCache(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(t.Context())
+	defer cancelFunc()
+
+	memFsys := afero.NewMemMapFs()
+	idx, err := index.Build(ctx, afero.NewIOFS(memFsys))
+	require.NoError(t, err)
+
+	store := disk.NewFromIndexWithConf(idx, &disk.Conf{})
+	store.SubscriptionManager = storage.NewSubscriptionManager(ctx)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+
+	compiler, err := compile.NewManager(ctx, store)
+	require.NoError(t, err)
+
+	ruletableMgr, err := ruletable.NewRuleTableManager(ruletable.NewProtoRuletable(), compiler, store, schemaMgr)
+	require.NoError(t, err)
+	store.Subscribe(ruletableMgr)
+
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+	conf.DecisionCache.Enabled = true
+
+	eng := NewFromConf(ctx, conf, Components{
+		PolicyLoader:     compiler,
+		RuleTableManager: ruletableMgr,
+		SchemaMgr:        schemaMgr,
+		AuditLog:         audit.NewNopLog(),
+	})
+	require.NotNil(t, eng.decisionCache)
+
+	mkInput := func(kind string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "1",
+			Resource:  &enginev1.Resource{Kind: kind, Id: "1"},
+			Principal: &enginev1.Principal{Id: "sam", Roles: []string{"user"}},
+			Actions:   []string{"throw"},
+		}
+	}
+
+	isCached := func(t *testing.T, input *enginev1.CheckInput) bool {
+		t.Helper()
+
+		params := eng.decisionCache.params(evaluator.NewCheckOptions(ctx, conf))
+		require.NotNil(t, params)
+
+		return eng.decisionCache.entries.Has(eng.decisionCache.key(input, params))
+	}
+
+	requireEffect := func(t *testing.T, input *enginev1.CheckInput, want effectv1.Effect) {
+		t.Helper()
+
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			outputs, err := eng.Check(ctx, []*enginev1.CheckInput{input})
+			require.NoError(c, err)
+			require.Len(c, outputs, 1)
+			require.Equal(c, want, outputs[0].Actions["throw"].GetEffect())
+		}, 1*time.Second, 50*time.Millisecond)
+	}
+
+	addOrUpdatePolicy(t, "resource_policies/rock.yaml", mkResourcePolicy("rock", effectv1.Effect_EFFECT_ALLOW, ""), memFsys, idx, store)
+	addOrUpdatePolicy(t, "resource_policies/paper.yaml", mkResourcePolicy("paper", effectv1.Effect_EFFECT_ALLOW, ""), memFsys, idx, store)
+
+	rock := mkInput("rock")
+	paper := mkInput("paper")
+
+	t.Run("caches_decisions", func(t *testing.T) {
+		requireEffect(t, rock, effectv1.Effect_EFFECT_ALLOW)
+		requireEffect(t, paper, effectv1.Effect_EFFECT_ALLOW)
+		require.True(t, isCached(t, rock))
+		require.True(t, isCached(t, paper))
+
+		otherRequest := mkInput("rock")
+		otherRequest.RequestId = "2"
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{otherRequest})
+		require.NoError(t, err)
+		require.Equal(t, "2", outputs[0].RequestId)
+	})
+
+	t.Run("invalidates_affected_decisions", func(t *testing.T) {
+		addOrUpdatePolicy(t, "resource_policies/rock.yaml", mkResourcePolicy("rock", effectv1.Effect_EFFECT_DENY, ""), memFsys, idx, store)
+
+		requireEffect(t, rock, effectv1.Effect_EFFECT_DENY)
+		require.True(t, isCached(t, paper))
+	})
+
+	t.Run("skips_time_dependent_decisions", func(t *testing.T) {
+		addOrUpdatePolicy(t, "resource_policies/rock.yaml", mkResourcePolicy("rock", effectv1.Effect_EFFECT_ALLOW, `now() > timestamp("2000-01-01T00:00:00Z")`), memFsys, idx, store)
+
+		requireEffect(t, rock, effectv1.Effect_EFFECT_ALLOW)
+		require.False(t, isCached(t, rock))
+	})
+
+	t.Run("skips_relationship_dependent_decisions", func(t *testing.T) {
+		eng.relations = relations.NewMemoryProvider([]relations.Tuple{{Subject: "sam", Relation: "owner", Object: "scissors:1"}})
+		t.Cleanup(func() { eng.relations = nil })
+
+		addOrUpdatePolicy(t, "resource_policies/scissors.yaml", mkResourcePolicy("scissors", effectv1.Effect_EFFECT_ALLOW, `related(P.id, "owner", R.kind + ":" + R.id)`), memFsys, idx, store)
+
+		scissors := mkInput("scissors")
+		requireEffect(t, scissors, effectv1.Effect_EFFECT_ALLOW)
+		require.False(t, isCached(t, scissors))
+	})
+}
+
+func mkResourcePolicy(resource string, effect effectv1.Effect, condition string) *policyv1.Policy {
+	rule := &policyv1.ResourceRule{
+		Actions: []string{"throw"},
+		Roles:   []string{"user"},
+		Effect:  effect,
+	}
+
+	if condition != "" {
+		rule.Condition = &policyv1.Condition{
+			Condition: &policyv1.Condition_Match{
+				Match: &policyv1.Match{
+					Op: &policyv1.Match_Expr{Expr: condition},
+				},
+			},
+		}
+	}
+
+	return &policyv1.Policy{
+		ApiVersion: "api.cerbos.dev/v1",
+		PolicyType: &policyv1.Policy_ResourcePolicy{
+			ResourcePolicy: &policyv1.ResourcePolicy{
+				Resource: resource,
+				Version:  "default",
+				Rules:    []*policyv1.ResourceRule{rule},
+			},
+		},
+	}
+}
+
+func addOrUpdatePolicy(t *testing.T, f string, p *policyv1.Policy, memFsys afero.Fs, idx index.Index, store *disk.Store) {
+	t.Helper()
+
+	var s bytes.Buffer
+	require.NoError(t, policy.WritePolicy(&s, p))
+
+	require.NoError(t, afero.WriteFile(memFsys, f, s.Bytes(), fs.ModeAppend))
+
+	evt, err := idx.AddOrUpdate(index.Entry{File: f, Policy: policy.Wrap(p)})
+	require.NoError(t, err)
+
+	store.NotifySubscribers(evt)
+}
@@ -20,12 +20,14 @@ import (
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/conditions"
 	"github.com/cerbos/cerbos/internal/engine/policyloader"
 	"github.com/cerbos/cerbos/internal/engine/tracer"
 	"github.com/cerbos/cerbos/internal/evaluator"
 	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/relations"
 	"github.com/cerbos/cerbos/internal/ruletable"
 	"github.com/cerbos/cerbos/internal/schema"
 )
@@ -54,6 +56,10 @@ type Engine struct {
 	ruleTableManager  *ruletable.Manager
 	conf              *evaluator.Conf
 	metadataExtractor audit.MetadataExtractor
+	decisionCache     *decisionCache
+	planCache         *planCache
+	shadow            *shadow
+	relations         relations.Provider
 	workerPool        []chan<- workIn
 	workerIndex       uint64
 }
@@ -64,6 +70,8 @@ type Components struct {
 	RuleTableManager  *ruletable.Manager
 	SchemaMgr         schema.Manager
 	MetadataExtractor audit.MetadataExtractor
+	Shadow            *ShadowComponents
+	Relations         relations.Provider
 }
 
 func New(ctx context.Context, components Components) (*Engine, error) {
@@ -101,14 +109,31 @@ func NewEphemeral(conf *evaluator.Conf, rtMgr *ruletable.Manager, schemaMgr sche
 }
 
 func newEngine(conf *evaluator.Conf, c Components) *Engine {
-	return &Engine{
+	engine := &Engine{
 		conf:              conf,
 		policyLoader:      c.PolicyLoader,
 		ruleTableManager:  c.RuleTableManager,
 		schemaMgr:         c.SchemaMgr,
 		auditLog:          c.AuditLog,
 		metadataExtractor: c.MetadataExtractor,
+		relations:         c.Relations,
 	}
+
+	if conf.DecisionCache.Enabled && c.RuleTableManager != nil {
+		engine.decisionCache = newDecisionCache(conf.DecisionCache)
+		c.RuleTableManager.AddChangeListener(engine.decisionCache.invalidate)
+	}
+
+	if conf.PlanCache.Enabled && c.RuleTableManager != nil {
+		engine.planCache = newPlanCache(conf.PlanCache)
+		c.RuleTableManager.AddChangeListener(engine.planCache.invalidate)
+	}
+
+	if conf.Shadow.Enabled && c.Shadow != nil {
+		engine.shadow = newShadow(conf, c.Shadow, c.SchemaMgr)
+	}
+
+	return engine
 }
 
 func (engine *Engine) startWorker(ctx context.Context, num int, inputChan <-chan workIn) {
@@ -125,7 +150,13 @@ func (engine *Engine) startWorker(ctx context.Context, num int, inputChan <-chan
 				return
 			}
 
-			result, trail, err := engine.evaluate(work.ctx, work.input, work.checkOpts)
+			if work.planInput != nil {
+				planResult, trail, err := engine.doPlan(work.ctx, work.planInput, work.checkOpts)
+				work.out <- workOut{index: work.index, planResult: planResult, trail: trail, err: err}
+				continue
+			}
+
+			result, trail, err := engine.evaluate(work.ctx, work.input, work.checkOpts, work.cacheParams)
 			work.out <- workOut{index: work.index, result: result, trail: trail, err: err}
 		}
 	}
@@ -165,6 +196,79 @@ func (engine *Engine) Plan(ctx context.Context, input *enginev1.PlanResourcesInp
 	return engine.logPlanDecision(ctx, input, output, err, trail)
 }
 
+// PlanBatch produces a query plan for each of the inputs and writes a single decision log entry for all of them.
+// The inputs are planned concurrently on the worker pool if it is available.
+func (engine *Engine) PlanBatch(ctx context.Context, inputs []*enginev1.PlanResourcesInput, opts ...evaluator.CheckOpt) ([]*enginev1.PlanResourcesOutput, error) {
+	outputs, trail, err := metrics.RecordDuration3(metrics.EnginePlanLatency(), func() (outputs []*enginev1.PlanResourcesOutput, trail *auditv1.AuditTrail, err error) {
+		ctx, span := tracing.StartSpan(ctx, "engine.PlanBatch")
+		defer span.End()
+
+		checkOpts := evaluator.NewCheckOptions(ctx, engine.conf, opts...)
+
+		if len(inputs) < 2 || len(engine.workerPool) == 0 {
+			outputs, trail, err = engine.planSerial(ctx, inputs, checkOpts)
+		} else {
+			outputs, trail, err = engine.planParallel(ctx, inputs, checkOpts)
+		}
+
+		if err != nil {
+			tracing.MarkFailed(span, http.StatusBadRequest, err)
+		}
+
+		return outputs, trail, err
+	})
+
+	return engine.logPlanBatchDecision(ctx, inputs, outputs, err, trail)
+}
+
+func (engine *Engine) planSerial(ctx context.Context, inputs []*enginev1.PlanResourcesInput, checkOpts *evaluator.CheckOptions) ([]*enginev1.PlanResourcesOutput, *auditv1.AuditTrail, error) {
+	outputs := make([]*enginev1.PlanResourcesOutput, len(inputs))
+	trail := &auditv1.AuditTrail{}
+
+	for i, input := range inputs {
+		o, t, err := engine.doPlan(ctx, input, checkOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outputs[i] = o
+		trail = mergeTrails(trail, t)
+	}
+
+	return outputs, trail, nil
+}
+
+func (engine *Engine) planParallel(ctx context.Context, inputs []*enginev1.PlanResourcesInput, checkOpts *evaluator.CheckOptions) ([]*enginev1.PlanResourcesOutput, *auditv1.AuditTrail, error) {
+	ctx, span := tracing.StartSpan(ctx, "engine.PlanParallel")
+	defer span.End()
+
+	outputs := make([]*enginev1.PlanResourcesOutput, len(inputs))
+	trail := &auditv1.AuditTrail{}
+	collector := make(chan workOut, len(inputs))
+
+	for i, input := range inputs {
+		if err := engine.submitWork(ctx, workIn{index: i, ctx: ctx, planInput: input, out: collector, checkOpts: checkOpts}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for range inputs {
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case wo := <-collector:
+			if wo.err != nil {
+				return nil, nil, wo.err
+			}
+
+			outputs[wo.index] = wo.planResult
+			trail = mergeTrails(trail, wo.trail)
+		}
+	}
+
+	return outputs, trail, nil
+}
+
 func (engine *Engine) doPlan(ctx context.Context, input *enginev1.PlanResourcesInput, opts *evaluator.CheckOptions) (*enginev1.PlanResourcesOutput, *auditv1.AuditTrail, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, nil, err
@@ -173,10 +277,66 @@ func (engine *Engine) doPlan(ctx context.Context, input *enginev1.PlanResourcesI
 	ppVersion := evaluator.PolicyVersion(input.Principal.PolicyVersion, opts.EvalParams)
 	rpVersion := evaluator.PolicyVersion(input.Resource.PolicyVersion, opts.EvalParams)
 
-	return engine.ruleTableManager.Plan(ctx, input, ppVersion, rpVersion, opts.NowFunc(), opts.Globals())
+	params, ok := engine.planCache.params(opts)
+	if !ok {
+		return engine.ruleTableManager.Plan(ctx, input, ppVersion, rpVersion, opts.NowFunc(), opts.Globals())
+	}
+
+	key := engine.planCache.key(input, params)
+	if output, trail, ok := engine.planCache.get(key, input); ok {
+		return output, trail, nil
+	}
+
+	output, trail, err := engine.ruleTableManager.Plan(ctx, input, ppVersion, rpVersion, opts.NowFunc(), opts.Globals())
+	if err == nil {
+		engine.planCache.set(key, output, trail)
+	}
+
+	return output, trail, err
+}
+
+// PlanPrincipals produces a query plan with the conditions that a principal must satisfy to perform the actions on the resource.
+// Unlike Plan, the input is not validated against the resource schema and the decision is not written to the decision log.
+func (engine *Engine) PlanPrincipals(ctx context.Context, input *enginev1.PlanPrincipalsInput, opts ...evaluator.CheckOpt) (*enginev1.PlanPrincipalsOutput, error) {
+	ctx, span := tracing.StartSpan(ctx, "engine.PlanPrincipals")
+	defer span.End()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	checkOpts := evaluator.NewCheckOptions(ctx, engine.conf, opts...)
+	ppVersion := evaluator.PolicyVersion(input.Principal.GetPolicyVersion(), checkOpts.EvalParams)
+	rpVersion := evaluator.PolicyVersion(input.Resource.PolicyVersion, checkOpts.EvalParams)
+
+	output, err := engine.ruleTableManager.PlanPrincipals(ctx, input, ppVersion, rpVersion, checkOpts.NowFunc(), checkOpts.Globals())
+	if err != nil {
+		tracing.MarkFailed(span, http.StatusBadRequest, err)
+		return nil, err
+	}
+
+	return output, nil
 }
 
 func (engine *Engine) logPlanDecision(ctx context.Context, input *enginev1.PlanResourcesInput, output *enginev1.PlanResourcesOutput, planErr error, trail *auditv1.AuditTrail) (*enginev1.PlanResourcesOutput, error) {
+	engine.writePlanDecision(ctx, &auditv1.DecisionLogEntry_PlanResources{
+		Input:  input,
+		Output: output,
+	}, planErr, trail)
+
+	return output, planErr
+}
+
+func (engine *Engine) logPlanBatchDecision(ctx context.Context, inputs []*enginev1.PlanResourcesInput, outputs []*enginev1.PlanResourcesOutput, planErr error, trail *auditv1.AuditTrail) ([]*enginev1.PlanResourcesOutput, error) {
+	engine.writePlanDecision(ctx, &auditv1.DecisionLogEntry_PlanResources{
+		Inputs:  inputs,
+		Outputs: outputs,
+	}, planErr, trail)
+
+	return outputs, planErr
+}
+
+func (engine *Engine) writePlanDecision(ctx context.Context, planRes *auditv1.DecisionLogEntry_PlanResources, planErr error, trail *auditv1.AuditTrail) {
 	if err := engine.auditLog.WriteDecisionLogEntry(ctx, func() (*auditv1.DecisionLogEntry, error) {
 		callID, ok := audit.CallIDFromContext(ctx)
 		if !ok {
@@ -187,11 +347,6 @@ func (engine *Engine) logPlanDecision(ctx context.Context, input *enginev1.PlanR
 			}
 		}
 
-		planRes := &auditv1.DecisionLogEntry_PlanResources{
-			Input:  input,
-			Output: output,
-		}
-
 		if planErr != nil {
 			planRes.Error = planErr.Error()
 		}
@@ -215,23 +370,23 @@ func (engine *Engine) logPlanDecision(ctx context.Context, input *enginev1.PlanR
 	}); err != nil {
 		logging.FromContext(ctx).Warn("Failed to log decision", zap.Error(err))
 	}
-
-	return output, planErr
 }
 
 func (engine *Engine) Check(ctx context.Context, inputs []*enginev1.CheckInput, opts ...evaluator.CheckOpt) ([]*enginev1.CheckOutput, error) {
+	var checkOpts *evaluator.CheckOptions
 	outputs, trail, err := metrics.RecordDuration3(metrics.EngineCheckLatency(), func() (outputs []*enginev1.CheckOutput, trail *auditv1.AuditTrail, err error) {
 		ctx, span := tracing.StartSpan(ctx, "engine.Check")
 		defer span.End()
 
-		checkOpts := evaluator.NewCheckOptions(ctx, engine.conf, opts...)
+		checkOpts = engine.checkOptions(ctx, opts...)
+		cacheParams := engine.decisionCache.params(checkOpts)
 
 		// if the number of inputs is less than the threshold, do a serial execution as it is usually faster.
 		// ditto if the worker pool is not initialized
 		if len(inputs) < parallelismThreshold || len(engine.workerPool) == 0 {
-			outputs, trail, err = engine.checkSerial(ctx, inputs, checkOpts)
+			outputs, trail, err = engine.checkSerial(ctx, inputs, checkOpts, cacheParams)
 		} else {
-			outputs, trail, err = engine.checkParallel(ctx, inputs, checkOpts)
+			outputs, trail, err = engine.checkParallel(ctx, inputs, checkOpts, cacheParams)
 		}
 
 		if err != nil {
@@ -242,9 +397,58 @@ func (engine *Engine) Check(ctx context.Context, inputs []*enginev1.CheckInput,
 	})
 	metrics.EngineCheckBatchSize().Record(context.Background(), int64(len(inputs)))
 
+	if err == nil {
+		engine.shadowCheck(ctx, inputs, outputs, checkOpts)
+	}
+
 	return engine.logCheckDecision(ctx, inputs, outputs, err, trail)
 }
 
+// Explain evaluates the inputs in the same way as Check and also returns an explanation of how each decision was reached.
+// The explanations are built from the engine traces, so each input is evaluated serially with its own trace sink.
+func (engine *Engine) Explain(ctx context.Context, inputs []*enginev1.CheckInput, opts ...evaluator.CheckOpt) ([]*enginev1.CheckOutput, []*enginev1.Explanation, error) {
+	ctx, span := tracing.StartSpan(ctx, "engine.Explain")
+	defer span.End()
+
+	checkOpts := engine.checkOptions(ctx, opts...)
+	outputs := make([]*enginev1.CheckOutput, len(inputs))
+	explanations := make([]*enginev1.Explanation, len(inputs))
+	trail := &auditv1.AuditTrail{}
+
+	var err error
+	for i, input := range inputs {
+		explainer := ruletable.NewExplainer()
+		inputOpts := *checkOpts
+		inputOpts.TracerSink = explainer
+
+		var t *auditv1.AuditTrail
+		outputs[i], t, err = engine.evaluate(ctx, input, &inputOpts, nil)
+		if err != nil {
+			tracing.MarkFailed(span, http.StatusBadRequest, err)
+			outputs = nil
+			explanations = nil
+			break
+		}
+
+		explanations[i] = explainer.Explanation(outputs[i])
+		trail = mergeTrails(trail, t)
+	}
+
+	outputs, err = engine.logCheckDecision(ctx, inputs, outputs, err, trail)
+	return outputs, explanations, err
+}
+
+// checkOptions applies the options for a check request. If the engine has a relationship provider, the options include
+// a checker that memoises the tuples read from the provider for the duration of the request.
+func (engine *Engine) checkOptions(ctx context.Context, opts ...evaluator.CheckOpt) *evaluator.CheckOptions {
+	checkOpts := evaluator.NewCheckOptions(ctx, engine.conf, opts...)
+	if checkOpts.EvalParams.Relations == nil && engine.relations != nil {
+		checkOpts.EvalParams.Relations = relations.NewChecker(engine.relations)
+	}
+
+	return checkOpts
+}
+
 func (engine *Engine) logCheckDecision(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, checkErr error, trail *auditv1.AuditTrail) ([]*enginev1.CheckOutput, error) {
 	if err := engine.auditLog.WriteDecisionLogEntry(ctx, func() (*auditv1.DecisionLogEntry, error) {
 		ctx, span := tracing.StartSpan(ctx, "audit.WriteDecisionLog")
@@ -291,7 +495,7 @@ func (engine *Engine) logCheckDecision(ctx context.Context, inputs []*enginev1.C
 	return outputs, checkErr
 }
 
-func (engine *Engine) checkSerial(ctx context.Context, inputs []*enginev1.CheckInput, checkOpts *evaluator.CheckOptions) ([]*enginev1.CheckOutput, *auditv1.AuditTrail, error) {
+func (engine *Engine) checkSerial(ctx context.Context, inputs []*enginev1.CheckInput, checkOpts *evaluator.CheckOptions, cacheParams *decisionCacheParams) ([]*enginev1.CheckOutput, *auditv1.AuditTrail, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.CheckSerial")
 	defer span.End()
 
@@ -299,7 +503,7 @@ func (engine *Engine) checkSerial(ctx context.Context, inputs []*enginev1.CheckI
 	trail := &auditv1.AuditTrail{}
 
 	for i, input := range inputs {
-		o, t, err := engine.evaluate(ctx, input, checkOpts)
+		o, t, err := engine.evaluate(ctx, input, checkOpts, cacheParams)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -311,7 +515,7 @@ func (engine *Engine) checkSerial(ctx context.Context, inputs []*enginev1.CheckI
 	return outputs, trail, nil
 }
 
-func (engine *Engine) checkParallel(ctx context.Context, inputs []*enginev1.CheckInput, checkOpts *evaluator.CheckOptions) ([]*enginev1.CheckOutput, *auditv1.AuditTrail, error) {
+func (engine *Engine) checkParallel(ctx context.Context, inputs []*enginev1.CheckInput, checkOpts *evaluator.CheckOptions, cacheParams *decisionCacheParams) ([]*enginev1.CheckOutput, *auditv1.AuditTrail, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.CheckParallel")
 	defer span.End()
 
@@ -320,7 +524,7 @@ func (engine *Engine) checkParallel(ctx context.Context, inputs []*enginev1.Chec
 	collector := make(chan workOut, len(inputs))
 
 	for i, input := range inputs {
-		if err := engine.submitWork(ctx, workIn{index: i, ctx: ctx, input: input, out: collector, checkOpts: checkOpts}); err != nil {
+		if err := engine.submitWork(ctx, workIn{index: i, ctx: ctx, input: input, out: collector, checkOpts: checkOpts, cacheParams: cacheParams}); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -342,7 +546,7 @@ func (engine *Engine) checkParallel(ctx context.Context, inputs []*enginev1.Chec
 	return outputs, trail, nil
 }
 
-func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput, checkOpts *evaluator.CheckOptions) (*enginev1.CheckOutput, *auditv1.AuditTrail, error) {
+func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput, checkOpts *evaluator.CheckOptions, cacheParams *decisionCacheParams) (*enginev1.CheckOutput, *auditv1.AuditTrail, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.Evaluate")
 	defer span.End()
 
@@ -356,22 +560,66 @@ func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput,
 
 	tctx := tracer.Start(checkOpts.TracerSink)
 
-	return engine.ruleTableManager.Check(ctx, tctx, checkOpts.EvalParams, input)
+	if cacheParams == nil {
+		return engine.ruleTableManager.Check(ctx, tctx, checkOpts.EvalParams, input)
+	}
+
+	key := engine.decisionCache.key(input, cacheParams)
+	if output, trail, ok := engine.decisionCache.get(key, input); ok {
+		return output, trail, nil
+	}
+
+	// Decisions that depend on the current time must not be cached. Time functions are the only consumers of
+	// `NowFunc` during evaluation, so we can detect their use by watching for calls to it.
+	evalParams := checkOpts.EvalParams
+	nowFunc := evalParams.NowFunc
+	timeDependent := false
+	evalParams.NowFunc = func() time.Time {
+		timeDependent = true
+		return nowFunc()
+	}
+
+	// Relationship tuples can change independently of the policies, so decisions that depend on them must not be cached either.
+	relationDependent := false
+	if evalParams.Relations != nil {
+		evalParams.Relations = observedRelations{RelationChecker: evalParams.Relations, used: &relationDependent}
+	}
+
+	output, trail, err := engine.ruleTableManager.Check(ctx, tctx, evalParams, input)
+	if err == nil && !timeDependent && !relationDependent {
+		engine.decisionCache.set(key, output, trail)
+	}
+
+	return output, trail, err
+}
+
+// observedRelations records whether a relation checker was consulted.
+type observedRelations struct {
+	conditions.RelationChecker
+	used *bool
+}
+
+func (o observedRelations) Related(ctx context.Context, subject, relation, object string) (bool, error) {
+	*o.used = true
+	return o.RelationChecker.Related(ctx, subject, relation, object)
 }
 
 type workOut struct {
-	err    error
-	result *enginev1.CheckOutput
-	trail  *auditv1.AuditTrail
-	index  int
+	err        error
+	result     *enginev1.CheckOutput
+	planResult *enginev1.PlanResourcesOutput
+	trail      *auditv1.AuditTrail
+	index      int
 }
 
 type workIn struct {
-	ctx       context.Context
-	input     *enginev1.CheckInput
-	checkOpts *evaluator.CheckOptions
-	out       chan<- workOut
-	index     int
+	ctx         context.Context
+	input       *enginev1.CheckInput
+	planInput   *enginev1.PlanResourcesInput
+	checkOpts   *evaluator.CheckOptions
+	cacheParams *decisionCacheParams
+	out         chan<- workOut
+	index       int
 }
 
 func mergeTrails(a, b *auditv1.AuditTrail) *auditv1.AuditTrail {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/fs"
 	"path/filepath"
 	"slices"
 	"sort"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -25,6 +27,7 @@ import (
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	privatev1 "github.com/cerbos/cerbos/api/genpb/cerbos/private/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	"github.com/cerbos/cerbos/internal/audit"
@@ -32,11 +35,14 @@ import (
 	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/engine/tracer"
 	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/printer"
 	"github.com/cerbos/cerbos/internal/ruletable"
 	"github.com/cerbos/cerbos/internal/ruletable/planner"
 	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/index"
 	"github.com/cerbos/cerbos/internal/test"
 	"github.com/cerbos/cerbos/internal/util"
 )
@@ -481,6 +487,116 @@ func TestQueryPlan(t *testing.T) {
 	}
 }
 
+func TestPlanNow(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies"})
+	defer cancelFunc()
+
+	timestamp := time.Date(2024, 1, 16, 10, 18, 27, 0, time.UTC)
+	principal := &enginev1.Principal{Id: "macro_user", PolicyVersion: "default", Roles: []string{"employee", "user"}}
+
+	testCases := []struct {
+		action  string
+		wantNow bool
+	}{
+		{action: "timestamp", wantNow: true},
+		{action: "map", wantNow: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.action, func(t *testing.T) {
+			output, err := eng.Plan(t.Context(), &enginev1.PlanResourcesInput{
+				RequestId: "requestId",
+				Actions:   []string{tc.action},
+				Principal: principal,
+				Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "macro", PolicyVersion: "default"},
+			}, evaluator.WithNowFunc(func() time.Time { return timestamp }))
+			require.NoError(t, err)
+
+			if !tc.wantNow {
+				require.Nil(t, output.Now)
+				return
+			}
+
+			require.NotNil(t, output.Now)
+			require.True(t, output.Now.AsTime().Equal(timestamp))
+		})
+	}
+}
+
+func TestPlanBatch(t *testing.T) {
+	mockAuditLog := &mockAuditLog{}
+	eng, cancelFunc := mkEngine(t, param{subDir: "query_planner/policies", auditLog: mockAuditLog})
+	defer cancelFunc()
+
+	auxData := &enginev1.AuxData{Jwt: make(map[string]*structpb.Value)}
+	auxData.Jwt["customInt"] = structpb.NewNumberValue(42)
+
+	timestamp, err := time.Parse(time.RFC3339, "2024-01-16T10:18:27.395716+13:00")
+	require.NoError(t, err)
+	nowFunc := evaluator.WithNowFunc(func() time.Time { return timestamp })
+
+	for _, suite := range test.LoadTestCases(t, "query_planner/suite") {
+		t.Run(suite.Name, func(t *testing.T) {
+			ts := readQPTestSuite(t, suite.Input)
+
+			var inputs []*enginev1.PlanResourcesInput
+			for _, tt := range ts.Tests {
+				if tt.WantErr {
+					continue
+				}
+
+				input := &enginev1.PlanResourcesInput{
+					RequestId: "requestId",
+					Actions:   tt.Actions,
+					Principal: ts.Principal,
+					Resource: &enginev1.PlanResourcesInput_Resource{
+						Kind:          tt.Resource.Kind,
+						Attr:          tt.Resource.Attr,
+						PolicyVersion: tt.Resource.PolicyVersion,
+						Scope:         tt.Resource.Scope,
+					},
+					IncludeMeta: true,
+					AuxData:     auxData,
+				}
+				if tt.Actions == nil {
+					input.Actions = []string{tt.Action} //nolint:staticcheck
+				}
+				inputs = append(inputs, input)
+			}
+
+			if len(inputs) == 0 {
+				t.Skip("No valid inputs")
+			}
+
+			wantOutputs := make([]*enginev1.PlanResourcesOutput, len(inputs))
+			for i, input := range inputs {
+				wantOutputs[i], err = eng.Plan(t.Context(), input, nowFunc)
+				require.NoError(t, err)
+			}
+
+			mockAuditLog.clear()
+			haveOutputs, err := eng.(*Engine).PlanBatch(t.Context(), inputs, nowFunc)
+			require.NoError(t, err)
+			require.Len(t, haveOutputs, len(wantOutputs))
+			for i, want := range wantOutputs {
+				have := haveOutputs[i]
+				require.Equal(t, want.Kind, have.Kind)
+				require.Equal(t, want.MatchedScopes, have.MatchedScopes)
+				require.Empty(t, cmp.Diff(stabiliseFilter(want.Filter), stabiliseFilter(have.Filter),
+					protocmp.Transform(),
+					protocmp.SortRepeatedFields(&enginev1.PlanResourcesFilter_Expression{}, "operands")))
+			}
+
+			decisionLogs := mockAuditLog.getDecisionLogs()
+			require.Len(t, decisionLogs, 1)
+			planRes := decisionLogs[0].GetPlanResources()
+			require.NotNil(t, planRes)
+			require.Len(t, planRes.Inputs, len(inputs))
+			require.Len(t, planRes.Outputs, len(inputs))
+		})
+	}
+}
+
 // Create a recursive function to normalize all expressions with commutative operators.
 func stabiliseFilter(filter *enginev1.PlanResourcesFilter) *enginev1.PlanResourcesFilter {
 	if filter == nil {
@@ -622,3 +738,63 @@ func (m *mockAuditLog) getDecisionLogs() []*auditv1.DecisionLogEntry {
 	decisionLogs := slices.Clone(m.decisionLogs)
 	return decisionLogs
 }
+
+// memStore is a mutable in-memory policy store for tests that need to modify policies.
+type memStore struct {
+	fsys  afero.Fs
+	idx   index.Index
+	store *disk.Store
+}
+
+func (ms *memStore) addOrUpdatePolicy(t *testing.T, f string, p *policyv1.Policy) {
+	t.Helper()
+
+	var s bytes.Buffer
+	require.NoError(t, policy.WritePolicy(&s, p))
+
+	require.NoError(t, afero.WriteFile(ms.fsys, f, s.Bytes(), fs.ModeAppend))
+
+	evt, err := ms.idx.AddOrUpdate(index.Entry{File: f, Policy: policy.Wrap(p)})
+	require.NoError(t, err)
+
+	ms.store.NotifySubscribers(evt)
+}
+
+func mkMemEngine(t *testing.T, conf *evaluator.Conf) (*Engine, *memStore) {
+	t.Helper()
+
+	components, ms := mkMemComponents(t)
+	return NewFromConf(t.Context(), conf, components), ms
+}
+
+func mkMemComponents(t *testing.T) (Components, *memStore) {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(t.Context())
+	t.Cleanup(cancelFunc)
+
+	fsys := afero.NewMemMapFs()
+	idx, err := index.Build(ctx, afero.NewIOFS(fsys))
+	require.NoError(t, err)
+
+	store := disk.NewFromIndexWithConf(idx, &disk.Conf{})
+	store.SubscriptionManager = storage.NewSubscriptionManager(ctx)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+
+	compiler, err := compile.NewManager(ctx, store)
+	require.NoError(t, err)
+
+	ruletableMgr, err := ruletable.NewRuleTableManager(ruletable.NewProtoRuletable(), compiler, store, schemaMgr)
+	require.NoError(t, err)
+	store.Subscribe(ruletableMgr)
+
+	components := Components{
+		PolicyLoader:     compiler,
+		RuleTableManager: ruletableMgr,
+		SchemaMgr:        schemaMgr,
+		AuditLog:         audit.NewNopLog(),
+	}
+
+	return components, &memStore{fsys: fsys, idx: idx, store: store}
+}
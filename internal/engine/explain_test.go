@@ -0,0 +1,179 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+)
+
+const (
+	explainDerivedRoles = `---
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: document_roles
+  definitions:
+    - name: editor
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: P.id in R.attr.editors
+    - name: reviewer
+      parentRoles: ["auditor"]
+      condition:
+        match:
+          expr: "!R.attr.locked"
+`
+	explainPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  importDerivedRoles: ["document_roles"]
+  rules:
+    - name: owner-edit
+      actions: ["edit"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          all:
+            of:
+              - expr: R.attr.owner == P.id
+              - expr: "!R.attr.locked"
+    - name: admin-edit
+      actions: ["edit"]
+      roles: ["admin"]
+      effect: EFFECT_ALLOW
+    - name: editor-edit
+      actions: ["edit"]
+      derivedRoles: ["editor", "reviewer"]
+      effect: EFFECT_ALLOW
+`
+)
+
+func TestExplain(t *testing.T) {
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+
+	eng, ms := mkMemEngine(t, conf)
+	ms.addOrUpdatePolicy(t, "derived_roles/document_roles.yaml", readPolicy(t, explainDerivedRoles))
+	ms.addOrUpdatePolicy(t, "resource_policies/document.yaml", readPolicy(t, explainPolicy))
+
+	mkInput := func(owner string, roles ...string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"edit"},
+			Principal: &enginev1.Principal{Id: "alice", Roles: append([]string{"user"}, roles...)},
+			Resource: &enginev1.Resource{
+				Kind: "document",
+				Id:   "doc1",
+				Attr: map[string]*structpb.Value{
+					"owner":   structpb.NewStringValue(owner),
+					"locked":  structpb.NewBoolValue(false),
+					"editors": structpb.NewListValue(&structpb.ListValue{}),
+				},
+			},
+		}
+	}
+	waitForAllow(t, eng, mkInput("alice"))
+
+	outputs, explanations, err := eng.Explain(t.Context(), []*enginev1.CheckInput{mkInput("bob"), mkInput("alice"), mkInput("bob", "auditor")})
+	require.NoError(t, err)
+	require.Len(t, outputs, 3)
+	require.Len(t, explanations, 3)
+
+	const policyKey = "resource.document.vdefault"
+	adminRule := &enginev1.Explanation_Rule{
+		Policy:  policyKey,
+		Name:    "admin-edit",
+		Outcome: enginev1.Explanation_Rule_OUTCOME_ROLE_MISMATCH,
+	}
+	editorRule := &enginev1.Explanation_Rule{
+		Policy:      policyKey,
+		Name:        "editor-edit",
+		Outcome:     enginev1.Explanation_Rule_OUTCOME_DERIVED_ROLE_NOT_ACTIVATED,
+		DerivedRole: "editor",
+	}
+
+	t.Run("denied", func(t *testing.T) {
+		want := &enginev1.Explanation{
+			Actions: map[string]*enginev1.Explanation_Action{
+				"edit": {
+					Effect: effectv1.Effect_EFFECT_DENY,
+					Policy: policyKey,
+					Rules: []*enginev1.Explanation_Rule{
+						adminRule,
+						editorRule,
+						{
+							Policy:  policyKey,
+							Name:    "owner-edit",
+							Outcome: enginev1.Explanation_Rule_OUTCOME_CONDITION_NOT_MET,
+							Condition: &enginev1.Explanation_Condition{
+								Op: enginev1.Explanation_Condition_OP_ALL,
+								Conditions: []*enginev1.Explanation_Condition{
+									{
+										Op: enginev1.Explanation_Condition_OP_EXPR,
+										Expr: &enginev1.Explanation_Expr{
+											Expr:  "R.attr.owner == P.id",
+											Value: structpb.NewBoolValue(false),
+											Operands: []*enginev1.Explanation_Expr{
+												{Expr: "R.attr.owner", Value: structpb.NewStringValue("bob")},
+												{Expr: "P.id", Value: structpb.NewStringValue("alice")},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		require.Empty(t, cmp.Diff(want, explanations[0], protocmp.Transform()))
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		action := explanations[1].Actions["edit"]
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, action.Effect)
+		require.Equal(t, policyKey, action.Policy)
+		require.Equal(t, "owner-edit", action.Rule)
+		require.Len(t, action.Rules, 3)
+
+		ownerRule := action.Rules[2]
+		require.Equal(t, enginev1.Explanation_Rule_OUTCOME_ACTIVATED, ownerRule.Outcome)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, ownerRule.Effect)
+		require.True(t, ownerRule.Condition.Satisfied)
+		require.Len(t, ownerRule.Condition.Conditions, 2)
+		require.Empty(t, cmp.Diff(&enginev1.Explanation_Expr{
+			Expr:     "!R.attr.locked",
+			Value:    structpb.NewBoolValue(true),
+			Operands: []*enginev1.Explanation_Expr{{Expr: "R.attr.locked", Value: structpb.NewBoolValue(false)}},
+		}, ownerRule.Condition.Conditions[1].Expr, protocmp.Transform()))
+	})
+
+	t.Run("allowed_by_later_role", func(t *testing.T) {
+		action := explanations[2].Actions["edit"]
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, action.Effect)
+		require.Equal(t, "editor-edit", action.Rule)
+		require.Len(t, action.Rules, 3)
+		require.Empty(t, cmp.Diff(&enginev1.Explanation_Rule{
+			Policy:      policyKey,
+			Name:        "editor-edit",
+			Outcome:     enginev1.Explanation_Rule_OUTCOME_ACTIVATED,
+			Effect:      effectv1.Effect_EFFECT_ALLOW,
+			DerivedRole: "reviewer",
+		}, action.Rules[1], protocmp.Transform()))
+	})
+}
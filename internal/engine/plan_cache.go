@@ -0,0 +1,103 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/cache"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// planCacheIgnoreFields are the fields of the plan input that don't influence the plan.
+var planCacheIgnoreFields = map[string]struct{}{
+	"cerbos.engine.v1.PlanResourcesInput.request_id": {},
+}
+
+// planCache caches query plans keyed by a hash of the input and the evaluation parameters.
+// It uses the same generation scheme as the decision cache to invalidate plans when the policies they were produced from change.
+type planCache struct {
+	entries *cache.Cache[uint64, *planCacheEntry]
+	*cacheGenerations
+	ttl              time.Duration
+	timeDependentTTL time.Duration
+}
+
+type planCacheEntry struct {
+	output *enginev1.PlanResourcesOutput
+	trail  *auditv1.AuditTrail
+}
+
+func newPlanCache(conf evaluator.PlanCacheConf) *planCache {
+	return &planCache{
+		entries:          cache.New[uint64, *planCacheEntry]("plan", conf.Size),
+		cacheGenerations: newCacheGenerations(),
+		ttl:              conf.TTL,
+		timeDependentTTL: min(conf.TimeDependentTTL, conf.TTL),
+	}
+}
+
+// params computes the hash of the evaluation parameters for a plan request.
+// It returns false if the plans are unsuitable for caching.
+func (pc *planCache) params(checkOpts *evaluator.CheckOptions) (uint64, bool) {
+	if pc == nil {
+		return 0, false
+	}
+
+	return cacheParamsHash(checkOpts)
+}
+
+func (pc *planCache) key(input *enginev1.PlanResourcesInput, params uint64) uint64 {
+	return pc.mix(util.HashPB(input, planCacheIgnoreFields), params, input.GetResource().GetKind(), input.GetPrincipal().GetId())
+}
+
+func (pc *planCache) get(key uint64, input *enginev1.PlanResourcesInput) (*enginev1.PlanResourcesOutput, *auditv1.AuditTrail, bool) {
+	entry, ok := pc.entries.Get(key)
+	if !ok {
+		return nil, nil, false
+	}
+
+	// callers are free to modify the output and the trail, so we always hand out copies
+	output := proto.Clone(entry.output).(*enginev1.PlanResourcesOutput) //nolint:forcetypeassert
+	output.RequestId = input.RequestId
+
+	var trail *auditv1.AuditTrail
+	if entry.trail != nil {
+		trail = proto.Clone(entry.trail).(*auditv1.AuditTrail) //nolint:forcetypeassert
+	}
+
+	return output, trail, true
+}
+
+// set caches the plan. Plans that depend on the current time are only kept for the time-dependent TTL, which disables
+// caching them altogether if it is zero.
+func (pc *planCache) set(key uint64, output *enginev1.PlanResourcesOutput, trail *auditv1.AuditTrail) {
+	ttl := pc.ttl
+	if output.Now != nil {
+		if pc.timeDependentTTL <= 0 {
+			return
+		}
+		ttl = pc.timeDependentTTL
+	}
+
+	entry := &planCacheEntry{output: proto.Clone(output).(*enginev1.PlanResourcesOutput)} //nolint:forcetypeassert
+	if trail != nil {
+		entry.trail = proto.Clone(trail).(*auditv1.AuditTrail) //nolint:forcetypeassert
+	}
+
+	pc.entries.SetWithExpire(key, entry, ttl)
+}
+
+// invalidate makes the plans affected by the rule table change unreachable.
+func (pc *planCache) invalidate(change ruletable.Change) {
+	if pc.bump(change) {
+		pc.entries.Purge()
+	}
+}
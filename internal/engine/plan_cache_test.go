@@ -0,0 +1,102 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+)
+
+func TestPlanCache(t *testing.T) {
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+	conf.PlanCache.Enabled = true
+
+	ctx := t.Context()
+	eng, ms := mkMemEngine(t, conf)
+	require.NotNil(t, eng.planCache)
+
+	mkInput := func(kind string) *enginev1.PlanResourcesInput {
+		return &enginev1.PlanResourcesInput{
+			RequestId: "1",
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: kind},
+			Principal: &enginev1.Principal{Id: "sam", Roles: []string{"user"}},
+			Actions:   []string{"throw"},
+		}
+	}
+
+	isCached := func(t *testing.T, input *enginev1.PlanResourcesInput) bool {
+		t.Helper()
+
+		params, ok := eng.planCache.params(evaluator.NewCheckOptions(ctx, conf))
+		require.True(t, ok)
+
+		return eng.planCache.entries.Has(eng.planCache.key(input, params))
+	}
+
+	requireKind := func(t *testing.T, input *enginev1.PlanResourcesInput, want enginev1.PlanResourcesFilter_Kind) {
+		t.Helper()
+
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			output, err := eng.Plan(ctx, input)
+			require.NoError(c, err)
+			require.Equal(c, want, output.GetFilter().GetKind())
+		}, 1*time.Second, 50*time.Millisecond)
+	}
+
+	ms.addOrUpdatePolicy(t, "resource_policies/rock.yaml", mkResourcePolicy("rock", effectv1.Effect_EFFECT_ALLOW, ""))
+	ms.addOrUpdatePolicy(t, "resource_policies/paper.yaml", mkResourcePolicy("paper", effectv1.Effect_EFFECT_ALLOW, ""))
+
+	rock := mkInput("rock")
+	paper := mkInput("paper")
+
+	t.Run("caches_plans", func(t *testing.T) {
+		requireKind(t, rock, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED)
+		requireKind(t, paper, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED)
+		require.True(t, isCached(t, rock))
+		require.True(t, isCached(t, paper))
+
+		otherRequest := mkInput("rock")
+		otherRequest.RequestId = "2"
+		output, err := eng.Plan(ctx, otherRequest)
+		require.NoError(t, err)
+		require.Equal(t, "2", output.RequestId)
+	})
+
+	t.Run("invalidates_affected_plans", func(t *testing.T) {
+		ms.addOrUpdatePolicy(t, "resource_policies/rock.yaml", mkResourcePolicy("rock", effectv1.Effect_EFFECT_DENY, ""))
+
+		requireKind(t, rock, enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED)
+		require.True(t, isCached(t, paper))
+	})
+
+	t.Run("skips_time_dependent_plans", func(t *testing.T) {
+		ms.addOrUpdatePolicy(t, "resource_policies/rock.yaml", mkResourcePolicy("rock", effectv1.Effect_EFFECT_ALLOW, `timeSince(timestamp(R.attr.created)) < duration("1h")`))
+
+		requireKind(t, rock, enginev1.PlanResourcesFilter_KIND_CONDITIONAL)
+		require.False(t, isCached(t, rock))
+	})
+
+	t.Run("caches_time_dependent_plans_with_bounded_ttl", func(t *testing.T) {
+		eng.planCache.timeDependentTTL = 1 * time.Minute
+		t.Cleanup(func() { eng.planCache.timeDependentTTL = 0 })
+
+		output, err := eng.Plan(ctx, rock)
+		require.NoError(t, err)
+		require.NotNil(t, output.Now)
+		require.True(t, isCached(t, rock))
+
+		cached, err := eng.Plan(ctx, rock)
+		require.NoError(t, err)
+		require.Equal(t, output.Now.AsTime(), cached.Now.AsTime())
+	})
+}
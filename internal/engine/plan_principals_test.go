@@ -0,0 +1,171 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+)
+
+const (
+	planPrincipalsDerivedRoles = `---
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: report_roles
+  definitions:
+    - name: author
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: R.attr.author == P.id
+`
+	planPrincipalsResourcePolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: report
+  version: default
+  importDerivedRoles: ["report_roles"]
+  rules:
+    - actions: ["edit", "view"]
+      roles: ["admin"]
+      effect: EFFECT_ALLOW
+    - actions: ["edit", "view"]
+      derivedRoles: ["author"]
+      effect: EFFECT_ALLOW
+    - actions: ["view"]
+      roles: ["*"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: R.attr.public
+    - actions: ["edit"]
+      roles: ["*"]
+      effect: EFFECT_DENY
+      condition:
+        match:
+          all:
+            of:
+              - expr: R.attr.locked
+              - expr: P.attr.clearance < 3
+    - actions: ["edit", "view"]
+      roles: ["contractor"]
+      effect: EFFECT_DENY
+`
+	planPrincipalsPrincipalPolicy = `---
+apiVersion: api.cerbos.dev/v1
+principalPolicy:
+  principal: carol
+  version: default
+  rules:
+    - resource: report
+      actions:
+        - action: "view"
+          effect: EFFECT_ALLOW
+        - action: "edit"
+          effect: EFFECT_DENY
+          condition:
+            match:
+              expr: R.attr.locked
+`
+)
+
+func TestPlanPrincipals(t *testing.T) {
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+
+	eng, ms := mkMemEngine(t, conf)
+	ms.addOrUpdatePolicy(t, "derived_roles/report_roles.yaml", readPolicy(t, planPrincipalsDerivedRoles))
+	ms.addOrUpdatePolicy(t, "resource_policies/report.yaml", readPolicy(t, planPrincipalsResourcePolicy))
+	ms.addOrUpdatePolicy(t, "principal_policies/carol.yaml", readPolicy(t, planPrincipalsPrincipalPolicy))
+
+	waitForAllow(t, eng, &enginev1.CheckInput{
+		Actions:   []string{"view"},
+		Principal: &enginev1.Principal{Id: "carol", Roles: []string{"guest"}},
+		Resource:  &enginev1.Resource{Kind: "report", Id: "r1"},
+	})
+
+	roleSets := [][]string{{"admin"}, {"user"}, {"guest"}, {"contractor"}, {"admin", "contractor"}, {"user", "guest"}, {"user", "contractor"}}
+	ids := []string{"alice", "bob", "carol"}
+	clearances := []float64{1, 5}
+
+	for _, action := range []string{"edit", "view"} {
+		for _, locked := range []bool{false, true} {
+			for _, public := range []bool{false, true} {
+				resource := &enginev1.Resource{
+					Kind: "report",
+					Id:   "r1",
+					Attr: map[string]*structpb.Value{
+						"author": structpb.NewStringValue("alice"),
+						"locked": structpb.NewBoolValue(locked),
+						"public": structpb.NewBoolValue(public),
+					},
+				}
+
+				t.Run(fmt.Sprintf("action=%s/locked=%t/public=%t", action, locked, public), func(t *testing.T) {
+					plan, err := eng.PlanPrincipals(t.Context(), &enginev1.PlanPrincipalsInput{
+						RequestId: "test",
+						Actions:   []string{action},
+						Resource:  resource,
+					})
+					require.NoError(t, err)
+
+					for _, roles := range roleSets {
+						for _, id := range ids {
+							for _, clearance := range clearances {
+								input := &enginev1.CheckInput{
+									RequestId: "test",
+									Actions:   []string{action},
+									Principal: &enginev1.Principal{
+										Id:    id,
+										Roles: roles,
+										Attr:  map[string]*structpb.Value{"clearance": structpb.NewNumberValue(clearance)},
+									},
+									Resource: resource,
+								}
+
+								outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{input})
+								require.NoError(t, err)
+
+								planned, err := evalPlanFilter(plan.Filter, input)
+								require.NoError(t, err, "filter: %s", plan.FilterDebug)
+
+								checked := outputs[0].Actions[action].Effect == effectv1.Effect_EFFECT_ALLOW
+								require.Equal(t, checked, planned, "principal %s with roles %v and clearance %v: filter %s", id, roles, clearance, plan.FilterDebug)
+							}
+						}
+					}
+				})
+			}
+		}
+	}
+
+	t.Run("filter", func(t *testing.T) {
+		plan, err := eng.PlanPrincipals(t.Context(), &enginev1.PlanPrincipalsInput{
+			RequestId: "test",
+			Actions:   []string{"edit"},
+			Resource: &enginev1.Resource{
+				Kind: "report",
+				Id:   "r1",
+				Attr: map[string]*structpb.Value{
+					"author": structpb.NewStringValue("alice"),
+					"locked": structpb.NewBoolValue(false),
+					"public": structpb.NewBoolValue(false),
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, enginev1.PlanResourcesFilter_KIND_CONDITIONAL, plan.Filter.Kind)
+		require.Equal(t, "report", plan.Kind)
+		require.Equal(t, "r1", plan.ResourceId)
+		require.Equal(t, `(or (in "admin" request.principal.roles) (and (in "user" request.principal.roles) (eq "alice" request.principal.id)))`, plan.FilterDebug)
+	})
+}
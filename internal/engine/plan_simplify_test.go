@@ -0,0 +1,176 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+)
+
+const (
+	randomPolicyCount    = 25
+	randomPrincipalCount = 6
+	randomResourceCount  = 30
+)
+
+var (
+	randomPolicyAtoms = []string{
+		`R.attr.status == "draft"`,
+		`R.attr.status == "published"`,
+		`R.attr.status == "archived"`,
+		`R.attr.status in ["draft", "archived"]`,
+		`R.attr.rank < 2`,
+		`R.attr.rank == 1`,
+		`R.attr.rank == P.attr.level`,
+		`R.attr.flagged`,
+		`P.attr.level == 1`,
+		`R.attr.owner == P.id`,
+	}
+	randomPolicyRoles = []string{"user", "admin", "*"}
+	randomStatuses    = []string{"draft", "published", "archived"}
+)
+
+// TestPlanFilterOptions checks that the filters produced with each of the filter options agree with Check for random policies and inputs.
+func TestPlanFilterOptions(t *testing.T) {
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+
+	eng, ms := mkMemEngine(t, conf)
+	rng := rand.New(rand.NewPCG(42, 1024)) //nolint:gosec
+
+	kinds := make([]string, randomPolicyCount)
+	for i := range kinds {
+		kinds[i] = fmt.Sprintf("doc_%02d", i)
+		ms.addOrUpdatePolicy(t, fmt.Sprintf("resource_policies/%s.yaml", kinds[i]), readPolicy(t, randomResourcePolicy(rng, kinds[i])))
+	}
+
+	for _, kind := range kinds {
+		waitForAllow(t, eng, &enginev1.CheckInput{
+			Actions:   []string{"ping"},
+			Principal: &enginev1.Principal{Id: "probe", Roles: []string{"probe"}},
+			Resource:  &enginev1.Resource{Kind: kind, Id: "probe"},
+		})
+	}
+
+	options := map[string]*enginev1.PlanResourcesInput_FilterOptions{
+		"none":     nil,
+		"simplify": {Simplify: true},
+		"dnf":      {NormalForm: enginev1.PlanResourcesInput_FilterOptions_NORMAL_FORM_DNF},
+		"cnf":      {NormalForm: enginev1.PlanResourcesInput_FilterOptions_NORMAL_FORM_CNF},
+	}
+
+	for _, kind := range kinds {
+		for range randomPrincipalCount {
+			principal := randomPrincipal(rng)
+			resources := make([]*enginev1.Resource, randomResourceCount)
+			for i := range resources {
+				resources[i] = randomResource(rng, kind)
+			}
+
+			for name, opts := range options {
+				t.Run(fmt.Sprintf("%s/%s/%s", kind, principal.Id, name), func(t *testing.T) {
+					plan, err := eng.Plan(t.Context(), &enginev1.PlanResourcesInput{
+						RequestId:     "test",
+						Actions:       []string{"view"},
+						Principal:     principal,
+						Resource:      &enginev1.PlanResourcesInput_Resource{Kind: kind},
+						FilterOptions: opts,
+					})
+					require.NoError(t, err)
+
+					for _, resource := range resources {
+						input := &enginev1.CheckInput{
+							RequestId: "test",
+							Actions:   []string{"view"},
+							Principal: principal,
+							Resource:  resource,
+						}
+
+						outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{input})
+						require.NoError(t, err)
+
+						planned, err := evalPlanFilter(plan.Filter, input)
+						require.NoError(t, err, "filter: %s", plan.FilterDebug)
+
+						checked := outputs[0].Actions["view"].Effect == effectv1.Effect_EFFECT_ALLOW
+						require.Equal(t, checked, planned, "resource %v: filter %s", resource.Attr, plan.FilterDebug)
+					}
+				})
+			}
+		}
+	}
+}
+
+func randomResourcePolicy(rng *rand.Rand, kind string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "---\napiVersion: api.cerbos.dev/v1\nresourcePolicy:\n  resource: %s\n  version: default\n  rules:\n", kind)
+	b.WriteString("    - actions: [\"ping\"]\n      roles: [\"probe\"]\n      effect: EFFECT_ALLOW\n")
+
+	for range 2 + rng.IntN(5) {
+		effect := "EFFECT_ALLOW"
+		if rng.IntN(4) == 0 {
+			effect = "EFFECT_DENY"
+		}
+
+		fmt.Fprintf(&b, "    - actions: [\"view\"]\n      roles: [%q]\n      effect: %s\n", randomPolicyRoles[rng.IntN(len(randomPolicyRoles))], effect)
+		if rng.IntN(5) > 0 {
+			fmt.Fprintf(&b, "      condition:\n        match:\n          expr: %q\n", randomCondition(rng, 3))
+		}
+	}
+
+	return b.String()
+}
+
+func randomCondition(rng *rand.Rand, depth int) string {
+	if depth == 0 || rng.IntN(3) == 0 {
+		return randomPolicyAtoms[rng.IntN(len(randomPolicyAtoms))]
+	}
+
+	switch rng.IntN(5) {
+	case 0:
+		return fmt.Sprintf("!(%s)", randomCondition(rng, depth-1))
+	case 1, 2:
+		return fmt.Sprintf("(%s) && (%s)", randomCondition(rng, depth-1), randomCondition(rng, depth-1))
+	default:
+		return fmt.Sprintf("(%s) || (%s)", randomCondition(rng, depth-1), randomCondition(rng, depth-1))
+	}
+}
+
+// randomPrincipal returns a principal with a single role. The planner combines the rules of all roles of the principal
+// whereas Check decides for each role independently, so their results can differ for principals with several roles.
+func randomPrincipal(rng *rand.Rand) *enginev1.Principal {
+	roleSets := [][]string{{"user"}, {"admin"}, {"guest"}}
+	ids := []string{"alice", "bob"}
+
+	return &enginev1.Principal{
+		Id:    ids[rng.IntN(len(ids))],
+		Roles: roleSets[rng.IntN(len(roleSets))],
+		Attr:  map[string]*structpb.Value{"level": structpb.NewNumberValue(float64(1 + rng.IntN(2)))},
+	}
+}
+
+func randomResource(rng *rand.Rand, kind string) *enginev1.Resource {
+	owners := []string{"alice", "bob", "carol"}
+
+	return &enginev1.Resource{
+		Kind: kind,
+		Id:   "r1",
+		Attr: map[string]*structpb.Value{
+			"status":  structpb.NewStringValue(randomStatuses[rng.IntN(len(randomStatuses))]),
+			"rank":    structpb.NewNumberValue(float64(rng.IntN(3))),
+			"flagged": structpb.NewBoolValue(rng.IntN(2) == 0),
+			"owner":   structpb.NewStringValue(owners[rng.IntN(len(owners))]),
+		},
+	}
+}
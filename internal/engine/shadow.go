@@ -0,0 +1,185 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"math/rand"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/engine/policyloader"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/schema"
+)
+
+const (
+	// maxConcurrentShadowChecks bounds the number of shadow evaluations in flight. Sampled requests are skipped
+	// when the limit is reached so that a slow shadow store cannot build up an unbounded backlog.
+	maxConcurrentShadowChecks = 64
+
+	divergenceEffect = "effect"
+	divergenceOutput = "output"
+	divergenceError  = "error"
+)
+
+// ShadowComponents are the components used to evaluate a sample of check requests against a secondary policy store.
+type ShadowComponents struct {
+	PolicyLoader     policyloader.PolicyLoader
+	RuleTableManager *ruletable.Manager
+}
+
+type shadow struct {
+	engine     *Engine
+	source     *auditv1.PolicySource
+	inFlight   chan struct{}
+	percentage float64
+}
+
+func newShadow(conf *evaluator.Conf, c *ShadowComponents, schemaMgr schema.Manager) *shadow {
+	// Sampled inputs are evaluated serially in a background goroutine, and never served from the decision cache.
+	shadowConf := *conf
+	shadowConf.DecisionCache.Enabled = false
+	shadowConf.PlanCache.Enabled = false
+	shadowConf.NumWorkers = 0
+
+	return &shadow{
+		engine:     NewEphemeral(&shadowConf, c.RuleTableManager, schemaMgr),
+		source:     c.PolicyLoader.Source(),
+		inFlight:   make(chan struct{}, maxConcurrentShadowChecks),
+		percentage: conf.Shadow.Percentage,
+	}
+}
+
+func (s *shadow) sample() bool {
+	return rand.Float64()*100 < s.percentage //nolint:gosec,mnd
+}
+
+// shadowCheck evaluates a sample of inputs against the shadow policies in the background and records any differences
+// from the outputs of the primary policies in the decision log.
+func (engine *Engine) shadowCheck(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, checkOpts *evaluator.CheckOptions) {
+	s := engine.shadow
+	if s == nil || len(inputs) == 0 || !s.sample() {
+		return
+	}
+
+	select {
+	case s.inFlight <- struct{}{}:
+	default:
+		metrics.Add(ctx, metrics.EngineShadowCheckCount(), int64(len(inputs)), metrics.ResultKey("skipped"))
+		return
+	}
+
+	// Evaluate at the same point in time as the primary so that time-based conditions don't produce spurious differences.
+	shadowOpts := &evaluator.CheckOptions{EvalParams: checkOpts.EvalParams}
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		defer func() { <-s.inFlight }()
+		engine.compareWithShadow(ctx, inputs, outputs, shadowOpts)
+	}()
+}
+
+func (engine *Engine) compareWithShadow(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, checkOpts *evaluator.CheckOptions) {
+	ctx, span := tracing.StartSpan(ctx, "engine.ShadowCheck")
+	defer span.End()
+
+	metrics.Add(ctx, metrics.EngineShadowCheckCount(), int64(len(inputs)), metrics.ResultKey("evaluated"))
+
+	divergence := &auditv1.DecisionLogEntry_CheckResourcesDivergence{}
+	shadowOutputs, _, err := engine.shadow.engine.checkSerial(ctx, inputs, checkOpts, nil)
+	if err != nil {
+		metrics.Add(ctx, metrics.EngineShadowDivergenceCount(), int64(len(inputs)), metrics.KindKey(divergenceError))
+		divergence.Inputs = inputs
+		divergence.Outputs = outputs
+		divergence.ShadowError = err.Error()
+	} else {
+		for i, output := range outputs {
+			kind := diverges(output, shadowOutputs[i])
+			if kind == "" {
+				continue
+			}
+
+			metrics.Inc(ctx, metrics.EngineShadowDivergenceCount(), metrics.KindKey(kind))
+			divergence.Inputs = append(divergence.Inputs, inputs[i])
+			divergence.Outputs = append(divergence.Outputs, output)
+			divergence.ShadowOutputs = append(divergence.ShadowOutputs, shadowOutputs[i])
+		}
+
+		if len(divergence.Inputs) == 0 {
+			return
+		}
+	}
+
+	divergence.ShadowPolicySource = engine.shadow.source
+	engine.logShadowDivergence(ctx, divergence)
+}
+
+func (engine *Engine) logShadowDivergence(ctx context.Context, divergence *auditv1.DecisionLogEntry_CheckResourcesDivergence) {
+	if err := engine.auditLog.WriteDecisionLogEntry(ctx, func() (*auditv1.DecisionLogEntry, error) {
+		callID, ok := audit.CallIDFromContext(ctx)
+		if !ok {
+			var err error
+			callID, err = audit.NewID()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		entry := &auditv1.DecisionLogEntry{
+			CallId:    string(callID),
+			Timestamp: timestamppb.New(time.Now()),
+			Peer:      audit.PeerFromContext(ctx),
+			Method: &auditv1.DecisionLogEntry_CheckResourcesDivergence_{
+				CheckResourcesDivergence: divergence,
+			},
+			PolicySource: engine.policyLoader.Source(),
+		}
+
+		if engine.metadataExtractor != nil {
+			entry.Metadata = engine.metadataExtractor(ctx)
+		}
+
+		return entry, nil
+	}); err != nil {
+		logging.FromContext(ctx).Warn("Failed to log shadow divergence", zap.Error(err))
+	}
+}
+
+// diverges returns the kind of difference between the primary and shadow outputs, or an empty string if they are equivalent.
+func diverges(primary, shadow *enginev1.CheckOutput) string {
+	if !maps.EqualFunc(primary.GetActions(), shadow.GetActions(), func(a, b *enginev1.CheckOutput_ActionEffect) bool {
+		return a.GetEffect() == b.GetEffect()
+	}) {
+		return divergenceEffect
+	}
+
+	if !slices.EqualFunc(sortedOutputs(primary.GetOutputs()), sortedOutputs(shadow.GetOutputs()), func(a, b *enginev1.OutputEntry) bool {
+		return proto.Equal(a, b)
+	}) {
+		return divergenceOutput
+	}
+
+	return ""
+}
+
+// sortedOutputs returns the output entries ordered by source, because rules are not evaluated in a stable order.
+func sortedOutputs(outputs []*enginev1.OutputEntry) []*enginev1.OutputEntry {
+	return slices.SortedStableFunc(slices.Values(outputs), func(a, b *enginev1.OutputEntry) int {
+		return cmp.Compare(a.GetSrc(), b.GetSrc())
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+)
+
+func TestShadowCheck(t *testing.T) {
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+	conf.Shadow.Enabled = true
+	conf.Shadow.Percentage = 100
+
+	components, primary := mkMemComponents(t)
+	primary.addOrUpdatePolicy(t, "derived_roles/document_roles.yaml", readPolicy(t, explainDerivedRoles))
+	primary.addOrUpdatePolicy(t, "resource_policies/document.yaml", readPolicy(t, explainPolicy))
+
+	shadowComponents, candidate := mkMemComponents(t)
+	candidate.addOrUpdatePolicy(t, "resource_policies/document.yaml", readPolicy(t, whatIfCandidatePolicy))
+
+	auditLog := &mockAuditLog{}
+	components.AuditLog = auditLog
+	components.Shadow = &ShadowComponents{
+		PolicyLoader:     shadowComponents.PolicyLoader,
+		RuleTableManager: shadowComponents.RuleTableManager,
+	}
+	eng := NewFromConf(t.Context(), conf, components)
+
+	input := &enginev1.CheckInput{
+		RequestId: "test",
+		Actions:   []string{"edit"},
+		Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+		Resource: &enginev1.Resource{
+			Kind: "document",
+			Id:   "doc1",
+			Attr: map[string]*structpb.Value{
+				"owner":   structpb.NewStringValue("alice"),
+				"locked":  structpb.NewBoolValue(false),
+				"editors": structpb.NewListValue(&structpb.ListValue{}),
+			},
+		},
+	}
+
+	var divergence *auditv1.DecisionLogEntry_CheckResourcesDivergence
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{input})
+		require.NoError(c, err)
+		require.Equal(c, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["edit"].GetEffect())
+
+		for _, entry := range auditLog.getDecisionLogs() {
+			if d := entry.GetCheckResourcesDivergence(); d != nil {
+				divergence = d
+			}
+		}
+		require.NotNil(c, divergence)
+	}, 1*time.Second, 50*time.Millisecond)
+
+	require.Len(t, divergence.Inputs, 1)
+	require.Equal(t, "doc1", divergence.Inputs[0].Resource.Id)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, divergence.Outputs[0].Actions["edit"].Effect)
+	require.Equal(t, effectv1.Effect_EFFECT_DENY, divergence.ShadowOutputs[0].Actions["edit"].Effect)
+	require.Empty(t, divergence.ShadowError)
+}
+
+func TestDiverges(t *testing.T) {
+	mkOutput := func(effect effectv1.Effect, outputs ...*enginev1.OutputEntry) *enginev1.CheckOutput {
+		return &enginev1.CheckOutput{
+			Actions: map[string]*enginev1.CheckOutput_ActionEffect{"view": {Effect: effect, Policy: "resource.document.vdefault"}},
+			Outputs: outputs,
+		}
+	}
+
+	outA := &enginev1.OutputEntry{Src: "a", Val: structpb.NewStringValue("a")}
+	outB := &enginev1.OutputEntry{Src: "b", Val: structpb.NewStringValue("b")}
+	outB2 := &enginev1.OutputEntry{Src: "b", Val: structpb.NewStringValue("c")}
+
+	testCases := []struct {
+		primary *enginev1.CheckOutput
+		shadow  *enginev1.CheckOutput
+		name    string
+		want    string
+	}{
+		{
+			name:    "same",
+			primary: mkOutput(effectv1.Effect_EFFECT_ALLOW, outA, outB),
+			shadow:  mkOutput(effectv1.Effect_EFFECT_ALLOW, outA, outB),
+		},
+		{
+			name:    "outputs_in_different_order",
+			primary: mkOutput(effectv1.Effect_EFFECT_ALLOW, outA, outB),
+			shadow:  mkOutput(effectv1.Effect_EFFECT_ALLOW, outB, outA),
+		},
+		{
+			name:    "different_effect",
+			primary: mkOutput(effectv1.Effect_EFFECT_ALLOW),
+			shadow:  mkOutput(effectv1.Effect_EFFECT_DENY),
+			want:    divergenceEffect,
+		},
+		{
+			name:    "different_output_value",
+			primary: mkOutput(effectv1.Effect_EFFECT_ALLOW, outA, outB),
+			shadow:  mkOutput(effectv1.Effect_EFFECT_ALLOW, outA, outB2),
+			want:    divergenceOutput,
+		},
+		{
+			name:    "missing_output",
+			primary: mkOutput(effectv1.Effect_EFFECT_ALLOW, outA, outB),
+			shadow:  mkOutput(effectv1.Effect_EFFECT_ALLOW, outA),
+			want:    divergenceOutput,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, diverges(tc.primary, tc.shadow))
+		})
+	}
+}
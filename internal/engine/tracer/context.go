@@ -19,12 +19,18 @@ func Start(sink Sink) Context {
 		return noopContext{}
 	}
 
-	return &context{sink: sink}
+	es, ok := sink.(ExplanationSink)
+	return &context{sink: sink, explain: ok && es.Explain()}
 }
 
 type context struct {
 	sink       Sink
 	components []*enginev1.Trace_Component
+	explain    bool
+}
+
+func (c *context) Explaining() bool {
+	return c.explain
 }
 
 func (c *context) StartAction(action string) Context {
@@ -141,6 +147,7 @@ func (c *context) start(component *enginev1.Trace_Component) Context {
 	return &context{
 		sink:       c.sink,
 		components: components,
+		explain:    c.explain,
 	}
 }
 
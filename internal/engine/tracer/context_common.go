@@ -33,6 +33,8 @@ type Context interface {
 	ComputedResult(result any)
 	Failed(err error, message string)
 	Skipped(err error, message string)
+	// Explaining returns true if the traces are used to build an explanation of the decision.
+	Explaining() bool
 }
 
 type noopContext struct{}
@@ -84,3 +86,5 @@ func (noopContext) ComputedResult(any) {}
 func (noopContext) Failed(error, string) {}
 
 func (noopContext) Skipped(error, string) {}
+
+func (noopContext) Explaining() bool { return false }
@@ -14,6 +14,14 @@ type Sink interface {
 	AddTrace(trace *enginev1.Trace)
 }
 
+// ExplanationSink is implemented by sinks that build explanations of decisions from the traces.
+// Traces that are only useful for explaining a decision, such as the rules skipped because they don't apply to the
+// principal's roles and the values of the sub-expressions of conditions, are only produced for these sinks.
+type ExplanationSink interface {
+	Sink
+	Explain() bool
+}
+
 type Collector struct {
 	traces []*enginev1.Trace
 	mutex  sync.RWMutex
@@ -38,3 +46,40 @@ func (c *Collector) Traces() []*enginev1.Trace {
 	defer c.mutex.RUnlock()
 	return c.traces
 }
+
+// MultiSink returns a sink that sends traces to all the given sinks that are enabled.
+// Explanation traces are produced if any of the sinks requires them.
+func MultiSink(sinks ...Sink) Sink {
+	ms := &multiSink{}
+	for _, s := range sinks {
+		if s == nil || !s.Enabled() {
+			continue
+		}
+
+		ms.sinks = append(ms.sinks, s)
+		if es, ok := s.(ExplanationSink); ok && es.Explain() {
+			ms.explain = true
+		}
+	}
+
+	return ms
+}
+
+type multiSink struct {
+	sinks   []Sink
+	explain bool
+}
+
+func (ms *multiSink) Enabled() bool {
+	return len(ms.sinks) > 0
+}
+
+func (ms *multiSink) Explain() bool {
+	return ms.explain
+}
+
+func (ms *multiSink) AddTrace(trace *enginev1.Trace) {
+	for _, s := range ms.sinks {
+		s.AddTrace(trace)
+	}
+}
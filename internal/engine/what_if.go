@@ -0,0 +1,78 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/relations"
+	"github.com/cerbos/cerbos/internal/schema"
+)
+
+// ErrWhatIfUnsupported is returned when the engine can't compile candidate policies because its policies are not compiled from source.
+var ErrWhatIfUnsupported = errors.New("evaluating candidate policies requires a store with policies in source format")
+
+// candidateCompiler is implemented by policy loaders that can compile candidate policies against the live policies.
+type candidateCompiler interface {
+	CompileWithOverrides(context.Context, []*policyv1.Policy, schema.Manager) ([]*runtimev1.RunnablePolicySet, error)
+}
+
+// WhatIf evaluates the inputs against the live policies and against the live policies overlaid with the candidate
+// policies, which replace any live policies with the same module IDs. The candidates are compiled against the live
+// policies, so they can import live derived roles, variables and constants, and scoped candidates can inherit from
+// live parent scopes. The live rule table is not modified and neither evaluation is written to the audit log.
+func (engine *Engine) WhatIf(ctx context.Context, candidates []*policyv1.Policy, inputs []*enginev1.CheckInput, opts ...evaluator.CheckOpt) (current, candidate []*enginev1.CheckOutput, err error) {
+	compiler, ok := engine.policyLoader.(candidateCompiler)
+	if !ok || engine.ruleTableManager == nil {
+		return nil, nil, ErrWhatIfUnsupported
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "engine.WhatIf")
+	defer span.End()
+
+	policySets, err := compiler.CompileWithOverrides(ctx, candidates, engine.schemaMgr)
+	if err != nil {
+		tracing.MarkFailed(span, http.StatusBadRequest, err)
+		return nil, nil, err
+	}
+
+	rtMgr, err := engine.ruleTableManager.WithPolicies(policySets)
+	if err != nil {
+		tracing.MarkFailed(span, http.StatusInternalServerError, err)
+		return nil, nil, fmt.Errorf("failed to create candidate rule table: %w", err)
+	}
+
+	// The ephemeral engines must not register change listeners on the live rule table manager.
+	conf := *engine.conf
+	conf.DecisionCache.Enabled = false
+	conf.PlanCache.Enabled = false
+	conf.NumWorkers = 0
+
+	// Both evaluations share the same relationship checker so that they see the same tuples.
+	if engine.relations != nil {
+		opts = append(opts, evaluator.WithRelations(relations.NewChecker(engine.relations)))
+	}
+
+	current, err = NewEphemeral(&conf, engine.ruleTableManager, engine.schemaMgr).Check(ctx, inputs, opts...)
+	if err != nil {
+		tracing.MarkFailed(span, http.StatusBadRequest, err)
+		return nil, nil, err
+	}
+
+	candidate, err = NewEphemeral(&conf, rtMgr, engine.schemaMgr).Check(ctx, inputs, opts...)
+	if err != nil {
+		tracing.MarkFailed(span, http.StatusBadRequest, err)
+		return nil, nil, err
+	}
+
+	return current, candidate, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/evaluator"
+)
+
+const (
+	whatIfCandidatePolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  rules:
+    - name: admin-edit
+      actions: ["edit"]
+      roles: ["admin"]
+      effect: EFFECT_ALLOW
+`
+	whatIfImportingPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: report
+  version: default
+  importDerivedRoles: ["document_roles"]
+  rules:
+    - name: editor-view
+      actions: ["view"]
+      derivedRoles: ["editor"]
+      effect: EFFECT_ALLOW
+`
+	whatIfScopedPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  scope: acme
+  rules:
+    - name: user-archive
+      actions: ["archive"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+	whatIfDerivedRoles = `---
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: document_roles
+  definitions:
+    - name: editor
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: P.attr.department == "editorial"
+    - name: reviewer
+      parentRoles: ["auditor"]
+`
+	whatIfMissingImportPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: report
+  version: default
+  importDerivedRoles: ["report_roles"]
+  rules:
+    - name: editor-view
+      actions: ["view"]
+      derivedRoles: ["editor"]
+      effect: EFFECT_ALLOW
+`
+)
+
+func TestWhatIf(t *testing.T) {
+	conf := &evaluator.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+
+	eng, ms := mkMemEngine(t, conf)
+	ms.addOrUpdatePolicy(t, "derived_roles/document_roles.yaml", readPolicy(t, explainDerivedRoles))
+	ms.addOrUpdatePolicy(t, "resource_policies/document.yaml", readPolicy(t, explainPolicy))
+
+	mkInput := func(kind, scope, owner string, actions ...string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   actions,
+			Principal: &enginev1.Principal{
+				Id:    "alice",
+				Roles: []string{"user"},
+				Attr:  map[string]*structpb.Value{"department": structpb.NewStringValue("editorial")},
+			},
+			Resource: &enginev1.Resource{
+				Kind:  kind,
+				Id:    "doc1",
+				Scope: scope,
+				Attr: map[string]*structpb.Value{
+					"owner":   structpb.NewStringValue(owner),
+					"locked":  structpb.NewBoolValue(false),
+					"editors": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("alice")}}),
+				},
+			},
+		}
+	}
+	waitForAllow(t, eng, mkInput("document", "", "alice", "edit"))
+
+	whatIf := func(t *testing.T, candidates []*policyv1.Policy, input *enginev1.CheckInput) (current, candidate *enginev1.CheckOutput) {
+		t.Helper()
+
+		currentOutputs, candidateOutputs, err := eng.WhatIf(t.Context(), candidates, []*enginev1.CheckInput{input})
+		require.NoError(t, err)
+		require.Len(t, currentOutputs, 1)
+		require.Len(t, candidateOutputs, 1)
+
+		return currentOutputs[0], candidateOutputs[0]
+	}
+
+	t.Run("replaces_live_policy", func(t *testing.T) {
+		input := mkInput("document", "", "alice", "edit")
+		current, candidate := whatIf(t, []*policyv1.Policy{readPolicy(t, whatIfCandidatePolicy)}, input)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, current.Actions["edit"].Effect)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, candidate.Actions["edit"].Effect)
+
+		// the live rule table must be unaffected
+		outputs, err := eng.Check(t.Context(), []*enginev1.CheckInput{input})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["edit"].Effect)
+	})
+
+	t.Run("imports_live_derived_roles", func(t *testing.T) {
+		current, candidate := whatIf(t, []*policyv1.Policy{readPolicy(t, whatIfImportingPolicy)}, mkInput("report", "", "bob", "view"))
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, current.Actions["view"].Effect)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, candidate.Actions["view"].Effect)
+		require.Equal(t, "resource.report.vdefault", candidate.Actions["view"].Policy)
+	})
+
+	t.Run("scoped_with_live_parent", func(t *testing.T) {
+		current, candidate := whatIf(t, []*policyv1.Policy{readPolicy(t, whatIfScopedPolicy)}, mkInput("document", "acme", "alice", "edit", "archive"))
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, current.Actions["archive"].Effect)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, candidate.Actions["archive"].Effect)
+		require.Equal(t, "resource.document.vdefault/acme", candidate.Actions["archive"].Policy)
+		// edit is not covered by the candidate, so the live parent scope decides
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, candidate.Actions["edit"].Effect)
+	})
+
+	t.Run("recompiles_live_dependents", func(t *testing.T) {
+		input := mkInput("document", "", "bob", "edit")
+		input.Resource.Attr["editors"] = structpb.NewListValue(&structpb.ListValue{})
+
+		current, candidate := whatIf(t, []*policyv1.Policy{readPolicy(t, whatIfDerivedRoles)}, input)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, current.Actions["edit"].Effect)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, candidate.Actions["edit"].Effect)
+	})
+
+	t.Run("invalid_candidate", func(t *testing.T) {
+		_, _, err := eng.WhatIf(t.Context(), []*policyv1.Policy{readPolicy(t, whatIfMissingImportPolicy)}, []*enginev1.CheckInput{mkInput("report", "", "bob", "view")})
+		require.ErrorIs(t, err, compile.PolicyCompilationErr{})
+	})
+}
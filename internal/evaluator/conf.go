@@ -9,6 +9,9 @@ import (
 	"strings"
 	"time"
 
+	"go.uber.org/multierr"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/namer"
 )
@@ -17,9 +20,31 @@ const (
 	confKey = "engine"
 
 	defaultPolicyLoaderTimeout = 2 * time.Second
+	defaultDecisionCacheSize   = 1024
+	defaultDecisionCacheTTL    = 1 * time.Minute
+	defaultPlanCacheSize       = 1024
+	defaultPlanCacheTTL        = 1 * time.Minute
+	maxShadowPercentage        = 100
 )
 
-var errEmptyDefaultVersion = errors.New("engine.defaultVersion must not be an empty string")
+var (
+	errEmptyDefaultVersion       = errors.New("engine.defaultVersion must not be an empty string")
+	errInvalidCombiningAlgorithm = errors.New("engine.defaultCombiningAlgorithm must be one of denyOverrides, permitOverrides, firstApplicable or denyUnlessPermit")
+	errInvalidDecisionCacheSize  = errors.New("engine.decisionCache.size must be greater than zero")
+	errInvalidDecisionCacheTTL   = errors.New("engine.decisionCache.ttl must be greater than zero")
+	errInvalidPlanCacheSize      = errors.New("engine.planCache.size must be greater than zero")
+	errInvalidPlanCacheTTL       = errors.New("engine.planCache.ttl must be greater than zero")
+	errInvalidPlanCacheTimeTTL   = errors.New("engine.planCache.timeDependentTTL must not be negative")
+	errInvalidShadowPercentage   = errors.New("engine.shadow.percentage must be greater than zero and less than or equal to 100")
+	errMissingShadowStorage      = errors.New("engine.shadow.storage.driver must be set")
+)
+
+var combiningAlgorithms = map[string]policyv1.CombiningAlgorithm{
+	"denyOverrides":    policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES,
+	"permitOverrides":  policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES,
+	"firstApplicable":  policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE,
+	"denyUnlessPermit": policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT,
+}
 
 // Conf is optional configuration for engine.
 type Conf struct {
@@ -29,9 +54,48 @@ type Conf struct {
 	DefaultPolicyVersion string `yaml:"defaultPolicyVersion" conf:",example=\"default\""`
 	// LenientScopeSearch configures the engine to ignore missing scopes and search upwards through the scope tree until it finds a usable policy.
 	LenientScopeSearch bool `yaml:"lenientScopeSearch" conf:",example=false"`
+	// DefaultCombiningAlgorithm is the algorithm used to combine rule effects in policies that don't declare their own. Valid values are denyOverrides, permitOverrides, firstApplicable and denyUnlessPermit.
+	DefaultCombiningAlgorithm string `yaml:"defaultCombiningAlgorithm" conf:",example=denyOverrides"`
+	// ConditionCostLimit is the maximum runtime cost of evaluating a single condition expression, as computed by CEL cost tracking. Actions whose conditions exceed the limit are denied. Policies can override it with conditionCostLimit. Set to zero to disable the limit.
+	ConditionCostLimit uint64 `yaml:"conditionCostLimit" conf:",example=1000000"`
 	// PolicyLoaderTimeout is the timeout for loading policies from the policy store.
 	PolicyLoaderTimeout time.Duration `yaml:"policyLoaderTimeout" conf:",example=2s"`
-	NumWorkers          uint          `yaml:"numWorkers" conf:",ignore"`
+	// DecisionCache configures an optional in-memory cache of check decisions.
+	DecisionCache DecisionCacheConf `yaml:"decisionCache"`
+	// PlanCache configures an optional in-memory cache of query plans.
+	PlanCache PlanCacheConf `yaml:"planCache"`
+	// Shadow configures an optional secondary policy store to evaluate a sample of check requests against.
+	Shadow     ShadowConf `yaml:"shadow"`
+	NumWorkers uint       `yaml:"numWorkers" conf:",ignore"`
+}
+
+type DecisionCacheConf struct {
+	// Enabled turns on caching of check decisions. Decisions that depend on the current time are never cached.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// Size is the maximum number of decisions to keep in the cache.
+	Size uint `yaml:"size" conf:",example=1024"`
+	// TTL is the maximum length of time a decision is kept in the cache.
+	TTL time.Duration `yaml:"ttl" conf:",example=60s"`
+}
+
+type PlanCacheConf struct {
+	// Enabled turns on caching of query plans.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// Size is the maximum number of plans to keep in the cache.
+	Size uint `yaml:"size" conf:",example=1024"`
+	// TTL is the maximum length of time a plan is kept in the cache.
+	TTL time.Duration `yaml:"ttl" conf:",example=60s"`
+	// TimeDependentTTL is the maximum length of time a plan that depends on the current time is kept in the cache. Set to zero to never cache such plans.
+	TimeDependentTTL time.Duration `yaml:"timeDependentTTL" conf:",example=0s"`
+}
+
+type ShadowConf struct {
+	// Storage configures the store containing the shadow policies, using the same format as the top-level storage section.
+	Storage map[string]any `yaml:"storage" conf:",example=\n    driver: git\n    git:\n      protocol: https\n      url: https://github.com/cerbos/policy-test.git\n      branch: candidate\n      checkoutDir: /tmp/cerbos/shadow"`
+	// Enabled turns on shadow evaluation. Responses are always produced by the primary store, and any differences in the results of the shadow store are written to the decision log.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// Percentage is the percentage of check requests to evaluate against the shadow store.
+	Percentage float64 `yaml:"percentage" conf:",example=10"`
 }
 
 func (c *Conf) Key() string {
@@ -40,16 +104,100 @@ func (c *Conf) Key() string {
 
 func (c *Conf) SetDefaults() {
 	c.DefaultPolicyVersion = namer.DefaultVersion
+	c.DefaultCombiningAlgorithm = CombiningAlgorithmName(policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES)
 	c.PolicyLoaderTimeout = defaultPolicyLoaderTimeout
 	c.NumWorkers = uint(runtime.NumCPU() + 4) //nolint:mnd
+	c.DecisionCache.Size = defaultDecisionCacheSize
+	c.DecisionCache.TTL = defaultDecisionCacheTTL
+	c.PlanCache.Size = defaultPlanCacheSize
+	c.PlanCache.TTL = defaultPlanCacheTTL
 }
 
-func (c *Conf) Validate() error {
+func (c *Conf) Validate() (errs error) {
 	if strings.TrimSpace(c.DefaultPolicyVersion) == "" {
-		return errEmptyDefaultVersion
+		errs = multierr.Append(errs, errEmptyDefaultVersion)
+	}
+
+	if c.DefaultCombiningAlgorithm != "" {
+		if _, ok := combiningAlgorithms[c.DefaultCombiningAlgorithm]; !ok {
+			errs = multierr.Append(errs, errInvalidCombiningAlgorithm)
+		}
+	}
+
+	if c.DecisionCache.Enabled {
+		if c.DecisionCache.Size == 0 {
+			errs = multierr.Append(errs, errInvalidDecisionCacheSize)
+		}
+
+		if c.DecisionCache.TTL <= 0 {
+			errs = multierr.Append(errs, errInvalidDecisionCacheTTL)
+		}
+	}
+
+	if c.PlanCache.Enabled {
+		if c.PlanCache.Size == 0 {
+			errs = multierr.Append(errs, errInvalidPlanCacheSize)
+		}
+
+		if c.PlanCache.TTL <= 0 {
+			errs = multierr.Append(errs, errInvalidPlanCacheTTL)
+		}
+
+		if c.PlanCache.TimeDependentTTL < 0 {
+			errs = multierr.Append(errs, errInvalidPlanCacheTimeTTL)
+		}
+	}
+
+	if c.Shadow.Enabled {
+		if c.Shadow.Percentage <= 0 || c.Shadow.Percentage > maxShadowPercentage {
+			errs = multierr.Append(errs, errInvalidShadowPercentage)
+		}
+
+		if driver, _ := c.Shadow.Storage["driver"].(string); driver == "" {
+			errs = multierr.Append(errs, errMissingShadowStorage)
+		}
+	}
+
+	return errs
+}
+
+// CombiningAlgorithm returns the algorithm to use for a policy that declares the given algorithm.
+func (c *Conf) CombiningAlgorithm(declared policyv1.CombiningAlgorithm) policyv1.CombiningAlgorithm {
+	if declared != policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_UNSPECIFIED {
+		return declared
+	}
+
+	if c != nil {
+		if alg, ok := combiningAlgorithms[c.DefaultCombiningAlgorithm]; ok {
+			return alg
+		}
+	}
+
+	return policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES
+}
+
+// CostLimit returns the condition cost limit to use for a policy that declares the given limit.
+func (c *Conf) CostLimit(declared uint32) uint64 {
+	if declared > 0 {
+		return uint64(declared)
+	}
+
+	if c != nil {
+		return c.ConditionCostLimit
+	}
+
+	return 0
+}
+
+// CombiningAlgorithmName returns the name used to refer to the algorithm in configuration and traces.
+func CombiningAlgorithmName(alg policyv1.CombiningAlgorithm) string {
+	for name, a := range combiningAlgorithms {
+		if a == alg {
+			return name
+		}
 	}
 
-	return nil
+	return alg.String()
 }
 
 func GetConf() (*Conf, error) {
@@ -34,6 +34,13 @@ func WithNowFunc(nowFunc func() time.Time) CheckOpt {
 	}
 }
 
+// WithRelations sets the checker used by the `related` function during condition evaluation.
+func WithRelations(checker conditions.RelationChecker) CheckOpt {
+	return func(co *CheckOptions) {
+		co.EvalParams.Relations = checker
+	}
+}
+
 // WithLenientScopeSearch enables lenient scope search.
 func WithLenientScopeSearch() CheckOpt {
 	return func(co *CheckOptions) {
@@ -79,6 +86,7 @@ func (co *CheckOptions) Globals() map[string]any {
 type EvalParams struct {
 	Globals              map[string]any
 	NowFunc              conditions.NowFunc
+	Relations            conditions.RelationChecker
 	DefaultPolicyVersion string
 	LenientScopeSearch   bool
 }
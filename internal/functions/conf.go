@@ -0,0 +1,116 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+const (
+	confKey = "functions"
+
+	defaultMaxMemoryPages = 256 // 16MiB
+	defaultTimeout        = 10 * time.Millisecond
+	maxMemoryPages        = 65536
+)
+
+var functionNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Conf is optional configuration for custom functions implemented by WebAssembly modules.
+type Conf struct {
+	// Modules is the list of WebAssembly modules that implement custom functions for policy conditions.
+	Modules []ModuleConf `yaml:"modules"`
+	// MaxMemoryPages is the maximum number of 64KiB memory pages that each instance of a module can use.
+	MaxMemoryPages uint32 `yaml:"maxMemoryPages" conf:",example=256"`
+	// Timeout is the maximum length of time that a single function call can run for.
+	Timeout time.Duration `yaml:"timeout" conf:",example=10ms"`
+}
+
+type ModuleConf struct {
+	// Path is the path to the WebAssembly module file.
+	Path string `yaml:"path" conf:"required,example=/etc/cerbos/functions.wasm"`
+	// Functions is the list of functions exported by the module.
+	Functions []FunctionConf `yaml:"functions"`
+}
+
+type FunctionConf struct {
+	// Name is the name used to call the function in policy conditions.
+	Name string `yaml:"name" conf:"required,example=tenantChecksum"`
+	// Export is the name of the function exported by the module. Defaults to the value of name.
+	Export string `yaml:"export" conf:",example=tenant_checksum"`
+	// Result is the type of the value returned by the function. One of bool, int, uint, double, string or bytes.
+	Result string `yaml:"result" conf:"required,example=bool"`
+	// Params is the list of parameter types of the function. Each one is one of bool, int, uint, double, string or bytes.
+	Params []string `yaml:"params" conf:",example=[string]"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.MaxMemoryPages = defaultMaxMemoryPages
+	c.Timeout = defaultTimeout
+}
+
+func (c *Conf) Validate() (errs error) {
+	if c.MaxMemoryPages == 0 || c.MaxMemoryPages > maxMemoryPages {
+		errs = multierr.Append(errs, fmt.Errorf("functions.maxMemoryPages must be between 1 and %d", maxMemoryPages))
+	}
+
+	if c.Timeout <= 0 {
+		errs = multierr.Append(errs, fmt.Errorf("functions.timeout must be greater than zero"))
+	}
+
+	names := make(map[string]struct{})
+	for _, m := range c.Modules {
+		if m.Path == "" {
+			errs = multierr.Append(errs, fmt.Errorf("functions.modules.path must be set"))
+		}
+
+		for _, fn := range m.Functions {
+			if !functionNameRegex.MatchString(fn.Name) {
+				errs = multierr.Append(errs, fmt.Errorf("invalid function name %q", fn.Name))
+			}
+
+			if _, ok := names[fn.Name]; ok {
+				errs = multierr.Append(errs, fmt.Errorf("duplicate function name %q", fn.Name))
+			}
+			names[fn.Name] = struct{}{}
+
+			if _, ok := valueTypes[fn.Result]; !ok {
+				errs = multierr.Append(errs, fmt.Errorf("invalid result type %q for function %q", fn.Result, fn.Name))
+			}
+
+			for _, p := range fn.Params {
+				if _, ok := valueTypes[p]; !ok {
+					errs = multierr.Append(errs, fmt.Errorf("invalid parameter type %q for function %q", p, fn.Name))
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func (fc FunctionConf) export() string {
+	if fc.Export != "" {
+		return fc.Export
+	}
+
+	return fc.Name
+}
+
+func GetConf() (*Conf, error) {
+	conf := &Conf{}
+	err := config.GetSection(conf)
+
+	return conf, err
+}
@@ -0,0 +1,387 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+const (
+	// allocateExport is the function that modules must export to receive string and bytes arguments.
+	// It is called with the number of bytes required and must return a pointer to that much free memory.
+	allocateExport = "allocate"
+	// initializeExport is the function that WASI reactor modules export to initialise themselves.
+	initializeExport = "_initialize"
+)
+
+// valueType describes how values of a type declared in the configuration are represented in CEL and WebAssembly.
+type valueType struct {
+	celType *cel.Type
+	// params are the WebAssembly parameters used to pass a value to a function.
+	// Strings and bytes are passed as a pointer to the data and its length.
+	params []api.ValueType
+	// result is the WebAssembly type used to return a value from a function.
+	// Strings and bytes are returned as an i64 with the pointer in the high 32 bits and the length in the low 32 bits.
+	result api.ValueType
+}
+
+func (vt valueType) isBuffer() bool {
+	return len(vt.params) > 1
+}
+
+var valueTypes = map[string]valueType{
+	"bool":   {celType: cel.BoolType, params: []api.ValueType{api.ValueTypeI32}, result: api.ValueTypeI32},
+	"int":    {celType: cel.IntType, params: []api.ValueType{api.ValueTypeI64}, result: api.ValueTypeI64},
+	"uint":   {celType: cel.UintType, params: []api.ValueType{api.ValueTypeI64}, result: api.ValueTypeI64},
+	"double": {celType: cel.DoubleType, params: []api.ValueType{api.ValueTypeF64}, result: api.ValueTypeF64},
+	"string": {celType: cel.StringType, params: []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, result: api.ValueTypeI64},
+	"bytes":  {celType: cel.BytesType, params: []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, result: api.ValueTypeI64},
+}
+
+// Runtime runs the WebAssembly modules that implement custom functions.
+type Runtime struct {
+	runtime   wazero.Runtime
+	functions []conditions.Function
+	modules   []*module
+}
+
+// New loads the custom functions defined in the configuration and registers them with the CEL environment used by conditions.
+func New(ctx context.Context) (*Runtime, error) {
+	conf, err := GetConf()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := Load(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conditions.RegisterFunctions(r.Functions()...); err != nil {
+		_ = r.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewFromFile loads the custom functions defined in a Cerbos configuration file and registers them with the CEL environment.
+// It allows commands that evaluate conditions without a running server to use the same functions.
+func NewFromFile(ctx context.Context, confFile string) (*Runtime, error) {
+	if err := config.Load(confFile, nil); err != nil {
+		return nil, fmt.Errorf("failed to load config file %q: %w", confFile, err)
+	}
+
+	return New(ctx)
+}
+
+// Load compiles the modules defined in the configuration and checks that they export the declared functions.
+func Load(ctx context.Context, conf *Conf) (*Runtime, error) {
+	if len(conf.Modules) == 0 {
+		return &Runtime{}, nil
+	}
+
+	// Modules have no access to the filesystem, network, clock or environment of the host.
+	rt := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(conf.MaxMemoryPages).
+		WithCloseOnContextDone(true))
+
+	r := &Runtime{runtime: rt}
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, rt); err != nil {
+		_ = r.Close()
+		return nil, fmt.Errorf("failed to instantiate WASI: %w", err)
+	}
+
+	for _, mc := range conf.Modules {
+		m, err := loadModule(ctx, rt, mc, conf.Timeout)
+		if err != nil {
+			_ = r.Close()
+			return nil, fmt.Errorf("failed to load module %q: %w", mc.Path, err)
+		}
+
+		r.modules = append(r.modules, m)
+		for _, fc := range mc.Functions {
+			r.functions = append(r.functions, m.function(fc))
+		}
+	}
+
+	return r, nil
+}
+
+// Functions returns the custom functions implemented by the modules.
+func (r *Runtime) Functions() []conditions.Function {
+	return r.functions
+}
+
+func (r *Runtime) Close() error {
+	if r.runtime == nil {
+		return nil
+	}
+
+	var errs error
+	for _, m := range r.modules {
+		errs = multierr.Append(errs, m.close())
+	}
+
+	return multierr.Append(errs, r.runtime.Close(context.Background()))
+}
+
+type module struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	// instances is a pool of idle module instances. Instances are not safe for concurrent use.
+	instances chan api.Module
+	timeout   time.Duration
+}
+
+func loadModule(ctx context.Context, rt wazero.Runtime, conf ModuleConf, timeout time.Duration) (*module, error) {
+	bin, err := os.ReadFile(conf.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := rt.CompileModule(ctx, bin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile module: %w", err)
+	}
+
+	if err := checkExports(compiled, conf.Functions); err != nil {
+		return nil, err
+	}
+
+	return &module{
+		runtime:   rt,
+		compiled:  compiled,
+		instances: make(chan api.Module, runtime.GOMAXPROCS(0)),
+		timeout:   timeout,
+	}, nil
+}
+
+func checkExports(compiled wazero.CompiledModule, functions []FunctionConf) (errs error) {
+	exports := compiled.ExportedFunctions()
+
+	needsAllocate, needsMemory := false, false
+	for _, fc := range functions {
+		def, ok := exports[fc.export()]
+		if !ok {
+			errs = multierr.Append(errs, fmt.Errorf("function %q is not exported", fc.export()))
+			continue
+		}
+
+		var params []api.ValueType
+		for _, p := range fc.Params {
+			params = append(params, valueTypes[p].params...)
+			needsAllocate = needsAllocate || valueTypes[p].isBuffer()
+		}
+
+		needsMemory = needsMemory || needsAllocate || valueTypes[fc.Result].isBuffer()
+
+		result := []api.ValueType{valueTypes[fc.Result].result}
+		if !slices.Equal(def.ParamTypes(), params) || !slices.Equal(def.ResultTypes(), result) {
+			errs = multierr.Append(errs, fmt.Errorf("exported function %q has signature %s, expected %s",
+				fc.export(), signature(def.ParamTypes(), def.ResultTypes()), signature(params, result)))
+		}
+	}
+
+	if needsAllocate {
+		if def, ok := exports[allocateExport]; !ok ||
+			!slices.Equal(def.ParamTypes(), []api.ValueType{api.ValueTypeI32}) ||
+			!slices.Equal(def.ResultTypes(), []api.ValueType{api.ValueTypeI32}) {
+			errs = multierr.Append(errs, fmt.Errorf("module must export %q with signature (i32) -> (i32) to receive string or bytes arguments", allocateExport))
+		}
+	}
+
+	if needsMemory && len(compiled.ExportedMemories()) == 0 {
+		errs = multierr.Append(errs, errors.New("module must export its memory to exchange string or bytes values"))
+	}
+
+	return errs
+}
+
+func signature(params, results []api.ValueType) string {
+	names := func(vts []api.ValueType) []string {
+		out := make([]string, len(vts))
+		for i, vt := range vts {
+			out[i] = api.ValueTypeName(vt)
+		}
+		return out
+	}
+
+	return fmt.Sprintf("%v -> %v", names(params), names(results))
+}
+
+func (m *module) function(conf FunctionConf) conditions.Function {
+	params := make([]*cel.Type, len(conf.Params))
+	for i, p := range conf.Params {
+		params[i] = valueTypes[p].celType
+	}
+
+	return conditions.Function{
+		Name:   conf.Name,
+		Params: params,
+		Result: valueTypes[conf.Result].celType,
+		Impl: func(args ...ref.Val) ref.Val {
+			return m.call(conf, args)
+		},
+	}
+}
+
+func (m *module) call(conf FunctionConf, args []ref.Val) ref.Val {
+	ctx, cancelFn := context.WithTimeout(context.Background(), m.timeout)
+	defer cancelFn()
+
+	inst, err := m.acquire(ctx)
+	if err != nil {
+		return types.NewErr("failed to instantiate module for function %s: %v", conf.Name, err)
+	}
+
+	result, err := invoke(ctx, inst, conf, args)
+	if err != nil {
+		// The state of the instance is unknown after a failure, so it must not be reused.
+		_ = inst.Close(context.Background())
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return types.NewErr("function %s timed out after %s", conf.Name, m.timeout)
+		}
+		return types.NewErr("function %s failed: %v", conf.Name, err)
+	}
+
+	m.release(inst)
+	return result
+}
+
+func (m *module) acquire(ctx context.Context) (api.Module, error) {
+	select {
+	case inst := <-m.instances:
+		return inst, nil
+	default:
+		return m.runtime.InstantiateModule(ctx, m.compiled, wazero.NewModuleConfig().WithName("").WithStartFunctions(initializeExport))
+	}
+}
+
+func (m *module) release(inst api.Module) {
+	select {
+	case m.instances <- inst:
+	default:
+		_ = inst.Close(context.Background())
+	}
+}
+
+func (m *module) close() (errs error) {
+	for {
+		select {
+		case inst := <-m.instances:
+			errs = multierr.Append(errs, inst.Close(context.Background()))
+		default:
+			return errs
+		}
+	}
+}
+
+func invoke(ctx context.Context, inst api.Module, conf FunctionConf, args []ref.Val) (ref.Val, error) {
+	if len(args) != len(conf.Params) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(conf.Params), len(args))
+	}
+
+	var params []uint64
+	for i, arg := range args {
+		p, err := encode(ctx, inst, conf.Params[i], arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d: %w", i, err)
+		}
+		params = append(params, p...)
+	}
+
+	results, err := inst.ExportedFunction(conf.export()).Call(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode(inst, conf.Result, results[0])
+}
+
+func encode(ctx context.Context, inst api.Module, typ string, arg ref.Val) ([]uint64, error) {
+	switch a := arg.(type) {
+	case types.Bool:
+		if a {
+			return []uint64{api.EncodeI32(1)}, nil
+		}
+		return []uint64{api.EncodeI32(0)}, nil
+	case types.Int:
+		return []uint64{api.EncodeI64(int64(a))}, nil
+	case types.Uint:
+		return []uint64{uint64(a)}, nil
+	case types.Double:
+		return []uint64{api.EncodeF64(float64(a))}, nil
+	case types.String:
+		return write(ctx, inst, []byte(a))
+	case types.Bytes:
+		return write(ctx, inst, a)
+	default:
+		return nil, fmt.Errorf("unexpected value of type %s for parameter of type %s", arg.Type().TypeName(), typ)
+	}
+}
+
+func write(ctx context.Context, inst api.Module, data []byte) ([]uint64, error) {
+	size := uint32(len(data)) //nolint:gosec
+	results, err := inst.ExportedFunction(allocateExport).Call(ctx, api.EncodeU32(size))
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate memory: %w", err)
+	}
+
+	ptr := api.DecodeU32(results[0])
+	if !inst.Memory().Write(ptr, data) {
+		return nil, fmt.Errorf("allocated memory at %d is out of range", ptr)
+	}
+
+	return []uint64{api.EncodeU32(ptr), api.EncodeU32(size)}, nil
+}
+
+func decode(inst api.Module, typ string, result uint64) (ref.Val, error) {
+	switch typ {
+	case "bool":
+		return types.Bool(api.DecodeI32(result) != 0), nil
+	case "int":
+		return types.Int(int64(result)), nil //nolint:gosec
+	case "uint":
+		return types.Uint(result), nil
+	case "double":
+		return types.Double(api.DecodeF64(result)), nil
+	case "string", "bytes":
+		ptr, size := uint32(result>>32), uint32(result) //nolint:gosec,mnd
+		data, ok := inst.Memory().Read(ptr, size)
+		if !ok {
+			return nil, fmt.Errorf("result at %d with length %d is out of range", ptr, size)
+		}
+
+		// The memory view is only valid until the instance is used again, so it must be copied.
+		if typ == "bytes" {
+			return types.Bytes(slices.Clone(data)), nil
+		}
+
+		if !utf8.Valid(data) {
+			return nil, errors.New("result is not a valid UTF-8 string")
+		}
+		return types.String(data), nil
+	default:
+		return nil, fmt.Errorf("unsupported result type %s", typ)
+	}
+}
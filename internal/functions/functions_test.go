@@ -0,0 +1,138 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package functions_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/functions"
+)
+
+func TestFunctions(t *testing.T) {
+	conf := mkConf(
+		functions.FunctionConf{Name: "add", Params: []string{"int", "int"}, Result: "int"},
+		functions.FunctionConf{Name: "echo", Params: []string{"string"}, Result: "string"},
+		functions.FunctionConf{Name: "echoBytes", Export: "echo", Params: []string{"bytes"}, Result: "bytes"},
+		functions.FunctionConf{Name: "length", Params: []string{"string"}, Result: "int"},
+		functions.FunctionConf{Name: "isPositive", Export: "is_positive", Params: []string{"double"}, Result: "bool"},
+		functions.FunctionConf{Name: "fail", Result: "bool"},
+		functions.FunctionConf{Name: "grow", Result: "bool"},
+		functions.FunctionConf{Name: "spin", Result: "bool"},
+	)
+	conf.MaxMemoryPages = 16
+	conf.Timeout = 50 * time.Millisecond
+	registerFunctions(t, conf)
+
+	testCases := []struct {
+		want    ref.Val
+		expr    string
+		wantErr string
+	}{
+		{expr: `add(40, 2)`, want: types.Int(42)},
+		{expr: `echo("héllo")`, want: types.String("héllo")},
+		{expr: `echoBytes(b"\x00\x01")`, want: types.Bytes([]byte{0, 1})},
+		{expr: `length("héllo")`, want: types.Int(6)},
+		{expr: `length(echo("abc")) == 3 && isPositive(0.5) && !isPositive(-0.5)`, want: types.True},
+		{expr: `fail()`, wantErr: "function fail failed"},
+		{expr: `grow()`, want: types.False},
+		{expr: `spin()`, wantErr: "function spin timed out"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			ast, issues := conditions.StdEnv.Compile(tc.expr)
+			require.NoError(t, issues.Err())
+
+			val, _, err := conditions.ContextEval(t.Context(), conditions.StdEnv, ast.NativeRep(), cel.NoVars(), conditions.Now())
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, val)
+		})
+	}
+
+	t.Run("type_checked", func(t *testing.T) {
+		_, issues := conditions.StdEnv.Compile(`add("a", 1)`)
+		require.ErrorContains(t, issues.Err(), "found no matching overload for 'add'")
+	})
+}
+
+func TestLoadErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		wantErr string
+		fn      functions.FunctionConf
+	}{
+		{
+			name:    "missing_export",
+			fn:      functions.FunctionConf{Name: "missing", Result: "bool"},
+			wantErr: `function "missing" is not exported`,
+		},
+		{
+			name:    "wrong_signature",
+			fn:      functions.FunctionConf{Name: "add", Params: []string{"string"}, Result: "int"},
+			wantErr: `exported function "add" has signature [i64 i64] -> [i64], expected [i32 i32] -> [i64]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := functions.Load(t.Context(), mkConf(tc.fn))
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+
+	t.Run("builtin_conflict", func(t *testing.T) {
+		r, err := functions.Load(t.Context(), mkConf(functions.FunctionConf{Name: "now", Export: "spin", Result: "bool"}))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, r.Close()) })
+
+		require.ErrorContains(t, conditions.RegisterFunctions(r.Functions()...), `custom function "now" conflicts with a built-in function`)
+	})
+}
+
+func TestConfValidate(t *testing.T) {
+	conf := mkConf(
+		functions.FunctionConf{Name: "add", Params: []string{"int", "int"}, Result: "int"},
+		functions.FunctionConf{Name: "add", Params: []string{"list"}, Result: "int"},
+		functions.FunctionConf{Name: "not-valid", Result: "map"},
+	)
+
+	err := conf.Validate()
+	require.ErrorContains(t, err, `duplicate function name "add"`)
+	require.ErrorContains(t, err, `invalid parameter type "list" for function "add"`)
+	require.ErrorContains(t, err, `invalid function name "not-valid"`)
+	require.ErrorContains(t, err, `invalid result type "map" for function "not-valid"`)
+}
+
+func mkConf(fns ...functions.FunctionConf) *functions.Conf {
+	conf := &functions.Conf{}
+	conf.SetDefaults()
+	conf.Modules = []functions.ModuleConf{{Path: filepath.Join("testdata", "functions.wasm"), Functions: fns}}
+	return conf
+}
+
+func registerFunctions(t *testing.T, conf *functions.Conf) {
+	t.Helper()
+
+	r, err := functions.Load(t.Context(), conf)
+	require.NoError(t, err)
+	require.NoError(t, conditions.RegisterFunctions(r.Functions()...))
+
+	t.Cleanup(func() {
+		require.NoError(t, conditions.RegisterFunctions())
+		require.NoError(t, r.Close())
+	})
+}
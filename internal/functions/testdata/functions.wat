@@ -0,0 +1,54 @@
+;; Source of functions.wasm. Rebuild with: wat2wasm functions.wat -o functions.wasm
+(module
+  (memory (export "memory") 1)
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; Bump allocator used by the host to pass strings and bytes.
+  (func (export "allocate") (param $size i32) (result i32)
+    (local $ptr i32)
+    global.get $heap
+    local.set $ptr
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap
+    local.get $ptr)
+
+  (func (export "add") (param i64 i64) (result i64)
+    local.get 0
+    local.get 1
+    i64.add)
+
+  (func (export "spin") (result i32)
+    (loop $forever
+      br $forever)
+    i32.const 0)
+
+  ;; Returns the string argument by packing its pointer and length into an i64.
+  (func (export "echo") (param i32 i32) (result i64)
+    local.get 0
+    i64.extend_i32_u
+    i64.const 32
+    i64.shl
+    local.get 1
+    i64.extend_i32_u
+    i64.or)
+
+  (func (export "length") (param i32 i32) (result i64)
+    local.get 1
+    i64.extend_i32_u)
+
+  (func (export "is_positive") (param f64) (result i32)
+    local.get 0
+    f64.const 0
+    f64.gt)
+
+  (func (export "fail") (result i32)
+    unreachable)
+
+  ;; Returns true if the memory could be grown by 100 pages.
+  (func (export "grow") (result i32)
+    i32.const 100
+    memory.grow
+    i32.const -1
+    i32.ne))
@@ -150,6 +150,20 @@ var (
 		)
 	})
 
+	EngineShadowCheckCount = once(func() (metric.Int64Counter, error) {
+		return Meter().Int64Counter(
+			"cerbos_dev_engine_shadow_check_count",
+			metric.WithDescription("Number of check inputs sampled for evaluation against the shadow policies"),
+		)
+	})
+
+	EngineShadowDivergenceCount = once(func() (metric.Int64Counter, error) {
+		return Meter().Int64Counter(
+			"cerbos_dev_engine_shadow_divergence_count",
+			metric.WithDescription("Number of check inputs for which the shadow policies produced a different result"),
+		)
+	})
+
 	HubConnected = once(func() (metric.Int64UpDownCounter, error) {
 		return Meter().Int64UpDownCounter(
 			"cerbos_dev_hub_connected",
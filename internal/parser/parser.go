@@ -180,6 +180,11 @@ func UnmarshalBytes[T proto.Message](contents []byte, factory func() T, opts ...
 	return outMsg, outSrc, outErr
 }
 
+// Parse parses the contents into a YAML syntax tree that retains comments.
+func Parse(contents []byte) (*ast.File, error) {
+	return parse(contents, true)
+}
+
 func parse(contents []byte, detectProblems bool) (_ *ast.File, outErr error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -0,0 +1,355 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	indentWidth = 2
+	lineWidth   = 100
+)
+
+var (
+	ambiguousWords = map[string]struct{}{
+		"true": {}, "false": {}, "yes": {}, "no": {}, "on": {}, "off": {}, "y": {}, "n": {}, "null": {}, "~": {}, "<<": {},
+	}
+	numberLike = regexp.MustCompile(`^[-+]?(\.?[0-9]|\.(inf|nan)$)`)
+)
+
+type emitter struct {
+	strings.Builder
+}
+
+func emit(docs []*node, trailing []comment) []byte {
+	e := &emitter{}
+	for i, doc := range docs {
+		if i > 0 {
+			e.WriteString("---\n")
+		}
+		e.elements(doc.elements, 0, "")
+	}
+
+	for _, c := range trailing {
+		if c.blankBefore && e.Len() > 0 {
+			e.WriteString("\n")
+		}
+		e.line(0, c.text)
+	}
+
+	return []byte(e.String())
+}
+
+func (e *emitter) line(indent int, text string) {
+	e.WriteString(strings.Repeat(" ", indent))
+	e.WriteString(text)
+	e.WriteString("\n")
+}
+
+func (e *emitter) comments(indent int, comments []comment, blockStart bool) {
+	for i, c := range comments {
+		if c.blankBefore && !(blockStart && i == 0) {
+			e.WriteString("\n")
+		}
+		e.line(indent, c.text)
+	}
+}
+
+// elements writes the entries of a mapping or the items of a sequence at the given indentation.
+// If firstPrefix is not empty, it replaces the indentation of the first line, so that a mapping can start on the same line as a sequence indicator.
+func (e *emitter) elements(elements []*element, indent int, firstPrefix string) {
+	for i, el := range elements {
+		prefix := strings.Repeat(" ", indent)
+		if i == 0 && firstPrefix != "" {
+			prefix = firstPrefix
+		} else {
+			e.comments(indent, el.head, i == 0)
+			if el.blankBefore && (i > 0 || len(el.head) > 0) {
+				e.WriteString("\n")
+			}
+		}
+
+		if el.key != "" || el.keyStr {
+			e.value(prefix+renderKey(el)+":", el.value, indent, el.inline)
+		} else {
+			e.item(prefix, el, indent)
+		}
+
+		e.comments(indent, el.foot, false)
+	}
+}
+
+func (e *emitter) item(prefix string, el *element, indent int) {
+	v := el.value
+	if v.kind == mappingNode && len(v.elements) > 0 {
+		// Comments attached to the first entry can't be written between the sequence indicator and the entry, so they are written above the item.
+		first := v.elements[0]
+		e.comments(indent, first.head, false)
+		first.head = nil
+		first.inline = strings.TrimSpace(el.inline + " " + first.inline)
+		e.elements(v.elements, indent+indentWidth, prefix+"- ")
+		return
+	}
+
+	e.value(prefix+"-", v, indent, el.inline)
+}
+
+// value writes a value that follows the given prefix, which is either a mapping key or a sequence indicator.
+func (e *emitter) value(prefix string, v *node, indent int, inline string) {
+	if inline != "" {
+		inline = " " + inline
+	}
+
+	switch v.kind {
+	case mappingNode:
+		if len(v.elements) == 0 {
+			e.line(0, prefix+" {}"+inline)
+			return
+		}
+
+		e.line(0, prefix+inline)
+		e.elements(v.elements, indent+indentWidth, "")
+	case sequenceNode:
+		if len(v.elements) == 0 {
+			e.line(0, prefix+" []"+inline)
+			return
+		}
+
+		if flow, ok := flowSequence(v); ok && len(prefix)+1+len(flow)+len(inline) <= lineWidth {
+			e.line(0, prefix+" "+flow+inline)
+			return
+		}
+
+		e.line(0, prefix+inline)
+		e.elements(v.elements, indent+indentWidth, "")
+	default:
+		header, lines := renderScalar(v, len(prefix)+1, indent+indentWidth)
+		e.line(0, prefix+" "+header+inline)
+		for _, l := range lines {
+			if l == "" {
+				e.WriteString("\n")
+				continue
+			}
+			e.line(indent+indentWidth, l)
+		}
+	}
+}
+
+func renderKey(el *element) string {
+	if !el.keyStr {
+		return el.key
+	}
+
+	if isPlainSafe(el.key) || (el.keyPlain && keepPlain(el.key)) {
+		return el.key
+	}
+
+	return quote(el.key)
+}
+
+// flowSequence renders a sequence of single-line scalars without comments in flow style.
+func flowSequence(v *node) (string, bool) {
+	items := make([]string, len(v.elements))
+	for i, el := range v.elements {
+		if el.value.kind != scalarNode || len(el.head) > 0 || len(el.foot) > 0 || el.inline != "" {
+			return "", false
+		}
+
+		switch {
+		case !el.value.str:
+			items[i] = el.value.value
+		case strings.ContainsAny(el.value.value, "\n\r") || hasControlChars(el.value.value):
+			return "", false
+		case el.value.plain && keepPlain(el.value.value) && !strings.ContainsAny(el.value.value, ",[]{}"):
+			items[i] = el.value.value
+		default:
+			items[i] = quote(el.value.value)
+		}
+	}
+
+	return "[" + strings.Join(items, ", ") + "]", true
+}
+
+// renderScalar returns the text of a scalar that starts at the given column and,
+// for block scalars, the lines of its content that are indented by blockIndent.
+func renderScalar(v *node, col, blockIndent int) (string, []string) {
+	if !v.str {
+		return v.value, nil
+	}
+
+	s := v.value
+	if strings.Contains(s, "\n") {
+		if header, ok := literalHeader(s); ok {
+			return header, strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+		}
+
+		return strconv.Quote(s), nil
+	}
+
+	if v.cel && col+len(s) > lineWidth {
+		if lines := fold(s, lineWidth-blockIndent); len(lines) > 1 {
+			return ">-", lines
+		}
+	}
+
+	if isPlainSafe(s) || (v.plain && keepPlain(s)) {
+		return s, nil
+	}
+
+	return quote(s), nil
+}
+
+// literalHeader returns the header of a literal block scalar that preserves the string exactly.
+func literalHeader(s string) (string, bool) {
+	if strings.Contains(s, "\r") || hasControlChars(s) {
+		return "", false
+	}
+
+	firstLine := strings.TrimLeft(s, "\n")
+	if firstLine == "" || firstLine[0] == ' ' || firstLine[0] == '\t' {
+		return "", false
+	}
+
+	body := strings.TrimRight(s, "\n")
+	if lastLine := body[strings.LastIndex(body, "\n")+1:]; strings.TrimSpace(lastLine) == "" {
+		return "", false
+	}
+
+	switch len(s) - len(body) {
+	case 0:
+		return "|-", true
+	case 1:
+		return "|", true
+	default:
+		return "", false
+	}
+}
+
+// fold splits a CEL expression into lines for a folded block scalar, which joins the lines with single spaces.
+// The expression is split before each of the && and || operators with the lowest nesting depth, and any line that is
+// still longer than the width is wrapped between words. Lines are only split at single spaces outside of string literals,
+// so that folding reproduces the expression exactly.
+func fold(s string, width int) []string {
+	if s != strings.TrimSpace(s) || strings.ContainsAny(s, "\t\r\n") || hasControlChars(s) {
+		return nil
+	}
+
+	var breaks, operators []int
+	depths := make(map[int]int)
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+		case c == ' ' && s[i-1] != ' ' && i+1 < len(s) && s[i+1] != ' ':
+			breaks = append(breaks, i)
+			if rest := s[i+1:]; strings.HasPrefix(rest, "&& ") || strings.HasPrefix(rest, "|| ") {
+				operators = append(operators, i)
+				depths[i] = depth
+			}
+		}
+	}
+
+	var splits []int
+	if len(operators) > 0 {
+		minDepth := depths[operators[0]]
+		for _, i := range operators {
+			minDepth = min(minDepth, depths[i])
+		}
+
+		for _, i := range operators {
+			if depths[i] == minDepth {
+				splits = append(splits, i)
+			}
+		}
+	}
+
+	var lines []string
+	start := 0
+	for _, end := range append(splits, len(s)) {
+		lines = append(lines, wrap(s, start, end, breaks, width)...)
+		start = end + 1
+	}
+
+	return lines
+}
+
+// wrap splits s[start:end] at the given break positions so that each line is no longer than the width where possible.
+func wrap(s string, start, end int, breaks []int, width int) []string {
+	var lines []string
+	last := -1
+	for _, b := range breaks {
+		if b <= start || b >= end {
+			continue
+		}
+
+		if b-start > width && last > start {
+			lines = append(lines, s[start:last])
+			start = last + 1
+		}
+		last = b
+	}
+
+	if end-start > width && last > start {
+		lines = append(lines, s[start:last])
+		start = last + 1
+	}
+
+	return append(lines, s[start:end])
+}
+
+// isPlainSafe returns true if the string can be written without quotes and read back as the same string.
+func isPlainSafe(s string) bool {
+	if s == "" || s != strings.TrimSpace(s) || hasControlChars(s) || strings.ContainsAny(s, "\t\n\r") {
+		return false
+	}
+
+	if _, ok := ambiguousWords[strings.ToLower(s)]; ok || numberLike.MatchString(strings.ToLower(s)) {
+		return false
+	}
+
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") {
+		return false
+	}
+
+	return !strings.Contains(s, ": ") && !strings.Contains(s, " #") && !strings.HasSuffix(s, ":")
+}
+
+// keepPlain returns true if a string that was plain in the source looks like another type, so that quoting it could change how it is decoded.
+func keepPlain(s string) bool {
+	lower := strings.ToLower(s)
+	_, ok := ambiguousWords[lower]
+	return ok || numberLike.MatchString(lower)
+}
+
+// quote returns the string in double quotes, or in single quotes if it contains double quotes but no single quotes.
+func quote(s string) string {
+	if strings.Contains(s, `"`) && !strings.Contains(s, "'") && !hasControlChars(s) && !strings.ContainsAny(s, "\n\r\t") {
+		return "'" + s + "'"
+	}
+
+	return strconv.Quote(s)
+}
+
+func hasControlChars(s string) bool {
+	return strings.ContainsFunc(s, func(r rune) bool {
+		return r != '\n' && r != '\t' && r != '\r' && (unicode.IsControl(r) || r == unicode.ReplacementChar)
+	})
+}
@@ -0,0 +1,515 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package format rewrites policy and test suite files in a canonical YAML style.
+package format
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/lexer"
+	"github.com/goccy/go-yaml/token"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/parser"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// ErrUnsupported is returned when a file uses YAML features that the formatter cannot preserve.
+var ErrUnsupported = errors.New("unsupported YAML feature")
+
+type Kind int
+
+const (
+	KindPolicy Kind = iota
+	KindTestSuite
+)
+
+// KindOf returns the kind of file that the formatter should treat the named file as.
+// It returns false for files that are not formatted: JSON files, schemas and test fixtures.
+func KindOf(path string) (Kind, bool) {
+	ext, ok := util.IsSupportedFileTypeExt(path)
+	if !ok || ext == ".json" {
+		return KindPolicy, false
+	}
+
+	for _, dir := range strings.Split(filepath.ToSlash(filepath.Dir(path)), "/") {
+		if dir == util.SchemasDirectory || dir == util.TestDataDirectory {
+			return KindPolicy, false
+		}
+	}
+
+	if util.IsSupportedTestFile(path) {
+		return KindTestSuite, true
+	}
+
+	return KindPolicy, true
+}
+
+func (k Kind) newMessage() proto.Message {
+	if k == KindTestSuite {
+		return &policyv1.TestSuite{}
+	}
+
+	return &policyv1.Policy{}
+}
+
+// decode reads the contents with the same decoder that is used to load files of this kind.
+func (k Kind) decode(contents []byte) ([]proto.Message, error) {
+	if k == KindTestSuite {
+		msg := k.newMessage()
+		if err := util.ReadJSONOrYAML(bytes.NewReader(contents), msg); err != nil {
+			return nil, err
+		}
+
+		return []proto.Message{msg}, nil
+	}
+
+	msgs, _, err := parser.UnmarshalBytes(contents, k.newMessage)
+	return msgs, err
+}
+
+// Format returns the contents in canonical style. Fields are ordered as they are declared in the protobuf
+// definitions, lists of actions and roles are sorted, and long CEL expressions are folded over several lines.
+// Comments are kept with the field or list item that they are attached to.
+// An error is returned if the formatted contents don't decode to the same messages as the original contents.
+func Format(contents []byte, kind Kind) ([]byte, error) {
+	want, err := kind.decode(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := parser.Parse(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &builder{}
+	var docs []*node
+	for _, doc := range f.Docs {
+		if doc.Body == nil || doc.Body.Type() == ast.CommentType {
+			continue
+		}
+
+		root, err := b.buildMessage(doc.Body, kind.newMessage().ProtoReflect().Descriptor())
+		if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, root)
+	}
+
+	trailing := b.attachComments(contents)
+
+	out := emit(docs, trailing)
+
+	have, err := kind.decode(out)
+	if err != nil {
+		return nil, fmt.Errorf("formatted output is invalid: %w", err)
+	}
+
+	if !equivalent(want, have) {
+		return nil, errors.New("formatted output does not match the original contents")
+	}
+
+	return out, nil
+}
+
+// setFields are the lists that are compiled into sets, so sorting them does not change the rule table.
+var setFields = map[protoreflect.FullName]struct{}{
+	"cerbos.policy.v1.ResourceRule.actions":       {},
+	"cerbos.policy.v1.ResourceRule.derived_roles": {},
+	"cerbos.policy.v1.ResourceRule.roles":         {},
+	"cerbos.policy.v1.RoleDef.parent_roles":       {},
+	"cerbos.policy.v1.RoleRule.allow_actions":     {},
+}
+
+// celFields are the string fields and map values that contain CEL expressions.
+var celFields = map[protoreflect.FullName]struct{}{
+	"cerbos.policy.v1.Match.expr":                             {},
+	"cerbos.policy.v1.Output.expr":                            {},
+	"cerbos.policy.v1.Output.When.rule_activated":             {},
+	"cerbos.policy.v1.Output.When.condition_not_met":          {},
+	"cerbos.policy.v1.Variables.LocalEntry.value":             {},
+	"cerbos.policy.v1.ExportVariables.DefinitionsEntry.value": {},
+	"cerbos.policy.v1.Policy.VariablesEntry.value":            {},
+}
+
+func equivalent(want, have []proto.Message) bool {
+	if len(want) != len(have) {
+		return false
+	}
+
+	for i := range want {
+		sortSets(want[i].ProtoReflect())
+		sortSets(have[i].ProtoReflect())
+		if !proto.Equal(want[i], have[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortSets(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			if _, ok := setFields[fd.FullName()]; ok {
+				values := make([]string, list.Len())
+				for i := range values {
+					values[i] = list.Get(i).String()
+				}
+				slices.Sort(values)
+				for i, s := range values {
+					list.Set(i, protoreflect.ValueOfString(s))
+				}
+			} else if fd.Message() != nil {
+				for i := range list.Len() {
+					sortSets(list.Get(i).Message())
+				}
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					sortSets(mv.Message())
+					return true
+				})
+			}
+		case fd.Message() != nil:
+			sortSets(v.Message())
+		}
+
+		return true
+	})
+}
+
+type nodeKind int
+
+const (
+	scalarNode nodeKind = iota
+	mappingNode
+	sequenceNode
+)
+
+type node struct {
+	value    string
+	elements []*element
+	kind     nodeKind
+	str      bool
+	// plain is true if the string was not quoted in the source. Plain strings that look like other types are kept
+	// plain because test suites are decoded as YAML 1.1, which reads words like yes and no as booleans.
+	plain bool
+	cel   bool
+}
+
+// element is an entry of a mapping or an item of a sequence, along with the comments attached to it.
+type element struct {
+	value       *node
+	key         string
+	inline      string
+	head        []comment
+	foot        []comment
+	line        int
+	col         int
+	order       int
+	keyStr      bool
+	keyPlain    bool
+	blankBefore bool
+}
+
+type comment struct {
+	text        string
+	blankBefore bool
+}
+
+type builder struct {
+	// elements that start a line in the source, in document order.
+	elements []*element
+}
+
+func (b *builder) track(el *element, tok *token.Token) {
+	if tok == nil || tok.Position == nil {
+		return
+	}
+
+	el.line, el.col = tok.Position.Line, tok.Position.Column
+	b.elements = append(b.elements, el)
+}
+
+func (b *builder) buildMessage(n ast.Node, md protoreflect.MessageDescriptor) (*node, error) {
+	values, flow, err := mappingValues(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if values == nil {
+		return b.buildFree(n)
+	}
+
+	res := &node{kind: mappingNode}
+	for _, mv := range values {
+		key, ok := mv.Key.(*ast.StringNode)
+		if !ok {
+			return nil, unsupported(mv.Key)
+		}
+
+		fields := md.Fields()
+		fd := fields.ByJSONName(key.Value)
+		if fd == nil {
+			fd = fields.ByTextName(key.Value)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %q at %s", key.Value, position(key))
+		}
+
+		el := &element{key: fd.JSONName(), order: fd.Index()}
+		if !flow {
+			b.track(el, key.GetToken())
+		}
+
+		if el.value, err = b.buildField(mv.Value, fd); err != nil {
+			return nil, err
+		}
+
+		res.elements = append(res.elements, el)
+	}
+
+	slices.SortStableFunc(res.elements, func(a, b *element) int { return a.order - b.order })
+	return res, nil
+}
+
+func (b *builder) buildField(n ast.Node, fd protoreflect.FieldDescriptor) (*node, error) {
+	switch {
+	case fd.IsList():
+		seq, ok := n.(*ast.SequenceNode)
+		if !ok {
+			return b.buildFree(n)
+		}
+
+		res, err := b.buildSequence(seq, func(item ast.Node) (*node, error) { return b.buildValue(item, fd) })
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := setFields[fd.FullName()]; ok && !slices.ContainsFunc(res.elements, func(el *element) bool { return !el.value.str }) {
+			slices.SortStableFunc(res.elements, func(a, b *element) int { return strings.Compare(a.value.value, b.value.value) })
+		}
+
+		return res, nil
+	case fd.IsMap():
+		return b.buildMapping(n, func(item ast.Node) (*node, error) { return b.buildValue(item, fd.MapValue()) })
+	default:
+		return b.buildValue(n, fd)
+	}
+}
+
+func (b *builder) buildValue(n ast.Node, fd protoreflect.FieldDescriptor) (*node, error) {
+	if md := fd.Message(); md != nil {
+		if strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+			return b.buildFree(n)
+		}
+
+		return b.buildMessage(n, md)
+	}
+
+	res, err := b.buildFree(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := celFields[fd.FullName()]; ok && res.kind == scalarNode {
+		res.cel = true
+	}
+
+	return res, nil
+}
+
+// buildFree builds a node whose structure is not described by a message, such as a google.protobuf.Value.
+// The order of the keys of mappings is retained.
+func (b *builder) buildFree(n ast.Node) (*node, error) {
+	switch t := n.(type) {
+	case *ast.MappingNode, *ast.MappingValueNode:
+		return b.buildMapping(n, b.buildFree)
+	case *ast.SequenceNode:
+		return b.buildSequence(t, b.buildFree)
+	case *ast.StringNode:
+		return &node{kind: scalarNode, value: t.Value, str: true, plain: t.Token.Type == token.StringType}, nil
+	case *ast.LiteralNode:
+		return &node{kind: scalarNode, value: t.Value.Value, str: true}, nil
+	case *ast.NullNode:
+		return &node{kind: scalarNode, value: "null"}, nil
+	case *ast.BoolNode, *ast.IntegerNode, *ast.FloatNode, *ast.InfinityNode, *ast.NanNode:
+		return &node{kind: scalarNode, value: t.GetToken().Value}, nil
+	default:
+		return nil, unsupported(n)
+	}
+}
+
+func (b *builder) buildMapping(n ast.Node, buildValue func(ast.Node) (*node, error)) (*node, error) {
+	values, flow, err := mappingValues(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if values == nil {
+		return b.buildFree(n)
+	}
+
+	res := &node{kind: mappingNode}
+	for _, mv := range values {
+		el := &element{}
+		switch k := mv.Key.(type) {
+		case *ast.StringNode:
+			el.key, el.keyStr, el.keyPlain = k.Value, true, k.Token.Type == token.StringType
+		case *ast.BoolNode, *ast.IntegerNode, *ast.FloatNode:
+			el.key = k.GetToken().Value
+		default:
+			return nil, unsupported(mv.Key)
+		}
+
+		if !flow {
+			b.track(el, mv.Key.GetToken())
+		}
+
+		if el.value, err = buildValue(mv.Value); err != nil {
+			return nil, err
+		}
+
+		res.elements = append(res.elements, el)
+	}
+
+	return res, nil
+}
+
+func (b *builder) buildSequence(seq *ast.SequenceNode, buildItem func(ast.Node) (*node, error)) (*node, error) {
+	res := &node{kind: sequenceNode}
+	for i, item := range seq.Values {
+		el := &element{}
+		if !seq.IsFlowStyle && i < len(seq.Entries) {
+			b.track(el, seq.Entries[i].Start)
+		}
+
+		var err error
+		if el.value, err = buildItem(item); err != nil {
+			return nil, err
+		}
+
+		res.elements = append(res.elements, el)
+	}
+
+	return res, nil
+}
+
+// mappingValues returns the entries of a mapping node. It returns nil if the node is not a mapping.
+func mappingValues(n ast.Node) (values []*ast.MappingValueNode, flow bool, _ error) {
+	switch t := n.(type) {
+	case *ast.MappingNode:
+		return t.Values, t.IsFlowStyle, nil
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{t}, t.IsFlowStyle, nil
+	case *ast.AnchorNode, *ast.AliasNode, *ast.TagNode:
+		return nil, false, unsupported(n)
+	default:
+		return nil, false, nil
+	}
+}
+
+// attachComments attaches each comment in the source to the closest element and returns the comments
+// that could not be attached to any element.
+func (b *builder) attachComments(contents []byte) []comment {
+	lines := strings.Split(string(contents), "\n")
+	blankBefore := func(line int) bool {
+		return line > 1 && line-2 < len(lines) && strings.TrimSpace(lines[line-2]) == ""
+	}
+
+	for i, el := range b.elements {
+		// A blank line before a sequence item belongs to the item rather than to the first entry of its mapping.
+		el.blankBefore = blankBefore(el.line) && (i == 0 || b.elements[i-1].line != el.line)
+	}
+
+	tokens := lexer.Tokenize(string(contents))
+	contentStart := make(map[int]int)
+	for _, tok := range tokens {
+		if tok.Type == token.CommentType || tok.Position == nil {
+			continue
+		}
+
+		if col, ok := contentStart[tok.Position.Line]; !ok || tok.Position.Column < col {
+			contentStart[tok.Position.Line] = tok.Position.Column
+		}
+	}
+
+	var trailing []comment
+	for _, tok := range tokens {
+		if tok.Type != token.CommentType || tok.Position == nil {
+			continue
+		}
+
+		line, col := tok.Position.Line, tok.Position.Column
+		c := comment{text: "#" + strings.TrimRight(tok.Value, " \t\r"), blankBefore: blankBefore(line)}
+
+		if start, ok := contentStart[line]; ok && start < col {
+			// The comment follows some content on the same line, so it belongs to the last element that starts on or before that line.
+			if el := b.lastElement(func(el *element) bool { return el.line <= line }); el != nil {
+				el.inline = strings.TrimSpace(el.inline + " " + c.text)
+				continue
+			}
+		} else {
+			next := b.firstElement(func(el *element) bool { return el.line > line })
+			if next != nil && next.col >= col {
+				next.head = append(next.head, c)
+				continue
+			}
+
+			// The comment is indented further than the content that follows it, so it trails the preceding element at the same level.
+			if el := b.lastElement(func(el *element) bool { return el.line < line && el.col <= col }); el != nil {
+				el.foot = append(el.foot, c)
+				continue
+			}
+		}
+
+		trailing = append(trailing, c)
+	}
+
+	return trailing
+}
+
+func (b *builder) firstElement(match func(*element) bool) *element {
+	if i := slices.IndexFunc(b.elements, match); i >= 0 {
+		return b.elements[i]
+	}
+
+	return nil
+}
+
+func (b *builder) lastElement(match func(*element) bool) *element {
+	for i := len(b.elements) - 1; i >= 0; i-- {
+		if match(b.elements[i]) {
+			return b.elements[i]
+		}
+	}
+
+	return nil
+}
+
+func unsupported(n ast.Node) error {
+	return fmt.Errorf("%w at %s: %s", ErrUnsupported, position(n), n.Type())
+}
+
+func position(n ast.Node) string {
+	if tok := n.GetToken(); tok != nil && tok.Position != nil {
+		return fmt.Sprintf("%d:%d", tok.Position.Line, tok.Position.Column)
+	}
+
+	return "unknown position"
+}
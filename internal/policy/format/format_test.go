@@ -0,0 +1,238 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build tests
+
+package format_test
+
+import (
+	"cmp"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/rogpeppe/go-internal/txtar"
+	"github.com/stretchr/testify/require"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/policy/format"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const cases = `
+-- policy.yaml --
+# Header comment
+
+---
+apiVersion: "api.cerbos.dev/v1"
+resourcePolicy:
+  # Rules
+  rules:
+    # View rule
+    - roles: ['user', "admin"] # Who
+      effect: EFFECT_ALLOW
+      actions:
+        - view
+        - "*"
+      name: view
+      condition: {match: {expr: "request.resource.attr.public == true && request.principal.attr.department == request.resource.attr.department && !(request.principal.id in request.resource.attr.blocked)"}}
+
+    - effect: EFFECT_DENY
+      actions: ["delete"]
+      derivedRoles:
+        - owner # Only owners
+        - approver
+      condition:
+        match:
+          all:
+            of:
+              - expr: |-
+                  R.attr.locked
+                  && P.attr.x == 1
+              - expr: >
+                  V.locked
+        # End of condition
+  version: "default"
+  importDerivedRoles: [common]
+  resource: "leave_request"
+  variables:
+    local:
+      locked: 'R.attr.status == "LOCKED"'
+      "1": "true"
+# End of file
+-- policy.yaml.golden --
+# Header comment
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: leave_request
+  version: default
+  importDerivedRoles: ["common"]
+  # Rules
+  rules:
+    # View rule
+    - actions: ["*", "view"]
+      roles: ["admin", "user"] # Who
+      condition:
+        match:
+          expr: >-
+            request.resource.attr.public == true
+            && request.principal.attr.department == request.resource.attr.department
+            && !(request.principal.id in request.resource.attr.blocked)
+      effect: EFFECT_ALLOW
+      name: view
+
+    - actions: ["delete"]
+      derivedRoles:
+        - approver
+        - owner # Only owners
+      condition:
+        match:
+          all:
+            of:
+              - expr: |-
+                  R.attr.locked
+                  && P.attr.x == 1
+              - expr: |
+                  V.locked
+        # End of condition
+      effect: EFFECT_DENY
+  variables:
+    local:
+      locked: R.attr.status == "LOCKED"
+      "1": "true"
+# End of file
+-- suite_test.yaml --
+tests:
+  - name: View
+    expected:
+      - resource: doc
+        principal: alice
+        actions: {view: EFFECT_ALLOW}
+    input:
+      resources: [doc]
+      principals: [alice]
+      actions: [view]
+name: "Suite"
+principals:
+  alice: {id: alice, roles: [user], attr: {"on": yes, n: 1}}
+resources:
+  doc: {kind: document, id: "1"}
+-- suite_test.yaml.golden --
+name: Suite
+tests:
+  - name: View
+    input:
+      principals: ["alice"]
+      resources: ["doc"]
+      actions: ["view"]
+    expected:
+      - principal: alice
+        resource: doc
+        actions:
+          view: EFFECT_ALLOW
+principals:
+  alice:
+    id: alice
+    roles: ["user"]
+    attr:
+      "on": yes
+      n: 1
+resources:
+  doc:
+    kind: document
+    id: "1"
+`
+
+func TestFormat(t *testing.T) {
+	archive := txtar.Parse([]byte(cases))
+	files := make(map[string][]byte, len(archive.Files))
+	for _, f := range archive.Files {
+		files[f.Name] = f.Data
+	}
+
+	for _, name := range []string{"policy.yaml", "suite_test.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			kind, ok := format.KindOf(name)
+			require.True(t, ok)
+
+			have, err := format.Format(files[name], kind)
+			require.NoError(t, err)
+			require.Equal(t, string(files[name+".golden"]), string(have))
+
+			again, err := format.Format(have, kind)
+			require.NoError(t, err)
+			require.Equal(t, string(have), string(again), "Formatting is not idempotent")
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := format.Format([]byte("apiVersion: &v api.cerbos.dev/v1\ndescription: *v\nderivedRoles:\n  name: x\n  definitions: []\n"), format.KindPolicy)
+		require.ErrorIs(t, err, format.ErrUnsupported)
+	})
+}
+
+func TestFormatPreservesRuleTable(t *testing.T) {
+	src := test.PathToDir(t, "store")
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS(src)))
+
+	want := ruleTable(t, dir)
+
+	count := 0
+	require.NoError(t, fs.WalkDir(os.DirFS(dir), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		kind, ok := format.KindOf(path)
+		if !ok {
+			return nil
+		}
+
+		fullPath := filepath.Join(dir, path)
+		contents, err := os.ReadFile(fullPath)
+		require.NoError(t, err)
+
+		formatted, err := format.Format(contents, kind)
+		require.NoError(t, err, "Failed to format %s", path)
+
+		again, err := format.Format(formatted, kind)
+		require.NoError(t, err, "Failed to format %s again", path)
+		require.Equal(t, string(formatted), string(again), "Formatting %s is not idempotent", path)
+
+		count++
+		return os.WriteFile(fullPath, formatted, 0o600)
+	}))
+
+	require.Greater(t, count, 0)
+	require.Equal(t, want, ruleTable(t, dir))
+}
+
+// ruleTable returns the hash of the rule table compiled from the policies in the directory.
+func ruleTable(t *testing.T, dir string) uint64 {
+	t.Helper()
+
+	ctx := t.Context()
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	mgr, err := compile.NewManager(ctx, store)
+	require.NoError(t, err)
+
+	rt := ruletable.NewProtoRuletable()
+	require.NoError(t, ruletable.LoadPolicies(ctx, rt, mgr))
+
+	// The rows are generated by iterating over maps, so they are sorted to make the hash stable.
+	slices.SortFunc(rt.Rules, func(a, b *runtimev1.RuleTable_RuleRow) int {
+		return cmp.Compare(util.HashPB(a, nil), util.HashPB(b, nil))
+	})
+
+	return util.HashPB(rt, nil)
+}
@@ -0,0 +1,293 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml/ast"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+// policyBodies are the keys of the policy types that have local variables and constants.
+var policyBodies = []string{"resourcePolicy", "resource_policy", "principalPolicy", "principal_policy", "derivedRoles", "derived_roles"}
+
+// celFields are the string fields and map values that contain CEL expressions.
+var celFields = map[protoreflect.FullName]struct{}{
+	"cerbos.policy.v1.Match.expr":                             {},
+	"cerbos.policy.v1.Output.expr":                            {},
+	"cerbos.policy.v1.Output.When.rule_activated":             {},
+	"cerbos.policy.v1.Output.When.condition_not_met":          {},
+	"cerbos.policy.v1.Variables.LocalEntry.value":             {},
+	"cerbos.policy.v1.ExportVariables.DefinitionsEntry.value": {},
+	"cerbos.policy.v1.Policy.VariablesEntry.value":            {},
+}
+
+func migrateTopLevelGlobals(s *source, doc *document) ([]edit, error) {
+	globals := find(doc.entries, "globals")
+	if globals == nil {
+		return nil, nil
+	}
+
+	if find(doc.entries, "variables") != nil {
+		return nil, fmt.Errorf("globals section at %s can't be renamed because the policy already has a variables section", position(globals))
+	}
+
+	return []edit{{start: s.offset(firstToken(globals.Key)), end: s.end(globals.Key), text: "variables"}}, nil
+}
+
+func migrateDeprecatedFunctions(s *source, doc *document) ([]edit, error) {
+	var edits []edit
+	var err error
+	visitCEL(doc.entries, (&policyv1.Policy{}).ProtoReflect().Descriptor(), func(n ast.Node) {
+		if err != nil {
+			return
+		}
+
+		var e *edit
+		if e, err = renameFunctions(s, n); e != nil {
+			edits = append(edits, *e)
+		}
+	})
+
+	return edits, err
+}
+
+// visitCEL calls the function with the nodes of the CEL expressions in the entries of a mapping that represents the message.
+func visitCEL(entries []*ast.MappingValueNode, md protoreflect.MessageDescriptor, fn func(ast.Node)) {
+	visitMessage := func(n ast.Node, md protoreflect.MessageDescriptor) {
+		if entries, ok := mappingEntries(n); ok && !strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+			visitCEL(entries, md, fn)
+		}
+	}
+
+	for _, mv := range entries {
+		fd := md.Fields().ByJSONName(keyName(mv))
+		if fd == nil {
+			fd = md.Fields().ByTextName(keyName(mv))
+		}
+		if fd == nil {
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+			values, _ := mappingEntries(mv.Value)
+			for _, v := range values {
+				if fd.MapValue().Message() != nil {
+					visitMessage(v.Value, fd.MapValue().Message())
+				} else if _, ok := celFields[fd.MapValue().FullName()]; ok {
+					fn(v.Value)
+				}
+			}
+		case fd.IsList():
+			if seq, ok := mv.Value.(*ast.SequenceNode); ok && fd.Message() != nil {
+				for _, v := range seq.Values {
+					visitMessage(v, fd.Message())
+				}
+			}
+		case fd.Message() != nil:
+			visitMessage(mv.Value, fd.Message())
+		default:
+			if _, ok := celFields[fd.FullName()]; ok {
+				fn(mv.Value)
+			}
+		}
+	}
+}
+
+// renameFunctions returns an edit that replaces calls to deprecated functions in the expression.
+// Where possible, the function names are replaced in the source text so that the quoting style and line breaks are preserved.
+func renameFunctions(s *source, n ast.Node) (*edit, error) {
+	var expr string
+	switch t := n.(type) {
+	case *ast.StringNode:
+		expr = t.Value
+	case *ast.LiteralNode:
+		expr = t.Value.Value
+	default:
+		return nil, nil
+	}
+
+	renamed, count := renameCalls(expr)
+	if count == 0 {
+		return nil, nil
+	}
+
+	e := &edit{start: s.offset(firstToken(n)), end: s.end(n)}
+	raw := string(s.text[e.start:e.end])
+
+	occurrences := 0
+	inSource := true
+	for old := range conditions.DeprecatedFunctions {
+		occurrences += strings.Count(expr, old)
+		inSource = inSource && strings.Count(raw, old) == strings.Count(expr, old)
+	}
+
+	switch {
+	case inSource && occurrences == count:
+		e.text = raw
+		for old, replacement := range conditions.DeprecatedFunctions {
+			e.text = strings.ReplaceAll(e.text, old, replacement)
+		}
+	case !strings.Contains(renamed, "\n"):
+		e.text = strconv.Quote(renamed)
+	default:
+		return nil, fmt.Errorf("deprecated functions in the expression at %s must be renamed manually", position(n))
+	}
+
+	return e, nil
+}
+
+// renameCalls replaces calls to deprecated functions in the expression and returns the number of calls that were replaced.
+// Identifiers in string literals and identifiers that are not followed by an argument list are not replaced.
+func renameCalls(expr string) (string, int) {
+	var b strings.Builder
+	count := 0
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == '"' || c == '\'':
+			end := stringLiteralEnd(expr, i)
+			b.WriteString(expr[i:end])
+			i = end
+		case isIdentStart(c) && (i == 0 || !isIdentChar(expr[i-1])):
+			end := i + 1
+			for end < len(expr) && isIdentChar(expr[end]) {
+				end++
+			}
+
+			word := expr[i:end]
+			if replacement, ok := conditions.DeprecatedFunctions[word]; ok && strings.HasPrefix(strings.TrimLeft(expr[end:], " \t\r\n"), "(") {
+				word = replacement
+				count++
+			}
+
+			b.WriteString(word)
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return b.String(), count
+}
+
+// stringLiteralEnd returns the offset after the end of the string literal that starts at the given offset.
+func stringLiteralEnd(expr string, start int) int {
+	delim := expr[start : start+1]
+	if strings.HasPrefix(expr[start:], strings.Repeat(delim, 3)) {
+		delim = strings.Repeat(delim, 3)
+	}
+
+	for i := start + len(delim); i < len(expr); i++ {
+		switch {
+		case expr[i] == '\\':
+			i++
+		case strings.HasPrefix(expr[i:], delim):
+			return i + len(delim)
+		}
+	}
+
+	return len(expr)
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
+
+func migrateTopLevelVariables(s *source, doc *document) ([]edit, error) {
+	variables := find(doc.entries, "variables")
+	if variables == nil {
+		return nil, nil
+	}
+
+	body := find(doc.entries, policyBodies...)
+	if body == nil {
+		return nil, fmt.Errorf("top-level variables section at %s can only be moved in resource policies, principal policies and derived roles", position(variables))
+	}
+
+	start, end, err := s.block(variables, false)
+	if err != nil {
+		return nil, err
+	}
+
+	edits := []edit{remove(start, end)}
+
+	entries, _ := mappingEntries(variables.Value)
+	if len(entries) == 0 {
+		return edits, nil
+	}
+
+	bodyEntries, ok := blockMapping(body.Value)
+	if !ok {
+		return nil, fmt.Errorf("policy at %s must be a block mapping to move the top-level variables section into it", position(body))
+	}
+
+	flow := isFlow(variables.Value)
+	entriesText := func(indent int) string {
+		var b strings.Builder
+		for _, e := range entries {
+			text, col := s.entry(e, flow)
+			b.WriteString(reindent(text, col, indent))
+		}
+		return b.String()
+	}
+
+	indent := s.indent(bodyEntries[0])
+	unit := indent - s.indent(body)
+
+	section := find(bodyEntries, "variables")
+	if section == nil {
+		_, bodyEnd, err := s.block(body, false)
+		if err != nil {
+			return nil, err
+		}
+
+		text := pad(indent) + "variables:\n" + pad(indent+unit) + "local:\n" + entriesText(indent+2*unit)
+		return append(edits, insert(bodyEnd, text)), nil
+	}
+
+	sectionEntries, ok := blockMapping(section.Value)
+	if !ok {
+		return nil, fmt.Errorf("variables section at %s must be a block mapping to move the top-level variables section into it", position(section))
+	}
+
+	local := find(sectionEntries, "local")
+	if local == nil {
+		_, sectionEnd, err := s.block(section, false)
+		if err != nil {
+			return nil, err
+		}
+
+		indent := s.indent(sectionEntries[0])
+		return append(edits, insert(sectionEnd, pad(indent)+"local:\n"+entriesText(indent+unit))), nil
+	}
+
+	localEntries, ok := blockMapping(local.Value)
+	if !ok {
+		return nil, fmt.Errorf("local variables at %s must be a block mapping to move the top-level variables section into it", position(local))
+	}
+
+	_, localEnd, err := s.block(local, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(edits, insert(localEnd, entriesText(s.indent(localEntries[0])))), nil
+}
+
+func pad(indent int) string {
+	return strings.Repeat(" ", indent)
+}
@@ -0,0 +1,261 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migrate rewrites policies that use deprecated constructs.
+// Each codemod edits the source text at the positions reported by the parser, so that formatting and comments are preserved.
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/parser"
+)
+
+// Codemod is an automated rewrite of a deprecated construct.
+type Codemod struct {
+	apply func(*workspace)
+	// ID is the unique identifier of the codemod.
+	ID string
+	// Version is the Cerbos version that introduced the replacement for the deprecated construct.
+	Version string
+	// Description describes the rewrite.
+	Description string
+}
+
+// Codemods is the list of available codemods, in the order that they are applied.
+var Codemods = []*Codemod{
+	{
+		ID:          "top-level-globals",
+		Version:     "v0.9.0",
+		Description: "Rename the top-level globals section of policies to variables",
+		apply:       eachDocument(migrateTopLevelGlobals),
+	},
+	{
+		ID:          "deprecated-functions",
+		Version:     "v0.14.0",
+		Description: "Replace the has_intersection and is_subset functions with hasIntersection and isSubset",
+		apply:       eachDocument(migrateDeprecatedFunctions),
+	},
+	{
+		ID:          "top-level-variables",
+		Version:     "v0.29.0",
+		Description: "Move the top-level variables section of policies to variables.local",
+		apply:       eachDocument(migrateTopLevelVariables),
+	},
+	{
+		ID:          "shared-variables",
+		Version:     "v0.29.0",
+		Description: "Move local variables that are defined identically in several policies, such as a resource policy and the derived roles that it imports, to an exportVariables policy",
+		apply:       sharedVariables.apply,
+	},
+	{
+		ID:          "shared-constants",
+		Version:     "v0.40.0",
+		Description: "Move local constants that are defined identically in several policies to an exportConstants policy",
+		apply:       sharedConstants.apply,
+	},
+}
+
+// Select returns the codemods with the given IDs that rewrite constructs deprecated after the given Cerbos version.
+// If the version is empty, codemods for all versions are returned. If no IDs are given, codemods with any ID are returned.
+func Select(fromVersion string, ids []string) ([]*Codemod, error) {
+	if fromVersion != "" {
+		if !strings.HasPrefix(fromVersion, "v") {
+			fromVersion = "v" + fromVersion
+		}
+
+		if !semver.IsValid(fromVersion) {
+			return nil, fmt.Errorf("invalid version %q", fromVersion)
+		}
+	}
+
+	for _, id := range ids {
+		if !slices.ContainsFunc(Codemods, func(c *Codemod) bool { return c.ID == id }) {
+			return nil, fmt.Errorf("unknown codemod %q", id)
+		}
+	}
+
+	var selected []*Codemod
+	for _, c := range Codemods {
+		if fromVersion != "" && semver.Compare(c.Version, fromVersion) <= 0 {
+			continue
+		}
+
+		if len(ids) > 0 && !slices.Contains(ids, c.ID) {
+			continue
+		}
+
+		selected = append(selected, c)
+	}
+
+	return selected, nil
+}
+
+// Change is a file that was modified or created by the codemods.
+type Change struct {
+	// Path is the path of the file, relative to the policy directory.
+	Path string
+	// Old is the original contents of the file, or nil if the file was created.
+	Old []byte
+	// New is the migrated contents of the file.
+	New []byte
+	// Codemods are the IDs of the codemods that changed the file.
+	Codemods []string
+}
+
+// Warning describes a deprecated construct that could not be migrated automatically.
+type Warning struct {
+	Path    string
+	Codemod string
+	Message string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: [%s] %s", w.Path, w.Codemod, w.Message)
+}
+
+// Result is the outcome of running the codemods.
+type Result struct {
+	Changes  []Change
+	Warnings []Warning
+}
+
+// Run applies the codemods to the policy files, which are keyed by their slash-separated paths relative to the policy directory.
+// The files are not modified; the migrated contents are returned as changes.
+func Run(files map[string][]byte, codemods []*Codemod) (*Result, error) {
+	w := &workspace{
+		original: files,
+		files:    make(map[string][]byte, len(files)),
+		applied:  make(map[string][]string),
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(files)) {
+		contents := files[path]
+		if _, err := parser.Parse(contents); err != nil {
+			w.warnings = append(w.warnings, Warning{Path: path, Message: fmt.Sprintf("Skipped invalid YAML: %v", err)})
+			continue
+		}
+
+		w.files[path] = contents
+	}
+
+	for _, c := range codemods {
+		w.codemod = c
+		c.apply(w)
+	}
+
+	res := &Result{Warnings: w.warnings}
+	for _, path := range slices.Sorted(maps.Keys(w.applied)) {
+		old, exists := w.original[path]
+		contents := w.files[path]
+		if exists && bytes.Equal(old, contents) {
+			continue
+		}
+
+		if err := checkValid(old, exists, contents); err != nil {
+			return nil, fmt.Errorf("migrating %s produced an invalid policy: %w", path, err)
+		}
+
+		res.Changes = append(res.Changes, Change{Path: path, Old: old, New: contents, Codemods: w.applied[path]})
+	}
+
+	return res, nil
+}
+
+// checkValid returns an error if the migrated contents are not a valid policy, unless the original contents were also invalid.
+func checkValid(old []byte, exists bool, contents []byte) error {
+	if _, _, err := parser.UnmarshalBytes(contents, func() *policyv1.Policy { return &policyv1.Policy{} }); err != nil {
+		if exists {
+			if _, _, oldErr := parser.UnmarshalBytes(old, func() *policyv1.Policy { return &policyv1.Policy{} }); oldErr != nil {
+				return nil
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+type workspace struct {
+	original map[string][]byte
+	files    map[string][]byte
+	applied  map[string][]string
+	codemod  *Codemod
+	warnings []Warning
+}
+
+func (w *workspace) paths() []string {
+	return slices.Sorted(maps.Keys(w.files))
+}
+
+func (w *workspace) warn(path, format string, args ...any) {
+	w.warnings = append(w.warnings, Warning{Path: path, Codemod: w.codemod.ID, Message: fmt.Sprintf(format, args...)})
+}
+
+func (w *workspace) source(path string) *source {
+	s, err := newSource(w.files[path])
+	if err != nil {
+		w.warn(path, "Failed to parse: %v", err)
+		return nil
+	}
+
+	return s
+}
+
+func (w *workspace) update(path string, contents []byte) {
+	w.files[path] = contents
+	w.applied[path] = append(w.applied[path], w.codemod.ID)
+}
+
+func (w *workspace) edit(path string, s *source, edits []edit) {
+	if len(edits) == 0 {
+		return
+	}
+
+	contents, err := s.apply(edits)
+	if err != nil {
+		w.warn(path, "Failed to apply changes: %v", err)
+		return
+	}
+
+	w.update(path, contents)
+}
+
+func (w *workspace) exists(path string) bool {
+	_, ok := w.files[path]
+	return ok
+}
+
+// eachDocument returns a codemod that applies the function to each document of each file.
+// If the function returns an error, the document is left unchanged and the error is reported as a warning.
+func eachDocument(fn func(*source, *document) ([]edit, error)) func(*workspace) {
+	return func(w *workspace) {
+		for _, path := range w.paths() {
+			s := w.source(path)
+			if s == nil {
+				continue
+			}
+
+			var edits []edit
+			for _, doc := range s.documents() {
+				docEdits, err := fn(s, doc)
+				if err != nil {
+					w.warn(path, "%v", err)
+					continue
+				}
+
+				edits = append(edits, docEdits...)
+			}
+
+			w.edit(path, s, edits)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate_test
+
+import (
+	"cmp"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/rogpeppe/go-internal/txtar"
+	"github.com/stretchr/testify/require"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/policy/migrate"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const policies = `
+-- legacy.yaml --
+# Written for Cerbos 0.8
+apiVersion: api.cerbos.dev/v1
+globals:
+  is_member: has_intersection(P.attr.groups, R.attr.groups) # Shared groups
+resourcePolicy:
+  resource: project
+  version: default
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+      condition:
+        match:
+          all:
+            of:
+              - expr: V.is_member
+              - expr: >-
+                  P.attr.teams.is_subset(R.attr.teams)
+                  && "is_subset(" != R.id
+-- leave_request.yaml --
+apiVersion: api.cerbos.dev/v1
+variables:
+  pending: R.attr.status == "PENDING_APPROVAL"
+resourcePolicy:
+  resource: leave_request
+  version: default
+  importDerivedRoles:
+    - common_roles
+  variables:
+    local:
+      # Copied from the derived roles
+      is_owner: R.attr.owner == P.id
+      is_manager: P.attr.managed_geographies.exists(g, g == R.attr.geography)
+  constants:
+    local:
+      max_days: 30
+  rules:
+    - actions: ["approve"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["direct_manager"]
+      condition:
+        match:
+          expr: V.pending && V.is_manager && !V.is_owner && R.attr.days <= C.max_days
+-- derived_roles/common_roles.yaml --
+apiVersion: "api.cerbos.dev/v1"
+derivedRoles:
+  name: common_roles
+  variables:
+    import: [base_variables]
+    local:
+      is_owner: R.attr.owner == P.id
+      is_manager: |-
+        P.attr.managed_geographies.exists(g, g == R.attr.geography)
+  constants:
+    local:
+      max_days: 30
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: V.is_owner
+    - name: direct_manager
+      parentRoles: ["manager"]
+      condition:
+        match:
+          expr: V.is_manager && V.region == "EU" && R.attr.days <= C.max_days
+-- base_variables.yaml --
+apiVersion: api.cerbos.dev/v1
+exportVariables:
+  name: base_variables
+  definitions:
+    region: P.attr.region
+`
+
+const migrated = `
+-- common_constants.yaml --
+apiVersion: api.cerbos.dev/v1
+exportConstants:
+  name: common_constants
+  definitions:
+    max_days: 30
+-- common_variables.yaml --
+apiVersion: api.cerbos.dev/v1
+exportVariables:
+  name: common_variables
+  definitions:
+    is_manager: |-
+      P.attr.managed_geographies.exists(g, g == R.attr.geography)
+    is_owner: R.attr.owner == P.id
+-- derived_roles/common_roles.yaml --
+apiVersion: "api.cerbos.dev/v1"
+derivedRoles:
+  name: common_roles
+  variables:
+    import: [base_variables, common_variables]
+  constants:
+    import:
+      - common_constants
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: V.is_owner
+    - name: direct_manager
+      parentRoles: ["manager"]
+      condition:
+        match:
+          expr: V.is_manager && V.region == "EU" && R.attr.days <= C.max_days
+-- leave_request.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: leave_request
+  version: default
+  importDerivedRoles:
+    - common_roles
+  variables:
+    import:
+      - common_variables
+    local:
+      pending: R.attr.status == "PENDING_APPROVAL"
+  constants:
+    import:
+      - common_constants
+  rules:
+    - actions: ["approve"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["direct_manager"]
+      condition:
+        match:
+          expr: V.pending && V.is_manager && !V.is_owner && R.attr.days <= C.max_days
+-- legacy.yaml --
+# Written for Cerbos 0.8
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: project
+  version: default
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+      condition:
+        match:
+          all:
+            of:
+              - expr: V.is_member
+              - expr: "P.attr.teams.isSubset(R.attr.teams) && \"is_subset(\" != R.id"
+  variables:
+    local:
+      is_member: hasIntersection(P.attr.groups, R.attr.groups) # Shared groups
+`
+
+func TestRun(t *testing.T) {
+	codemods, err := migrate.Select("", nil)
+	require.NoError(t, err)
+
+	files := readArchive(policies)
+	res, err := migrate.Run(files, codemods)
+	require.NoError(t, err)
+	require.Empty(t, res.Warnings)
+
+	want := readArchive(migrated)
+	have := make(map[string]string, len(res.Changes))
+	for _, c := range res.Changes {
+		have[c.Path] = string(c.New)
+		if old, ok := files[c.Path]; ok {
+			require.Equal(t, string(old), string(c.Old))
+		} else {
+			require.Nil(t, c.Old)
+		}
+	}
+
+	require.Len(t, have, len(want))
+	for path, contents := range want {
+		require.Equal(t, string(contents), have[path], "Unexpected contents of %s", path)
+	}
+
+	t.Run("idempotent", func(t *testing.T) {
+		for path, contents := range have {
+			files[path] = []byte(contents)
+		}
+
+		res, err := migrate.Run(files, codemods)
+		require.NoError(t, err)
+		require.Empty(t, res.Changes)
+	})
+}
+
+func TestRunPreservesRuleTable(t *testing.T) {
+	files := readArchive(policies)
+	delete(files, "legacy.yaml")
+
+	codemods, err := migrate.Select("", []string{"top-level-variables", "shared-variables", "shared-constants"})
+	require.NoError(t, err)
+
+	res, err := migrate.Run(files, codemods)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Changes)
+
+	want := ruleTable(t, files)
+	for _, c := range res.Changes {
+		files[c.Path] = c.New
+	}
+	require.Equal(t, want, ruleTable(t, files))
+}
+
+func TestSelect(t *testing.T) {
+	ids := func(codemods []*migrate.Codemod) []string {
+		ids := make([]string, len(codemods))
+		for i, c := range codemods {
+			ids[i] = c.ID
+		}
+		return ids
+	}
+
+	codemods, err := migrate.Select("0.29.0", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"shared-constants"}, ids(codemods))
+
+	codemods, err = migrate.Select("v0.9.0", []string{"top-level-globals", "deprecated-functions"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"deprecated-functions"}, ids(codemods))
+
+	_, err = migrate.Select("latest", nil)
+	require.Error(t, err)
+
+	_, err = migrate.Select("", []string{"unknown"})
+	require.Error(t, err)
+}
+
+func readArchive(contents string) map[string][]byte {
+	archive := txtar.Parse([]byte(contents))
+	files := make(map[string][]byte, len(archive.Files))
+	for _, f := range archive.Files {
+		files[f.Name] = f.Data
+	}
+	return files
+}
+
+// ruleTable returns the hash of the rule table compiled from the policies.
+func ruleTable(t *testing.T, files map[string][]byte) uint64 {
+	t.Helper()
+
+	dir := t.TempDir()
+	for path, contents := range files {
+		fullPath := filepath.Join(dir, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o700))
+		require.NoError(t, os.WriteFile(fullPath, contents, 0o600))
+	}
+
+	ctx := t.Context()
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	mgr, err := compile.NewManager(ctx, store)
+	require.NoError(t, err)
+
+	rt := ruletable.NewProtoRuletable()
+	require.NoError(t, ruletable.LoadPolicies(ctx, rt, mgr))
+	require.NotEmpty(t, rt.Rules)
+
+	// The rows are generated by iterating over maps, so they are sorted to make the hash stable.
+	slices.SortFunc(rt.Rules, func(a, b *runtimev1.RuleTable_RuleRow) int {
+		return cmp.Compare(util.HashPB(a, nil), util.HashPB(b, nil))
+	})
+
+	return util.HashPB(rt, nil)
+}
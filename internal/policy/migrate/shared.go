@@ -0,0 +1,310 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+)
+
+var (
+	variableReference = regexp.MustCompile(`\b(?:V|variables)\.([A-Za-z_][A-Za-z0-9_]*)`)
+	// unresolvedReference matches references to variables that can't be resolved statically, and references to constants,
+	// which are not available to exported variables.
+	unresolvedReference = regexp.MustCompile(`\b(?:V|variables)\s*\[|\b(?:C|constants)\b`)
+)
+
+// shared moves local definitions that are repeated in several policies to an export policy that the policies import.
+type shared struct {
+	// canExport reports whether the definition can be exported along with the other selected definitions.
+	canExport func(value any, selected map[string]*definition) bool
+	// section is the key of the policy section that contains the local definitions.
+	section string
+	// export is the key of the export policy type.
+	export string
+	// exportKeys are the accepted spellings of the export policy type key.
+	exportKeys []string
+	// name is the name of the export policy to create.
+	name string
+}
+
+var sharedVariables = &shared{
+	section:    "variables",
+	export:     "exportVariables",
+	exportKeys: []string{"exportVariables", "export_variables"},
+	name:       "common_variables",
+	canExport: func(value any, selected map[string]*definition) bool {
+		expr, ok := value.(string)
+		if !ok || unresolvedReference.MatchString(expr) {
+			return false
+		}
+
+		for _, match := range variableReference.FindAllStringSubmatch(expr, -1) {
+			if _, ok := selected[match[1]]; !ok {
+				return false
+			}
+		}
+
+		return true
+	},
+}
+
+var sharedConstants = &shared{
+	section:    "constants",
+	export:     "exportConstants",
+	exportKeys: []string{"exportConstants", "export_constants"},
+	name:       "common_constants",
+	canExport: func(any, map[string]*definition) bool {
+		return true
+	},
+}
+
+// localDefinitions is the local section of a policy.
+type localDefinitions struct {
+	source  *source
+	imports *ast.MappingValueNode
+	local   *ast.MappingValueNode
+	entries []*ast.MappingValueNode
+	path    string
+	// editable is true if the sections are in block style, so that the entries can be removed by deleting lines.
+	editable bool
+}
+
+type definition struct {
+	value  any
+	policy *localDefinitions
+	entry  *ast.MappingValueNode
+}
+
+func (sh *shared) apply(w *workspace) {
+	var policies []*localDefinitions
+	definitions := make(map[string][]*definition)
+	reserved := make(map[string]struct{})
+	exportNames := make(map[string]struct{})
+
+	for _, path := range w.paths() {
+		s := w.source(path)
+		if s == nil {
+			continue
+		}
+
+		for _, doc := range s.documents() {
+			if export := find(doc.entries, sh.exportKeys...); export != nil {
+				entries, _ := mappingEntries(export.Value)
+				if name := find(entries, "name"); name != nil {
+					exportNames[name.Value.GetToken().Value] = struct{}{}
+				}
+
+				if defs := find(entries, "definitions"); defs != nil {
+					defEntries, _ := mappingEntries(defs.Value)
+					for _, e := range defEntries {
+						reserved[keyName(e)] = struct{}{}
+					}
+				}
+				continue
+			}
+
+			p := sh.localDefinitions(path, s, doc)
+			if p == nil {
+				continue
+			}
+
+			policies = append(policies, p)
+			for _, e := range p.entries {
+				var value any
+				if err := yaml.NodeToValue(e.Value, &value); err != nil {
+					p.editable = false
+					continue
+				}
+
+				definitions[keyName(e)] = append(definitions[keyName(e)], &definition{value: value, policy: p, entry: e})
+			}
+		}
+	}
+
+	selected := sh.selectDefinitions(definitions, reserved)
+	if len(selected) == 0 {
+		return
+	}
+
+	name := sh.name
+	for i := 2; ; i++ {
+		if _, ok := exportNames[name]; !ok && !w.exists(name+".yaml") {
+			break
+		}
+		name = fmt.Sprintf("%s_%d", sh.name, i)
+	}
+
+	edits := make(map[string][]edit)
+	sources := make(map[string]*source)
+	for _, p := range policies {
+		if !p.editable {
+			continue
+		}
+
+		policyEdits, err := sh.removeDefinitions(p, selected, name)
+		if err != nil {
+			w.warn(p.path, "%v", err)
+			continue
+		}
+
+		edits[p.path] = append(edits[p.path], policyEdits...)
+		sources[p.path] = p.source
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(edits)) {
+		w.edit(path, sources[path], edits[path])
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "apiVersion: api.cerbos.dev/v1\n%s:\n  name: %s\n  definitions:\n", sh.export, name)
+	for _, defName := range slices.Sorted(maps.Keys(selected)) {
+		d := selected[defName]
+		text, col := d.policy.source.entry(d.entry, false)
+		b.WriteString(reindent(text, col, 4)) //nolint:mnd
+	}
+
+	w.update(name+".yaml", []byte(b.String()))
+}
+
+// localDefinitions returns the local section of the policy in the document, or nil if the policy has no local definitions.
+func (sh *shared) localDefinitions(path string, s *source, doc *document) *localDefinitions {
+	body := find(doc.entries, policyBodies...)
+	if body == nil {
+		return nil
+	}
+
+	bodyEntries, _ := mappingEntries(body.Value)
+	section := find(bodyEntries, sh.section)
+	if section == nil {
+		return nil
+	}
+
+	sectionEntries, _ := mappingEntries(section.Value)
+	local := find(sectionEntries, "local")
+	if local == nil {
+		return nil
+	}
+
+	entries, _ := mappingEntries(local.Value)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	p := &localDefinitions{
+		path:     path,
+		source:   s,
+		local:    local,
+		entries:  entries,
+		imports:  find(sectionEntries, "import"),
+		editable: !isFlow(body.Value) && !isFlow(section.Value) && !isFlow(local.Value),
+	}
+
+	if p.imports != nil {
+		if _, ok := p.imports.Value.(*ast.SequenceNode); !ok {
+			p.editable = false
+		}
+	}
+
+	return p
+}
+
+// selectDefinitions returns the definitions that are defined identically in every policy that defines them, and that can be
+// removed from at least two policies.
+func (sh *shared) selectDefinitions(definitions map[string][]*definition, reserved map[string]struct{}) map[string]*definition {
+	selected := make(map[string]*definition)
+	for name, defs := range definitions {
+		if _, ok := reserved[name]; ok {
+			continue
+		}
+
+		var editable []*definition
+		identical := true
+		for _, d := range defs {
+			identical = identical && reflect.DeepEqual(d.value, defs[0].value)
+			if d.policy.editable {
+				editable = append(editable, d)
+			}
+		}
+
+		if identical && len(editable) > 1 {
+			selected[name] = editable[0]
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for name, d := range selected {
+			if !sh.canExport(d.value, selected) {
+				delete(selected, name)
+				changed = true
+			}
+		}
+	}
+
+	return selected
+}
+
+// removeDefinitions returns the edits that remove the selected definitions from the policy and import the export policy instead.
+func (sh *shared) removeDefinitions(p *localDefinitions, selected map[string]*definition, name string) ([]edit, error) {
+	s := p.source
+
+	var removed []*ast.MappingValueNode
+	for _, e := range p.entries {
+		if _, ok := selected[keyName(e)]; ok {
+			removed = append(removed, e)
+		}
+	}
+
+	if len(removed) == 0 {
+		return nil, nil
+	}
+
+	localStart, localEnd, err := s.block(p.local, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var edits []edit
+	if len(removed) == len(p.entries) {
+		edits = append(edits, remove(localStart, localEnd))
+	} else {
+		for _, e := range removed {
+			start, end, err := s.block(e, true)
+			if err != nil {
+				return nil, err
+			}
+			edits = append(edits, remove(start, end))
+		}
+	}
+
+	if p.imports == nil {
+		indent := s.indent(p.local)
+		unit := s.indent(p.entries[0]) - indent
+		return append(edits, insert(localStart, pad(indent)+"import:\n"+pad(indent+unit)+"- "+name+"\n")), nil
+	}
+
+	seq := p.imports.Value.(*ast.SequenceNode) //nolint:forcetypeassert
+	if seq.IsFlowStyle {
+		text := name
+		if len(seq.Values) > 0 {
+			text = ", " + name
+		}
+		return append(edits, insert(s.offset(seq.End), text)), nil
+	}
+
+	_, importsEnd, err := s.block(p.imports, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(edits, insert(importsEnd, pad(s.indent(seq))+"- "+name+"\n")), nil
+}
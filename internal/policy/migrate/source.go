@@ -0,0 +1,353 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/lexer"
+	"github.com/goccy/go-yaml/token"
+
+	"github.com/cerbos/cerbos/internal/parser"
+)
+
+// source is the contents of a policy file along with its syntax tree and tokens.
+// Token positions count runes, so they are converted to byte offsets before editing the contents.
+type source struct {
+	file   *ast.File
+	text   []byte
+	lines  []int
+	tokens token.Tokens
+}
+
+func newSource(text []byte) (*source, error) {
+	f, err := parser.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &source{file: f, text: text, lines: []int{0}, tokens: lexer.Tokenize(string(text))}
+	for i, c := range text {
+		if c == '\n' {
+			s.lines = append(s.lines, i+1)
+		}
+	}
+
+	return s, nil
+}
+
+// document is a YAML document whose body is a mapping.
+type document struct {
+	entries []*ast.MappingValueNode
+}
+
+func (s *source) documents() []*document {
+	var docs []*document
+	for _, doc := range s.file.Docs {
+		if doc.Body == nil {
+			continue
+		}
+
+		if entries, ok := mappingEntries(doc.Body); ok {
+			docs = append(docs, &document{entries: entries})
+		}
+	}
+
+	return docs
+}
+
+// offset returns the byte offset of the token.
+func (s *source) offset(tok *token.Token) int {
+	line, col := tok.Position.Line, tok.Position.Column
+	if line < 1 || line > len(s.lines) {
+		return len(s.text)
+	}
+
+	i := s.lines[line-1]
+	for c := 1; c < col && i < len(s.text) && s.text[i] != '\n'; c++ {
+		_, size := utf8.DecodeRune(s.text[i:])
+		i += size
+	}
+
+	return i
+}
+
+// lineOf returns the line number of the byte offset.
+func (s *source) lineOf(offset int) int {
+	return sort.Search(len(s.lines), func(i int) bool { return s.lines[i] > offset })
+}
+
+func (s *source) lineStart(line int) int {
+	return s.lines[line-1]
+}
+
+// lineEnd returns the offset after the newline at the end of the line.
+func (s *source) lineEnd(line int) int {
+	if line < len(s.lines) {
+		return s.lines[line]
+	}
+
+	return len(s.text)
+}
+
+// next returns the token that follows the last token of the node, or nil if the node is at the end of the file.
+func (s *source) next(n ast.Node) *token.Token {
+	last := lastToken(n)
+	i := slices.IndexFunc(s.tokens, func(t *token.Token) bool { return samePosition(t, last) })
+	if i < 0 || i+1 >= len(s.tokens) {
+		return nil
+	}
+
+	return s.tokens[i+1]
+}
+
+// end returns the offset after the last character of the node.
+func (s *source) end(n ast.Node) int {
+	end := len(s.text)
+	if next := s.next(n); next != nil {
+		end = s.offset(next)
+	}
+
+	start := s.offset(lastToken(n))
+	for end > start+1 && strings.ContainsRune(" \t\r\n", rune(s.text[end-1])) {
+		end--
+	}
+
+	return end
+}
+
+// block returns the range of the lines that a block mapping entry occupies, including any comment at the end of its last line.
+// If withComments is true, the range includes the comment lines directly above the entry that are indented by the same amount.
+func (s *source) block(mv *ast.MappingValueNode, withComments bool) (start, end int, err error) {
+	keyStart := s.offset(firstToken(mv))
+	line := s.lineOf(keyStart)
+	if len(bytes.TrimLeft(s.text[s.lineStart(line):keyStart], " ")) > 0 {
+		return 0, 0, fmt.Errorf("%q does not start a line at %s", keyName(mv), position(mv))
+	}
+
+	lastLine := s.lineOf(s.end(mv) - 1)
+	if next := s.next(mv); next != nil && next.Type != token.CommentType && next.Position.Line <= lastLine {
+		return 0, 0, fmt.Errorf("%q does not end a line at %s", keyName(mv), position(mv))
+	}
+
+	if withComments {
+		line = s.firstCommentLine(line, keyStart-s.lineStart(line))
+	}
+
+	return s.lineStart(line), s.lineEnd(lastLine), nil
+}
+
+// firstCommentLine returns the first of the comment lines with the given indentation that are directly above the line.
+func (s *source) firstCommentLine(line, indent int) int {
+	for line > 1 {
+		prev := s.text[s.lineStart(line-1):s.lineEnd(line-1)]
+		if trimmed := bytes.TrimLeft(prev, " "); !bytes.HasPrefix(trimmed, []byte("#")) || len(prev)-len(trimmed) != indent {
+			break
+		}
+		line--
+	}
+
+	return line
+}
+
+// entry returns the text of a mapping entry, starting with the comment lines directly above it, and the column that it starts at.
+// The text of an entry of a block mapping ends with the line that the entry ends on.
+func (s *source) entry(mv *ast.MappingValueNode, flow bool) (string, int) {
+	start := s.offset(firstToken(mv))
+	col := start - s.lineStart(s.lineOf(start))
+	if flow {
+		return string(s.text[start:s.end(mv)]) + "\n", col
+	}
+
+	start = s.lineStart(s.firstCommentLine(s.lineOf(start), col)) + col
+	return string(s.text[start:s.lineEnd(s.lineOf(s.end(mv)-1))]), col
+}
+
+// indent returns the column that the node starts at, counting from zero.
+func (s *source) indent(n ast.Node) int {
+	start := s.offset(firstToken(n))
+	return start - s.lineStart(s.lineOf(start))
+}
+
+// edit replaces the text between the start and end offsets.
+type edit struct {
+	text       string
+	start, end int
+}
+
+func insert(offset int, text string) edit {
+	return edit{start: offset, end: offset, text: text}
+}
+
+func remove(start, end int) edit {
+	return edit{start: start, end: end}
+}
+
+// apply returns the text with the edits applied. Insertions at the same offset as other edits are applied first.
+func (s *source) apply(edits []edit) ([]byte, error) {
+	slices.SortStableFunc(edits, func(a, b edit) int {
+		if a.start != b.start {
+			return a.start - b.start
+		}
+		return (a.end - a.start) - (b.end - b.start)
+	})
+
+	var out bytes.Buffer
+	pos := 0
+	for _, e := range edits {
+		if e.start < pos {
+			return nil, errors.New("overlapping edits")
+		}
+
+		out.Write(s.text[pos:e.start])
+		if e.start == len(s.text) && e.text != "" && !bytes.HasSuffix(out.Bytes(), []byte("\n")) {
+			out.WriteString("\n")
+		}
+		out.WriteString(e.text)
+		pos = e.end
+	}
+	out.Write(s.text[pos:])
+
+	return out.Bytes(), nil
+}
+
+// reindent moves text whose first line starts at column from so that it starts at column to.
+// Following lines are moved by the same amount, unless they are less indented than the first line.
+func reindent(text string, from, to int) string {
+	lines := strings.SplitAfter(text, "\n")
+	var b strings.Builder
+	for i, line := range lines {
+		if i > 0 {
+			trimmed := strings.TrimLeft(line, " ")
+			line = line[min(from, len(line)-len(trimmed)):]
+		}
+
+		if strings.TrimSpace(line) != "" {
+			b.WriteString(strings.Repeat(" ", to))
+		}
+		b.WriteString(line)
+	}
+
+	return b.String()
+}
+
+func mappingEntries(n ast.Node) ([]*ast.MappingValueNode, bool) {
+	switch t := n.(type) {
+	case *ast.MappingNode:
+		return t.Values, true
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{t}, true
+	default:
+		return nil, false
+	}
+}
+
+// blockMapping returns the entries of a non-empty block mapping.
+func blockMapping(n ast.Node) ([]*ast.MappingValueNode, bool) {
+	if m, ok := n.(*ast.MappingNode); ok && m.IsFlowStyle {
+		return nil, false
+	}
+
+	entries, ok := mappingEntries(n)
+	return entries, ok && len(entries) > 0
+}
+
+func isFlow(n ast.Node) bool {
+	switch t := n.(type) {
+	case *ast.MappingNode:
+		return t.IsFlowStyle
+	case *ast.SequenceNode:
+		return t.IsFlowStyle
+	default:
+		return false
+	}
+}
+
+func keyName(mv *ast.MappingValueNode) string {
+	if key, ok := mv.Key.(*ast.StringNode); ok {
+		return key.Value
+	}
+
+	return ""
+}
+
+func find(entries []*ast.MappingValueNode, names ...string) *ast.MappingValueNode {
+	for _, mv := range entries {
+		if slices.Contains(names, keyName(mv)) {
+			return mv
+		}
+	}
+
+	return nil
+}
+
+func firstToken(n ast.Node) *token.Token {
+	return boundaryToken(n, -1)
+}
+
+func lastToken(n ast.Node) *token.Token {
+	return boundaryToken(n, 1)
+}
+
+// boundaryToken returns the first token of the node if dir is negative, or the last token if dir is positive.
+func boundaryToken(n ast.Node, dir int) *token.Token {
+	var found *token.Token
+	consider := func(t *token.Token) {
+		if t == nil || t.Position == nil || t.Type == token.CommentType {
+			return
+		}
+
+		if found == nil || comparePositions(t, found)*dir > 0 {
+			found = t
+		}
+	}
+
+	ast.Walk(visitor(func(n ast.Node) {
+		switch t := n.(type) {
+		case *ast.CommentNode, *ast.CommentGroupNode:
+			return
+		case *ast.MappingNode:
+			consider(t.End)
+		case *ast.SequenceNode:
+			consider(t.End)
+		}
+		consider(n.GetToken())
+	}), n)
+
+	return found
+}
+
+func comparePositions(a, b *token.Token) int {
+	if a.Position.Line != b.Position.Line {
+		return a.Position.Line - b.Position.Line
+	}
+
+	return a.Position.Column - b.Position.Column
+}
+
+func samePosition(a, b *token.Token) bool {
+	return a.Position != nil && b.Position != nil && comparePositions(a, b) == 0
+}
+
+type visitor func(ast.Node)
+
+func (v visitor) Visit(n ast.Node) ast.Visitor {
+	v(n)
+	return v
+}
+
+func position(n ast.Node) string {
+	if tok := firstToken(n); tok != nil {
+		return fmt.Sprintf("%d:%d", tok.Position.Line, tok.Position.Column)
+	}
+
+	return "unknown position"
+}
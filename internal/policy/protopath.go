@@ -77,12 +77,23 @@ func VariablesLocalProtoPath(p *policyv1.Policy) string {
 	return fmt.Sprintf("%s.variables.local", policyKind(p))
 }
 
+func CombiningAlgorithmProtoPath(p *policyv1.Policy) string {
+	return fmt.Sprintf("%s.combining_algorithm", policyKind(p))
+}
+
+// PolicyProtoPath returns the path of the policy definition that holds the policy type specific fields.
+func PolicyProtoPath(p *policyv1.Policy) string {
+	return policyKind(p)
+}
+
 func policyKind(p *policyv1.Policy) string {
 	switch p.PolicyType.(type) {
 	case *policyv1.Policy_ResourcePolicy:
 		return "resource_policy"
 	case *policyv1.Policy_PrincipalPolicy:
 		return "principal_policy"
+	case *policyv1.Policy_RolePolicy:
+		return "role_policy"
 	case *policyv1.Policy_DerivedRoles:
 		return "derived_roles"
 	case *policyv1.Policy_ExportConstants:
@@ -42,6 +42,7 @@ var (
 	TraceEventEffectAllow   = color.New(color.FgGreen).SprintFunc()
 	TraceEventEffectDeny    = color.New(color.FgRed).SprintFunc()
 	TraceEventSkipped       = color.New(color.FgHiWhite).SprintFunc()
+	WarningMsg              = color.New(color.FgYellow).SprintFunc()
 )
 
 func Position(file string, position *sourcev1.Position) string {
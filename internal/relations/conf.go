@@ -0,0 +1,52 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package relations
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+const ConfKey = "relations"
+
+// Conf is optional configuration for relationship checks.
+type Conf struct {
+	confHolder
+}
+
+type confHolder struct {
+	// Provider states which relationship tuple provider to use. Relationship checks are disabled if it is not set.
+	Provider string `yaml:"provider" conf:",example=file"`
+}
+
+func (c *Conf) UnmarshalYAML(unmarshal func(any) error) error {
+	// Provider configuration is nested under the "relations" key, so it must be ignored when parsing this section strictly.
+	// See the equivalent workaround in the audit configuration for details.
+	var confMap map[string]any
+	if err := unmarshal(&confMap); err != nil {
+		return fmt.Errorf("failed to unmarshal relations config: %w", err)
+	}
+
+	yamlBytes, err := yaml.Marshal(confMap)
+	if err != nil {
+		return fmt.Errorf("failed to marshal relations config [%v]: %w", confMap, err)
+	}
+
+	c.confHolder = confHolder{}
+	return yaml.Unmarshal(yamlBytes, &c.confHolder)
+}
+
+func (c *Conf) Key() string {
+	return ConfKey
+}
+
+func GetConf() (*Conf, error) {
+	conf := &Conf{}
+	err := config.GetSection(conf)
+
+	return conf, err
+}
@@ -0,0 +1,32 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package file
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cerbos/cerbos/internal/relations"
+)
+
+const confKey = relations.ConfKey + ".file"
+
+// Conf is required (if provider is set to 'file') configuration for the file relationship provider.
+// +desc=This section is required only if relations.provider is file.
+type Conf struct {
+	// Path to the YAML or JSON file containing the relationship tuples.
+	Path string `yaml:"path" conf:"required,example=/path/to/tuples.yaml"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) Validate() error {
+	if strings.TrimSpace(c.Path) == "" {
+		return errors.New("path must be specified")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/relations"
+)
+
+const ProviderName = "file"
+
+func init() {
+	relations.RegisterProvider(ProviderName, func(ctx context.Context, confW *config.Wrapper) (relations.Provider, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewProvider(ctx, conf)
+	})
+}
+
+type tuplesFile struct {
+	Tuples []relations.Tuple `yaml:"tuples"`
+}
+
+// NewProvider creates a provider from the tuples in a file. The file is only read once.
+func NewProvider(ctx context.Context, conf *Conf) (*relations.MemoryProvider, error) {
+	f, err := os.Open(conf.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open relationship tuples file: %w", err)
+	}
+	defer f.Close()
+
+	tuples, err := ReadTuples(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read relationship tuples from %s: %w", conf.Path, err)
+	}
+
+	logging.FromContext(ctx).Named("relations").Info("Loaded relationship tuples", zap.String("path", conf.Path), zap.Int("count", len(tuples)))
+	return relations.NewMemoryProvider(tuples), nil
+}
+
+// ReadTuples reads relationship tuples in YAML or JSON format.
+func ReadTuples(r io.Reader) ([]relations.Tuple, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	var tf tuplesFile
+	if err := dec.Decode(&tf); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	for i, t := range tf.Tuples {
+		if t.Subject == "" || t.Relation == "" || t.Object == "" {
+			return nil, fmt.Errorf("tuple %d: subject, relation and object must all be specified", i)
+		}
+	}
+
+	return tf.Tuples, nil
+}
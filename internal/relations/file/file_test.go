@@ -0,0 +1,71 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package file_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/relations"
+	"github.com/cerbos/cerbos/internal/relations/file"
+)
+
+func TestNewProvider(t *testing.T) {
+	provider, err := file.NewProvider(t.Context(), &file.Conf{Path: filepath.Join("testdata", "tuples.yaml")})
+	require.NoError(t, err)
+
+	checker := relations.NewChecker(provider)
+	got, err := checker.Related(t.Context(), "bob", "editor", "folder:1")
+	require.NoError(t, err)
+	require.True(t, got)
+
+	got, err = checker.Related(t.Context(), "bob", "owner", "folder:1")
+	require.NoError(t, err)
+	require.False(t, got)
+}
+
+func TestReadTuples(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		want    []relations.Tuple
+		wantErr bool
+	}{
+		{
+			name:  "json",
+			input: `{"tuples": [{"subject": "alice", "relation": "owner", "object": "folder:1"}]}`,
+			want:  []relations.Tuple{{Subject: "alice", Relation: "owner", Object: "folder:1"}},
+		},
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name:    "missing_object",
+			input:   "tuples:\n  - subject: alice\n    relation: owner\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown_field",
+			input:   "tuples:\n  - subject: alice\n    relation: owner\n    object: folder:1\n    resource: folder:1\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := file.ReadTuples(strings.NewReader(tc.input))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
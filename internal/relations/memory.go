@@ -0,0 +1,34 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package relations
+
+import "context"
+
+var _ Provider = (*MemoryProvider)(nil)
+
+// Tuple states that the subject has the relation with the object.
+type Tuple struct {
+	Subject  string `yaml:"subject" json:"subject"`
+	Relation string `yaml:"relation" json:"relation"`
+	Object   string `yaml:"object" json:"object"`
+}
+
+// MemoryProvider is a provider backed by a fixed set of tuples held in memory.
+type MemoryProvider struct {
+	subjects map[tupleKey][]string
+}
+
+func NewMemoryProvider(tuples []Tuple) *MemoryProvider {
+	subjects := make(map[tupleKey][]string)
+	for _, t := range tuples {
+		key := tupleKey{relation: t.Relation, object: t.Object}
+		subjects[key] = append(subjects[key], t.Subject)
+	}
+
+	return &MemoryProvider{subjects: subjects}
+}
+
+func (mp *MemoryProvider) Subjects(_ context.Context, relation, object string) ([]string, error) {
+	return mp.subjects[tupleKey{relation: relation, object: object}], nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package relations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+// maxDepth is the maximum number of usersets that are expanded while checking a relation.
+const maxDepth = 16
+
+var (
+	ErrMaxDepthExceeded = fmt.Errorf("relationship graph is deeper than %d levels", maxDepth)
+
+	errUnknownProvider = errors.New("unknown relationship provider")
+)
+
+// Provider is a source of relationship tuples.
+//
+// A tuple states that a subject has a relation with an object. The subject of a tuple can either be an identifier
+// (such as the ID of a principal) or a userset of the form `object#relation`, which stands for all the subjects
+// that have the relation with that object.
+type Provider interface {
+	// Subjects returns the subjects of the tuples with the given relation and object.
+	Subjects(ctx context.Context, relation, object string) ([]string, error)
+}
+
+// Constructor creates a provider from configuration.
+type Constructor func(context.Context, *config.Wrapper) (Provider, error)
+
+var (
+	providers   = map[string]Constructor{}
+	providersMu sync.RWMutex
+)
+
+// RegisterProvider registers a relationship provider.
+func RegisterProvider(name string, cons Constructor) {
+	providersMu.Lock()
+	providers[name] = cons
+	providersMu.Unlock()
+}
+
+// New creates the relationship provider defined in the global configuration.
+// It returns a nil provider if relationship checks are not configured.
+func New(ctx context.Context) (Provider, error) {
+	return NewFromConf(ctx, config.Global())
+}
+
+func NewFromConf(ctx context.Context, confW *config.Wrapper) (Provider, error) {
+	conf := new(Conf)
+	if err := confW.GetSection(conf); err != nil {
+		return nil, fmt.Errorf("failed to read relations configuration: %w", err)
+	}
+
+	if conf.Provider == "" {
+		return nil, nil
+	}
+
+	providersMu.RLock()
+	cons, ok := providers[conf.Provider]
+	providersMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w [%s]", errUnknownProvider, conf.Provider)
+	}
+
+	return cons(ctx, confW)
+}
+
+var _ conditions.RelationChecker = (*Checker)(nil)
+
+// Checker answers relationship queries using a provider. Usersets are expanded transitively.
+//
+// A checker memoises the tuples it reads from the provider, so it should only be used for the lifetime of a single request.
+type Checker struct {
+	provider Provider
+	subjects map[tupleKey][]string
+	mu       sync.Mutex
+}
+
+type tupleKey struct {
+	relation string
+	object   string
+}
+
+func NewChecker(provider Provider) *Checker {
+	return &Checker{provider: provider, subjects: make(map[tupleKey][]string)}
+}
+
+// Related returns true if the subject has the relation with the object, either directly or through a userset.
+func (c *Checker) Related(ctx context.Context, subject, relation, object string) (bool, error) {
+	visited := make(map[tupleKey]struct{})
+	return c.related(ctx, subject, tupleKey{relation: relation, object: object}, visited, 0)
+}
+
+func (c *Checker) related(ctx context.Context, subject string, key tupleKey, visited map[tupleKey]struct{}, depth int) (bool, error) {
+	if depth > maxDepth {
+		return false, ErrMaxDepthExceeded
+	}
+
+	if _, ok := visited[key]; ok {
+		return false, nil
+	}
+	visited[key] = struct{}{}
+
+	subjects, err := c.lookup(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range subjects {
+		if s == subject {
+			return true, nil
+		}
+	}
+
+	for _, s := range subjects {
+		userset, ok := parseUserset(s)
+		if !ok {
+			continue
+		}
+
+		found, err := c.related(ctx, subject, userset, visited, depth+1)
+		if err != nil || found {
+			return found, err
+		}
+	}
+
+	return false, nil
+}
+
+func (c *Checker) lookup(ctx context.Context, key tupleKey) ([]string, error) {
+	c.mu.Lock()
+	subjects, ok := c.subjects[key]
+	c.mu.Unlock()
+	if ok {
+		return subjects, nil
+	}
+
+	subjects, err := c.provider.Subjects(ctx, key.relation, key.object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read relationship tuples for %s#%s: %w", key.object, key.relation, err)
+	}
+
+	c.mu.Lock()
+	c.subjects[key] = subjects
+	c.mu.Unlock()
+
+	return subjects, nil
+}
+
+func parseUserset(subject string) (tupleKey, bool) {
+	object, relation, ok := strings.Cut(subject, "#")
+	if !ok || object == "" || relation == "" {
+		return tupleKey{}, false
+	}
+
+	return tupleKey{relation: relation, object: object}, true
+}
@@ -0,0 +1,90 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package relations_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/relations"
+)
+
+type countingProvider struct {
+	relations.Provider
+	calls int
+}
+
+func (cp *countingProvider) Subjects(ctx context.Context, relation, object string) ([]string, error) {
+	cp.calls++
+	return cp.Provider.Subjects(ctx, relation, object)
+}
+
+func TestChecker(t *testing.T) {
+	provider := relations.NewMemoryProvider([]relations.Tuple{
+		{Subject: "alice", Relation: "editor", Object: "folder:1"},
+		{Subject: "group:eng#member", Relation: "editor", Object: "folder:2"},
+		{Subject: "bob", Relation: "member", Object: "group:eng"},
+		{Subject: "group:platform#member", Relation: "member", Object: "group:eng"},
+		{Subject: "carol", Relation: "member", Object: "group:platform"},
+		// cycle
+		{Subject: "group:b#member", Relation: "member", Object: "group:a"},
+		{Subject: "group:a#member", Relation: "member", Object: "group:b"},
+	})
+
+	testCases := []struct {
+		subject  string
+		relation string
+		object   string
+		want     bool
+	}{
+		{subject: "alice", relation: "editor", object: "folder:1", want: true},
+		{subject: "alice", relation: "viewer", object: "folder:1", want: false},
+		{subject: "alice", relation: "editor", object: "folder:2", want: false},
+		{subject: "bob", relation: "editor", object: "folder:2", want: true},
+		{subject: "carol", relation: "editor", object: "folder:2", want: true},
+		{subject: "carol", relation: "editor", object: "folder:1", want: false},
+		{subject: "dave", relation: "member", object: "group:a", want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s_%s_%s", tc.subject, tc.relation, tc.object), func(t *testing.T) {
+			checker := relations.NewChecker(provider)
+			got, err := checker.Related(t.Context(), tc.subject, tc.relation, tc.object)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("memoised", func(t *testing.T) {
+		cp := &countingProvider{Provider: provider}
+		checker := relations.NewChecker(cp)
+
+		for range 3 {
+			got, err := checker.Related(t.Context(), "carol", "editor", "folder:2")
+			require.NoError(t, err)
+			require.True(t, got)
+		}
+
+		require.Equal(t, 3, cp.calls)
+	})
+
+	t.Run("max_depth", func(t *testing.T) {
+		tuples := make([]relations.Tuple, 0, 21)
+		for i := range 20 {
+			tuples = append(tuples, relations.Tuple{Subject: fmt.Sprintf("group:%d#member", i+1), Relation: "member", Object: fmt.Sprintf("group:%d", i)})
+		}
+		tuples = append(tuples, relations.Tuple{Subject: "alice", Relation: "member", Object: "group:20"})
+
+		checker := relations.NewChecker(relations.NewMemoryProvider(tuples))
+		_, err := checker.Related(t.Context(), "alice", "member", "group:0")
+		require.ErrorIs(t, err, relations.ErrMaxDepthExceeded)
+
+		got, err := checker.Related(t.Context(), "alice", "member", "group:10")
+		require.NoError(t, err)
+		require.True(t, got)
+	})
+}
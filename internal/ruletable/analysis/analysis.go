@@ -0,0 +1,378 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package analysis finds rules in a rule table that can never take effect.
+package analysis
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	sourcev1 "github.com/cerbos/cerbos/api/genpb/cerbos/source/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+type Kind string
+
+const (
+	// KindShadowedRule is reported for rules that are always overridden by an unconditional rule of the same policy.
+	KindShadowedRule Kind = "shadowedRule"
+	// KindDuplicateRule is reported for rules that are identical to an earlier rule of the same policy.
+	KindDuplicateRule Kind = "duplicateRule"
+	// KindUnusedDerivedRoles is reported for derived roles imports that the policy never refers to.
+	KindUnusedDerivedRoles Kind = "unusedDerivedRoles"
+	// KindUntestedRole is reported for roles that don't appear in any principal test fixture.
+	KindUntestedRole Kind = "untestedRole"
+)
+
+// Descriptions describes each kind of warning.
+var Descriptions = map[Kind]string{
+	KindShadowedRule:       "Rules must not be overridden by an unconditional rule of the same policy",
+	KindDuplicateRule:      "Rules must not duplicate an earlier rule of the same policy",
+	KindUnusedDerivedRoles: "Imported derived roles must be referenced by the policy",
+	KindUntestedRole:       "Roles must be assigned to at least one principal in the test fixtures",
+}
+
+// Warning is a problem with a policy that doesn't prevent it from being compiled.
+type Warning struct {
+	Position *sourcev1.Position `json:"position,omitempty"`
+	File     string             `json:"file"`
+	Policy   string             `json:"policy"`
+	Rule     string             `json:"rule,omitempty"`
+	Kind     Kind               `json:"kind"`
+	Message  string             `json:"message"`
+}
+
+// Analyse inspects the rules of the rule table and returns warnings about rules that can never take effect.
+// The compilation units that the rule table was built from are used to find the source positions of the rules and the
+// derived roles imported by each policy. If fixtureRoles is not nil, rules for roles that are not in the set are reported as well.
+func Analyse(rt *runtimev1.RuleTable, units []*policy.CompilationUnit, fixtureRoles map[string]struct{}) []Warning {
+	a := &analyser{sources: make(map[string]*policy.CompilationUnit, len(units))}
+	for _, unit := range units {
+		a.sources[namer.FQN(unit.MainPolicy())] = unit
+	}
+
+	rules := groupRules(rt.GetRules())
+	for _, policyRules := range rules {
+		a.checkShadowedRules(policyRules)
+		a.checkDuplicateRules(policyRules)
+	}
+
+	for _, unit := range units {
+		a.checkUnusedDerivedRoles(unit)
+	}
+
+	if fixtureRoles != nil {
+		a.checkUntestedRoles(rt.GetRules(), fixtureRoles)
+	}
+
+	slices.SortStableFunc(a.warnings, func(x, y Warning) int {
+		return cmp.Or(
+			cmp.Compare(x.File, y.File),
+			cmp.Compare(x.Position.GetLine(), y.Position.GetLine()),
+			cmp.Compare(x.Position.GetColumn(), y.Position.GetColumn()),
+			cmp.Compare(x.Kind, y.Kind),
+			cmp.Compare(x.Message, y.Message),
+		)
+	})
+
+	return a.warnings
+}
+
+type analyser struct {
+	sources  map[string]*policy.CompilationUnit
+	warnings []Warning
+}
+
+// rule collects the rows produced from a single rule of a policy.
+type rule struct {
+	name      string
+	fqn       string
+	rows      []*runtimev1.RuleTable_RuleRow
+	ordinal   uint32
+	algorithm policyv1.CombiningAlgorithm
+}
+
+// groupRules groups the rows of resource and principal policies by the rule they were produced from.
+// Rows from role policies are skipped because they can only allow actions.
+func groupRules(rows []*runtimev1.RuleTable_RuleRow) [][]*rule {
+	type ruleKey struct {
+		fqn     string
+		ordinal uint32
+	}
+
+	byKey := make(map[ruleKey]*rule)
+	byPolicy := make(map[string][]*rule)
+	for _, row := range rows {
+		if row.FromRolePolicy || row.ActionSet == nil {
+			continue
+		}
+
+		key := ruleKey{fqn: row.OriginFqn, ordinal: row.Ordinal}
+		r, ok := byKey[key]
+		if !ok {
+			r = &rule{name: row.Name, fqn: row.OriginFqn, ordinal: row.Ordinal, algorithm: row.CombiningAlgorithm}
+			byKey[key] = r
+			byPolicy[row.OriginFqn] = append(byPolicy[row.OriginFqn], r)
+		}
+		r.rows = append(r.rows, row)
+	}
+
+	res := make([][]*rule, 0, len(byPolicy))
+	for _, fqn := range slices.Sorted(maps.Keys(byPolicy)) {
+		policyRules := byPolicy[fqn]
+		slices.SortFunc(policyRules, func(x, y *rule) int { return cmp.Compare(x.ordinal, y.ordinal) })
+		res = append(res, policyRules)
+	}
+
+	return res
+}
+
+// checkShadowedRules reports rules where every row is overridden by an unconditional row of another rule under the combining algorithm of the policy.
+func (a *analyser) checkShadowedRules(rules []*rule) {
+	for _, r := range rules {
+		shadowedBy := make(map[string]struct{})
+		for _, row := range r.rows {
+			winner := findWinner(rules, r, row)
+			if winner == nil {
+				shadowedBy = nil
+				break
+			}
+			shadowedBy[winner.name] = struct{}{}
+		}
+
+		if len(shadowedBy) == 0 {
+			continue
+		}
+
+		a.addRuleWarning(r, KindShadowedRule, "Rule %q can never take effect because it is always overridden by %s", r.name, quoteAll(slices.Sorted(maps.Keys(shadowedBy))))
+	}
+}
+
+func findWinner(rules []*rule, r *rule, row *runtimev1.RuleTable_RuleRow) *rule {
+	for _, other := range rules {
+		if other == r {
+			continue
+		}
+
+		for _, o := range other.rows {
+			if o.Condition != nil || o.DerivedRoleCondition != nil {
+				continue
+			}
+
+			if o.Principal != row.Principal ||
+				!covers(o.Resource, row.Resource) ||
+				!covers(o.Role, row.Role) ||
+				!covers(o.GetAction(), row.GetAction()) {
+				continue
+			}
+
+			if overrides(r.algorithm, o, row) {
+				return other
+			}
+		}
+	}
+
+	return nil
+}
+
+func covers(general, specific string) bool {
+	return general == specific || general == "*"
+}
+
+// overrides reports whether an unconditional row always decides the outcome before the given row is considered.
+func overrides(algorithm policyv1.CombiningAlgorithm, winner, row *runtimev1.RuleTable_RuleRow) bool {
+	switch algorithm {
+	case policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE:
+		return winner.Ordinal < row.Ordinal
+	case policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES, policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT:
+		return winner.Effect == effectv1.Effect_EFFECT_ALLOW && row.Effect == effectv1.Effect_EFFECT_DENY
+	default:
+		return winner.Effect == effectv1.Effect_EFFECT_DENY && row.Effect == effectv1.Effect_EFFECT_ALLOW
+	}
+}
+
+// checkDuplicateRules reports rules that produce exactly the same rows as an earlier rule of the policy.
+func (a *analyser) checkDuplicateRules(rules []*rule) {
+	seen := make(map[string]*rule, len(rules))
+	for _, r := range rules {
+		sig := ruleSignature(r)
+		if first, ok := seen[sig]; ok {
+			a.addRuleWarning(r, KindDuplicateRule, "Rule %q is a duplicate of rule %q", r.name, first.name)
+			continue
+		}
+		seen[sig] = r
+	}
+}
+
+func ruleSignature(r *rule) string {
+	sigs := make([]string, len(r.rows))
+	for i, row := range r.rows {
+		sigs[i] = fmt.Sprintf("%s|%s|%s|%s|%s|%d|%d|%d|%d",
+			row.Resource,
+			row.Role,
+			row.GetAction(),
+			row.Principal,
+			row.OriginDerivedRole,
+			row.Effect,
+			util.HashPB(row.Condition, nil),
+			util.HashPB(row.DerivedRoleCondition, nil),
+			util.HashPB(row.EmitOutput, nil),
+		)
+	}
+
+	slices.Sort(sigs)
+	return strings.Join(sigs, "\n")
+}
+
+// checkUnusedDerivedRoles reports derived roles imports where none of the imported roles are referenced by the policy rules.
+func (a *analyser) checkUnusedDerivedRoles(unit *policy.CompilationUnit) {
+	p := unit.MainPolicy()
+	rp := p.GetResourcePolicy()
+	if rp == nil || len(rp.ImportDerivedRoles) == 0 {
+		return
+	}
+
+	referenced := make(map[string]struct{})
+	for _, r := range rp.Rules {
+		for _, dr := range r.DerivedRoles {
+			referenced[dr] = struct{}{}
+		}
+	}
+
+	definitions := make(map[string][]string)
+	for _, def := range unit.Definitions {
+		if dr := def.GetDerivedRoles(); dr != nil {
+			for _, rd := range dr.Definitions {
+				definitions[dr.Name] = append(definitions[dr.Name], rd.Name)
+			}
+		}
+	}
+
+	srcCtx := unit.SourceContexts[unit.ModID]
+	for i, imp := range rp.ImportDerivedRoles {
+		if slices.ContainsFunc(definitions[imp], func(role string) bool {
+			_, ok := referenced[role]
+			return ok
+		}) {
+			continue
+		}
+
+		a.warnings = append(a.warnings, Warning{
+			Position: srcCtx.PositionForProtoPath(policy.ResourcePolicyImportDerivedRolesProtoPath(i)),
+			File:     policy.GetSourceFile(p),
+			Policy:   namer.PolicyKey(p),
+			Kind:     KindUnusedDerivedRoles,
+			Message:  fmt.Sprintf("Derived roles %q are imported but none of them are referenced by the rules of the policy", imp),
+		})
+	}
+}
+
+// checkUntestedRoles reports roles that the rules of a policy refer to but that no principal fixture has.
+func (a *analyser) checkUntestedRoles(rows []*runtimev1.RuleTable_RuleRow, fixtureRoles map[string]struct{}) {
+	type policyRole struct {
+		fqn  string
+		role string
+	}
+
+	reported := make(map[policyRole]struct{})
+	for _, row := range rows {
+		if row.Role == "" || row.Role == "*" {
+			continue
+		}
+
+		if _, ok := fixtureRoles[row.Role]; ok {
+			continue
+		}
+
+		key := policyRole{fqn: row.OriginFqn, role: row.Role}
+		if _, ok := reported[key]; ok {
+			continue
+		}
+		reported[key] = struct{}{}
+
+		msg := fmt.Sprintf("Role %q is not assigned to any principal in the test fixtures", row.Role)
+		if row.FromRolePolicy {
+			a.addPolicyWarning(row.OriginFqn, "role_policy.role", KindUntestedRole, msg)
+			continue
+		}
+
+		a.addRuleWarning(&rule{name: row.Name, fqn: row.OriginFqn, ordinal: row.Ordinal}, KindUntestedRole, "%s", msg)
+	}
+}
+
+func (a *analyser) addRuleWarning(r *rule, kind Kind, format string, args ...any) {
+	w := Warning{
+		File:    "<unknown>",
+		Policy:  namer.PolicyKeyFromFQN(r.fqn),
+		Rule:    r.name,
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	}
+
+	if unit, ok := a.sources[r.fqn]; ok {
+		p := unit.MainPolicy()
+		w.File = policy.GetSourceFile(p)
+		if path := ruleProtoPath(p, r.ordinal); path != "" {
+			w.Position = unit.SourceContexts[unit.ModID].PositionForProtoPath(path)
+		}
+	}
+
+	a.warnings = append(a.warnings, w)
+}
+
+func (a *analyser) addPolicyWarning(fqn, path string, kind Kind, msg string) {
+	w := Warning{
+		File:    "<unknown>",
+		Policy:  namer.PolicyKeyFromFQN(fqn),
+		Kind:    kind,
+		Message: msg,
+	}
+
+	if unit, ok := a.sources[fqn]; ok {
+		w.File = policy.GetSourceFile(unit.MainPolicy())
+		w.Position = unit.SourceContexts[unit.ModID].PositionForProtoPath(path)
+	}
+
+	a.warnings = append(a.warnings, w)
+}
+
+// ruleProtoPath finds the path of the rule with the given ordinal in the policy definition.
+func ruleProtoPath(p *policyv1.Policy, ordinal uint32) string {
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		return policy.ResourcePolicyRuleProtoPath(int(ordinal))
+	case *policyv1.Policy_PrincipalPolicy:
+		// principal policy ordinals count the action rules across all the resource rules
+		n := ordinal
+		for i, r := range pt.PrincipalPolicy.Rules {
+			if n < uint32(len(r.Actions)) {
+				return policy.PrincipalPolicyActionRuleProtoPath(i, int(n))
+			}
+			n -= uint32(len(r.Actions))
+		}
+	}
+
+	return ""
+}
+
+func quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = fmt.Sprintf("%q", n)
+	}
+
+	if len(quoted) == 1 {
+		return "rule " + quoted[0]
+	}
+
+	return "rules " + strings.Join(quoted, ", ")
+}
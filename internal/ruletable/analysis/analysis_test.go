@@ -0,0 +1,174 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package analysis_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rogpeppe/go-internal/txtar"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/ruletable/analysis"
+	"github.com/cerbos/cerbos/internal/schema"
+)
+
+const policies = `
+-- derived_roles.yaml --
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: common
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: request.resource.attr.owner == request.principal.id
+-- unused_derived_roles.yaml --
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: unused
+  definitions:
+    - name: auditor
+      parentRoles: ["user"]
+-- document.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  importDerivedRoles:
+    - common
+    - unused
+  rules:
+    - name: public
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+      condition:
+        match:
+          expr: request.resource.attr.public
+    - name: public_again
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+      condition:
+        match:
+          expr: request.resource.attr.public
+    - name: owner_delete
+      actions: ["delete"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["owner"]
+    - name: no_delete
+      actions: ["delete"]
+      effect: EFFECT_DENY
+      roles: ["user"]
+    - name: admin_edit
+      actions: ["edit"]
+      effect: EFFECT_ALLOW
+      roles: ["admin"]
+-- report.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: report
+  version: default
+  rules:
+    - name: conditional_deny
+      actions: ["view"]
+      effect: EFFECT_DENY
+      roles: ["user"]
+      condition:
+        match:
+          expr: request.resource.attr.secret
+    - name: view
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+-- first_applicable.yaml --
+apiVersion: api.cerbos.dev/v1
+principalPolicy:
+  principal: harry
+  version: default
+  combiningAlgorithm: COMBINING_ALGORITHM_FIRST_APPLICABLE
+  rules:
+    - resource: document
+      actions:
+        - name: allow_all
+          action: "*"
+          effect: EFFECT_ALLOW
+        - name: deny_delete
+          action: "delete"
+          effect: EFFECT_DENY
+`
+
+func TestAnalyse(t *testing.T) {
+	archive := txtar.Parse([]byte(policies))
+	units := []*policy.CompilationUnit{
+		mkCompilationUnit(t, "document.yaml", archive),
+		mkCompilationUnit(t, "report.yaml", archive),
+		mkCompilationUnit(t, "first_applicable.yaml", archive),
+	}
+
+	rt := ruletable.NewProtoRuletable()
+	for _, unit := range units {
+		rps, err := compile.Compile(unit, schema.NewNopManager())
+		require.NoError(t, err)
+		rt.Rules = append(rt.Rules, ruletable.AddPolicy(rt, rps)...)
+	}
+
+	type warning struct {
+		file string
+		rule string
+		kind analysis.Kind
+		line uint32
+	}
+
+	summarise := func(warnings []analysis.Warning) []warning {
+		res := make([]warning, len(warnings))
+		for i, w := range warnings {
+			res[i] = warning{file: w.File, rule: w.Rule, kind: w.Kind, line: w.Position.GetLine()}
+		}
+		return res
+	}
+
+	t.Run("without_fixtures", func(t *testing.T) {
+		have := summarise(analysis.Analyse(rt, units, nil))
+		require.Equal(t, []warning{
+			{file: "document.yaml", kind: analysis.KindUnusedDerivedRoles, line: 7},
+			{file: "document.yaml", rule: "public_again", kind: analysis.KindDuplicateRule, line: 16},
+			{file: "document.yaml", rule: "owner_delete", kind: analysis.KindShadowedRule, line: 23},
+			{file: "first_applicable.yaml", rule: "deny_delete", kind: analysis.KindShadowedRule, line: 12},
+		}, have)
+	})
+
+	t.Run("with_fixtures", func(t *testing.T) {
+		have := summarise(analysis.Analyse(rt, units, map[string]struct{}{"user": {}}))
+		require.Contains(t, have, warning{file: "document.yaml", rule: "admin_edit", kind: analysis.KindUntestedRole, line: 31})
+		require.Len(t, have, 5)
+	})
+}
+
+func mkCompilationUnit(t *testing.T, mainDef string, archive *txtar.Archive) *policy.CompilationUnit {
+	t.Helper()
+
+	cu := &policy.CompilationUnit{}
+
+	for _, f := range archive.Files {
+		p, sc, err := policy.ReadPolicyWithSourceContextFromReader(bytes.NewReader(f.Data))
+		require.NoError(t, err, "Unexpected error from %s", f.Name)
+
+		modID := namer.GenModuleID(p)
+
+		if f.Name == mainDef {
+			cu.ModID = modID
+		}
+
+		cu.AddDefinition(modID, policy.WithMetadata(p, f.Name, nil, f.Name, policy.SourceFile(f.Name)), sc)
+	}
+
+	return cu
+}
@@ -0,0 +1,222 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/util"
+	"github.com/cerbos/cerbos/internal/verify"
+)
+
+const maxDecisionLogLineSize = 16 * 1024 * 1024
+
+var _ verify.Checker = (*Comparer)(nil)
+
+// DecisionChange is a check whose effects or outputs differ between the old and new policies.
+// Only the actions whose effects changed are listed, and the outputs are only included if they changed.
+type DecisionChange struct {
+	Effects    map[string]EffectChange `json:"effects,omitempty"`
+	OldOutputs map[string]any          `json:"oldOutputs,omitempty"`
+	NewOutputs map[string]any          `json:"newOutputs,omitempty"`
+	Source     string                  `json:"source"`
+	Principal  string                  `json:"principal"`
+	Resource   string                  `json:"resource"`
+}
+
+type EffectChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// Comparer evaluates check inputs against the old and new policies and records the decisions that differ.
+// It implements verify.Checker so that the inputs of policy tests can be compared by running the tests with it.
+type Comparer struct {
+	oldEng  verify.Checker
+	newEng  verify.Checker
+	seen    map[uint64]struct{}
+	source  string
+	changes []DecisionChange
+	mu      sync.Mutex
+}
+
+func NewComparer(oldEng, newEng verify.Checker) *Comparer {
+	return &Comparer{oldEng: oldEng, newEng: newEng, seen: make(map[uint64]struct{})}
+}
+
+// Check evaluates the inputs against both sets of policies and returns the outputs of the old policies.
+func (c *Comparer) Check(ctx context.Context, inputs []*enginev1.CheckInput, opts ...evaluator.CheckOpt) ([]*enginev1.CheckOutput, error) {
+	c.mu.Lock()
+	source := c.source
+	c.mu.Unlock()
+
+	return c.compare(ctx, source, inputs, opts...)
+}
+
+// CompareTests runs the policy tests found in fsys and records the checks whose decisions differ.
+// The test expectations are irrelevant because the decisions of the old and new policies are compared with each other.
+func (c *Comparer) CompareTests(ctx context.Context, fsys fs.FS) error {
+	c.mu.Lock()
+	c.source = "tests"
+	c.mu.Unlock()
+
+	_, err := verify.Verify(ctx, fsys, c, verify.Config{})
+	return err
+}
+
+// CompareDecisionLog evaluates the CheckResources calls recorded in a decision log, which contains an audit
+// log entry in JSON format on each line, and records the checks whose decisions differ. Other kinds of entries are ignored.
+// The policies are evaluated at the time that the call was recorded.
+func (c *Comparer) CompareDecisionLog(ctx context.Context, source string, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxDecisionLogLineSize)
+
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	for line := 1; scanner.Scan(); line++ {
+		data := scanner.Bytes()
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
+		}
+
+		entry := &auditv1.DecisionLogEntry{}
+		if err := unmarshaler.Unmarshal(data, entry); err != nil {
+			return fmt.Errorf("failed to read decision log entry at line %d: %w", line, err)
+		}
+
+		inputs := entry.GetCheckResources().GetInputs()
+		if len(inputs) == 0 {
+			//nolint:staticcheck // Entries written by older versions only have the deprecated field.
+			inputs = entry.GetInputs()
+		}
+
+		if len(inputs) == 0 {
+			continue
+		}
+
+		var opts []evaluator.CheckOpt
+		if ts := entry.GetTimestamp(); ts != nil {
+			opts = append(opts, evaluator.WithNowFunc(ts.AsTime))
+		}
+
+		entrySource := fmt.Sprintf("%s:%d", source, line)
+		if callID := entry.GetCallId(); callID != "" {
+			entrySource = fmt.Sprintf("%s (call %s)", entrySource, callID)
+		}
+
+		if _, err := c.compare(ctx, entrySource, inputs, opts...); err != nil {
+			return fmt.Errorf("failed to evaluate decision log entry at line %d: %w", line, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// Changes returns the decisions that differ, sorted by principal and resource.
+func (c *Comparer) Changes() []DecisionChange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	changes := slices.Clone(c.changes)
+	slices.SortStableFunc(changes, func(a, b DecisionChange) int {
+		return cmp.Or(cmp.Compare(a.Principal, b.Principal), cmp.Compare(a.Resource, b.Resource))
+	})
+
+	return changes
+}
+
+func (c *Comparer) compare(ctx context.Context, source string, inputs []*enginev1.CheckInput, opts ...evaluator.CheckOpt) ([]*enginev1.CheckOutput, error) {
+	oldOutputs, err := c.oldEng.Check(ctx, inputs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	newOutputs, err := c.newEng.Check(ctx, inputs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate new policies: %w", err)
+	}
+
+	for i, input := range inputs {
+		c.compareOutput(source, input, oldOutputs[i], newOutputs[i])
+	}
+
+	return oldOutputs, nil
+}
+
+func (c *Comparer) compareOutput(source string, input *enginev1.CheckInput, oldOutput, newOutput *enginev1.CheckOutput) {
+	change := DecisionChange{
+		Source:    source,
+		Principal: input.GetPrincipal().GetId(),
+		Resource:  fmt.Sprintf("%s:%s", input.GetResource().GetKind(), input.GetResource().GetId()),
+	}
+
+	for _, action := range input.Actions {
+		oldEffect := oldOutput.GetActions()[action].GetEffect()
+		newEffect := newOutput.GetActions()[action].GetEffect()
+		if oldEffect != newEffect {
+			if change.Effects == nil {
+				change.Effects = make(map[string]EffectChange)
+			}
+			change.Effects[action] = EffectChange{Old: oldEffect.String(), New: newEffect.String()}
+		}
+	}
+
+	oldValues, newValues := outputValues(oldOutput), outputValues(newOutput)
+	outputsChanged := !slices.EqualFunc(oldValues, newValues, outputEntriesEqual)
+	if outputsChanged {
+		change.OldOutputs, change.NewOutputs = asMap(oldValues), asMap(newValues)
+	}
+
+	if change.Effects == nil && !outputsChanged {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The same check can be made many times by the tests, so only the first one is recorded.
+	key := util.HashPB(input, nil)
+	if _, ok := c.seen[key]; ok {
+		return
+	}
+
+	c.seen[key] = struct{}{}
+	c.changes = append(c.changes, change)
+}
+
+// outputValues returns the outputs sorted by the rule that produced them.
+func outputValues(output *enginev1.CheckOutput) []*enginev1.OutputEntry {
+	entries := slices.Clone(output.GetOutputs())
+	slices.SortStableFunc(entries, func(a, b *enginev1.OutputEntry) int {
+		return cmp.Compare(a.GetSrc(), b.GetSrc())
+	})
+
+	return entries
+}
+
+func outputEntriesEqual(a, b *enginev1.OutputEntry) bool {
+	return a.GetSrc() == b.GetSrc() && proto.Equal(a.GetVal(), b.GetVal())
+}
+
+func asMap(entries []*enginev1.OutputEntry) map[string]any {
+	res := make(map[string]any, len(entries))
+	for _, e := range entries {
+		res[e.GetSrc()] = e.GetVal().AsInterface()
+	}
+
+	return res
+}
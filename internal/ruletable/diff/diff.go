@@ -0,0 +1,309 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff compares two rule tables to find the permissions that differ between two sets of policies.
+package diff
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+const (
+	TargetKindResource  = "resource"
+	TargetKindPrincipal = "principal"
+)
+
+// Report lists the differences between the old and new policies.
+type Report struct {
+	Actions          []ActionChange           `json:"actions,omitempty"`
+	Conditions       []ConditionChange        `json:"conditions,omitempty"`
+	ScopePermissions []ScopePermissionsChange `json:"scopePermissions,omitempty"`
+	Decisions        []DecisionChange         `json:"decisions,omitempty"`
+}
+
+// Empty returns true if there are no differences.
+func (r *Report) Empty() bool {
+	return len(r.Actions) == 0 && len(r.Conditions) == 0 && len(r.ScopePermissions) == 0 && len(r.Decisions) == 0
+}
+
+// Target identifies the resource or principal that a rule applies to.
+// Rules from role policies apply to resources, so they are compared with the rules of resource policies in the same scope.
+type Target struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Scope   string `json:"scope,omitempty"`
+}
+
+func (t Target) String() string {
+	s := fmt.Sprintf("%s.%s.v%s", t.Kind, t.Name, t.Version)
+	if t.Scope != "" {
+		s += "/" + t.Scope
+	}
+
+	return s
+}
+
+func (t Target) compare(other Target) int {
+	return cmp.Or(
+		cmp.Compare(t.Kind, other.Kind),
+		cmp.Compare(t.Name, other.Name),
+		cmp.Compare(t.Version, other.Version),
+		cmp.Compare(t.Scope, other.Scope),
+	)
+}
+
+// ActionChange lists the actions that a role gained or lost with the given effect.
+// The role is the derived role if the rules are defined for a derived role.
+type ActionChange struct {
+	Target  Target   `json:"target"`
+	Role    string   `json:"role"`
+	Effect  string   `json:"effect"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// ConditionChange is a rule that exists in both sets of policies with a different condition.
+type ConditionChange struct {
+	Target Target `json:"target"`
+	Rule   string `json:"rule"`
+	Role   string `json:"role"`
+	Old    string `json:"old"`
+	New    string `json:"new"`
+}
+
+// ScopePermissionsChange is a policy whose scope permissions changed.
+// Resource and role policies in the same scope can have different scope permissions, so changes are reported for each policy.
+type ScopePermissionsChange struct {
+	Policy string `json:"policy"`
+	Old    string `json:"old"`
+	New    string `json:"new"`
+}
+
+type roleEffectKey struct {
+	target Target
+	role   string
+	effect effectv1.Effect
+}
+
+type ruleKey struct {
+	target Target
+	rule   string
+	role   string
+}
+
+type summary struct {
+	actions map[roleEffectKey]map[string]struct{}
+	// conditions are sets because unnamed rules with the same role and actions have the same key.
+	conditions map[ruleKey]map[string]struct{}
+	// scopePermissions are keyed by the policy that the rows originate from.
+	scopePermissions map[string]policyv1.ScopePermissions
+}
+
+// Compare compares the rules of the old and new rule tables.
+func Compare(oldRT, newRT *runtimev1.RuleTable) *Report {
+	oldSum, newSum := summarise(oldRT), summarise(newRT)
+	report := &Report{}
+
+	for _, key := range slices.SortedFunc(keysOf(oldSum.actions, newSum.actions), compareRoleEffectKeys) {
+		oldActions, newActions := oldSum.actions[key], newSum.actions[key]
+		added, removed := difference(newActions, oldActions), difference(oldActions, newActions)
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		report.Actions = append(report.Actions, ActionChange{
+			Target:  key.target,
+			Role:    key.role,
+			Effect:  key.effect.String(),
+			Added:   added,
+			Removed: removed,
+		})
+	}
+
+	for _, key := range slices.SortedFunc(maps.Keys(oldSum.conditions), compareRuleKeys) {
+		newConds, ok := newSum.conditions[key]
+		if !ok {
+			continue
+		}
+
+		oldCond, newCond := joinConditions(oldSum.conditions[key]), joinConditions(newConds)
+		if oldCond == newCond {
+			continue
+		}
+
+		report.Conditions = append(report.Conditions, ConditionChange{Target: key.target, Rule: key.rule, Role: key.role, Old: oldCond, New: newCond})
+	}
+
+	for _, fqn := range slices.Sorted(maps.Keys(oldSum.scopePermissions)) {
+		oldPerms := oldSum.scopePermissions[fqn]
+		newPerms, ok := newSum.scopePermissions[fqn]
+		if !ok || oldPerms == newPerms {
+			continue
+		}
+
+		report.ScopePermissions = append(report.ScopePermissions, ScopePermissionsChange{Policy: fqn, Old: oldPerms.String(), New: newPerms.String()})
+	}
+
+	return report
+}
+
+func summarise(rt *runtimev1.RuleTable) summary {
+	s := summary{
+		actions:          make(map[roleEffectKey]map[string]struct{}),
+		conditions:       make(map[ruleKey]map[string]struct{}),
+		scopePermissions: make(map[string]policyv1.ScopePermissions),
+	}
+
+	for _, row := range rt.GetRules() {
+		target := targetOf(row)
+		role := row.Role
+		if row.OriginDerivedRole != "" {
+			role = row.OriginDerivedRole
+		}
+
+		key := roleEffectKey{target: target, role: role, effect: row.Effect}
+		actions, ok := s.actions[key]
+		if !ok {
+			actions = make(map[string]struct{})
+			s.actions[key] = actions
+		}
+
+		rowActions := []string{row.GetAction()}
+		if aa := row.GetAllowActions(); aa != nil {
+			rowActions = slices.Sorted(maps.Keys(aa.Actions))
+		}
+
+		for _, a := range rowActions {
+			actions[a] = struct{}{}
+		}
+
+		// The rules of role policies are not named, so they are identified by their actions instead.
+		rule := row.Name
+		if rule == "" {
+			rule = fmt.Sprintf("[%s]", strings.Join(rowActions, ", "))
+		}
+		rk := ruleKey{target: target, rule: rule, role: role}
+		conds, ok := s.conditions[rk]
+		if !ok {
+			conds = make(map[string]struct{})
+			s.conditions[rk] = conds
+		}
+		conds[describeCondition(row)] = struct{}{}
+
+		s.scopePermissions[namer.PolicyKeyFromFQN(row.OriginFqn)] = row.ScopePermissions
+	}
+
+	return s
+}
+
+func targetOf(row *runtimev1.RuleTable_RuleRow) Target {
+	if row.PolicyKind == policyv1.Kind_KIND_PRINCIPAL {
+		return Target{Kind: TargetKindPrincipal, Name: row.Principal, Version: row.Version, Scope: row.Scope}
+	}
+
+	return Target{Kind: TargetKindResource, Name: row.Resource, Version: row.Version, Scope: row.Scope}
+}
+
+// describeCondition renders the conditions of the rule and its derived role, and the variables that they can use.
+func describeCondition(row *runtimev1.RuleTable_RuleRow) string {
+	var parts []string
+	if row.DerivedRoleCondition != nil {
+		parts = append(parts, fmt.Sprintf("derived role: %s", renderCondition(row.DerivedRoleCondition)))
+	}
+
+	if row.Condition != nil {
+		parts = append(parts, renderCondition(row.Condition))
+	}
+
+	for _, params := range []*runtimev1.RuleTable_RuleRow_Params{row.DerivedRoleParams, row.Params} {
+		for _, v := range params.GetOrderedVariables() {
+			parts = append(parts, fmt.Sprintf("V.%s = %s", v.Name, v.GetExpr().GetOriginal()))
+		}
+	}
+
+	if len(parts) == 0 {
+		return "true"
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func renderCondition(cond *runtimev1.Condition) string {
+	renderList := func(op string, list *runtimev1.Condition_ExprList) string {
+		exprs := make([]string, len(list.GetExpr()))
+		for i, c := range list.GetExpr() {
+			exprs[i] = renderCondition(c)
+		}
+
+		return fmt.Sprintf("%s(%s)", op, strings.Join(exprs, ", "))
+	}
+
+	switch op := cond.GetOp().(type) {
+	case *runtimev1.Condition_All:
+		return renderList("all", op.All)
+	case *runtimev1.Condition_Any:
+		return renderList("any", op.Any)
+	case *runtimev1.Condition_None:
+		return renderList("none", op.None)
+	case *runtimev1.Condition_Expr:
+		return op.Expr.GetOriginal()
+	default:
+		return "true"
+	}
+}
+
+func keysOf[K comparable, V any](a, b map[K]V) func(func(K) bool) {
+	return func(yield func(K) bool) {
+		for k := range a {
+			if !yield(k) {
+				return
+			}
+		}
+
+		for k := range b {
+			if _, ok := a[k]; ok {
+				continue
+			}
+
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// joinConditions renders the set of conditions of rules that have the same key in a stable order.
+func joinConditions(conds map[string]struct{}) string {
+	return strings.Join(slices.Sorted(maps.Keys(conds)), " | ")
+}
+
+// difference returns the sorted elements of a that are not in b.
+func difference(a, b map[string]struct{}) []string {
+	var res []string
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			res = append(res, k)
+		}
+	}
+
+	slices.Sort(res)
+	return res
+}
+
+func compareRoleEffectKeys(a, b roleEffectKey) int {
+	return cmp.Or(a.target.compare(b.target), cmp.Compare(a.role, b.role), cmp.Compare(a.effect, b.effect))
+}
+
+func compareRuleKeys(a, b ruleKey) int {
+	return cmp.Or(a.target.compare(b.target), cmp.Compare(a.rule, b.rule), cmp.Compare(a.role, b.role))
+}
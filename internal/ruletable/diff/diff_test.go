@@ -0,0 +1,222 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rogpeppe/go-internal/txtar"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/ruletable/diff"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+const oldPolicies = `
+-- document.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  rules:
+    - name: view
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+      condition:
+        match:
+          expr: R.attr.public
+    - name: manage
+      actions: ["edit", "archive"]
+      effect: EFFECT_ALLOW
+      roles: ["admin"]
+-- document_acme.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  scope: acme
+  scopePermissions: SCOPE_PERMISSIONS_OVERRIDE_PARENT
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+`
+
+const newPolicies = `
+-- document.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  rules:
+    - name: view
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+      condition:
+        match:
+          expr: R.attr.public || R.attr.owner == P.id
+    - name: manage
+      actions: ["edit", "delete"]
+      effect: EFFECT_ALLOW
+      roles: ["admin"]
+-- document_acme.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  scope: acme
+  scopePermissions: SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS
+  rules:
+    - actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+-- document_test.yaml --
+name: DocumentTestSuite
+principals:
+  alice:
+    id: alice
+    roles: ["user"]
+resources:
+  private:
+    id: private
+    kind: document
+    attr:
+      owner: alice
+      public: false
+  public:
+    id: public
+    kind: document
+    attr:
+      owner: bob
+      public: true
+tests:
+  - name: View
+    input:
+      principals: ["alice"]
+      resources: ["private", "public"]
+      actions: ["view"]
+    expected:
+      - principal: alice
+        resource: private
+        actions:
+          view: EFFECT_ALLOW
+      - principal: alice
+        resource: public
+        actions:
+          view: EFFECT_ALLOW
+`
+
+const decisionLog = `{"callId":"01","timestamp":"2025-01-01T00:00:00Z","checkResources":{"inputs":[{"requestId":"1","resource":{"kind":"document","id":"report","attr":{}},"principal":{"id":"bob","roles":["admin"]},"actions":["archive","delete","edit"]}]},"peer":{"address":"10.0.0.1"},"logKind":"decision"}
+
+{"callId":"02","timestamp":"2025-01-01T00:00:01Z","planResources":{"input":{"requestId":"2","action":"view","principal":{"id":"bob","roles":["admin"]},"resource":{"kind":"document"}}}}
+`
+
+func TestCompare(t *testing.T) {
+	oldRT, oldEng := mkPolicySet(t, oldPolicies)
+	newRT, newEng := mkPolicySet(t, newPolicies)
+
+	document := diff.Target{Kind: diff.TargetKindResource, Name: "document", Version: "default"}
+
+	t.Run("rule_table", func(t *testing.T) {
+		report := diff.Compare(oldRT, newRT)
+
+		require.Equal(t, []diff.ActionChange{
+			{Target: document, Role: "admin", Effect: "EFFECT_ALLOW", Added: []string{"delete"}, Removed: []string{"archive"}},
+		}, report.Actions)
+		require.Equal(t, []diff.ConditionChange{
+			{Target: document, Rule: "view", Role: "user", Old: "R.attr.public", New: "R.attr.public || R.attr.owner == P.id"},
+		}, report.Conditions)
+		require.Equal(t, []diff.ScopePermissionsChange{
+			{Policy: "resource.document.vdefault/acme", Old: "SCOPE_PERMISSIONS_OVERRIDE_PARENT", New: "SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS"},
+		}, report.ScopePermissions)
+		require.Empty(t, report.Decisions)
+	})
+
+	t.Run("identical", func(t *testing.T) {
+		require.True(t, diff.Compare(newRT, newRT).Empty())
+	})
+
+	t.Run("decisions", func(t *testing.T) {
+		comparer := diff.NewComparer(oldEng, newEng)
+
+		archive := txtar.Parse([]byte(newPolicies))
+		dir := t.TempDir()
+		require.NoError(t, txtar.Write(archive, dir))
+		require.NoError(t, comparer.CompareTests(t.Context(), os.DirFS(dir)))
+		require.NoError(t, comparer.CompareDecisionLog(t.Context(), "audit.log", strings.NewReader(decisionLog)))
+
+		require.Equal(t, []diff.DecisionChange{
+			{
+				Effects:   map[string]diff.EffectChange{"view": {Old: "EFFECT_DENY", New: "EFFECT_ALLOW"}},
+				Source:    "tests",
+				Principal: "alice",
+				Resource:  "document:private",
+			},
+			{
+				Effects: map[string]diff.EffectChange{
+					"archive": {Old: "EFFECT_ALLOW", New: "EFFECT_DENY"},
+					"delete":  {Old: "EFFECT_DENY", New: "EFFECT_ALLOW"},
+				},
+				Source:    "audit.log:1 (call 01)",
+				Principal: "bob",
+				Resource:  "document:report",
+			},
+		}, comparer.Changes())
+	})
+}
+
+func TestCompareStoreWithItself(t *testing.T) {
+	dir := test.PathToDir(t, "store")
+	rt, _ := mkPolicySetFromDir(t, dir)
+
+	// The order of the rows differs between rule tables built from the same policies, so compare several of them.
+	for range 5 {
+		other, _ := mkPolicySetFromDir(t, dir)
+		report := diff.Compare(rt, other)
+		require.True(t, report.Empty(), "Unexpected differences: %+v", report)
+	}
+}
+
+func mkPolicySet(t *testing.T, policies string) (*runtimev1.RuleTable, *engine.Engine) {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, txtar.Write(txtar.Parse([]byte(policies)), dir))
+
+	return mkPolicySetFromDir(t, dir)
+}
+
+func mkPolicySetFromDir(t *testing.T, dir string) (*runtimev1.RuleTable, *engine.Engine) {
+	t.Helper()
+
+	ctx := t.Context()
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	mgr, err := compile.NewManager(ctx, store)
+	require.NoError(t, err)
+
+	rt := ruletable.NewProtoRuletable()
+	require.NoError(t, ruletable.LoadPolicies(ctx, rt, mgr))
+
+	ruleTable := proto.CloneOf(rt)
+
+	schemaMgr := schema.NewNopManager()
+	rtMgr, err := ruletable.NewRuleTableManager(rt, mgr, store, schemaMgr)
+	require.NoError(t, err)
+
+	return ruleTable, engine.NewEphemeral(nil, rtMgr, schemaMgr)
+}
@@ -0,0 +1,284 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletable
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/engine/tracer"
+)
+
+var _ tracer.ExplanationSink = (*Explainer)(nil)
+
+// Explainer is a trace sink that builds an explanation of the decisions made for a single check input.
+type Explainer struct {
+	actions    map[string]*actionExplanation
+	rules      map[*enginev1.Trace_Component]*enginev1.Explanation_Rule
+	conditions map[*enginev1.Trace_Component]*enginev1.Explanation_Condition
+	exprs      map[*enginev1.Trace_Component]*enginev1.Explanation_Expr
+	mu         sync.Mutex
+}
+
+type actionExplanation struct {
+	seen          map[ruleKey]*enginev1.Explanation_Rule
+	consentScopes map[string]struct{}
+	scopes        map[string]int
+	rules         []*enginev1.Explanation_Rule
+}
+
+type ruleKey struct {
+	policy string
+	scope  string
+	name   string
+}
+
+func NewExplainer() *Explainer {
+	return &Explainer{
+		actions:    make(map[string]*actionExplanation),
+		rules:      make(map[*enginev1.Trace_Component]*enginev1.Explanation_Rule),
+		conditions: make(map[*enginev1.Trace_Component]*enginev1.Explanation_Condition),
+		exprs:      make(map[*enginev1.Trace_Component]*enginev1.Explanation_Expr),
+	}
+}
+
+func (*Explainer) Enabled() bool {
+	return true
+}
+
+func (*Explainer) Explain() bool {
+	return true
+}
+
+// AddTrace records the traces produced for actions. The traces have the following structure:
+//
+//	policy > action > scope > policy > rule [> derived role | condition...]
+func (e *Explainer) AddTrace(trace *enginev1.Trace) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	components := trace.Components
+	if len(components) < 3 ||
+		components[0].Kind != enginev1.Trace_Component_KIND_POLICY ||
+		components[1].Kind != enginev1.Trace_Component_KIND_ACTION ||
+		components[2].Kind != enginev1.Trace_Component_KIND_SCOPE {
+		return
+	}
+
+	action := e.action(components[1].GetAction())
+	scope := components[2].GetScope()
+	if _, ok := action.scopes[scope]; !ok {
+		action.scopes[scope] = len(action.scopes)
+	}
+
+	if len(components) == 3 {
+		if trace.Event.GetMessage() == requiresParentalConsent {
+			action.consentScopes[scope] = struct{}{}
+		}
+		return
+	}
+
+	if len(components) < 5 ||
+		components[3].Kind != enginev1.Trace_Component_KIND_POLICY ||
+		components[4].Kind != enginev1.Trace_Component_KIND_RULE {
+		return
+	}
+
+	rule, ok := e.rules[components[4]]
+	if !ok {
+		key := ruleKey{policy: components[3].GetPolicy(), scope: scope, name: components[4].GetRule()}
+		rule = &enginev1.Explanation_Rule{Policy: key.policy, Scope: key.scope, Name: key.name}
+		// Rules are evaluated again for each of the principal's roles. Later evaluations are only recorded if they activate the rule.
+		if _, seen := action.seen[key]; !seen {
+			action.seen[key] = rule
+			action.rules = append(action.rules, rule)
+		}
+		e.rules[components[4]] = rule
+	}
+
+	switch rest := components[5:]; {
+	case len(rest) == 0:
+		addRuleEvent(rule, trace.Event)
+		action.replaceIfActivated(rule)
+	case rest[0].Kind == enginev1.Trace_Component_KIND_DERIVED_ROLE:
+		rule.DerivedRole = rest[0].GetDerivedRole()
+	case rest[0].Kind == enginev1.Trace_Component_KIND_CONDITION && len(rest) > 1:
+		e.addConditionEvent(rule, rest[1:], trace.Event)
+	}
+}
+
+func (e *Explainer) action(name string) *actionExplanation {
+	action, ok := e.actions[name]
+	if !ok {
+		action = &actionExplanation{
+			seen:          make(map[ruleKey]*enginev1.Explanation_Rule),
+			consentScopes: make(map[string]struct{}),
+			scopes:        make(map[string]int),
+		}
+		e.actions[name] = action
+	}
+
+	return action
+}
+
+// replaceIfActivated replaces the recorded evaluation of the rule with the given one if only the given one activated the rule.
+func (a *actionExplanation) replaceIfActivated(rule *enginev1.Explanation_Rule) {
+	key := ruleKey{policy: rule.Policy, scope: rule.Scope, name: rule.Name}
+	recorded := a.seen[key]
+	if recorded == rule || !isActivated(rule) || isActivated(recorded) {
+		return
+	}
+
+	if i := slices.Index(a.rules, recorded); i >= 0 {
+		a.rules[i] = rule
+		a.seen[key] = rule
+	}
+}
+
+func isActivated(rule *enginev1.Explanation_Rule) bool {
+	return rule.Outcome == enginev1.Explanation_Rule_OUTCOME_ACTIVATED ||
+		rule.Outcome == enginev1.Explanation_Rule_OUTCOME_SCOPE_PERMISSIONS_BLOCKED
+}
+
+func addRuleEvent(rule *enginev1.Explanation_Rule, event *enginev1.Trace_Event) {
+	switch event.GetMessage() {
+	case ruleActivated:
+		rule.Outcome = enginev1.Explanation_Rule_OUTCOME_ACTIVATED
+		rule.Effect = event.Effect
+	case scopePermissionsBlocked:
+		rule.Outcome = enginev1.Explanation_Rule_OUTCOME_SCOPE_PERMISSIONS_BLOCKED
+		rule.Effect = event.Effect
+	case noMatchingRoles:
+		rule.Outcome = enginev1.Explanation_Rule_OUTCOME_ROLE_MISMATCH
+	case noMatchingDerivedRoles:
+		rule.Outcome = enginev1.Explanation_Rule_OUTCOME_DERIVED_ROLE_NOT_ACTIVATED
+	case conditionNotSatisfied:
+		if rule.Outcome == enginev1.Explanation_Rule_OUTCOME_UNSPECIFIED {
+			rule.Outcome = enginev1.Explanation_Rule_OUTCOME_CONDITION_NOT_MET
+		}
+	default:
+		if event.GetError() != "" {
+			rule.Outcome = enginev1.Explanation_Rule_OUTCOME_CONDITION_ERROR
+			rule.Error = event.Error
+		}
+	}
+}
+
+// addConditionEvent adds the event to the condition tree of the rule, creating the nodes along the path if necessary.
+func (e *Explainer) addConditionEvent(rule *enginev1.Explanation_Rule, path []*enginev1.Trace_Component, event *enginev1.Trace_Event) {
+	attach := func(c *enginev1.Explanation_Condition) { rule.Condition = c }
+	var parentExpr *enginev1.Explanation_Expr
+
+	for _, component := range path {
+		switch component.Kind { //nolint:exhaustive
+		case enginev1.Trace_Component_KIND_CONDITION_ALL:
+			attach = e.conditionNode(component, enginev1.Explanation_Condition_OP_ALL, attach)
+		case enginev1.Trace_Component_KIND_CONDITION_ANY:
+			attach = e.conditionNode(component, enginev1.Explanation_Condition_OP_ANY, attach)
+		case enginev1.Trace_Component_KIND_CONDITION_NONE:
+			attach = e.conditionNode(component, enginev1.Explanation_Condition_OP_NONE, attach)
+		case enginev1.Trace_Component_KIND_EXPR:
+			if parentExpr == nil {
+				e.conditionNode(component, enginev1.Explanation_Condition_OP_EXPR, attach)
+				parentExpr = e.conditions[component].Expr
+				continue
+			}
+
+			expr, ok := e.exprs[component]
+			if !ok {
+				expr = &enginev1.Explanation_Expr{Expr: component.GetExpr()}
+				parentExpr.Operands = append(parentExpr.Operands, expr)
+				e.exprs[component] = expr
+			}
+			parentExpr = expr
+		}
+	}
+
+	last := path[len(path)-1]
+	if condition, ok := e.conditions[last]; ok {
+		condition.Satisfied = event.GetResult().GetBoolValue()
+		condition.Error = event.GetError()
+		if condition.Expr != nil {
+			if condition.Expr.Error = event.GetError(); condition.Expr.Error == "" {
+				condition.Expr.Value = event.GetResult()
+			}
+		}
+		return
+	}
+
+	if expr, ok := e.exprs[last]; ok && event.GetStatus() != enginev1.Trace_Event_STATUS_SKIPPED {
+		expr.Value = event.GetResult()
+		expr.Error = event.GetError()
+	}
+}
+
+// conditionNode returns a function for attaching children to the condition node of the component, creating the node if necessary.
+func (e *Explainer) conditionNode(component *enginev1.Trace_Component, op enginev1.Explanation_Condition_Op, attach func(*enginev1.Explanation_Condition)) func(*enginev1.Explanation_Condition) {
+	condition, ok := e.conditions[component]
+	if !ok {
+		condition = &enginev1.Explanation_Condition{Op: op}
+		if op == enginev1.Explanation_Condition_OP_EXPR {
+			condition.Expr = &enginev1.Explanation_Expr{Expr: component.GetExpr()}
+		}
+		attach(condition)
+		e.conditions[component] = condition
+	}
+
+	return func(c *enginev1.Explanation_Condition) { condition.Conditions = append(condition.Conditions, c) }
+}
+
+// Explanation returns the explanation of the decisions in the output.
+func (e *Explainer) Explanation(output *enginev1.CheckOutput) *enginev1.Explanation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	explanation := &enginev1.Explanation{Actions: make(map[string]*enginev1.Explanation_Action, len(output.GetActions()))}
+	for name, ae := range output.GetActions() {
+		action := &enginev1.Explanation_Action{
+			Effect: ae.Effect,
+			Policy: ae.Policy,
+			Scope:  ae.Scope,
+		}
+		explanation.Actions[name] = action
+
+		a, ok := e.actions[name]
+		if !ok {
+			continue
+		}
+
+		// Rows are not retrieved in a stable order, so sort the rules by scope evaluation order and then by name
+		slices.SortStableFunc(a.rules, func(x, y *enginev1.Explanation_Rule) int {
+			return cmp.Or(
+				cmp.Compare(a.scopes[x.Scope], a.scopes[y.Scope]),
+				cmp.Compare(x.Policy, y.Policy),
+				cmp.Compare(x.Name, y.Name),
+			)
+		})
+
+		action.Rules = a.rules
+		var decidingRule *enginev1.Explanation_Rule
+		for _, rule := range a.rules {
+			if _, ok := a.consentScopes[rule.Scope]; ok &&
+				ae.Effect != effectv1.Effect_EFFECT_ALLOW &&
+				rule.Outcome == enginev1.Explanation_Rule_OUTCOME_ACTIVATED &&
+				rule.Effect == effectv1.Effect_EFFECT_ALLOW {
+				rule.Outcome = enginev1.Explanation_Rule_OUTCOME_SCOPE_PERMISSIONS_BLOCKED
+			}
+
+			if decidingRule == nil && rule.Scope == ae.Scope && rule.Effect == ae.Effect && isActivated(rule) {
+				decidingRule = rule
+			}
+		}
+
+		if decidingRule != nil {
+			action.Policy = decidingRule.Policy
+			action.Rule = decidingRule.Name
+		}
+	}
+
+	return explanation
+}
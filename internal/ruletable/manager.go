@@ -8,6 +8,8 @@ package ruletable
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
@@ -21,6 +23,7 @@ import (
 	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/util"
 )
 
 type Manager struct {
@@ -28,7 +31,48 @@ type Manager struct {
 	policyLoader policyloader.PolicyLoader
 	schemaLoader schema.Loader
 	log          *logging.Logger
+	listeners    []ChangeListener
 	mu           sync.RWMutex
+	listenersMu  sync.RWMutex
+}
+
+// ChangeListener is notified after the rule table has been modified in response to a storage event.
+type ChangeListener func(Change)
+
+// Change describes the parts of the rule table affected by a modification.
+type Change struct {
+	// Resources are the sanitized resource kinds whose policies were added, updated or deleted.
+	Resources []string
+	// Principals are the principal IDs whose policies were added, updated or deleted.
+	Principals []string
+	// All is set when the modification could affect any decision (e.g. reloads and role policy changes).
+	All bool
+}
+
+func (c *Change) addMeta(meta *runtimev1.RuleTableMetadata) {
+	switch n := meta.GetName().(type) {
+	case *runtimev1.RuleTableMetadata_Resource:
+		c.Resources = append(c.Resources, n.Resource)
+	case *runtimev1.RuleTableMetadata_Principal:
+		c.Principals = append(c.Principals, n.Principal)
+	default:
+		c.All = true
+	}
+}
+
+func (c *Change) addPolicySet(rps *runtimev1.RunnablePolicySet) {
+	switch ps := rps.GetPolicySet().(type) {
+	case *runtimev1.RunnablePolicySet_ResourcePolicy:
+		c.Resources = append(c.Resources, namer.SanitizedResource(ps.ResourcePolicy.GetMeta().GetResource()))
+	case *runtimev1.RunnablePolicySet_PrincipalPolicy:
+		c.Principals = append(c.Principals, ps.PrincipalPolicy.GetMeta().GetPrincipal())
+	case *runtimev1.RunnablePolicySet_RolePolicy:
+		c.All = true
+	}
+}
+
+func (c Change) isEmpty() bool {
+	return !c.All && len(c.Resources) == 0 && len(c.Principals) == 0
 }
 
 func NewRuleTableManager(protoRT *runtimev1.RuleTable, policyLoader policyloader.PolicyLoader, schemaLoader schema.Loader, schemaMgr schema.Manager) (*Manager, error) {
@@ -68,6 +112,34 @@ func (mgr *Manager) Plan(ctx context.Context, input *enginev1.PlanResourcesInput
 	return mgr.planWithAuditTrail(ctx, input, principalVersion, resourceVersion, nowFunc, globals)
 }
 
+func (mgr *Manager) PlanPrincipals(ctx context.Context, input *enginev1.PlanPrincipalsInput, principalVersion, resourceVersion string, nowFunc conditions.NowFunc, globals map[string]any) (*enginev1.PlanPrincipalsOutput, error) {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
+	return mgr.RuleTable.PlanPrincipals(ctx, input, principalVersion, resourceVersion, nowFunc, globals)
+}
+
+// AddChangeListener registers a listener to be notified whenever the rule table is modified by a storage event.
+func (mgr *Manager) AddChangeListener(listener ChangeListener) {
+	mgr.listenersMu.Lock()
+	defer mgr.listenersMu.Unlock()
+
+	mgr.listeners = append(mgr.listeners, listener)
+}
+
+func (mgr *Manager) notifyListeners(change Change) {
+	if change.isEmpty() {
+		return
+	}
+
+	mgr.listenersMu.RLock()
+	defer mgr.listenersMu.RUnlock()
+
+	for _, listener := range mgr.listeners {
+		listener(change)
+	}
+}
+
 func (mgr *Manager) SubscriberID() string {
 	return "engine.RuleTable"
 }
@@ -78,12 +150,21 @@ func (mgr *Manager) OnStorageEvent(events ...storage.Event) {
 		case storage.EventReload:
 			if err := mgr.reload(); err != nil {
 				mgr.log.Warnw("Error reloading rule table, maintaining last valid state", "error", err)
+				continue
 			}
+			mgr.notifyListeners(Change{All: true})
 		case storage.EventAddOrUpdatePolicy, storage.EventDeleteOrDisablePolicy:
 			mgr.log.Debugw("Processing storage event", "event", event)
-			if err := mgr.processPolicyEvent(event); err != nil {
+			change, err := mgr.processPolicyEvent(event)
+			if err != nil {
 				mgr.log.Warnw("Error processing storage event, maintaining last valid state", "event", event, "error", err)
+				// the rule table could have been partially modified before the error occurred
+				change = Change{All: true}
 			}
+			mgr.notifyListeners(change)
+		case storage.EventAddOrUpdateSchema, storage.EventDeleteSchema:
+			// schema changes don't modify the rule table but they do affect the outcome of input validation
+			mgr.notifyListeners(Change{All: true})
 		default:
 			mgr.log.Debugw("Ignoring storage event", "event", event)
 		}
@@ -115,7 +196,7 @@ func (mgr *Manager) reload() error {
 	return nil
 }
 
-func (mgr *Manager) processPolicyEvent(evt storage.Event) (err error) {
+func (mgr *Manager) processPolicyEvent(evt storage.Event) (change Change, err error) {
 	ctx, cancelFunc := context.WithTimeout(context.Background(), mgr.conf.PolicyLoaderTimeout)
 	defer cancelFunc()
 
@@ -124,51 +205,53 @@ func (mgr *Manager) processPolicyEvent(evt storage.Event) (err error) {
 		var rps *runtimev1.RunnablePolicySet
 		rps, err = mgr.policyLoader.GetFirstMatch(ctx, []namer.ModuleID{evt.PolicyID})
 		if err != nil {
-			return fmt.Errorf("failed to load policy: %w", err)
+			return change, fmt.Errorf("failed to load policy: %w", err)
 		}
 
 		// Only delete if we successfully retrieved the policy above (e.g. no compilation errors occurred)
-		mgr.deletePolicy(evt.PolicyID)
+		mgr.deletePolicy(evt.PolicyID, &change)
 		if evt.OldPolicyID != nil {
-			mgr.deletePolicy(*evt.OldPolicyID)
+			mgr.deletePolicy(*evt.OldPolicyID, &change)
 		}
 
 		if rps != nil {
-			if err = mgr.addPolicy(rps); err != nil {
-				return err
+			if err = mgr.addPolicy(rps, &change); err != nil {
+				return change, err
 			}
 		}
 	case storage.EventDeleteOrDisablePolicy:
-		mgr.deletePolicy(evt.PolicyID)
+		mgr.deletePolicy(evt.PolicyID, &change)
 	}
 
 	if len(evt.Dependents) > 0 {
 		// handle reloading dependents atomically
 		toReload, err := mgr.policyLoader.GetAllMatching(ctx, evt.Dependents)
 		if err != nil {
-			return fmt.Errorf("failed to load dependent policies: %w", err)
+			return change, fmt.Errorf("failed to load dependent policies: %w", err)
 		}
 
 		// we leave ruletable state static until we're sure all dependents are valid, and then update
 		for _, modID := range evt.Dependents {
-			mgr.deletePolicy(modID)
+			mgr.deletePolicy(modID, &change)
 		}
 
 		for _, rps := range toReload {
-			if err = mgr.addPolicy(rps); err != nil {
-				return err
+			if err = mgr.addPolicy(rps, &change); err != nil {
+				return change, err
 			}
 		}
 	}
 
-	return nil
+	return change, nil
 }
 
-func (mgr *Manager) addPolicy(rps *runtimev1.RunnablePolicySet) error {
+func (mgr *Manager) addPolicy(rps *runtimev1.RunnablePolicySet, change *Change) error {
 	if rps == nil {
 		return nil
 	}
 
+	change.addPolicySet(rps)
+
 	mgr.mu.Lock()
 	defer mgr.mu.Unlock()
 
@@ -179,10 +262,104 @@ func (mgr *Manager) addPolicy(rps *runtimev1.RunnablePolicySet) error {
 	return nil
 }
 
-func (mgr *Manager) deletePolicy(moduleID namer.ModuleID) {
+// WithPolicies returns a manager for a copy of the rule table in which the policy sets replace the policies with the same module IDs.
+// Only the index entries of the scopes that the policy sets affect are copied, and the live rule table is not modified.
+func (mgr *Manager) WithPolicies(policySets []*runtimev1.RunnablePolicySet) (*Manager, error) {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
+	scopes := make(map[string]struct{}, len(policySets))
+	for _, rps := range policySets {
+		scopes[namer.ScopeFromFQN(rps.Fqn)] = struct{}{}
+	}
+
+	overlay := &Manager{
+		log:       mgr.log,
+		RuleTable: mgr.cloneScopes(scopes),
+	}
+
+	for _, rps := range policySets {
+		overlay.doDeletePolicy(namer.GenModuleIDFromFQN(rps.Fqn))
+	}
+
+	for _, rps := range policySets {
+		if err := overlay.indexRules(AddPolicy(overlay.RuleTable.RuleTable, rps)); err != nil {
+			return nil, fmt.Errorf("failed to index rules of %s: %w", namer.PolicyKeyFromFQN(rps.Fqn), err)
+		}
+	}
+
+	for scope := range scopes {
+		overlay.precompileScopeParentRoles(scope)
+	}
+
+	return overlay, nil
+}
+
+// cloneScopes returns a copy of the rule table that can be modified in the given scopes without affecting the original.
+// The index entries of the other scopes are shared with the original. The caller must obtain a lock first.
+func (rt *RuleTable) cloneScopes(scopes map[string]struct{}) *RuleTable {
+	clone := &RuleTable{
+		RuleTable: &runtimev1.RuleTable{
+			Schemas:            maps.Clone(rt.Schemas),
+			Meta:               maps.Clone(rt.Meta),
+			ScopeParentRoles:   maps.Clone(rt.ScopeParentRoles),
+			PolicyDerivedRoles: make(map[uint64]*runtimev1.RuleTable_PolicyDerivedRoles),
+			JsonSchemas:        rt.JsonSchemas,
+		},
+		conf:                  rt.conf,
+		schemaMgr:             rt.schemaMgr,
+		primaryIdx:            make(map[string]map[string]*util.GlobMap[*util.GlobMap[[]*Row]], len(rt.primaryIdx)),
+		principalScopeMap:     maps.Clone(rt.principalScopeMap),
+		resourceScopeMap:      maps.Clone(rt.resourceScopeMap),
+		scopeScopePermissions: maps.Clone(rt.scopeScopePermissions),
+		parentRoleAncestors:   maps.Clone(rt.parentRoleAncestors),
+		policyDerivedRoles:    maps.Clone(rt.policyDerivedRoles),
+		combiningAlgorithms:   maps.Clone(rt.combiningAlgorithms),
+		conditionCostLimits:   maps.Clone(rt.conditionCostLimits),
+	}
+
+	for version, scopeMap := range rt.primaryIdx {
+		clonedScopeMap := maps.Clone(scopeMap)
+		for scope := range scopes {
+			roleMap, ok := scopeMap[scope]
+			if !ok {
+				continue
+			}
+
+			clonedRoleMap := util.NewGlobMap(make(map[string]*util.GlobMap[[]*Row], roleMap.Len()))
+			for role, actionMap := range roleMap.GetAll() {
+				clonedActionMap := util.NewGlobMap(make(map[string][]*Row, actionMap.Len()))
+				for action, rows := range actionMap.GetAll() {
+					clonedActionMap.Set(action, slices.Clone(rows))
+				}
+				clonedRoleMap.Set(role, clonedActionMap)
+			}
+			clonedScopeMap[scope] = clonedRoleMap
+		}
+		clone.primaryIdx[version] = clonedScopeMap
+	}
+
+	for scope := range scopes {
+		if parentRoles, ok := rt.ScopeParentRoles[scope]; ok {
+			clone.ScopeParentRoles[scope] = &runtimev1.RuleTable_RoleParentRoles{RoleParentRoles: maps.Clone(parentRoles.GetRoleParentRoles())}
+		}
+
+		if ancestors, ok := rt.parentRoleAncestors[scope]; ok {
+			clone.parentRoleAncestors[scope] = maps.Clone(ancestors)
+		}
+	}
+
+	return clone
+}
+
+func (mgr *Manager) deletePolicy(moduleID namer.ModuleID, change *Change) {
 	mgr.mu.Lock()
 	defer mgr.mu.Unlock()
 
+	if meta := mgr.Meta[moduleID.RawValue()]; meta != nil {
+		change.addMeta(meta)
+	}
+
 	mgr.doDeletePolicy(moduleID)
 }
 
@@ -195,43 +372,48 @@ func (mgr *Manager) doDeletePolicy(moduleID namer.ModuleID) {
 
 	mgr.log.Debugf("Deleting policy %s", meta.GetFqn())
 
+	// the rows of a policy are always in the scope of the policy
+	scope := namer.ScopeFromFQN(meta.GetFqn())
 	for version, scopeMap := range mgr.primaryIdx {
-		for scope, roleMap := range scopeMap {
-			scopedParentRoleAncestors := mgr.parentRoleAncestors[scope]
+		roleMap, ok := scopeMap[scope]
+		if !ok {
+			continue
+		}
 
-			for role, actionMap := range roleMap.GetAll() {
-				for action, rules := range actionMap.GetAll() {
-					newRules := make([]*Row, 0, len(rules))
-					for _, r := range rules {
-						if r.OriginFqn != meta.GetFqn() {
-							newRules = append(newRules, r)
-						} else {
-							mgr.log.Debugf("Dropping rule %s", r.GetOriginFqn())
-						}
-					}
+		scopedParentRoleAncestors := mgr.parentRoleAncestors[scope]
 
-					if len(newRules) > 0 {
-						actionMap.Set(action, newRules)
+		for role, actionMap := range roleMap.GetAll() {
+			for action, rules := range actionMap.GetAll() {
+				newRules := make([]*Row, 0, len(rules))
+				for _, r := range rules {
+					if r.OriginFqn != meta.GetFqn() {
+						newRules = append(newRules, r)
 					} else {
-						actionMap.DeleteLiteral(action)
+						mgr.log.Debugf("Dropping rule %s", r.GetOriginFqn())
 					}
 				}
 
-				if actionMap.Len() == 0 {
-					roleMap.DeleteLiteral(role)
-					delete(scopedParentRoleAncestors, role)
+				if len(newRules) > 0 {
+					actionMap.Set(action, newRules)
+				} else {
+					actionMap.DeleteLiteral(action)
 				}
 			}
 
-			if roleMap.Len() == 0 {
-				delete(scopeMap, scope)
-				delete(mgr.principalScopeMap, scope)
-				delete(mgr.resourceScopeMap, scope)
-				delete(mgr.scopeScopePermissions, scope)
-				delete(mgr.parentRoleAncestors, scope)
+			if actionMap.Len() == 0 {
+				roleMap.DeleteLiteral(role)
+				delete(scopedParentRoleAncestors, role)
 			}
 		}
 
+		if roleMap.Len() == 0 {
+			delete(scopeMap, scope)
+			delete(mgr.principalScopeMap, scope)
+			delete(mgr.resourceScopeMap, scope)
+			delete(mgr.scopeScopePermissions, scope)
+			delete(mgr.parentRoleAncestors, scope)
+		}
+
 		if len(scopeMap) == 0 {
 			delete(mgr.primaryIdx, version)
 		}
@@ -240,4 +422,6 @@ func (mgr *Manager) doDeletePolicy(moduleID namer.ModuleID) {
 	delete(mgr.Schemas, moduleID.RawValue())
 	delete(mgr.Meta, moduleID.RawValue())
 	delete(mgr.policyDerivedRoles, moduleID)
+	delete(mgr.combiningAlgorithms, moduleID)
+	delete(mgr.conditionCostLimits, moduleID)
 }
@@ -0,0 +1,271 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletable
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+)
+
+// otherRoles stands for the roles that no rule refers to explicitly. It only matches the rows that apply to any role.
+const otherRoles = ""
+
+// planSubject is a role or a principal ID for which the rows are evaluated when planning principals.
+type planSubject struct {
+	// gate is the condition that a principal must satisfy for the rows of the subject to apply to it.
+	gate        *planner.QpN
+	principalID string
+	roles       []string
+}
+
+// PlanPrincipals produces a query plan with the conditions that a principal must satisfy to perform the actions on the resource.
+// It is the inverse of Plan: the resource is known and the residual conditions are over the attributes, roles and ID of the principal.
+func (rt *RuleTable) PlanPrincipals(ctx context.Context, input *enginev1.PlanPrincipalsInput, principalVersion, resourceVersion string, nowFunc conditions.NowFunc, globals map[string]any) (*enginev1.PlanPrincipalsOutput, error) {
+	fqn := namer.ResourcePolicyFQN(input.Resource.Kind, resourceVersion, input.Resource.Scope)
+
+	_, span := tracing.StartSpan(ctx, "engine.PlanPrincipals")
+	span.SetAttributes(tracing.PolicyFQN(fqn))
+	defer span.End()
+
+	principalScope := input.Principal.GetScope()
+	principalScopes, _, _ := rt.GetAllScopes(policy.PrincipalKind, principalScope, "", principalVersion)
+	resourceScopes, _, _ := rt.GetAllScopes(policy.ResourceKind, input.Resource.Scope, input.Resource.Kind, resourceVersion)
+	scopes := rt.CombineScopes(principalScopes, resourceScopes)
+
+	output := &enginev1.PlanPrincipalsOutput{
+		RequestId:     input.RequestId,
+		Actions:       input.Actions,
+		Kind:          input.Resource.Kind,
+		ResourceId:    input.Resource.Id,
+		PolicyVersion: input.Resource.PolicyVersion,
+		Scope:         input.Resource.Scope,
+	}
+
+	roles := rt.roleNames(resourceVersion, scopes)
+	allRoles := rt.GetParentRoles(input.Resource.Scope, append(slices.Clone(roles), otherRoles))
+	candidateRows := rt.GetRows(resourceVersion, namer.SanitizedResource(input.Resource.Kind), scopes, allRoles, input.Actions)
+	if len(candidateRows) == 0 {
+		output.Filter = &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED}
+		output.FilterDebug = noPolicyMatch
+		return output, nil
+	}
+
+	otherRolesNode, err := planner.MkPrincipalOtherRoleNode(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	roleSubjects := make([]planSubject, 0, len(roles)+1)
+	for _, role := range roles {
+		roleSubjects = append(roleSubjects, planSubject{
+			gate:  planner.MkPrincipalRoleNode(role),
+			roles: rt.GetParentRoles(input.Resource.Scope, []string{role}),
+		})
+	}
+	roleSubjects = append(roleSubjects, planSubject{gate: otherRolesNode, roles: []string{otherRoles}})
+
+	var principalSubjects []planSubject
+	for _, id := range principalIDs(candidateRows) {
+		principalSubjects = append(principalSubjects, planSubject{gate: planner.MkPrincipalIDNode(id), principalID: id})
+	}
+
+	pc := &planContext{
+		rt: rt,
+		evalCtx: &planner.EvalContext{
+			TimeFn:           nowFunc,
+			UnknownPrincipal: true,
+		},
+		request: &enginev1.Request{
+			Principal: &enginev1.Request_Principal{Scope: principalScope},
+			Resource: &enginev1.Request_Resource{
+				Kind:          input.Resource.Kind,
+				Id:            input.Resource.Id,
+				Attr:          input.Resource.Attr,
+				PolicyVersion: input.Resource.PolicyVersion,
+				Scope:         input.Resource.Scope,
+			},
+			AuxData: input.AuxData,
+		},
+		globals:           globals,
+		effectivePolicies: make(map[string]*policyv1.SourceAttributes),
+		resourceVersion:   resourceVersion,
+		principalVersion:  principalVersion,
+		scopes:            scopes,
+		candidateRows:     candidateRows,
+	}
+
+	filters := make([]*enginev1.PlanResourcesFilter, 0, len(input.Actions))
+	policyMatch := false
+	for _, action := range input.Actions {
+		nf := new(planner.NodeFilter)
+
+		var hasPolicyTypeAllow bool
+		var rootNode *planner.QpN
+
+		// evaluate resource policies before principal policies
+		for _, pt := range []policyv1.Kind{policyv1.Kind_KIND_RESOURCE, policyv1.Kind_KIND_PRINCIPAL} {
+			subjects := roleSubjects
+			if pt == policyv1.Kind_KIND_PRINCIPAL {
+				subjects = principalSubjects
+			}
+
+			policyTypeAllowNode, policyTypeDenyNode, err := pc.planSubjects(ctx, pt, action, subjects)
+			if err != nil {
+				return nil, err
+			}
+
+			// the DENY conditions of a role are already part of its ALLOW conditions
+			if pt == policyv1.Kind_KIND_RESOURCE && policyTypeAllowNode != nil {
+				policyTypeDenyNode = nil
+			}
+
+			if policyTypeAllowNode != nil {
+				hasPolicyTypeAllow = true
+				if rootNode == nil {
+					rootNode = policyTypeAllowNode
+				} else {
+					rootNode = planner.MkOrNode([]*planner.QpN{policyTypeAllowNode, rootNode})
+				}
+			}
+
+			if policyTypeDenyNode != nil {
+				inv := planner.InvertNodeBooleanValue(policyTypeDenyNode)
+				if rootNode == nil {
+					rootNode = inv
+				} else {
+					rootNode = planner.MkAndNode([]*planner.QpN{inv, rootNode})
+				}
+			}
+		}
+
+		if rootNode != nil {
+			policyMatch = true
+			if !hasPolicyTypeAllow {
+				nf.ResetToUnconditionalDeny()
+			} else {
+				nf.Add(rootNode, effectv1.Effect_EFFECT_ALLOW)
+			}
+		}
+
+		if nf.AllowIsEmpty() && !nf.DenyIsEmpty() {
+			nf.ResetToUnconditionalDeny()
+		}
+		f, err := planner.ToFilter(nf.ToAST())
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	output.Filter, output.FilterDebug, err = planner.MergeWithAnd(filters)
+	if err != nil {
+		return nil, err
+	}
+	if !policyMatch {
+		output.FilterDebug = noPolicyMatch
+	}
+
+	return output, nil
+}
+
+// planSubjects returns the ALLOW and DENY nodes of the rows of the policy kind that apply to the action, with the rows of each
+// subject guarded by the condition that the principal matches the subject. A principal can have several roles and the decision
+// for each role is made independently, so the DENY conditions of a role are folded into its ALLOW conditions instead of applying
+// to the other roles. The DENY node is the disjunction of the DENY conditions of all subjects.
+func (pc *planContext) planSubjects(ctx context.Context, pt policyv1.Kind, action string, subjects []planSubject) (allowNode, denyNode *planner.QpN, err error) {
+	var allowNodes, denyNodes []*planner.QpN
+	for _, s := range subjects {
+		includingParentRoles := make(map[string]struct{}, len(s.roles))
+		for _, r := range s.roles {
+			includingParentRoles[r] = struct{}{}
+		}
+
+		rp, err := pc.planRole(ctx, pt, action, s.principalID, s.roles, includingParentRoles, make(map[string]func() (*exprpb.Expr, error)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if rp.deny != nil {
+			denyNodes = append(denyNodes, gateNode(s.gate, rp.deny))
+		}
+
+		if pt != policyv1.Kind_KIND_RESOURCE || rp.deny == nil {
+			if rp.allow != nil {
+				allowNodes = append(allowNodes, gateNode(s.gate, rp.allow))
+			}
+			continue
+		}
+
+		if b, ok := planner.IsNodeConstBool(rp.deny); ok && b {
+			allowNodes = append(allowNodes, planner.MkFalseNode())
+		} else if rp.allow != nil {
+			allowNodes = append(allowNodes, gateNode(s.gate, planner.MkAndNode([]*planner.QpN{rp.allow, planner.InvertNodeBooleanValue(rp.deny)})))
+		}
+	}
+
+	return planner.MkOrNode(allowNodes), planner.MkOrNode(denyNodes), nil
+}
+
+func gateNode(gate, node *planner.QpN) *planner.QpN {
+	if b, ok := planner.IsNodeConstBool(gate); ok && b {
+		return node
+	}
+
+	if b, ok := planner.IsNodeConstBool(node); ok && b {
+		return gate
+	}
+
+	return planner.MkAndNode([]*planner.QpN{gate, node})
+}
+
+// roleNames returns the sorted names of the roles that the rules in the scopes refer to explicitly.
+func (rt *RuleTable) roleNames(version string, scopes []string) []string {
+	seen := make(map[string]struct{})
+	for _, scope := range scopes {
+		roleSet, ok := rt.primaryIdx[version][scope]
+		if !ok {
+			continue
+		}
+
+		for role := range roleSet.GetAll() {
+			if role == "" || strings.Contains(role, "*") {
+				continue
+			}
+			seen[role] = struct{}{}
+		}
+	}
+
+	roles := make([]string, 0, len(seen))
+	for role := range seen {
+		roles = append(roles, role)
+	}
+	slices.Sort(roles)
+
+	return roles
+}
+
+// principalIDs returns the sorted IDs of the principals that have principal policy rows among the rows.
+func principalIDs(rows []*Row) []string {
+	var ids []string
+	for _, row := range rows {
+		if row.PolicyKind == policyv1.Kind_KIND_PRINCIPAL && !slices.Contains(ids, row.Principal) {
+			ids = append(ids, row.Principal)
+		}
+	}
+	slices.Sort(ids)
+
+	return ids
+}
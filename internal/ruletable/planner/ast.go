@@ -54,6 +54,12 @@ const (
 	Map                = "map"
 	Lambda             = "lambda"
 	If                 = "if"
+	Timestamp          = "timestamp"
+	Duration           = "duration"
+	Size               = "size"
+	LowerAscii         = "lowerAscii"
+	UpperAscii         = "upperAscii"
+	Matches            = "matches"
 )
 
 var ErrUnknownOperator = errors.New("unknown operator")
@@ -137,6 +143,16 @@ func replaceVarsGen(e celast.Expr, f replaceVarsFunc) (output celast.Expr, err e
 			return fact.NewSelect(0, r(ex.Operand()), ex.FieldName())
 		case celast.CallKind:
 			ex := e.AsCall()
+			var e1 celast.Expr
+			var matched bool
+
+			e1, matched, err = f(e)
+			if err != nil {
+				return nil
+			}
+			if matched {
+				return r(e1)
+			}
 			args := make([]celast.Expr, len(ex.Args()))
 			for i, arg := range ex.Args() {
 				args[i] = r(arg)
@@ -749,6 +765,10 @@ func normaliseFilterExprOpExpr(expr *enginev1.PlanResourcesFilter_Expression_Ope
 	}
 
 	expr.Expression.Operands = operands
+	if s := normaliseTimeComparison(expr); s != nil {
+		return s
+	}
+
 	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: expr}
 }
 
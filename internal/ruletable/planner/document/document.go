@@ -0,0 +1,668 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package document translates query plan filters to MongoDB and Elasticsearch queries.
+package document
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+)
+
+// ErrUntranslatable is returned when the filter contains an expression that cannot be translated to the query language.
+var ErrUntranslatable = errors.New("filter cannot be translated to a document query")
+
+const (
+	opStartsWith      = "startsWith"
+	opEndsWith        = "endsWith"
+	opContains        = "contains"
+	opHasIntersection = "hasIntersection"
+)
+
+type (
+	Format = requestv1.PlanResourcesRequest_Document_Format
+
+	operand = enginev1.PlanResourcesFilter_Expression_Operand
+)
+
+// Translate translates the filter to a query document in the requested format.
+func Translate(filter *enginev1.PlanResourcesFilter, opts *requestv1.PlanResourcesRequest_Document) (map[string]any, error) {
+	switch opts.GetFormat() {
+	case requestv1.PlanResourcesRequest_Document_FORMAT_MONGODB:
+		return MongoDB(filter, opts.GetFields())
+	case requestv1.PlanResourcesRequest_Document_FORMAT_ELASTICSEARCH:
+		return Elasticsearch(filter, opts.GetFields())
+	default:
+		return nil, fmt.Errorf("unsupported document query format %v", opts.GetFormat())
+	}
+}
+
+// MongoDB translates the filter to a MongoDB query document.
+// The fields map attribute names, as they appear in the filter, to the names of the fields in the documents.
+func MongoDB(filter *enginev1.PlanResourcesFilter, fields map[string]string) (map[string]any, error) {
+	n, err := parse(filter, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return mongoQuery(n)
+}
+
+// Elasticsearch translates the filter to an Elasticsearch or OpenSearch query.
+// The fields map attribute names, as they appear in the filter, to the names of the fields in the documents.
+func Elasticsearch(filter *enginev1.PlanResourcesFilter, fields map[string]string) (map[string]any, error) {
+	n, err := parse(filter, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return esQuery(n, "")
+}
+
+func untranslatable(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrUntranslatable, fmt.Sprintf(format, args...))
+}
+
+// node is a condition in a form that is independent of the query language.
+type node interface {
+	isNode()
+}
+
+type (
+	// constNode is a condition that is always true or always false.
+	constNode struct {
+		value bool
+	}
+	// logicalNode is the conjunction or disjunction of conditions.
+	logicalNode struct {
+		op    string
+		nodes []node
+	}
+	notNode struct {
+		node node
+	}
+	// cmpNode compares a field with a value.
+	cmpNode struct {
+		value any
+		field string
+		op    string
+	}
+	// inNode checks whether a field is equal to one of the values.
+	inNode struct {
+		field  string
+		values []any
+	}
+	// containsNode checks whether a list field contains the value.
+	containsNode struct {
+		value any
+		field string
+	}
+	// intersectsNode checks whether a list field contains any of the values.
+	intersectsNode struct {
+		field  string
+		values []any
+	}
+	// matchNode checks whether a string field starts with, ends with, contains or matches the value.
+	matchNode struct {
+		field string
+		op    string
+		value string
+	}
+	// sizeNode compares the size of a list field with a value.
+	sizeNode struct {
+		field string
+		op    string
+		size  int
+	}
+	// elemNode checks whether any or all of the elements of a list field satisfy the body.
+	// Fields in the body are relative to the element, and the element itself is the field with an empty name.
+	elemNode struct {
+		body  node
+		field string
+		op    string
+	}
+)
+
+func (constNode) isNode()      {}
+func (logicalNode) isNode()    {}
+func (notNode) isNode()        {}
+func (cmpNode) isNode()        {}
+func (inNode) isNode()         {}
+func (containsNode) isNode()   {}
+func (intersectsNode) isNode() {}
+func (matchNode) isNode()      {}
+func (sizeNode) isNode()       {}
+func (elemNode) isNode()       {}
+
+// isLeaf returns true if the node is a single test of the field with an empty name, which is the element of a list of scalars.
+func isLeaf(n node) bool {
+	switch n := n.(type) {
+	case cmpNode:
+		return n.field == ""
+	case inNode:
+		return n.field == ""
+	case matchNode:
+		return n.field == ""
+	default:
+		return false
+	}
+}
+
+type termKind int
+
+const (
+	termValue termKind = iota
+	termList
+	termField
+	termSize
+)
+
+type term struct {
+	value  any
+	field  string
+	values []any
+	kind   termKind
+}
+
+type parser struct {
+	fields map[string]string
+	// scope contains the values of the variables of the enclosing lambdas.
+	scope map[string]term
+	// elemVar is the variable of the innermost enclosing lambda that iterates over a list field.
+	elemVar string
+}
+
+func parse(filter *enginev1.PlanResourcesFilter, fields map[string]string) (node, error) {
+	switch filter.GetKind() {
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED:
+		return constNode{value: true}, nil
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED:
+		return constNode{value: false}, nil
+	case enginev1.PlanResourcesFilter_KIND_CONDITIONAL:
+		p := &parser{fields: fields, scope: make(map[string]term)}
+		return p.condition(filter.Condition)
+	default:
+		return nil, fmt.Errorf("unexpected filter kind %v", filter.GetKind())
+	}
+}
+
+// condition parses an operand that evaluates to a boolean.
+func (p *parser) condition(op *operand) (node, error) {
+	e := op.GetExpression()
+	if e == nil {
+		return p.boolTerm(op)
+	}
+
+	switch e.Operator {
+	case planner.And, planner.Or:
+		nodes := make([]node, len(e.Operands))
+		for i, o := range e.Operands {
+			n, err := p.condition(o)
+			if err != nil {
+				return nil, err
+			}
+			nodes[i] = n
+		}
+		return logicalNode{op: e.Operator, nodes: nodes}, nil
+	case planner.Not:
+		if len(e.Operands) != 1 {
+			return nil, untranslatable("operator %q expects one operand", e.Operator)
+		}
+
+		n, err := p.condition(e.Operands[0])
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: n}, nil
+	case planner.Equals, planner.NotEquals, planner.GreaterThan, planner.GreaterThanOrEqual, planner.LessThan, planner.LessThanOrEqual:
+		return p.comparison(e)
+	case planner.In:
+		return p.in(e)
+	case opHasIntersection:
+		return p.hasIntersection(e)
+	case opStartsWith, opEndsWith, opContains, planner.Matches:
+		return p.match(e)
+	case planner.Exists, planner.All:
+		return p.lambda(e)
+	case planner.ExistsOne:
+		return nil, untranslatable("operator %q is not supported", e.Operator)
+	default:
+		return p.boolTerm(op)
+	}
+}
+
+// boolTerm parses an operand that is not a boolean operation, but which should evaluate to a boolean.
+func (p *parser) boolTerm(op *operand) (node, error) {
+	tm, err := p.term(op)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tm.kind {
+	case termValue:
+		b, ok := tm.value.(bool)
+		if !ok {
+			return nil, untranslatable("value %v is not a boolean", tm.value)
+		}
+		return constNode{value: b}, nil
+	case termField:
+		return cmpNode{field: tm.field, op: planner.Equals, value: true}, nil
+	default:
+		return nil, untranslatable("operand is not a boolean")
+	}
+}
+
+func (p *parser) comparison(e *enginev1.PlanResourcesFilter_Expression) (node, error) {
+	lhs, rhs, err := p.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := e.Operator
+	if lhs.kind == termValue && rhs.kind != termValue {
+		lhs, rhs = rhs, lhs
+		operator = flip(operator)
+	}
+
+	if rhs.kind != termValue {
+		return nil, untranslatable("operator %q can only compare a field with a value", operator)
+	}
+
+	switch lhs.kind {
+	case termField:
+		if rhs.value == nil && operator != planner.Equals && operator != planner.NotEquals {
+			return nil, untranslatable("null cannot be compared with operator %q", operator)
+		}
+		return cmpNode{field: lhs.field, op: operator, value: rhs.value}, nil
+	case termSize:
+		n, ok := rhs.value.(float64)
+		if !ok || n != float64(int(n)) || (operator != planner.Equals && operator != planner.NotEquals) {
+			return nil, untranslatable("the size of a list can only be compared for equality with an integer")
+		}
+		return sizeNode{field: lhs.field, op: operator, size: int(n)}, nil
+	default:
+		return nil, untranslatable("operator %q can only compare a field with a value", operator)
+	}
+}
+
+func flip(operator string) string {
+	switch operator {
+	case planner.GreaterThan:
+		return planner.LessThan
+	case planner.GreaterThanOrEqual:
+		return planner.LessThanOrEqual
+	case planner.LessThan:
+		return planner.GreaterThan
+	case planner.LessThanOrEqual:
+		return planner.GreaterThanOrEqual
+	default:
+		return operator
+	}
+}
+
+func (p *parser) in(e *enginev1.PlanResourcesFilter_Expression) (node, error) {
+	lhs, rhs, err := p.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case lhs.kind == termField && rhs.kind == termList:
+		if len(rhs.values) == 0 {
+			return constNode{value: false}, nil
+		}
+		return inNode{field: lhs.field, values: rhs.values}, nil
+	case lhs.kind == termValue && rhs.kind == termField:
+		return containsNode{field: rhs.field, value: lhs.value}, nil
+	case lhs.kind == termValue && rhs.kind == termList:
+		return constNode{value: slices.Contains(rhs.values, lhs.value)}, nil
+	default:
+		return nil, untranslatable("operator %q requires a field and a list of values, or a value and a list field", e.Operator)
+	}
+}
+
+func (p *parser) hasIntersection(e *enginev1.PlanResourcesFilter_Expression) (node, error) {
+	lhs, rhs, err := p.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if lhs.kind != termField {
+		lhs, rhs = rhs, lhs
+	}
+
+	if lhs.kind != termField || rhs.kind != termList {
+		return nil, untranslatable("operands of %q must be a list field and a list of values", e.Operator)
+	}
+
+	if len(rhs.values) == 0 {
+		return constNode{value: false}, nil
+	}
+
+	return intersectsNode{field: lhs.field, values: rhs.values}, nil
+}
+
+func (p *parser) match(e *enginev1.PlanResourcesFilter_Expression) (node, error) {
+	target, arg, err := p.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.kind != termField {
+		return nil, untranslatable("target of %q must be a field", e.Operator)
+	}
+
+	s, ok := arg.value.(string)
+	if arg.kind != termValue || !ok {
+		return nil, untranslatable("argument of %q must be a string value", e.Operator)
+	}
+
+	return matchNode{field: target.field, op: e.Operator, value: s}, nil
+}
+
+func (p *parser) lambda(e *enginev1.PlanResourcesFilter_Expression) (node, error) {
+	const nOperands = 2
+	if len(e.Operands) != nOperands {
+		return nil, untranslatable("operator %q expects two operands", e.Operator)
+	}
+
+	lambda := e.Operands[1].GetExpression()
+	if lambda.GetOperator() != planner.Lambda || len(lambda.Operands) != nOperands || lambda.Operands[1].GetVariable() == "" {
+		return nil, untranslatable("operator %q must be applied to a lambda with a single variable", e.Operator)
+	}
+
+	body, iterVar := lambda.Operands[0], lambda.Operands[1].GetVariable()
+	if _, ok := p.scope[iterVar]; ok {
+		return nil, untranslatable("variable %q shadows another variable", iterVar)
+	}
+	defer delete(p.scope, iterVar)
+
+	rng, err := p.term(e.Operands[0])
+	if err != nil {
+		return nil, err
+	}
+
+	switch rng.kind {
+	case termField:
+		outerVar := p.elemVar
+		p.elemVar = iterVar
+		p.scope[iterVar] = term{kind: termField}
+		defer func() { p.elemVar = outerVar }()
+
+		n, err := p.condition(body)
+		if err != nil {
+			return nil, err
+		}
+
+		if usesElement(n) && !isLeaf(n) {
+			return nil, untranslatable("the body of operator %q must be a single comparison if the list elements are not objects", e.Operator)
+		}
+
+		return elemNode{field: rng.field, op: e.Operator, body: n}, nil
+	case termList:
+		nodes := make([]node, len(rng.values))
+		for i, v := range rng.values {
+			p.scope[iterVar] = term{kind: termValue, value: v}
+			if nodes[i], err = p.condition(body); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(nodes) == 0 {
+			return constNode{value: e.Operator == planner.All}, nil
+		}
+
+		if e.Operator == planner.All {
+			return logicalNode{op: planner.And, nodes: nodes}, nil
+		}
+		return logicalNode{op: planner.Or, nodes: nodes}, nil
+	default:
+		return nil, untranslatable("operator %q must be applied to a list field or a list of values", e.Operator)
+	}
+}
+
+// usesElement returns true if the node references the element of a list of scalars, rather than fields of the elements.
+func usesElement(n node) bool {
+	switch n := n.(type) {
+	case logicalNode:
+		return slices.ContainsFunc(n.nodes, usesElement)
+	case notNode:
+		return usesElement(n.node)
+	case cmpNode:
+		return n.field == ""
+	case inNode:
+		return n.field == ""
+	case containsNode:
+		return n.field == ""
+	case intersectsNode:
+		return n.field == ""
+	case matchNode:
+		return n.field == ""
+	case sizeNode:
+		return n.field == ""
+	case elemNode:
+		return n.field == ""
+	default:
+		return false
+	}
+}
+
+func (p *parser) binaryTerms(e *enginev1.PlanResourcesFilter_Expression) (term, term, error) {
+	const nOperands = 2
+	if len(e.Operands) != nOperands {
+		return term{}, term{}, untranslatable("operator %q expects two operands", e.Operator)
+	}
+
+	lhs, err := p.term(e.Operands[0])
+	if err != nil {
+		return term{}, term{}, err
+	}
+
+	rhs, err := p.term(e.Operands[1])
+	if err != nil {
+		return term{}, term{}, err
+	}
+
+	return lhs, rhs, nil
+}
+
+// term parses an operand that evaluates to a value.
+func (p *parser) term(op *operand) (term, error) {
+	switch node := op.GetNode().(type) {
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Value:
+		return valueTerm(node.Value)
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Variable:
+		return p.resolve(strings.Split(node.Variable, "."))
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Expression:
+		return p.exprTerm(node.Expression)
+	default:
+		return term{}, untranslatable("empty operand")
+	}
+}
+
+func valueTerm(v *structpb.Value) (term, error) {
+	if l := v.GetListValue(); l != nil {
+		values := make([]any, len(l.Values))
+		for i, lv := range l.Values {
+			if lv.GetListValue() != nil || lv.GetStructValue() != nil {
+				return term{}, untranslatable("lists can only contain scalar values")
+			}
+			values[i] = lv.AsInterface()
+		}
+		return term{kind: termList, values: values}, nil
+	}
+
+	if v.GetStructValue() != nil {
+		return term{}, untranslatable("map values are not supported")
+	}
+
+	return term{kind: termValue, value: v.AsInterface()}, nil
+}
+
+func (p *parser) exprTerm(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	switch e.Operator {
+	case planner.Index, planner.GetField:
+		path, err := p.path(e)
+		if err != nil {
+			return term{}, err
+		}
+		return p.resolve(path)
+	case planner.List:
+		values := make([]any, len(e.Operands))
+		for i, o := range e.Operands {
+			tm, err := p.term(o)
+			if err != nil {
+				return term{}, err
+			}
+
+			if tm.kind != termValue {
+				return term{}, untranslatable("lists can only contain values")
+			}
+			values[i] = tm.value
+		}
+		return term{kind: termList, values: values}, nil
+	case planner.Timestamp:
+		return p.timestamp(e)
+	case planner.Size:
+		if len(e.Operands) != 1 {
+			return term{}, untranslatable("operator %q expects one operand", e.Operator)
+		}
+
+		tm, err := p.term(e.Operands[0])
+		if err != nil {
+			return term{}, err
+		}
+
+		switch tm.kind {
+		case termList:
+			return term{kind: termValue, value: float64(len(tm.values))}, nil
+		case termField:
+			return term{kind: termSize, field: tm.field}, nil
+		default:
+			return term{}, untranslatable("operator %q can only be applied to list fields", e.Operator)
+		}
+	default:
+		return term{}, untranslatable("operator %q is not supported", e.Operator)
+	}
+}
+
+// timestamp parses a conversion to a timestamp. Fields are assumed to hold dates, so only string values are converted.
+func (p *parser) timestamp(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	if len(e.Operands) != 1 {
+		return term{}, untranslatable("operator %q expects one operand", e.Operator)
+	}
+
+	tm, err := p.term(e.Operands[0])
+	if err != nil {
+		return term{}, err
+	}
+
+	switch tm.kind {
+	case termField:
+		return tm, nil
+	case termValue:
+		s, ok := tm.value.(string)
+		if !ok {
+			return term{}, untranslatable("operator %q can only be applied to fields and string values", e.Operator)
+		}
+
+		ts, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return term{}, untranslatable("invalid timestamp %q", s)
+		}
+		return term{kind: termValue, value: ts}, nil
+	default:
+		return term{}, untranslatable("operator %q can only be applied to fields and string values", e.Operator)
+	}
+}
+
+// path returns the attribute path referenced by a chain of field selections and indexing operations with string keys.
+func (p *parser) path(e *enginev1.PlanResourcesFilter_Expression) ([]string, error) {
+	const nOperands = 2
+	if len(e.Operands) != nOperands {
+		return nil, untranslatable("operator %q expects two operands", e.Operator)
+	}
+
+	var path []string
+	switch base := e.Operands[0].GetNode().(type) {
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Variable:
+		path = strings.Split(base.Variable, ".")
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Expression:
+		if base.Expression.Operator != planner.Index && base.Expression.Operator != planner.GetField {
+			return nil, untranslatable("operator %q can only be applied to attributes", e.Operator)
+		}
+
+		bp, err := p.path(base.Expression)
+		if err != nil {
+			return nil, err
+		}
+		path = bp
+	default:
+		return nil, untranslatable("operator %q can only be applied to attributes", e.Operator)
+	}
+
+	key := e.Operands[1]
+	if e.Operator == planner.GetField {
+		return append(path, key.GetVariable()), nil
+	}
+
+	s, ok := key.GetValue().GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return nil, untranslatable("only string keys are supported by operator %q", e.Operator)
+	}
+
+	return append(path, s.StringValue), nil
+}
+
+// resolve returns the term for the attribute path, which must either be the variable of an enclosing lambda or start with a mapped attribute.
+func (p *parser) resolve(path []string) (term, error) {
+	if tm, ok := p.scope[path[0]]; ok {
+		switch {
+		case tm.kind == termValue && len(path) == 1:
+			return tm, nil
+		case tm.kind == termField && path[0] == p.elemVar:
+			return term{kind: termField, field: strings.Join(path[1:], ".")}, nil
+		case tm.kind == termField:
+			return term{}, untranslatable("variable %q cannot be referenced in the body of a nested lambda", path[0])
+		default:
+			return term{}, untranslatable("fields of %q can only be accessed if it is an element of a list field", path[0])
+		}
+	}
+
+	name := strings.Join(path, ".")
+	if p.elemVar != "" {
+		return term{}, untranslatable("attribute %q cannot be referenced in the body of a lambda over a list field", name)
+	}
+
+	for _, attr := range slices.Backward(slices.Sorted(maps.Keys(p.fields))) {
+		rest, ok := strings.CutPrefix(name, attr)
+		if !ok || (rest != "" && rest[0] != '.') {
+			continue
+		}
+
+		field := p.fields[attr]
+		if field == "" {
+			field = strings.TrimPrefix(rest, ".")
+		} else {
+			field += rest
+		}
+
+		if field == "" {
+			return term{}, untranslatable("attribute %q is mapped to an empty field name", name)
+		}
+
+		return term{kind: termField, field: field}, nil
+	}
+
+	return term{}, untranslatable("attribute %q is not mapped to a field", name)
+}
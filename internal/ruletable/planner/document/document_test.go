@@ -0,0 +1,366 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package document_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/ruletable/planner/document"
+)
+
+var fields = map[string]string{
+	"request.resource.attr":       "",
+	"request.resource.attr.owner": "owner.id",
+}
+
+func TestTranslate(t *testing.T) {
+	testCases := []struct {
+		name  string
+		cond  *enginev1.PlanResourcesFilter_Expression_Operand
+		mongo string
+		es    string
+	}{
+		{
+			name:  "eq",
+			cond:  expr("eq", variable("request.resource.attr.owner"), value("alice")),
+			mongo: `{"owner.id": {"$eq": "alice"}}`,
+			es:    `{"term": {"owner.id": "alice"}}`,
+		},
+		{
+			name:  "ne",
+			cond:  expr("ne", variable("request.resource.attr.status"), value("closed")),
+			mongo: `{"status": {"$ne": "closed"}}`,
+			es:    `{"bool": {"must_not": [{"term": {"status": "closed"}}]}}`,
+		},
+		{
+			name:  "flipped_comparison",
+			cond:  expr("lt", value(2), variable("request.resource.attr.meta.level")),
+			mongo: `{"meta.level": {"$gt": 2}}`,
+			es:    `{"range": {"meta.level": {"gt": 2}}}`,
+		},
+		{
+			name:  "is_null",
+			cond:  expr("eq", variable("request.resource.attr.reviewer"), value(nil)),
+			mongo: `{"reviewer": {"$eq": null}}`,
+			es:    `{"bool": {"must_not": [{"exists": {"field": "reviewer"}}]}}`,
+		},
+		{
+			name:  "boolean_field",
+			cond:  variable("request.resource.attr.public"),
+			mongo: `{"public": {"$eq": true}}`,
+			es:    `{"term": {"public": true}}`,
+		},
+		{
+			name: "and_or_not",
+			cond: expr("and",
+				expr("or", expr("eq", variable("request.resource.attr.owner"), value("alice")), expr("ge", variable("request.resource.attr.level"), value(3))),
+				expr("not", expr("eq", variable("request.resource.attr.status"), value("closed"))),
+			),
+			mongo: `{"$and": [{"$or": [{"owner.id": {"$eq": "alice"}}, {"level": {"$gte": 3}}]}, {"$nor": [{"status": {"$eq": "closed"}}]}]}`,
+			es:    `{"bool": {"filter": [{"bool": {"should": [{"term": {"owner.id": "alice"}}, {"range": {"level": {"gte": 3}}}], "minimum_should_match": 1}}, {"bool": {"must_not": [{"term": {"status": "closed"}}]}}]}}`,
+		},
+		{
+			name:  "index",
+			cond:  expr("eq", expr("index", variable("request.resource.attr.labels"), value("team")), value("a")),
+			mongo: `{"labels.team": {"$eq": "a"}}`,
+			es:    `{"term": {"labels.team": "a"}}`,
+		},
+		{
+			name:  "in_values",
+			cond:  expr("in", variable("request.resource.attr.status"), value([]any{"open", "pending"})),
+			mongo: `{"status": {"$in": ["open", "pending"]}}`,
+			es:    `{"terms": {"status": ["open", "pending"]}}`,
+		},
+		{
+			name:  "in_empty_list",
+			cond:  expr("in", variable("request.resource.attr.status"), value([]any{})),
+			mongo: `{"$expr": false}`,
+			es:    `{"match_none": {}}`,
+		},
+		{
+			name:  "in_list_field",
+			cond:  expr("in", value("public"), variable("request.resource.attr.tags")),
+			mongo: `{"tags": {"$elemMatch": {"$eq": "public"}}}`,
+			es:    `{"term": {"tags": "public"}}`,
+		},
+		{
+			name:  "has_intersection",
+			cond:  expr("hasIntersection", value([]any{"draft", "secret"}), variable("request.resource.attr.tags")),
+			mongo: `{"tags": {"$in": ["draft", "secret"]}}`,
+			es:    `{"terms": {"tags": ["draft", "secret"]}}`,
+		},
+		{
+			name:  "starts_with",
+			cond:  expr("startsWith", variable("request.resource.attr.title"), value("a.b*")),
+			mongo: `{"title": {"$regex": "^a\\.b\\*"}}`,
+			es:    `{"prefix": {"title": {"value": "a.b*"}}}`,
+		},
+		{
+			name:  "ends_with",
+			cond:  expr("endsWith", variable("request.resource.attr.title"), value("a?")),
+			mongo: `{"title": {"$regex": "a\\?\\z"}}`,
+			es:    `{"wildcard": {"title": {"value": "*a\\?"}}}`,
+		},
+		{
+			name:  "contains",
+			cond:  expr("contains", variable("request.resource.attr.title"), value("plan")),
+			mongo: `{"title": {"$regex": "plan"}}`,
+			es:    `{"wildcard": {"title": {"value": "*plan*"}}}`,
+		},
+		{
+			name:  "size",
+			cond:  expr("eq", expr("size", variable("request.resource.attr.tags")), value(2)),
+			mongo: `{"tags": {"$size": 2}}`,
+		},
+		{
+			name:  "exists_scalars",
+			cond:  expr("exists", variable("request.resource.attr.scores"), lambda(expr("gt", variable("s"), value(5)), "s")),
+			mongo: `{"scores": {"$elemMatch": {"$gt": 5}}}`,
+			es:    `{"range": {"scores": {"gt": 5}}}`,
+		},
+		{
+			name:  "all_scalars",
+			cond:  expr("all", variable("request.resource.attr.scores"), lambda(expr("gt", variable("s"), value(5)), "s")),
+			mongo: `{"scores": {"$not": {"$elemMatch": {"$not": {"$gt": 5}}}}}`,
+			es:    `{"bool": {"must_not": [{"range": {"scores": {"lte": 5}}}]}}`,
+		},
+		{
+			name: "exists_objects",
+			cond: expr("exists", variable("request.resource.attr.reviews"), lambda(
+				expr("and", expr("eq", variable("r.author"), value("bob")), expr("ge", variable("r.rating"), value(4))), "r")),
+			mongo: `{"reviews": {"$elemMatch": {"$and": [{"author": {"$eq": "bob"}}, {"rating": {"$gte": 4}}]}}}`,
+			es:    `{"nested": {"path": "reviews", "query": {"bool": {"filter": [{"term": {"reviews.author": "bob"}}, {"range": {"reviews.rating": {"gte": 4}}}]}}}}`,
+		},
+		{
+			name:  "all_objects",
+			cond:  expr("all", variable("request.resource.attr.reviews"), lambda(expr("ge", variable("r.rating"), value(4)), "r")),
+			mongo: `{"reviews": {"$not": {"$elemMatch": {"$nor": [{"rating": {"$gte": 4}}]}}}}`,
+			es:    `{"bool": {"must_not": [{"nested": {"path": "reviews", "query": {"bool": {"must_not": [{"range": {"reviews.rating": {"gte": 4}}}]}}}}]}}`,
+		},
+		{
+			name: "nested_exists",
+			cond: expr("exists", variable("request.resource.attr.reviews"), lambda(
+				expr("exists", variable("r.comments"), lambda(expr("eq", variable("c.author"), value("alice")), "c")), "r")),
+			mongo: `{"reviews": {"$elemMatch": {"comments": {"$elemMatch": {"author": {"$eq": "alice"}}}}}}`,
+			es:    `{"nested": {"path": "reviews", "query": {"nested": {"path": "reviews.comments", "query": {"term": {"reviews.comments.author": "alice"}}}}}}`,
+		},
+		{
+			name:  "exists_literal_list",
+			cond:  expr("exists", value([]any{"a", "b"}), lambda(expr("eq", variable("request.resource.attr.status"), variable("x")), "x")),
+			mongo: `{"$or": [{"status": {"$eq": "a"}}, {"status": {"$eq": "b"}}]}`,
+			es:    `{"bool": {"should": [{"term": {"status": "a"}}, {"term": {"status": "b"}}], "minimum_should_match": 1}}`,
+		},
+		{
+			name:  "timestamp",
+			cond:  expr("lt", expr("timestamp", variable("request.resource.attr.created")), expr("timestamp", value("2024-01-16T09:18:27+01:00"))),
+			mongo: `{"created": {"$lt": {"$date": "2024-01-16T08:18:27Z"}}}`,
+			es:    `{"range": {"created": {"lt": "2024-01-16T08:18:27Z"}}}`,
+		},
+		{
+			name:  "matches",
+			cond:  expr("matches", variable("request.resource.attr.title"), value("^[a-z]+$")),
+			mongo: `{"title": {"$regex": "^[a-z]+$"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: tc.cond}
+
+			t.Run("mongodb", func(t *testing.T) {
+				have, err := document.MongoDB(filter, fields)
+				require.NoError(t, err)
+				requireJSON(t, tc.mongo, have)
+			})
+
+			t.Run("elasticsearch", func(t *testing.T) {
+				have, err := document.Elasticsearch(filter, fields)
+				if tc.es == "" {
+					require.ErrorIs(t, err, document.ErrUntranslatable)
+					return
+				}
+
+				require.NoError(t, err)
+				requireJSON(t, tc.es, have)
+			})
+		})
+	}
+}
+
+func TestTranslateKinds(t *testing.T) {
+	testCases := []struct {
+		format requestv1.PlanResourcesRequest_Document_Format
+		kind   enginev1.PlanResourcesFilter_Kind
+		want   string
+	}{
+		{format: requestv1.PlanResourcesRequest_Document_FORMAT_MONGODB, kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, want: `{}`},
+		{format: requestv1.PlanResourcesRequest_Document_FORMAT_MONGODB, kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED, want: `{"$expr": false}`},
+		{format: requestv1.PlanResourcesRequest_Document_FORMAT_ELASTICSEARCH, kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, want: `{"match_all": {}}`},
+		{format: requestv1.PlanResourcesRequest_Document_FORMAT_ELASTICSEARCH, kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED, want: `{"match_none": {}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format.String()+"/"+tc.kind.String(), func(t *testing.T) {
+			have, err := document.Translate(&enginev1.PlanResourcesFilter{Kind: tc.kind}, &requestv1.PlanResourcesRequest_Document{Format: tc.format})
+			require.NoError(t, err)
+			requireJSON(t, tc.want, have)
+		})
+	}
+}
+
+func TestTranslateErrors(t *testing.T) {
+	testCases := []struct {
+		cond    *enginev1.PlanResourcesFilter_Expression_Operand
+		name    string
+		wantErr string
+	}{
+		{
+			name:    "unmapped_attribute",
+			cond:    expr("eq", variable("request.principal.attr.owner"), value("x")),
+			wantErr: `attribute "request.principal.attr.owner" is not mapped to a field`,
+		},
+		{
+			name:    "field_comparison",
+			cond:    expr("eq", variable("request.resource.attr.owner"), variable("request.resource.attr.creator")),
+			wantErr: `operator "eq" can only compare a field with a value`,
+		},
+		{
+			name:    "unsupported_operator",
+			cond:    expr("eq", expr("add", variable("request.resource.attr.level"), value(1)), value(2)),
+			wantErr: `operator "add" is not supported`,
+		},
+		{
+			name:    "exists_one",
+			cond:    expr("exists_one", variable("request.resource.attr.tags"), lambda(expr("eq", variable("t"), value("a")), "t")),
+			wantErr: `operator "exists_one" is not supported`,
+		},
+		{
+			name:    "size_range",
+			cond:    expr("gt", expr("size", variable("request.resource.attr.tags")), value(1)),
+			wantErr: "the size of a list can only be compared for equality with an integer",
+		},
+		{
+			name: "outer_attribute_in_lambda",
+			cond: expr("exists", variable("request.resource.attr.tags"), lambda(
+				expr("eq", variable("t"), variable("request.resource.attr.owner")), "t")),
+			wantErr: `attribute "request.resource.attr.owner" cannot be referenced in the body of a lambda over a list field`,
+		},
+		{
+			name: "compound_scalar_lambda",
+			cond: expr("exists", variable("request.resource.attr.scores"), lambda(
+				expr("and", expr("gt", variable("s"), value(1)), expr("lt", variable("s"), value(5))), "s")),
+			wantErr: `the body of operator "exists" must be a single comparison if the list elements are not objects`,
+		},
+		{
+			name:    "lower_ascii",
+			cond:    expr("eq", expr("lowerAscii", variable("request.resource.attr.title")), value("a")),
+			wantErr: `operator "lowerAscii" is not supported`,
+		},
+		{
+			name:    "invalid_timestamp",
+			cond:    expr("lt", variable("request.resource.attr.created"), expr("timestamp", value("yesterday"))),
+			wantErr: `invalid timestamp "yesterday"`,
+		},
+		{
+			name:    "pattern_from_field",
+			cond:    expr("startsWith", variable("request.resource.attr.title"), variable("request.resource.attr.owner")),
+			wantErr: `argument of "startsWith" must be a string value`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: tc.cond}
+
+			_, err := document.MongoDB(filter, fields)
+			require.ErrorIs(t, err, document.ErrUntranslatable)
+			require.ErrorContains(t, err, tc.wantErr)
+
+			_, err = document.Elasticsearch(filter, fields)
+			require.ErrorIs(t, err, document.ErrUntranslatable)
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestElasticsearchErrors(t *testing.T) {
+	testCases := []struct {
+		cond    *enginev1.PlanResourcesFilter_Expression_Operand
+		name    string
+		wantErr string
+	}{
+		{
+			name:    "size",
+			cond:    expr("eq", expr("size", variable("request.resource.attr.tags")), value(1)),
+			wantErr: "the size of a list cannot be queried in Elasticsearch",
+		},
+		{
+			name:    "exists_not_equal",
+			cond:    expr("exists", variable("request.resource.attr.tags"), lambda(expr("ne", variable("t"), value("a")), "t")),
+			wantErr: `operator "exists" cannot be applied to a list of scalars with a body that is an inequality or a null check`,
+		},
+		{
+			name:    "all_equal",
+			cond:    expr("all", variable("request.resource.attr.tags"), lambda(expr("eq", variable("t"), value("a")), "t")),
+			wantErr: `operator "all" can only be applied to a list of scalars with a body that is an inequality or a range comparison`,
+		},
+		{
+			name:    "matches",
+			cond:    expr("matches", variable("request.resource.attr.title"), value("^a")),
+			wantErr: `operator "matches" cannot be translated because Elasticsearch uses a different regular expression syntax`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: tc.cond}
+			_, err := document.Elasticsearch(filter, fields)
+			require.ErrorIs(t, err, document.ErrUntranslatable)
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func requireJSON(t *testing.T, want string, have map[string]any) {
+	t.Helper()
+
+	// The query must be representable as a protobuf struct to be included in the API response.
+	_, err := structpb.NewStruct(have)
+	require.NoError(t, err)
+
+	haveJSON, err := json.Marshal(have)
+	require.NoError(t, err)
+	require.JSONEq(t, want, string(haveJSON))
+}
+
+func expr(op string, operands ...*enginev1.PlanResourcesFilter_Expression_Operand) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{
+		Node: &enginev1.PlanResourcesFilter_Expression_Operand_Expression{
+			Expression: &enginev1.PlanResourcesFilter_Expression{Operator: op, Operands: operands},
+		},
+	}
+}
+
+func lambda(body *enginev1.PlanResourcesFilter_Expression_Operand, iterVar string) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return expr("lambda", body, variable(iterVar))
+}
+
+func variable(name string) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: name}}
+}
+
+func value(v any) *enginev1.PlanResourcesFilter_Expression_Operand {
+	val, err := structpb.NewValue(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Value{Value: val}}
+}
@@ -0,0 +1,191 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package document
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+)
+
+var (
+	esRangeOperators = map[string]string{
+		planner.GreaterThan:        "gt",
+		planner.GreaterThanOrEqual: "gte",
+		planner.LessThan:           "lt",
+		planner.LessThanOrEqual:    "lte",
+	}
+
+	// esNegatedOperators are the operators that return the opposite result for every element of a list.
+	esNegatedOperators = map[string]string{
+		planner.NotEquals:          planner.Equals,
+		planner.GreaterThan:        planner.LessThanOrEqual,
+		planner.GreaterThanOrEqual: planner.LessThan,
+		planner.LessThan:           planner.GreaterThanOrEqual,
+		planner.LessThanOrEqual:    planner.GreaterThan,
+	}
+
+	esWildcardEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`)
+)
+
+// esQuery returns the query for the node. Field names are relative to the prefix, which is the path of the enclosing nested object.
+func esQuery(n node, prefix string) (map[string]any, error) {
+	switch n := n.(type) {
+	case constNode:
+		if n.value {
+			return map[string]any{"match_all": map[string]any{}}, nil
+		}
+		return map[string]any{"match_none": map[string]any{}}, nil
+	case logicalNode:
+		queries := make([]any, len(n.nodes))
+		for i, c := range n.nodes {
+			q, err := esQuery(c, prefix)
+			if err != nil {
+				return nil, err
+			}
+			queries[i] = q
+		}
+
+		if n.op == planner.And {
+			return esBool("filter", queries), nil
+		}
+		return map[string]any{"bool": map[string]any{"should": queries, "minimum_should_match": 1}}, nil
+	case notNode:
+		q, err := esQuery(n.node, prefix)
+		if err != nil {
+			return nil, err
+		}
+		return esBool("must_not", []any{q}), nil
+	case cmpNode:
+		return esCmp(esField(prefix, n.field), n.op, n.value)
+	case inNode:
+		return esTerms(esField(prefix, n.field), n.values)
+	case containsNode:
+		return esCmp(esField(prefix, n.field), planner.Equals, n.value)
+	case intersectsNode:
+		return esTerms(esField(prefix, n.field), n.values)
+	case matchNode:
+		field := esField(prefix, n.field)
+		switch n.op {
+		case planner.Matches:
+			return nil, untranslatable("operator %q cannot be translated because Elasticsearch uses a different regular expression syntax", n.op)
+		case opStartsWith:
+			return map[string]any{"prefix": map[string]any{field: map[string]any{"value": n.value}}}, nil
+		case opEndsWith:
+			return map[string]any{"wildcard": map[string]any{field: map[string]any{"value": "*" + esWildcardEscaper.Replace(n.value)}}}, nil
+		default:
+			return map[string]any{"wildcard": map[string]any{field: map[string]any{"value": "*" + esWildcardEscaper.Replace(n.value) + "*"}}}, nil
+		}
+	case sizeNode:
+		return nil, untranslatable("the size of a list cannot be queried in Elasticsearch")
+	case elemNode:
+		return esElem(n, prefix)
+	default:
+		return nil, fmt.Errorf("unexpected node %T", n)
+	}
+}
+
+func esElem(n elemNode, prefix string) (map[string]any, error) {
+	path := esField(prefix, n.field)
+
+	if !isLeaf(n.body) {
+		body, err := esQuery(n.body, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.op == planner.All {
+			// Every element satisfies the condition if no element fails to satisfy it.
+			return esBool("must_not", []any{esNested(path, esBool("must_not", []any{body}))}), nil
+		}
+		return esNested(path, body), nil
+	}
+
+	// Queries on a list of scalars match if any element matches, so an element of the list behaves like the list field itself.
+	leaf := n.body
+	if n.op == planner.All {
+		cmp, ok := leaf.(cmpNode)
+		negated, negatable := esNegatedOperators[cmp.op]
+		if !ok || !negatable || cmp.value == nil {
+			return nil, untranslatable("operator %q can only be applied to a list of scalars with a body that is an inequality or a range comparison", n.op)
+		}
+
+		q, err := esCmp(path, negated, cmp.value)
+		if err != nil {
+			return nil, err
+		}
+		return esBool("must_not", []any{q}), nil
+	}
+
+	if cmp, ok := leaf.(cmpNode); ok && (cmp.op == planner.NotEquals || cmp.value == nil) {
+		return nil, untranslatable("operator %q cannot be applied to a list of scalars with a body that is an inequality or a null check", n.op)
+	}
+
+	return esQuery(leaf, path)
+}
+
+func esCmp(field, op string, value any) (map[string]any, error) {
+	value = esValue(value)
+	switch op {
+	case planner.Equals:
+		if value == nil {
+			return esBool("must_not", []any{esExists(field)}), nil
+		}
+		return map[string]any{"term": map[string]any{field: value}}, nil
+	case planner.NotEquals:
+		if value == nil {
+			return esExists(field), nil
+		}
+		return esBool("must_not", []any{map[string]any{"term": map[string]any{field: value}}}), nil
+	default:
+		return map[string]any{"range": map[string]any{field: map[string]any{esRangeOperators[op]: value}}}, nil
+	}
+}
+
+func esTerms(field string, values []any) (map[string]any, error) {
+	if slices.Contains(values, nil) {
+		return nil, untranslatable("lists containing null are not supported")
+	}
+
+	esValues := make([]any, len(values))
+	for i, v := range values {
+		esValues[i] = esValue(v)
+	}
+
+	return map[string]any{"terms": map[string]any{field: esValues}}, nil
+}
+
+// esValue returns the value to use in a query. Timestamps are written as strings in the default date format of Elasticsearch.
+func esValue(v any) any {
+	if t, ok := v.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	return v
+}
+
+func esBool(clause string, queries []any) map[string]any {
+	return map[string]any{"bool": map[string]any{clause: queries}}
+}
+
+func esExists(field string) map[string]any {
+	return map[string]any{"exists": map[string]any{"field": field}}
+}
+
+func esNested(path string, query map[string]any) map[string]any {
+	return map[string]any{"nested": map[string]any{"path": path, "query": query}}
+}
+
+func esField(prefix, field string) string {
+	switch {
+	case prefix == "":
+		return field
+	case field == "":
+		return prefix
+	default:
+		return prefix + "." + field
+	}
+}
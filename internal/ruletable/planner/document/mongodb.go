@@ -0,0 +1,141 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package document
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+)
+
+var mongoOperators = map[string]string{
+	planner.Equals:             "$eq",
+	planner.NotEquals:          "$ne",
+	planner.GreaterThan:        "$gt",
+	planner.GreaterThanOrEqual: "$gte",
+	planner.LessThan:           "$lt",
+	planner.LessThanOrEqual:    "$lte",
+}
+
+func mongoQuery(n node) (map[string]any, error) {
+	switch n := n.(type) {
+	case constNode:
+		if n.value {
+			return map[string]any{}, nil
+		}
+		return map[string]any{"$expr": false}, nil
+	case logicalNode:
+		queries := make([]any, len(n.nodes))
+		for i, c := range n.nodes {
+			q, err := mongoQuery(c)
+			if err != nil {
+				return nil, err
+			}
+			queries[i] = q
+		}
+
+		if n.op == planner.And {
+			return map[string]any{"$and": queries}, nil
+		}
+		return map[string]any{"$or": queries}, nil
+	case notNode:
+		q, err := mongoQuery(n.node)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"$nor": []any{q}}, nil
+	case containsNode:
+		return map[string]any{n.field: map[string]any{"$elemMatch": map[string]any{"$eq": mongoValue(n.value)}}}, nil
+	case intersectsNode:
+		return map[string]any{n.field: map[string]any{"$in": mongoValues(n.values)}}, nil
+	case sizeNode:
+		if n.op == planner.NotEquals {
+			return map[string]any{n.field: map[string]any{"$not": map[string]any{"$size": n.size}}}, nil
+		}
+		return map[string]any{n.field: map[string]any{"$size": n.size}}, nil
+	case elemNode:
+		return mongoElem(n)
+	default:
+		field, ops, err := mongoLeaf(n)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{field: ops}, nil
+	}
+}
+
+// mongoLeaf returns the field and the operator expression for a single test of a field.
+func mongoLeaf(n node) (string, map[string]any, error) {
+	switch n := n.(type) {
+	case cmpNode:
+		return n.field, map[string]any{mongoOperators[n.op]: mongoValue(n.value)}, nil
+	case inNode:
+		return n.field, map[string]any{"$in": mongoValues(n.values)}, nil
+	case matchNode:
+		if n.op == planner.Matches {
+			return n.field, map[string]any{"$regex": n.value}, nil
+		}
+
+		pattern := regexp.QuoteMeta(n.value)
+		switch n.op {
+		case opStartsWith:
+			pattern = "^" + pattern
+		case opEndsWith:
+			pattern += `\z`
+		}
+		return n.field, map[string]any{"$regex": pattern}, nil
+	default:
+		return "", nil, fmt.Errorf("unexpected node %T", n)
+	}
+}
+
+func mongoElem(n elemNode) (map[string]any, error) {
+	var match map[string]any
+	if isLeaf(n.body) {
+		_, ops, err := mongoLeaf(n.body)
+		if err != nil {
+			return nil, err
+		}
+
+		match = ops
+		if n.op == planner.All {
+			match = map[string]any{"$not": ops}
+		}
+	} else {
+		body, err := mongoQuery(n.body)
+		if err != nil {
+			return nil, err
+		}
+
+		match = body
+		if n.op == planner.All {
+			match = map[string]any{"$nor": []any{body}}
+		}
+	}
+
+	if n.op == planner.All {
+		// Every element satisfies the condition if no element fails to satisfy it.
+		return map[string]any{n.field: map[string]any{"$not": map[string]any{"$elemMatch": match}}}, nil
+	}
+
+	return map[string]any{n.field: map[string]any{"$elemMatch": match}}, nil
+}
+
+// mongoValue returns the value to use in a query. Timestamps are written as dates in MongoDB Extended JSON.
+func mongoValue(v any) any {
+	if t, ok := v.(time.Time); ok {
+		return map[string]any{"$date": t.UTC().Format(time.RFC3339Nano)}
+	}
+	return v
+}
+
+func mongoValues(values []any) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = mongoValue(v)
+	}
+	return out
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
@@ -188,10 +190,55 @@ func MkFalseNode() *enginev1.PlanResourcesAst_Node {
 	return &QpN{Node: &qpNE{Expression: conditions.FalseExpr}}
 }
 
-func mkTrueNode() *enginev1.PlanResourcesAst_Node {
+func MkTrueNode() *enginev1.PlanResourcesAst_Node {
 	return &QpN{Node: &qpNE{Expression: conditions.TrueExpr}}
 }
 
+// MkPrincipalRoleNode returns a node for the condition that the principal has the role.
+func MkPrincipalRoleNode(role string) *QpN {
+	return &QpN{Node: &qpNE{Expression: &exprpb.CheckedExpr{
+		Expr: plannerutils.MkCallExprProto(operators.In, mkConstStringExpr(role), principalFieldExpr("roles")),
+	}}}
+}
+
+// MkPrincipalIDNode returns a node for the condition that the principal has the ID.
+func MkPrincipalIDNode(id string) *QpN {
+	return &QpN{Node: &qpNE{Expression: &exprpb.CheckedExpr{
+		Expr: plannerutils.MkCallExprProto(operators.Equals, principalFieldExpr("id"), mkConstStringExpr(id)),
+	}}}
+}
+
+// MkPrincipalOtherRoleNode returns a node for the condition that the principal has a role that is not one of the roles.
+func MkPrincipalOtherRoleNode(roles []string) (*QpN, error) {
+	// every principal has at least one role
+	if len(roles) == 0 {
+		return MkTrueNode(), nil
+	}
+
+	quoted := make([]string, len(roles))
+	for i, r := range roles {
+		quoted[i] = strconv.Quote(r)
+	}
+
+	src := fmt.Sprintf("%s.%s.roles.exists(r, !(r in [%s]))", conditions.CELRequestIdent, conditions.CELPrincipalField, strings.Join(quoted, ", "))
+	ast, iss := conditions.StdEnv.Compile(src)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+
+	expr, err := cel.AstToCheckedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QpN{Node: &qpNE{Expression: expr}}, nil
+}
+
+func principalFieldExpr(field string) *exprpb.Expr {
+	request := &exprpb.Expr{ExprKind: &exprpb.Expr_IdentExpr{IdentExpr: &exprpb.Expr_Ident{Name: conditions.CELRequestIdent}}}
+	return plannerutils.MkSelectExprProto(plannerutils.MkSelectExprProto(request, conditions.CELPrincipalField), field)
+}
+
 func InvertNodeBooleanValue(node *enginev1.PlanResourcesAst_Node) *enginev1.PlanResourcesAst_Node {
 	if lo, ok := node.Node.(*enginev1.PlanResourcesAst_Node_LogicalOperation); ok {
 		// No point NOT'ing a NOT. Therefore strip the existing NOT operator
@@ -215,11 +262,14 @@ func InvertNodeBooleanValue(node *enginev1.PlanResourcesAst_Node) *enginev1.Plan
 
 type EvalContext struct {
 	TimeFn func() time.Time
+	// UnknownPrincipal makes the principal unknown instead of the resource, so that the residual conditions are over the
+	// principal. This is used to find the principals that can perform an action on a known resource.
+	UnknownPrincipal bool
 }
 
 func (evalCtx *EvalContext) EvaluateCondition(ctx context.Context, condition *runtimev1.Condition, request *enginev1.Request, globals, constants map[string]any, variables map[string]celast.Expr, derivedRolesList func() (*exprpb.Expr, error)) (*enginev1.PlanResourcesAst_Node, error) {
 	if condition == nil {
-		return mkTrueNode(), nil
+		return MkTrueNode(), nil
 	}
 
 	res := new(QpN)
@@ -234,7 +284,7 @@ func (evalCtx *EvalContext) EvaluateCondition(ctx context.Context, condition *ru
 
 			if b, ok := IsNodeConstBool(node); ok {
 				if b {
-					return mkTrueNode(), nil
+					return MkTrueNode(), nil
 				}
 			} else {
 				nodes = append(nodes, node)
@@ -328,7 +378,7 @@ func (evalCtx *EvalContext) evaluateConditionExpression(ctx context.Context, exp
 		return nil, err
 	}
 
-	if m := request.Resource.GetAttr(); len(m) > 0 {
+	if m := request.Resource.GetAttr(); len(m) > 0 && !evalCtx.UnknownPrincipal {
 		e, err = replaceResourceVals(e, m)
 		if err != nil {
 			return nil, err
@@ -351,6 +401,11 @@ func (evalCtx *EvalContext) evaluateConditionExpression(ctx context.Context, exp
 		return nil, err
 	}
 
+	e, err = replaceTimeSince(e)
+	if err != nil {
+		return nil, err
+	}
+
 	val, residual, err := p.evalPartially(ctx, e)
 	if err != nil {
 		// ignore expressions that are invalid
@@ -410,7 +465,7 @@ func (p *partialEvaluator) evaluateUnknown(ctx context.Context, residual celast.
 
 func (p *partialEvaluator) evalPartially(ctx context.Context, e celast.Expr) (ref.Val, celast.Expr, error) {
 	ast := celast.NewAST(e, nil)
-	val, details, err := conditions.ContextEval(ctx, p.env, ast, p.vars, p.nowFn, cel.EvalOptions(cel.OptPartialEval, cel.OptTrackState))
+	val, details, err := conditions.ContextEval(ctx, p.env, ast, p.vars, p.nowFn, cel.EvalOptions(cel.OptPartialEval, cel.OptTrackState), conditions.OpaqueRelations())
 	if err != nil {
 		return val, nil, err
 	}
@@ -418,10 +473,15 @@ func (p *partialEvaluator) evalPartially(ctx context.Context, e celast.Expr) (re
 	return val, residualExpr(ast, details), err
 }
 
-func newPartialEvaluator(env *cel.Env, knownVars map[string]any, nowFn func() time.Time) (*partialEvaluator, error) {
+func newPartialEvaluator(env *cel.Env, knownVars map[string]any, nowFn func() time.Time, unknownPrincipal bool) (*partialEvaluator, error) {
+	unknownAbbrev, unknownField := conditions.CELResourceAbbrev, conditions.CELResourceField
+	if unknownPrincipal {
+		unknownAbbrev, unknownField = conditions.CELPrincipalAbbrev, conditions.CELPrincipalField
+	}
+
 	vars, err := cel.PartialVars(knownVars,
-		cel.AttributePattern(conditions.CELResourceAbbrev),
-		cel.AttributePattern(conditions.CELRequestIdent).QualString(conditions.CELResourceField))
+		cel.AttributePattern(unknownAbbrev),
+		cel.AttributePattern(conditions.CELRequestIdent).QualString(unknownField))
 	if err != nil {
 		return nil, err
 	}
@@ -436,12 +496,17 @@ func newPartialEvaluator(env *cel.Env, knownVars map[string]any, nowFn func() ti
 func (evalCtx *EvalContext) newEvaluator(request *enginev1.Request, globals, constants map[string]any) (p *partialEvaluator, err error) {
 	knownVars := make(map[string]any)
 	knownVars[conditions.CELRequestIdent] = request
-	knownVars[conditions.CELPrincipalAbbrev] = request.Principal
 	knownVars[conditions.CELGlobalsIdent] = globals
 	knownVars[conditions.CELGlobalsAbbrev] = globals
 	knownVars[conditions.CELConstantsIdent] = constants
 	knownVars[conditions.CELConstantsAbbrev] = constants
 
+	if evalCtx.UnknownPrincipal {
+		return evalCtx.newPrincipalEvaluator(request, knownVars)
+	}
+
+	knownVars[conditions.CELPrincipalAbbrev] = request.Principal
+
 	env := conditions.StdEnv
 
 	const nNameVariants = 2 // qualified, unqualified name
@@ -478,7 +543,26 @@ func (evalCtx *EvalContext) newEvaluator(request *enginev1.Request, globals, con
 		return nil, err
 	}
 
-	return newPartialEvaluator(env, knownVars, evalCtx.TimeFn)
+	return newPartialEvaluator(env, knownVars, evalCtx.TimeFn, false)
+}
+
+// newPrincipalEvaluator returns an evaluator for which the resource is known and the principal is unknown, except for its scope.
+func (evalCtx *EvalContext) newPrincipalEvaluator(request *enginev1.Request, knownVars map[string]any) (*partialEvaluator, error) {
+	knownVars[conditions.CELResourceAbbrev] = request.Resource
+
+	names := conditions.PrincipalFieldNames(conditions.CELScopeField)
+	ds := make([]*decls.VariableDecl, len(names))
+	for i, s := range names {
+		ds[i] = decls.NewVariable(s, types.StringType)
+		knownVars[s] = request.Principal.GetScope()
+	}
+
+	env, err := conditions.StdEnv.Extend(cel.VariableDecls(ds...))
+	if err != nil {
+		return nil, err
+	}
+
+	return newPartialEvaluator(env, knownVars, evalCtx.TimeFn, true)
 }
 
 func (p *partialEvaluator) evalComprehensionBody(ctx context.Context, e celast.Expr) (celast.Expr, error) {
@@ -577,7 +661,7 @@ func evalComprehensionBodyImpl(ctx context.Context, env *cel.Env, pvars interpre
 			return nil, err
 		}
 		var det *cel.EvalDetails
-		_, det, err = conditions.ContextEval(ctx, env1, ast, pvars1, nowFn, cel.EvalOptions(cel.OptTrackState, cel.OptPartialEval))
+		_, det, err = conditions.ContextEval(ctx, env1, ast, pvars1, nowFn, cel.EvalOptions(cel.OptTrackState, cel.OptPartialEval), conditions.OpaqueRelations())
 		if err != nil {
 			return nil, err
 		}
@@ -806,3 +890,31 @@ func replaceCamelCaseFields(expr celast.Expr) (celast.Expr, error) {
 		return nil, false, nil
 	})
 }
+
+// replaceTimeSince rewrites `timeSince(x)` and `x.timeSince()` to `now() - x`.
+// The partial evaluator replaces `now()` with the request time, so the filter refers to a fixed point in time instead of
+// an opaque function call that depends on the time at which the filter is applied.
+func replaceTimeSince(expr celast.Expr) (celast.Expr, error) {
+	return replaceVarsGen(expr, func(input celast.Expr) (celast.Expr, bool, error) {
+		if input.Kind() != celast.CallKind {
+			return nil, false, nil
+		}
+		call := input.AsCall()
+		if call.FunctionName() != conditions.TimeSinceFn {
+			return nil, false, nil
+		}
+
+		var ts celast.Expr
+		switch {
+		case call.IsMemberFunction() && len(call.Args()) == 0:
+			ts = call.Target()
+		case !call.IsMemberFunction() && len(call.Args()) == 1:
+			ts = call.Args()[0]
+		default:
+			return nil, false, nil
+		}
+
+		fact := celast.NewExprFactory()
+		return fact.NewCall(0, operators.Subtract, fact.NewCall(0, conditions.NowFn), ts), true, nil
+	})
+}
@@ -300,7 +300,7 @@ func TestResidualExpr(t *testing.T) {
 			is.NoError(err)
 			got := residualExpr(nativeAST, det)
 			is.NoError(err)
-			p, err := newPartialEvaluator(env, knownVars, nowFn)
+			p, err := newPartialEvaluator(env, knownVars, nowFn, false)
 			is.NoError(err)
 			got, err = p.evalComprehensionBody(t.Context(), got)
 			is.NoError(err)
@@ -0,0 +1,453 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+// maxNormalFormTerms is the maximum number of terms that a filter in normal form can have.
+// Converting to a normal form can grow the filter exponentially, so larger filters are rejected.
+const maxNormalFormTerms = 1024
+
+var ErrNormalFormTooLarge = errors.New("filter is too large to be converted to the requested normal form")
+
+// ApplyFilterOptions returns a copy of the filter that is simplified or converted to a normal form according to the options.
+func ApplyFilterOptions(filter *enginev1.PlanResourcesFilter, opts *enginev1.PlanResourcesInput_FilterOptions) (*enginev1.PlanResourcesFilter, error) {
+	switch opts.GetNormalForm() {
+	case enginev1.PlanResourcesInput_FilterOptions_NORMAL_FORM_DNF:
+		return ToNormalForm(filter, Or)
+	case enginev1.PlanResourcesInput_FilterOptions_NORMAL_FORM_CNF:
+		return ToNormalForm(filter, And)
+	}
+
+	if opts.GetSimplify() {
+		return Simplify(filter), nil
+	}
+
+	return filter, nil
+}
+
+// Simplify returns a copy of the filter with the redundant conditions removed.
+// Only the rewrites that are valid in three-valued logic are applied, so that conditions on missing attributes
+// (which are errors in CEL and nulls in SQL) are treated in the same way by the simplified filter.
+// The rewrites are flattening nested operations, folding constants, removing duplicates, absorption and subsumption,
+// and merging equality comparisons of the same variable in a disjunction into a single membership test.
+func Simplify(filter *enginev1.PlanResourcesFilter) *enginev1.PlanResourcesFilter {
+	if filter.Kind != enginev1.PlanResourcesFilter_KIND_CONDITIONAL {
+		return filter
+	}
+
+	return mkFilterFromCondition(simplifyOperand(filter.Condition))
+}
+
+// ToNormalForm returns a copy of the filter in disjunctive normal form if the outer operator is Or,
+// or in conjunctive normal form if it is And. The terms of the normal form are simplified in the same way as Simplify.
+func ToNormalForm(filter *enginev1.PlanResourcesFilter, outer string) (*enginev1.PlanResourcesFilter, error) {
+	if filter.Kind != enginev1.PlanResourcesFilter_KIND_CONDITIONAL {
+		return filter, nil
+	}
+
+	cond := simplifyOperand(filter.Condition)
+	if _, ok := asBoolValue(cond); ok {
+		return mkFilterFromCondition(cond), nil
+	}
+
+	terms, err := distribute(toNNF(cond, false), outer)
+	if err != nil {
+		return nil, err
+	}
+
+	inner := dualOperator(outer)
+	operands := make([]*exprOp, len(terms))
+	for i, term := range terms {
+		operands[i] = mkLogicalOperand(inner, term)
+	}
+
+	return mkFilterFromCondition(simplifyOperand(mkLogicalOperand(outer, operands))), nil
+}
+
+func mkFilterFromCondition(cond *exprOp) *enginev1.PlanResourcesFilter {
+	if b, ok := asBoolValue(cond); ok {
+		if b {
+			return &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED}
+		}
+		return &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED}
+	}
+
+	return &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: cond}
+}
+
+func simplifyOperand(op *exprOp) *exprOp {
+	expr := op.GetExpression()
+	if expr == nil {
+		return op
+	}
+
+	switch expr.Operator {
+	case Not:
+		if len(expr.Operands) != 1 {
+			return op
+		}
+
+		inner := simplifyOperand(expr.Operands[0])
+		if b, ok := asBoolValue(inner); ok {
+			return mkBoolOperand(!b)
+		}
+
+		// double negation
+		if ie := inner.GetExpression(); ie != nil && ie.Operator == Not && len(ie.Operands) == 1 {
+			return ie.Operands[0]
+		}
+
+		return &exprOp{Node: mkExprOpExpr(Not, inner)}
+	case And, Or:
+		return simplifyLogicalOperation(expr.Operator, expr.Operands)
+	default:
+		return op
+	}
+}
+
+func simplifyLogicalOperation(operator string, operands []*exprOp) *exprOp {
+	// the identity is the value that can be dropped from the operation and the other value determines the result
+	identity := operator == And
+
+	var flat []*exprOp
+	seen := make(map[string]struct{}, len(operands))
+	var add func(*exprOp) bool
+	add = func(op *exprOp) bool {
+		if e := op.GetExpression(); e != nil && e.Operator == operator {
+			for _, o := range e.Operands {
+				if !add(o) {
+					return false
+				}
+			}
+			return true
+		}
+
+		if b, ok := asBoolValue(op); ok {
+			return b == identity
+		}
+
+		key := operandKey(op)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			flat = append(flat, op)
+		}
+		return true
+	}
+
+	for _, o := range operands {
+		if !add(simplifyOperand(o)) {
+			return mkBoolOperand(!identity)
+		}
+	}
+
+	flat = absorb(operator, flat)
+	if operator == Or {
+		flat = mergeEqualities(flat)
+	}
+
+	return mkLogicalOperand(operator, flat)
+}
+
+// absorb removes the operands that are implied by other operands of the operation.
+// In a disjunction, a conjunction is redundant if another operand is a conjunction of a subset of its terms: A ∨ (A ∧ B) = A.
+// Dually, in a conjunction, a disjunction is redundant if another operand is a disjunction of a subset of its terms: A ∧ (A ∨ B) = A.
+func absorb(operator string, operands []*exprOp) []*exprOp {
+	if len(operands) < 2 { //nolint:mnd
+		return operands
+	}
+
+	inner := dualOperator(operator)
+	terms := make([]map[string]struct{}, len(operands))
+	for i, op := range operands {
+		terms[i] = termKeys(op, inner)
+	}
+
+	absorbed := make([]bool, len(operands))
+	for i := range operands {
+		for j := range operands {
+			if i == j || absorbed[j] || len(terms[j]) > len(terms[i]) {
+				continue
+			}
+
+			// operands with equal terms are only different in order, so the first of them is kept
+			if len(terms[j]) == len(terms[i]) && j > i {
+				continue
+			}
+
+			if isSubset(terms[j], terms[i]) {
+				absorbed[i] = true
+				break
+			}
+		}
+	}
+
+	result := make([]*exprOp, 0, len(operands))
+	for i, op := range operands {
+		if !absorbed[i] {
+			result = append(result, op)
+		}
+	}
+
+	return result
+}
+
+func termKeys(op *exprOp, operator string) map[string]struct{} {
+	if e := op.GetExpression(); e != nil && e.Operator == operator {
+		keys := make(map[string]struct{}, len(e.Operands))
+		for _, o := range e.Operands {
+			keys[operandKey(o)] = struct{}{}
+		}
+		return keys
+	}
+
+	return map[string]struct{}{operandKey(op): {}}
+}
+
+func isSubset(a, b map[string]struct{}) bool {
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeEqualities replaces the equality comparisons and membership tests of a variable in a disjunction with a single membership test.
+// The merged operation takes the place of the first comparison of the variable.
+func mergeEqualities(operands []*exprOp) []*exprOp {
+	type group struct {
+		variable *exprOp
+		values   []*structpb.Value
+		keys     map[string]struct{}
+		count    int
+	}
+
+	groups := make(map[string]*group)
+	vars := make([]string, len(operands))
+	for i, op := range operands {
+		variable, values, ok := equalityOperands(op)
+		if !ok {
+			continue
+		}
+
+		varKey := operandKey(variable)
+		vars[i] = varKey
+		g, ok := groups[varKey]
+		if !ok {
+			g = &group{variable: variable, keys: make(map[string]struct{})}
+			groups[varKey] = g
+		}
+
+		g.count++
+		for _, v := range values {
+			k := valueKey(v)
+			if _, ok := g.keys[k]; !ok {
+				g.keys[k] = struct{}{}
+				g.values = append(g.values, v)
+			}
+		}
+	}
+
+	result := make([]*exprOp, 0, len(operands))
+	for i, op := range operands {
+		g, ok := groups[vars[i]]
+		if !ok || g.count < 2 { //nolint:mnd
+			result = append(result, op)
+			continue
+		}
+
+		if g.variable == nil {
+			// already merged into a previous operand
+			continue
+		}
+
+		if len(g.values) == 1 {
+			result = append(result, &exprOp{Node: mkExprOpExpr(Equals, g.variable, mkValueOperand(g.values[0]))})
+		} else {
+			result = append(result, &exprOp{Node: mkExprOpExpr(In, g.variable, mkValueOperand(structpb.NewListValue(&structpb.ListValue{Values: g.values})))})
+		}
+		g.variable = nil
+	}
+
+	return result
+}
+
+// equalityOperands returns the variable and the values of an equality comparison of a variable with a scalar value,
+// or of a membership test of a variable in a list of scalar values.
+func equalityOperands(op *exprOp) (*exprOp, []*structpb.Value, bool) {
+	e := op.GetExpression()
+	if e == nil || len(e.Operands) != 2 { //nolint:mnd
+		return nil, nil, false
+	}
+
+	switch e.Operator {
+	case Equals:
+		for i, o := range e.Operands {
+			if o.GetVariable() == "" {
+				continue
+			}
+
+			if v := e.Operands[1-i].GetValue(); isScalarValue(v) {
+				return o, []*structpb.Value{v}, true
+			}
+		}
+	case In:
+		if e.Operands[0].GetVariable() == "" {
+			return nil, nil, false
+		}
+
+		list := e.Operands[1].GetValue().GetListValue()
+		if len(list.GetValues()) == 0 || slices.ContainsFunc(list.Values, func(v *structpb.Value) bool { return !isScalarValue(v) }) {
+			return nil, nil, false
+		}
+
+		return e.Operands[0], list.Values, true
+	}
+
+	return nil, nil, false
+}
+
+func isScalarValue(v *structpb.Value) bool {
+	switch v.GetKind().(type) {
+	case *structpb.Value_StringValue, *structpb.Value_NumberValue, *structpb.Value_BoolValue:
+		return true
+	default:
+		return false
+	}
+}
+
+// toNNF pushes the negations in the operand down to the atomic conditions.
+func toNNF(op *exprOp, negate bool) *exprOp {
+	e := op.GetExpression()
+	if e == nil {
+		if b, ok := asBoolValue(op); ok {
+			return mkBoolOperand(b != negate)
+		}
+		return negateIf(op, negate)
+	}
+
+	switch e.Operator {
+	case Not:
+		if len(e.Operands) == 1 {
+			return toNNF(e.Operands[0], !negate)
+		}
+	case And, Or:
+		operator := e.Operator
+		if negate {
+			operator = dualOperator(operator)
+		}
+
+		operands := make([]*exprOp, len(e.Operands))
+		for i, o := range e.Operands {
+			operands[i] = toNNF(o, negate)
+		}
+
+		return &exprOp{Node: mkExprOpExpr(operator, operands...)}
+	}
+
+	return negateIf(op, negate)
+}
+
+func negateIf(op *exprOp, negate bool) *exprOp {
+	if !negate {
+		return op
+	}
+
+	return &exprOp{Node: mkExprOpExpr(Not, op)}
+}
+
+// distribute converts an operand in negation normal form to a list of terms, which are combined with the outer operator.
+// Each term is a list of literals combined with the dual of the outer operator.
+func distribute(op *exprOp, outer string) ([][]*exprOp, error) {
+	e := op.GetExpression()
+	if e == nil || (e.Operator != And && e.Operator != Or) {
+		return [][]*exprOp{{op}}, nil
+	}
+
+	if e.Operator == outer {
+		var terms [][]*exprOp
+		for _, o := range e.Operands {
+			t, err := distribute(o, outer)
+			if err != nil {
+				return nil, err
+			}
+
+			terms = append(terms, t...)
+			if len(terms) > maxNormalFormTerms {
+				return nil, ErrNormalFormTooLarge
+			}
+		}
+
+		return terms, nil
+	}
+
+	terms := [][]*exprOp{nil}
+	for _, o := range e.Operands {
+		t, err := distribute(o, outer)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(terms)*len(t) > maxNormalFormTerms {
+			return nil, ErrNormalFormTooLarge
+		}
+
+		product := make([][]*exprOp, 0, len(terms)*len(t))
+		for _, a := range terms {
+			for _, b := range t {
+				product = append(product, append(slices.Clip(a), b...))
+			}
+		}
+		terms = product
+	}
+
+	return terms, nil
+}
+
+func dualOperator(operator string) string {
+	if operator == And {
+		return Or
+	}
+
+	return And
+}
+
+func mkLogicalOperand(operator string, operands []*exprOp) *exprOp {
+	switch len(operands) {
+	case 0:
+		return mkBoolOperand(operator == And)
+	case 1:
+		return operands[0]
+	default:
+		return &exprOp{Node: mkExprOpExpr(operator, operands...)}
+	}
+}
+
+func mkBoolOperand(b bool) *exprOp {
+	return mkValueOperand(structpb.NewBoolValue(b))
+}
+
+func mkValueOperand(v *structpb.Value) *exprOp {
+	return &exprOp{Node: &exprOpValue{Value: v}}
+}
+
+func operandKey(op *exprOp) string {
+	b := new(strings.Builder)
+	filterExprOpToString(b, op)
+	return b.String()
+}
+
+func valueKey(v *structpb.Value) string {
+	return operandKey(mkValueOperand(v))
+}
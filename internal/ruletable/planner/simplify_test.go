@@ -0,0 +1,58 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestApplyFilterOptions(t *testing.T) {
+	tcases := test.LoadTestCases(t, "query_planner_simplify")
+
+	for _, tcase := range tcases {
+		t.Run(tcase.Name, func(t *testing.T) {
+			tc := readQPFilterTestCase(t, tcase.Input)
+			haveFilter, err := ApplyFilterOptions(tc.Input, tc.FilterOptions)
+			require.NoError(t, err)
+			require.Empty(t, cmp.Diff(tc.WantFilter, haveFilter, protocmp.Transform()))
+
+			haveStr := FilterToString(haveFilter)
+			require.Equal(t, tc.WantString, haveStr)
+		})
+	}
+}
+
+func TestToNormalFormTooLarge(t *testing.T) {
+	// a conjunction of n disjunctions of two conditions has 2^n terms in DNF
+	clauses := make([]*exprOp, 11)
+	for i := range clauses {
+		clauses[i] = &exprOp{Node: mkExprOpExpr(Or,
+			&exprOp{Node: mkExprOpExpr(Equals, &exprOp{Node: &exprOpVar{Variable: fmt.Sprintf("request.resource.attr.a%d", i)}}, mkValueOperand(structpb.NewNumberValue(1)))},
+			&exprOp{Node: mkExprOpExpr(LessThan, &exprOp{Node: &exprOpVar{Variable: fmt.Sprintf("request.resource.attr.b%d", i)}}, mkValueOperand(structpb.NewNumberValue(1)))},
+		)}
+	}
+
+	filter := &enginev1.PlanResourcesFilter{
+		Kind:      enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+		Condition: &exprOp{Node: mkExprOpExpr(And, clauses...)},
+	}
+
+	_, err := ToNormalForm(filter, Or)
+	require.ErrorIs(t, err, ErrNormalFormTooLarge)
+
+	// the filter is already in CNF
+	cnf, err := ToNormalForm(filter, And)
+	require.NoError(t, err)
+	require.Len(t, cnf.Condition.GetExpression().GetOperands(), len(clauses))
+}
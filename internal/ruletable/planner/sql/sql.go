@@ -0,0 +1,1008 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sql translates query plan filters to SQL WHERE clauses.
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"    // MySQL dialect
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres" // Postgres dialect
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"  // SQLite dialect
+	"github.com/doug-martin/goqu/v9/exp"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+)
+
+// ErrUntranslatable is returned when the filter contains an expression that cannot be translated to SQL.
+var ErrUntranslatable = errors.New("filter cannot be translated to SQL")
+
+const (
+	// likeEscape is the escape character used in LIKE patterns. It is not a backslash because MySQL treats backslashes in string literals as escape characters.
+	likeEscape  = '!'
+	wherePrefix = "SELECT 1 WHERE "
+)
+
+var (
+	trueExpr  = goqu.L("1 = 1")
+	falseExpr = goqu.L("1 = 0")
+)
+
+type (
+	Dialect = requestv1.PlanResourcesRequest_Sql_Dialect
+	Mapping = requestv1.PlanResourcesRequest_Sql_Mapping
+
+	operand = enginev1.PlanResourcesFilter_Expression_Operand
+)
+
+// Translate translates the filter to a WHERE clause, without the WHERE keyword, and the values to bind to its parameters.
+func Translate(filter *enginev1.PlanResourcesFilter, opts *requestv1.PlanResourcesRequest_Sql) (string, []any, error) {
+	t, err := newTranslator(opts)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var where exp.Expression
+	switch filter.GetKind() {
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED:
+		where = trueExpr
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED:
+		where = falseExpr
+	case enginev1.PlanResourcesFilter_KIND_CONDITIONAL:
+		if where, err = t.condition(filter.Condition); err != nil {
+			return "", nil, err
+		}
+	default:
+		return "", nil, fmt.Errorf("unexpected filter kind %v", filter.GetKind())
+	}
+
+	query, args, err := t.dialect.Select(goqu.L("1")).Where(where).Prepared(true).ToSQL()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate SQL: %w", err)
+	}
+
+	clause, ok := strings.CutPrefix(query, wherePrefix)
+	if !ok {
+		return "", nil, fmt.Errorf("unexpected SQL generated: %s", query)
+	}
+
+	return clause, args, nil
+}
+
+type translator struct {
+	dialect    goqu.DialectWrapper
+	attributes map[string]*Mapping
+	// scope contains the values of the variables of the enclosing lambdas.
+	scope       map[string]term
+	dialectName Dialect
+}
+
+func newTranslator(opts *requestv1.PlanResourcesRequest_Sql) (*translator, error) {
+	var name string
+	switch opts.GetDialect() {
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES:
+		name = "postgres"
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL:
+		name = "mysql"
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_SQLITE:
+		name = "sqlite3"
+	default:
+		return nil, fmt.Errorf("unsupported SQL dialect %v", opts.GetDialect())
+	}
+
+	return &translator{
+		dialect:     goqu.Dialect(name),
+		dialectName: opts.GetDialect(),
+		attributes:  opts.GetAttributes(),
+		scope:       make(map[string]term),
+	}, nil
+}
+
+type termKind int
+
+const (
+	// termValue is a literal scalar value.
+	termValue termKind = iota
+	// termList is a literal list of scalar values.
+	termList
+	// termColumn is a column or a value extracted from a JSON column.
+	termColumn
+	// termExpr is any other SQL expression.
+	termExpr
+	// termJoin is a list attribute stored in a join table.
+	termJoin
+	// termTerms is a list of terms.
+	termTerms
+)
+
+type term struct {
+	value  any
+	expr   exp.Expression
+	join   *requestv1.PlanResourcesRequest_Sql_Mapping_JoinTable
+	values []any
+	terms  []term
+	kind   termKind
+	json   bool
+	// timestamp is true if the term is an expression that evaluates to a timestamp in the representation used by the dialect.
+	timestamp bool
+}
+
+func untranslatable(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrUntranslatable, fmt.Sprintf(format, args...))
+}
+
+// condition translates an operand that evaluates to a boolean.
+func (t *translator) condition(op *operand) (exp.Expression, error) {
+	e := op.GetExpression()
+	if e == nil {
+		return t.boolTerm(op)
+	}
+
+	switch e.Operator {
+	case planner.And, planner.Or:
+		exprs := make([]exp.Expression, len(e.Operands))
+		for i, o := range e.Operands {
+			c, err := t.condition(o)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = c
+		}
+
+		if e.Operator == planner.And {
+			return goqu.And(exprs...), nil
+		}
+		return goqu.Or(exprs...), nil
+	case planner.Not:
+		if len(e.Operands) != 1 {
+			return nil, untranslatable("operator %q expects one operand", e.Operator)
+		}
+
+		c, err := t.condition(e.Operands[0])
+		if err != nil {
+			return nil, err
+		}
+		return goqu.L("NOT (?)", c), nil
+	case planner.Equals, planner.NotEquals, planner.GreaterThan, planner.GreaterThanOrEqual, planner.LessThan, planner.LessThanOrEqual:
+		return t.comparison(e)
+	case planner.In:
+		return t.in(e)
+	case "hasIntersection":
+		return t.hasIntersection(e)
+	case "startsWith", "endsWith", "contains":
+		return t.like(e)
+	case planner.Matches:
+		return t.matches(e)
+	case planner.Exists, planner.All, planner.ExistsOne:
+		return t.lambda(e)
+	default:
+		return t.boolTerm(op)
+	}
+}
+
+// boolTerm translates an operand that is not a boolean operation, but which should evaluate to a boolean.
+func (t *translator) boolTerm(op *operand) (exp.Expression, error) {
+	tm, err := t.term(op)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tm.kind {
+	case termValue:
+		b, ok := tm.value.(bool)
+		if !ok {
+			return nil, untranslatable("value %v is not a boolean", tm.value)
+		}
+
+		if b {
+			return trueExpr, nil
+		}
+		return falseExpr, nil
+	case termColumn:
+		lhs, rhs := t.coerce(tm, true)
+		return goqu.L("? = ?", lhs, rhs), nil
+	case termExpr:
+		return tm.expr, nil
+	default:
+		return nil, untranslatable("list is not a boolean")
+	}
+}
+
+var comparisonOperators = map[string]string{
+	planner.Equals:             "=",
+	planner.NotEquals:          "<>",
+	planner.GreaterThan:        ">",
+	planner.GreaterThanOrEqual: ">=",
+	planner.LessThan:           "<",
+	planner.LessThanOrEqual:    "<=",
+}
+
+func (t *translator) comparison(e *enginev1.PlanResourcesFilter_Expression) (exp.Expression, error) {
+	lhs, rhs, err := t.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := e.Operator
+	if lhs.kind == termValue && rhs.kind != termValue {
+		lhs, rhs = rhs, lhs
+		operator = flip(operator)
+	}
+
+	if !lhs.isScalar() || !rhs.isScalar() {
+		return nil, untranslatable("lists cannot be compared with operator %q", operator)
+	}
+
+	// Attributes compared with timestamps must be converted to the same representation.
+	if lhs.timestamp != rhs.timestamp && lhs.kind != termValue && rhs.kind != termValue {
+		lhs, rhs = t.toTimestamp(lhs), t.toTimestamp(rhs)
+	}
+
+	if rhs.kind == termValue && rhs.value == nil {
+		switch operator {
+		case planner.Equals:
+			return goqu.L("? IS NULL", t.scalar(lhs)), nil
+		case planner.NotEquals:
+			return goqu.L("? IS NOT NULL", t.scalar(lhs)), nil
+		default:
+			return nil, untranslatable("null cannot be compared with operator %q", operator)
+		}
+	}
+
+	var l, r any = t.scalar(lhs), t.scalar(rhs)
+	if rhs.kind == termValue {
+		l, r = t.coerce(lhs, rhs.value)
+	}
+
+	return goqu.L(fmt.Sprintf("? %s ?", comparisonOperators[operator]), l, r), nil
+}
+
+func flip(operator string) string {
+	switch operator {
+	case planner.GreaterThan:
+		return planner.LessThan
+	case planner.GreaterThanOrEqual:
+		return planner.LessThanOrEqual
+	case planner.LessThan:
+		return planner.GreaterThan
+	case planner.LessThanOrEqual:
+		return planner.GreaterThanOrEqual
+	default:
+		return operator
+	}
+}
+
+func (t *translator) in(e *enginev1.PlanResourcesFilter_Expression) (exp.Expression, error) {
+	lhs, rhs, err := t.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lhs.isScalar() {
+		return nil, untranslatable("left operand of %q must be a scalar", e.Operator)
+	}
+
+	switch rhs.kind {
+	case termList:
+		return t.inValues(lhs, rhs.values)
+	case termTerms:
+		exprs := make([]any, len(rhs.terms))
+		for i, tm := range rhs.terms {
+			if !tm.isScalar() {
+				return nil, untranslatable("lists of lists are not supported")
+			}
+			exprs[i] = t.scalar(tm)
+		}
+		return goqu.L("? IN ?", t.scalar(lhs), exprs), nil
+	case termJoin:
+		return goqu.L("EXISTS ?", t.joinQuery(rhs.join, goqu.L("? = ?", t.joinColumn(rhs.join, rhs.join.Value), t.scalar(lhs)))), nil
+	default:
+		return nil, untranslatable("right operand of %q must be a list or an attribute mapped to a join table", e.Operator)
+	}
+}
+
+func (t *translator) inValues(lhs term, values []any) (exp.Expression, error) {
+	if len(values) == 0 {
+		return falseExpr, nil
+	}
+
+	if slices.Contains(values, nil) {
+		return nil, untranslatable("lists containing null are not supported")
+	}
+
+	l := t.scalar(lhs)
+	if lhs.json {
+		if !sameTypes(values) {
+			return nil, untranslatable("JSON attributes can only be compared with lists of values of the same type")
+		}
+
+		coerced := make([]any, len(values))
+		for i, v := range values {
+			l, coerced[i] = t.coerce(lhs, v)
+		}
+		values = coerced
+	}
+
+	return goqu.L("? IN ?", l, values), nil
+}
+
+func sameTypes(values []any) bool {
+	typeOf := func(v any) string {
+		switch v.(type) {
+		case int64, float64:
+			return "number"
+		default:
+			return fmt.Sprintf("%T", v)
+		}
+	}
+
+	for _, v := range values[1:] {
+		if typeOf(v) != typeOf(values[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *translator) hasIntersection(e *enginev1.PlanResourcesFilter_Expression) (exp.Expression, error) {
+	lhs, rhs, err := t.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if lhs.kind != termJoin {
+		lhs, rhs = rhs, lhs
+	}
+
+	if lhs.kind != termJoin || rhs.kind != termList {
+		return nil, untranslatable("operands of %q must be a list of values and an attribute mapped to a join table", e.Operator)
+	}
+
+	if len(rhs.values) == 0 {
+		return falseExpr, nil
+	}
+
+	in, err := t.inValues(term{kind: termColumn, expr: t.joinColumn(lhs.join, lhs.join.Value)}, rhs.values)
+	if err != nil {
+		return nil, err
+	}
+
+	return goqu.L("EXISTS ?", t.joinQuery(lhs.join, in)), nil
+}
+
+func (t *translator) like(e *enginev1.PlanResourcesFilter_Expression) (exp.Expression, error) {
+	target, arg, err := t.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if !target.isScalar() {
+		return nil, untranslatable("target of %q must be a string", e.Operator)
+	}
+
+	s, ok := arg.value.(string)
+	if arg.kind != termValue || !ok {
+		return nil, untranslatable("argument of %q must be a string value", e.Operator)
+	}
+
+	if t.dialectName == requestv1.PlanResourcesRequest_Sql_DIALECT_SQLITE {
+		// LIKE is case-insensitive in SQLite, but GLOB is not.
+		return goqu.L("? GLOB ?", t.scalar(target), pattern(e.Operator, s, "*", escapeGlob)), nil
+	}
+
+	return goqu.L(fmt.Sprintf("? LIKE ? ESCAPE '%c'", likeEscape), t.scalar(target), pattern(e.Operator, s, "%", escapeLike)), nil
+}
+
+func (t *translator) matches(e *enginev1.PlanResourcesFilter_Expression) (exp.Expression, error) {
+	target, arg, err := t.binaryTerms(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if !target.isScalar() {
+		return nil, untranslatable("target of %q must be a string", e.Operator)
+	}
+
+	s, ok := arg.value.(string)
+	if arg.kind != termValue || !ok {
+		return nil, untranslatable("argument of %q must be a string value", e.Operator)
+	}
+
+	switch t.dialectName {
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES:
+		return goqu.L("? ~ ?", t.scalar(target), s), nil
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL:
+		return goqu.L("REGEXP_LIKE(?, ?, 'c')", t.scalar(target), s), nil
+	default:
+		return nil, untranslatable("operator %q is not supported by SQLite without a user-defined REGEXP function", e.Operator)
+	}
+}
+
+func pattern(operator, s, wildcard string, escape func(string) string) string {
+	switch operator {
+	case "startsWith":
+		return escape(s) + wildcard
+	case "endsWith":
+		return wildcard + escape(s)
+	default:
+		return wildcard + escape(s) + wildcard
+	}
+}
+
+func escapeLike(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r == likeEscape || r == '%' || r == '_' {
+			sb.WriteRune(likeEscape)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func escapeGlob(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r == '*' || r == '?' || r == '[' {
+			sb.WriteRune('[')
+			sb.WriteRune(r)
+			sb.WriteRune(']')
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func (t *translator) lambda(e *enginev1.PlanResourcesFilter_Expression) (exp.Expression, error) {
+	const nOperands = 2
+	if len(e.Operands) != nOperands {
+		return nil, untranslatable("operator %q expects two operands", e.Operator)
+	}
+
+	lambda := e.Operands[1].GetExpression()
+	if lambda.GetOperator() != planner.Lambda || len(lambda.Operands) != nOperands || lambda.Operands[1].GetVariable() == "" {
+		return nil, untranslatable("operator %q must be applied to a lambda with a single variable", e.Operator)
+	}
+
+	body, iterVar := lambda.Operands[0], lambda.Operands[1].GetVariable()
+	if _, ok := t.scope[iterVar]; ok {
+		return nil, untranslatable("variable %q shadows another variable", iterVar)
+	}
+	defer delete(t.scope, iterVar)
+
+	rng, err := t.term(e.Operands[0])
+	if err != nil {
+		return nil, err
+	}
+
+	switch rng.kind {
+	case termJoin:
+		t.scope[iterVar] = term{kind: termColumn, expr: t.joinColumn(rng.join, rng.join.Value), join: rng.join}
+		cond, err := t.condition(body)
+		if err != nil {
+			return nil, err
+		}
+
+		switch e.Operator {
+		case planner.Exists:
+			return goqu.L("EXISTS ?", t.joinQuery(rng.join, cond)), nil
+		case planner.All:
+			return goqu.L("NOT EXISTS ?", t.joinQuery(rng.join, goqu.L("NOT (?)", cond))), nil
+		default:
+			return goqu.L("? = 1", t.joinQuery(rng.join, cond).Select(goqu.COUNT(goqu.Star()))), nil
+		}
+	case termList:
+		if e.Operator == planner.ExistsOne {
+			return nil, untranslatable("operator %q cannot be applied to a list of values", e.Operator)
+		}
+
+		conds := make([]exp.Expression, len(rng.values))
+		for i, v := range rng.values {
+			t.scope[iterVar] = term{kind: termValue, value: v}
+			if conds[i], err = t.condition(body); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(conds) == 0 {
+			if e.Operator == planner.All {
+				return trueExpr, nil
+			}
+			return falseExpr, nil
+		}
+
+		if e.Operator == planner.All {
+			return goqu.And(conds...), nil
+		}
+		return goqu.Or(conds...), nil
+	default:
+		return nil, untranslatable("operator %q must be applied to a list of values or an attribute mapped to a join table", e.Operator)
+	}
+}
+
+func (t *translator) joinQuery(join *requestv1.PlanResourcesRequest_Sql_Mapping_JoinTable, cond exp.Expression) *goqu.SelectDataset {
+	where := []exp.Expression{goqu.L("? = ?", t.joinColumn(join, join.Key), goqu.I(join.Reference))}
+	if cond != nil {
+		where = append(where, cond)
+	}
+
+	return t.dialect.From(goqu.I(join.Table)).Select(goqu.L("1")).Where(where...)
+}
+
+func (t *translator) joinColumn(join *requestv1.PlanResourcesRequest_Sql_Mapping_JoinTable, column string) exp.IdentifierExpression {
+	return goqu.I(join.Table + "." + column)
+}
+
+func (t *translator) binaryTerms(e *enginev1.PlanResourcesFilter_Expression) (term, term, error) {
+	const nOperands = 2
+	if len(e.Operands) != nOperands {
+		return term{}, term{}, untranslatable("operator %q expects two operands", e.Operator)
+	}
+
+	lhs, err := t.term(e.Operands[0])
+	if err != nil {
+		return term{}, term{}, err
+	}
+
+	rhs, err := t.term(e.Operands[1])
+	if err != nil {
+		return term{}, term{}, err
+	}
+
+	return lhs, rhs, nil
+}
+
+// term translates an operand that evaluates to a value.
+func (t *translator) term(op *operand) (term, error) {
+	switch node := op.GetNode().(type) {
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Value:
+		return valueTerm(node.Value)
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Variable:
+		return t.resolve(strings.Split(node.Variable, "."))
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Expression:
+		return t.exprTerm(node.Expression)
+	default:
+		return term{}, untranslatable("empty operand")
+	}
+}
+
+func valueTerm(v *structpb.Value) (term, error) {
+	if l := v.GetListValue(); l != nil {
+		values := make([]any, len(l.Values))
+		for i, lv := range l.Values {
+			tm, err := valueTerm(lv)
+			if err != nil {
+				return term{}, err
+			}
+
+			if tm.kind != termValue {
+				return term{}, untranslatable("lists of lists are not supported")
+			}
+			values[i] = tm.value
+		}
+
+		return term{kind: termList, values: values}, nil
+	}
+
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_NullValue:
+		return term{kind: termValue}, nil
+	case *structpb.Value_BoolValue:
+		return term{kind: termValue, value: k.BoolValue}, nil
+	case *structpb.Value_StringValue:
+		return term{kind: termValue, value: k.StringValue}, nil
+	case *structpb.Value_NumberValue:
+		if n := k.NumberValue; n == math.Trunc(n) && math.Abs(n) < 1<<53 {
+			return term{kind: termValue, value: int64(n)}, nil
+		}
+		return term{kind: termValue, value: k.NumberValue}, nil
+	default:
+		return term{}, untranslatable("value %v is not supported", v)
+	}
+}
+
+func (t *translator) exprTerm(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	switch e.Operator {
+	case planner.Index, planner.GetField:
+		path, err := t.path(e)
+		if err != nil {
+			return term{}, err
+		}
+		return t.resolve(path)
+	case planner.List:
+		terms := make([]term, len(e.Operands))
+		for i, o := range e.Operands {
+			tm, err := t.term(o)
+			if err != nil {
+				return term{}, err
+			}
+			terms[i] = tm
+		}
+		return term{kind: termTerms, terms: terms}, nil
+	case planner.Size:
+		return t.size(e)
+	case planner.Add, planner.Sub, planner.Mult:
+		return t.arithmetic(e)
+	case planner.If:
+		return t.conditional(e)
+	case planner.Timestamp:
+		return t.timestamp(e)
+	case planner.LowerAscii, planner.UpperAscii:
+		return t.changeCase(e)
+	case planner.And, planner.Or, planner.Not, planner.Equals, planner.NotEquals, planner.GreaterThan, planner.GreaterThanOrEqual,
+		planner.LessThan, planner.LessThanOrEqual, planner.In, "hasIntersection", "startsWith", "endsWith", "contains", planner.Matches,
+		planner.Exists, planner.All, planner.ExistsOne:
+		cond, err := t.condition(&operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Expression{Expression: e}})
+		if err != nil {
+			return term{}, err
+		}
+		return term{kind: termExpr, expr: cond}, nil
+	default:
+		return term{}, untranslatable("operator %q is not supported", e.Operator)
+	}
+}
+
+// path returns the attribute path referenced by a chain of field selections and indexing operations with string keys.
+func (t *translator) path(e *enginev1.PlanResourcesFilter_Expression) ([]string, error) {
+	const nOperands = 2
+	if len(e.Operands) != nOperands {
+		return nil, untranslatable("operator %q expects two operands", e.Operator)
+	}
+
+	var path []string
+	switch base := e.Operands[0].GetNode().(type) {
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Variable:
+		path = strings.Split(base.Variable, ".")
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Expression:
+		if base.Expression.Operator != planner.Index && base.Expression.Operator != planner.GetField {
+			return nil, untranslatable("operator %q can only be applied to attributes", e.Operator)
+		}
+
+		p, err := t.path(base.Expression)
+		if err != nil {
+			return nil, err
+		}
+		path = p
+	default:
+		return nil, untranslatable("operator %q can only be applied to attributes", e.Operator)
+	}
+
+	key := e.Operands[1]
+	if e.Operator == planner.GetField {
+		return append(path, key.GetVariable()), nil
+	}
+
+	s, ok := key.GetValue().GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return nil, untranslatable("only string keys are supported by operator %q", e.Operator)
+	}
+
+	return append(path, s.StringValue), nil
+}
+
+// resolve returns the term for the attribute path, which must either be the variable of an enclosing lambda or start with a mapped attribute.
+func (t *translator) resolve(path []string) (term, error) {
+	if tm, ok := t.scope[path[0]]; ok {
+		switch {
+		case len(path) == 1:
+			return tm, nil
+		case len(path) == 2 && tm.join != nil: //nolint:mnd
+			return term{kind: termColumn, expr: t.joinColumn(tm.join, path[1])}, nil
+		default:
+			return term{}, untranslatable("fields of %q can only be accessed if it is an element of a list mapped to a join table", path[0])
+		}
+	}
+
+	name := strings.Join(path, ".")
+	for _, attr := range slices.Backward(slices.Sorted(maps.Keys(t.attributes))) {
+		rest, ok := strings.CutPrefix(name, attr)
+		if !ok || (rest != "" && rest[0] != '.') {
+			continue
+		}
+
+		var subPath []string
+		if rest != "" {
+			subPath = path[len(strings.Split(attr, ".")):]
+		}
+
+		return t.mapped(attr, t.attributes[attr], subPath)
+	}
+
+	return term{}, untranslatable("attribute %q is not mapped to a column", name)
+}
+
+func (t *translator) mapped(attr string, mapping *Mapping, subPath []string) (term, error) {
+	switch m := mapping.GetMapping().(type) {
+	case *requestv1.PlanResourcesRequest_Sql_Mapping_Column:
+		if len(subPath) > 0 {
+			return term{}, untranslatable("fields of attribute %q can only be accessed if it is mapped to a JSON column", attr)
+		}
+		return term{kind: termColumn, expr: goqu.I(m.Column)}, nil
+	case *requestv1.PlanResourcesRequest_Sql_Mapping_Json:
+		return term{kind: termColumn, expr: t.jsonExtract(m.Json.Column, append(slices.Clone(m.Json.Path), subPath...)), json: true}, nil
+	case *requestv1.PlanResourcesRequest_Sql_Mapping_Join:
+		if len(subPath) > 0 {
+			return term{}, untranslatable("fields of attribute %q cannot be accessed because it is mapped to a join table", attr)
+		}
+		return term{kind: termJoin, join: m.Join}, nil
+	default:
+		return term{}, untranslatable("attribute %q has an empty mapping", attr)
+	}
+}
+
+// jsonExtract returns an expression that extracts the scalar value at the path in the JSON column. The path is passed as parameters.
+func (t *translator) jsonExtract(column string, path []string) exp.Expression {
+	switch t.dialectName {
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES:
+		if len(path) == 0 {
+			return goqu.L("? #>> '{}'", goqu.I(column))
+		}
+
+		args := make([]any, len(path)+1)
+		args[0] = goqu.I(column)
+		var sb strings.Builder
+		sb.WriteString("(?")
+		for i, p := range path {
+			if i == len(path)-1 {
+				sb.WriteString(" ->> ?::text")
+			} else {
+				sb.WriteString(" -> ?::text")
+			}
+			args[i+1] = p
+		}
+		sb.WriteString(")")
+		return goqu.L(sb.String(), args...)
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL:
+		return goqu.L("JSON_UNQUOTE(JSON_EXTRACT(?, ?))", goqu.I(column), jsonPath(path))
+	default:
+		return goqu.L("json_extract(?, ?)", goqu.I(column), jsonPath(path))
+	}
+}
+
+func jsonPath(path []string) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, p := range path {
+		sb.WriteString(`."`)
+		sb.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(p))
+		sb.WriteString(`"`)
+	}
+	return sb.String()
+}
+
+// coerce returns the expressions to use when comparing the term with a value.
+// Values extracted from JSON columns are text in Postgres and MySQL, so they must be converted to the type of the value.
+func (t *translator) coerce(tm term, value any) (exp.Expression, any) {
+	lhs := t.scalar(tm)
+	if !tm.json {
+		return lhs, value
+	}
+
+	switch t.dialectName {
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES:
+		switch value.(type) {
+		case bool:
+			return goqu.L("CAST(? AS boolean)", lhs), value
+		case int64, float64:
+			return goqu.L("CAST(? AS numeric)", lhs), value
+		}
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL:
+		if b, ok := value.(bool); ok {
+			return lhs, fmt.Sprintf("%t", b)
+		}
+	}
+
+	return lhs, value
+}
+
+func (t *translator) size(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	if len(e.Operands) != 1 {
+		return term{}, untranslatable("operator %q expects one operand", e.Operator)
+	}
+
+	tm, err := t.term(e.Operands[0])
+	if err != nil {
+		return term{}, err
+	}
+
+	switch {
+	case tm.kind == termJoin:
+		return term{kind: termExpr, expr: t.joinQuery(tm.join, nil).Select(goqu.COUNT(goqu.Star()))}, nil
+	case tm.kind == termList:
+		return term{kind: termValue, value: int64(len(tm.values))}, nil
+	case tm.kind == termColumn && !tm.json:
+		if t.dialectName == requestv1.PlanResourcesRequest_Sql_DIALECT_SQLITE {
+			return term{kind: termExpr, expr: goqu.L("length(?)", tm.expr)}, nil
+		}
+		return term{kind: termExpr, expr: goqu.L("char_length(?)", tm.expr)}, nil
+	default:
+		return term{}, untranslatable("operator %q can only be applied to string columns and attributes mapped to join tables", e.Operator)
+	}
+}
+
+// timestamp translates a conversion to a timestamp. String values must be in RFC 3339 format, and attributes are assumed to be
+// timestamp columns or strings that the database can convert to timestamps.
+func (t *translator) timestamp(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	if len(e.Operands) != 1 {
+		return term{}, untranslatable("operator %q expects one operand", e.Operator)
+	}
+
+	tm, err := t.term(e.Operands[0])
+	if err != nil {
+		return term{}, err
+	}
+
+	switch {
+	case tm.kind == termValue:
+		s, ok := tm.value.(string)
+		if !ok {
+			return term{}, untranslatable("operator %q can only be applied to strings", e.Operator)
+		}
+
+		ts, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return term{}, untranslatable("invalid timestamp %q", s)
+		}
+		return t.timestampLiteral(ts), nil
+	case tm.isScalar():
+		return t.toTimestamp(tm), nil
+	default:
+		return term{}, untranslatable("operator %q cannot be applied to a list", e.Operator)
+	}
+}
+
+// timestampLiteral returns a term for the timestamp. The timestamp is bound as a string parameter so that the parameters can be
+// represented as JSON values, and converted to a timestamp in SQL.
+func (t *translator) timestampLiteral(ts time.Time) term {
+	ts = ts.UTC()
+
+	var expr exp.Expression
+	switch t.dialectName {
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES:
+		expr = goqu.L("CAST(? AS timestamptz)", ts.Format(time.RFC3339Nano))
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL:
+		expr = goqu.L("CAST(? AS DATETIME(6))", ts.Format("2006-01-02 15:04:05.999999"))
+	default:
+		expr = goqu.L("julianday(?)", ts.Format(time.RFC3339Nano))
+	}
+
+	return term{kind: termExpr, expr: expr, timestamp: true}
+}
+
+// toTimestamp converts a scalar term to a timestamp. SQLite has no timestamp type, so timestamps are compared as Julian day numbers.
+func (t *translator) toTimestamp(tm term) term {
+	if tm.timestamp {
+		return tm
+	}
+
+	expr := t.scalar(tm)
+	switch t.dialectName {
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES:
+		if tm.json {
+			expr = goqu.L("CAST(? AS timestamptz)", expr)
+		}
+	case requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL:
+		if tm.json {
+			expr = goqu.L("CAST(? AS DATETIME(6))", expr)
+		}
+	default:
+		expr = goqu.L("julianday(?)", expr)
+	}
+
+	return term{kind: termExpr, expr: expr, timestamp: true}
+}
+
+// changeCase translates lowerAscii and upperAscii. Unlike the CEL functions, LOWER and UPPER also convert non-ASCII characters
+// in most databases.
+func (t *translator) changeCase(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	if len(e.Operands) != 1 {
+		return term{}, untranslatable("operator %q expects one operand", e.Operator)
+	}
+
+	tm, err := t.term(e.Operands[0])
+	if err != nil {
+		return term{}, err
+	}
+
+	if !tm.isScalar() {
+		return term{}, untranslatable("operator %q cannot be applied to a list", e.Operator)
+	}
+
+	if e.Operator == planner.LowerAscii {
+		return term{kind: termExpr, expr: goqu.L("LOWER(?)", t.scalar(tm))}, nil
+	}
+	return term{kind: termExpr, expr: goqu.L("UPPER(?)", t.scalar(tm))}, nil
+}
+
+var arithmeticOperators = map[string]string{
+	planner.Add:  "+",
+	planner.Sub:  "-",
+	planner.Mult: "*",
+}
+
+func (t *translator) arithmetic(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	lhs, rhs, err := t.binaryTerms(e)
+	if err != nil {
+		return term{}, err
+	}
+
+	// The operand types are not known unless one of them is a number, and addition is also used for concatenating strings and lists.
+	number := func(tm term) bool {
+		switch tm.value.(type) {
+		case int64, float64:
+			return tm.kind == termValue
+		default:
+			return false
+		}
+	}
+
+	if (!number(lhs) && !number(rhs)) || !lhs.isScalar() || !rhs.isScalar() {
+		return term{}, untranslatable("operator %q can only be translated if one of its operands is a number", e.Operator)
+	}
+
+	l, r := t.scalar(lhs), t.scalar(rhs)
+	if number(rhs) {
+		l, _ = t.coerce(lhs, rhs.value)
+	}
+	if number(lhs) {
+		r, _ = t.coerce(rhs, lhs.value)
+	}
+
+	return term{kind: termExpr, expr: goqu.L(fmt.Sprintf("(? %s ?)", arithmeticOperators[e.Operator]), l, r)}, nil
+}
+
+func (t *translator) conditional(e *enginev1.PlanResourcesFilter_Expression) (term, error) {
+	const nOperands = 3
+	if len(e.Operands) != nOperands {
+		return term{}, untranslatable("operator %q expects three operands", e.Operator)
+	}
+
+	cond, err := t.condition(e.Operands[0])
+	if err != nil {
+		return term{}, err
+	}
+
+	branches := make([]any, 2) //nolint:mnd
+	for i, o := range e.Operands[1:] {
+		tm, err := t.term(o)
+		if err != nil {
+			return term{}, err
+		}
+
+		if !tm.isScalar() {
+			return term{}, untranslatable("branches of operator %q must be scalars", e.Operator)
+		}
+		branches[i] = t.scalar(tm)
+	}
+
+	return term{kind: termExpr, expr: goqu.L("CASE WHEN ? THEN ? ELSE ? END", cond, branches[0], branches[1])}, nil
+}
+
+func (tm term) isScalar() bool {
+	return tm.kind == termValue || tm.kind == termColumn || tm.kind == termExpr
+}
+
+// scalar returns the SQL expression for a scalar term. Values are bound as parameters.
+func (t *translator) scalar(tm term) exp.Expression {
+	if tm.kind == termValue {
+		if tm.value == nil {
+			return goqu.L("NULL")
+		}
+		return goqu.L("?", tm.value)
+	}
+
+	return tm.expr
+}
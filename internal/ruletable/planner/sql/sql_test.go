@@ -0,0 +1,458 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	dbsql "database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	_ "modernc.org/sqlite"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/ruletable/planner/sql"
+)
+
+type testCase struct {
+	name     string
+	cond     *enginev1.PlanResourcesFilter_Expression_Operand
+	postgres string
+	mysql    string
+	args     []any
+	// mysqlArgs overrides args for MySQL, which uses JSON path expressions and compares booleans in JSON columns as strings.
+	mysqlArgs []any
+	// matches are the IDs of the documents in the SQLite test database that match the filter.
+	matches []string
+}
+
+func TestTranslate(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:     "eq",
+			cond:     expr("eq", variable("request.resource.attr.owner"), value("alice")),
+			postgres: `"docs"."owner" = $1`,
+			mysql:    "`docs`.`owner` = ?",
+			args:     []any{"alice"},
+			matches:  []string{"doc1", "doc3"},
+		},
+		{
+			name:      "flipped_comparison",
+			cond:      expr("lt", value(2), variable("request.resource.attr.meta.level")),
+			postgres:  `CAST(("docs"."meta" ->> $1::text) AS numeric) > $2`,
+			mysql:     "JSON_UNQUOTE(JSON_EXTRACT(`docs`.`meta`, ?)) > ?",
+			args:      []any{"level", int64(2)},
+			mysqlArgs: []any{`$."level"`, int64(2)},
+			matches:   []string{"doc3"},
+		},
+		{
+			name:     "is_null",
+			cond:     expr("ne", variable("request.resource.attr.reviewer"), value(nil)),
+			postgres: `"docs"."reviewer" IS NOT NULL`,
+			mysql:    "`docs`.`reviewer` IS NOT NULL",
+			matches:  []string{"doc2"},
+		},
+		{
+			name:     "in_values",
+			cond:     expr("in", variable("request.resource.attr.owner"), value([]any{"bob", "carol"})),
+			postgres: `"docs"."owner" IN ($1, $2)`,
+			mysql:    "`docs`.`owner` IN (?, ?)",
+			args:     []any{"bob", "carol"},
+			matches:  []string{"doc2"},
+		},
+		{
+			name:     "in_empty_list",
+			cond:     expr("in", variable("request.resource.attr.owner"), value([]any{})),
+			postgres: `1 = 0`,
+			mysql:    `1 = 0`,
+			matches:  []string{},
+		},
+		{
+			name:     "in_join_table",
+			cond:     expr("in", value("public"), variable("request.resource.attr.tags")),
+			postgres: `EXISTS (SELECT 1 FROM "doc_tags" WHERE ("doc_tags"."doc_id" = "docs"."id" AND "doc_tags"."tag" = $1))`,
+			mysql:    "EXISTS (SELECT 1 FROM `doc_tags` WHERE (`doc_tags`.`doc_id` = `docs`.`id` AND `doc_tags`.`tag` = ?))",
+			args:     []any{"public"},
+			matches:  []string{"doc1", "doc2"},
+		},
+		{
+			name:     "has_intersection",
+			cond:     expr("hasIntersection", value([]any{"draft", "secret"}), variable("request.resource.attr.tags")),
+			postgres: `EXISTS (SELECT 1 FROM "doc_tags" WHERE ("doc_tags"."doc_id" = "docs"."id" AND "doc_tags"."tag" IN ($1, $2)))`,
+			mysql:    "EXISTS (SELECT 1 FROM `doc_tags` WHERE (`doc_tags`.`doc_id` = `docs`.`id` AND `doc_tags`.`tag` IN (?, ?)))",
+			args:     []any{"draft", "secret"},
+			matches:  []string{"doc2", "doc3"},
+		},
+		{
+			name:     "starts_with",
+			cond:     expr("startsWith", variable("request.resource.attr.title"), value("50%_")),
+			postgres: `"docs"."title" LIKE $1 ESCAPE '!'`,
+			mysql:    "`docs`.`title` LIKE ? ESCAPE '!'",
+			args:     []any{"50!%!_%"},
+			matches:  []string{"doc1"},
+		},
+		{
+			name:     "contains",
+			cond:     expr("contains", variable("request.resource.attr.title"), value("Plan")),
+			postgres: `"docs"."title" LIKE $1 ESCAPE '!'`,
+			mysql:    "`docs`.`title` LIKE ? ESCAPE '!'",
+			args:     []any{"%Plan%"},
+			matches:  []string{"doc2"},
+		},
+		{
+			name: "exists",
+			cond: expr("exists", variable("request.resource.attr.tags"), lambda(
+				expr("and", expr("endsWith", variable("t"), value("ic")), expr("eq", variable("t.added_by"), value("alice"))), "t")),
+			postgres: `EXISTS (SELECT 1 FROM "doc_tags" WHERE ("doc_tags"."doc_id" = "docs"."id" AND ("doc_tags"."tag" LIKE $1 ESCAPE '!' AND "doc_tags"."added_by" = $2)))`,
+			mysql:    "EXISTS (SELECT 1 FROM `doc_tags` WHERE (`doc_tags`.`doc_id` = `docs`.`id` AND (`doc_tags`.`tag` LIKE ? ESCAPE '!' AND `doc_tags`.`added_by` = ?)))",
+			args:     []any{"%ic", "alice"},
+			matches:  []string{"doc1"},
+		},
+		{
+			name:     "all",
+			cond:     expr("all", variable("request.resource.attr.tags"), lambda(expr("ne", variable("t"), value("secret")), "t")),
+			postgres: `NOT EXISTS (SELECT 1 FROM "doc_tags" WHERE ("doc_tags"."doc_id" = "docs"."id" AND NOT ("doc_tags"."tag" <> $1)))`,
+			mysql:    "NOT EXISTS (SELECT 1 FROM `doc_tags` WHERE (`doc_tags`.`doc_id` = `docs`.`id` AND NOT (`doc_tags`.`tag` <> ?)))",
+			args:     []any{"secret"},
+			matches:  []string{"doc1", "doc2", "doc4"},
+		},
+		{
+			name:     "exists_one",
+			cond:     expr("exists_one", variable("request.resource.attr.tags"), lambda(expr("in", variable("t"), value([]any{"public", "draft"})), "t")),
+			postgres: `(SELECT COUNT(*) FROM "doc_tags" WHERE ("doc_tags"."doc_id" = "docs"."id" AND "doc_tags"."tag" IN ($1, $2))) = 1`,
+			mysql:    "(SELECT COUNT(*) FROM `doc_tags` WHERE (`doc_tags`.`doc_id` = `docs`.`id` AND `doc_tags`.`tag` IN (?, ?))) = 1",
+			args:     []any{"public", "draft"},
+			matches:  []string{"doc1"},
+		},
+		{
+			name:     "exists_over_values",
+			cond:     expr("exists", value([]any{"bob", "carol"}), lambda(expr("eq", variable("request.resource.attr.owner"), variable("o")), "o")),
+			postgres: `("docs"."owner" = $1 OR "docs"."owner" = $2)`,
+			mysql:    "(`docs`.`owner` = ? OR `docs`.`owner` = ?)",
+			args:     []any{"bob", "carol"},
+			matches:  []string{"doc2"},
+		},
+		{
+			name:      "json_bool_and_index",
+			cond:      expr("or", variable("request.resource.attr.meta.public"), expr("eq", expr("index", variable("request.resource.attr.meta"), value("team")), value("x"))),
+			postgres:  `(CAST(("docs"."meta" ->> $1::text) AS boolean) = $2 OR ("docs"."meta" ->> $3::text) = $4)`,
+			mysql:     "(JSON_UNQUOTE(JSON_EXTRACT(`docs`.`meta`, ?)) = ? OR JSON_UNQUOTE(JSON_EXTRACT(`docs`.`meta`, ?)) = ?)",
+			args:      []any{"public", true, "team", "x"},
+			mysqlArgs: []any{`$."public"`, "true", `$."team"`, "x"},
+			matches:   []string{"doc1", "doc4"},
+		},
+		{
+			name:     "not",
+			cond:     expr("not", expr("eq", variable("request.resource.attr.owner"), value("alice"))),
+			postgres: `NOT ("docs"."owner" = $1)`,
+			mysql:    "NOT (`docs`.`owner` = ?)",
+			args:     []any{"alice"},
+			matches:  []string{"doc2", "doc4"},
+		},
+		{
+			name:     "size",
+			cond:     expr("and", expr("ge", expr("size", variable("request.resource.attr.tags")), value(2)), expr("lt", expr("size", variable("request.resource.attr.title")), value(20))),
+			postgres: `((SELECT COUNT(*) FROM "doc_tags" WHERE "doc_tags"."doc_id" = "docs"."id") >= $1 AND char_length("docs"."title") < $2)`,
+			mysql:    "((SELECT COUNT(*) FROM `doc_tags` WHERE `doc_tags`.`doc_id` = `docs`.`id`) >= ? AND char_length(`docs`.`title`) < ?)",
+			args:     []any{int64(2), int64(20)},
+			matches:  []string{"doc1"},
+		},
+		{
+			name:      "arithmetic",
+			cond:      expr("gt", expr("add", variable("request.resource.attr.meta.level"), value(1.5)), value(3)),
+			postgres:  `(CAST(("docs"."meta" ->> $1::text) AS numeric) + $2) > $3`,
+			mysql:     "(JSON_UNQUOTE(JSON_EXTRACT(`docs`.`meta`, ?)) + ?) > ?",
+			args:      []any{"level", 1.5, int64(3)},
+			mysqlArgs: []any{`$."level"`, 1.5, int64(3)},
+			matches:   []string{"doc2", "doc3"},
+		},
+		{
+			name:     "conditional",
+			cond:     expr("eq", expr("if", expr("eq", variable("request.resource.attr.owner"), value("alice")), value("mine"), value("theirs")), value("theirs")),
+			postgres: `CASE WHEN "docs"."owner" = $1 THEN $2 ELSE $3 END = $4`,
+			mysql:    "CASE WHEN `docs`.`owner` = ? THEN ? ELSE ? END = ?",
+			args:     []any{"alice", "mine", "theirs", "theirs"},
+			matches:  []string{"doc2", "doc4"},
+		},
+		{
+			name:      "timestamp",
+			cond:      expr("lt", expr("timestamp", variable("request.resource.attr.created")), expr("timestamp", value("2024-01-16T09:18:27Z"))),
+			postgres:  `"docs"."created" < CAST($1 AS timestamptz)`,
+			mysql:     "`docs`.`created` < CAST(? AS DATETIME(6))",
+			args:      []any{"2024-01-16T09:18:27Z"},
+			mysqlArgs: []any{"2024-01-16 09:18:27"},
+			matches:   []string{"doc1", "doc2"},
+		},
+		{
+			name:      "timestamp_attribute",
+			cond:      expr("gt", variable("request.resource.attr.created"), expr("timestamp", value("2024-01-16T09:18:27.5Z"))),
+			postgres:  `"docs"."created" > CAST($1 AS timestamptz)`,
+			mysql:     "`docs`.`created` > CAST(? AS DATETIME(6))",
+			args:      []any{"2024-01-16T09:18:27.5Z"},
+			mysqlArgs: []any{"2024-01-16 09:18:27.5"},
+			matches:   []string{"doc4"},
+		},
+		{
+			name:     "lower_ascii",
+			cond:     expr("eq", expr("lowerAscii", variable("request.resource.attr.title")), value("plans")),
+			postgres: `LOWER("docs"."title") = $1`,
+			mysql:    "LOWER(`docs`.`title`) = ?",
+			args:     []any{"plans"},
+			matches:  []string{"doc4"},
+		},
+	}
+
+	db := mkDB(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: tc.cond}
+
+			t.Run("postgres", func(t *testing.T) {
+				where, args, err := sql.Translate(filter, mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES))
+				require.NoError(t, err)
+				require.Equal(t, tc.postgres, where)
+				requireArgs(t, tc.args, args)
+			})
+
+			t.Run("mysql", func(t *testing.T) {
+				where, args, err := sql.Translate(filter, mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL))
+				require.NoError(t, err)
+				require.Equal(t, tc.mysql, where)
+				wantArgs := tc.args
+				if tc.mysqlArgs != nil {
+					wantArgs = tc.mysqlArgs
+				}
+				requireArgs(t, wantArgs, args)
+			})
+
+			t.Run("sqlite", func(t *testing.T) {
+				where, args, err := sql.Translate(filter, mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_SQLITE))
+				require.NoError(t, err)
+
+				rows, err := db.QueryContext(t.Context(), "SELECT id FROM docs WHERE "+where+" ORDER BY id", args...)
+				require.NoError(t, err, where)
+				defer rows.Close()
+
+				have := []string{}
+				for rows.Next() {
+					var id string
+					require.NoError(t, rows.Scan(&id))
+					have = append(have, id)
+				}
+				require.NoError(t, rows.Err())
+				require.Equal(t, tc.matches, have, where)
+			})
+		})
+	}
+}
+
+func TestTranslateKinds(t *testing.T) {
+	opts := mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES)
+
+	where, args, err := sql.Translate(&enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED}, opts)
+	require.NoError(t, err)
+	require.Equal(t, "1 = 1", where)
+	require.Empty(t, args)
+
+	where, args, err = sql.Translate(&enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED}, opts)
+	require.NoError(t, err)
+	require.Equal(t, "1 = 0", where)
+	require.Empty(t, args)
+}
+
+func TestTranslateErrors(t *testing.T) {
+	testCases := []struct {
+		cond    *enginev1.PlanResourcesFilter_Expression_Operand
+		name    string
+		wantErr string
+	}{
+		{
+			name:    "unmapped_attribute",
+			cond:    expr("eq", variable("request.resource.attr.unknown"), value("x")),
+			wantErr: `attribute "request.resource.attr.unknown" is not mapped to a column`,
+		},
+		{
+			name:    "field_of_column",
+			cond:    expr("eq", variable("request.resource.attr.owner.name"), value("x")),
+			wantErr: `fields of attribute "request.resource.attr.owner" can only be accessed if it is mapped to a JSON column`,
+		},
+		{
+			name:    "unsupported_operator",
+			cond:    expr("eq", expr("charAt", variable("request.resource.attr.owner"), value(0)), value("a")),
+			wantErr: `operator "charAt" is not supported`,
+		},
+		{
+			name:    "duration",
+			cond:    expr("gt", expr("duration", variable("request.resource.attr.meta.ttl")), expr("duration", value("3600s"))),
+			wantErr: `operator "duration" is not supported`,
+		},
+		{
+			name:    "division",
+			cond:    expr("gt", expr("div", variable("request.resource.attr.meta.level"), value(2)), value(1)),
+			wantErr: `operator "div" is not supported`,
+		},
+		{
+			name:    "untyped_addition",
+			cond:    expr("eq", expr("add", variable("request.resource.attr.owner"), variable("request.resource.attr.title")), value("x")),
+			wantErr: `operator "add" can only be translated if one of its operands is a number`,
+		},
+		{
+			name:    "list_comparison",
+			cond:    expr("eq", variable("request.resource.attr.tags"), value([]any{"a"})),
+			wantErr: `lists cannot be compared with operator "eq"`,
+		},
+		{
+			name:    "list_in_column",
+			cond:    expr("in", value("a"), variable("request.resource.attr.owner")),
+			wantErr: `right operand of "in" must be a list or an attribute mapped to a join table`,
+		},
+		{
+			name:    "intersection_without_join",
+			cond:    expr("hasIntersection", variable("request.resource.attr.owner"), value([]any{"a"})),
+			wantErr: `operands of "hasIntersection" must be a list of values and an attribute mapped to a join table`,
+		},
+		{
+			name:    "pattern_from_column",
+			cond:    expr("startsWith", variable("request.resource.attr.title"), variable("request.resource.attr.owner")),
+			wantErr: `argument of "startsWith" must be a string value`,
+		},
+		{
+			name:    "two_variable_lambda",
+			cond:    expr("exists", variable("request.resource.attr.tags"), &enginev1.PlanResourcesFilter_Expression_Operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Expression{Expression: &enginev1.PlanResourcesFilter_Expression{Operator: "lambda", Operands: []*enginev1.PlanResourcesFilter_Expression_Operand{value(true), variable("i"), variable("t")}}}}),
+			wantErr: `operator "exists" must be applied to a lambda with a single variable`,
+		},
+		{
+			name:    "filter_macro",
+			cond:    expr("eq", expr("size", expr("filter", variable("request.resource.attr.tags"), lambda(value(true), "t"))), value(1)),
+			wantErr: `operator "filter" is not supported`,
+		},
+		{
+			name:    "mixed_json_list",
+			cond:    expr("in", variable("request.resource.attr.meta.level"), value([]any{1, "2"})),
+			wantErr: "JSON attributes can only be compared with lists of values of the same type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: tc.cond}
+			_, _, err := sql.Translate(filter, mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES))
+			require.ErrorIs(t, err, sql.ErrUntranslatable)
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTranslateMatches(t *testing.T) {
+	filter := &enginev1.PlanResourcesFilter{
+		Kind:      enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+		Condition: expr("matches", variable("request.resource.attr.owner"), value("^a.*e$")),
+	}
+
+	where, args, err := sql.Translate(filter, mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_POSTGRES))
+	require.NoError(t, err)
+	require.Equal(t, `"docs"."owner" ~ $1`, where)
+	require.Equal(t, []any{"^a.*e$"}, args)
+
+	where, args, err = sql.Translate(filter, mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_MYSQL))
+	require.NoError(t, err)
+	require.Equal(t, "REGEXP_LIKE(`docs`.`owner`, ?, 'c')", where)
+	require.Equal(t, []any{"^a.*e$"}, args)
+
+	_, _, err = sql.Translate(filter, mkOpts(requestv1.PlanResourcesRequest_Sql_DIALECT_SQLITE))
+	require.ErrorIs(t, err, sql.ErrUntranslatable)
+}
+
+func mkOpts(dialect requestv1.PlanResourcesRequest_Sql_Dialect) *requestv1.PlanResourcesRequest_Sql {
+	column := func(name string) *requestv1.PlanResourcesRequest_Sql_Mapping {
+		return &requestv1.PlanResourcesRequest_Sql_Mapping{Mapping: &requestv1.PlanResourcesRequest_Sql_Mapping_Column{Column: name}}
+	}
+
+	return &requestv1.PlanResourcesRequest_Sql{
+		Dialect: dialect,
+		Attributes: map[string]*requestv1.PlanResourcesRequest_Sql_Mapping{
+			"request.resource.attr.owner":    column("docs.owner"),
+			"request.resource.attr.reviewer": column("docs.reviewer"),
+			"request.resource.attr.title":    column("docs.title"),
+			"request.resource.attr.created":  column("docs.created"),
+			"request.resource.attr.meta": {
+				Mapping: &requestv1.PlanResourcesRequest_Sql_Mapping_Json{
+					Json: &requestv1.PlanResourcesRequest_Sql_Mapping_JsonPath{Column: "docs.meta"},
+				},
+			},
+			"request.resource.attr.tags": {
+				Mapping: &requestv1.PlanResourcesRequest_Sql_Mapping_Join{
+					Join: &requestv1.PlanResourcesRequest_Sql_Mapping_JoinTable{Table: "doc_tags", Key: "doc_id", Reference: "docs.id", Value: "tag"},
+				},
+			},
+		},
+	}
+}
+
+func mkDB(t *testing.T) *dbsql.DB {
+	t.Helper()
+
+	db, err := dbsql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	for _, stmt := range []string{
+		`CREATE TABLE docs (id TEXT PRIMARY KEY, owner TEXT, reviewer TEXT, title TEXT, meta TEXT, created TEXT)`,
+		`CREATE TABLE doc_tags (doc_id TEXT, tag TEXT, added_by TEXT)`,
+		`INSERT INTO docs VALUES
+			('doc1', 'alice', NULL, '50%_off', '{"level": 1, "public": true, "team": "a"}', '2024-01-10T00:00:00Z'),
+			('doc2', 'bob', 'carol', 'Plans for 2025 and beyond', '{"level": 2, "public": false}', '2024-01-16T09:00:00Z'),
+			('doc3', 'alice', NULL, 'Secrets', '{"level": 3.5, "public": false}', NULL),
+			('doc4', 'dave', NULL, 'plans', '{"level": 0, "team": "x"}', '2024-01-16T10:00:00+00:00')`,
+		`INSERT INTO doc_tags VALUES
+			('doc1', 'public', 'alice'),
+			('doc1', 'topic', 'bob'),
+			('doc2', 'public', 'bob'),
+			('doc2', 'draft', 'bob'),
+			('doc3', 'secret', 'alice')`,
+	} {
+		_, err := db.ExecContext(t.Context(), stmt)
+		require.NoError(t, err)
+	}
+
+	return db
+}
+
+func requireArgs(t *testing.T, want, have []any) {
+	t.Helper()
+
+	if len(want) == 0 {
+		require.Empty(t, have)
+		return
+	}
+
+	require.Equal(t, want, have)
+}
+
+func expr(op string, operands ...*enginev1.PlanResourcesFilter_Expression_Operand) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{
+		Node: &enginev1.PlanResourcesFilter_Expression_Operand_Expression{
+			Expression: &enginev1.PlanResourcesFilter_Expression{Operator: op, Operands: operands},
+		},
+	}
+}
+
+func lambda(body *enginev1.PlanResourcesFilter_Expression_Operand, iterVar string) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return expr("lambda", body, variable(iterVar))
+}
+
+func variable(name string) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: name}}
+}
+
+func value(v any) *enginev1.PlanResourcesFilter_Expression_Operand {
+	val, err := structpb.NewValue(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Value{Value: val}}
+}
@@ -438,7 +438,7 @@ func (l *lambdaMatcher) evaluateExpr(ctx context.Context, knownVars map[string]a
 	}
 	// ast = celast.NewAST(l.innerExpr, nil)
 	ast := ast1.NativeRep()
-	_, details, err := conditions.ContextEval(ctx, env, ast, vars, p.nowFn, cel.EvalOptions(cel.OptPartialEval, cel.OptTrackState))
+	_, details, err := conditions.ContextEval(ctx, env, ast, vars, p.nowFn, cel.EvalOptions(cel.OptPartialEval, cel.OptTrackState), conditions.OpaqueRelations())
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +72,7 @@ func TestStructMatcher(t *testing.T) {
 	}
 	env := conditions.StdEnv
 	knownVars := make(map[string]any)
-	p, err := newPartialEvaluator(env, knownVars, time.Now)
+	p, err := newPartialEvaluator(env, knownVars, time.Now, false)
 	require.NoError(t, err)
 	s := newExpressionProcessor(p)
 	for _, tc := range tests {
@@ -0,0 +1,203 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// normaliseTimeComparison rewrites a comparison between time arithmetic and a constant so that the timestamp or duration
+// expression is compared directly with a constant. For example, `timeSince(timestamp(R.attr.created)) > duration("1h")`
+// is planned as `(gt (sub (timestamp "2024-01-16T10:18:27Z") (timestamp R.attr.created)) (duration "3600s"))`
+// and becomes `(lt (timestamp R.attr.created) (timestamp "2024-01-16T09:18:27Z"))`.
+// If the return value is nil, then the expression cannot be rewritten.
+func normaliseTimeComparison(expr *exprOpExpr) *exprOp {
+	e := expr.Expression
+	if !isComparison(e.Operator) || len(e.Operands) != 2 { //nolint:mnd
+		return nil
+	}
+
+	operator, lhs, rhs := e.Operator, e.Operands[0], e.Operands[1]
+	if isTimeConst(lhs) && !isTimeConst(rhs) {
+		operator, lhs, rhs = mirrorComparison(operator), rhs, lhs
+	}
+
+	rewritten := false
+	for {
+		op, l, r, ok := foldTimeComparison(operator, lhs, rhs)
+		if !ok {
+			break
+		}
+		operator, lhs, rhs, rewritten = op, l, r, true
+	}
+
+	if !rewritten {
+		return nil
+	}
+
+	return &exprOp{Node: mkExprOpExpr(operator, lhs, rhs)}
+}
+
+// foldTimeComparison moves the constant operand of the time arithmetic on the left-hand side of the comparison to the right-hand side.
+func foldTimeComparison(operator string, lhs, rhs *exprOp) (string, *exprOp, *exprOp, bool) {
+	arith := lhs.GetExpression()
+	if arith == nil || (arith.Operator != Add && arith.Operator != Sub) || len(arith.Operands) != 2 { //nolint:mnd
+		return "", nil, nil, false
+	}
+	a, b := arith.Operands[0], arith.Operands[1]
+
+	switch c := rhs; {
+	case isTimestampConst(c):
+		n, _ := timestampConst(c)
+		switch {
+		// x + d op n => x op n - d
+		case arith.Operator == Add && isDurationConst(b):
+			d, _ := durationConst(b)
+			return operator, a, mkTimestampOperand(n.Add(-d)), true
+		case arith.Operator == Add && isDurationConst(a):
+			d, _ := durationConst(a)
+			return operator, b, mkTimestampOperand(n.Add(-d)), true
+		// x - d op n => x op n + d
+		case arith.Operator == Sub && isDurationConst(b):
+			d, _ := durationConst(b)
+			return operator, a, mkTimestampOperand(n.Add(d)), true
+		}
+	case isDurationConst(c):
+		d, _ := durationConst(c)
+		switch {
+		// n - x op d => x mirror(op) n - d
+		case arith.Operator == Sub && isTimestampConst(a):
+			n, _ := timestampConst(a)
+			return mirrorComparison(operator), b, mkTimestampOperand(n.Add(-d)), true
+		// x - n op d => x op n + d
+		case arith.Operator == Sub && isTimestampConst(b):
+			n, _ := timestampConst(b)
+			return operator, a, mkTimestampOperand(n.Add(d)), true
+		// x + d1 op d => x op d - d1
+		case arith.Operator == Add && isDurationConst(b):
+			d1, _ := durationConst(b)
+			return operator, a, mkDurationOperand(d - d1), true
+		case arith.Operator == Add && isDurationConst(a):
+			d1, _ := durationConst(a)
+			return operator, b, mkDurationOperand(d - d1), true
+		// x - d1 op d => x op d + d1
+		case arith.Operator == Sub && isDurationConst(b):
+			d1, _ := durationConst(b)
+			return operator, a, mkDurationOperand(d + d1), true
+		}
+	}
+
+	return "", nil, nil, false
+}
+
+func isComparison(operator string) bool {
+	switch operator {
+	case Equals, NotEquals, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+// mirrorComparison returns the operator that gives the same result when the operands are swapped.
+func mirrorComparison(operator string) string {
+	switch operator {
+	case GreaterThan:
+		return LessThan
+	case GreaterThanOrEqual:
+		return LessThanOrEqual
+	case LessThan:
+		return GreaterThan
+	case LessThanOrEqual:
+		return GreaterThanOrEqual
+	default:
+		return operator
+	}
+}
+
+func isTimeConst(op *exprOp) bool {
+	return isTimestampConst(op) || isDurationConst(op)
+}
+
+func isTimestampConst(op *exprOp) bool {
+	_, ok := timestampConst(op)
+	return ok
+}
+
+func isDurationConst(op *exprOp) bool {
+	_, ok := durationConst(op)
+	return ok
+}
+
+// timestampConst returns the value of a `(timestamp "...")` expression with a literal RFC 3339 string.
+func timestampConst(op *exprOp) (time.Time, bool) {
+	s, ok := unaryStringConst(op, Timestamp)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// durationConst returns the value of a `(duration "...")` expression with a literal duration string.
+func durationConst(op *exprOp) (time.Duration, bool) {
+	s, ok := unaryStringConst(op, Duration)
+	if !ok {
+		return 0, false
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, false
+	}
+
+	return d, true
+}
+
+func unaryStringConst(op *exprOp, operator string) (string, bool) {
+	e := op.GetExpression()
+	if e == nil || e.Operator != operator || len(e.Operands) != 1 {
+		return "", false
+	}
+
+	v, ok := e.Operands[0].GetValue().GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return "", false
+	}
+
+	return v.StringValue, true
+}
+
+func mkTimestampOperand(t time.Time) *exprOp {
+	return &exprOp{Node: mkExprOpExpr(Timestamp, mkValueOperand(structpb.NewStringValue(t.UTC().Format(time.RFC3339Nano))))}
+}
+
+func mkDurationOperand(d time.Duration) *exprOp {
+	return &exprOp{Node: mkExprOpExpr(Duration, mkValueOperand(structpb.NewStringValue(formatDuration(d))))}
+}
+
+// formatDuration formats the duration as a number of seconds, which is the format used by CEL and protobuf.
+func formatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	secs, nanos := d/time.Second, d%time.Second
+	if nanos == 0 {
+		return fmt.Sprintf("%s%ds", sign, secs)
+	}
+
+	return fmt.Sprintf("%s%d.%ss", sign, secs, strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+}
@@ -0,0 +1,54 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+//go:embed testdata/normalise_operators.yaml
+var normaliseOperatorsBlob []byte
+
+func TestNormaliseOperators(t *testing.T) {
+	var testCases []struct {
+		Condition string `json:"condition"`
+		Filter    string `json:"filter"`
+	}
+	require.NoError(t, yaml.Unmarshal(normaliseOperatorsBlob, &testCases))
+
+	now := time.Date(2024, 1, 16, 10, 18, 27, 0, time.UTC)
+	evalCtx := &EvalContext{TimeFn: func() time.Time { return now }}
+	request := &enginev1.Request{
+		Principal: &enginev1.Request_Principal{Attr: map[string]*structpb.Value{"prefix": structpb.NewStringValue("ab")}},
+		Resource:  &enginev1.Request_Resource{},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Condition, func(t *testing.T) {
+			ast, iss := conditions.StdEnv.Compile(tc.Condition)
+			require.NoError(t, iss.Err())
+			checked, err := cel.AstToCheckedExpr(ast)
+			require.NoError(t, err)
+
+			condition := &runtimev1.Condition{Op: &runtimev1.Condition_Expr{Expr: &runtimev1.Expr{Original: tc.Condition, Checked: checked}}}
+			node, err := evalCtx.EvaluateCondition(t.Context(), condition, request, nil, nil, nil, nil)
+			require.NoError(t, err)
+
+			filter, err := ToFilter(node)
+			require.NoError(t, err)
+			require.Equal(t, tc.Filter, FilterToString(filter))
+		})
+	}
+}
@@ -4,7 +4,9 @@
 package ruletable
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"maps"
@@ -12,11 +14,14 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	celast "github.com/google/cel-go/common/ast"
 	"go.uber.org/multierr"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
@@ -38,6 +43,7 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/interpreter"
 )
 
 var _ evaluator.Evaluator = (*RuleTable)(nil)
@@ -45,6 +51,13 @@ var _ evaluator.Evaluator = (*RuleTable)(nil)
 const (
 	allowActionsIdxKey      = "\x00_cerbos_reserved_allow_actions"
 	conditionNotSatisfied   = "Condition not satisfied"
+	noMatchingDerivedRoles  = "No matching derived roles"
+	noMatchingRoles         = "No matching roles"
+	notEvaluated            = "Not evaluated"
+	requiresParentalConsent = "Requires parental consent"
+	ruleActivated           = "Rule activated"
+	scopePermissionsBlocked = "Blocked by scope permissions"
+	costLimitExceeded       = "Condition evaluation cost limit exceeded"
 	noMatchScopePermissions = "NO_MATCH_FOR_SCOPE_PERMISSIONS"
 	noPolicyMatch           = "NO_MATCH"
 )
@@ -125,14 +138,16 @@ func addPrincipalPolicy(rt *runtimev1.RuleTable, rpps *runtimev1.RunnablePrincip
 		// through). We do this by creating a noop row in the rule table which means we bypass the
 		// "policy does not exist in the scope" during evaluation.
 		res = append(res, &runtimev1.RuleTable_RuleRow{
-			OriginFqn:         rpps.Meta.Fqn,
-			Scope:             p.Scope,
-			ScopePermissions:  scopePermissions,
-			Version:           rpps.Meta.Version,
-			Principal:         principalID,
-			PolicyKind:        policyv1.Kind_KIND_PRINCIPAL,
-			Params:            &runtimev1.RuleTable_RuleRow_Params{},
-			DerivedRoleParams: &runtimev1.RuleTable_RuleRow_Params{},
+			OriginFqn:          rpps.Meta.Fqn,
+			Scope:              p.Scope,
+			ScopePermissions:   scopePermissions,
+			Version:            rpps.Meta.Version,
+			Principal:          principalID,
+			PolicyKind:         policyv1.Kind_KIND_PRINCIPAL,
+			Params:             &runtimev1.RuleTable_RuleRow_Params{},
+			DerivedRoleParams:  &runtimev1.RuleTable_RuleRow_Params{},
+			CombiningAlgorithm: p.CombiningAlgorithm,
+			ConditionCostLimit: p.ConditionCostLimit,
 		})
 	}
 
@@ -170,8 +185,11 @@ func addPrincipalPolicy(rt *runtimev1.RuleTable, rpps *runtimev1.RunnablePrincip
 					OrderedVariables: p.OrderedVariables,
 					Constants:        p.Constants,
 				},
-				EvaluationKey: evaluationKey,
-				PolicyKind:    policyv1.Kind_KIND_PRINCIPAL,
+				EvaluationKey:      evaluationKey,
+				PolicyKind:         policyv1.Kind_KIND_PRINCIPAL,
+				CombiningAlgorithm: p.CombiningAlgorithm,
+				ConditionCostLimit: p.ConditionCostLimit,
+				Ordinal:            rule.Ordinal,
 			}
 
 			if p.ScopePermissions == policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS &&
@@ -230,18 +248,20 @@ func addResourcePolicy(rt *runtimev1.RuleTable, rrps *runtimev1.RunnableResource
 		// through). We do this by creating a noop row in the rule table which means we bypass the
 		// "policy does not exist in the scope" during evaluation.
 		res = append(res, &runtimev1.RuleTable_RuleRow{
-			OriginFqn:         rrps.Meta.Fqn,
-			Resource:          sanitizedResource,
-			Scope:             p.Scope,
-			ScopePermissions:  scopePermissions,
-			Version:           rrps.Meta.Version,
-			PolicyKind:        policyv1.Kind_KIND_RESOURCE,
-			Params:            &runtimev1.RuleTable_RuleRow_Params{},
-			DerivedRoleParams: &runtimev1.RuleTable_RuleRow_Params{},
+			OriginFqn:          rrps.Meta.Fqn,
+			Resource:           sanitizedResource,
+			Scope:              p.Scope,
+			ScopePermissions:   scopePermissions,
+			Version:            rrps.Meta.Version,
+			PolicyKind:         policyv1.Kind_KIND_RESOURCE,
+			Params:             &runtimev1.RuleTable_RuleRow_Params{},
+			DerivedRoleParams:  &runtimev1.RuleTable_RuleRow_Params{},
+			CombiningAlgorithm: p.CombiningAlgorithm,
+			ConditionCostLimit: p.ConditionCostLimit,
 		})
 	}
 
-	for _, rule := range p.Rules {
+	for i, rule := range p.Rules {
 		emitOutput := rule.EmitOutput
 		if emitOutput == nil && rule.Output != nil { //nolint:staticcheck
 			emitOutput = &runtimev1.Output{
@@ -273,8 +293,11 @@ func addResourcePolicy(rt *runtimev1.RuleTable, rrps *runtimev1.RunnableResource
 						OrderedVariables: p.OrderedVariables,
 						Constants:        p.Constants,
 					},
-					EvaluationKey: evaluationKey,
-					PolicyKind:    policyv1.Kind_KIND_RESOURCE,
+					EvaluationKey:      evaluationKey,
+					PolicyKind:         policyv1.Kind_KIND_RESOURCE,
+					CombiningAlgorithm: p.CombiningAlgorithm,
+					ConditionCostLimit: p.ConditionCostLimit,
+					Ordinal:            uint32(i),
 				}
 
 				if p.ScopePermissions == policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS &&
@@ -322,8 +345,11 @@ func addResourcePolicy(rt *runtimev1.RuleTable, rrps *runtimev1.RunnableResource
 								OrderedVariables: rdr.OrderedVariables,
 								Constants:        rdr.Constants,
 							},
-							EvaluationKey: evaluationKey,
-							PolicyKind:    policyv1.Kind_KIND_RESOURCE,
+							EvaluationKey:      evaluationKey,
+							PolicyKind:         policyv1.Kind_KIND_RESOURCE,
+							CombiningAlgorithm: p.CombiningAlgorithm,
+							ConditionCostLimit: p.ConditionCostLimit,
+							Ordinal:            uint32(i),
 						}
 
 						if p.ScopePermissions == policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS &&
@@ -426,6 +452,8 @@ type RuleTable struct {
 	scopeScopePermissions map[string]policyv1.ScopePermissions
 	parentRoleAncestors   map[string]map[string][]string
 	policyDerivedRoles    map[namer.ModuleID]map[string]*WrappedRunnableDerivedRole
+	combiningAlgorithms   map[namer.ModuleID]policyv1.CombiningAlgorithm
+	conditionCostLimits   map[namer.ModuleID]uint32
 }
 
 type Row struct {
@@ -506,6 +534,8 @@ func (rt *RuleTable) init(protoRT *runtimev1.RuleTable) error {
 	clear(rt.resourceScopeMap)
 	clear(rt.scopeScopePermissions)
 	clear(rt.parentRoleAncestors)
+	clear(rt.combiningAlgorithms)
+	clear(rt.conditionCostLimits)
 
 	rt.primaryIdx = make(map[string]map[string]*util.GlobMap[*util.GlobMap[[]*Row]])
 	rt.policyDerivedRoles = make(map[namer.ModuleID]map[string]*WrappedRunnableDerivedRole)
@@ -513,6 +543,8 @@ func (rt *RuleTable) init(protoRT *runtimev1.RuleTable) error {
 	rt.resourceScopeMap = make(map[string]struct{})
 	rt.scopeScopePermissions = make(map[string]policyv1.ScopePermissions)
 	rt.parentRoleAncestors = make(map[string]map[string][]string)
+	rt.combiningAlgorithms = make(map[namer.ModuleID]policyv1.CombiningAlgorithm)
+	rt.conditionCostLimits = make(map[namer.ModuleID]uint32)
 
 	if err := rt.indexRules(rt.Rules); err != nil {
 		return err
@@ -534,17 +566,18 @@ func (rt *RuleTable) indexRules(rules []*runtimev1.RuleTable_RuleRow) error {
 		row := &Row{
 			RuleTable_RuleRow: rule,
 		}
+		costLimit := rt.conf.CostLimit(rule.ConditionCostLimit)
 
 		switch rule.PolicyKind { //nolint:exhaustive
 		case policyv1.Kind_KIND_RESOURCE:
 			if !rule.FromRolePolicy { //nolint:nestif
-				params, err := generateRowParams(rule.OriginFqn, rule.Params.OrderedVariables, rule.Params.Constants)
+				params, err := generateRowParams(rule.OriginFqn, rule.Params.OrderedVariables, rule.Params.Constants, costLimit)
 				if err != nil {
 					return err
 				}
 				row.Params = params
 				if rule.OriginDerivedRole != "" {
-					drParams, err := generateRowParams(namer.DerivedRolesFQN(rule.OriginDerivedRole), rule.DerivedRoleParams.OrderedVariables, rule.DerivedRoleParams.Constants)
+					drParams, err := generateRowParams(namer.DerivedRolesFQN(rule.OriginDerivedRole), rule.DerivedRoleParams.OrderedVariables, rule.DerivedRoleParams.Constants, costLimit)
 					if err != nil {
 						return err
 					}
@@ -566,7 +599,7 @@ func (rt *RuleTable) indexRules(rules []*runtimev1.RuleTable_RuleRow) error {
 				}
 			}
 		case policyv1.Kind_KIND_PRINCIPAL:
-			params, err := generateRowParams(rule.OriginFqn, rule.Params.OrderedVariables, rule.Params.Constants)
+			params, err := generateRowParams(rule.OriginFqn, rule.Params.OrderedVariables, rule.Params.Constants, costLimit)
 			if err != nil {
 				return err
 			}
@@ -579,8 +612,8 @@ func (rt *RuleTable) indexRules(rules []*runtimev1.RuleTable_RuleRow) error {
 	return nil
 }
 
-func generateRowParams(fqn string, orderedVariables []*runtimev1.Variable, constants map[string]*structpb.Value) (*rowParams, error) {
-	progs, err := getCelProgramsFromExpressions(orderedVariables)
+func generateRowParams(fqn string, orderedVariables []*runtimev1.Variable, constants map[string]*structpb.Value, costLimit uint64) (*rowParams, error) {
+	progs, err := getCelProgramsFromExpressions(orderedVariables, costLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -593,15 +626,16 @@ func generateRowParams(fqn string, orderedVariables []*runtimev1.Variable, const
 	}, nil
 }
 
-func getCelProgramsFromExpressions(vars []*runtimev1.Variable) ([]*CelProgram, error) {
+func getCelProgramsFromExpressions(vars []*runtimev1.Variable, costLimit uint64) ([]*CelProgram, error) {
 	progs := make([]*CelProgram, len(vars))
+	programOpts := append([]cel.ProgramOption{conditions.InterruptCheck()}, conditions.CostLimit(costLimit)...)
 
 	for i, v := range vars {
 		if v.Expr.Checked == nil {
 			continue
 		}
 
-		p, err := conditions.StdEnv.Program(cel.CheckedExprToAst(v.Expr.Checked))
+		p, err := conditions.StdEnv.Program(cel.CheckedExprToAst(v.Expr.Checked), programOpts...)
 		if err != nil {
 			return progs, err
 		}
@@ -617,6 +651,16 @@ func (rt *RuleTable) indexRule(r *Row) {
 		rt.scopeScopePermissions[r.Scope] = r.ScopePermissions
 	}
 
+	if !r.FromRolePolicy {
+		combiningAlgorithm := r.CombiningAlgorithm
+		if r.ScopePermissions == policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS {
+			// conditional ALLOWs in these scopes have been rewritten as DENYs, which only makes sense under deny-overrides
+			combiningAlgorithm = policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES
+		}
+		rt.combiningAlgorithms[namer.GenModuleIDFromFQN(r.OriginFqn)] = combiningAlgorithm
+		rt.conditionCostLimits[namer.GenModuleIDFromFQN(r.OriginFqn)] = r.ConditionCostLimit
+	}
+
 	switch r.PolicyKind { //nolint:exhaustive
 	case policyv1.Kind_KIND_PRINCIPAL:
 		rt.principalScopeMap[r.Scope] = struct{}{}
@@ -909,6 +953,42 @@ func (rt *RuleTable) GetRows(version, resource string, scopes, roles, actions []
 	return res
 }
 
+// rulesForOtherRoles returns the names of the resource policy rules in the scope that apply to the action but not to any of the roles.
+func (rt *RuleTable) rulesForOtherRoles(version, resource, scope, action string, roles map[string]struct{}) []string {
+	roleSet, ok := rt.primaryIdx[version][scope]
+	if !ok {
+		return nil
+	}
+
+	matched := make(map[string]struct{})
+	unmatched := make(map[string]struct{})
+	for _, actionSet := range roleSet.GetAll() {
+		for _, rows := range actionSet.GetMerged(action) {
+			for _, row := range rows {
+				if row.PolicyKind != policyv1.Kind_KIND_RESOURCE || row.FromRolePolicy || !util.MatchesGlob(row.Resource, resource) {
+					continue
+				}
+
+				if _, ok := roles[row.Role]; ok || row.Role == "*" {
+					matched[row.Name] = struct{}{}
+				} else {
+					unmatched[row.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(unmatched))
+	for name := range unmatched {
+		if _, ok := matched[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
 func (rt *RuleTable) GetParentRoles(scope string, roles []string) []string {
 	parentRoles := make([]string, len(roles))
 	copy(parentRoles, roles)
@@ -928,29 +1008,34 @@ func (rt *RuleTable) GetParentRoles(scope string, roles []string) []string {
 }
 
 func (rt *RuleTable) precompileParentRoles() {
-	for scope, parentRoles := range rt.ScopeParentRoles {
-		if parentRoles == nil {
-			continue
-		}
+	for scope := range rt.ScopeParentRoles {
+		rt.precompileScopeParentRoles(scope)
+	}
+}
 
-		if _, ok := rt.parentRoleAncestors[scope]; !ok {
-			rt.parentRoleAncestors[scope] = make(map[string][]string)
-		}
+func (rt *RuleTable) precompileScopeParentRoles(scope string) {
+	parentRoles := rt.ScopeParentRoles[scope]
+	if parentRoles == nil {
+		return
+	}
 
-		scopeCache := rt.parentRoleAncestors[scope]
+	if _, ok := rt.parentRoleAncestors[scope]; !ok {
+		rt.parentRoleAncestors[scope] = make(map[string][]string)
+	}
 
-		for role := range parentRoles.RoleParentRoles {
-			visited := make(map[string]struct{})
-			roleParentsSet := make(map[string]struct{})
-			rt.collectParentRoles(scope, role, roleParentsSet, visited)
+	scopeCache := rt.parentRoleAncestors[scope]
 
-			roleParents := make([]string, 0, len(roleParentsSet))
-			for rp := range roleParentsSet {
-				roleParents = append(roleParents, rp)
-			}
+	for role := range parentRoles.RoleParentRoles {
+		visited := make(map[string]struct{})
+		roleParentsSet := make(map[string]struct{})
+		rt.collectParentRoles(scope, role, roleParentsSet, visited)
 
-			scopeCache[role] = roleParents
+		roleParents := make([]string, 0, len(roleParentsSet))
+		for rp := range roleParentsSet {
+			roleParents = append(roleParents, rp)
 		}
+
+		scopeCache[role] = roleParents
 	}
 }
 
@@ -986,6 +1071,22 @@ func (rt *RuleTable) GetScopeScopePermissions(scope string) policyv1.ScopePermis
 	return rt.scopeScopePermissions[scope]
 }
 
+// GetCombiningAlgorithm returns the algorithm used to combine the effects of the rules of the given policy.
+// The second return value is false if the policy doesn't exist.
+func (rt *RuleTable) GetCombiningAlgorithm(fqn string) (policyv1.CombiningAlgorithm, bool) {
+	combiningAlgorithm, ok := rt.combiningAlgorithms[namer.GenModuleIDFromFQN(fqn)]
+	if !ok {
+		return policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES, false
+	}
+
+	return rt.conf.CombiningAlgorithm(combiningAlgorithm), true
+}
+
+// GetConditionCostLimit returns the maximum cost of evaluating a condition of the given policy. Zero means that there is no limit.
+func (rt *RuleTable) GetConditionCostLimit(fqn string) uint64 {
+	return rt.conf.CostLimit(rt.conditionCostLimits[namer.GenModuleIDFromFQN(fqn)])
+}
+
 func (rt *RuleTable) GetSchema(fqn string) *policyv1.Schemas {
 	modID := namer.GenModuleIDFromFQN(fqn)
 	if s, ok := rt.Schemas[modID.RawValue()]; ok {
@@ -1142,6 +1243,9 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 	// We use a compound key comprising the parameter origin and the rule FQN.
 	conditionCache := make(map[string]bool)
 
+	// candidate rows sorted in rule order, lazily created for policies using the firstApplicable combining algorithm
+	var orderedRows []*Row
+
 	processedScopedDerivedRoles := make(map[string]struct{})
 	policyTypes := []policyv1.Kind{policyv1.Kind_KIND_PRINCIPAL, policyv1.Kind_KIND_RESOURCE}
 	for _, action := range actionsToResolve {
@@ -1150,6 +1254,8 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 		var actionEffectInfo EffectInfo
 		var mainPolicyKey string
 		var scopes []string
+		var exceededCostLimit bool
+	policyTypesLoop:
 		for _, pt := range policyTypes {
 			if pt == policyv1.Kind_KIND_PRINCIPAL {
 				mainPolicyKey = principalPolicyKey
@@ -1184,6 +1290,9 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 
 				parentRoles := rt.GetParentRoles(input.Resource.Scope, []string{role})
 
+				// DENY from the innermost denyUnlessPermit policy that didn't allow the action, applied if none of the parent scopes allow it either
+				var denyUnlessPermitInfo *EffectInfo
+
 			scopesLoop:
 				for _, scope := range scopes {
 					sctx := actx.StartScope(scope)
@@ -1194,7 +1303,9 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 					if pt == policyv1.Kind_KIND_RESOURCE { //nolint:nestif
 						if _, ok := processedScopedDerivedRoles[scope]; !ok { //nolint:nestif
 							effectiveDerivedRoles := make(internal.StringSet)
-							if drs := rt.GetDerivedRoles(namer.ResourcePolicyFQN(input.Resource.Kind, resourceVersion, scope)); drs != nil {
+							resourcePolicyFQN := namer.ResourcePolicyFQN(input.Resource.Kind, resourceVersion, scope)
+							evalCtx.setCostLimit(rt.GetConditionCostLimit(resourcePolicyFQN))
+							if drs := rt.GetDerivedRoles(resourcePolicyFQN); drs != nil {
 								for name, dr := range drs {
 									drctx := tctx.StartPolicy(dr.OriginFqn).StartDerivedRole(name)
 									if !internal.SetIntersects(dr.ParentRoles, includingParentRoles) {
@@ -1209,6 +1320,11 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 									} else {
 										var err error
 										variables, err = evalCtx.evaluateVariables(ctx, drctx.StartVariables(), dr.Constants, dr.OrderedVariables)
+										if errors.Is(err, conditions.ErrCostLimitExceeded) {
+											// Rules that use the derived role evaluate it again, and deny the action when they exceed the limit
+											drctx.Failed(err, costLimitExceeded)
+											continue
+										}
 										if err != nil {
 											return nil, err
 										}
@@ -1218,6 +1334,9 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 									// we don't use `conditionCache` as we don't do any evaluations scoped solely to derived role conditions
 									ok, err := evalCtx.SatisfiesCondition(ctx, drctx.StartCondition(), dr.Condition, dr.Constants, variables)
 									if err != nil {
+										if errors.Is(err, conditions.ErrCostLimitExceeded) {
+											drctx.Failed(err, costLimitExceeded)
+										}
 										continue
 									}
 
@@ -1238,13 +1357,38 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 						break
 					}
 
+					var policyFQN string
+					if pt == policyv1.Kind_KIND_PRINCIPAL {
+						policyFQN = namer.PrincipalPolicyFQN(input.Principal.Id, principalVersion, scope)
+					} else {
+						policyFQN = namer.ResourcePolicyFQN(input.Resource.Kind, resourceVersion, scope)
+					}
+					combiningAlgorithm, policyExists := rt.GetCombiningAlgorithm(policyFQN)
+
+					// Rules for other roles are never candidates, so they have to be looked up separately to explain that they were skipped
+					if i == 0 && pt == policyv1.Kind_KIND_RESOURCE && sctx.Explaining() {
+						policyKey := namer.PolicyKeyFromFQN(policyFQN)
+						for _, name := range rt.rulesForOtherRoles(resourceVersion, sanitizedResource, scope, action, includingParentRoles) {
+							sctx.StartPolicy(policyKey).StartRule(name).Skipped(nil, noMatchingRoles)
+						}
+					}
+
+					scopeRows := candidateRows
+					if combiningAlgorithm == policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE {
+						if orderedRows == nil {
+							orderedRows = inRuleOrder(candidateRows)
+						}
+						scopeRows = orderedRows
+					}
+
 					// Only process rows that match the current policy type
-					for _, row := range candidateRows {
+					for _, row := range scopeRows {
 						if !row.Matches(pt, scope, action, input.Principal.Id, parentRoles) {
 							continue
 						}
 
-						rulectx := sctx.StartRule(row.Name)
+						rulectx := startRule(sctx, row)
+						evalCtx.setCostLimit(rt.conf.CostLimit(row.ConditionCostLimit))
 
 						if m := rt.GetMeta(row.OriginFqn); m != nil && m.GetSourceAttributes() != nil {
 							maps.Copy(result.auditTrail.EffectivePolicies, m.GetSourceAttributes())
@@ -1259,6 +1403,11 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 							} else {
 								var err error
 								variables, err = evalCtx.evaluateCELProgramsOrVariables(ctx, pctx, constants, row.Params.CelPrograms, row.Params.Variables)
+								if errors.Is(err, conditions.ErrCostLimitExceeded) {
+									actionEffectInfo = rt.denyForCostLimit(rulectx, result, row, evalCtx.costLimit, err)
+									exceededCostLimit = true
+									break policyTypesLoop
+								}
 								if err != nil {
 									pctx.Skipped(err, "Error evaluating variables")
 									return nil, err
@@ -1283,6 +1432,11 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 									} else {
 										var err error
 										derivedRoleVariables, err = evalCtx.evaluateCELProgramsOrVariables(ctx, drctx, derivedRoleConstants, row.DerivedRoleParams.CelPrograms, row.DerivedRoleParams.Variables)
+										if errors.Is(err, conditions.ErrCostLimitExceeded) {
+											actionEffectInfo = rt.denyForCostLimit(rulectx, result, row, evalCtx.costLimit, err)
+											exceededCostLimit = true
+											break policyTypesLoop
+										}
 										if err != nil {
 											drctx.Skipped(err, "Error evaluating derived role variables")
 											return nil, err
@@ -1295,20 +1449,34 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 								// confuse matters by adding condition trace logs if a rule is referencing a derived role, so we pass a no-op context here.
 								// TODO(saml) we could probably pre-compile the condition also
 								drSatisfied, err := evalCtx.SatisfiesCondition(ctx, tracing.StartTracer(nil), row.DerivedRoleCondition, derivedRoleConstants, derivedRoleVariables)
+								if errors.Is(err, conditions.ErrCostLimitExceeded) {
+									actionEffectInfo = rt.denyForCostLimit(rulectx, result, row, evalCtx.costLimit, err)
+									exceededCostLimit = true
+									break policyTypesLoop
+								}
 								if err != nil {
 									rulectx.Skipped(err, "Error evaluating derived role condition")
 									continue
 								}
 
+								if drctx.Explaining() {
+									drctx.ComputedBoolResult(drSatisfied, nil, "")
+								}
+
 								// terminate early if the derived role condition isn't satisfied, which is consistent with the pre-rule table implementation
 								if !drSatisfied {
-									rulectx.Skipped(err, "No matching derived roles")
+									rulectx.Skipped(err, noMatchingDerivedRoles)
 									conditionCache[row.EvaluationKey] = false
 									continue
 								}
 							}
 
 							isSatisfied, err := evalCtx.SatisfiesCondition(ctx, rulectx.StartCondition(), row.Condition, constants, variables)
+							if errors.Is(err, conditions.ErrCostLimitExceeded) {
+								actionEffectInfo = rt.denyForCostLimit(rulectx, result, row, evalCtx.costLimit, err)
+								exceededCostLimit = true
+								break policyTypesLoop
+							}
 							if err != nil {
 								rulectx.Skipped(err, "Error evaluating condition")
 								continue
@@ -1319,6 +1487,14 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 						}
 
 						if satisfiesCondition { //nolint:nestif
+							if rulectx.Explaining() {
+								if row.FromRolePolicy && row.Effect == effectv1.Effect_EFFECT_DENY {
+									rulectx.AppliedEffect(row.Effect, scopePermissionsBlocked)
+								} else {
+									rulectx.AppliedEffect(row.Effect, ruleActivated)
+								}
+							}
+
 							var outputExpr *exprpb.CheckedExpr
 							if row.EmitOutput != nil && row.EmitOutput.When != nil && row.EmitOutput.When.RuleActivated != nil {
 								outputExpr = row.EmitOutput.When.RuleActivated.Checked
@@ -1336,17 +1512,27 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 
 							roleEffectSet[row.Effect] = struct{}{}
 							if row.Effect == effectv1.Effect_EFFECT_DENY {
+								if !row.FromRolePolicy && allowsOverrideDenies(combiningAlgorithm) {
+									// The DENY only applies if none of the ALLOW rules match, which is decided once all rows have been evaluated
+									continue
+								}
+
 								roleEffectInfo.Effect = effectv1.Effect_EFFECT_DENY
 								roleEffectInfo.Scope = scope
 								if row.FromRolePolicy {
 									// Implicit DENY generated as a result of no matching role policy action
 									// needs to be attributed to said role policy
 									roleEffectInfo.Policy = namer.PolicyKeyFromFQN(row.OriginFqn)
+								} else {
+									roleEffectInfo.CombiningAlgorithm = combiningAlgorithm
 								}
 								break scopesLoop
 							} else if row.NoMatchForScopePermissions {
 								roleEffectInfo.Policy = noMatchScopePermissions
 								roleEffectInfo.Scope = scope
+							} else if !row.FromRolePolicy && combiningAlgorithm == policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE {
+								// The first matching rule decides the effect, so the remaining rules are irrelevant
+								break
 							}
 						} else {
 							if row.EmitOutput != nil && row.EmitOutput.When != nil && row.EmitOutput.When.ConditionNotMet != nil {
@@ -1366,14 +1552,35 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 						switch rt.GetScopeScopePermissions(scope) { //nolint:exhaustive
 						case policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS:
 							delete(roleEffectSet, effectv1.Effect_EFFECT_ALLOW)
+							if sctx.Explaining() {
+								sctx.Skipped(nil, requiresParentalConsent)
+							}
 						case policyv1.ScopePermissions_SCOPE_PERMISSIONS_OVERRIDE_PARENT:
 							roleEffectInfo.Effect = effectv1.Effect_EFFECT_ALLOW
 							roleEffectInfo.Scope = scope
+							roleEffectInfo.CombiningAlgorithm = combiningAlgorithm
 							break scopesLoop
 						}
+					} else if _, hasDeny := roleEffectSet[effectv1.Effect_EFFECT_DENY]; hasDeny {
+						// A DENY deferred by permitOverrides or denyUnlessPermit
+						roleEffectInfo.Effect = effectv1.Effect_EFFECT_DENY
+						roleEffectInfo.Scope = scope
+						roleEffectInfo.CombiningAlgorithm = combiningAlgorithm
+						break scopesLoop
+					} else if denyUnlessPermitInfo == nil && policyExists && combiningAlgorithm == policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT {
+						denyUnlessPermitInfo = &EffectInfo{
+							Effect:             effectv1.Effect_EFFECT_DENY,
+							Policy:             roleEffectInfo.Policy,
+							Scope:              scope,
+							CombiningAlgorithm: combiningAlgorithm,
+						}
 					}
 				}
 
+				if roleEffectInfo.Effect == effectv1.Effect_EFFECT_NO_MATCH && denyUnlessPermitInfo != nil {
+					roleEffectInfo = *denyUnlessPermitInfo
+				}
+
 				// Match the first result
 				if actionEffectInfo.Effect == effectv1.Effect_EFFECT_NO_MATCH {
 					actionEffectInfo = roleEffectInfo
@@ -1402,16 +1609,95 @@ func (rt *RuleTable) check(ctx context.Context, tctx tracer.Context, evalParams
 		}
 
 		result.setEffect(action, actionEffectInfo)
-		actx.AppliedEffect(actionEffectInfo.Effect, "")
+		if exceededCostLimit {
+			actx.AppliedEffect(actionEffectInfo.Effect, costLimitExceeded)
+		} else {
+			actx.AppliedEffect(actionEffectInfo.Effect, combiningAlgorithmMessage(actionEffectInfo.CombiningAlgorithm))
+		}
 	}
 
 	return result, nil
 }
 
+// denyForCostLimit records that evaluating an expression of the rule exceeded the cost limit. The action is denied regardless of
+// the effects of the other rules, because the outcome of the policy can't be determined.
+func (rt *RuleTable) denyForCostLimit(tctx tracer.Context, result *policyEvalResult, row *Row, limit uint64, err error) EffectInfo {
+	tctx.Failed(err, costLimitExceeded)
+	result.addValidationError(&schemav1.ValidationError{
+		Path:    namer.RuleFQN(rt.GetMeta(row.OriginFqn), row.Scope, row.Name),
+		Message: fmt.Sprintf("condition evaluation exceeded the cost limit of %d", limit),
+		Source:  schemav1.ValidationError_SOURCE_POLICY,
+	})
+
+	return EffectInfo{
+		Effect: effectv1.Effect_EFFECT_DENY,
+		Policy: namer.PolicyKeyFromFQN(row.OriginFqn),
+		Scope:  row.Scope,
+	}
+}
+
+// startRule starts the trace of a rule. Explanations need to know which policy the rule belongs to, so the policy is
+// added to the trace when explaining.
+func startRule(sctx tracer.Context, row *Row) tracer.Context {
+	if sctx.Explaining() {
+		return sctx.StartPolicy(namer.PolicyKeyFromFQN(row.OriginFqn)).StartRule(row.Name)
+	}
+
+	return sctx.StartRule(row.Name)
+}
+
+// allowsOverrideDenies returns true if a matching ALLOW rule takes precedence over matching DENY rules under the algorithm.
+func allowsOverrideDenies(combiningAlgorithm policyv1.CombiningAlgorithm) bool {
+	return combiningAlgorithm == policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_PERMIT_OVERRIDES ||
+		combiningAlgorithm == policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_UNLESS_PERMIT
+}
+
+// inRuleOrder returns a copy of the rows sorted in the order the rules are defined in their policies.
+// Rows generated from role policies are placed first because they are restrictions that apply regardless of the rule order.
+func inRuleOrder(rows []*Row) []*Row {
+	ordered := slices.Clone(rows)
+	slices.SortStableFunc(ordered, func(a, b *Row) int {
+		if a.FromRolePolicy != b.FromRolePolicy {
+			if a.FromRolePolicy {
+				return -1
+			}
+			return 1
+		}
+
+		return cmp.Compare(a.Ordinal, b.Ordinal)
+	})
+
+	return ordered
+}
+
+// orNodes returns the disjunction of two nodes, either of which may be nil.
+func orNodes(a, b *planner.QpN) *planner.QpN {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	default:
+		return planner.MkOrNode([]*planner.QpN{a, b})
+	}
+}
+
+// combiningAlgorithmMessage describes the algorithm that decided the effect for the engine trace.
+// The default deny-overrides behaviour is not called out to keep traces of existing policies unchanged.
+func combiningAlgorithmMessage(combiningAlgorithm policyv1.CombiningAlgorithm) string {
+	switch combiningAlgorithm { //nolint:exhaustive
+	case policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_UNSPECIFIED, policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_DENY_OVERRIDES:
+		return ""
+	default:
+		return fmt.Sprintf("Decided by the %s combining algorithm", evaluator.CombiningAlgorithmName(combiningAlgorithm))
+	}
+}
+
 type EffectInfo struct {
-	Policy string
-	Scope  string
-	Effect effectv1.Effect
+	Policy             string
+	Scope              string
+	Effect             effectv1.Effect
+	CombiningAlgorithm policyv1.CombiningAlgorithm
 }
 
 type policyEvalResult struct {
@@ -1474,6 +1760,15 @@ func (er *policyEvalResult) setEffect(action string, effect EffectInfo) {
 	}
 }
 
+// addValidationError adds the error to the result unless it has already been reported for another action.
+func (er *policyEvalResult) addValidationError(err *schemav1.ValidationError) {
+	if slices.ContainsFunc(er.validationErrors, func(e *schemav1.ValidationError) bool { return proto.Equal(e, err) }) {
+		return
+	}
+
+	er.validationErrors = append(er.validationErrors, err)
+}
+
 func newAuditTrail(srcAttr map[string]*policyv1.SourceAttributes) *auditv1.AuditTrail {
 	return &auditv1.AuditTrail{EffectivePolicies: maps.Clone(srcAttr)}
 }
@@ -1503,6 +1798,7 @@ type EvalContext struct {
 	runtime               *enginev1.Runtime
 	effectiveDerivedRoles internal.StringSet
 	evaluator.EvalParams
+	costLimit uint64
 }
 
 func NewEvalContext(ep evaluator.EvalParams, request *enginev1.Request) *EvalContext {
@@ -1517,9 +1813,21 @@ func (ec *EvalContext) withEffectiveDerivedRoles(effectiveDerivedRoles internal.
 		EvalParams:            ec.EvalParams,
 		request:               ec.request,
 		effectiveDerivedRoles: effectiveDerivedRoles,
+		costLimit:             ec.costLimit,
 	}
 }
 
+// setCostLimit sets the maximum cost of evaluating each expression. It must be called before evaluating expressions from a different policy.
+func (ec *EvalContext) setCostLimit(limit uint64) {
+	ec.costLimit = limit
+}
+
+// programOptions returns the options for evaluating expressions in this context.
+func (ec *EvalContext) programOptions(ctx context.Context, opts ...cel.ProgramOption) []cel.ProgramOption {
+	opts = append(opts, conditions.WithRelations(ctx, ec.Relations))
+	return append(opts, conditions.CostLimit(ec.costLimit)...)
+}
+
 func (ec *EvalContext) lazyRuntime() any { // We have to return `any` rather than `*enginev1.Runtime` here to be able to use this function as a lazy binding in the CEL evaluator.
 	if ec.runtime == nil {
 		ec.runtime = &enginev1.Runtime{}
@@ -1533,10 +1841,10 @@ func (ec *EvalContext) lazyRuntime() any { // We have to return `any` rather tha
 }
 
 func (ec *EvalContext) evaluateCELProgramsOrVariables(ctx context.Context, tctx tracer.Context, constants map[string]any, celPrograms []*CelProgram, variables []*runtimev1.Variable) (map[string]any, error) {
-	// if nowFunc is provided, we need to recompute the cel.Program to handle the custom time decorator, otherwise we can reuse the precomputed program
-	// from build-time.
-	if ec.NowFunc == nil {
-		return ec.evaluatePrograms(constants, celPrograms)
+	// if nowFunc or a relation checker is provided, we need to recompute the cel.Program to handle the custom decorators, otherwise we can reuse
+	// the precomputed program from build-time.
+	if ec.NowFunc == nil && ec.Relations == nil {
+		return ec.evaluatePrograms(ctx, constants, celPrograms)
 	}
 
 	return ec.evaluateVariables(ctx, tctx.StartVariables(), constants, variables)
@@ -1576,12 +1884,12 @@ func (ec *EvalContext) buildEvalVars(constants, variables map[string]any) map[st
 	}
 }
 
-func (ec *EvalContext) evaluatePrograms(constants map[string]any, celPrograms []*CelProgram) (map[string]any, error) {
+func (ec *EvalContext) evaluatePrograms(ctx context.Context, constants map[string]any, celPrograms []*CelProgram) (map[string]any, error) {
 	var errs error
 
 	evalVars := make(map[string]any, len(celPrograms))
 	for _, prg := range celPrograms {
-		result, _, err := prg.Prog.Eval(ec.buildEvalVars(constants, evalVars))
+		result, _, err := conditions.ProgramContextEval(ctx, prg.Prog, ec.buildEvalVars(constants, evalVars))
 		if err != nil {
 			errs = multierr.Append(errs, fmt.Errorf("error evaluating `%s`: %w", prg.Name, err))
 			continue
@@ -1602,7 +1910,13 @@ func (ec *EvalContext) SatisfiesCondition(ctx context.Context, tctx tracer.Conte
 	switch t := cond.Op.(type) {
 	case *runtimev1.Condition_Expr:
 		ectx := tctx.StartExpr(t.Expr.Original)
-		val, err := ec.evaluateBoolCELExpr(ctx, t.Expr.Checked, constants, variables)
+		var val bool
+		var err error
+		if ectx.Explaining() {
+			val, err = ec.explainBoolCELExpr(ctx, ectx, t.Expr.Checked, constants, variables)
+		} else {
+			val, err = ec.evaluateBoolCELExpr(ctx, t.Expr.Checked, constants, variables)
+		}
 		if err != nil {
 			ectx.ComputedBoolResult(false, err, "Failed to evaluate expression")
 			return false, fmt.Errorf("failed to evaluate `%s`: %w", t.Expr.Original, err)
@@ -1690,6 +2004,83 @@ func (ec *EvalContext) evaluateBoolCELExpr(ctx context.Context, expr *exprpb.Che
 	return boolVal, nil
 }
 
+// explainBoolCELExpr is the equivalent of evaluateBoolCELExpr that adds the values of the sub-expressions to the trace.
+func (ec *EvalContext) explainBoolCELExpr(ctx context.Context, tctx tracer.Context, expr *exprpb.CheckedExpr, constants, variables map[string]any) (bool, error) {
+	ast, err := celast.ToAST(expr)
+	if err != nil {
+		return false, err
+	}
+
+	result, details, err := conditions.ContextEval(ctx, conditions.StdEnv, ast, ec.buildEvalVars(constants, variables), ec.NowFunc, ec.programOptions(ctx, cel.EvalOptions(cel.OptTrackState))...)
+	if details != nil {
+		traceOperands(tctx, ast.Expr(), ast.SourceInfo(), details.State())
+	}
+
+	if err != nil {
+		// ignore expressions that are invalid
+		if types.IsError(result) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	boolVal, ok := result.Value().(bool)
+	return ok && boolVal, nil
+}
+
+// traceOperands adds the values of the operands of the function calls in the expression to the trace.
+func traceOperands(tctx tracer.Context, expr celast.Expr, info *celast.SourceInfo, state interpreter.EvalState) {
+	if expr.Kind() != celast.CallKind {
+		return
+	}
+
+	call := expr.AsCall()
+	operands := call.Args()
+	if call.IsMemberFunction() {
+		operands = append([]celast.Expr{call.Target()}, operands...)
+	}
+
+	for _, operand := range operands {
+		if operand.Kind() == celast.LiteralKind {
+			continue
+		}
+
+		src, err := cel.ExprToString(operand, info)
+		if err != nil {
+			continue
+		}
+
+		octx := tctx.StartExpr(src)
+		val, ok := state.Value(operand.ID())
+		switch {
+		case !ok:
+			octx.Skipped(nil, notEvaluated)
+			continue
+		case types.IsError(val):
+			evalErr, _ := val.Value().(error)
+			octx.Failed(evalErr, "Failed to evaluate expression")
+		default:
+			octx.ComputedResult(celValueToNative(val))
+		}
+
+		traceOperands(octx, operand, info, state)
+	}
+}
+
+func celValueToNative(val ref.Val) any {
+	pbVal, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return val.Value()
+	}
+
+	if v, ok := pbVal.(*structpb.Value); ok {
+		return v.AsInterface()
+	}
+
+	return val.Value()
+}
+
 func (ec *EvalContext) evaluateProtobufValueCELExpr(ctx context.Context, expr *exprpb.CheckedExpr, constants, variables map[string]any) *structpb.Value {
 	result, err := ec.evaluateCELExpr(ctx, expr, constants, variables)
 	if err != nil {
@@ -1723,7 +2114,7 @@ func (ec *EvalContext) evaluateCELExpr(ctx context.Context, expr *exprpb.Checked
 	if err != nil {
 		return nil, err
 	}
-	result, _, err := conditions.ContextEval(ctx, conditions.StdEnv, ast, ec.buildEvalVars(constants, variables), ec.NowFunc)
+	result, _, err := conditions.ContextEval(ctx, conditions.StdEnv, ast, ec.buildEvalVars(constants, variables), ec.NowFunc, ec.programOptions(ctx)...)
 	if err != nil {
 		// ignore expressions that are invalid
 		if types.IsError(result) {
@@ -1770,7 +2161,12 @@ func (rt *RuleTable) planWithAuditTrail(ctx context.Context, input *enginev1.Pla
 	resourceScopes, _, _ := rt.GetAllScopes(policy.ResourceKind, input.Resource.Scope, input.Resource.Kind, resourceVersion)
 
 	request := planner.PlanResourcesInputToRequest(input)
-	evalCtx := &planner.EvalContext{TimeFn: nowFunc}
+	// Record the time seen by `now()` and `timeSince()` so that callers can tell which time the filter was produced for.
+	var now time.Time
+	evalCtx := &planner.EvalContext{TimeFn: func() time.Time {
+		now = nowFunc()
+		return now
+	}}
 
 	effectivePolicies := make(map[string]*policyv1.SourceAttributes)
 	auditTrail := &auditv1.AuditTrail{EffectivePolicies: effectivePolicies}
@@ -1808,6 +2204,18 @@ func (rt *RuleTable) planWithAuditTrail(ctx context.Context, input *enginev1.Pla
 		includingParentRoles[r] = struct{}{}
 	}
 
+	pc := &planContext{
+		rt:                rt,
+		evalCtx:           evalCtx,
+		request:           request,
+		globals:           globals,
+		effectivePolicies: effectivePolicies,
+		resourceVersion:   resourceVersion,
+		principalVersion:  principalVersion,
+		scopes:            scopes,
+		candidateRows:     candidateRows,
+	}
+
 	policyMatch := false
 	for _, action := range input.Actions {
 		matchedScopes[action] = ""
@@ -1827,161 +2235,18 @@ func (rt *RuleTable) planWithAuditTrail(ctx context.Context, input *enginev1.Pla
 					break
 				}
 
-				var roleAllowNode, roleDenyNode *planner.QpN
-				var pendingAllow bool
-
 				parentRoles := rt.GetParentRoles(input.Resource.Scope, []string{role})
-
-				for _, scope := range scopes {
-					var scopeAllowNode, scopeDenyNode *planner.QpN
-
-					derivedRolesList := planner.MkDerivedRolesList(nil)
-					if pt == policyv1.Kind_KIND_RESOURCE { //nolint:nestif
-						if c, ok := scopedDerivedRolesList[scope]; ok {
-							derivedRolesList = c
-						} else {
-							var derivedRoles []planner.RN
-							if drs := rt.GetDerivedRoles(namer.ResourcePolicyFQN(input.Resource.Kind, resourceVersion, scope)); drs != nil {
-								for name, dr := range drs {
-									if !internal.SetIntersects(dr.ParentRoles, includingParentRoles) {
-										continue
-									}
-
-									var err error
-									variables, err := planner.VariableExprs(dr.OrderedVariables)
-									if err != nil {
-										return nil, auditTrail, err
-									}
-
-									node, err := evalCtx.EvaluateCondition(ctx, dr.Condition, request, globals, dr.Constants, variables, derivedRolesList)
-									if err != nil {
-										return nil, auditTrail, err
-									}
-
-									derivedRoles = append(derivedRoles, planner.RN{
-										Node: func() (*enginev1.PlanResourcesAst_Node, error) {
-											return node, nil
-										},
-										Role: name,
-									})
-								}
-							}
-
-							sort.Slice(derivedRoles, func(i, j int) bool {
-								return derivedRoles[i].Role < derivedRoles[j].Role
-							})
-
-							derivedRolesList = planner.MkDerivedRolesList(derivedRoles)
-
-							scopedDerivedRolesList[scope] = derivedRolesList
-						}
-					}
-
-					for _, row := range candidateRows {
-						if ok := row.Matches(pt, scope, action, input.Principal.Id, parentRoles); !ok {
-							continue
-						}
-
-						if m := rt.GetMeta(row.OriginFqn); m != nil && m.GetSourceAttributes() != nil {
-							maps.Copy(effectivePolicies, m.GetSourceAttributes())
-						}
-
-						var constants map[string]any
-						var variables map[string]celast.Expr
-						if row.Params != nil {
-							constants = row.Params.Constants
-							var err error
-							variables, err = planner.VariableExprs(row.Params.Variables)
-							if err != nil {
-								return nil, auditTrail, err
-							}
-						}
-
-						node, err := evalCtx.EvaluateCondition(ctx, row.Condition, request, globals, constants, variables, derivedRolesList)
-						if err != nil {
-							return nil, auditTrail, err
-						}
-
-						if row.DerivedRoleCondition != nil { //nolint:nestif
-							var variables map[string]celast.Expr
-							if row.DerivedRoleParams != nil {
-								var err error
-								variables, err = planner.VariableExprs(row.DerivedRoleParams.Variables)
-								if err != nil {
-									return nil, auditTrail, err
-								}
-							}
-
-							drNode, err := evalCtx.EvaluateCondition(ctx, row.DerivedRoleCondition, request, globals, row.DerivedRoleParams.Constants, variables, derivedRolesList)
-							if err != nil {
-								return nil, auditTrail, err
-							}
-
-							if row.Condition == nil {
-								node = drNode
-							} else {
-								node = planner.MkNodeFromLO(planner.MkAndLogicalOperation([]*planner.QpN{node, drNode}))
-							}
-						}
-
-						switch row.Effect { //nolint:exhaustive
-						case effectv1.Effect_EFFECT_ALLOW:
-							if scopeAllowNode == nil {
-								scopeAllowNode = node
-							} else {
-								scopeAllowNode = planner.MkOrNode([]*planner.QpN{scopeAllowNode, node})
-							}
-						case effectv1.Effect_EFFECT_DENY:
-							// ignore constant false DENY nodes
-							if b, ok := planner.IsNodeConstBool(node); ok && !b {
-								continue
-							}
-							if scopeDenyNode == nil {
-								scopeDenyNode = node
-							} else {
-								scopeDenyNode = planner.MkOrNode([]*planner.QpN{scopeDenyNode, node})
-							}
-						}
-					}
-
-					if scopeDenyNode != nil {
-						if roleDenyNode == nil {
-							roleDenyNode = scopeDenyNode
-						} else {
-							roleDenyNode = planner.MkOrNode([]*planner.QpN{roleDenyNode, scopeDenyNode})
-						}
-					}
-
-					if scopeAllowNode != nil { //nolint:nestif
-						if roleAllowNode == nil {
-							roleAllowNode = scopeAllowNode
-						} else {
-							if pendingAllow {
-								roleAllowNode = planner.MkAndNode([]*planner.QpN{roleAllowNode, scopeAllowNode})
-								pendingAllow = false
-							} else {
-								roleAllowNode = planner.MkOrNode([]*planner.QpN{roleAllowNode, scopeAllowNode})
-							}
-						}
-
-						if rt.GetScopeScopePermissions(scope) == policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS {
-							pendingAllow = true
-						}
-					}
-
-					if (scopeDenyNode != nil || scopeAllowNode != nil) &&
-						rt.GetScopeScopePermissions(scope) == policyv1.ScopePermissions_SCOPE_PERMISSIONS_OVERRIDE_PARENT {
-						matchedScopes[action] = scope
-						break
-					}
+				rp, err := pc.planRole(ctx, pt, action, input.Principal.Id, parentRoles, includingParentRoles, scopedDerivedRolesList)
+				if err != nil {
+					return nil, auditTrail, err
 				}
 
-				// only an ALLOW from a scope with ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS exists with no
-				// matching rules in the parent scopes, therefore null the node
-				if pendingAllow {
-					roleAllowNode = nil
+				if rp.scopeMatched {
+					matchedScopes[action] = rp.matchedScope
 				}
 
+				roleAllowNode, roleDenyNode := rp.allow, rp.deny
+
 				// Const DENY overrides any ALLOW in the same role
 				if b, ok := planner.IsNodeConstBool(roleDenyNode); ok && b {
 					// Roles are evaluated independently, therefore an ALLOW for one role needs to override a DENY for another.
@@ -2064,9 +2329,249 @@ func (rt *RuleTable) planWithAuditTrail(ctx context.Context, input *enginev1.Pla
 	if err != nil {
 		return nil, nil, err
 	}
+	if input.FilterOptions != nil {
+		if output.Filter, err = planner.ApplyFilterOptions(output.Filter, input.FilterOptions); err != nil {
+			return nil, nil, err
+		}
+		output.FilterDebug = planner.FilterToString(output.Filter)
+	}
 	if !policyMatch {
 		output.FilterDebug = noPolicyMatch
 	}
+	if !now.IsZero() {
+		output.Now = timestamppb.New(now)
+	}
 
 	return output, auditTrail, nil
 }
+
+// planContext holds the state that is shared by the evaluations of the rows for each role when producing a query plan.
+type planContext struct {
+	rt                *RuleTable
+	evalCtx           *planner.EvalContext
+	request           *enginev1.Request
+	globals           map[string]any
+	effectivePolicies map[string]*policyv1.SourceAttributes
+	resourceVersion   string
+	principalVersion  string
+	scopes            []string
+	candidateRows     []*Row
+	// orderedRows are the candidate rows sorted in rule order, lazily created for policies using the firstApplicable combining algorithm.
+	orderedRows []*Row
+}
+
+// rolePlan holds the ALLOW and DENY nodes of the rows that apply to a role.
+type rolePlan struct {
+	allow *planner.QpN
+	deny  *planner.QpN
+	// matchedScope is the scope at which evaluation stopped because it overrides its parents. It is only set if scopeMatched is true.
+	matchedScope string
+	scopeMatched bool
+}
+
+// planRole evaluates the rows of the policy kind that apply to the action for a principal with the given ID and roles.
+func (pc *planContext) planRole(ctx context.Context, pt policyv1.Kind, action, principalID string, parentRoles []string, includingParentRoles map[string]struct{}, scopedDerivedRolesList map[string]func() (*exprpb.Expr, error)) (rolePlan, error) {
+	var rp rolePlan
+	var pendingAllow bool
+
+	for _, scope := range pc.scopes {
+		var scopeAllowNode, scopeDenyNode *planner.QpN
+
+		derivedRolesList := planner.MkDerivedRolesList(nil)
+		if pt == policyv1.Kind_KIND_RESOURCE { //nolint:nestif
+			if c, ok := scopedDerivedRolesList[scope]; ok {
+				derivedRolesList = c
+			} else {
+				var derivedRoles []planner.RN
+				if drs := pc.rt.GetDerivedRoles(namer.ResourcePolicyFQN(pc.request.Resource.Kind, pc.resourceVersion, scope)); drs != nil {
+					for name, dr := range drs {
+						if !internal.SetIntersects(dr.ParentRoles, includingParentRoles) {
+							continue
+						}
+
+						var err error
+						variables, err := planner.VariableExprs(dr.OrderedVariables)
+						if err != nil {
+							return rp, err
+						}
+
+						node, err := pc.evalCtx.EvaluateCondition(ctx, dr.Condition, pc.request, pc.globals, dr.Constants, variables, derivedRolesList)
+						if err != nil {
+							return rp, err
+						}
+
+						derivedRoles = append(derivedRoles, planner.RN{
+							Node: func() (*enginev1.PlanResourcesAst_Node, error) {
+								return node, nil
+							},
+							Role: name,
+						})
+					}
+				}
+
+				sort.Slice(derivedRoles, func(i, j int) bool {
+					return derivedRoles[i].Role < derivedRoles[j].Role
+				})
+
+				derivedRolesList = planner.MkDerivedRolesList(derivedRoles)
+
+				scopedDerivedRolesList[scope] = derivedRolesList
+			}
+		}
+
+		var policyFQN string
+		if pt == policyv1.Kind_KIND_PRINCIPAL {
+			policyFQN = namer.PrincipalPolicyFQN(principalID, pc.principalVersion, scope)
+		} else {
+			policyFQN = namer.ResourcePolicyFQN(pc.request.Resource.Kind, pc.resourceVersion, scope)
+		}
+		combiningAlgorithm, _ := pc.rt.GetCombiningAlgorithm(policyFQN)
+
+		scopeRows := pc.candidateRows
+		if combiningAlgorithm == policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE {
+			if pc.orderedRows == nil {
+				pc.orderedRows = inRuleOrder(pc.candidateRows)
+			}
+			scopeRows = pc.orderedRows
+		}
+
+		// precedingNode is the disjunction of the conditions of the rules preceding the current one (firstApplicable)
+		// and deferredDenyNode is the disjunction of the DENY conditions that can be overridden by an ALLOW.
+		var precedingNode, deferredDenyNode *planner.QpN
+		for _, row := range scopeRows {
+			if ok := row.Matches(pt, scope, action, principalID, parentRoles); !ok {
+				continue
+			}
+
+			if m := pc.rt.GetMeta(row.OriginFqn); m != nil && m.GetSourceAttributes() != nil {
+				maps.Copy(pc.effectivePolicies, m.GetSourceAttributes())
+			}
+
+			var constants map[string]any
+			var variables map[string]celast.Expr
+			if row.Params != nil {
+				constants = row.Params.Constants
+				var err error
+				variables, err = planner.VariableExprs(row.Params.Variables)
+				if err != nil {
+					return rp, err
+				}
+			}
+
+			node, err := pc.evalCtx.EvaluateCondition(ctx, row.Condition, pc.request, pc.globals, constants, variables, derivedRolesList)
+			if err != nil {
+				return rp, err
+			}
+
+			if row.DerivedRoleCondition != nil { //nolint:nestif
+				var variables map[string]celast.Expr
+				if row.DerivedRoleParams != nil {
+					var err error
+					variables, err = planner.VariableExprs(row.DerivedRoleParams.Variables)
+					if err != nil {
+						return rp, err
+					}
+				}
+
+				drNode, err := pc.evalCtx.EvaluateCondition(ctx, row.DerivedRoleCondition, pc.request, pc.globals, row.DerivedRoleParams.Constants, variables, derivedRolesList)
+				if err != nil {
+					return rp, err
+				}
+
+				if row.Condition == nil {
+					node = drNode
+				} else {
+					node = planner.MkNodeFromLO(planner.MkAndLogicalOperation([]*planner.QpN{node, drNode}))
+				}
+			}
+
+			if !row.FromRolePolicy && combiningAlgorithm == policyv1.CombiningAlgorithm_COMBINING_ALGORITHM_FIRST_APPLICABLE {
+				// a rule only applies if none of the preceding rules do
+				if b, ok := planner.IsNodeConstBool(precedingNode); ok && b {
+					break
+				}
+
+				if b, ok := planner.IsNodeConstBool(node); ok && !b {
+					continue
+				}
+
+				matched := node
+				if precedingNode != nil {
+					node = planner.MkAndNode([]*planner.QpN{node, planner.InvertNodeBooleanValue(precedingNode)})
+				}
+				precedingNode = orNodes(precedingNode, matched)
+			}
+
+			switch row.Effect { //nolint:exhaustive
+			case effectv1.Effect_EFFECT_ALLOW:
+				if scopeAllowNode == nil {
+					scopeAllowNode = node
+				} else {
+					scopeAllowNode = planner.MkOrNode([]*planner.QpN{scopeAllowNode, node})
+				}
+			case effectv1.Effect_EFFECT_DENY:
+				// ignore constant false DENY nodes
+				if b, ok := planner.IsNodeConstBool(node); ok && !b {
+					continue
+				}
+				if !row.FromRolePolicy && allowsOverrideDenies(combiningAlgorithm) {
+					deferredDenyNode = orNodes(deferredDenyNode, node)
+					continue
+				}
+				if scopeDenyNode == nil {
+					scopeDenyNode = node
+				} else {
+					scopeDenyNode = planner.MkOrNode([]*planner.QpN{scopeDenyNode, node})
+				}
+			}
+		}
+
+		// permitOverrides and denyUnlessPermit: a DENY only applies if none of the ALLOW rules do.
+		// Anything else that denyUnlessPermit doesn't allow is denied unless a parent scope allows it, which is the default outcome of a plan.
+		if deferredDenyNode != nil {
+			if scopeAllowNode != nil {
+				deferredDenyNode = planner.MkAndNode([]*planner.QpN{deferredDenyNode, planner.InvertNodeBooleanValue(scopeAllowNode)})
+			}
+			scopeDenyNode = orNodes(scopeDenyNode, deferredDenyNode)
+		}
+
+		if scopeDenyNode != nil {
+			if rp.deny == nil {
+				rp.deny = scopeDenyNode
+			} else {
+				rp.deny = planner.MkOrNode([]*planner.QpN{rp.deny, scopeDenyNode})
+			}
+		}
+
+		if scopeAllowNode != nil { //nolint:nestif
+			if rp.allow == nil {
+				rp.allow = scopeAllowNode
+			} else {
+				if pendingAllow {
+					rp.allow = planner.MkAndNode([]*planner.QpN{rp.allow, scopeAllowNode})
+					pendingAllow = false
+				} else {
+					rp.allow = planner.MkOrNode([]*planner.QpN{rp.allow, scopeAllowNode})
+				}
+			}
+
+			if pc.rt.GetScopeScopePermissions(scope) == policyv1.ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS {
+				pendingAllow = true
+			}
+		}
+
+		if (scopeDenyNode != nil || scopeAllowNode != nil) &&
+			pc.rt.GetScopeScopePermissions(scope) == policyv1.ScopePermissions_SCOPE_PERMISSIONS_OVERRIDE_PARENT {
+			rp.matchedScope, rp.scopeMatched = scope, true
+			break
+		}
+	}
+
+	// only an ALLOW from a scope with ScopePermissions_SCOPE_PERMISSIONS_REQUIRE_PARENTAL_CONSENT_FOR_ALLOWS exists with no
+	// matching rules in the parent scopes, therefore null the node
+	if pendingAllow {
+		rp.allow = nil
+	}
+
+	return rp, nil
+}
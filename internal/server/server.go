@@ -39,6 +39,7 @@ import (
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 	"github.com/cerbos/cerbos/internal/audit"
 	"github.com/cerbos/cerbos/internal/engine/policyloader"
+	"github.com/cerbos/cerbos/internal/functions"
 	"github.com/cerbos/cerbos/internal/ruletable"
 	"github.com/cerbos/cerbos/internal/telemetry"
 	"github.com/cerbos/cerbos/internal/validator"
@@ -61,9 +62,15 @@ import (
 	_ "github.com/cerbos/cerbos/internal/audit/hub"
 	"github.com/cerbos/cerbos/internal/auxdata"
 	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/evaluator"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/relations"
+
+	// Import file to register the relationship provider.
+	_ "github.com/cerbos/cerbos/internal/relations/file"
 	internalSchema "github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
 
@@ -79,6 +86,8 @@ import (
 	_ "github.com/cerbos/cerbos/internal/storage/db/postgres"
 	// Import sqlite3 to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	// Import tuples to register the sqlite3 and postgres relationship providers.
+	_ "github.com/cerbos/cerbos/internal/storage/db/tuples"
 	// Import disk to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/disk"
 	// Import git to register the storage driver.
@@ -120,34 +129,26 @@ func Start(ctx context.Context) error {
 		return fmt.Errorf("failed to create metadata extractor: %w", err)
 	}
 
+	// load custom functions before any policies are compiled
+	customFunctions, err := functions.New(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load custom functions: %w", err)
+	}
+	defer func() {
+		if err := customFunctions.Close(); err != nil {
+			zap.L().Error("Custom functions runtime didn't shutdown correctly", zap.Error(err))
+		}
+	}()
+
 	// create store
 	store, err := storage.New(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create store: %w", err)
 	}
 
-	var policyLoader policyloader.PolicyLoader
-	switch st := store.(type) {
-	// Overlay needs to take precedence over BinaryStore in this type switch,
-	// as our overlay store implements BinaryStore also
-	case overlay.Overlay:
-		// create wrapped policy loader
-		pl, err := st.GetOverlayPolicyLoader(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to create overlay policy loader: %w", err)
-		}
-		policyLoader = pl
-	case storage.BinaryStore:
-		policyLoader = st
-
-	case storage.SourceStore:
-		// create compile manager
-		policyLoader, err = compile.NewManager(ctx, st)
-		if err != nil {
-			return fmt.Errorf("failed to create compile manager: %w", err)
-		}
-	default:
-		return ErrInvalidStore
+	policyLoader, err := mkPolicyLoader(ctx, store)
+	if err != nil {
+		return err
 	}
 
 	rt := ruletable.NewProtoRuletable()
@@ -171,6 +172,26 @@ func Start(ctx context.Context) error {
 		ss.Subscribe(ruletableMgr)
 	}
 
+	// create shadow components
+	shadow, closeShadow, err := mkShadowComponents(ctx, schemaMgr)
+	if err != nil {
+		return fmt.Errorf("failed to create shadow store: %w", err)
+	}
+	defer closeShadow()
+
+	// create relationship provider
+	relationsProvider, err := relations.New(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create relationship provider: %w", err)
+	}
+	if closer, ok := relationsProvider.(io.Closer); ok {
+		defer func() {
+			if err := closer.Close(); err != nil {
+				zap.L().Error("Relationship provider didn't shutdown correctly", zap.Error(err))
+			}
+		}()
+	}
+
 	// create engine
 	eng, err := engine.New(ctx, engine.Components{
 		PolicyLoader:      policyLoader,
@@ -178,6 +199,8 @@ func Start(ctx context.Context) error {
 		SchemaMgr:         schemaMgr,
 		AuditLog:          auditLog,
 		MetadataExtractor: mdExtractor,
+		Shadow:            shadow,
+		Relations:         relationsProvider,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create engine: %w", err)
@@ -197,6 +220,89 @@ func Start(ctx context.Context) error {
 	return s.Start(ctx, Param{AuditLog: auditLog, AuxData: auxData, Engine: eng, Store: store})
 }
 
+func mkPolicyLoader(ctx context.Context, store storage.Store) (policyloader.PolicyLoader, error) {
+	switch st := store.(type) {
+	// Overlay needs to take precedence over BinaryStore in this type switch,
+	// as our overlay store implements BinaryStore also
+	case overlay.Overlay:
+		// create wrapped policy loader
+		pl, err := st.GetOverlayPolicyLoader(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create overlay policy loader: %w", err)
+		}
+		return pl, nil
+	case storage.BinaryStore:
+		return st, nil
+
+	case storage.SourceStore:
+		// create compile manager
+		pl, err := compile.NewManager(ctx, st)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create compile manager: %w", err)
+		}
+		return pl, nil
+	default:
+		return nil, ErrInvalidStore
+	}
+}
+
+// mkShadowComponents creates the secondary store that a sample of check requests is evaluated against, if it is enabled.
+// The returned function closes the store and must always be called.
+func mkShadowComponents(ctx context.Context, schemaMgr internalSchema.Manager) (*engine.ShadowComponents, func(), error) {
+	noop := func() {}
+
+	engineConf, err := evaluator.GetConf()
+	if err != nil {
+		return nil, noop, fmt.Errorf("failed to read engine configuration: %w", err)
+	}
+
+	if !engineConf.Shadow.Enabled {
+		return nil, noop, nil
+	}
+
+	confW, err := config.WrapperFromMap(map[string]any{storage.ConfKey: engineConf.Shadow.Storage})
+	if err != nil {
+		return nil, noop, fmt.Errorf("failed to read shadow storage configuration: %w", err)
+	}
+
+	store, err := storage.NewFromConf(ctx, confW)
+	if err != nil {
+		return nil, noop, err
+	}
+
+	closeStore := func() {
+		if closer, ok := store.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				zap.L().Error("Shadow store didn't shutdown correctly", zap.Error(err))
+			}
+		}
+	}
+
+	policyLoader, err := mkPolicyLoader(ctx, store)
+	if err != nil {
+		closeStore()
+		return nil, noop, err
+	}
+
+	rt := ruletable.NewProtoRuletable()
+	if err := ruletable.LoadPolicies(ctx, rt, policyLoader); err != nil {
+		closeStore()
+		return nil, noop, err
+	}
+
+	ruletableMgr, err := ruletable.NewRuleTableManager(rt, policyLoader, store, schemaMgr)
+	if err != nil {
+		closeStore()
+		return nil, noop, fmt.Errorf("failed to create shadow ruletable manager: %w", err)
+	}
+
+	if ss, ok := store.(storage.Subscribable); ok {
+		ss.Subscribe(ruletableMgr)
+	}
+
+	return &engine.ShadowComponents{PolicyLoader: policyLoader, RuleTableManager: ruletableMgr}, closeStore, nil
+}
+
 type Param struct {
 	AuditLog audit.Log
 	AuxData  *auxdata.AuxData
@@ -294,6 +400,13 @@ func (s *Server) Start(ctx context.Context, param Param) error {
 		log.Error("Failed to cleanly close audit log", zap.Error(err))
 	}
 
+	if param.AuxData != nil {
+		log.Debug("Shutting down aux data providers")
+		if err := param.AuxData.Close(); err != nil {
+			log.Error("Aux data providers didn't shutdown correctly", zap.Error(err))
+		}
+	}
+
 	if closer, ok := param.Store.(io.Closer); ok {
 		log.Debug("Shutting down store")
 		if err := closer.Close(); err != nil {
@@ -400,7 +513,7 @@ func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, err
 
 		go checkForUnsafeAdminCredentials(log, adminPasswdHash)
 
-		svcv1.RegisterCerbosAdminServiceServer(server, svc.NewCerbosAdminService(param.Store, param.AuditLog, adminUser, adminPasswdHash))
+		svcv1.RegisterCerbosAdminServiceServer(server, svc.NewCerbosAdminService(param.Store, param.AuditLog, cerbosSvc, adminUser, adminPasswdHash))
 		s.health.SetServingStatus(svcv1.CerbosAdminService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 	}
 
@@ -129,7 +129,7 @@ func TestServer(t *testing.T) {
 
 func apiTests(tpg testParamGen) func(*testing.T) {
 	return func(t *testing.T) {
-		tr := LoadTestCases(t, nil, "checks", "playground", "plan_resources")
+		tr := LoadTestCases(t, nil, "checks", "playground", "plan_resources", "plan_principals")
 
 		t.Run("with_tls", func(t *testing.T) {
 			testdataDir := test.PathToDir(t, "server")
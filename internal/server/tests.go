@@ -172,6 +172,18 @@ func (tr *TestRunner) executeGRPCTestCase(grpcConn *grpc.ClientConn, tc *private
 			have, err = retry(func() (proto.Message, error) {
 				return cerbosClient.CheckResources(ctx, call.CheckResources.Input)
 			})
+		case *privatev1.ServerTestCase_ExplainCheck:
+			cerbosClient := svcv1.NewCerbosServiceClient(grpcConn)
+			want = call.ExplainCheck.WantResponse
+			have, err = retry(func() (proto.Message, error) {
+				return cerbosClient.ExplainCheck(ctx, call.ExplainCheck.Input)
+			})
+		case *privatev1.ServerTestCase_PlanPrincipals:
+			cerbosClient := svcv1.NewCerbosServiceClient(grpcConn)
+			want = call.PlanPrincipals.WantResponse
+			have, err = retry(func() (proto.Message, error) {
+				return cerbosClient.PlanPrincipals(ctx, call.PlanPrincipals.Input)
+			})
 		case *privatev1.ServerTestCase_PlaygroundValidate:
 			playgroundClient := svcv1.NewCerbosPlaygroundServiceClient(grpcConn)
 			want = call.PlaygroundValidate.WantResponse
@@ -192,6 +204,10 @@ func (tr *TestRunner) executeGRPCTestCase(grpcConn *grpc.ClientConn, tc *private
 			adminClient := svcv1.NewCerbosAdminServiceClient(grpcConn)
 			want = call.AdminAddOrUpdatePolicy.WantResponse
 			have, err = adminClient.AddOrUpdatePolicy(ctx, call.AdminAddOrUpdatePolicy.Input)
+		case *privatev1.ServerTestCase_AdminCheckWithPolicies:
+			adminClient := svcv1.NewCerbosAdminServiceClient(grpcConn)
+			want = call.AdminCheckWithPolicies.WantResponse
+			have, err = adminClient.CheckWithPolicies(ctx, call.AdminCheckWithPolicies.Input)
 		case *privatev1.ServerTestCase_PlanResources:
 			cerbosClient := svcv1.NewCerbosServiceClient(grpcConn)
 			want = call.PlanResources.WantResponse
@@ -270,6 +286,16 @@ func (tr *TestRunner) executeHTTPTestCase(c *http.Client, hostAddr string, creds
 			input = call.CheckResources.Input
 			want = call.CheckResources.WantResponse
 			have = &responsev1.CheckResourcesResponse{}
+		case *privatev1.ServerTestCase_ExplainCheck:
+			addr = fmt.Sprintf("%s/api/check/explain", hostAddr)
+			input = call.ExplainCheck.Input
+			want = call.ExplainCheck.WantResponse
+			have = &responsev1.ExplainCheckResponse{}
+		case *privatev1.ServerTestCase_PlanPrincipals:
+			addr = fmt.Sprintf("%s/api/plan/principals", hostAddr)
+			input = call.PlanPrincipals.Input
+			want = call.PlanPrincipals.WantResponse
+			have = &responsev1.PlanPrincipalsResponse{}
 		case *privatev1.ServerTestCase_PlaygroundValidate:
 			addr = fmt.Sprintf("%s/api/playground/validate", hostAddr)
 			input = call.PlaygroundValidate.Input
@@ -295,6 +321,11 @@ func (tr *TestRunner) executeHTTPTestCase(c *http.Client, hostAddr string, creds
 			input = call.AdminAddOrUpdatePolicy.Input
 			want = call.AdminAddOrUpdatePolicy.WantResponse
 			have = &responsev1.AddOrUpdatePolicyResponse{}
+		case *privatev1.ServerTestCase_AdminCheckWithPolicies:
+			addr = fmt.Sprintf("%s/admin/check/with_policies", hostAddr)
+			input = call.AdminCheckWithPolicies.Input
+			want = call.AdminCheckWithPolicies.WantResponse
+			have = &responsev1.CheckWithPoliciesResponse{}
 		case *privatev1.ServerTestCase_PlanResources:
 			addr = fmt.Sprintf("%s/api/plan/resources", hostAddr)
 			input = call.PlanResources.Input
@@ -423,7 +454,9 @@ func compareProto(t *testing.T, want, have proto.Message) {
 		protocmp.SortRepeated(cmpPlaygroundError),
 		protocmp.SortRepeated(cmpValidationError),
 		protocmp.IgnoreFields(&responsev1.CheckResourcesResponse{}, "cerbos_call_id"),
+		protocmp.IgnoreFields(&responsev1.ExplainCheckResponse{}, "cerbos_call_id"),
 		protocmp.IgnoreFields(&responsev1.PlanResourcesResponse{}, "cerbos_call_id"),
+		protocmp.IgnoreFields(&responsev1.PlanPrincipalsResponse{}, "cerbos_call_id"),
 		protocmp.IgnoreFields(&responsev1.PlaygroundFailure_ErrorDetails{}, "context"),
 	))
 
@@ -0,0 +1,72 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !js && !wasm
+
+package tuples
+
+import (
+	"context"
+	"fmt"
+
+	// Import the postgres dialect.
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	pgxzap "github.com/jackc/pgx-zap"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5/tracelog"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/relations"
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+)
+
+const (
+	PostgresProviderName = "postgres"
+	urlToSchemaDocs      = "https://docs.cerbos.dev/cerbos/latest/configuration/relations.html#postgres-schema"
+)
+
+func init() {
+	relations.RegisterProvider(PostgresProviderName, func(ctx context.Context, confW *config.Wrapper) (relations.Provider, error) {
+		conf := new(PostgresConf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewPostgresProvider(ctx, conf)
+	})
+}
+
+// NewPostgresProvider creates a provider backed by a Postgres database. The relation_tuple table must already exist.
+func NewPostgresProvider(ctx context.Context, conf *PostgresConf) (*Provider, error) {
+	log := logging.FromContext(ctx).Named("relations.postgres")
+
+	pgConf, err := pgx.ParseConfig(conf.URL)
+	if err != nil {
+		log.Error("Failed to parse Postgres connection URL", zap.Error(err))
+		return nil, err
+	}
+	pgConf.Tracer = &tracelog.TraceLog{Logger: pgxzap.NewLogger(log), LogLevel: tracelog.LogLevelWarn}
+
+	log.Info("Initializing Postgres relationship provider", zap.String("host", pgConf.Host), zap.String("database", pgConf.Database))
+
+	connStr := stdlib.RegisterConnConfig(pgConf)
+	db, err := internal.ConnectWithRetries(ctx, "pgx", connStr, conf.ConnRetry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	conf.ConnPool.Configure(db)
+
+	p := newProvider("postgres", db)
+	if !conf.SkipSchemaCheck {
+		if err := p.checkSchema(ctx); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("schema check failed. Ensure that the schema is correctly defined as documented at %s: %w", urlToSchemaDocs, err)
+		}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS relation_tuple (
+    subject VARCHAR(255) NOT NULL,
+    relation VARCHAR(128) NOT NULL,
+    object VARCHAR(255) NOT NULL,
+    PRIMARY KEY (relation, object, subject)
+);
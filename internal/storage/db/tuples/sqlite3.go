@@ -0,0 +1,58 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !js && !wasm
+
+package tuples
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	// import sqlite3 dialect.
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
+	"go.uber.org/zap"
+
+	// import the sqlite3 database driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/relations"
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+)
+
+const SQLite3ProviderName = "sqlite3"
+
+//go:embed schema_sqlite3.sql
+var sqlite3Schema string
+
+func init() {
+	relations.RegisterProvider(SQLite3ProviderName, func(ctx context.Context, confW *config.Wrapper) (relations.Provider, error) {
+		conf := new(SQLite3Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, err
+		}
+
+		return NewSQLite3Provider(ctx, conf)
+	})
+}
+
+// NewSQLite3Provider creates a provider backed by a sqlite3 database. The relation_tuple table is created if it doesn't exist.
+func NewSQLite3Provider(ctx context.Context, conf *SQLite3Conf) (*Provider, error) {
+	log := logging.FromContext(ctx).Named("relations.sqlite3")
+	log.Info("Initializing sqlite3 relationship provider", zap.String("DSN", conf.DSN))
+
+	db, err := internal.ConnectWithRetries(ctx, "sqlite", conf.DSN, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if _, err := db.ExecContext(ctx, sqlite3Schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return newProvider("sqlite3", db), nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !js && !wasm
+
+// Package tuples provides relationship tuple providers backed by SQL databases.
+package tuples
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/cerbos/cerbos/internal/relations"
+)
+
+const (
+	TupleTbl           = "relation_tuple"
+	TupleTblSubjectCol = "subject"
+	TupleTblRelCol     = "relation"
+	TupleTblObjectCol  = "object"
+)
+
+var _ relations.Provider = (*Provider)(nil)
+
+// Provider reads relationship tuples from the relation_tuple table.
+type Provider struct {
+	db   *goqu.Database
+	conn *sqlx.DB
+}
+
+func newProvider(dialect string, conn *sqlx.DB) *Provider {
+	return &Provider{db: goqu.New(dialect, conn), conn: conn}
+}
+
+func (p *Provider) Subjects(ctx context.Context, relation, object string) ([]string, error) {
+	var subjects []string
+	err := p.db.From(TupleTbl).
+		Prepared(true).
+		Select(goqu.C(TupleTblSubjectCol)).
+		Where(goqu.Ex{TupleTblRelCol: relation, TupleTblObjectCol: object}).
+		ScanValsContext(ctx, &subjects)
+
+	return subjects, err
+}
+
+// Add inserts tuples, ignoring any that already exist.
+func (p *Provider) Add(ctx context.Context, tuples ...relations.Tuple) error {
+	if len(tuples) == 0 {
+		return nil
+	}
+
+	rows := make([]any, len(tuples))
+	for i, t := range tuples {
+		rows[i] = goqu.Record{TupleTblSubjectCol: t.Subject, TupleTblRelCol: t.Relation, TupleTblObjectCol: t.Object}
+	}
+
+	_, err := p.db.Insert(TupleTbl).
+		Prepared(true).
+		Rows(rows...).
+		OnConflict(goqu.DoNothing()).
+		Executor().ExecContext(ctx)
+
+	return err
+}
+
+func (p *Provider) checkSchema(ctx context.Context) error {
+	_, err := p.db.From(TupleTbl).Select(goqu.C(TupleTblSubjectCol)).Limit(1).Executor().ExecContext(ctx)
+	return err
+}
+
+func (p *Provider) Close() error {
+	return p.conn.Close()
+}
@@ -0,0 +1,49 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !js && !wasm
+
+package tuples_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/relations"
+	"github.com/cerbos/cerbos/internal/storage/db/tuples"
+)
+
+func TestSQLite3Provider(t *testing.T) {
+	dsn := fmt.Sprintf("%s?_fk=true", filepath.Join(t.TempDir(), "tuples.sqlite"))
+	provider, err := tuples.NewSQLite3Provider(t.Context(), &tuples.SQLite3Conf{DSN: dsn})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = provider.Close() })
+
+	require.NoError(t, provider.Add(t.Context(),
+		relations.Tuple{Subject: "group:eng#member", Relation: "editor", Object: "folder:1"},
+		relations.Tuple{Subject: "alice", Relation: "member", Object: "group:eng"},
+		relations.Tuple{Subject: "bob", Relation: "member", Object: "group:eng"},
+	))
+	// duplicates are ignored
+	require.NoError(t, provider.Add(t.Context(), relations.Tuple{Subject: "bob", Relation: "member", Object: "group:eng"}))
+
+	subjects, err := provider.Subjects(t.Context(), "member", "group:eng")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"alice", "bob"}, subjects)
+
+	subjects, err = provider.Subjects(t.Context(), "viewer", "folder:1")
+	require.NoError(t, err)
+	require.Empty(t, subjects)
+
+	checker := relations.NewChecker(provider)
+	got, err := checker.Related(t.Context(), "alice", "editor", "folder:1")
+	require.NoError(t, err)
+	require.True(t, got)
+
+	got, err = checker.Related(t.Context(), "carol", "editor", "folder:1")
+	require.NoError(t, err)
+	require.False(t, got)
+}
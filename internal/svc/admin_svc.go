@@ -29,6 +29,8 @@ import (
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
 	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/storage"
@@ -44,17 +46,19 @@ var (
 
 // CerbosAdminService implements the Cerbos administration service.
 type CerbosAdminService struct {
-	sfGroup  singleflight.Group
-	store    storage.Store
-	auditLog audit.Log
+	sfGroup   singleflight.Group
+	store     storage.Store
+	auditLog  audit.Log
+	cerbosSvc *CerbosService
 	*svcv1.UnimplementedCerbosAdminServiceServer
 	adminUser       string
 	adminPasswdHash []byte
 }
 
-func NewCerbosAdminService(store storage.Store, auditLog audit.Log, adminUser string, adminPasswdHash []byte) *CerbosAdminService {
+func NewCerbosAdminService(store storage.Store, auditLog audit.Log, cerbosSvc *CerbosService, adminUser string, adminPasswdHash []byte) *CerbosAdminService {
 	svc := &CerbosAdminService{
 		auditLog:                              auditLog,
+		cerbosSvc:                             cerbosSvc,
 		adminUser:                             adminUser,
 		adminPasswdHash:                       adminPasswdHash,
 		UnimplementedCerbosAdminServiceServer: &svcv1.UnimplementedCerbosAdminServiceServer{},
@@ -389,6 +393,61 @@ func (cas *CerbosAdminService) ReloadStore(ctx context.Context, req *requestv1.R
 	return &responsev1.ReloadStoreResponse{}, nil
 }
 
+// CheckWithPolicies evaluates the request against the live policies and against the live policies overlaid with the candidate policies from the request.
+func (cas *CerbosAdminService) CheckWithPolicies(ctx context.Context, req *requestv1.CheckWithPoliciesRequest) (*responsev1.CheckWithPoliciesResponse, error) {
+	if err := cas.checkCredentials(ctx); err != nil {
+		return nil, err
+	}
+
+	if cas.cerbosSvc == nil {
+		return nil, status.Error(codes.Unimplemented, "Checks are not supported by this server")
+	}
+
+	log := logging.ReqScopeLog(ctx)
+	inputs, err := cas.cerbosSvc.checkResourcesInputs(ctx, log, req.RequestId, req.Principal, req.Resources, req.AuxData)
+	if err != nil {
+		return nil, err
+	}
+
+	current, candidate, err := cas.cerbosSvc.eng.WhatIf(logging.ToContext(ctx, log), req.Policies, inputs)
+	if err != nil {
+		switch {
+		case errors.Is(err, engine.ErrWhatIfUnsupported):
+			return nil, status.Error(codes.FailedPrecondition, "Checks with candidate policies are not supported by the configured store")
+		case errors.Is(err, compile.PolicyCompilationErr{}):
+			log.Debug("Failed to compile candidate policies", zap.Error(err))
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid policies: %v", err)
+		default:
+			return nil, checkFailed(log, err)
+		}
+	}
+
+	currentResults := checkResourcesResults(inputs, current, nil, true)
+	candidateResults := checkResourcesResults(inputs, candidate, nil, true)
+	results := make([]*responsev1.CheckWithPoliciesResponse_Result, len(inputs))
+	for i := range inputs {
+		results[i] = &responsev1.CheckWithPoliciesResponse_Result{
+			Current:        currentResults[i],
+			Candidate:      candidateResults[i],
+			ChangedActions: changedActions(currentResults[i], candidateResults[i]),
+		}
+	}
+
+	return &responsev1.CheckWithPoliciesResponse{RequestId: req.RequestId, Results: results}, nil
+}
+
+func changedActions(current, candidate *responsev1.CheckResourcesResponse_ResultEntry) []string {
+	var changed []string
+	for action, effect := range current.Actions {
+		if candidate.Actions[action] != effect {
+			changed = append(changed, action)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
 func (cas *CerbosAdminService) ListAuditLogEntries(req *requestv1.ListAuditLogEntriesRequest, stream svcv1.CerbosAdminService_ListAuditLogEntriesServer) error {
 	ctx := stream.Context()
 
@@ -6,11 +6,13 @@ package svc
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
@@ -22,6 +24,9 @@ import (
 	"github.com/cerbos/cerbos/internal/engine"
 	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+	"github.com/cerbos/cerbos/internal/ruletable/planner/document"
+	"github.com/cerbos/cerbos/internal/ruletable/planner/sql"
 	"github.com/cerbos/cerbos/internal/util"
 )
 
@@ -64,20 +69,35 @@ func (cs *CerbosService) PlanResources(ctx context.Context, request *requestv1.P
 	}
 
 	input := &enginev1.PlanResourcesInput{
-		RequestId:   request.RequestId,
-		Action:      request.Action, //nolint:staticcheck
-		Actions:     request.Actions,
-		Principal:   request.Principal,
-		Resource:    request.Resource,
-		AuxData:     auxData,
-		IncludeMeta: request.IncludeMeta,
+		RequestId:     request.RequestId,
+		Action:        request.Action, //nolint:staticcheck
+		Actions:       request.Actions,
+		Principal:     request.Principal,
+		Resource:      request.Resource,
+		AuxData:       auxData,
+		IncludeMeta:   request.IncludeMeta,
+		FilterOptions: request.FilterOptions,
+	}
+
+	if err := cs.auxData.EnrichPlan(ctx, input); err != nil {
+		log.Error("Failed to fetch auxData", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "failed to fetch auxData")
+	}
+	ctx = cs.auxData.AuditContext(ctx)
+
+	if len(request.Resources) > 0 {
+		return cs.planResourcesBatch(logging.ToContext(ctx, log), request, input)
 	}
+
 	output, err := cs.eng.Plan(logging.ToContext(ctx, log), input)
 	if err != nil {
 		log.Error("Resources query plan request failed", zap.Error(err))
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
 			return nil, status.Errorf(codes.FailedPrecondition, "Resources query plan failed due to invalid policy")
 		}
+		if errors.Is(err, planner.ErrNormalFormTooLarge) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "Resources query plan request failed")
 	}
 
@@ -87,6 +107,7 @@ func (cs *CerbosService) PlanResources(ctx context.Context, request *requestv1.P
 		PolicyVersion:    request.Resource.PolicyVersion,
 		Filter:           output.Filter,
 		ValidationErrors: output.ValidationErrors,
+		Now:              output.Now,
 	}
 
 	if request.IncludeMeta {
@@ -107,9 +128,170 @@ func (cs *CerbosService) PlanResources(ctx context.Context, request *requestv1.P
 		response.Action = oneAction //nolint:staticcheck
 	}
 
+	if request.Sql != nil {
+		response.Sql, err = translateFilterToSQL(output.Filter, request.Sql)
+		if err != nil {
+			log.Error("Failed to translate filter to SQL", zap.Error(err))
+			if errors.Is(err, sql.ErrUntranslatable) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, status.Errorf(codes.Internal, "Failed to translate filter to SQL")
+		}
+	}
+
+	if request.Document != nil {
+		response.Document, err = translateFilterToDocument(output.Filter, request.Document)
+		if err != nil {
+			log.Error("Failed to translate filter to document query", zap.Error(err))
+			if errors.Is(err, document.ErrUntranslatable) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, status.Errorf(codes.Internal, "Failed to translate filter to document query")
+		}
+	}
+
+	return response, nil
+}
+
+// planResourcesBatch produces a query plan for each of the resources in the request. The template input holds the fields that
+// are common to all of the resources.
+func (cs *CerbosService) planResourcesBatch(ctx context.Context, request *requestv1.PlanResourcesRequest, template *enginev1.PlanResourcesInput) (*responsev1.PlanResourcesResponse, error) {
+	log := logging.FromContext(ctx)
+
+	inputs := make([]*enginev1.PlanResourcesInput, len(request.Resources))
+	for i, r := range request.Resources {
+		inputs[i] = &enginev1.PlanResourcesInput{
+			RequestId:     template.RequestId,
+			Actions:       template.Actions,
+			Principal:     template.Principal,
+			Resource:      r,
+			AuxData:       template.AuxData,
+			IncludeMeta:   template.IncludeMeta,
+			FilterOptions: template.FilterOptions,
+		}
+	}
+
+	outputs, err := cs.eng.PlanBatch(ctx, inputs)
+	if err != nil {
+		log.Error("Resources query plan request failed", zap.Error(err))
+		if errors.Is(err, compile.PolicyCompilationErr{}) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Resources query plan failed due to invalid policy")
+		}
+		if errors.Is(err, planner.ErrNormalFormTooLarge) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Resources query plan request failed")
+	}
+
+	response := &responsev1.PlanResourcesResponse{
+		RequestId: request.RequestId,
+		Actions:   request.Actions,
+		Results:   make([]*responsev1.PlanResourcesResponse_Result, len(outputs)),
+	}
+
+	for i, output := range outputs {
+		result := &responsev1.PlanResourcesResponse_Result{
+			ResourceKind:     request.Resources[i].Kind,
+			PolicyVersion:    request.Resources[i].PolicyVersion,
+			Scope:            request.Resources[i].Scope,
+			Filter:           output.Filter,
+			ValidationErrors: output.ValidationErrors,
+			Now:              output.Now,
+		}
+
+		// All resources are planned with the same time.
+		if output.Now != nil {
+			response.Now = output.Now
+		}
+
+		if request.IncludeMeta {
+			result.Meta = &responsev1.PlanResourcesResponse_Meta{
+				FilterDebug:   output.FilterDebug,
+				MatchedScopes: output.MatchedScopes,
+			}
+		}
+
+		response.Results[i] = result
+	}
+
+	return response, nil
+}
+
+func (cs *CerbosService) PlanPrincipals(ctx context.Context, request *requestv1.PlanPrincipalsRequest) (*responsev1.PlanPrincipalsResponse, error) {
+	log := logging.ReqScopeLog(ctx)
+
+	auxData, err := cs.auxData.Extract(ctx, request.AuxData)
+	if err != nil {
+		log.Error("Failed to extract auxData", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, "invalid auxData")
+	}
+
+	input := &enginev1.PlanPrincipalsInput{
+		RequestId:   request.RequestId,
+		Actions:     request.Actions,
+		Resource:    request.Resource,
+		Principal:   request.Principal,
+		AuxData:     auxData,
+		IncludeMeta: request.IncludeMeta,
+	}
+
+	output, err := cs.eng.PlanPrincipals(logging.ToContext(ctx, log), input)
+	if err != nil {
+		log.Error("Principals query plan request failed", zap.Error(err))
+		if errors.Is(err, compile.PolicyCompilationErr{}) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Principals query plan failed due to invalid policy")
+		}
+		return nil, status.Errorf(codes.Internal, "Principals query plan request failed")
+	}
+
+	response := &responsev1.PlanPrincipalsResponse{
+		RequestId:     request.RequestId,
+		Actions:       request.Actions,
+		ResourceKind:  request.Resource.Kind,
+		ResourceId:    request.Resource.Id,
+		PolicyVersion: request.Resource.PolicyVersion,
+		Filter:        output.Filter,
+	}
+
+	if request.IncludeMeta {
+		response.Meta = &responsev1.PlanPrincipalsResponse_Meta{
+			FilterDebug: output.FilterDebug,
+		}
+	}
+
 	return response, nil
 }
 
+func translateFilterToSQL(filter *enginev1.PlanResourcesFilter, opts *requestv1.PlanResourcesRequest_Sql) (*responsev1.PlanResourcesResponse_Sql, error) {
+	where, args, err := sql.Translate(filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*structpb.Value, len(args))
+	for i, arg := range args {
+		if values[i], err = structpb.NewValue(arg); err != nil {
+			return nil, fmt.Errorf("failed to convert SQL argument %v: %w", arg, err)
+		}
+	}
+
+	return &responsev1.PlanResourcesResponse_Sql{Where: where, Args: values}, nil
+}
+
+func translateFilterToDocument(filter *enginev1.PlanResourcesFilter, opts *requestv1.PlanResourcesRequest_Document) (*responsev1.PlanResourcesResponse_Document, error) {
+	query, err := document.Translate(filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	queryStruct, err := structpb.NewStruct(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query document: %w", err)
+	}
+
+	return &responsev1.PlanResourcesResponse_Document{Query: queryStruct}, nil
+}
+
 // CheckResourceSet checks a batch of homogenous resources.
 // Deprecated: Since 0.16.0. Use CheckResources instead.
 func (cs *CerbosService) CheckResourceSet(ctx context.Context, req *requestv1.CheckResourceSetRequest) (*responsev1.CheckResourceSetResponse, error) {
@@ -152,6 +334,12 @@ func (cs *CerbosService) CheckResourceSet(ctx context.Context, req *requestv1.Ch
 		i++
 	}
 
+	if err := cs.auxData.Enrich(ctx, inputs); err != nil {
+		log.Error("Failed to fetch auxData", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "failed to fetch auxData")
+	}
+	ctx = cs.auxData.AuditContext(ctx)
+
 	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs)
 	if err != nil {
 		log.Error("Policy check failed", zap.Error(err))
@@ -200,6 +388,12 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 		}
 	}
 
+	if err := cs.auxData.Enrich(ctx, inputs); err != nil {
+		log.Error("Failed to fetch auxData", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "failed to fetch auxData")
+	}
+	ctx = cs.auxData.AuditContext(ctx)
+
 	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs)
 	if err != nil {
 		log.Error("Policy check failed", zap.Error(err))
@@ -233,87 +427,139 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 // CheckResources checks a batch of heterogenous resources.
 func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.CheckResourcesRequest) (*responsev1.CheckResourcesResponse, error) {
 	log := logging.ReqScopeLog(ctx)
-	if err := cs.checkNumResourcesLimit(len(req.Resources)); err != nil {
+	inputs, err := cs.checkResourcesInputs(ctx, log, req.RequestId, req.Principal, req.Resources, req.AuxData)
+	if err != nil {
+		return nil, err
+	}
+	ctx = cs.auxData.AuditContext(ctx)
+
+	var outputs []*enginev1.CheckOutput
+	var explanations []*enginev1.Explanation
+	if req.IncludeExplanation {
+		outputs, explanations, err = cs.eng.Explain(logging.ToContext(ctx, log), inputs)
+	} else {
+		outputs, err = cs.eng.Check(logging.ToContext(ctx, log), inputs)
+	}
+	if err != nil {
+		return nil, checkFailed(log, err)
+	}
+
+	return tracing.RecordSpan2(ctx, "assemble_response", func(_ context.Context, _ trace.Span) (*responsev1.CheckResourcesResponse, error) {
+		return &responsev1.CheckResourcesResponse{
+			RequestId: req.RequestId,
+			Results:   checkResourcesResults(inputs, outputs, explanations, req.IncludeMeta),
+		}, nil
+	})
+}
+
+// ExplainCheck checks a batch of heterogenous resources and explains how each decision was reached.
+func (cs *CerbosService) ExplainCheck(ctx context.Context, req *requestv1.ExplainCheckRequest) (*responsev1.ExplainCheckResponse, error) {
+	log := logging.ReqScopeLog(ctx)
+	inputs, err := cs.checkResourcesInputs(ctx, log, req.RequestId, req.Principal, req.Resources, req.AuxData)
+	if err != nil {
+		return nil, err
+	}
+	ctx = cs.auxData.AuditContext(ctx)
+
+	outputs, explanations, err := cs.eng.Explain(logging.ToContext(ctx, log), inputs)
+	if err != nil {
+		return nil, checkFailed(log, err)
+	}
+
+	return &responsev1.ExplainCheckResponse{
+		RequestId: req.RequestId,
+		Results:   checkResourcesResults(inputs, outputs, explanations, true),
+	}, nil
+}
+
+func (cs *CerbosService) checkResourcesInputs(ctx context.Context, log *zap.Logger, requestID string, principal *enginev1.Principal, resources []*requestv1.CheckResourcesRequest_ResourceEntry, reqAuxData *requestv1.AuxData) ([]*enginev1.CheckInput, error) {
+	if err := cs.checkNumResourcesLimit(len(resources)); err != nil {
 		log.Error("Request too large", zap.Error(err))
 		return nil, err
 	}
 
-	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
+	auxData, err := cs.auxData.Extract(ctx, reqAuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
 		return nil, status.Error(codes.InvalidArgument, "invalid auxData")
 	}
 
-	inputs := make([]*enginev1.CheckInput, len(req.Resources))
-	for i, res := range req.Resources {
+	inputs := make([]*enginev1.CheckInput, len(resources))
+	for i, res := range resources {
 		if err := cs.checkNumActionsLimit(len(res.Actions)); err != nil {
 			log.Error("Request too large", zap.Error(err))
 			return nil, err
 		}
 
 		inputs[i] = &enginev1.CheckInput{
-			RequestId: req.RequestId,
+			RequestId: requestID,
 			Actions:   res.Actions,
-			Principal: req.Principal,
+			Principal: principal,
 			Resource:  res.Resource,
 			AuxData:   auxData,
 		}
 	}
 
-	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs)
-	if err != nil {
-		log.Error("Policy check failed", zap.Error(err))
-		if errors.Is(err, compile.PolicyCompilationErr{}) {
-			return nil, status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
-		}
-		return nil, status.Errorf(codes.Internal, "Policy check failed")
+	if err := cs.auxData.Enrich(ctx, inputs); err != nil {
+		log.Error("Failed to fetch auxData", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "failed to fetch auxData")
 	}
 
-	return tracing.RecordSpan2(ctx, "assemble_response", func(_ context.Context, _ trace.Span) (*responsev1.CheckResourcesResponse, error) {
-		result := &responsev1.CheckResourcesResponse{
-			RequestId: req.RequestId,
-			Results:   make([]*responsev1.CheckResourcesResponse_ResultEntry, len(outputs)),
+	return inputs, nil
+}
+
+func checkFailed(log *zap.Logger, err error) error {
+	log.Error("Policy check failed", zap.Error(err))
+	if errors.Is(err, compile.PolicyCompilationErr{}) {
+		return status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
+	}
+	return status.Errorf(codes.Internal, "Policy check failed")
+}
+
+func checkResourcesResults(inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, explanations []*enginev1.Explanation, includeMeta bool) []*responsev1.CheckResourcesResponse_ResultEntry {
+	results := make([]*responsev1.CheckResourcesResponse_ResultEntry, len(outputs))
+	for i, out := range outputs {
+		resource := inputs[i].Resource
+		entry := &responsev1.CheckResourcesResponse_ResultEntry{
+			Resource: &responsev1.CheckResourcesResponse_ResultEntry_Resource{
+				Id:            resource.Id,
+				Kind:          resource.Kind,
+				PolicyVersion: resource.PolicyVersion,
+				Scope:         resource.Scope,
+			},
+			ValidationErrors: out.ValidationErrors,
+			Actions:          make(map[string]effectv1.Effect, len(out.Actions)),
 		}
 
-		for i, out := range outputs {
-			resource := inputs[i].Resource
-			entry := &responsev1.CheckResourcesResponse_ResultEntry{
-				Resource: &responsev1.CheckResourcesResponse_ResultEntry_Resource{
-					Id:            resource.Id,
-					Kind:          resource.Kind,
-					PolicyVersion: resource.PolicyVersion,
-					Scope:         resource.Scope,
-				},
-				ValidationErrors: out.ValidationErrors,
-				Actions:          make(map[string]effectv1.Effect, len(out.Actions)),
+		if includeMeta {
+			entry.Meta = &responsev1.CheckResourcesResponse_ResultEntry_Meta{
+				EffectiveDerivedRoles: out.EffectiveDerivedRoles,
+				Actions:               make(map[string]*responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta, len(out.Actions)),
 			}
+		}
 
-			if req.IncludeMeta {
-				entry.Meta = &responsev1.CheckResourcesResponse_ResultEntry_Meta{
-					EffectiveDerivedRoles: out.EffectiveDerivedRoles,
-					Actions:               make(map[string]*responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta, len(out.Actions)),
-				}
-			}
+		if len(out.Outputs) > 0 {
+			entry.Outputs = out.Outputs
+		}
 
-			if len(out.Outputs) > 0 {
-				entry.Outputs = out.Outputs
-			}
+		if explanations != nil {
+			entry.Explanation = explanations[i]
+		}
 
-			for action, actionEffect := range out.Actions {
-				entry.Actions[action] = actionEffect.Effect
-				if req.IncludeMeta {
-					entry.Meta.Actions[action] = &responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta{
-						MatchedPolicy: actionEffect.Policy,
-						MatchedScope:  actionEffect.Scope,
-					}
+		for action, actionEffect := range out.Actions {
+			entry.Actions[action] = actionEffect.Effect
+			if includeMeta {
+				entry.Meta.Actions[action] = &responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta{
+					MatchedPolicy: actionEffect.Policy,
+					MatchedScope:  actionEffect.Scope,
 				}
 			}
-
-			result.Results[i] = entry
 		}
 
-		return result, nil
-	})
+		results[i] = entry
+	}
+
+	return results
 }
 
 func (cs *CerbosService) checkNumResourcesLimit(n int) error {
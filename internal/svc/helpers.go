@@ -35,6 +35,16 @@ func ExtractRequestFields(fullMethod string, req any) map[string]any {
 			metaTagKey: map[string]string{requestIDTagKey: crsReq.RequestId},
 		}
 
+	case "/cerbos.svc.v1.CerbosService/ExplainCheck":
+		ecReq, ok := req.(*requestv1.ExplainCheckRequest)
+		if !ok || ecReq.RequestId == "" {
+			return nil
+		}
+
+		return map[string]any{
+			metaTagKey: map[string]string{requestIDTagKey: ecReq.RequestId},
+		}
+
 	case "/cerbos.svc.v1.CerbosService/CheckResourceSet":
 		crsReq, ok := req.(*requestv1.CheckResourceSetRequest)
 		if !ok || crsReq.RequestId == "" {
@@ -0,0 +1,222 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package coverage_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rogpeppe/go-internal/txtar"
+	"github.com/stretchr/testify/require"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/verify"
+	"github.com/cerbos/cerbos/internal/verify/coverage"
+)
+
+const policies = `
+-- derived_roles.yaml --
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: common
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: request.resource.attr.owner == request.principal.id
+    - name: auditor
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: P.attr.auditor
+-- document.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  importDerivedRoles:
+    - common
+  rules:
+    - name: view
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user"]
+      condition:
+        match:
+          any:
+            of:
+              - expr: R.attr.public
+              - expr: R.attr.shared
+    - name: delete
+      actions: ["delete"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["owner"]
+    - name: audit
+      actions: ["audit"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["auditor"]
+-- document_test.yaml --
+name: DocumentTestSuite
+principals:
+  alice:
+    id: alice
+    roles: ["user"]
+resources:
+  public:
+    id: public
+    kind: document
+    attr:
+      owner: alice
+      public: true
+      shared: false
+  private:
+    id: private
+    kind: document
+    attr:
+      owner: bob
+      public: false
+      shared: false
+tests:
+  - name: View and delete
+    input:
+      principals: ["alice"]
+      resources: ["public", "private"]
+      actions: ["view", "delete"]
+    expected:
+      - principal: alice
+        resource: public
+        actions:
+          view: EFFECT_ALLOW
+          delete: EFFECT_ALLOW
+      - principal: alice
+        resource: private
+        actions:
+          view: EFFECT_DENY
+          delete: EFFECT_DENY
+`
+
+func TestCoverage(t *testing.T) {
+	archive := txtar.Parse([]byte(policies))
+	dir := t.TempDir()
+	require.NoError(t, txtar.Write(archive, dir))
+
+	report := runTests(t, dir, archive)
+	require.Len(t, report.Policies, 2)
+
+	type hits struct {
+		name string
+		hits uint32
+	}
+
+	summariseHits := func(hs []coverage.Hits) []hits {
+		res := make([]hits, len(hs))
+		for i, h := range hs {
+			res[i] = hits{name: h.Name, hits: h.Hits}
+		}
+		return res
+	}
+
+	t.Run("derived_roles", func(t *testing.T) {
+		pr := report.Policies[0]
+		require.Equal(t, "derived_roles.yaml", pr.File)
+		require.Equal(t, []hits{{name: "owner", hits: 2}, {name: "auditor"}}, summariseHits(pr.DerivedRoles))
+		require.Equal(t, coverage.Counts{Covered: 1, Total: 2}, pr.Summary.DerivedRoles)
+	})
+
+	t.Run("resource_policy", func(t *testing.T) {
+		pr := report.Policies[1]
+		require.Equal(t, "document.yaml", pr.File)
+		require.Equal(t, []hits{{name: "view", hits: 1}, {name: "delete", hits: 1}, {name: "audit"}}, summariseHits(pr.Rules))
+		require.Equal(t, coverage.Counts{Covered: 2, Total: 3}, pr.Summary.Rules)
+
+		branches := make(map[string]coverage.Branches, len(pr.Branches))
+		for _, b := range pr.Branches {
+			branches[b.Path] = b
+		}
+
+		require.Len(t, branches, 3)
+		require.Equal(t, uint32(14), branches["any"].Position.GetLine())
+		require.Positive(t, branches["any"].True)
+		require.Positive(t, branches["any"].False)
+		require.Positive(t, branches["any.of[0].expr"].True)
+		require.Positive(t, branches["any.of[0].expr"].False)
+		require.Zero(t, branches["any.of[1].expr"].True)
+		require.Positive(t, branches["any.of[1].expr"].False)
+	})
+
+	t.Run("lcov", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, coverage.WriteLCOV(&buf, report))
+
+		out := buf.String()
+		require.Contains(t, out, "SF:document.yaml\n")
+		require.Contains(t, out, "FNDA:0,audit\n")
+		require.Contains(t, out, "FNF:3\nFNH:2\n")
+		require.Equal(t, 2, strings.Count(out, "end_of_record"))
+	})
+
+	t.Run("cobertura", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, coverage.WriteCobertura(&buf, report, dir, time.Unix(0, 0)))
+
+		out := buf.String()
+		require.Contains(t, out, `<class name="resource.document.vdefault" filename="document.yaml"`)
+		require.Contains(t, out, `condition-coverage="50% (1/2)"`)
+	})
+}
+
+func runTests(t *testing.T, dir string, archive *txtar.Archive) *coverage.Report {
+	t.Helper()
+
+	ctx := t.Context()
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
+	require.NoError(t, err)
+
+	mgr, err := compile.NewManager(ctx, store)
+	require.NoError(t, err)
+
+	rt := ruletable.NewProtoRuletable()
+	require.NoError(t, ruletable.LoadPolicies(ctx, rt, mgr))
+
+	schemaMgr := schema.NewNopManager()
+	rtMgr, err := ruletable.NewRuleTableManager(rt, mgr, store, schemaMgr)
+	require.NoError(t, err)
+
+	recorder := coverage.NewRecorder()
+	results, err := verify.Verify(ctx, os.DirFS(dir), engine.NewEphemeral(nil, rtMgr, schemaMgr), verify.Config{Coverage: recorder})
+	require.NoError(t, err)
+	require.Equal(t, policyv1.TestResults_RESULT_PASSED, results.Summary.OverallResult)
+
+	unit := &policy.CompilationUnit{}
+	for _, f := range archive.Files {
+		if strings.HasSuffix(f.Name, "_test.yaml") {
+			continue
+		}
+
+		p, sc, err := policy.ReadPolicyWithSourceContextFromReader(bytes.NewReader(f.Data))
+		require.NoError(t, err, "Unexpected error from %s", f.Name)
+
+		modID := namer.GenModuleID(p)
+		if filepath.Base(f.Name) == "document.yaml" {
+			unit.ModID = modID
+		}
+
+		unit.AddDefinition(modID, policy.WithMetadata(p, f.Name, nil, f.Name, policy.SourceFile(f.Name)), sc)
+	}
+
+	return recorder.NewReport([]*policy.CompilationUnit{unit})
+}
@@ -0,0 +1,234 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package coverage
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// line is the coverage of a line of a policy file, which can hold several rules and condition nodes.
+type line struct {
+	hits             uint32
+	branchesCovered  uint32
+	branchesTotal    uint32
+	hasBranches      bool
+	hasDefinitionHit bool
+}
+
+func lines(pr *PolicyReport) map[uint32]*line {
+	res := make(map[uint32]*line)
+	get := func(n uint32) *line {
+		l, ok := res[n]
+		if !ok {
+			l = &line{}
+			res[n] = l
+		}
+		return l
+	}
+
+	for _, h := range slices.Concat(pr.Rules, pr.DerivedRoles) {
+		if h.Position == nil {
+			continue
+		}
+
+		l := get(h.Position.Line)
+		l.hits += h.Hits
+		l.hasDefinitionHit = true
+	}
+
+	for _, b := range pr.Branches {
+		if b.Position == nil {
+			continue
+		}
+
+		l := get(b.Position.Line)
+		l.hasBranches = true
+		l.branchesTotal += 2
+		if b.True > 0 {
+			l.branchesCovered++
+		}
+		if b.False > 0 {
+			l.branchesCovered++
+		}
+		if !l.hasDefinitionHit {
+			l.hits += b.True + b.False
+		}
+	}
+
+	return res
+}
+
+type coberturaCoverage struct {
+	XMLName         xml.Name           `xml:"coverage"`
+	Sources         []string           `xml:"sources>source"`
+	Packages        []coberturaPackage `xml:"packages>package"`
+	LineRate        string             `xml:"line-rate,attr"`
+	BranchRate      string             `xml:"branch-rate,attr"`
+	Version         string             `xml:"version,attr"`
+	LinesCovered    uint32             `xml:"lines-covered,attr"`
+	LinesValid      uint32             `xml:"lines-valid,attr"`
+	BranchesCovered uint32             `xml:"branches-covered,attr"`
+	BranchesValid   uint32             `xml:"branches-valid,attr"`
+	Complexity      int                `xml:"complexity,attr"`
+	Timestamp       int64              `xml:"timestamp,attr"`
+}
+
+type coberturaPackage struct {
+	Name       string           `xml:"name,attr"`
+	LineRate   string           `xml:"line-rate,attr"`
+	BranchRate string           `xml:"branch-rate,attr"`
+	Classes    []coberturaClass `xml:"classes>class"`
+	Complexity int              `xml:"complexity,attr"`
+}
+
+type coberturaClass struct {
+	Name       string          `xml:"name,attr"`
+	Filename   string          `xml:"filename,attr"`
+	LineRate   string          `xml:"line-rate,attr"`
+	BranchRate string          `xml:"branch-rate,attr"`
+	Methods    struct{}        `xml:"methods"`
+	Lines      []coberturaLine `xml:"lines>line"`
+	Complexity int             `xml:"complexity,attr"`
+}
+
+type coberturaLine struct {
+	ConditionCoverage string `xml:"condition-coverage,attr,omitempty"`
+	Number            uint32 `xml:"number,attr"`
+	Hits              uint32 `xml:"hits,attr"`
+	Branch            bool   `xml:"branch,attr"`
+}
+
+// WriteCobertura writes the report in the Cobertura XML format. Each policy is reported as a class and the lines
+// of rules, derived roles and condition nodes are reported as lines. Condition nodes are reported as branches.
+func WriteCobertura(w io.Writer, report *Report, srcRoot string, timestamp time.Time) error {
+	var total, branches Counts
+	pkg := coberturaPackage{Name: "policies"}
+	for _, pr := range report.Policies {
+		var classLines, classBranches Counts
+		class := coberturaClass{Name: pr.Policy, Filename: pr.File}
+
+		policyLines := lines(pr)
+		for _, n := range slices.Sorted(maps.Keys(policyLines)) {
+			l := policyLines[n]
+			cl := coberturaLine{Number: n, Hits: l.hits, Branch: l.hasBranches}
+			if l.hasBranches {
+				cl.ConditionCoverage = fmt.Sprintf("%d%% (%d/%d)", l.branchesCovered*100/l.branchesTotal, l.branchesCovered, l.branchesTotal) //nolint:mnd
+				classBranches.add(Counts{Covered: l.branchesCovered, Total: l.branchesTotal})
+			}
+
+			classLines.Total++
+			if l.hits > 0 {
+				classLines.Covered++
+			}
+			class.Lines = append(class.Lines, cl)
+		}
+
+		class.LineRate = rate(classLines)
+		class.BranchRate = rate(classBranches)
+		pkg.Classes = append(pkg.Classes, class)
+		total.add(classLines)
+		branches.add(classBranches)
+	}
+
+	pkg.LineRate = rate(total)
+	pkg.BranchRate = rate(branches)
+
+	doc := coberturaCoverage{
+		Sources:         []string{srcRoot},
+		Packages:        []coberturaPackage{pkg},
+		LineRate:        rate(total),
+		BranchRate:      rate(branches),
+		Version:         util.Version,
+		LinesCovered:    total.Covered,
+		LinesValid:      total.Total,
+		BranchesCovered: branches.Covered,
+		BranchesValid:   branches.Total,
+		Timestamp:       timestamp.UnixMilli(),
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func rate(c Counts) string {
+	return strconv.FormatFloat(c.Percent()/100, 'f', 4, 64) //nolint:mnd
+}
+
+// WriteLCOV writes the report in the LCOV tracefile format. Rules and derived roles are reported as functions and
+// condition nodes are reported as branches that are taken when the node evaluates to true and false respectively.
+func WriteLCOV(w io.Writer, report *Report) error {
+	bw := bufio.NewWriter(w)
+	for _, pr := range report.Policies {
+		fmt.Fprintln(bw, "TN:")
+		fmt.Fprintf(bw, "SF:%s\n", pr.File)
+
+		defs := slices.Concat(pr.Rules, pr.DerivedRoles)
+		var fnHit int
+		for _, h := range defs {
+			fmt.Fprintf(bw, "FN:%d,%s\n", h.Position.GetLine(), h.Name)
+		}
+		for _, h := range defs {
+			fmt.Fprintf(bw, "FNDA:%d,%s\n", h.Hits, h.Name)
+			if h.Hits > 0 {
+				fnHit++
+			}
+		}
+		fmt.Fprintf(bw, "FNF:%d\nFNH:%d\n", len(defs), fnHit)
+
+		var brHit int
+		for i, b := range pr.Branches {
+			evaluated := b.True+b.False > 0
+			fmt.Fprintf(bw, "BRDA:%d,%d,0,%s\n", b.Position.GetLine(), i, taken(b.True, evaluated))
+			fmt.Fprintf(bw, "BRDA:%d,%d,1,%s\n", b.Position.GetLine(), i, taken(b.False, evaluated))
+			if b.True > 0 {
+				brHit++
+			}
+			if b.False > 0 {
+				brHit++
+			}
+		}
+		fmt.Fprintf(bw, "BRF:%d\nBRH:%d\n", 2*len(pr.Branches), brHit) //nolint:mnd
+
+		policyLines := lines(pr)
+		var lnHit int
+		for _, n := range slices.Sorted(maps.Keys(policyLines)) {
+			fmt.Fprintf(bw, "DA:%d,%d\n", n, policyLines[n].hits)
+			if policyLines[n].hits > 0 {
+				lnHit++
+			}
+		}
+		fmt.Fprintf(bw, "LF:%d\nLH:%d\n", len(policyLines), lnHit)
+		fmt.Fprintln(bw, "end_of_record")
+	}
+
+	return bw.Flush()
+}
+
+// taken formats the number of times that a branch was taken. LCOV uses "-" for branches of nodes that were never evaluated.
+func taken(n uint32, evaluated bool) string {
+	if !evaluated {
+		return "-"
+	}
+
+	return strconv.FormatUint(uint64(n), 10)
+}
@@ -0,0 +1,182 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package coverage records and reports the parts of the policies that are exercised by policy tests.
+package coverage
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/engine/tracer"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+var _ tracer.ExplanationSink = (*Recorder)(nil)
+
+type ownerKind int
+
+const (
+	ownerRule ownerKind = iota
+	ownerDerivedRole
+)
+
+// ownerKey identifies a rule or derived role definition.
+type ownerKey struct {
+	policy string
+	name   string
+	kind   ownerKind
+}
+
+// branchKey identifies a node of the condition of a rule or derived role.
+// The path is relative to the match block of the condition, for example "all.of[0].expr".
+type branchKey struct {
+	path  string
+	owner ownerKey
+}
+
+type outcomes struct {
+	true  uint32
+	false uint32
+}
+
+// Recorder is a trace sink that records the rules that were activated, the derived roles that were activated,
+// and the outcomes of each node of their conditions.
+type Recorder struct {
+	hits     map[ownerKey]uint32
+	branches map[branchKey]*outcomes
+	mu       sync.Mutex
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		hits:     make(map[ownerKey]uint32),
+		branches: make(map[branchKey]*outcomes),
+	}
+}
+
+func (*Recorder) Enabled() bool {
+	return true
+}
+
+// Explain returns true because rule activations are only traced when explaining.
+func (*Recorder) Explain() bool {
+	return true
+}
+
+// AddTrace records the traces of rules and derived roles. The relevant traces have the following structure:
+//
+//	... > policy > rule [> condition > (all|any|none|expr) ...]
+//	policy > derived role [> condition > (all|any|none|expr) ...]
+//
+// Derived roles that are traced as part of a rule don't identify the derived roles policy, so they are ignored.
+func (r *Recorder) AddTrace(trace *enginev1.Trace) {
+	var owner *ownerKey
+	policyKey := ""
+	components := trace.Components
+	for i, c := range components {
+		switch c.Kind {
+		case enginev1.Trace_Component_KIND_POLICY:
+			policyKey = namer.PolicyKeyFromFQN(c.GetPolicy())
+			owner = nil
+
+		case enginev1.Trace_Component_KIND_RULE:
+			owner = &ownerKey{policy: policyKey, name: c.GetRule(), kind: ownerRule}
+			if i == len(components)-1 {
+				r.recordRule(*owner, trace.Event)
+				return
+			}
+
+		case enginev1.Trace_Component_KIND_DERIVED_ROLE:
+			if owner != nil {
+				return
+			}
+			owner = &ownerKey{policy: policyKey, name: c.GetDerivedRole(), kind: ownerDerivedRole}
+
+		case enginev1.Trace_Component_KIND_CONDITION:
+			if owner != nil && owner.name != "" && c.Details == nil {
+				r.recordCondition(*owner, components[i+1:], trace.Event)
+				return
+			}
+
+		default:
+		}
+	}
+}
+
+func (r *Recorder) recordRule(owner ownerKey, event *enginev1.Trace_Event) {
+	if owner.name == "" || event.GetStatus() != enginev1.Trace_Event_STATUS_ACTIVATED || event.GetEffect() == effectv1.Effect_EFFECT_UNSPECIFIED {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hits[owner]++
+}
+
+func (r *Recorder) recordCondition(owner ownerKey, components []*enginev1.Trace_Component, event *enginev1.Trace_Event) {
+	if event.GetError() != "" || event.GetResult() == nil {
+		return
+	}
+
+	result := event.GetResult().GetBoolValue()
+	path, ok := conditionPath(components)
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The outcome of the top-level node decides whether the derived role is activated
+	if owner.kind == ownerDerivedRole && result && !strings.Contains(path, ".") {
+		r.hits[owner]++
+	}
+
+	if path == "" {
+		return
+	}
+
+	key := branchKey{owner: owner, path: path}
+	o, ok := r.branches[key]
+	if !ok {
+		o = &outcomes{}
+		r.branches[key] = o
+	}
+
+	if result {
+		o.true++
+	} else {
+		o.false++
+	}
+}
+
+// conditionPath converts the components of a condition trace to a path relative to the match block.
+// Traces of the sub-expressions of an expression are not condition nodes, so they are rejected.
+func conditionPath(components []*enginev1.Trace_Component) (string, bool) {
+	segments := make([]string, len(components))
+	for i, c := range components {
+		switch c.Kind {
+		case enginev1.Trace_Component_KIND_CONDITION_ALL:
+			segments[i] = "all"
+		case enginev1.Trace_Component_KIND_CONDITION_ANY:
+			segments[i] = "any"
+		case enginev1.Trace_Component_KIND_CONDITION_NONE:
+			segments[i] = "none"
+		case enginev1.Trace_Component_KIND_CONDITION:
+			segments[i] = fmt.Sprintf("of[%d]", c.GetIndex())
+		case enginev1.Trace_Component_KIND_EXPR:
+			if i != len(components)-1 {
+				return "", false
+			}
+			segments[i] = "expr"
+		default:
+			return "", false
+		}
+	}
+
+	return strings.Join(segments, "."), true
+}
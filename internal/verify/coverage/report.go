@@ -0,0 +1,235 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package coverage
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	sourcev1 "github.com/cerbos/cerbos/api/genpb/cerbos/source/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/parser"
+	"github.com/cerbos/cerbos/internal/policy"
+)
+
+// Report is the coverage of the policies by the tests.
+type Report struct {
+	Policies []*PolicyReport `json:"policies"`
+	Summary  Summary         `json:"summary"`
+}
+
+// PolicyReport is the coverage of a single policy.
+type PolicyReport struct {
+	Policy       string     `json:"policy"`
+	File         string     `json:"file"`
+	Rules        []Hits     `json:"rules,omitempty"`
+	DerivedRoles []Hits     `json:"derivedRoles,omitempty"`
+	Branches     []Branches `json:"branches,omitempty"`
+	Summary      Summary    `json:"summary"`
+}
+
+// Hits is the number of times that a rule or derived role was activated.
+type Hits struct {
+	Position *sourcev1.Position `json:"position,omitempty"`
+	Name     string             `json:"name"`
+	Hits     uint32             `json:"hits"`
+}
+
+// Branches is the number of times that a node of a condition evaluated to true and false.
+type Branches struct {
+	Position *sourcev1.Position `json:"position,omitempty"`
+	Owner    string             `json:"owner"`
+	Path     string             `json:"path"`
+	True     uint32             `json:"true"`
+	False    uint32             `json:"false"`
+}
+
+// Summary holds the number of covered and total items of each kind.
+type Summary struct {
+	Rules        Counts `json:"rules"`
+	DerivedRoles Counts `json:"derivedRoles"`
+	Branches     Counts `json:"branches"`
+}
+
+type Counts struct {
+	Covered uint32 `json:"covered"`
+	Total   uint32 `json:"total"`
+}
+
+// Percent returns the percentage of covered items. An empty set of items is fully covered.
+func (c Counts) Percent() float64 {
+	if c.Total == 0 {
+		return 100 //nolint:mnd
+	}
+
+	return 100 * float64(c.Covered) / float64(c.Total) //nolint:mnd
+}
+
+func (c *Counts) add(other Counts) {
+	c.Covered += other.Covered
+	c.Total += other.Total
+}
+
+// Overall returns the combined counts of rules, derived roles and condition branches.
+func (s Summary) Overall() Counts {
+	overall := s.Rules
+	overall.add(s.DerivedRoles)
+	overall.add(s.Branches)
+	return overall
+}
+
+func (s *Summary) add(other Summary) {
+	s.Rules.add(other.Rules)
+	s.DerivedRoles.add(other.DerivedRoles)
+	s.Branches.add(other.Branches)
+}
+
+// NewReport reports the coverage of the resource, principal and derived roles policies of the compilation units.
+// Rules of role policies are not named, so their activations can't be attributed and they are not included.
+func (r *Recorder) NewReport(units []*policy.CompilationUnit) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seen := make(map[namer.ModuleID]struct{})
+	report := &Report{}
+	for _, unit := range units {
+		for modID, p := range unit.Definitions {
+			if _, ok := seen[modID]; ok {
+				continue
+			}
+			seen[modID] = struct{}{}
+
+			if pr := r.policyReport(p, unit.SourceContexts[modID]); pr != nil {
+				report.Policies = append(report.Policies, pr)
+				report.Summary.add(pr.Summary)
+			}
+		}
+	}
+
+	slices.SortFunc(report.Policies, func(a, b *PolicyReport) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Policy, b.Policy))
+	})
+
+	return report
+}
+
+func (r *Recorder) policyReport(p *policyv1.Policy, srcCtx parser.SourceCtx) *PolicyReport {
+	pr := &PolicyReport{
+		Policy: namer.PolicyKey(p),
+		File:   policy.GetSourceFile(p),
+	}
+
+	addRule := func(path, name string, cond *policyv1.Condition) {
+		owner := ownerKey{policy: pr.Policy, name: name, kind: ownerRule}
+		pr.Rules = append(pr.Rules, r.hitsFor(owner, srcCtx.PositionForProtoPath(path)))
+		pr.Branches = append(pr.Branches, r.branchesFor(owner, path+".condition", cond, srcCtx)...)
+	}
+
+	switch pt := p.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		for i, rule := range pt.ResourcePolicy.Rules {
+			addRule(policy.ResourcePolicyRuleProtoPath(i), namer.ResourceRuleName(rule, i+1), rule.Condition)
+		}
+
+	case *policyv1.Policy_PrincipalPolicy:
+		for i, rule := range pt.PrincipalPolicy.Rules {
+			for j, action := range rule.Actions {
+				addRule(policy.PrincipalPolicyActionRuleProtoPath(i, j), namer.PrincipalResourceActionRuleName(action, rule.Resource, j+1), action.Condition)
+			}
+		}
+
+	case *policyv1.Policy_DerivedRoles:
+		for i, def := range pt.DerivedRoles.Definitions {
+			owner := ownerKey{policy: pr.Policy, name: def.Name, kind: ownerDerivedRole}
+			pr.DerivedRoles = append(pr.DerivedRoles, r.hitsFor(owner, srcCtx.PositionForProtoPath(policy.DerivedRoleRuleProtoPath(i))))
+			pr.Branches = append(pr.Branches, r.branchesFor(owner, policy.DerivedRoleConditionProtoPath(i), def.Condition, srcCtx)...)
+		}
+
+	default:
+		return nil
+	}
+
+	for _, h := range pr.Rules {
+		pr.Summary.Rules.Total++
+		if h.Hits > 0 {
+			pr.Summary.Rules.Covered++
+		}
+	}
+
+	for _, h := range pr.DerivedRoles {
+		pr.Summary.DerivedRoles.Total++
+		if h.Hits > 0 {
+			pr.Summary.DerivedRoles.Covered++
+		}
+	}
+
+	for _, b := range pr.Branches {
+		pr.Summary.Branches.Total += 2
+		if b.True > 0 {
+			pr.Summary.Branches.Covered++
+		}
+		if b.False > 0 {
+			pr.Summary.Branches.Covered++
+		}
+	}
+
+	return pr
+}
+
+func (r *Recorder) hitsFor(owner ownerKey, pos *sourcev1.Position) Hits {
+	return Hits{Position: pos, Name: owner.name, Hits: r.hits[owner]}
+}
+
+func (r *Recorder) branchesFor(owner ownerKey, condPath string, cond *policyv1.Condition, srcCtx parser.SourceCtx) []Branches {
+	m := cond.GetMatch()
+	if m == nil {
+		return nil
+	}
+
+	var branches []Branches
+	var visit func(path string, m *policyv1.Match)
+	visit = func(path string, m *policyv1.Match) {
+		var (
+			node string
+			list []*policyv1.Match
+		)
+
+		switch op := m.Op.(type) {
+		case *policyv1.Match_Expr:
+			node = "expr"
+		case *policyv1.Match_All:
+			node, list = "all", op.All.GetOf()
+		case *policyv1.Match_Any:
+			node, list = "any", op.Any.GetOf()
+		case *policyv1.Match_None:
+			node, list = "none", op.None.GetOf()
+		default:
+			return
+		}
+
+		if path != "" {
+			path += "."
+		}
+		path += node
+
+		b := Branches{
+			Position: srcCtx.PositionForProtoPath(condPath + ".match." + path),
+			Owner:    owner.name,
+			Path:     path,
+		}
+		if o, ok := r.branches[branchKey{owner: owner, path: path}]; ok {
+			b.True, b.False = o.true, o.false
+		}
+		branches = append(branches, b)
+
+		for i, sub := range list {
+			visit(fmt.Sprintf("%s.of[%d]", path, i), sub)
+		}
+	}
+
+	visit("", m)
+	return branches
+}
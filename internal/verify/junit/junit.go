@@ -10,6 +10,8 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	"github.com/tidwall/pretty"
 )
@@ -118,6 +120,10 @@ func processTestCases(s *policyv1.TestResults_Suite) ([]testCase, Summary, error
 						testCase.Error = &testError{Type: a.Details.Result.String(), Value: a.Details.GetError()}
 						summary.Errors++
 					case policyv1.TestResults_RESULT_FAILED:
+						if f := a.Details.GetPlanFailure(); f != nil {
+							testCase.Failure = planFailure(a.Details.Result, f)
+						}
+
 						if f := a.Details.GetFailure(); f != nil {
 							testCase.Failure = &failure{
 								Type:    a.Details.Result.String(),
@@ -157,6 +163,14 @@ func processTestCases(s *policyv1.TestResults_Suite) ([]testCase, Summary, error
 
 						summary.Failures++
 					case policyv1.TestResults_RESULT_PASSED:
+						if s := a.Details.GetPlanSuccess(); s != nil {
+							result := planResult(s.Kind, s.Condition)
+							testCase.Success = &success{
+								Type:          a.Details.Result.String(),
+								resultSuccess: resultSuccess{Actual: result, Expected: result},
+							}
+						}
+
 						if s := a.Details.GetSuccess(); s != nil {
 							testCase.Success = &success{
 								Type: a.Details.Result.String(),
@@ -198,6 +212,46 @@ func processTestCases(s *policyv1.TestResults_Suite) ([]testCase, Summary, error
 	return testCases, summary, nil
 }
 
+func planFailure(result policyv1.TestResults_Result, f *policyv1.TestResults_PlanFailure) *failure {
+	pf := &failure{
+		Type:    result.String(),
+		Message: "Plan expectation unsatisfied",
+		resultFailed: resultFailed{
+			Actual:   planResult(f.ActualKind, f.ActualCondition),
+			Expected: planResult(f.ExpectedKind, f.ExpectedCondition),
+		},
+	}
+
+	if len(f.Resources) > 0 {
+		outputSet := make([]output, len(f.Resources))
+		for i, r := range f.Resources {
+			filterEffect := effectv1.Effect_EFFECT_DENY
+			if r.FilterAllowed {
+				filterEffect = effectv1.Effect_EFFECT_ALLOW
+			}
+
+			outputSet[i] = output{
+				Src:      r.Resource,
+				Actual:   outputValue{Value: filterEffect.String()},
+				Expected: outputValue{Value: r.CheckEffect.String()},
+			}
+		}
+
+		pf.Message = "Plan does not match the check decisions"
+		pf.Outputs = &outputSet
+	}
+
+	return pf
+}
+
+func planResult(kind enginev1.PlanResourcesFilter_Kind, condition string) string {
+	if condition == "" {
+		return kind.String()
+	}
+
+	return fmt.Sprintf("%s %s", kind, condition)
+}
+
 func renderValue(v proto.Message) string {
 	vv, err := protojson.Marshal(v)
 	if err != nil {
@@ -0,0 +1,303 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mutation generates mutants of policies and checks whether the policy tests detect them.
+package mutation
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	sourcev1 "github.com/cerbos/cerbos/api/genpb/cerbos/source/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/parser"
+	"github.com/cerbos/cerbos/internal/policy"
+)
+
+type Operator string
+
+const (
+	OperatorFlipEffect      Operator = "flipEffect"
+	OperatorDropRole        Operator = "dropRole"
+	OperatorNegateCondition Operator = "negateCondition"
+	OperatorSwapAllAny      Operator = "swapAllAny"
+	OperatorRemoveRule      Operator = "removeRule"
+	OperatorWidenAction     Operator = "widenAction"
+)
+
+// Mutant is a copy of a policy with a single change to one of its rules or derived roles.
+type Mutant struct {
+	Position    *sourcev1.Position `json:"position,omitempty"`
+	policy      *policyv1.Policy
+	Operator    Operator `json:"operator"`
+	Policy      string   `json:"policy"`
+	File        string   `json:"file"`
+	Rule        string   `json:"rule"`
+	Description string   `json:"description"`
+	modID       namer.ModuleID
+	ID          int `json:"id"`
+}
+
+// Generate returns the mutants of the resource, principal and derived roles policies of the compilation units.
+// Role policies are not mutated because their rules are not named.
+func Generate(units []*policy.CompilationUnit) []*Mutant {
+	type definition struct {
+		def    *policyv1.Policy
+		srcCtx parser.SourceCtx
+		file   string
+		modID  namer.ModuleID
+	}
+
+	defs := make(map[namer.ModuleID]definition)
+	for _, unit := range units {
+		for modID, def := range unit.Definitions {
+			defs[modID] = definition{def: def, srcCtx: unit.SourceContexts[modID], file: policy.GetSourceFile(def), modID: modID}
+		}
+	}
+
+	sorted := slices.SortedFunc(maps.Values(defs), func(a, b definition) int {
+		return cmp.Or(cmp.Compare(a.file, b.file), cmp.Compare(a.modID.RawValue(), b.modID.RawValue()))
+	})
+
+	var mutants []*Mutant
+	for _, d := range sorted {
+		g := &generator{def: d.def, srcCtx: d.srcCtx, modID: d.modID, policyKey: namer.PolicyKey(d.def), file: d.file}
+		g.generate()
+		mutants = append(mutants, g.mutants...)
+	}
+
+	for i, m := range mutants {
+		m.ID = i + 1
+	}
+
+	return mutants
+}
+
+type generator struct {
+	def       *policyv1.Policy
+	srcCtx    parser.SourceCtx
+	policyKey string
+	file      string
+	mutants   []*Mutant
+	modID     namer.ModuleID
+}
+
+func (g *generator) generate() {
+	switch pt := g.def.PolicyType.(type) {
+	case *policyv1.Policy_ResourcePolicy:
+		for i, rule := range pt.ResourcePolicy.Rules {
+			g.resourceRule(i, rule)
+		}
+
+	case *policyv1.Policy_PrincipalPolicy:
+		for i, rule := range pt.PrincipalPolicy.Rules {
+			for j, action := range rule.Actions {
+				g.principalRule(i, j, rule, action)
+			}
+		}
+
+	case *policyv1.Policy_DerivedRoles:
+		for i, def := range pt.DerivedRoles.Definitions {
+			g.derivedRole(i, def)
+		}
+
+	default:
+	}
+}
+
+func (g *generator) resourceRule(idx int, rule *policyv1.ResourceRule) {
+	path := policy.ResourcePolicyRuleProtoPath(idx)
+	name := namer.ResourceRuleName(rule, idx+1)
+	get := func(p *policyv1.Policy) *policyv1.ResourceRule {
+		return p.GetResourcePolicy().Rules[idx]
+	}
+
+	effect := flip(rule.Effect)
+	g.add(OperatorFlipEffect, name, path, fmt.Sprintf("Change the effect to %s", effect), func(p *policyv1.Policy) {
+		get(p).Effect = effect
+	})
+
+	if len(rule.Roles)+len(rule.DerivedRoles) > 1 {
+		for i, role := range rule.Roles {
+			g.add(OperatorDropRole, name, fmt.Sprintf("%s.roles[%d]", path, i), fmt.Sprintf("Remove role %q", role), func(p *policyv1.Policy) {
+				r := get(p)
+				r.Roles = slices.Delete(r.Roles, i, i+1)
+			})
+		}
+
+		for i, role := range rule.DerivedRoles {
+			g.add(OperatorDropRole, name, policy.ResourcePolicyRuleReferencedDerivedRoleProtoPath(idx, i), fmt.Sprintf("Remove derived role %q", role), func(p *policyv1.Policy) {
+				r := get(p)
+				r.DerivedRoles = slices.Delete(r.DerivedRoles, i, i+1)
+			})
+		}
+	}
+
+	g.condition(name, path+".condition", rule.Condition, func(p *policyv1.Policy) *policyv1.Condition {
+		return get(p).Condition
+	})
+
+	g.add(OperatorRemoveRule, name, path, "Remove the rule", func(p *policyv1.Policy) {
+		rp := p.GetResourcePolicy()
+		rp.Rules = slices.Delete(rp.Rules, idx, idx+1)
+	})
+
+	if !slices.Contains(rule.Actions, "*") {
+		g.add(OperatorWidenAction, name, path, `Replace the actions with "*"`, func(p *policyv1.Policy) {
+			get(p).Actions = []string{"*"}
+		})
+	}
+}
+
+func (g *generator) principalRule(ruleIdx, actionIdx int, rule *policyv1.PrincipalRule, action *policyv1.PrincipalRule_Action) {
+	path := policy.PrincipalPolicyActionRuleProtoPath(ruleIdx, actionIdx)
+	name := namer.PrincipalResourceActionRuleName(action, rule.Resource, actionIdx+1)
+	get := func(p *policyv1.Policy) *policyv1.PrincipalRule_Action {
+		return p.GetPrincipalPolicy().Rules[ruleIdx].Actions[actionIdx]
+	}
+
+	effect := flip(action.Effect)
+	g.add(OperatorFlipEffect, name, path, fmt.Sprintf("Change the effect to %s", effect), func(p *policyv1.Policy) {
+		get(p).Effect = effect
+	})
+
+	g.condition(name, path+".condition", action.Condition, func(p *policyv1.Policy) *policyv1.Condition {
+		return get(p).Condition
+	})
+
+	g.add(OperatorRemoveRule, name, path, "Remove the rule", func(p *policyv1.Policy) {
+		pp := p.GetPrincipalPolicy()
+		r := pp.Rules[ruleIdx]
+		if len(r.Actions) == 1 {
+			pp.Rules = slices.Delete(pp.Rules, ruleIdx, ruleIdx+1)
+			return
+		}
+
+		r.Actions = slices.Delete(r.Actions, actionIdx, actionIdx+1)
+	})
+
+	if action.Action != "*" {
+		g.add(OperatorWidenAction, name, path, `Replace the action with "*"`, func(p *policyv1.Policy) {
+			get(p).Action = "*"
+		})
+	}
+}
+
+func (g *generator) derivedRole(idx int, def *policyv1.RoleDef) {
+	path := policy.DerivedRoleRuleProtoPath(idx)
+	get := func(p *policyv1.Policy) *policyv1.RoleDef {
+		return p.GetDerivedRoles().Definitions[idx]
+	}
+
+	if len(def.ParentRoles) > 1 {
+		for i, role := range def.ParentRoles {
+			g.add(OperatorDropRole, def.Name, fmt.Sprintf("%s.parent_roles[%d]", path, i), fmt.Sprintf("Remove parent role %q", role), func(p *policyv1.Policy) {
+				d := get(p)
+				d.ParentRoles = slices.Delete(d.ParentRoles, i, i+1)
+			})
+		}
+	}
+
+	g.condition(def.Name, policy.DerivedRoleConditionProtoPath(idx), def.Condition, func(p *policyv1.Policy) *policyv1.Condition {
+		return get(p).Condition
+	})
+}
+
+// condition adds the mutants that negate the condition and swap each of its `all` and `any` nodes.
+// Conditions defined as scripts are not mutated.
+func (g *generator) condition(rule, path string, cond *policyv1.Condition, get func(*policyv1.Policy) *policyv1.Condition) {
+	m := cond.GetMatch()
+	if m == nil {
+		return
+	}
+
+	g.add(OperatorNegateCondition, rule, path, "Negate the condition", func(p *policyv1.Policy) {
+		c := get(p)
+		c.Condition = &policyv1.Condition_Match{Match: &policyv1.Match{
+			Op: &policyv1.Match_None{None: &policyv1.Match_ExprList{Of: []*policyv1.Match{c.GetMatch()}}},
+		}}
+	})
+
+	var visit func(nodePath string, m *policyv1.Match, get func(*policyv1.Policy) *policyv1.Match)
+	visit = func(nodePath string, m *policyv1.Match, get func(*policyv1.Policy) *policyv1.Match) {
+		var list []*policyv1.Match
+		switch op := m.Op.(type) {
+		case *policyv1.Match_All:
+			nodePath += "all"
+			list = op.All.GetOf()
+			g.add(OperatorSwapAllAny, rule, nodePath, "Replace `all` with `any`", func(p *policyv1.Policy) {
+				n := get(p)
+				n.Op = &policyv1.Match_Any{Any: n.GetAll()}
+			})
+
+		case *policyv1.Match_Any:
+			nodePath += "any"
+			list = op.Any.GetOf()
+			g.add(OperatorSwapAllAny, rule, nodePath, "Replace `any` with `all`", func(p *policyv1.Policy) {
+				n := get(p)
+				n.Op = &policyv1.Match_All{All: n.GetAny()}
+			})
+
+		case *policyv1.Match_None:
+			nodePath += "none"
+			list = op.None.GetOf()
+
+		default:
+			return
+		}
+
+		for i, sub := range list {
+			visit(fmt.Sprintf("%s.of[%d].", nodePath, i), sub, func(p *policyv1.Policy) *policyv1.Match {
+				return exprList(get(p)).Of[i]
+			})
+		}
+	}
+
+	visit(path+".match.", m, func(p *policyv1.Policy) *policyv1.Match {
+		return get(p).GetMatch()
+	})
+}
+
+// add records a mutant of the policy produced by applying the mutate function to a copy of the policy.
+func (g *generator) add(op Operator, rule, protoPath, description string, mutate func(*policyv1.Policy)) {
+	p := proto.Clone(g.def).(*policyv1.Policy) //nolint:forcetypeassert
+	mutate(p)
+
+	g.mutants = append(g.mutants, &Mutant{
+		Position:    g.srcCtx.PositionForProtoPath(protoPath),
+		policy:      p,
+		Operator:    op,
+		Policy:      g.policyKey,
+		File:        g.file,
+		Rule:        rule,
+		Description: description,
+		modID:       g.modID,
+	})
+}
+
+func exprList(m *policyv1.Match) *policyv1.Match_ExprList {
+	switch op := m.Op.(type) {
+	case *policyv1.Match_All:
+		return op.All
+	case *policyv1.Match_Any:
+		return op.Any
+	case *policyv1.Match_None:
+		return op.None
+	default:
+		return nil
+	}
+}
+
+func flip(effect effectv1.Effect) effectv1.Effect {
+	if effect == effectv1.Effect_EFFECT_ALLOW {
+		return effectv1.Effect_EFFECT_DENY
+	}
+
+	return effectv1.Effect_EFFECT_ALLOW
+}
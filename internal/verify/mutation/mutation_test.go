@@ -0,0 +1,189 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rogpeppe/go-internal/txtar"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/verify"
+	"github.com/cerbos/cerbos/internal/verify/mutation"
+)
+
+const policies = `
+-- derived_roles.yaml --
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: common
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: request.resource.attr.owner == request.principal.id
+-- document.yaml --
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  resource: document
+  version: default
+  importDerivedRoles:
+    - common
+  rules:
+    - name: view
+      actions: ["view"]
+      effect: EFFECT_ALLOW
+      roles: ["user", "admin"]
+      condition:
+        match:
+          all:
+            of:
+              - expr: R.attr.public
+              - expr: R.attr.published
+    - name: delete
+      actions: ["delete"]
+      effect: EFFECT_ALLOW
+      derivedRoles: ["owner"]
+-- document_test.yaml --
+name: DocumentTestSuite
+principals:
+  alice:
+    id: alice
+    roles: ["user"]
+resources:
+  public:
+    id: public
+    kind: document
+    attr:
+      owner: alice
+      public: true
+      published: true
+  draft:
+    id: draft
+    kind: document
+    attr:
+      owner: bob
+      public: true
+      published: false
+tests:
+  - name: View and delete
+    input:
+      principals: ["alice"]
+      resources: ["public", "draft"]
+      actions: ["view", "delete"]
+    expected:
+      - principal: alice
+        resource: public
+        actions:
+          view: EFFECT_ALLOW
+          delete: EFFECT_ALLOW
+      - principal: alice
+        resource: draft
+        actions:
+          view: EFFECT_DENY
+          delete: EFFECT_DENY
+`
+
+func TestMutation(t *testing.T) {
+	archive := txtar.Parse([]byte(policies))
+	dir := t.TempDir()
+	require.NoError(t, txtar.Write(archive, dir))
+
+	units := mkCompilationUnits(t, archive)
+	mutants := mutation.Generate(units)
+
+	type mutant struct {
+		file     string
+		rule     string
+		operator mutation.Operator
+		line     uint32
+	}
+
+	summarise := func(m *mutation.Mutant) mutant {
+		return mutant{file: m.File, rule: m.Rule, operator: m.Operator, line: m.Position.GetLine()}
+	}
+
+	have := make([]mutant, len(mutants))
+	for i, m := range mutants {
+		require.Equal(t, i+1, m.ID)
+		have[i] = summarise(m)
+	}
+
+	require.Equal(t, []mutant{
+		{file: "derived_roles.yaml", rule: "owner", operator: mutation.OperatorNegateCondition, line: 7},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorFlipEffect, line: 8},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorDropRole, line: 11},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorDropRole, line: 11},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorNegateCondition, line: 12},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorSwapAllAny, line: 14},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorRemoveRule, line: 8},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorWidenAction, line: 8},
+		{file: "document.yaml", rule: "delete", operator: mutation.OperatorFlipEffect, line: 18},
+		{file: "document.yaml", rule: "delete", operator: mutation.OperatorRemoveRule, line: 18},
+		{file: "document.yaml", rule: "delete", operator: mutation.OperatorWidenAction, line: 18},
+	}, have)
+
+	runner, err := mutation.NewRunner(units, schema.NewNopManager(), os.DirFS(dir), verify.Config{}, 2)
+	require.NoError(t, err)
+
+	report, err := runner.Run(t.Context(), mutants)
+	require.NoError(t, err)
+	require.Len(t, report.Results, len(mutants))
+
+	var survived []mutant
+	for _, res := range report.Results {
+		if res.Status == mutation.StatusSurvived {
+			survived = append(survived, summarise(res.Mutant))
+		}
+	}
+
+	// The tests don't use the admin role, and widening either rule to all actions doesn't change any of the expected effects.
+	require.ElementsMatch(t, []mutant{
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorDropRole, line: 11},
+		{file: "document.yaml", rule: "view", operator: mutation.OperatorWidenAction, line: 8},
+		{file: "document.yaml", rule: "delete", operator: mutation.OperatorWidenAction, line: 18},
+	}, survived)
+	require.Equal(t, mutation.Summary{Killed: 8, Survived: 3}, report.Summary)
+	require.InDelta(t, 72.7, report.Summary.Score(), 0.1)
+}
+
+func TestScore(t *testing.T) {
+	require.InDelta(t, 50.0, mutation.Summary{Killed: 1, Survived: 1, Invalid: 3, Errored: 2}.Score(), 0.1)
+	require.InDelta(t, 100.0, mutation.Summary{Invalid: 1, Errored: 1}.Score(), 0.1)
+}
+
+func mkCompilationUnits(t *testing.T, archive *txtar.Archive) []*policy.CompilationUnit {
+	t.Helper()
+
+	unit := &policy.CompilationUnit{}
+	derivedRoles := &policy.CompilationUnit{}
+	for _, f := range archive.Files {
+		if strings.HasSuffix(f.Name, "_test.yaml") {
+			continue
+		}
+
+		p, sc, err := policy.ReadPolicyWithSourceContextFromReader(bytes.NewReader(f.Data))
+		require.NoError(t, err, "Unexpected error from %s", f.Name)
+
+		modID := namer.GenModuleID(p)
+		p = policy.WithMetadata(p, f.Name, nil, f.Name, policy.SourceFile(f.Name))
+		if f.Name == "document.yaml" {
+			unit.ModID = modID
+		} else {
+			derivedRoles.ModID = modID
+			derivedRoles.AddDefinition(modID, p, sc)
+		}
+
+		unit.AddDefinition(modID, p, sc)
+	}
+
+	return []*policy.CompilationUnit{unit, derivedRoles}
+}
@@ -0,0 +1,197 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"runtime"
+
+	"github.com/sourcegraph/conc/pool"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/ruletable"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/validator"
+	"github.com/cerbos/cerbos/internal/verify"
+)
+
+type Status string
+
+const (
+	// StatusKilled means that at least one test failed when run against the mutant.
+	StatusKilled Status = "killed"
+	// StatusSurvived means that all the tests passed when run against the mutant.
+	StatusSurvived Status = "survived"
+	// StatusInvalid means that the mutant is not a valid policy, so the tests were not run.
+	StatusInvalid Status = "invalid"
+	// StatusErrored means that no test failed, but some tests could not be run against the mutant, so it can't be classified.
+	StatusErrored Status = "errored"
+)
+
+type Result struct {
+	Mutant *Mutant `json:"mutant"`
+	Status Status  `json:"status"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// Report is the outcome of running the tests against each mutant.
+type Report struct {
+	Results []*Result `json:"results"`
+	Summary Summary   `json:"summary"`
+}
+
+type Summary struct {
+	Killed   int `json:"killed"`
+	Survived int `json:"survived"`
+	Invalid  int `json:"invalid"`
+	Errored  int `json:"errored"`
+}
+
+// Score returns the percentage of valid mutants that were killed, excluding the mutants that the tests errored on.
+// There is nothing to detect if there are no such mutants.
+func (s Summary) Score() float64 {
+	total := s.Killed + s.Survived
+	if total == 0 {
+		return 100 //nolint:mnd
+	}
+
+	return 100 * float64(s.Killed) / float64(total) //nolint:mnd
+}
+
+type compiledUnit struct {
+	unit  *policy.CompilationUnit
+	rps   *runtimev1.RunnablePolicySet
+	modID namer.ModuleID
+}
+
+// Runner runs the test suites against mutants of the policies.
+type Runner struct {
+	schemaMgr  schema.Manager
+	testsFS    fs.FS
+	verifyConf verify.Config
+	units      []compiledUnit
+	workers    int
+}
+
+// NewRunner compiles the compilation units to create a runner for the tests found in testsFS.
+// The mutants are evaluated by up to the given number of workers in parallel. The number of CPUs is used if it's zero.
+func NewRunner(units []*policy.CompilationUnit, schemaMgr schema.Manager, testsFS fs.FS, verifyConf verify.Config, workers int) (*Runner, error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	// The traces are not displayed for mutants, so there's no point in collecting them.
+	verifyConf.Trace = false
+	verifyConf.Coverage = nil
+
+	r := &Runner{schemaMgr: schemaMgr, testsFS: testsFS, verifyConf: verifyConf, workers: workers}
+	for _, unit := range units {
+		rps, err := compile.Compile(unit, schemaMgr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile %q: %w", unit.MainSourceFile(), err)
+		}
+
+		r.units = append(r.units, compiledUnit{unit: unit, rps: rps, modID: unit.ModID})
+	}
+
+	return r, nil
+}
+
+// Run runs the tests against each of the mutants and reports the ones that were not detected by any test.
+func (r *Runner) Run(ctx context.Context, mutants []*Mutant) (*Report, error) {
+	results := make([]*Result, len(mutants))
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError().WithMaxGoroutines(r.workers)
+	for i, m := range mutants {
+		p.Go(func(ctx context.Context) error {
+			res, err := r.run(ctx, m)
+			if err != nil {
+				return fmt.Errorf("failed to run tests against mutant %d: %w", m.ID, err)
+			}
+
+			results[i] = res
+			return nil
+		})
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{Results: results}
+	for _, res := range results {
+		switch res.Status {
+		case StatusKilled:
+			report.Summary.Killed++
+		case StatusSurvived:
+			report.Summary.Survived++
+		case StatusInvalid:
+			report.Summary.Invalid++
+		case StatusErrored:
+			report.Summary.Errored++
+		}
+	}
+
+	return report, nil
+}
+
+func (r *Runner) run(ctx context.Context, m *Mutant) (*Result, error) {
+	if err := validator.Validate(m.policy); err != nil {
+		return &Result{Mutant: m, Status: StatusInvalid, Error: err.Error()}, nil
+	}
+
+	rt := ruletable.NewProtoRuletable()
+	for _, cu := range r.units {
+		rps := cu.rps
+		// Every compilation unit that includes the mutated policy must be recompiled.
+		if _, ok := cu.unit.Definitions[m.modID]; ok {
+			unit := &policy.CompilationUnit{
+				ModID:          cu.modID,
+				Definitions:    maps.Clone(cu.unit.Definitions),
+				SourceContexts: cu.unit.SourceContexts,
+			}
+			unit.Definitions[m.modID] = m.policy
+
+			var err error
+			if rps, err = compile.Compile(unit, r.schemaMgr); err != nil {
+				return &Result{Mutant: m, Status: StatusInvalid, Error: err.Error()}, nil
+			}
+		}
+
+		if rps != nil {
+			rt.Rules = append(rt.Rules, ruletable.AddPolicy(rt, rps)...)
+		}
+	}
+
+	rtMgr, err := ruletable.NewRuleTableManager(rt, nil, nil, r.schemaMgr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rule table: %w", err)
+	}
+
+	results, err := verify.Verify(ctx, r.testsFS, engine.NewEphemeral(nil, rtMgr, r.schemaMgr), r.verifyConf)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[policyv1.TestResults_Result]uint32, len(results.Summary.ResultCounts))
+	for _, tally := range results.Summary.ResultCounts {
+		counts[tally.Result] = tally.Count
+	}
+
+	switch {
+	case counts[policyv1.TestResults_RESULT_FAILED] > 0:
+		return &Result{Mutant: m, Status: StatusKilled}, nil
+	case counts[policyv1.TestResults_RESULT_ERRORED] > 0:
+		return &Result{Mutant: m, Status: StatusErrored, Error: fmt.Sprintf("%d tests errored", counts[policyv1.TestResults_RESULT_ERRORED])}, nil
+	default:
+		return &Result{Mutant: m, Status: StatusSurvived}, nil
+	}
+}
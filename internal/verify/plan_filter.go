@@ -0,0 +1,360 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+)
+
+// evalPlanFilter evaluates a query plan filter against a resource fixture.
+// The filter condition is converted back to a CEL expression, so that it is evaluated with the same functions as the policy conditions.
+func evalPlanFilter(filter *enginev1.PlanResourcesFilter, resource *enginev1.Resource) (bool, error) {
+	switch filter.GetKind() {
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED:
+		return true, nil
+	case enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED:
+		return false, nil
+	case enginev1.PlanResourcesFilter_KIND_CONDITIONAL:
+	default:
+		return false, fmt.Errorf("unexpected filter kind %s", filter.GetKind())
+	}
+
+	var sb strings.Builder
+	if err := writeCEL(&sb, filter.Condition); err != nil {
+		return false, err
+	}
+
+	env := conditions.StdEnv
+	ast, issues := env.Parse(sb.String())
+	if issues != nil && issues.Err() != nil {
+		return false, fmt.Errorf("failed to parse %q: %w", sb.String(), issues.Err())
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return false, fmt.Errorf("failed to create program for %q: %w", sb.String(), err)
+	}
+
+	r := &enginev1.Request_Resource{
+		Kind:          resource.Kind,
+		Id:            resource.Id,
+		Attr:          resource.Attr,
+		PolicyVersion: resource.PolicyVersion,
+		Scope:         resource.Scope,
+	}
+
+	val, _, err := prg.Eval(map[string]any{
+		conditions.CELRequestIdent:   &enginev1.Request{Resource: r},
+		conditions.CELResourceAbbrev: r,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate %q: %w", sb.String(), err)
+	}
+
+	result, ok := val.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("filter %q evaluated to non-boolean value %v", sb.String(), val)
+	}
+
+	return result, nil
+}
+
+// writeCEL writes the CEL representation of a filter operand.
+func writeCEL(sb *strings.Builder, operand *enginev1.PlanResourcesFilter_Expression_Operand) error {
+	switch node := operand.GetNode().(type) {
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Value:
+		return writeCELValue(sb, node.Value)
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Variable:
+		sb.WriteString(node.Variable)
+		return nil
+	case *enginev1.PlanResourcesFilter_Expression_Operand_Expression:
+		return writeCELExpr(sb, node.Expression)
+	default:
+		return fmt.Errorf("unexpected operand %T", node)
+	}
+}
+
+var celInfixOperators = map[string]string{
+	planner.And:                "&&",
+	planner.Or:                 "||",
+	planner.Equals:             "==",
+	planner.NotEquals:          "!=",
+	planner.GreaterThan:        ">",
+	planner.GreaterThanOrEqual: ">=",
+	planner.LessThan:           "<",
+	planner.LessThanOrEqual:    "<=",
+	planner.In:                 "in",
+	planner.Add:                "+",
+	planner.Sub:                "-",
+	planner.Mult:               "*",
+	planner.Div:                "/",
+	planner.Mod:                "%",
+}
+
+func writeCELExpr(sb *strings.Builder, expr *enginev1.PlanResourcesFilter_Expression) error {
+	ops := expr.Operands
+
+	if infix, ok := celInfixOperators[expr.Operator]; ok {
+		if len(ops) == 0 {
+			return fmt.Errorf("operator %q has no operands", expr.Operator)
+		}
+
+		sb.WriteString("(")
+		for i, op := range ops {
+			if i > 0 {
+				fmt.Fprintf(sb, " %s ", infix)
+			}
+			if err := writeCEL(sb, op); err != nil {
+				return err
+			}
+		}
+		sb.WriteString(")")
+		return nil
+	}
+
+	switch expr.Operator {
+	case planner.Not, "-_":
+		if len(ops) != 1 {
+			return fmt.Errorf("operator %q expects one operand", expr.Operator)
+		}
+
+		prefix := "!"
+		if expr.Operator == "-_" {
+			prefix = "-"
+		}
+
+		sb.WriteString(prefix)
+		sb.WriteString("(")
+		if err := writeCEL(sb, ops[0]); err != nil {
+			return err
+		}
+		sb.WriteString(")")
+		return nil
+
+	case planner.If:
+		if len(ops) != 3 { //nolint:mnd
+			return fmt.Errorf("operator %q expects three operands", expr.Operator)
+		}
+
+		return writeCELSeq(sb, "(", ")", []string{" ? ", " : "}, ops)
+
+	case planner.Index:
+		if len(ops) != 2 { //nolint:mnd
+			return fmt.Errorf("operator %q expects two operands", expr.Operator)
+		}
+
+		return writeCELSeq(sb, "", "]", []string{"["}, ops)
+
+	case planner.GetField:
+		if len(ops) != 2 || ops[1].GetVariable() == "" { //nolint:mnd
+			return fmt.Errorf("operator %q expects an operand and a field name", expr.Operator)
+		}
+
+		if err := writeCEL(sb, ops[0]); err != nil {
+			return err
+		}
+		sb.WriteString(".")
+		sb.WriteString(ops[1].GetVariable())
+		return nil
+
+	case planner.List:
+		return writeCELSeq(sb, "[", "]", []string{", "}, ops)
+
+	case planner.Struct:
+		sb.WriteString("{")
+		for i, op := range ops {
+			field := op.GetExpression()
+			if field.GetOperator() != planner.SetField || len(field.GetOperands()) != 2 { //nolint:mnd
+				return fmt.Errorf("unexpected struct field %v", op)
+			}
+
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if err := writeCELSeq(sb, "", "", []string{": "}, field.Operands); err != nil {
+				return err
+			}
+		}
+		sb.WriteString("}")
+		return nil
+
+	case planner.All, planner.Exists, planner.ExistsOne, planner.Filter, planner.Map, planner.TransformList,
+		planner.TransformMap, planner.TransformMapEntry, "sortBy":
+		return writeCELComprehension(sb, expr)
+
+	default:
+		return writeCELCall(sb, expr.Operator, ops)
+	}
+}
+
+// writeCELComprehension writes a `(op target (lambda body [body2] var [var2]))` expression as a CEL macro call.
+func writeCELComprehension(sb *strings.Builder, expr *enginev1.PlanResourcesFilter_Expression) error {
+	if len(expr.Operands) != 2 || expr.Operands[1].GetExpression().GetOperator() != planner.Lambda { //nolint:mnd
+		return fmt.Errorf("operator %q expects a range and a lambda", expr.Operator)
+	}
+
+	lambda := expr.Operands[1].GetExpression().Operands
+	var bodies, vars []*enginev1.PlanResourcesFilter_Expression_Operand
+	switch len(lambda) {
+	case 2: //nolint:mnd
+		bodies, vars = lambda[:1], lambda[1:]
+	case 3: //nolint:mnd
+		// either a two-variable comprehension or a single-variable map with a filter
+		if lambda[1].GetVariable() != "" {
+			bodies, vars = lambda[:1], lambda[1:]
+		} else {
+			bodies, vars = lambda[:2], lambda[2:]
+		}
+	case 4: //nolint:mnd
+		bodies, vars = lambda[:2], lambda[2:]
+	default:
+		return fmt.Errorf("unexpected lambda for operator %q", expr.Operator)
+	}
+
+	macro := expr.Operator
+	switch {
+	case macro == planner.ExistsOne && len(vars) == 2: //nolint:mnd
+		macro = "existsOne"
+	case macro == planner.TransformList && len(vars) == 1:
+		macro = planner.Map
+	}
+
+	if err := writeCEL(sb, expr.Operands[0]); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(sb, ".%s(", macro)
+	for _, v := range vars {
+		if v.GetVariable() == "" {
+			return fmt.Errorf("unexpected lambda variable for operator %q", expr.Operator)
+		}
+		sb.WriteString(v.GetVariable())
+		sb.WriteString(", ")
+	}
+
+	return writeCELSeq(sb, "", ")", []string{", "}, bodies)
+}
+
+// writeCELCall writes a function call, using the receiver-style syntax if the function only has member overloads with a matching arity.
+func writeCELCall(sb *strings.Builder, name string, ops []*enginev1.PlanResourcesFilter_Expression_Operand) error {
+	fn, ok := conditions.StdEnv.Functions()[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", planner.ErrUnknownOperator, name)
+	}
+
+	member := false
+	for _, o := range fn.OverloadDecls() {
+		if len(o.ArgTypes()) != len(ops) {
+			continue
+		}
+
+		if !o.IsMemberFunction() {
+			member = false
+			break
+		}
+		member = true
+	}
+
+	if member {
+		if len(ops) == 0 {
+			return errors.New("member function without a receiver")
+		}
+
+		if err := writeCEL(sb, ops[0]); err != nil {
+			return err
+		}
+		sb.WriteString(".")
+		ops = ops[1:]
+	}
+
+	sb.WriteString(name)
+	return writeCELSeq(sb, "(", ")", []string{", "}, ops)
+}
+
+// writeCELSeq writes the operands with the given separators between them. The last separator is repeated if there are more operands than separators.
+func writeCELSeq(sb *strings.Builder, prefix, suffix string, seps []string, ops []*enginev1.PlanResourcesFilter_Expression_Operand) error {
+	sb.WriteString(prefix)
+	for i, op := range ops {
+		if i > 0 {
+			sb.WriteString(seps[min(i-1, len(seps)-1)])
+		}
+		if err := writeCEL(sb, op); err != nil {
+			return err
+		}
+	}
+	sb.WriteString(suffix)
+	return nil
+}
+
+func writeCELValue(sb *strings.Builder, value *structpb.Value) error {
+	switch v := value.GetKind().(type) {
+	case *structpb.Value_NullValue:
+		sb.WriteString("null")
+	case *structpb.Value_BoolValue:
+		sb.WriteString(strconv.FormatBool(v.BoolValue))
+	case *structpb.Value_NumberValue:
+		switch n := v.NumberValue; {
+		case math.IsNaN(n):
+			sb.WriteString(`double("NaN")`)
+		case math.IsInf(n, 1):
+			sb.WriteString(`double("Infinity")`)
+		case math.IsInf(n, -1):
+			sb.WriteString(`double("-Infinity")`)
+		default:
+			s := strconv.FormatFloat(n, 'g', -1, 64)
+			if !strings.ContainsAny(s, ".e") {
+				s += ".0"
+			}
+			sb.WriteString(s)
+		}
+	case *structpb.Value_StringValue:
+		sb.WriteString(strconv.Quote(v.StringValue))
+	case *structpb.Value_ListValue:
+		sb.WriteString("[")
+		for i, elem := range v.ListValue.GetValues() {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if err := writeCELValue(sb, elem); err != nil {
+				return err
+			}
+		}
+		sb.WriteString("]")
+	case *structpb.Value_StructValue:
+		fields := v.StructValue.GetFields()
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		sb.WriteString("{")
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(k))
+			sb.WriteString(": ")
+			if err := writeCELValue(sb, fields[k]); err != nil {
+				return err
+			}
+		}
+		sb.WriteString("}")
+	default:
+		return fmt.Errorf("unexpected value %v", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+func TestEvalPlanFilter(t *testing.T) {
+	resource := &enginev1.Resource{
+		Kind: "leave_request",
+		Id:   "XX125",
+		Attr: map[string]*structpb.Value{
+			"owner":   structpb.NewStringValue("harry"),
+			"days":    structpb.NewNumberValue(3),
+			"created": structpb.NewStringValue("2024-01-15T09:00:00Z"),
+			"tags":    structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("urgent"), structpb.NewStringValue("travel")}}),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		condition *enginev1.PlanResourcesFilter_Expression_Operand
+		wantCEL   string
+		want      bool
+	}{
+		{
+			name:      "eq",
+			condition: mkExpr("eq", mkVar("request.resource.attr.owner"), mkValue(structpb.NewStringValue("harry"))),
+			wantCEL:   `(request.resource.attr.owner == "harry")`,
+			want:      true,
+		},
+		{
+			name: "and_not",
+			condition: mkExpr("and",
+				mkExpr("lt", mkVar("request.resource.attr.days"), mkValue(structpb.NewNumberValue(5))),
+				mkExpr("not", mkExpr("in", mkValue(structpb.NewStringValue("sick")), mkVar("request.resource.attr.tags"))),
+			),
+			wantCEL: `((request.resource.attr.days < 5.0) && !(("sick" in request.resource.attr.tags)))`,
+			want:    true,
+		},
+		{
+			name:      "member_function",
+			condition: mkExpr("startsWith", mkVar("request.resource.attr.owner"), mkValue(structpb.NewStringValue("m"))),
+			wantCEL:   `request.resource.attr.owner.startsWith("m")`,
+			want:      false,
+		},
+		{
+			name: "global_function",
+			condition: mkExpr("hasIntersection", mkVar("request.resource.attr.tags"),
+				mkValue(structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("travel")}}))),
+			wantCEL: `hasIntersection(request.resource.attr.tags, ["travel"])`,
+			want:    true,
+		},
+		{
+			name: "comprehension",
+			condition: mkExpr("exists", mkVar("request.resource.attr.tags"),
+				mkExpr("lambda", mkExpr("eq", mkVar("t"), mkValue(structpb.NewStringValue("urgent"))), mkVar("t"))),
+			wantCEL: `request.resource.attr.tags.exists(t, (t == "urgent"))`,
+			want:    true,
+		},
+		{
+			name: "timestamp",
+			condition: mkExpr("lt",
+				mkExpr("timestamp", mkVar("request.resource.attr.created")),
+				mkExpr("timestamp", mkValue(structpb.NewStringValue("2024-01-16T00:00:00Z")))),
+			wantCEL: `(timestamp(request.resource.attr.created) < timestamp("2024-01-16T00:00:00Z"))`,
+			want:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sb strings.Builder
+			require.NoError(t, writeCEL(&sb, tc.condition))
+			require.Equal(t, tc.wantCEL, sb.String())
+
+			filter := &enginev1.PlanResourcesFilter{Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL, Condition: tc.condition}
+			have, err := evalPlanFilter(filter, resource)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func TestConditionString(t *testing.T) {
+	setField := func(name, value string) *enginev1.PlanResourcesFilter_Expression_Operand {
+		return mkExpr("set-field", mkValue(structpb.NewStringValue(name)), mkValue(structpb.NewStringValue(value)))
+	}
+
+	filter := &enginev1.PlanResourcesFilter{
+		Kind: enginev1.PlanResourcesFilter_KIND_CONDITIONAL,
+		Condition: mkExpr("eq",
+			mkVar("request.resource.attr.team"),
+			mkExpr("get-field", mkExpr("struct", setField("team", "design"), setField("department", "marketing")), mkVar("team"))),
+	}
+
+	want := `(eq request.resource.attr.team (get-field (struct (set-field "department" "marketing") (set-field "team" "design")) team))`
+	require.Equal(t, want, conditionString(filter))
+	require.Equal(t, "team", setFieldName(filter.Condition.GetExpression().Operands[1].GetExpression().Operands[0].GetExpression().Operands[0]), "filter was modified")
+}
+
+func mkExpr(op string, operands ...*enginev1.PlanResourcesFilter_Expression_Operand) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{
+		Node: &enginev1.PlanResourcesFilter_Expression_Operand_Expression{
+			Expression: &enginev1.PlanResourcesFilter_Expression{Operator: op, Operands: operands},
+		},
+	}
+}
+
+func mkVar(name string) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Variable{Variable: name}}
+}
+
+func mkValue(v *structpb.Value) *enginev1.PlanResourcesFilter_Expression_Operand {
+	return &enginev1.PlanResourcesFilter_Expression_Operand{Node: &enginev1.PlanResourcesFilter_Expression_Operand_Value{Value: v}}
+}
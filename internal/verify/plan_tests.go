@@ -0,0 +1,312 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/evaluator"
+	"github.com/cerbos/cerbos/internal/ruletable/planner"
+)
+
+var errPlanningUnsupported = errors.New("plan tests are not supported by this engine")
+
+// Planner is implemented by engines that can produce query plans. Plan tests fail with an error if the engine used to run
+// the test suites does not implement it.
+type Planner interface {
+	Plan(ctx context.Context, input *enginev1.PlanResourcesInput, opts ...evaluator.CheckOpt) (*enginev1.PlanResourcesOutput, error)
+}
+
+type planTest struct {
+	Name      *policyv1.Test_TestName
+	Input     *enginev1.PlanResourcesInput
+	Expected  map[string]*policyv1.PlanTestTable_PlanExpectation
+	Resources []string
+	Options   *policyv1.TestOptions
+	Table     *policyv1.PlanTestTable
+}
+
+func (r *testSuiteRun) getPlanTests() ([]*planTest, error) {
+	var allTests []*planTest
+
+	for _, table := range r.Suite.PlanTests {
+		tests, err := r.buildPlanTests(table)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plan test %q: %w", table.Name, err)
+		}
+
+		allTests = append(allTests, tests...)
+	}
+
+	return allTests, nil
+}
+
+func (r *testSuiteRun) buildPlanTests(table *policyv1.PlanTestTable) ([]*planTest, error) {
+	inputActions := make(map[string]struct{}, len(table.Input.Actions))
+	for _, a := range table.Input.Actions {
+		inputActions[a] = struct{}{}
+	}
+
+	type expectation struct {
+		actions   map[string]*policyv1.PlanTestTable_PlanExpectation
+		resources []string
+	}
+
+	expectations := make(map[string]*expectation, len(table.Expected))
+	for _, e := range table.Expected {
+		if _, ok := expectations[e.Principal]; ok {
+			return nil, fmt.Errorf("found more than one expectation for principal %q", e.Principal)
+		}
+
+		var extraExpectations []string
+		for a := range e.Actions {
+			if _, ok := inputActions[a]; !ok {
+				extraExpectations = append(extraExpectations, a)
+			}
+		}
+		if len(extraExpectations) > 0 {
+			sort.Strings(extraExpectations)
+			return nil, fmt.Errorf("found expectations for actions that do not exist in the input actions list: [%s]", strings.Join(extraExpectations, ","))
+		}
+
+		resources, err := r.collectFixtures("", e.Resources, e.ResourceGroups, r.lookupResourceGroup)
+		if err != nil {
+			return nil, err
+		}
+
+		expectations[e.Principal] = &expectation{actions: e.Actions, resources: resources}
+	}
+
+	principals, err := r.collectFixtures("", table.Input.Principals, table.Input.PrincipalGroups, r.lookupPrincipalGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	auxData, err := r.lookupAuxData(table.Input.AuxData)
+	if err != nil {
+		return nil, err
+	}
+
+	options := table.Options
+	if options == nil {
+		options = r.Suite.Options
+	}
+
+	tests := make([]*planTest, len(principals))
+	for i, principalKey := range principals {
+		principal, err := r.lookupPrincipal(principalKey)
+		if err != nil {
+			return nil, err
+		}
+
+		test := &planTest{
+			Name: &policyv1.Test_TestName{
+				TestTableName: table.Name,
+				PrincipalKey:  principalKey,
+				ResourceKey:   table.Input.Resource.Kind,
+			},
+			Input: &enginev1.PlanResourcesInput{
+				Principal:     principal,
+				Resource:      table.Input.Resource,
+				Actions:       table.Input.Actions,
+				AuxData:       auxData,
+				FilterOptions: table.Input.FilterOptions,
+			},
+			Options: options,
+			Table:   table,
+		}
+
+		if e, ok := expectations[principalKey]; ok {
+			test.Expected = e.actions
+			test.Resources = e.resources
+			delete(expectations, principalKey)
+		}
+
+		tests[i] = test
+	}
+
+	for principal := range expectations {
+		return nil, fmt.Errorf("found an expectation for principal %q, but it is not present in input", principal)
+	}
+
+	return tests, nil
+}
+
+func (r *testSuiteRun) runPlanTest(ctx context.Context, eng Checker, test *planTest, action string) *policyv1.TestResults_Details {
+	details := &policyv1.TestResults_Details{}
+
+	planner, ok := eng.(Planner)
+	if !ok {
+		return planTestError(details, errPlanningUnsupported)
+	}
+
+	input := &enginev1.PlanResourcesInput{
+		Principal:     test.Input.Principal,
+		Resource:      test.Input.Resource,
+		Actions:       []string{action},
+		AuxData:       test.Input.AuxData,
+		FilterOptions: test.Input.FilterOptions,
+	}
+
+	checkOpts, usedDefaultNow := testCheckOptions(test.Options)
+	output, err := planner.Plan(ctx, input, checkOpts...)
+	if err == nil && *usedDefaultNow {
+		err = errUsedDefaultNow
+	}
+	if err != nil {
+		return planTestError(details, err)
+	}
+
+	expected := test.Expected[action]
+	expectedKind := expected.GetKind()
+	if expectedKind == enginev1.PlanResourcesFilter_KIND_UNSPECIFIED {
+		expectedKind = enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED
+	}
+
+	actualKind := output.GetFilter().GetKind()
+	var actualCondition string
+	if actualKind == enginev1.PlanResourcesFilter_KIND_CONDITIONAL {
+		actualCondition = conditionString(output.Filter)
+	}
+
+	failure := &policyv1.TestResults_PlanFailure{
+		ExpectedKind:      expectedKind,
+		ActualKind:        actualKind,
+		ExpectedCondition: expected.GetCondition(),
+		ActualCondition:   actualCondition,
+	}
+
+	failed := expectedKind != actualKind
+	if expected.GetCondition() != "" && normaliseCondition(expected.GetCondition()) != normaliseCondition(actualCondition) {
+		failed = true
+	}
+
+	if !failed {
+		failure.Resources, err = r.crossCheckPlan(ctx, eng, test, action, output.Filter)
+		if err != nil {
+			return planTestError(details, err)
+		}
+
+		failed = len(failure.Resources) > 0
+	}
+
+	if failed {
+		details.Result = policyv1.TestResults_RESULT_FAILED
+		details.Outcome = &policyv1.TestResults_Details_PlanFailure{PlanFailure: failure}
+		return details
+	}
+
+	details.Result = policyv1.TestResults_RESULT_PASSED
+	details.Outcome = &policyv1.TestResults_Details_PlanSuccess{
+		PlanSuccess: &policyv1.TestResults_PlanSuccess{
+			Kind:      actualKind,
+			Condition: actualCondition,
+		},
+	}
+	return details
+}
+
+// crossCheckPlan evaluates the plan filter against each of the listed resources and compares the result with the decision
+// produced by the engine for the same principal, resource and action.
+func (r *testSuiteRun) crossCheckPlan(ctx context.Context, eng Checker, test *planTest, action string, filter *enginev1.PlanResourcesFilter) ([]*policyv1.TestResults_PlanFailure_ResourceMismatch, error) {
+	var mismatches []*policyv1.TestResults_PlanFailure_ResourceMismatch
+
+	for _, resourceKey := range test.Resources {
+		resource, err := r.lookupResource(resourceKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if resource.Kind != test.Input.Resource.Kind {
+			return nil, fmt.Errorf("resource %q has kind %q, but the plan is for kind %q", resourceKey, resource.Kind, test.Input.Resource.Kind)
+		}
+
+		filterAllowed, err := evalPlanFilter(filter, resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate the plan filter against resource %q: %w", resourceKey, err)
+		}
+
+		inputs := []*enginev1.CheckInput{{
+			Principal: test.Input.Principal,
+			Resource:  resource,
+			Actions:   []string{action},
+			AuxData:   test.Input.AuxData,
+		}}
+
+		actual, _, err := performCheck(ctx, eng, inputs, test.Options, &Config{})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(actual) == 0 {
+			return nil, errors.New("empty response from server")
+		}
+
+		checkEffect := actual[0].Actions[action].GetEffect()
+		if filterAllowed != (checkEffect == effectv1.Effect_EFFECT_ALLOW) {
+			mismatches = append(mismatches, &policyv1.TestResults_PlanFailure_ResourceMismatch{
+				Resource:      resourceKey,
+				FilterAllowed: filterAllowed,
+				CheckEffect:   checkEffect,
+			})
+		}
+	}
+
+	return mismatches, nil
+}
+
+func planTestError(details *policyv1.TestResults_Details, err error) *policyv1.TestResults_Details {
+	details.Result = policyv1.TestResults_RESULT_ERRORED
+	details.Outcome = &policyv1.TestResults_Details_Error{Error: err.Error()}
+	return details
+}
+
+// normaliseCondition collapses whitespace so that expected conditions can be wrapped over several lines.
+// conditionString returns the condition of the filter in the same format as the filterDebug field of the response.
+// The fields of structs are generated by iterating over maps, so they are sorted by name to make the condition stable.
+func conditionString(filter *enginev1.PlanResourcesFilter) string {
+	filter = proto.Clone(filter).(*enginev1.PlanResourcesFilter) //nolint:forcetypeassert
+	sortStructFields(filter.Condition)
+	return planner.FilterToString(filter)
+}
+
+func sortStructFields(operand *enginev1.PlanResourcesFilter_Expression_Operand) {
+	expr := operand.GetExpression()
+	if expr == nil {
+		return
+	}
+
+	for _, op := range expr.Operands {
+		sortStructFields(op)
+	}
+
+	if expr.Operator == planner.Struct {
+		slices.SortStableFunc(expr.Operands, func(a, b *enginev1.PlanResourcesFilter_Expression_Operand) int {
+			return cmp.Compare(setFieldName(a), setFieldName(b))
+		})
+	}
+}
+
+func setFieldName(operand *enginev1.PlanResourcesFilter_Expression_Operand) string {
+	if operands := operand.GetExpression().GetOperands(); len(operands) > 0 {
+		return operands[0].GetValue().GetStringValue()
+	}
+
+	return ""
+}
+
+func normaliseCondition(condition string) string {
+	return strings.Join(strings.Fields(condition), " ")
+}
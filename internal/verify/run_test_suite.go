@@ -22,7 +22,7 @@ import (
 
 var errUsedDefaultNow = errors.New("a policy used a time-based condition, but `now` was not provided in the test options")
 
-func runTestSuite(ctx context.Context, eng Checker, filter *testFilter, file string, suite *policyv1.TestSuite, fixture *TestFixture, trace bool) *policyv1.TestResults_Suite {
+func runTestSuite(ctx context.Context, eng Checker, filter *testFilter, file string, suite *policyv1.TestSuite, fixture *TestFixture, conf *Config) *policyv1.TestResults_Suite {
 	summary := &policyv1.TestResults_Summary{}
 	results := &policyv1.TestResults_Suite{
 		File:        file,
@@ -70,6 +70,13 @@ func runTestSuite(ctx context.Context, eng Checker, filter *testFilter, file str
 		return results
 	}
 
+	planTests, err := run.getPlanTests()
+	if err != nil {
+		results.Summary.OverallResult = policyv1.TestResults_RESULT_ERRORED
+		results.Error = fmt.Sprintf("Failed to load the test suite: %s", err.Error())
+		return results
+	}
+
 	for _, test := range tests {
 		if err := ctx.Err(); err != nil {
 			return results
@@ -84,7 +91,25 @@ func runTestSuite(ctx context.Context, eng Checker, filter *testFilter, file str
 		}
 
 		for _, action := range test.Input.Actions {
-			addResult(results, test.Name, action, runTest(ctx, eng, test, action, trace))
+			addResult(results, test.Name, action, runTest(ctx, eng, test, action, conf))
+		}
+	}
+
+	for _, test := range planTests {
+		if err := ctx.Err(); err != nil {
+			return results
+		}
+
+		if skipped := filter.ApplyPlan(test, suite); skipped != nil {
+			for _, action := range test.Input.Actions {
+				addResult(results, test.Name, action, skipped)
+			}
+
+			continue
+		}
+
+		for _, action := range test.Input.Actions {
+			addResult(results, test.Name, action, run.runPlanTest(ctx, eng, test, action))
 		}
 	}
 
@@ -108,6 +133,13 @@ func (r *testSuiteRun) checkUniqueTestNames() error {
 		dupes[t.Name] = struct{}{}
 	}
 
+	for _, t := range r.Suite.PlanTests {
+		if _, ok := dupes[t.Name]; ok {
+			errs = multierr.Append(errs, fmt.Errorf("another test named %s already exists", t.Name))
+		}
+		dupes[t.Name] = struct{}{}
+	}
+
 	return errs
 }
 
@@ -252,7 +284,7 @@ func (r *testSuiteRun) lookupAuxData(name string) (*enginev1.AuxData, error) {
 	return nil, fmt.Errorf("auxData %q not found", name)
 }
 
-func runTest(ctx context.Context, eng Checker, test *policyv1.Test, action string, trace bool) *policyv1.TestResults_Details {
+func runTest(ctx context.Context, eng Checker, test *policyv1.Test, action string, conf *Config) *policyv1.TestResults_Details {
 	details := &policyv1.TestResults_Details{}
 
 	inputs := []*enginev1.CheckInput{{
@@ -263,7 +295,7 @@ func runTest(ctx context.Context, eng Checker, test *policyv1.Test, action strin
 		AuxData:   test.Input.AuxData,
 	}}
 
-	actual, traces, err := performCheck(ctx, eng, inputs, test.Options, trace)
+	actual, traces, err := performCheck(ctx, eng, inputs, test.Options, conf)
 	details.EngineTrace = traces
 
 	if err != nil {
@@ -351,15 +383,42 @@ func runTest(ctx context.Context, eng Checker, test *policyv1.Test, action strin
 	return details
 }
 
-func performCheck(ctx context.Context, eng Checker, inputs []*enginev1.CheckInput, options *policyv1.TestOptions, trace bool) (_ []*enginev1.CheckOutput, traces []*enginev1.Trace, _ error) {
+func performCheck(ctx context.Context, eng Checker, inputs []*enginev1.CheckInput, options *policyv1.TestOptions, conf *Config) (_ []*enginev1.CheckOutput, traces []*enginev1.Trace, _ error) {
+	checkOpts, usedDefaultNow := testCheckOptions(options)
+
+	var sinks []tracer.Sink
+	if conf.Trace {
+		traceCollector := tracer.NewCollector()
+		sinks = append(sinks, traceCollector)
+		defer func() { traces = traceCollector.Traces() }()
+	}
+
+	if conf.Coverage != nil {
+		sinks = append(sinks, conf.Coverage)
+	}
+
+	if len(sinks) > 0 {
+		checkOpts = append(checkOpts, evaluator.WithTraceSink(tracer.MultiSink(sinks...)))
+	}
+
+	output, err := eng.Check(ctx, inputs, checkOpts...)
+	if err == nil && *usedDefaultNow {
+		err = errUsedDefaultNow
+	}
+	return output, traces, err
+}
+
+// testCheckOptions converts the test options to engine options.
+// The returned flag is set if a policy calls `now` without the test options providing a value for it.
+func testCheckOptions(options *policyv1.TestOptions) ([]evaluator.CheckOpt, *bool) {
 	var checkOpts []evaluator.CheckOpt
 
-	usedDefaultNow := false
+	usedDefaultNow := new(bool)
 	if now := options.GetNow(); now != nil {
 		checkOpts = append(checkOpts, evaluator.WithNowFunc(now.AsTime))
 	} else {
 		checkOpts = append(checkOpts, evaluator.WithNowFunc(func() time.Time {
-			usedDefaultNow = true
+			*usedDefaultNow = true
 			return time.Time{}
 		}))
 	}
@@ -376,15 +435,5 @@ func performCheck(ctx context.Context, eng Checker, inputs []*enginev1.CheckInpu
 		checkOpts = append(checkOpts, evaluator.WithDefaultPolicyVersion(defaultPolicyVersion))
 	}
 
-	if trace {
-		traceCollector := tracer.NewCollector()
-		checkOpts = append(checkOpts, evaluator.WithTraceSink(traceCollector))
-		defer func() { traces = traceCollector.Traces() }()
-	}
-
-	output, err := eng.Check(ctx, inputs, checkOpts...)
-	if err == nil && usedDefaultNow {
-		err = errUsedDefaultNow
-	}
-	return output, traces, err
+	return checkOpts, usedDefaultNow
 }
@@ -43,29 +43,38 @@ func newTestFilter(conf *Config) (*testFilter, error) {
 
 // Apply checks if the filter matches the given test, returning nil if the test should be run or a "skipped" result otherwise.
 func (f *testFilter) Apply(test *policyv1.Test, suite *policyv1.TestSuite) *policyv1.TestResults_Details {
+	return f.apply(suite, test.Name, test.Input.Resource, test.Input.Principal, test.Options, test.Skip, test.SkipReason)
+}
+
+// ApplyPlan checks if the filter matches the given plan test, returning nil if the test should be run or a "skipped" result otherwise.
+func (f *testFilter) ApplyPlan(test *planTest, suite *policyv1.TestSuite) *policyv1.TestResults_Details {
+	return f.apply(suite, test.Name, test.Input.Resource, test.Input.Principal, test.Options, test.Table.Skip, test.Table.SkipReason)
+}
+
+func (f *testFilter) apply(suite *policyv1.TestSuite, name *policyv1.Test_TestName, resource resourceFixture, principal *enginev1.Principal, options *policyv1.TestOptions, skip bool, skipReason string) *policyv1.TestResults_Details {
 	switch {
-	case !f.shouldRunTestNamed(fmt.Sprintf("%s/%s", suite.Name, test.Name.String())):
+	case !f.shouldRunTestNamed(fmt.Sprintf("%s/%s", suite.Name, name.String())):
 		return &policyv1.TestResults_Details{
 			Result:  policyv1.TestResults_RESULT_SKIPPED,
 			Outcome: &policyv1.TestResults_Details_SkipReason{SkipReason: SkipReasonName},
 		}
 
-	case !f.shouldRunTestForResource(test.Input.Resource, test.Options):
+	case !f.shouldRunTestForResource(resource, options):
 		return &policyv1.TestResults_Details{
 			Result:  policyv1.TestResults_RESULT_SKIPPED,
 			Outcome: &policyv1.TestResults_Details_SkipReason{SkipReason: SkipReasonResource},
 		}
 
-	case !f.shouldRunTestForPrincipal(test.Input.Principal, test.Options):
+	case !f.shouldRunTestForPrincipal(principal, options):
 		return &policyv1.TestResults_Details{
 			Result:  policyv1.TestResults_RESULT_SKIPPED,
 			Outcome: &policyv1.TestResults_Details_SkipReason{SkipReason: SkipReasonPrincipal},
 		}
 
-	case test.Skip:
+	case skip:
 		return &policyv1.TestResults_Details{
 			Result:  policyv1.TestResults_RESULT_SKIPPED,
-			Outcome: &policyv1.TestResults_Details_SkipReason{SkipReason: test.SkipReason},
+			Outcome: &policyv1.TestResults_Details_SkipReason{SkipReason: skipReason},
 		}
 
 	default:
@@ -81,12 +90,19 @@ func (f *testFilter) shouldRunTestNamed(name string) bool {
 	return f.includedTestNamesRegexp.MatchString(name)
 }
 
-func (f *testFilter) shouldRunTestForResource(resource *enginev1.Resource, options *policyv1.TestOptions) bool {
+// resourceFixture is implemented by the resources of both check and plan inputs.
+type resourceFixture interface {
+	GetKind() string
+	GetPolicyVersion() string
+	GetScope() string
+}
+
+func (f *testFilter) shouldRunTestForResource(resource resourceFixture, options *policyv1.TestOptions) bool {
 	if len(f.excludedResourcePolicyFQNs) == 0 {
 		return true
 	}
 
-	_, excluded := f.excludedResourcePolicyFQNs[namer.ResourcePolicyFQN(resource.Kind, policyVersion(resource, options), resource.Scope)]
+	_, excluded := f.excludedResourcePolicyFQNs[namer.ResourcePolicyFQN(resource.GetKind(), policyVersion(resource, options), resource.GetScope())]
 	return !excluded
 }
 
@@ -16,13 +16,16 @@ import (
 	internaljsonschema "github.com/cerbos/cerbos/internal/jsonschema"
 	"github.com/cerbos/cerbos/internal/util"
 	"github.com/cerbos/cerbos/internal/validator"
+	"github.com/cerbos/cerbos/internal/verify/coverage"
 )
 
 type Config struct {
 	ExcludedResourcePolicyFQNs  map[string]struct{}
 	ExcludedPrincipalPolicyFQNs map[string]struct{}
-	IncludedTestNamesRegexp     string
-	Trace                       bool
+	// Coverage records the parts of the policies that are exercised by the tests, if it is set.
+	Coverage                *coverage.Recorder
+	IncludedTestNamesRegexp string
+	Trace                   bool
 }
 
 type Checker interface {
@@ -35,33 +38,8 @@ func Verify(ctx context.Context, fsys fs.FS, eng Checker, conf Config) (*policyv
 	if err != nil {
 		return nil, err
 	}
-	var suiteDefs []string
-	fixtureDefs := make(map[string]struct{})
-
-	err = fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
-		if err := ctx.Err(); err != nil {
-			return err
-		}
-
-		if err != nil {
-			return err
-		}
-
-		if d.IsDir() {
-			if d.Name() == util.TestDataDirectory {
-				fixtureDefs[path] = struct{}{}
-				return fs.SkipDir
-			}
-
-			return nil
-		}
 
-		if util.IsSupportedTestFile(path) {
-			suiteDefs = append(suiteDefs, path)
-		}
-
-		return nil
-	})
+	suiteDefs, fixtureDefs, err := findTestFiles(ctx, fsys)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +107,7 @@ func Verify(ctx context.Context, fsys fs.FS, eng Checker, conf Config) (*policyv
 			}
 		}
 
-		return runTestSuite(ctx, eng, testFilter, file, suite, fixture, conf.Trace)
+		return runTestSuite(ctx, eng, testFilter, file, suite, fixture, &conf)
 	}
 
 	results := &policyv1.TestResults{
@@ -175,3 +153,73 @@ func addTally(summary *policyv1.TestResults_Summary, result policyv1.TestResults
 
 	return tally
 }
+
+// findTestFiles returns the paths of the test suites and the test fixture directories found in fsys.
+func findTestFiles(ctx context.Context, fsys fs.FS) ([]string, map[string]struct{}, error) {
+	var suiteDefs []string
+	fixtureDefs := make(map[string]struct{})
+
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == util.TestDataDirectory {
+				fixtureDefs[path] = struct{}{}
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if util.IsSupportedTestFile(path) {
+			suiteDefs = append(suiteDefs, path)
+		}
+
+		return nil
+	})
+
+	return suiteDefs, fixtureDefs, err
+}
+
+// PrincipalRoles returns the roles of all the principals defined in the test fixtures and test suites found in fsys.
+// It returns nil if there are no test suites. Files that can't be loaded are skipped because Verify reports them as errors.
+func PrincipalRoles(ctx context.Context, fsys fs.FS) (map[string]struct{}, error) {
+	suiteDefs, fixtureDefs, err := findTestFiles(ctx, fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(suiteDefs) == 0 {
+		return nil, nil
+	}
+
+	roles := make(map[string]struct{})
+	addRoles := func(principals map[string]*enginev1.Principal) {
+		for _, p := range principals {
+			for _, r := range p.Roles {
+				roles[r] = struct{}{}
+			}
+		}
+	}
+
+	for path := range fixtureDefs {
+		if principals, err := loadPrincipals(fsys, path); err == nil && principals != nil {
+			addRoles(principals.Fixtures)
+		}
+	}
+
+	for _, sd := range suiteDefs {
+		suite := &policyv1.TestSuite{}
+		if err := util.LoadFromJSONOrYAML(fsys, sd, suite); err == nil {
+			addRoles(suite.Principals)
+		}
+	}
+
+	return roles, nil
+}
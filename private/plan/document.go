@@ -0,0 +1,26 @@
+// Copyright 2021-2025 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/ruletable/planner/document"
+)
+
+// ErrUntranslatable is returned when the filter contains an expression that cannot be translated to the query language.
+var ErrUntranslatable = document.ErrUntranslatable
+
+// MongoDBQuery translates the filter to a MongoDB query document.
+// The fields map attribute names, as they appear in the filter, to the names of the fields in the documents.
+// An attribute that is not in the map is mapped by its longest prefix that is, and a prefix that is mapped to an empty field name
+// maps its attributes to the fields with the same names. For example, {"request.resource.attr": ""} maps request.resource.attr.owner to owner.
+func MongoDBQuery(filter *enginev1.PlanResourcesFilter, fields map[string]string) (map[string]any, error) {
+	return document.MongoDB(filter, fields)
+}
+
+// ElasticsearchQuery translates the filter to an Elasticsearch or OpenSearch query.
+// The fields are mapped in the same way as in MongoDBQuery.
+func ElasticsearchQuery(filter *enginev1.PlanResourcesFilter, fields map[string]string) (map[string]any, error) {
+	return document.Elasticsearch(filter, fields)
+}